@@ -72,7 +72,7 @@ func (s *AttestationsTestSuite) TestMsgTransfer_Attestations() {
 	t.Run("create attestations clients", func(t *testing.T) {
 		attestorAddresses := s.getAttestorAddresses()
 
-		clientStateA := attestations.NewClientState(attestorAddresses, 2, proofHeight)
+		clientStateA := attestations.NewClientState(attestorAddresses, 2, clienttypes.NewHeight(0, proofHeight))
 		consensusStateA := &attestations.ConsensusState{Timestamp: proofTimestamp}
 
 		msgCreateClientA, err := clienttypes.NewMsgCreateClient(clientStateA, consensusStateA, rlyWallet.FormattedAddress())
@@ -85,7 +85,7 @@ func (s *AttestationsTestSuite) TestMsgTransfer_Attestations() {
 		s.Require().NoError(testsuite.UnmarshalMsgResponses(txResp, &createClientRes))
 		clientIDA = createClientRes.ClientId
 
-		clientStateB := attestations.NewClientState(attestorAddresses, 2, proofHeight)
+		clientStateB := attestations.NewClientState(attestorAddresses, 2, clienttypes.NewHeight(0, proofHeight))
 		consensusStateB := &attestations.ConsensusState{Timestamp: proofTimestamp}
 
 		msgCreateClientB, err := clienttypes.NewMsgCreateClient(clientStateB, consensusStateB, rlyWallet.FormattedAddress())
@@ -335,7 +335,7 @@ func (s *AttestationsTestSuite) TestMsgTransfer_Timeout_Attestations() {
 	t.Run("create attestations clients", func(t *testing.T) {
 		attestorAddresses := s.getAttestorAddresses()
 
-		clientStateA := attestations.NewClientState(attestorAddresses, 2, proofHeight)
+		clientStateA := attestations.NewClientState(attestorAddresses, 2, clienttypes.NewHeight(0, proofHeight))
 		consensusStateA := &attestations.ConsensusState{Timestamp: proofTimestamp}
 
 		msgCreateClientA, err := clienttypes.NewMsgCreateClient(clientStateA, consensusStateA, rlyWallet.FormattedAddress())
@@ -348,7 +348,7 @@ func (s *AttestationsTestSuite) TestMsgTransfer_Timeout_Attestations() {
 		s.Require().NoError(testsuite.UnmarshalMsgResponses(txResp, &createClientRes))
 		clientIDA = createClientRes.ClientId
 
-		clientStateB := attestations.NewClientState(attestorAddresses, 2, proofHeight)
+		clientStateB := attestations.NewClientState(attestorAddresses, 2, clienttypes.NewHeight(0, proofHeight))
 		consensusStateB := &attestations.ConsensusState{Timestamp: proofTimestamp}
 
 		msgCreateClientB, err := clienttypes.NewMsgCreateClient(clientStateB, consensusStateB, rlyWallet.FormattedAddress())
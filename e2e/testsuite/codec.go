@@ -39,6 +39,9 @@ import (
 	solomachine "github.com/cosmos/ibc-go/v11/modules/light-clients/06-solomachine"
 	ibctmtypes "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
 	attestationstypes "github.com/cosmos/ibc-go/v11/modules/light-clients/attestations"
+	ethereumtypes "github.com/cosmos/ibc-go/v11/modules/light-clients/ethereum"
+	quorumtypes "github.com/cosmos/ibc-go/v11/modules/light-clients/quorum"
+	zktypes "github.com/cosmos/ibc-go/v11/modules/light-clients/zk"
 	ibctesting "github.com/cosmos/ibc-go/v11/testing"
 )
 
@@ -78,6 +81,9 @@ func codecAndEncodingConfig() (*codec.ProtoCodec, testutil.TestEncodingConfig) {
 	ibctmtypes.RegisterInterfaces(cfg.InterfaceRegistry)
 	wasmtypes.RegisterInterfaces(cfg.InterfaceRegistry)
 	attestationstypes.RegisterInterfaces(cfg.InterfaceRegistry)
+	ethereumtypes.RegisterInterfaces(cfg.InterfaceRegistry)
+	quorumtypes.RegisterInterfaces(cfg.InterfaceRegistry)
+	zktypes.RegisterInterfaces(cfg.InterfaceRegistry)
 	channeltypesv2.RegisterInterfaces(cfg.InterfaceRegistry)
 	packetforwardtypes.RegisterInterfaces(cfg.InterfaceRegistry)
 	ratelimitingtypes.RegisterInterfaces(cfg.InterfaceRegistry)
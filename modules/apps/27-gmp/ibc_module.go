@@ -33,7 +33,9 @@ func NewIBCModule(k *keeper.Keeper) *IBCModule {
 	}
 }
 
-func (*IBCModule) OnSendPacket(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+// OnSendPacket implements the IBCModule interface. The call of the packet is stored as in flight until the packet is
+// acknowledged or timed out.
+func (im *IBCModule) OnSendPacket(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
 	if payload.SourcePort != types.PortID || payload.DestinationPort != types.PortID {
 		return errorsmod.Wrapf(channeltypesv2.ErrInvalidPacket, "payload port ID is invalid: expected %s, got sourcePort: %s destPort: %s", types.PortID, payload.SourcePort, payload.DestinationPort)
 	}
@@ -59,6 +61,10 @@ func (*IBCModule) OnSendPacket(ctx sdk.Context, sourceChannel string, destinatio
 		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "sender %s is different from signer %s", sender, signer)
 	}
 
+	if err := im.keeper.OnSendPacket(ctx, sourceChannel, sequence, *data); err != nil {
+		return err
+	}
+
 	events.EmitSendCall(
 		ctx,
 		*data,
@@ -131,6 +137,7 @@ func (im *IBCModule) OnRecvPacket(ctx sdk.Context, sourceClient, destinationClie
 		ctx,
 		packetData,
 		destinationClient,
+		relayer,
 	)
 	if err != nil {
 		ackErr = err
@@ -160,11 +167,32 @@ func (im *IBCModule) OnRecvPacket(ctx sdk.Context, sourceClient, destinationClie
 	}
 }
 
-func (*IBCModule) OnTimeoutPacket(_ sdk.Context, _, _ string, _ uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+// OnTimeoutPacket implements the IBCModule interface. The timeout of the call in flight of the packet is recorded.
+func (im *IBCModule) OnTimeoutPacket(ctx sdk.Context, sourceClient, destinationClient string, sequence uint64, payload channeltypesv2.Payload, _ sdk.AccAddress) error {
+	callResult, err := im.keeper.OnTimeoutPacket(ctx, sourceClient, sequence)
+	if err != nil {
+		return err
+	}
+
+	if callResult != nil {
+		events.EmitOnTimeoutPacketEvent(ctx, *callResult, destinationClient, payload.SourcePort, payload.DestinationPort)
+	}
+
 	return nil
 }
 
-func (*IBCModule) OnAcknowledgementPacket(_ sdk.Context, _, _ string, _ uint64, _ []byte, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+// OnAcknowledgementPacket implements the IBCModule interface. The result or the error of the call in flight of the
+// packet is recorded from the acknowledgement.
+func (im *IBCModule) OnAcknowledgementPacket(ctx sdk.Context, sourceClient, destinationClient string, sequence uint64, acknowledgement []byte, payload channeltypesv2.Payload, _ sdk.AccAddress) error {
+	callResult, err := im.keeper.OnAcknowledgementPacket(ctx, sourceClient, sequence, acknowledgement, payload)
+	if err != nil {
+		return err
+	}
+
+	if callResult != nil {
+		events.EmitOnAcknowledgementPacketEvent(ctx, *callResult, destinationClient, payload.SourcePort, payload.DestinationPort)
+	}
+
 	return nil
 }
 
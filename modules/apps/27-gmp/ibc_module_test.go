@@ -3,11 +3,15 @@
 package gmp_test
 
 import (
+	"encoding/base64"
+	"strconv"
 	"testing"
 
 	"github.com/cosmos/gogoproto/proto"
 	testifysuite "github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -144,6 +148,10 @@ func (s *IBCModuleTestSuite) TestOnSendPacket() {
 			expPass := tc.expErr == nil
 			if expPass {
 				s.Require().NoError(err)
+
+				callResult, err := s.chainA.GetSimApp().GMPKeeper.GetCallResult(s.chainA.GetContext(), sourceClient, 1)
+				s.Require().NoError(err)
+				s.Require().Equal(types.NewPendingCallResult(sourceClient, 1, packetData), *callResult)
 			} else {
 				s.Require().ErrorIs(err, tc.expErr)
 			}
@@ -265,40 +273,234 @@ func (s *IBCModuleTestSuite) TestOnRecvPacket() {
 }
 
 func (s *IBCModuleTestSuite) TestOnTimeoutPacket() {
-	s.SetupTest()
+	var (
+		module   *gmp.IBCModule
+		sequence uint64
+	)
 
-	module := gmp.NewIBCModule(s.chainA.GetSimApp().GMPKeeper)
-	payload := channeltypesv2.Payload{}
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expResult bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: no call in flight",
+			func() {
+				sequence = 2
+			},
+			false,
+		},
+		{
+			"success: call result already recorded",
+			func() {
+				_, err := s.chainA.GetSimApp().GMPKeeper.OnTimeoutPacket(s.chainA.GetContext(), validClientID, sequence)
+				s.Require().NoError(err)
+			},
+			false,
+		},
+	}
 
-	err := module.OnTimeoutPacket(
-		s.chainA.GetContext(),
-		validClientID,
-		validClientID,
-		1,
-		payload,
-		s.chainA.SenderAccount.GetAddress(),
-	)
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
 
-	s.Require().NoError(err)
+			module = gmp.NewIBCModule(s.chainA.GetSimApp().GMPKeeper)
+			sequence = 1
+
+			payload := s.sendCall(module, sequence, types.EncodingProtobuf)
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			err := module.OnTimeoutPacket(
+				ctx,
+				validClientID,
+				validClientID,
+				sequence,
+				payload,
+				s.chainA.SenderAccount.GetAddress(),
+			)
+			s.Require().NoError(err)
+
+			if !tc.expResult {
+				s.Require().Empty(ctx.EventManager().Events())
+				return
+			}
+
+			callResult, err := s.chainA.GetSimApp().GMPKeeper.GetCallResult(ctx, validClientID, sequence)
+			s.Require().NoError(err)
+			s.Require().Equal(types.CALL_TIMEOUT, callResult.Status)
+			s.Require().Equal(ctx.BlockHeight(), callResult.Height)
+
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeTimeout,
+					s.callResultAttributes(sequence, types.CALL_TIMEOUT)...,
+				),
+			}.ToABCIEvents()
+
+			ibctesting.AssertEvents(&s.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+		})
+	}
 }
 
 func (s *IBCModuleTestSuite) TestOnAcknowledgementPacket() {
-	s.SetupTest()
+	var (
+		module  *gmp.IBCModule
+		payload channeltypesv2.Payload
+		ack     []byte
+	)
 
-	module := gmp.NewIBCModule(s.chainA.GetSimApp().GMPKeeper)
-	payload := channeltypesv2.Payload{}
+	result := []byte("result")
 
-	err := module.OnAcknowledgementPacket(
-		s.chainA.GetContext(),
-		validClientID,
-		validClientID,
-		1,
-		[]byte("ack"),
-		payload,
-		s.chainA.SenderAccount.GetAddress(),
-	)
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus types.CallStatus
+		expError  string
+	}{
+		{
+			"success: protobuf encoding",
+			func() {},
+			types.CALL_SUCCESS,
+			"",
+		},
+		{
+			"success: json encoding",
+			func() {
+				payload = s.sendCall(module, 2, types.EncodingJSON)
+				ack = s.marshalAck(result, types.EncodingJSON)
+			},
+			types.CALL_SUCCESS,
+			"",
+		},
+		{
+			"success: abi encoding",
+			func() {
+				payload = s.sendCall(module, 2, types.EncodingABI)
+				ack = s.marshalAck(result, types.EncodingABI)
+			},
+			types.CALL_SUCCESS,
+			"",
+		},
+		{
+			"failure: error acknowledgement",
+			func() {
+				ack = channeltypesv2.ErrorAcknowledgement[:]
+			},
+			types.CALL_FAILURE,
+			"error acknowledgement received from the destination chain",
+		},
+		{
+			"acknowledgement cannot be decoded: call result not recorded",
+			func() {
+				ack = []byte("invalid")
+			},
+			types.CALL_UNSPECIFIED,
+			"",
+		},
+		{
+			"failure: invalid version",
+			func() {
+				payload.Version = "invalid-version"
+			},
+			types.CALL_FAILURE,
+			types.ErrInvalidVersion.Error(),
+		},
+		{
+			"no call in flight",
+			func() {
+				err := s.chainA.GetSimApp().GMPKeeper.CallResults.Remove(s.chainA.GetContext(), collections.Join(validClientID, uint64(1)))
+				s.Require().NoError(err)
+			},
+			types.CALL_UNSPECIFIED,
+			"",
+		},
+		{
+			"call result already recorded",
+			func() {
+				_, err := s.chainA.GetSimApp().GMPKeeper.OnTimeoutPacket(s.chainA.GetContext(), validClientID, 1)
+				s.Require().NoError(err)
+			},
+			types.CALL_TIMEOUT,
+			"",
+		},
+	}
 
-	s.Require().NoError(err)
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			module = gmp.NewIBCModule(s.chainA.GetSimApp().GMPKeeper)
+			sequence := uint64(1)
+
+			payload = s.sendCall(module, sequence, types.EncodingProtobuf)
+			ack = s.marshalAck(result, types.EncodingProtobuf)
+
+			tc.malleate()
+
+			if payload.Encoding != types.EncodingProtobuf {
+				sequence = 2
+			}
+
+			ctx := s.chainA.GetContext()
+			err := module.OnAcknowledgementPacket(
+				ctx,
+				validClientID,
+				validClientID,
+				sequence,
+				ack,
+				payload,
+				s.chainA.SenderAccount.GetAddress(),
+			)
+			s.Require().NoError(err)
+
+			callResult, err := s.chainA.GetSimApp().GMPKeeper.GetCallResult(ctx, validClientID, sequence)
+			switch tc.expStatus {
+			case types.CALL_UNSPECIFIED:
+				s.Require().ErrorIs(err, types.ErrCallResultNotFound)
+				s.Require().Empty(ctx.EventManager().Events())
+				return
+			case types.CALL_TIMEOUT:
+				s.Require().NoError(err)
+				s.Require().Equal(types.CALL_TIMEOUT, callResult.Status)
+				s.Require().Empty(ctx.EventManager().Events())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expStatus, callResult.Status)
+			s.Require().Equal(ctx.BlockHeight(), callResult.Height)
+
+			expectedEvent := sdk.NewEvent(
+				types.EventTypeAckPacket,
+				s.callResultAttributes(sequence, tc.expStatus)...,
+			)
+
+			if tc.expStatus == types.CALL_SUCCESS {
+				s.Require().Equal(result, callResult.Result)
+				s.Require().Empty(callResult.Error)
+				expectedEvent = expectedEvent.AppendAttributes(
+					sdk.NewAttribute(types.AttributeKeyAckSuccess, "true"),
+					sdk.NewAttribute(types.AttributeKeyCallResult, base64.StdEncoding.EncodeToString(result)),
+				)
+			} else {
+				s.Require().Empty(callResult.Result)
+				s.Require().Contains(callResult.Error, tc.expError)
+				expectedEvent = expectedEvent.AppendAttributes(
+					sdk.NewAttribute(types.AttributeKeyAckSuccess, "false"),
+					sdk.NewAttribute(types.AttributeKeyAckError, callResult.Error),
+				)
+			}
+
+			ibctesting.AssertEvents(&s.Suite, sdk.Events{expectedEvent}.ToABCIEvents(), ctx.EventManager().Events().ToABCIEvents())
+		})
+	}
 }
 
 func (s *IBCModuleTestSuite) TestUnmarshalPacketData() {
@@ -392,3 +594,39 @@ func (s *IBCModuleTestSuite) serializeMsgs(msgs ...proto.Message) []byte {
 	s.Require().NoError(err)
 	return payload
 }
+
+// sendCall sends a call with the provided sequence and encoding from the sender account of chainA and returns its payload.
+func (s *IBCModuleTestSuite) sendCall(module *gmp.IBCModule, sequence uint64, encoding string) channeltypesv2.Payload {
+	sender := s.chainA.SenderAccount.GetAddress()
+	packetData := types.NewGMPPacketData(sender.String(), "", []byte("salt"), []byte("payload"), "")
+	dataBz, err := types.MarshalPacketData(&packetData, types.Version, encoding)
+	s.Require().NoError(err)
+
+	payload := channeltypesv2.NewPayload(types.PortID, types.PortID, types.Version, encoding, dataBz)
+	err = module.OnSendPacket(s.chainA.GetContext(), validClientID, validClientID, sequence, payload, sender)
+	s.Require().NoError(err)
+
+	return payload
+}
+
+// callResultAttributes returns the attributes shared by the acknowledgement and timeout events of a call sent with sendCall.
+func (s *IBCModuleTestSuite) callResultAttributes(sequence uint64, status types.CallStatus) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySender, s.chainA.SenderAccount.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyReceiver, ""),
+		sdk.NewAttribute(types.AttributeKeySalt, base64.StdEncoding.EncodeToString([]byte("salt"))),
+		sdk.NewAttribute(types.AttributeKeySourceClient, validClientID),
+		sdk.NewAttribute(types.AttributeKeyDestinationClient, validClientID),
+		sdk.NewAttribute(types.AttributeKeySourcePort, types.PortID),
+		sdk.NewAttribute(types.AttributeKeyDestinationPort, types.PortID),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyCallStatus, status.String()),
+	}
+}
+
+func (s *IBCModuleTestSuite) marshalAck(result []byte, encoding string) []byte {
+	ack := types.NewAcknowledgement(result)
+	ackBz, err := types.MarshalAcknowledgement(&ack, types.Version, encoding)
+	s.Require().NoError(err)
+	return ackBz
+}
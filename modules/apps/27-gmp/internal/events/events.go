@@ -62,6 +62,73 @@ func EmitOnRecvPacketEvent(
 	})
 }
 
+// EmitOnAcknowledgementPacketEvent emits a GMP acknowledgement event recording the result or the error of a call.
+func EmitOnAcknowledgementPacketEvent(
+	ctx sdk.Context,
+	callResult types.CallResult,
+	destinationClient,
+	sourcePort,
+	destinationPort string,
+) {
+	attributes := callResultAttributes(callResult, destinationClient, sourcePort, destinationPort)
+	attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyAckSuccess, strconv.FormatBool(callResult.Status == types.CALL_SUCCESS)))
+	if callResult.Status == types.CALL_SUCCESS {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyCallResult, base64.StdEncoding.EncodeToString(callResult.Result)))
+	} else {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyAckError, callResult.Error))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAckPacket,
+			attributes...,
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitOnTimeoutPacketEvent emits a GMP timeout event recording the timeout of a call.
+func EmitOnTimeoutPacketEvent(
+	ctx sdk.Context,
+	callResult types.CallResult,
+	destinationClient,
+	sourcePort,
+	destinationPort string,
+) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			callResultAttributes(callResult, destinationClient, sourcePort, destinationPort)...,
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+func callResultAttributes(
+	callResult types.CallResult,
+	destinationClient,
+	sourcePort,
+	destinationPort string,
+) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySender, callResult.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, callResult.Receiver),
+		sdk.NewAttribute(types.AttributeKeySalt, base64.StdEncoding.EncodeToString(callResult.Salt)),
+		sdk.NewAttribute(types.AttributeKeySourceClient, callResult.ClientId),
+		sdk.NewAttribute(types.AttributeKeyDestinationClient, destinationClient),
+		sdk.NewAttribute(types.AttributeKeySourcePort, sourcePort),
+		sdk.NewAttribute(types.AttributeKeyDestinationPort, destinationPort),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(callResult.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyCallStatus, callResult.Status.String()),
+	}
+}
+
 func packetAttributes(
 	packetData types.GMPPacketData,
 	sourceClient,
@@ -83,3 +83,52 @@ func (k *Keeper) GetAccount(ctx context.Context, address sdk.AccAddress) (*types
 
 	return &ics27Acc, nil
 }
+
+// LinkAccount links the existing account of the provided address to the provided account identifier, such that the
+// account is controlled by the GMP packets of the account identifier. The account identifier must not have an ICS27
+// account or an account at its predictable address, and the address must not already be an ICS27 account. As any
+// transfer to the predictable address creates an account whose balances would become unreachable once linked, the
+// account identifier must then be linked with a new salt.
+func (k *Keeper) LinkAccount(ctx context.Context, accountID *types.AccountIdentifier, address sdk.AccAddress) error {
+	if len(accountID.Salt) > types.MaximumSaltLength {
+		return errorsmod.Wrapf(types.ErrInvalidSalt, "salt must not exceed %d bytes", types.MaximumSaltLength)
+	}
+
+	predictableAddr, err := types.BuildAddressPredictable(accountID)
+	if err != nil {
+		return err
+	}
+
+	accountKey := collections.Join3(accountID.ClientId, accountID.Sender, accountID.Salt)
+	if has, err := k.Accounts.Has(ctx, accountKey); err != nil {
+		return err
+	} else if has {
+		return errorsmod.Wrapf(types.ErrAccountAlreadyExists, "ICS27 account already exists for account identifier %s", accountID)
+	}
+
+	// an account at the predictable address would become unreachable once the account identifier is linked
+	if k.accountKeeper.GetAccount(ctx, predictableAddr) != nil {
+		return errorsmod.Wrapf(types.ErrAccountAlreadyExists, "account already exists at predictable address %s, a new salt must be used", predictableAddr)
+	}
+
+	if has, err := k.AccountsByAddress.Has(ctx, address); err != nil {
+		return err
+	} else if has {
+		return errorsmod.Wrapf(types.ErrAccountAlreadyExists, "address %s is already an ICS27 account", address)
+	}
+
+	if k.accountKeeper.GetAccount(ctx, address) == nil {
+		return errorsmod.Wrapf(types.ErrAccountNotFound, "account %s not found", address)
+	}
+
+	ics27Account := types.NewICS27Account(address.String(), accountID)
+	if err := k.Accounts.Set(ctx, accountKey, ics27Account); err != nil {
+		return errorsmod.Wrapf(err, "failed to set account %s in store", ics27Account)
+	}
+	if err := k.AccountsByAddress.Set(ctx, address, ics27Account); err != nil {
+		return errorsmod.Wrapf(err, "failed to set account by address %s in store", ics27Account)
+	}
+
+	k.Logger(ctx).Info("Linked existing account to ICS27 account identifier", "account", ics27Account)
+	return nil
+}
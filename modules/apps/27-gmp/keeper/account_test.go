@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func (s *KeeperTestSuite) TestLinkAccount() {
+	var (
+		accountID types.AccountIdentifier
+		address   sdk.AccAddress
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				accountID.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: empty sender",
+			func() {
+				accountID.Sender = ""
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: salt too long",
+			func() {
+				accountID.Salt = make([]byte, types.MaximumSaltLength+1)
+			},
+			types.ErrInvalidSalt,
+		},
+		{
+			"failure: ICS27 account already exists for account identifier",
+			func() {
+				predictableAddr, err := types.BuildAddressPredictable(&accountID)
+				s.Require().NoError(err)
+				s.createGMPAccount(predictableAddr.String())
+			},
+			types.ErrAccountAlreadyExists,
+		},
+		{
+			"failure: account already exists at predictable address",
+			func() {
+				predictableAddr, err := types.BuildAddressPredictable(&accountID)
+				s.Require().NoError(err)
+				s.fundAccount(predictableAddr, sdk.NewCoins(ibctesting.TestCoin))
+			},
+			types.ErrAccountAlreadyExists,
+		},
+		{
+			"success: linked with a new salt once the predictable address is taken",
+			func() {
+				predictableAddr, err := types.BuildAddressPredictable(&accountID)
+				s.Require().NoError(err)
+				s.fundAccount(predictableAddr, sdk.NewCoins(ibctesting.TestCoin))
+
+				accountID.Salt = []byte("new-salt")
+			},
+			nil,
+		},
+		{
+			"failure: address is already an ICS27 account",
+			func() {
+				ics27Account := types.NewICS27Account(address.String(), &types.AccountIdentifier{ClientId: ibctesting.FirstClientID, Sender: accountID.Sender, Salt: []byte("other-salt")})
+				err := s.chainA.GetSimApp().GMPKeeper.AccountsByAddress.Set(s.chainA.GetContext(), address, ics27Account)
+				s.Require().NoError(err)
+			},
+			types.ErrAccountAlreadyExists,
+		},
+		{
+			"failure: account not found",
+			func() {
+				address = sdk.AccAddress("unknown-address-----")
+			},
+			types.ErrAccountNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			accountID = types.NewAccountIdentifier(ibctesting.FirstClientID, s.chainB.SenderAccount.GetAddress().String(), []byte(testSalt))
+			address = s.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			err := s.chainA.GetSimApp().GMPKeeper.LinkAccount(ctx, &accountID, address)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+
+				ics27Account, err := s.chainA.GetSimApp().GMPKeeper.Accounts.Get(ctx, collections.Join3(accountID.ClientId, accountID.Sender, accountID.Salt))
+				s.Require().NoError(err)
+				s.Require().Equal(address.String(), ics27Account.Address)
+
+				ics27Account, err = s.chainA.GetSimApp().GMPKeeper.AccountsByAddress.Get(ctx, address)
+				s.Require().NoError(err)
+				s.Require().Equal(accountID, *ics27Account.AccountId)
+
+				// GMP packets of the account identifier are executed by the linked account
+				recipient := s.chainA.SenderAccount.GetAddress()
+				data := types.NewGMPPacketData(accountID.Sender, "", accountID.Salt, s.serializeMsgs(s.newMsgSend(address, recipient)), "")
+				_, err = s.chainA.GetSimApp().GMPKeeper.OnRecvPacket(ctx, &data, accountID.ClientId, recipient)
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
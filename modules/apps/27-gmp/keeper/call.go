@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
+)
+
+// GetCallResult retrieves the result of the call sent with the packet of the provided client and sequence.
+func (k *Keeper) GetCallResult(ctx context.Context, clientID string, sequence uint64) (*types.CallResult, error) {
+	callResult, err := k.CallResults.Get(ctx, collections.Join(clientID, sequence))
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrCallResultNotFound, "client ID %s, sequence %d", clientID, sequence)
+		}
+		return nil, err
+	}
+
+	return &callResult, nil
+}
+
+// SetCallResult stores the result of a call, keyed by the client and sequence of its packet. The results of calls
+// which are no longer pending are indexed as completed.
+func (k *Keeper) SetCallResult(ctx context.Context, callResult types.CallResult) error {
+	key := collections.Join(callResult.ClientId, callResult.Sequence)
+	if err := k.CallResults.Set(ctx, key, callResult); err != nil {
+		return err
+	}
+
+	if callResult.Status == types.CALL_PENDING {
+		return nil
+	}
+
+	return k.CompletedCallResults.Set(ctx, key)
+}
+
+// OnSendPacket stores the call sent with the packet of the provided source client and sequence as in flight.
+func (k *Keeper) OnSendPacket(ctx context.Context, sourceClient string, sequence uint64, data types.GMPPacketData) error {
+	if err := k.SetCallResult(ctx, types.NewPendingCallResult(sourceClient, sequence, data)); err != nil {
+		return errorsmod.Wrapf(err, "failed to set call of client %s and sequence %d in store", sourceClient, sequence)
+	}
+
+	return nil
+}
+
+// OnAcknowledgementPacket records the result of the call in flight of the provided source client and sequence. The
+// acknowledgement is decoded with the version and encoding of the payload. An error acknowledgement is recorded as a
+// failure. An acknowledgement which cannot be decoded is logged and the call is not recorded, as the destination chain
+// acknowledged its execution. The recorded call result is returned, or nil if no call is in flight for the packet or
+// its acknowledgement cannot be decoded.
+func (k *Keeper) OnAcknowledgementPacket(ctx context.Context, sourceClient string, sequence uint64, acknowledgement []byte, payload channeltypesv2.Payload) (*types.CallResult, error) {
+	callResult, found, err := k.getInFlightCall(ctx, sourceClient, sequence)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	switch {
+	case bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]):
+		callResult.Status = types.CALL_FAILURE
+		callResult.Error = "error acknowledgement received from the destination chain"
+	case payload.Version != types.Version:
+		callResult.Status = types.CALL_FAILURE
+		callResult.Error = errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, payload.Version).Error()
+	default:
+		ack, err := types.UnmarshalAcknowledgement(acknowledgement, payload.Version, payload.Encoding)
+		if err != nil {
+			k.Logger(ctx).Error("failed to decode acknowledgement, call result not recorded", "error", err, "source_client", sourceClient, "sequence", sequence)
+			return nil, k.deleteCallResult(ctx, sourceClient, sequence)
+		}
+
+		callResult.Status = types.CALL_SUCCESS
+		callResult.Result = ack.Result
+	}
+
+	return k.recordCallResult(ctx, callResult)
+}
+
+// OnTimeoutPacket records the timeout of the call in flight of the provided source client and sequence. The recorded
+// call result is returned, or nil if no call is in flight for the packet.
+func (k *Keeper) OnTimeoutPacket(ctx context.Context, sourceClient string, sequence uint64) (*types.CallResult, error) {
+	callResult, found, err := k.getInFlightCall(ctx, sourceClient, sequence)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	callResult.Status = types.CALL_TIMEOUT
+
+	return k.recordCallResult(ctx, callResult)
+}
+
+// getInFlightCall returns the call in flight of the provided source client and sequence, if any. Calls sent before
+// the results of calls were stored have no call in flight.
+func (k *Keeper) getInFlightCall(ctx context.Context, sourceClient string, sequence uint64) (types.CallResult, bool, error) {
+	callResult, err := k.CallResults.Get(ctx, collections.Join(sourceClient, sequence))
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			k.Logger(ctx).Info("no call in flight for packet", "source_client", sourceClient, "sequence", sequence)
+			return types.CallResult{}, false, nil
+		}
+		return types.CallResult{}, false, err
+	}
+
+	if callResult.Status != types.CALL_PENDING {
+		k.Logger(ctx).Error("call result already recorded for packet", "source_client", sourceClient, "sequence", sequence, "status", callResult.Status)
+		return types.CallResult{}, false, nil
+	}
+
+	return callResult, true, nil
+}
+
+// recordCallResult stores the result of a call along with the height at which it was recorded. The result is stored
+// if the MaxCallResults param is non-zero, pruning the completed results of the oldest calls sent on the source client
+// beyond the limit, or the call in flight is deleted otherwise.
+func (k *Keeper) recordCallResult(ctx context.Context, callResult types.CallResult) (*types.CallResult, error) {
+	callResult.Height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	maxCallResults := k.GetParams(ctx).MaxCallResults
+	if maxCallResults == 0 {
+		if err := k.deleteCallResult(ctx, callResult.ClientId, callResult.Sequence); err != nil {
+			return nil, err
+		}
+
+		return &callResult, nil
+	}
+
+	if err := k.SetCallResult(ctx, callResult); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to set call result of client %s and sequence %d in store", callResult.ClientId, callResult.Sequence)
+	}
+
+	if err := k.pruneCallResults(ctx, callResult.ClientId, maxCallResults); err != nil {
+		return nil, err
+	}
+
+	return &callResult, nil
+}
+
+// deleteCallResult deletes the call sent with the packet of the provided client and sequence, and its completed index.
+func (k *Keeper) deleteCallResult(ctx context.Context, clientID string, sequence uint64) error {
+	key := collections.Join(clientID, sequence)
+	if err := k.CallResults.Remove(ctx, key); err != nil {
+		return errorsmod.Wrapf(err, "failed to delete call result of client %s and sequence %d from store", clientID, sequence)
+	}
+
+	if err := k.CompletedCallResults.Remove(ctx, key); err != nil {
+		return errorsmod.Wrapf(err, "failed to delete completed call result index of client %s and sequence %d from store", clientID, sequence)
+	}
+
+	return nil
+}
+
+// pruneCallResults deletes the completed results of the calls sent on the provided client with the lowest sequences,
+// such that at most maxCallResults completed results are kept. Only the completed call results index is iterated, so
+// that the calls in flight are neither pruned nor iterated over.
+func (k *Keeper) pruneCallResults(ctx context.Context, clientID string, maxCallResults uint64) error {
+	var keys []collections.Pair[string, uint64]
+	if err := k.CompletedCallResults.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](clientID), func(key collections.Pair[string, uint64]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	if uint64(len(keys)) <= maxCallResults {
+		return nil
+	}
+
+	for _, key := range keys[:uint64(len(keys))-maxCallResults] {
+		if err := k.deleteCallResult(ctx, key.K1(), key.K2()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
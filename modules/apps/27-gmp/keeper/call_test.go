@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"slices"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func (s *KeeperTestSuite) TestPruneCallResults() {
+	var maxCallResults uint64
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expRecorded []uint64
+	}{
+		{
+			"success: results within the limit are kept",
+			func() {},
+			[]uint64{1, 2, 3},
+		},
+		{
+			"success: results of the lowest sequences are pruned beyond the limit",
+			func() {
+				maxCallResults = 2
+			},
+			[]uint64{2, 3},
+		},
+		{
+			"success: completed results are not stored",
+			func() {
+				maxCallResults = 0
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			maxCallResults = types.DefaultMaxCallResults
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			gmpKeeper := s.chainA.GetSimApp().GMPKeeper
+			params := types.DefaultParams()
+			params.MaxCallResults = maxCallResults
+			gmpKeeper.SetParams(ctx, params)
+
+			packetData := types.NewGMPPacketData(s.chainA.SenderAccount.GetAddress().String(), ibctesting.TestAccAddress, nil, nil, "")
+			for sequence := uint64(1); sequence <= 4; sequence++ {
+				err := gmpKeeper.SetCallResult(ctx, types.NewPendingCallResult(ibctesting.FirstClientID, sequence, packetData))
+				s.Require().NoError(err)
+			}
+
+			// the call of sequence 4 remains in flight
+			for sequence := uint64(1); sequence <= 3; sequence++ {
+				callResult, err := gmpKeeper.OnTimeoutPacket(ctx, ibctesting.FirstClientID, sequence)
+				s.Require().NoError(err)
+				s.Require().Equal(types.CALL_TIMEOUT, callResult.Status)
+			}
+
+			for sequence := uint64(1); sequence <= 3; sequence++ {
+				callResult, err := gmpKeeper.GetCallResult(ctx, ibctesting.FirstClientID, sequence)
+				if !slices.Contains(tc.expRecorded, sequence) {
+					s.Require().ErrorIs(err, types.ErrCallResultNotFound)
+					continue
+				}
+
+				s.Require().NoError(err)
+				s.Require().Equal(types.CALL_TIMEOUT, callResult.Status)
+			}
+
+			callResult, err := gmpKeeper.GetCallResult(ctx, ibctesting.FirstClientID, 4)
+			s.Require().NoError(err)
+			s.Require().Equal(types.CALL_PENDING, callResult.Status)
+
+			// only the recorded results are indexed as completed
+			var indexed []uint64
+			err = gmpKeeper.CompletedCallResults.Walk(ctx, nil, func(key collections.Pair[string, uint64]) (bool, error) {
+				indexed = append(indexed, key.K2())
+				return false, nil
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expRecorded, indexed)
+		})
+	}
+}
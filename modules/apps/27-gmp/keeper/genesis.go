@@ -37,6 +37,20 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 		}
 	}
 
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, data.Params)
+
+	for _, callResult := range data.CallResults {
+		if err := callResult.Validate(); err != nil {
+			return err
+		}
+		if err := k.SetCallResult(ctx, callResult); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -54,7 +68,18 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	var callResults []types.CallResult
+	if err := k.CallResults.Walk(ctx, nil, func(_ collections.Pair[string, uint64], value types.CallResult) (bool, error) {
+		callResults = append(callResults, value)
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Ics27Accounts: accounts,
+		Params:        k.GetParams(ctx),
+		CallResults:   callResults,
 	}, nil
 }
@@ -48,6 +48,13 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 			},
 			ibcerrors.ErrInvalidAddress,
 		},
+		{
+			"failure: invalid call result",
+			func() {
+				genesisState.CallResults[0].Status = types.CALL_UNSPECIFIED
+			},
+			types.ErrInvalidCallResult,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -66,6 +73,10 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 						AccountId:      accountID,
 					},
 				},
+				Params: types.NewParams(1_000_000),
+				CallResults: []types.CallResult{
+					types.NewPendingCallResult(ibctesting.FirstClientID, 1, types.NewGMPPacketData(s.chainA.SenderAccount.GetAddress().String(), "", []byte(testSalt), []byte("payload"), "")),
+				},
 			}
 
 			tc.malleate()
@@ -74,6 +85,7 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 
 			if tc.expErr == nil {
 				s.Require().NoError(err)
+				s.Require().Equal(genesisState.Params, s.chainA.GetSimApp().GMPKeeper.GetParams(s.chainA.GetContext()))
 
 				if len(genesisState.Ics27Accounts) > 0 {
 					account := genesisState.Ics27Accounts[0]
@@ -99,6 +111,12 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 					s.Require().Equal(account.AccountId.Sender, storedAccount.AccountId.Sender)
 					s.Require().Equal(account.AccountId.Salt, storedAccount.AccountId.Salt)
 				}
+
+				for _, expCallResult := range genesisState.CallResults {
+					callResult, err := s.chainA.GetSimApp().GMPKeeper.GetCallResult(s.chainA.GetContext(), expCallResult.ClientId, expCallResult.Sequence)
+					s.Require().NoError(err)
+					s.Require().Equal(expCallResult, *callResult)
+				}
 			} else {
 				s.Require().ErrorIs(err, tc.expErr)
 			}
@@ -124,6 +142,14 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 
 	s.createGMPAccount(gmpAccountAddr)
 
+	expParams := types.NewParams(1_000_000)
+	s.chainA.GetSimApp().GMPKeeper.SetParams(s.chainA.GetContext(), expParams)
+
+	packetData := types.NewGMPPacketData(s.chainA.SenderAccount.GetAddress().String(), "", []byte(testSalt), []byte("payload"), "")
+	expCallResult := types.NewPendingCallResult(ibctesting.FirstClientID, 1, packetData)
+	err = s.chainA.GetSimApp().GMPKeeper.SetCallResult(s.chainA.GetContext(), expCallResult)
+	s.Require().NoError(err)
+
 	genesisState, err := s.chainA.GetSimApp().GMPKeeper.ExportGenesis(s.chainA.GetContext())
 	s.Require().NoError(err)
 	s.Require().Len(genesisState.Ics27Accounts, 1)
@@ -131,4 +157,6 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	s.Require().Equal(ibctesting.FirstClientID, genesisState.Ics27Accounts[0].AccountId.ClientId)
 	s.Require().Equal(sender, genesisState.Ics27Accounts[0].AccountId.Sender)
 	s.Require().Equal([]byte(testSalt), genesisState.Ics27Accounts[0].AccountId.Salt)
+	s.Require().Equal(expParams, genesisState.Params)
+	s.Require().Equal([]types.CallResult{expCallResult}, genesisState.CallResults)
 }
@@ -25,6 +25,7 @@ type Keeper struct {
 	msgRouter types.MessageRouter
 
 	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -36,6 +37,13 @@ type Keeper struct {
 	Accounts collections.Map[collections.Triple[string, string, []byte], types.ICS27Account]
 	// AccountsByAddress is a map of sdk.AccAddress to ICS27Account, for reverse lookups
 	AccountsByAddress collections.Map[sdk.AccAddress, types.ICS27Account]
+	// ModuleParams is the 27-gmp module parameters
+	ModuleParams collections.Item[types.Params]
+	// CallResults is a map of (ClientID, Sequence) to the CallResult of the call sent with the packet
+	CallResults collections.Map[collections.Pair[string, uint64], types.CallResult]
+	// CompletedCallResults is the set of (ClientID, Sequence) of the call results which are no longer pending, such
+	// that completed results are pruned without iterating over the calls in flight
+	CompletedCallResults collections.KeySet[collections.Pair[string, uint64]]
 }
 
 // NewKeeper creates a new Keeper instance
@@ -50,12 +58,15 @@ func NewKeeper(
 
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
-		cdc:               cdc,
-		msgRouter:         msgRouter,
-		accountKeeper:     accountKeeper,
-		authority:         authority,
-		Accounts:          collections.NewMap(sb, types.AccountsKey, "accounts", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.BytesKey), codec.CollValue[types.ICS27Account](cdc)),
-		AccountsByAddress: collections.NewMap(sb, types.AccountsByAddressKey, "accounts_by_address", sdk.AccAddressKey, codec.CollValue[types.ICS27Account](cdc)),
+		cdc:                  cdc,
+		msgRouter:            msgRouter,
+		accountKeeper:        accountKeeper,
+		authority:            authority,
+		Accounts:             collections.NewMap(sb, types.AccountsKey, "accounts", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.BytesKey), codec.CollValue[types.ICS27Account](cdc)),
+		AccountsByAddress:    collections.NewMap(sb, types.AccountsByAddressKey, "accounts_by_address", sdk.AccAddressKey, codec.CollValue[types.ICS27Account](cdc)),
+		ModuleParams:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		CallResults:          collections.NewMap(sb, types.CallResultsKey, "call_results", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.CallResult](cdc)),
+		CompletedCallResults: collections.NewKeySet(sb, types.CompletedCallResultsKey, "completed_call_results", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
 	}
 
 	schema, err := sb.Build()
@@ -68,11 +79,37 @@ func NewKeeper(
 	return &k
 }
 
+// WithBankKeeper sets the bank keeper. This function may be used after the keepers creation to pay the relayer fees
+// of packets, which cannot be set without it.
+func (k *Keeper) WithBankKeeper(bankKeeper types.BankKeeper) {
+	k.bankKeeper = bankKeeper
+}
+
 // GetAuthority returns the module's authority.
 func (k *Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// GetParams returns the current 27-gmp module parameters, or the default parameters if none are set.
+func (k *Keeper) GetParams(ctx context.Context) types.Params {
+	params, err := k.ModuleParams.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams()
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return params
+}
+
+// SetParams sets the 27-gmp module parameters.
+func (k *Keeper) SetParams(ctx context.Context, params types.Params) {
+	if err := k.ModuleParams.Set(ctx, params); err != nil {
+		panic(err)
+	}
+}
+
 // Logger returns a module-specific logger.
 func (*Keeper) Logger(goCtx context.Context) log.Logger {
 	return sdk.UnwrapSDKContext(goCtx).Logger().With("module", "x/"+exported.ModuleName+"-"+types.ModuleName)
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 sets the MaxCallResults param to its default value and indexes the call results which are no longer
+// pending as completed. The completed call results already stored are pruned once the next call result of their
+// client is recorded.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MaxCallResults = types.DefaultMaxCallResults
+	m.keeper.SetParams(ctx, params)
+
+	return m.keeper.CallResults.Walk(ctx, nil, func(key collections.Pair[string, uint64], callResult types.CallResult) (bool, error) {
+		if callResult.Status == types.CALL_PENDING {
+			return false, nil
+		}
+
+		return false, m.keeper.CompletedCallResults.Set(ctx, key)
+	})
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/keeper"
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	testCases := []struct {
+		name      string
+		params    types.Params
+		expParams types.Params
+	}{
+		{
+			"success: params of a previous version are set to their defaults",
+			types.Params{MaxExecutionGas: 1_000_000},
+			types.NewParams(1_000_000),
+		},
+		{
+			"success: default params are unchanged",
+			types.DefaultParams(),
+			types.DefaultParams(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			ctx := s.chainA.GetContext()
+			gmpKeeper := s.chainA.GetSimApp().GMPKeeper
+			gmpKeeper.SetParams(ctx, tc.params)
+
+			// call results of the previous version are stored without the completed call results index
+			packetData := types.NewGMPPacketData(s.chainA.SenderAccount.GetAddress().String(), ibctesting.TestAccAddress, nil, nil, "")
+			pendingCall := types.NewPendingCallResult(ibctesting.FirstClientID, 1, packetData)
+			completedCall := types.NewPendingCallResult(ibctesting.FirstClientID, 2, packetData)
+			completedCall.Status = types.CALL_TIMEOUT
+			for _, callResult := range []types.CallResult{pendingCall, completedCall} {
+				err := gmpKeeper.CallResults.Set(ctx, collections.Join(callResult.ClientId, callResult.Sequence), callResult)
+				s.Require().NoError(err)
+			}
+
+			migrator := keeper.NewMigrator(gmpKeeper)
+			err := migrator.Migrate1to2(ctx)
+			s.Require().NoError(err)
+
+			params := gmpKeeper.GetParams(ctx)
+			s.Require().Equal(tc.expParams, params)
+
+			indexed, err := gmpKeeper.CompletedCallResults.Has(ctx, collections.Join(ibctesting.FirstClientID, uint64(1)))
+			s.Require().NoError(err)
+			s.Require().False(indexed)
+
+			indexed, err = gmpKeeper.CompletedCallResults.Has(ctx, collections.Join(ibctesting.FirstClientID, uint64(2)))
+			s.Require().NoError(err)
+			s.Require().True(indexed)
+		})
+	}
+}
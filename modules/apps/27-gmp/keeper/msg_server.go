@@ -42,6 +42,18 @@ func (k *Keeper) SendCall(goCtx context.Context, msg *types.MsgSendCall) (*types
 	return &types.MsgSendCallResponse{Sequence: sequence}, nil
 }
 
+// UpdateParams defines the handler for the MsgUpdateParams message.
+func (k *Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, k.GetAuthority(), msg.Signer); err != nil {
+		return nil, err
+	}
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
 func (k *Keeper) sendPacket(ctx sdk.Context, encoding, sourceClient string, timeoutTimestamp uint64, packetData types.GMPPacketData) (uint64, error) {
 	if encoding == "" {
 		encoding = types.EncodingABI
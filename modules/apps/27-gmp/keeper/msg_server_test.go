@@ -7,6 +7,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
@@ -124,6 +125,12 @@ func (s *KeeperTestSuite) TestSendCall() {
 				s.Require().NotNil(resp)
 				s.Require().Equal(uint64(1), resp.Sequence)
 
+				callResult, err := s.chainA.GetSimApp().GMPKeeper.GetCallResult(s.chainA.GetContext(), path.EndpointA.ClientID, resp.Sequence)
+				s.Require().NoError(err)
+				s.Require().Equal(types.CALL_PENDING, callResult.Status)
+				s.Require().Equal(msg.Sender, callResult.Sender)
+				s.Require().Equal(msg.Salt, callResult.Salt)
+
 			case errors.Is(tc.expErr, errAny):
 				s.Require().Error(err)
 				s.Require().Nil(resp)
@@ -135,3 +142,41 @@ func (s *KeeperTestSuite) TestSendCall() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgUpdateParams(s.chainA.GetSimApp().GMPKeeper.GetAuthority(), types.NewParams(1_000_000)),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.NewParams(1_000_000)),
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.chainA.GetContext()
+			res, err := s.chainA.GetSimApp().GMPKeeper.UpdateParams(ctx, tc.msg)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().Equal(tc.msg.Params, s.chainA.GetSimApp().GMPKeeper.GetParams(ctx))
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Nil(res)
+				s.Require().Equal(types.DefaultParams(), s.chainA.GetSimApp().GMPKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
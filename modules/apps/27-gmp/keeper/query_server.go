@@ -11,11 +11,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
 
+// Params defines the handler for the Query/Params RPC method.
+func (k *Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
+
 // AccountAddress defines the handler for the Query/AccountAddress RPC method.
 func (k *Keeper) AccountAddress(ctx context.Context, req *types.QueryAccountAddressRequest) (*types.QueryAccountAddressResponse, error) {
 	salt, err := hex.DecodeString(req.Salt)
@@ -50,3 +60,19 @@ func (k *Keeper) AccountIdentifier(ctx context.Context, req *types.QueryAccountI
 		AccountId: ics27Acc.AccountId,
 	}, nil
 }
+
+// CallResult defines the handler for the Query/CallResult RPC method.
+func (k *Keeper) CallResult(ctx context.Context, req *types.QueryCallResultRequest) (*types.QueryCallResultResponse, error) {
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid client ID %s", req.ClientId)
+	}
+
+	callResult, err := k.GetCallResult(ctx, req.ClientId, req.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCallResultResponse{
+		CallResult: callResult,
+	}, nil
+}
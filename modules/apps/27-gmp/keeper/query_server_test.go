@@ -11,10 +11,23 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v11/testing"
 )
 
+func (s *KeeperTestSuite) TestQueryParams() {
+	s.SetupTest()
+
+	ctx := s.chainA.GetContext()
+	expParams := types.NewParams(1_000_000)
+	s.chainA.GetSimApp().GMPKeeper.SetParams(ctx, expParams)
+
+	res, err := s.chainA.GetSimApp().GMPKeeper.Params(ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&expParams, res.Params)
+}
+
 func (s *KeeperTestSuite) TestQueryAccountAddress() {
 	var req *types.QueryAccountAddressRequest
 
@@ -141,6 +154,69 @@ func (s *KeeperTestSuite) TestQueryAccountIdentifier() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryCallResult() {
+	var (
+		req           *types.QueryCallResultRequest
+		expCallResult types.CallResult
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				req.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: call result not found",
+			func() {
+				req.Sequence = 2
+			},
+			types.ErrCallResultNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			packetData := types.NewGMPPacketData(s.chainA.SenderAccount.GetAddress().String(), "", []byte(testSalt), []byte("payload"), "")
+			expCallResult = types.NewPendingCallResult(ibctesting.FirstClientID, 1, packetData)
+			expCallResult.Status = types.CALL_SUCCESS
+			expCallResult.Result = []byte("result")
+
+			err := s.chainA.GetSimApp().GMPKeeper.SetCallResult(s.chainA.GetContext(), expCallResult)
+			s.Require().NoError(err)
+
+			req = &types.QueryCallResultRequest{
+				ClientId: ibctesting.FirstClientID,
+				Sequence: 1,
+			}
+
+			tc.malleate()
+
+			resp, err := s.chainA.GetSimApp().GMPKeeper.CallResult(s.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(expCallResult, *resp.CallResult)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetAccount() {
 	testCases := []struct {
 		name     string
@@ -278,6 +354,7 @@ func (s *KeeperTestSuite) createGMPAccount(gmpAccountAddr string) {
 		s.chainA.GetContext(),
 		&data,
 		ibctesting.FirstClientID,
+		s.chainA.SenderAccount.GetAddress(),
 	)
 	s.Require().NoError(err)
 }
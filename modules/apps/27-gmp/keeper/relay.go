@@ -13,16 +13,24 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
 )
 
-// OnRecvPacket processes a GMP packet.
+// OnRecvPacket processes a GMP packet. The relayer fee of the execution options in the memo of the packet data
+// is paid to the relayer.
 // Returns the data result of the execution if successful.
 func (k *Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	data *types.GMPPacketData,
 	destClient string,
+	relayer sdk.AccAddress,
 ) ([]byte, error) {
+	options, err := icatypes.GetExecutionOptions(data)
+	if err != nil {
+		return nil, err
+	}
+
 	accountID := types.NewAccountIdentifier(destClient, data.Sender, data.Salt)
 
 	ics27Acc, err := k.getOrCreateICS27Account(ctx, &accountID)
@@ -40,7 +48,7 @@ func (k *Keeper) OnRecvPacket(
 		return nil, errorsmod.Wrapf(types.ErrAccountNotFound, "account %s not found", ics27Addr)
 	}
 
-	txResponse, err := k.executeTx(ctx, ics27SdkAcc, data.Payload)
+	txResponse, err := k.executeTx(ctx, ics27SdkAcc, data.Payload, options, relayer)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to execute ICS27 account transaction")
 	}
@@ -48,11 +56,13 @@ func (k *Keeper) OnRecvPacket(
 	return txResponse, nil
 }
 
-// executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer.
+// executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer, then
+// pays the relayer fee of the execution options.
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
-// into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
-// execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k *Keeper) executeTx(ctx sdk.Context, account sdk.AccountI, payload []byte) ([]byte, error) {
+// into state. The messages are executed under a gas meter limited to the gas limit of the execution options, capped
+// at the MaxExecutionGas param. The state changes will only be committed if all messages in the transaction succeed.
+// Thus the execution of the transaction is atomic, all state changes are reverted if a single message fails.
+func (k *Keeper) executeTx(ctx sdk.Context, account sdk.AccountI, payload []byte, options icatypes.ExecutionOptions, relayer sdk.AccAddress) ([]byte, error) {
 	msgs, err := types.DeserializeCosmosTx(k.cdc, payload)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to deserialize ICS27 CosmosTx")
@@ -62,6 +72,10 @@ func (k *Keeper) executeTx(ctx sdk.Context, account sdk.AccountI, payload []byte
 		return nil, err
 	}
 
+	if err := k.payRelayerFee(ctx, account.GetAddress(), relayer, options.RelayerFee); err != nil {
+		return nil, err
+	}
+
 	txMsgData := &sdk.TxMsgData{
 		MsgResponses: make([]*codectypes.Any, len(msgs)),
 	}
@@ -69,20 +83,27 @@ func (k *Keeper) executeTx(ctx sdk.Context, account sdk.AccountI, payload []byte
 	// CacheContext returns a new context with the multi-store branched into a cached storage object
 	// writeCache is called only if all msgs succeed, performing state transitions atomically
 	cacheCtx, writeCache := ctx.CacheContext()
-	for i, msg := range msgs {
-		if m, ok := msg.(sdk.HasValidateBasic); ok {
-			if err := m.ValidateBasic(); err != nil {
-				return nil, err
+	gasLimit := icatypes.ExecutionGasLimit(k.GetParams(ctx).MaxExecutionGas, options.GasLimit)
+	if err := icatypes.ExecuteWithGasLimit(cacheCtx, gasLimit, func(cacheCtx sdk.Context) error {
+		for i, msg := range msgs {
+			if m, ok := msg.(sdk.HasValidateBasic); ok {
+				if err := m.ValidateBasic(); err != nil {
+					return err
+				}
 			}
-		}
 
-		protoAny, err := k.executeMsg(cacheCtx, msg)
-		if err != nil {
-			ctx.Logger().Error("failed to execute 27-gmp message", "msg", msg, "error", err)
-			return nil, err
+			protoAny, err := k.executeMsg(cacheCtx, msg)
+			if err != nil {
+				ctx.Logger().Error("failed to execute 27-gmp message", "msg", msg, "error", err)
+				return err
+			}
+
+			txMsgData.MsgResponses[i] = protoAny
 		}
 
-		txMsgData.MsgResponses[i] = protoAny
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
 	writeCache()
@@ -127,6 +148,27 @@ func (k *Keeper) authenticateTx(_ sdk.Context, account sdk.AccountI, msgs []sdk.
 	return nil
 }
 
+// payRelayerFee sends the relayer fee from the ICS27 account to the relayer
+func (k *Keeper) payRelayerFee(ctx sdk.Context, accountAddr, relayer sdk.AccAddress, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	if k.bankKeeper == nil {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "relayer fees cannot be paid: bank keeper is not set")
+	}
+
+	if relayer.Empty() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "relayer address cannot be empty when a relayer fee is set")
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, accountAddr, relayer, fee); err != nil {
+		return errorsmod.Wrapf(err, "failed to pay relayer fee %s", fee)
+	}
+
+	return nil
+}
+
 // Attempts to get the message handler from the router and if found will then execute the message.
 // If the message execution is successful, the proto marshaled message response will be returned.
 func (k *Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*codectypes.Any, error) {
@@ -3,6 +3,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/keeper"
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v11/testing"
@@ -123,6 +125,7 @@ func (s *KeeperTestSuite) TestOnRecvPacket() {
 		gmpAccountAddr sdk.AccAddress
 		sender         string
 		recipient      sdk.AccAddress
+		relayer        sdk.AccAddress
 		destClient     string
 	)
 
@@ -151,6 +154,64 @@ func (s *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			nil,
 		},
+		{
+			"success: relayer fee paid to relayer",
+			func() {
+				s.fundAccount(gmpAccountAddr, sdk.NewCoins(ibctesting.TestCoin.Add(ibctesting.TestCoin)))
+				packetData.Payload = s.serializeMsgs(s.newMsgSend(gmpAccountAddr, recipient))
+				packetData.Memo = fmt.Sprintf(`{"execution": {"relayer_fee": "%s"}}`, ibctesting.TestCoin)
+			},
+			nil,
+		},
+		{
+			"success: gas limit within max execution gas",
+			func() {
+				gmpKeeper.SetParams(s.chainA.GetContext(), types.NewParams(1_000_000))
+
+				s.fundAccount(gmpAccountAddr, sdk.NewCoins(ibctesting.TestCoin))
+				packetData.Payload = s.serializeMsgs(s.newMsgSend(gmpAccountAddr, recipient))
+				packetData.Memo = `{"execution": {"gas_limit": "500000"}}`
+			},
+			nil,
+		},
+		{
+			"failure: execution exceeds gas limit",
+			func() {
+				s.fundAccount(gmpAccountAddr, sdk.NewCoins(ibctesting.TestCoin))
+				packetData.Payload = s.serializeMsgs(s.newMsgSend(gmpAccountAddr, recipient))
+				packetData.Memo = `{"execution": {"gas_limit": "100"}}`
+			},
+			sdkerrors.ErrOutOfGas,
+		},
+		{
+			"failure: execution exceeds max execution gas",
+			func() {
+				gmpKeeper.SetParams(s.chainA.GetContext(), types.NewParams(100))
+
+				s.fundAccount(gmpAccountAddr, sdk.NewCoins(ibctesting.TestCoin))
+				packetData.Payload = s.serializeMsgs(s.newMsgSend(gmpAccountAddr, recipient))
+				packetData.Memo = `{"execution": {"gas_limit": "500000"}}`
+			},
+			sdkerrors.ErrOutOfGas,
+		},
+		{
+			"failure: insufficient funds for relayer fee",
+			func() {
+				s.fundAccount(gmpAccountAddr, sdk.NewCoins(ibctesting.TestCoin))
+				packetData.Payload = s.serializeMsgs(s.newMsgSend(gmpAccountAddr, recipient))
+				packetData.Memo = fmt.Sprintf(`{"execution": {"relayer_fee": "%s"}}`, ibctesting.TestCoin.Add(ibctesting.TestCoin))
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			"failure: invalid execution options",
+			func() {
+				s.fundAccount(gmpAccountAddr, sdk.NewCoins(ibctesting.TestCoin))
+				packetData.Payload = s.serializeMsgs(s.newMsgSend(gmpAccountAddr, recipient))
+				packetData.Memo = `{"execution": {"gas_limit": 100}}`
+			},
+			icatypes.ErrInvalidExecutionOptions,
+		},
 		{
 			"failure: unauthorized signer",
 			func() {
@@ -201,6 +262,7 @@ func (s *KeeperTestSuite) TestOnRecvPacket() {
 			gmpKeeper = s.chainA.GetSimApp().GMPKeeper
 			sender = s.chainB.SenderAccount.GetAddress().String()
 			recipient = s.chainA.SenderAccount.GetAddress()
+			relayer = s.chainA.SenderAccounts[1].SenderAccount.GetAddress()
 			destClient = ibctesting.FirstClientID
 
 			accountID := types.NewAccountIdentifier(ibctesting.FirstClientID, sender, []byte(testSalt))
@@ -217,6 +279,7 @@ func (s *KeeperTestSuite) TestOnRecvPacket() {
 				s.chainA.GetContext(),
 				packetData,
 				destClient,
+				relayer,
 			)
 
 			expPass := tc.expErr == nil
@@ -187,7 +187,7 @@ func TestNewAppModuleBasic(t *testing.T) {
 
 func TestAppModuleConsensusVersion(t *testing.T) {
 	module := gmp.AppModule{}
-	require.Equal(t, uint64(1), module.ConsensusVersion())
+	require.Equal(t, uint64(2), module.ConsensusVersion())
 }
 
 func TestAppModuleDefaultGenesis(t *testing.T) {
@@ -12,6 +12,11 @@ func AutoCLIOptions() *autocliv1.ModuleOptions {
 		Query: &autocliv1.ServiceCommandDescriptor{
 			Service: _Query_serviceDesc.ServiceName,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current 27-gmp parameters",
+				},
 				{
 					RpcMethod: "AccountAddress",
 					Use:       "get-address [client_id] [sender] [salt]",
@@ -30,6 +35,15 @@ func AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "account_address"},
 					},
 				},
+				{
+					RpcMethod: "CallResult",
+					Use:       "call-result [client_id] [sequence]",
+					Short:     "Get the result of the ICS27 GMP call sent with the packet of a client and sequence",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "client_id"},
+						{ProtoField: "sequence"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -50,6 +64,10 @@ func AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "encoding", Optional: true},
 					},
 				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // authority gated
+				},
 			},
 		},
 	}
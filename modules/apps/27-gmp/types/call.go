@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+)
+
+// NewPendingCallResult creates a new CallResult instance for a call in flight, sent with the packet of the provided
+// client and sequence
+func NewPendingCallResult(clientID string, sequence uint64, packetData GMPPacketData) CallResult {
+	return CallResult{
+		ClientId: clientID,
+		Sequence: sequence,
+		Sender:   packetData.Sender,
+		Receiver: packetData.Receiver,
+		Salt:     packetData.Salt,
+		Status:   CALL_PENDING,
+	}
+}
+
+// Validate performs basic validation of the CallResult
+func (cr CallResult) Validate() error {
+	if err := host.ClientIdentifierValidator(cr.ClientId); err != nil {
+		return errorsmod.Wrapf(err, "invalid source client ID %s", cr.ClientId)
+	}
+	if cr.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidCallResult, "sequence cannot be 0")
+	}
+	if strings.TrimSpace(cr.Sender) == "" {
+		return errorsmod.Wrap(ErrInvalidCallResult, "missing sender address")
+	}
+	if _, found := CallStatus_name[int32(cr.Status)]; !found || cr.Status == CALL_UNSPECIFIED {
+		return errorsmod.Wrapf(ErrInvalidCallResult, "invalid call status %d", cr.Status)
+	}
+
+	return nil
+}
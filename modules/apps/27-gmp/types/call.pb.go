@@ -0,0 +1,752 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/gmp/v1/call.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CallStatus defines the outcome of a call sent with a GMP packet.
+type CallStatus int32
+
+const (
+	// Default zero value enumeration
+	CALL_UNSPECIFIED CallStatus = 0
+	// The packet of the call was sent and is awaiting an acknowledgement or a timeout
+	CALL_PENDING CallStatus = 1
+	// The call was executed successfully on the destination chain
+	CALL_SUCCESS CallStatus = 2
+	// The destination chain returned an error acknowledgement
+	CALL_FAILURE CallStatus = 3
+	// The packet of the call timed out before being received by the destination chain
+	CALL_TIMEOUT CallStatus = 4
+)
+
+var CallStatus_name = map[int32]string{
+	0: "CALL_STATUS_UNSPECIFIED",
+	1: "CALL_STATUS_PENDING",
+	2: "CALL_STATUS_SUCCESS",
+	3: "CALL_STATUS_FAILURE",
+	4: "CALL_STATUS_TIMEOUT",
+}
+
+var CallStatus_value = map[string]int32{
+	"CALL_STATUS_UNSPECIFIED": 0,
+	"CALL_STATUS_PENDING":     1,
+	"CALL_STATUS_SUCCESS":     2,
+	"CALL_STATUS_FAILURE":     3,
+	"CALL_STATUS_TIMEOUT":     4,
+}
+
+func (x CallStatus) String() string {
+	return proto.EnumName(CallStatus_name, int32(x))
+}
+
+func (CallStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8e997fadf53d7204, []int{0}
+}
+
+// CallResult defines the state of a call sent with a GMP packet, stored when the packet is sent and updated with the
+// result of the call upon its acknowledgement or timeout.
+type CallResult struct {
+	// The (local) client identifier the packet was sent on
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The sequence of the packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// The sender of the call
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The receiver of the call on the destination chain
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// The salt of the call
+	Salt []byte `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
+	// The status of the call
+	Status CallStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ibc.applications.gmp.v1.CallStatus" json:"status,omitempty"`
+	// The result of the acknowledgement of a successful call
+	Result []byte `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`
+	// The error of a failed call
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// The height at which the acknowledgement or the timeout was processed
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CallResult) Reset()         { *m = CallResult{} }
+func (m *CallResult) String() string { return proto.CompactTextString(m) }
+func (*CallResult) ProtoMessage()    {}
+func (*CallResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e997fadf53d7204, []int{0}
+}
+func (m *CallResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallResult.Merge(m, src)
+}
+func (m *CallResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallResult proto.InternalMessageInfo
+
+func (m *CallResult) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *CallResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *CallResult) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *CallResult) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *CallResult) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+func (m *CallResult) GetStatus() CallStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CALL_UNSPECIFIED
+}
+
+func (m *CallResult) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *CallResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CallResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ibc.applications.gmp.v1.CallStatus", CallStatus_name, CallStatus_value)
+	proto.RegisterType((*CallResult)(nil), "ibc.applications.gmp.v1.CallResult")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/gmp/v1/call.proto", fileDescriptor_8e997fadf53d7204)
+}
+
+var fileDescriptor_8e997fadf53d7204 = []byte{
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0xdb, 0x6e, 0x6d, 0x87, 0x45, 0xc2, 0x58, 0xdc, 0x50, 0x21, 0x84, 0xf5, 0x12,
+	0x85, 0xcd, 0x90, 0x15, 0xf4, 0xe0, 0xa9, 0x66, 0xb3, 0x12, 0xa8, 0xb5, 0x24, 0xcd, 0xc5, 0x4b,
+	0x49, 0xa6, 0x43, 0x3a, 0x90, 0x74, 0x62, 0x66, 0x52, 0xf0, 0x1b, 0xc8, 0x9e, 0xf6, 0x0b, 0xec,
+	0xc9, 0x2f, 0xe3, 0x71, 0x8f, 0x1e, 0xa5, 0xfd, 0x04, 0x7e, 0x03, 0xc9, 0x34, 0x6a, 0x28, 0x78,
+	0x7b, 0xff, 0xff, 0xfb, 0xfd, 0x1f, 0xef, 0xc1, 0x83, 0x17, 0x2c, 0x21, 0x38, 0x2e, 0x8a, 0x8c,
+	0x91, 0x58, 0x32, 0xbe, 0x11, 0x38, 0xcd, 0x0b, 0xbc, 0x75, 0x30, 0x89, 0xb3, 0xcc, 0x2e, 0x4a,
+	0x2e, 0x39, 0x3a, 0x67, 0x09, 0xb1, 0xdb, 0x8c, 0x9d, 0xe6, 0x85, 0xbd, 0x75, 0xc6, 0xa3, 0x94,
+	0xa7, 0x5c, 0x31, 0xb8, 0xae, 0x0e, 0xf8, 0xc5, 0xdd, 0x09, 0x84, 0x6e, 0x9c, 0x65, 0x01, 0x15,
+	0x55, 0x26, 0xd1, 0x33, 0x38, 0x24, 0x19, 0xa3, 0x1b, 0xb9, 0x64, 0x2b, 0x1d, 0x98, 0xc0, 0x1a,
+	0x06, 0x83, 0x83, 0xe1, 0xaf, 0xd0, 0x18, 0x0e, 0x04, 0xfd, 0x5c, 0xd1, 0x0d, 0xa1, 0xfa, 0x89,
+	0x09, 0xac, 0x5e, 0xf0, 0x57, 0xa3, 0xa7, 0xb0, 0x2f, 0xe8, 0x66, 0x45, 0x4b, 0xbd, 0xab, 0x52,
+	0x8d, 0xaa, 0x33, 0x25, 0x25, 0x94, 0x6d, 0x69, 0xa9, 0xf7, 0x0e, 0xf3, 0xfe, 0x68, 0x84, 0x60,
+	0x4f, 0xc4, 0x99, 0xd4, 0x4f, 0x4d, 0x60, 0x9d, 0x05, 0xaa, 0x46, 0x6f, 0x61, 0x5f, 0xc8, 0x58,
+	0x56, 0x42, 0xef, 0x9b, 0xc0, 0x7a, 0x7c, 0xf5, 0xdc, 0xfe, 0xcf, 0x3d, 0x76, 0xbd, 0x75, 0xa8,
+	0xd0, 0xa0, 0x89, 0xd4, 0x4b, 0x94, 0xea, 0x0e, 0xfd, 0x91, 0x1a, 0xd9, 0x28, 0x34, 0x82, 0xa7,
+	0xb4, 0x2c, 0x79, 0xa9, 0x0f, 0xd4, 0x06, 0x07, 0x51, 0xd3, 0x6b, 0xca, 0xd2, 0xb5, 0xd4, 0x87,
+	0x26, 0xb0, 0xba, 0x41, 0xa3, 0x5e, 0xfe, 0x02, 0x10, 0xfe, 0x1b, 0x8e, 0x1c, 0x78, 0xee, 0x4e,
+	0xa6, 0xd3, 0x65, 0xb8, 0x98, 0x2c, 0xa2, 0x70, 0x19, 0xcd, 0xc2, 0xb9, 0xe7, 0xfa, 0x37, 0xbe,
+	0x77, 0xad, 0x75, 0xc6, 0xa3, 0xdb, 0x7b, 0x53, 0x53, 0xed, 0x96, 0x8f, 0x5e, 0xc0, 0x27, 0xed,
+	0xc8, 0xdc, 0x9b, 0x5d, 0xfb, 0xb3, 0xf7, 0x1a, 0x18, 0x6b, 0xb7, 0xf7, 0xe6, 0x99, 0x6a, 0x35,
+	0xde, 0x31, 0x1a, 0x46, 0xae, 0xeb, 0x85, 0xa1, 0x76, 0xd2, 0x42, 0x1b, 0xef, 0x18, 0xbd, 0x99,
+	0xf8, 0xd3, 0x28, 0xf0, 0xb4, 0x6e, 0x0b, 0x6d, 0xbc, 0x63, 0x74, 0xe1, 0x7f, 0xf0, 0x3e, 0x46,
+	0x0b, 0xad, 0xd7, 0x42, 0x1b, 0x6f, 0xdc, 0xfb, 0xfa, 0xcd, 0xe8, 0xbc, 0x9b, 0x7f, 0xdf, 0x19,
+	0xe0, 0x61, 0x67, 0x80, 0x9f, 0x3b, 0x03, 0xdc, 0xed, 0x8d, 0xce, 0xc3, 0xde, 0xe8, 0xfc, 0xd8,
+	0x1b, 0x9d, 0x4f, 0xaf, 0x53, 0x26, 0xd7, 0x55, 0x62, 0x13, 0x9e, 0x63, 0xc2, 0x45, 0xce, 0x05,
+	0x66, 0x09, 0xb9, 0x4c, 0x39, 0xde, 0x3a, 0x0e, 0xce, 0xf9, 0xaa, 0xca, 0xa8, 0xa8, 0x9f, 0x52,
+	0xe0, 0xab, 0x37, 0x97, 0xf5, 0x3f, 0xca, 0x2f, 0x05, 0x15, 0x49, 0x5f, 0xfd, 0xd7, 0xab, 0xdf,
+	0x03, 0x00, 0x9f, 0x5d, 0x6f, 0x75, 0xb4, 0x02, 0x00, 0x00,
+}
+
+func (m *CallResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintCall(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintCall(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintCall(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintCall(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintCall(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintCall(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintCall(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintCall(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintCall(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCall(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCall(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CallResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovCall(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovCall(uint64(m.Sequence))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovCall(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovCall(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovCall(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCall(uint64(m.Status))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovCall(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCall(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCall(uint64(m.Height))
+	}
+	return n
+}
+
+func sovCall(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCall(x uint64) (n int) {
+	return sovCall(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CallResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCall
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCall
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCall
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCall
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCall
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CallStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCall
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCall
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCall(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCall
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCall(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCall
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCall
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCall
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCall
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCall
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCall
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCall        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCall          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCall = fmt.Errorf("proto: unexpected end of group")
+)
@@ -18,5 +18,5 @@ var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 // RegisterInterfaces registers the gmp types and the concrete ICS27Account implementation
 // against the associated x/auth AccountI and GenesisAccount interfaces.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSendCall{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSendCall{}, &MsgUpdateParams{})
 }
@@ -26,6 +26,11 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgSendCall{}),
 			nil,
 		},
+		{
+			"success: MsgUpdateParams",
+			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
+			nil,
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
@@ -17,4 +17,6 @@ var (
 	ErrAccountNotFound         = errorsmod.Register(ModuleName, 10, "account not found")
 	ErrInvalidMsgRoute         = errorsmod.Register(ModuleName, 11, "invalid msg route")
 	ErrInvalidVersion          = errorsmod.Register(ModuleName, 12, "invalid version")
+	ErrCallResultNotFound      = errorsmod.Register(ModuleName, 13, "call result not found")
+	ErrInvalidCallResult       = errorsmod.Register(ModuleName, 14, "invalid call result")
 )
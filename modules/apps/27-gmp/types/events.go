@@ -7,6 +7,8 @@ const (
 	EventTypePacket     = "ics27_gmp_packet"
 	EventTypeSendCall   = "ics27_gmp_send_call"
 	EventTypeRecvPacket = "ics27_gmp_recv_packet"
+	EventTypeAckPacket  = "ics27_gmp_acknowledge_packet"
+	EventTypeTimeout    = "ics27_gmp_timeout"
 
 	AttributeKeySender            = "sender"
 	AttributeKeyReceiver          = "receiver"
@@ -20,4 +22,6 @@ const (
 	AttributeKeySequence          = "sequence"
 	AttributeKeyAckError          = "error"
 	AttributeKeyAckSuccess        = "success"
+	AttributeKeyCallStatus        = "status"
+	AttributeKeyCallResult        = "result"
 )
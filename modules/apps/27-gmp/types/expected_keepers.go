@@ -17,3 +17,8 @@ type AccountKeeper interface {
 	// Set an account in the store.
 	SetAccount(ctx context.Context, acc sdk.AccountI)
 }
+
+// BankKeeper defines the expected bank keeper used to pay relayer fees from ICS27 accounts
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
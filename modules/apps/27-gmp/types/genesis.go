@@ -3,6 +3,8 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +17,8 @@ import (
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Ics27Accounts: []RegisteredICS27Account{},
+		Params:        DefaultParams(),
+		CallResults:   []CallResult{},
 	}
 }
 
@@ -36,5 +40,18 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
-	return nil
+	seenCalls := make(map[string]struct{})
+	for _, callResult := range gs.CallResults {
+		if err := callResult.Validate(); err != nil {
+			return err
+		}
+
+		callKey := fmt.Sprintf("%s/%d", callResult.ClientId, callResult.Sequence)
+		if _, found := seenCalls[callKey]; found {
+			return errorsmod.Wrapf(ErrInvalidCallResult, "duplicate call result for client %s and sequence %d", callResult.ClientId, callResult.Sequence)
+		}
+		seenCalls[callKey] = struct{}{}
+	}
+
+	return gs.Params.Validate()
 }
@@ -27,6 +27,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// The list of registered ICS27 accounts
 	Ics27Accounts []RegisteredICS27Account `protobuf:"bytes,2,rep,name=ics27_accounts,json=ics27Accounts,proto3" json:"ics27_accounts"`
+	// The 27-gmp parameters
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// The results of the calls sent with GMP packets, including the calls in flight
+	CallResults []CallResult `protobuf:"bytes,4,rep,name=call_results,json=callResults,proto3" json:"call_results"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +73,20 @@ func (m *GenesisState) GetIcs27Accounts() []RegisteredICS27Account {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetCallResults() []CallResult {
+	if m != nil {
+		return m.CallResults
+	}
+	return nil
+}
+
 // RegisteredICS27Account contains an account identifier and associated interchain account address
 type RegisteredICS27Account struct {
 	/// The address of the ics27 account
@@ -134,27 +152,32 @@ func init() {
 }
 
 var fileDescriptor_7cccbdb788964d3f = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xc1, 0x4b, 0x02, 0x41,
-	0x14, 0xc6, 0x77, 0x2c, 0x02, 0xc7, 0x32, 0x58, 0xa2, 0xc4, 0xc3, 0x26, 0x42, 0x24, 0x81, 0x33,
-	0xec, 0x06, 0x79, 0xd6, 0x0e, 0xe1, 0xa9, 0xd0, 0x5b, 0x04, 0xb2, 0x3b, 0x3b, 0x4d, 0x0f, 0xdc,
-	0x9d, 0x61, 0xdf, 0x28, 0xf4, 0x5f, 0x44, 0x7f, 0x95, 0x47, 0x8f, 0x9d, 0x22, 0xf4, 0x1f, 0x09,
-	0xdd, 0x11, 0x3a, 0xe4, 0xed, 0xf1, 0xbd, 0xef, 0xf7, 0xbe, 0xc7, 0x47, 0xaf, 0x20, 0x11, 0x3c,
-	0x36, 0x66, 0x0a, 0x22, 0xb6, 0xa0, 0x73, 0xe4, 0x2a, 0x33, 0x7c, 0x1e, 0x72, 0x25, 0x73, 0x89,
-	0x80, 0xcc, 0x14, 0xda, 0x6a, 0xff, 0x02, 0x12, 0xc1, 0xfe, 0xda, 0x98, 0xca, 0x0c, 0x9b, 0x87,
-	0xcd, 0xbd, 0x7c, 0x2c, 0x84, 0x9e, 0xe5, 0xb6, 0xe4, 0x9b, 0x67, 0x4a, 0x2b, 0xbd, 0x1d, 0xf9,
-	0x66, 0x2a, 0xd5, 0xf6, 0x94, 0x1e, 0x3f, 0x94, 0x31, 0x63, 0x1b, 0x5b, 0xe9, 0xbf, 0xd0, 0x3a,
-	0x08, 0x8c, 0x7a, 0x13, 0x07, 0x63, 0xa3, 0xd2, 0x3a, 0xe8, 0xd4, 0x22, 0xce, 0xf6, 0xc4, 0xb3,
-	0x91, 0x54, 0x80, 0x56, 0x16, 0x32, 0x1d, 0xde, 0x8f, 0xa3, 0x5e, 0xbf, 0xe4, 0x06, 0x87, 0x8b,
-	0xef, 0x4b, 0x6f, 0x74, 0xb2, 0x3d, 0xe6, 0x34, 0x6c, 0x7f, 0x12, 0x7a, 0xfe, 0xbf, 0xdf, 0xbf,
-	0xa6, 0xa7, 0x2e, 0x72, 0x12, 0xa7, 0x69, 0x21, 0x11, 0x1b, 0xa4, 0x45, 0x3a, 0xd5, 0x51, 0xdd,
-	0xc9, 0xfd, 0x52, 0xf5, 0x1f, 0x29, 0xdd, 0x19, 0x21, 0x6d, 0x54, 0x5a, 0xa4, 0x53, 0x8b, 0x6e,
-	0xf6, 0x7e, 0xe7, 0xce, 0x0f, 0x53, 0x99, 0x5b, 0x78, 0x05, 0x59, 0xb8, 0xc7, 0xaa, 0xf1, 0x6e,
-	0x31, 0x78, 0x5a, 0xac, 0x02, 0xb2, 0x5c, 0x05, 0xe4, 0x67, 0x15, 0x90, 0x8f, 0x75, 0xe0, 0x2d,
-	0xd7, 0x81, 0xf7, 0xb5, 0x0e, 0xbc, 0xe7, 0x3b, 0x05, 0xf6, 0x6d, 0x96, 0x30, 0xa1, 0x33, 0x2e,
-	0x34, 0x66, 0x1a, 0x39, 0x24, 0xa2, 0xab, 0x34, 0x9f, 0x87, 0x21, 0xcf, 0x74, 0x3a, 0x9b, 0x4a,
-	0xdc, 0x54, 0x8f, 0x3c, 0xea, 0x75, 0x37, 0xad, 0xdb, 0x77, 0x23, 0x31, 0x39, 0xda, 0x76, 0x7b,
-	0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x9d, 0xfa, 0x27, 0xda, 0x01, 0x00, 0x00,
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0xea, 0xd3, 0x40,
+	0x18, 0xc4, 0xb3, 0x6d, 0x29, 0x74, 0x5b, 0x2b, 0x04, 0xd1, 0xd0, 0x43, 0x5a, 0xaa, 0x62, 0x11,
+	0x9a, 0x25, 0x11, 0xec, 0xc9, 0x43, 0xdb, 0x83, 0x14, 0x04, 0x4b, 0x7a, 0x13, 0xa1, 0x6c, 0x36,
+	0xeb, 0xba, 0x90, 0x64, 0x43, 0xbe, 0x4d, 0xc1, 0xb7, 0x10, 0x9f, 0xaa, 0xc7, 0x1e, 0x3d, 0x89,
+	0xb4, 0x4f, 0xe1, 0x4d, 0x92, 0x6c, 0xc5, 0xc3, 0x3f, 0xb7, 0x65, 0x32, 0x33, 0xfc, 0x86, 0x7c,
+	0xf8, 0xa5, 0x8c, 0x18, 0xa1, 0x79, 0x9e, 0x48, 0x46, 0xb5, 0x54, 0x19, 0x10, 0x91, 0xe6, 0xe4,
+	0xe4, 0x13, 0xc1, 0x33, 0x0e, 0x12, 0xbc, 0xbc, 0x50, 0x5a, 0xd9, 0xcf, 0x64, 0xc4, 0xbc, 0xff,
+	0x6d, 0x9e, 0x48, 0x73, 0xef, 0xe4, 0x4f, 0x5a, 0xf3, 0x94, 0x31, 0x55, 0x66, 0xba, 0xc9, 0x4f,
+	0xe6, 0x6d, 0x36, 0x46, 0x93, 0xc4, 0x78, 0x5e, 0xb4, 0x79, 0x72, 0x5a, 0xd0, 0xd4, 0x90, 0x4c,
+	0x9e, 0x08, 0x25, 0x54, 0xfd, 0x24, 0xd5, 0xab, 0x51, 0xe7, 0x7f, 0x10, 0x1e, 0xbd, 0x6f, 0x88,
+	0x0f, 0x9a, 0x6a, 0x6e, 0x7f, 0xc6, 0x63, 0xc9, 0x20, 0x58, 0x1d, 0x0d, 0x07, 0x38, 0x9d, 0x59,
+	0x77, 0x31, 0x0c, 0x88, 0xd7, 0xb2, 0xc4, 0x0b, 0xb9, 0x90, 0xa0, 0x79, 0xc1, 0xe3, 0xdd, 0xf6,
+	0x10, 0xac, 0xd6, 0x4d, 0x6e, 0xd3, 0x3b, 0xff, 0x9a, 0x5a, 0xe1, 0xa3, 0xba, 0xcc, 0x68, 0x60,
+	0xbf, 0xc3, 0xfd, 0x06, 0xca, 0xe9, 0xce, 0xd0, 0x62, 0x18, 0x4c, 0x5b, 0x5b, 0xf7, 0xb5, 0xcd,
+	0xb4, 0x98, 0x90, 0xfd, 0x01, 0x8f, 0xaa, 0xdd, 0xc7, 0x82, 0x43, 0x99, 0x68, 0x70, 0x7a, 0x35,
+	0xda, 0xf3, 0xd6, 0x92, 0x2d, 0x4d, 0x92, 0xb0, 0xf6, 0x9a, 0xa2, 0x21, 0xfb, 0xa7, 0xc0, 0xfc,
+	0x07, 0xc2, 0x4f, 0x1f, 0x86, 0xb7, 0x5f, 0xe1, 0xc7, 0x66, 0xff, 0x91, 0xc6, 0x71, 0xc1, 0x01,
+	0x1c, 0x34, 0x43, 0x8b, 0x41, 0x38, 0x36, 0xf2, 0xba, 0x51, 0xed, 0x8f, 0x18, 0xdf, 0x8d, 0x32,
+	0x76, 0x3a, 0xf5, 0xa8, 0xd7, 0xad, 0x3c, 0xa6, 0x7e, 0x17, 0xf3, 0x4c, 0xcb, 0x2f, 0x92, 0x17,
+	0x06, 0x6b, 0x40, 0xef, 0x1f, 0x36, 0xfb, 0xf3, 0xd5, 0x45, 0x97, 0xab, 0x8b, 0x7e, 0x5f, 0x5d,
+	0xf4, 0xfd, 0xe6, 0x5a, 0x97, 0x9b, 0x6b, 0xfd, 0xbc, 0xb9, 0xd6, 0xa7, 0xb7, 0x42, 0xea, 0xaf,
+	0x65, 0xe4, 0x31, 0x95, 0x12, 0xa6, 0x20, 0x55, 0x40, 0x64, 0xc4, 0x96, 0x42, 0x91, 0x93, 0xef,
+	0x93, 0x54, 0xc5, 0x65, 0xc2, 0xa1, 0xba, 0x03, 0x20, 0xc1, 0x6a, 0x59, 0x9d, 0x80, 0xfe, 0x96,
+	0x73, 0x88, 0xfa, 0xf5, 0x9f, 0x7e, 0xf3, 0x77, 0x00, 0x44, 0x38, 0x8c, 0xf5, 0xb2, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -177,6 +200,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CallResults) > 0 {
+		for iNdEx := len(m.CallResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Ics27Accounts) > 0 {
 		for iNdEx := len(m.Ics27Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -257,6 +304,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CallResults) > 0 {
+		for _, e := range m.CallResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -344,6 +399,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallResults = append(m.CallResults, CallResult{})
+			if err := m.CallResults[len(m.CallResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
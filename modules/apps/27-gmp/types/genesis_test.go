@@ -15,12 +15,23 @@ func TestDefaultGenesisState(t *testing.T) {
 	gs := types.DefaultGenesisState()
 	require.NotNil(t, gs)
 	require.Empty(t, gs.Ics27Accounts)
+	require.Empty(t, gs.CallResults)
+	require.Equal(t, types.DefaultParams(), gs.Params)
 }
 
 func TestGenesisState_Validate(t *testing.T) {
 	validAddress := ibctesting.TestAccAddress
 	validClientID := ibctesting.FirstClientID
 
+	callResult := types.CallResult{
+		ClientId: validClientID,
+		Sequence: 1,
+		Sender:   validAddress,
+		Salt:     []byte("salt"),
+		Status:   types.CALL_SUCCESS,
+		Result:   []byte("result"),
+	}
+
 	testCases := []struct {
 		name     string
 		genState *types.GenesisState
@@ -111,6 +122,68 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"success: valid genesis with call result",
+			&types.GenesisState{
+				CallResults: []types.CallResult{callResult},
+			},
+			false,
+		},
+		{
+			"failure: call result with invalid client ID",
+			&types.GenesisState{
+				CallResults: []types.CallResult{
+					{
+						ClientId: "x",
+						Sequence: 1,
+						Sender:   validAddress,
+						Salt:     []byte("salt"),
+						Status:   types.CALL_SUCCESS,
+						Result:   []byte("result"),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"failure: call result with zero sequence",
+			&types.GenesisState{
+				CallResults: []types.CallResult{
+					{
+						ClientId: validClientID,
+						Sequence: 0,
+						Sender:   validAddress,
+						Salt:     []byte("salt"),
+						Status:   types.CALL_SUCCESS,
+						Result:   []byte("result"),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"failure: call result with unspecified status",
+			&types.GenesisState{
+				CallResults: []types.CallResult{
+					{
+						ClientId: validClientID,
+						Sequence: 1,
+						Sender:   validAddress,
+						Salt:     []byte("salt"),
+						Status:   types.CALL_UNSPECIFIED,
+						Result:   []byte("result"),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"failure: duplicate call result",
+			&types.GenesisState{
+				CallResults: []types.CallResult{callResult, callResult},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
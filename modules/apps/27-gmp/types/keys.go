@@ -36,4 +36,13 @@ var (
 
 	// AccountsByAddressKey is the key used to store the accounts by address in the keeper
 	AccountsByAddressKey = collections.NewPrefix(1)
+
+	// ParamsKey is the key used to store the params in the keeper
+	ParamsKey = collections.NewPrefix(2)
+
+	// CallResultsKey is the key used to store the results of the calls sent with GMP packets in the keeper
+	CallResultsKey = collections.NewPrefix(3)
+
+	// CompletedCallResultsKey is the key used to index the completed call results by client and sequence in the keeper
+	CompletedCallResultsKey = collections.NewPrefix(4)
 )
@@ -22,6 +22,9 @@ const (
 var (
 	_ sdk.Msg              = (*MsgSendCall)(nil)
 	_ sdk.HasValidateBasic = (*MsgSendCall)(nil)
+
+	_ sdk.Msg              = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 )
 
 // NewMsgSendCall creates a new MsgSendCall instance
@@ -86,3 +89,20 @@ func validateEncoding(encoding string) error {
 		return errorsmod.Wrapf(ErrInvalidEncoding, "unsupported encoding format %s", encoding)
 	}
 }
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Params.Validate()
+}
@@ -433,6 +433,36 @@ func TestMsgSendCall_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success: valid signer address",
+			types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.NewParams(1_000_000)),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgUpdateParams("signer", types.DefaultParams()),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
 func TestGMPPacketData_GetPacketSender(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// DefaultMaxCallResults is the default maximum number of completed call results stored per source client
+const DefaultMaxCallResults uint64 = 100
+
+// NewParams creates a new parameter configuration for the 27-gmp module. The maximum number of stored call results is
+// set to its default value.
+func NewParams(maxExecutionGas uint64) Params {
+	return Params{
+		MaxExecutionGas: maxExecutionGas,
+		MaxCallResults:  DefaultMaxCallResults,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the 27-gmp module. The execution gas is not limited.
+func DefaultParams() Params {
+	return NewParams(0)
+}
+
+// Validate validates all 27-gmp module parameters
+func (Params) Validate() error {
+	return nil
+}
@@ -0,0 +1,345 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/gmp/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of 27-gmp parameters.
+type Params struct {
+	// max_execution_gas defines the maximum gas the payload of a received GMP packet may consume when executed.
+	// A zero value does not limit the execution gas.
+	MaxExecutionGas uint64 `protobuf:"varint,1,opt,name=max_execution_gas,json=maxExecutionGas,proto3" json:"max_execution_gas,omitempty"`
+	// max_call_results defines the maximum number of completed call results stored per source client. The results of
+	// the calls with the lowest sequences are pruned beyond the limit. A zero value disables storing completed results.
+	MaxCallResults uint64 `protobuf:"varint,2,opt,name=max_call_results,json=maxCallResults,proto3" json:"max_call_results,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7f62845301daadb, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxExecutionGas() uint64 {
+	if m != nil {
+		return m.MaxExecutionGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCallResults() uint64 {
+	if m != nil {
+		return m.MaxCallResults
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ibc.applications.gmp.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/gmp/v1/params.proto", fileDescriptor_b7f62845301daadb)
+}
+
+var fileDescriptor_b7f62845301daadb = []byte{
+	// 229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xcf, 0x3f, 0x4b, 0xc4, 0x30,
+	0x18, 0x80, 0xf1, 0x56, 0xe4, 0x86, 0x0e, 0xfe, 0xe9, 0xe2, 0x4d, 0x41, 0xc4, 0xe1, 0x10, 0x2e,
+	0x2f, 0x55, 0xd0, 0x5d, 0x11, 0xd7, 0xe3, 0x46, 0x07, 0xcb, 0x9b, 0x18, 0x62, 0x20, 0xaf, 0x09,
+	0x7d, 0xd3, 0x52, 0xbf, 0x85, 0x1f, 0xcb, 0xf1, 0x46, 0x47, 0x69, 0xbf, 0x88, 0xb4, 0x22, 0xdc,
+	0xfa, 0xf0, 0x5b, 0x9e, 0xe2, 0xd2, 0x29, 0x0d, 0x18, 0xa3, 0x77, 0x1a, 0x93, 0x0b, 0xef, 0x0c,
+	0x96, 0x22, 0x74, 0x15, 0x44, 0x6c, 0x90, 0x58, 0xc6, 0x26, 0xa4, 0x50, 0x9e, 0x39, 0xa5, 0xe5,
+	0xbe, 0x92, 0x96, 0xa2, 0xec, 0xaa, 0x8b, 0x97, 0x62, 0xb1, 0x99, 0x61, 0x79, 0x55, 0x9c, 0x12,
+	0xf6, 0xb5, 0xe9, 0x8d, 0x6e, 0x27, 0x52, 0x5b, 0xe4, 0x65, 0x7e, 0x9e, 0xaf, 0x0e, 0xb7, 0xc7,
+	0x84, 0xfd, 0xe3, 0x7f, 0x7f, 0x42, 0x2e, 0x57, 0xc5, 0xc9, 0x64, 0x35, 0x7a, 0x5f, 0x37, 0x86,
+	0x5b, 0x9f, 0x78, 0x79, 0x30, 0xd3, 0x23, 0xc2, 0xfe, 0x01, 0xbd, 0xdf, 0xfe, 0xd5, 0xfb, 0xcd,
+	0xd7, 0x20, 0xf2, 0xdd, 0x20, 0xf2, 0x9f, 0x41, 0xe4, 0x9f, 0xa3, 0xc8, 0x76, 0xa3, 0xc8, 0xbe,
+	0x47, 0x91, 0x3d, 0xdf, 0x5a, 0x97, 0xde, 0x5a, 0x25, 0x75, 0x20, 0xd0, 0x81, 0x29, 0x30, 0x38,
+	0xa5, 0xd7, 0x36, 0x40, 0x57, 0x55, 0x40, 0xe1, 0xb5, 0xf5, 0x86, 0xa7, 0x33, 0x86, 0xeb, 0xbb,
+	0xf5, 0x34, 0x95, 0x3e, 0xa2, 0x61, 0xb5, 0x98, 0x8f, 0x6e, 0x7e, 0x07, 0x00, 0xbf, 0x24, 0xdf,
+	0x33, 0xf9, 0x00, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxCallResults != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCallResults))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxExecutionGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExecutionGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxExecutionGas != 0 {
+		n += 1 + sovParams(uint64(m.MaxExecutionGas))
+	}
+	if m.MaxCallResults != 0 {
+		n += 1 + sovParams(uint64(m.MaxCallResults))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionGas", wireType)
+			}
+			m.MaxExecutionGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallResults", wireType)
+			}
+			m.MaxCallResults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallResults |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -28,6 +28,89 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d55aa1ab285a918, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d55aa1ab285a918, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 // QueryAccountAddressRequest is the request type for the Query/AccountAddress RPC method.
 type QueryAccountAddressRequest struct {
 	// The (local) client identifier
@@ -42,7 +125,7 @@ func (m *QueryAccountAddressRequest) Reset()         { *m = QueryAccountAddressR
 func (m *QueryAccountAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountAddressRequest) ProtoMessage()    {}
 func (*QueryAccountAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d55aa1ab285a918, []int{0}
+	return fileDescriptor_0d55aa1ab285a918, []int{2}
 }
 func (m *QueryAccountAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -102,7 +185,7 @@ func (m *QueryAccountAddressResponse) Reset()         { *m = QueryAccountAddress
 func (m *QueryAccountAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountAddressResponse) ProtoMessage()    {}
 func (*QueryAccountAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d55aa1ab285a918, []int{1}
+	return fileDescriptor_0d55aa1ab285a918, []int{3}
 }
 func (m *QueryAccountAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,7 +230,7 @@ func (m *QueryAccountIdentifierRequest) Reset()         { *m = QueryAccountIdent
 func (m *QueryAccountIdentifierRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountIdentifierRequest) ProtoMessage()    {}
 func (*QueryAccountIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d55aa1ab285a918, []int{2}
+	return fileDescriptor_0d55aa1ab285a918, []int{4}
 }
 func (m *QueryAccountIdentifierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -192,7 +275,7 @@ func (m *QueryAccountIdentifierResponse) Reset()         { *m = QueryAccountIden
 func (m *QueryAccountIdentifierResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountIdentifierResponse) ProtoMessage()    {}
 func (*QueryAccountIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d55aa1ab285a918, []int{3}
+	return fileDescriptor_0d55aa1ab285a918, []int{5}
 }
 func (m *QueryAccountIdentifierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,11 +311,115 @@ func (m *QueryAccountIdentifierResponse) GetAccountId() *AccountIdentifier {
 	return nil
 }
 
+// QueryCallResultRequest is the request type for the Query/CallResult RPC method.
+type QueryCallResultRequest struct {
+	// The (local) client identifier the packet was sent on
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The sequence of the packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryCallResultRequest) Reset()         { *m = QueryCallResultRequest{} }
+func (m *QueryCallResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCallResultRequest) ProtoMessage()    {}
+func (*QueryCallResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d55aa1ab285a918, []int{6}
+}
+func (m *QueryCallResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCallResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallResultRequest.Merge(m, src)
+}
+func (m *QueryCallResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCallResultRequest proto.InternalMessageInfo
+
+func (m *QueryCallResultRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryCallResultRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryCallResultResponse is the response type for the Query/CallResult RPC method.
+type QueryCallResultResponse struct {
+	CallResult *CallResult `protobuf:"bytes,1,opt,name=call_result,json=callResult,proto3" json:"call_result,omitempty"`
+}
+
+func (m *QueryCallResultResponse) Reset()         { *m = QueryCallResultResponse{} }
+func (m *QueryCallResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCallResultResponse) ProtoMessage()    {}
+func (*QueryCallResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d55aa1ab285a918, []int{7}
+}
+func (m *QueryCallResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCallResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallResultResponse.Merge(m, src)
+}
+func (m *QueryCallResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCallResultResponse proto.InternalMessageInfo
+
+func (m *QueryCallResultResponse) GetCallResult() *CallResult {
+	if m != nil {
+		return m.CallResult
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.gmp.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.gmp.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryAccountAddressRequest)(nil), "ibc.applications.gmp.v1.QueryAccountAddressRequest")
 	proto.RegisterType((*QueryAccountAddressResponse)(nil), "ibc.applications.gmp.v1.QueryAccountAddressResponse")
 	proto.RegisterType((*QueryAccountIdentifierRequest)(nil), "ibc.applications.gmp.v1.QueryAccountIdentifierRequest")
 	proto.RegisterType((*QueryAccountIdentifierResponse)(nil), "ibc.applications.gmp.v1.QueryAccountIdentifierResponse")
+	proto.RegisterType((*QueryCallResultRequest)(nil), "ibc.applications.gmp.v1.QueryCallResultRequest")
+	proto.RegisterType((*QueryCallResultResponse)(nil), "ibc.applications.gmp.v1.QueryCallResultResponse")
 }
 
 func init() {
@@ -240,36 +427,46 @@ func init() {
 }
 
 var fileDescriptor_0d55aa1ab285a918 = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6b, 0xd4, 0x40,
-	0x18, 0xde, 0xb4, 0x5a, 0xdc, 0x11, 0x2a, 0xce, 0x41, 0x97, 0x54, 0x83, 0x44, 0x44, 0x59, 0x68,
-	0x86, 0x6c, 0x65, 0xeb, 0x45, 0xb1, 0x3d, 0x48, 0xf7, 0xa6, 0x3d, 0x7a, 0x29, 0x93, 0xc9, 0x18,
-	0x07, 0x93, 0x99, 0x69, 0x66, 0xb2, 0x50, 0x96, 0x5c, 0xfc, 0x05, 0x82, 0xe0, 0x2f, 0x52, 0xf0,
-	0x58, 0xf0, 0xe2, 0x51, 0x76, 0xfd, 0x21, 0x92, 0xcc, 0x64, 0xed, 0x1a, 0x03, 0xbb, 0xb7, 0xe4,
-	0xfd, 0x78, 0x3e, 0xf2, 0xbc, 0x01, 0x0f, 0x59, 0x44, 0x10, 0x96, 0x32, 0x65, 0x04, 0x6b, 0x26,
-	0xb8, 0x42, 0x49, 0x26, 0xd1, 0x34, 0x44, 0xe7, 0x05, 0xcd, 0x2f, 0x02, 0x99, 0x0b, 0x2d, 0xe0,
-	0x5d, 0x16, 0x91, 0xe0, 0xea, 0x50, 0x90, 0x64, 0x32, 0x98, 0x86, 0xee, 0xbd, 0x44, 0x88, 0x24,
-	0xa5, 0x08, 0x4b, 0x86, 0x30, 0xe7, 0x42, 0xdb, 0x76, 0xbd, 0xe6, 0x3e, 0xea, 0xc2, 0xc6, 0x84,
-	0x88, 0x82, 0x6b, 0x33, 0xe6, 0x53, 0xe0, 0xbe, 0xa9, 0xc8, 0x8e, 0x4c, 0xf5, 0x28, 0x8e, 0x73,
-	0xaa, 0xd4, 0x29, 0x3d, 0x2f, 0xa8, 0xd2, 0x70, 0x0f, 0xf4, 0x49, 0xca, 0x28, 0xd7, 0x67, 0x2c,
-	0x1e, 0x38, 0x0f, 0x9c, 0x27, 0xfd, 0xd3, 0x1b, 0xa6, 0x30, 0x89, 0xe1, 0x1d, 0xb0, 0xa3, 0x28,
-	0x8f, 0x69, 0x3e, 0xd8, 0xaa, 0x3b, 0xf6, 0x0d, 0x42, 0x70, 0x4d, 0xe1, 0x54, 0x0f, 0xb6, 0xeb,
-	0x6a, 0xfd, 0xec, 0xbf, 0x02, 0x7b, 0xff, 0xa5, 0x51, 0x52, 0x70, 0x45, 0xe1, 0x63, 0x70, 0xcb,
-	0xca, 0x3a, 0xc3, 0xa6, 0x65, 0xd9, 0x76, 0xf1, 0xca, 0x82, 0x7f, 0x02, 0xee, 0x5f, 0xc5, 0x99,
-	0xc4, 0x94, 0x6b, 0xf6, 0x8e, 0xd1, 0xbc, 0x51, 0xbc, 0x36, 0xd2, 0x07, 0xe0, 0x75, 0x21, 0x59,
-	0x51, 0x13, 0x00, 0x1a, 0x28, 0xeb, 0xfe, 0xe6, 0x68, 0x18, 0x74, 0xa4, 0x11, 0xb4, 0x71, 0xfa,
-	0xb8, 0x29, 0x8d, 0xbe, 0x6c, 0x83, 0xeb, 0x35, 0x1b, 0xfc, 0xe6, 0x80, 0xdd, 0xd5, 0x8f, 0x00,
-	0x0f, 0x3a, 0x31, 0xbb, 0x93, 0x71, 0x9f, 0x6e, 0xb6, 0x64, 0x2c, 0xf9, 0x27, 0x1f, 0x7f, 0xfc,
-	0xfe, 0xbc, 0x75, 0x0c, 0x5f, 0x22, 0x7b, 0x1d, 0xcb, 0xab, 0x30, 0xa9, 0x2a, 0x34, 0x5b, 0xe6,
-	0x5d, 0x36, 0x97, 0xa2, 0xd0, 0xcc, 0x24, 0x5b, 0xa2, 0x59, 0x15, 0xe6, 0xf3, 0xe1, 0xb0, 0x84,
-	0x5f, 0x1d, 0x70, 0xbb, 0x65, 0x19, 0x8e, 0xd7, 0x52, 0xd5, 0x4a, 0xcd, 0x3d, 0xdc, 0x78, 0xcf,
-	0x1a, 0x7a, 0x51, 0x1b, 0x7a, 0x06, 0xc7, 0x2d, 0x43, 0x7f, 0xc5, 0xff, 0x73, 0x0f, 0x25, 0x62,
-	0x4b, 0x9c, 0xe3, 0xd7, 0xdf, 0xe7, 0x9e, 0x73, 0x39, 0xf7, 0x9c, 0x5f, 0x73, 0xcf, 0xf9, 0xb4,
-	0xf0, 0x7a, 0x97, 0x0b, 0xaf, 0xf7, 0x73, 0xe1, 0xf5, 0xde, 0x8e, 0x13, 0xa6, 0xdf, 0x17, 0x51,
-	0x40, 0x44, 0x86, 0x88, 0x50, 0x99, 0x50, 0x15, 0xc5, 0x7e, 0x22, 0xd0, 0x34, 0x0c, 0x51, 0x26,
-	0xe2, 0x22, 0xa5, 0xca, 0x30, 0x8e, 0x0e, 0xf7, 0x2b, 0x52, 0x7d, 0x21, 0xa9, 0x8a, 0x76, 0xea,
-	0xff, 0xea, 0xe0, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xe3, 0x90, 0x7c, 0xdc, 0x03, 0x00,
-	0x00,
+	// 615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xae, 0xfb, 0x6b, 0xa3, 0x76, 0x2a, 0xf5, 0x27, 0x16, 0xd4, 0x04, 0x17, 0x5c, 0xe4, 0x82,
+	0x40, 0x81, 0x7a, 0x49, 0x0a, 0x09, 0x97, 0x22, 0x5a, 0x10, 0x6a, 0x2e, 0xa8, 0xcd, 0x91, 0x4b,
+	0xb4, 0xb1, 0x17, 0x63, 0x61, 0x7b, 0x5d, 0xaf, 0x1d, 0xa9, 0x8a, 0x72, 0x41, 0x3c, 0x00, 0x12,
+	0xef, 0xc1, 0x53, 0x80, 0xc4, 0x09, 0x55, 0xe2, 0xc2, 0x11, 0x25, 0x3c, 0x08, 0xf2, 0x7a, 0xf3,
+	0xaf, 0xae, 0x2b, 0xf7, 0x66, 0xcf, 0xce, 0x7c, 0xdf, 0x37, 0xb3, 0xdf, 0x2c, 0x6c, 0x3b, 0x5d,
+	0x13, 0x93, 0x20, 0x70, 0x1d, 0x93, 0x44, 0x0e, 0xf3, 0x39, 0xb6, 0xbd, 0x00, 0xf7, 0x6a, 0xf8,
+	0x24, 0xa6, 0xe1, 0xa9, 0x11, 0x84, 0x2c, 0x62, 0xa8, 0xec, 0x74, 0x4d, 0x63, 0x36, 0xc9, 0xb0,
+	0xbd, 0xc0, 0xe8, 0xd5, 0xd4, 0x5b, 0x36, 0x63, 0xb6, 0x4b, 0x31, 0x09, 0x1c, 0x4c, 0x7c, 0x9f,
+	0x45, 0xf2, 0x58, 0x94, 0xa9, 0xf7, 0xf2, 0xb0, 0x89, 0x69, 0xb2, 0xd8, 0x8f, 0x64, 0x9a, 0x9e,
+	0x97, 0x66, 0x12, 0xd7, 0x95, 0x39, 0x77, 0xf3, 0x72, 0x02, 0x12, 0x12, 0x4f, 0x12, 0xea, 0x37,
+	0x00, 0x1d, 0x27, 0xb2, 0x8f, 0x44, 0xb0, 0x4d, 0x4f, 0x62, 0xca, 0x23, 0xfd, 0x0d, 0x5c, 0x9f,
+	0x8b, 0xf2, 0x80, 0xf9, 0x9c, 0xa2, 0x26, 0x94, 0xd2, 0xe2, 0x8a, 0x72, 0x47, 0x79, 0xb0, 0x56,
+	0xdf, 0x32, 0x72, 0xba, 0x34, 0x64, 0xa1, 0x4c, 0xd7, 0x29, 0xa8, 0x02, 0x6f, 0x3f, 0xed, 0x62,
+	0xdf, 0xb2, 0x42, 0xca, 0xc7, 0x6c, 0x68, 0x13, 0x56, 0x4d, 0xd7, 0xa1, 0x7e, 0xd4, 0x71, 0x2c,
+	0x81, 0xbc, 0xda, 0x5e, 0x49, 0x03, 0x2d, 0x0b, 0x6d, 0x40, 0x89, 0x53, 0xdf, 0xa2, 0x61, 0x65,
+	0x51, 0x9c, 0xc8, 0x3f, 0x84, 0x60, 0x89, 0x13, 0x37, 0xaa, 0xfc, 0x27, 0xa2, 0xe2, 0x5b, 0x7f,
+	0x0d, 0x9b, 0x17, 0xd2, 0x48, 0xf9, 0xf7, 0xe1, 0x7f, 0x39, 0xc6, 0x0e, 0x49, 0x8f, 0x24, 0xdb,
+	0x3a, 0x99, 0x2b, 0xd0, 0x0f, 0xe1, 0xf6, 0x2c, 0x4e, 0xcb, 0xa2, 0x7e, 0xe4, 0xbc, 0x73, 0x68,
+	0x38, 0x56, 0x5c, 0x18, 0xe9, 0x03, 0x68, 0x79, 0x48, 0x52, 0x54, 0x0b, 0x60, 0x0c, 0x25, 0xbb,
+	0x5f, 0xab, 0x57, 0x73, 0xe7, 0x9a, 0xc5, 0x59, 0x25, 0xe3, 0x90, 0x7e, 0x0c, 0x1b, 0x82, 0xec,
+	0x25, 0x71, 0xdd, 0x36, 0xe5, 0xb1, 0x1b, 0x15, 0x9a, 0xb0, 0x0a, 0x2b, 0x3c, 0xc9, 0xf3, 0x4d,
+	0x2a, 0x66, 0xbc, 0xd4, 0x9e, 0xfc, 0xeb, 0x1d, 0x28, 0x67, 0x20, 0xa5, 0xf0, 0x57, 0xb0, 0x96,
+	0xb8, 0xad, 0x13, 0x8a, 0xb0, 0x54, 0xbe, 0x9d, 0xab, 0x7c, 0x06, 0x01, 0xcc, 0xc9, 0x77, 0xfd,
+	0xe7, 0x32, 0x2c, 0x0b, 0x06, 0xf4, 0x49, 0x81, 0x52, 0x6a, 0x1b, 0xf4, 0x30, 0x17, 0x25, 0xeb,
+	0x55, 0xf5, 0x51, 0xb1, 0xe4, 0x54, 0xb5, 0xbe, 0xf5, 0xf1, 0xd7, 0xdf, 0x2f, 0x8b, 0x37, 0x51,
+	0x19, 0xcb, 0xf5, 0x38, 0xb7, 0x16, 0xe8, 0xbb, 0x02, 0xeb, 0xf3, 0xfe, 0x41, 0xbb, 0x97, 0x33,
+	0x5c, 0x68, 0x6a, 0xf5, 0xc9, 0xd5, 0x8a, 0xa4, 0xbc, 0x43, 0x21, 0xef, 0x00, 0xbd, 0xc8, 0xc8,
+	0x4b, 0xaf, 0x8b, 0xe3, 0xfe, 0xe4, 0x22, 0x07, 0xe3, 0x47, 0x81, 0xe3, 0x7e, 0xba, 0x14, 0x03,
+	0xdc, 0x4f, 0xf6, 0x60, 0xaf, 0x5a, 0x1d, 0xa0, 0x6f, 0x0a, 0x5c, 0xcb, 0xb8, 0x05, 0x35, 0x0a,
+	0xa9, 0xca, 0x18, 0x5e, 0x6d, 0x5e, 0xb9, 0x4e, 0x36, 0xf4, 0x5c, 0x34, 0xf4, 0x0c, 0x35, 0x32,
+	0x0d, 0x4d, 0xc5, 0x9f, 0x5b, 0xa5, 0x01, 0x76, 0xa6, 0x82, 0xbf, 0x2a, 0x00, 0x53, 0xeb, 0x20,
+	0x7c, 0xb9, 0x8e, 0x8c, 0xf3, 0xd5, 0xc7, 0xc5, 0x0b, 0xa4, 0xe2, 0x3d, 0xa1, 0xb8, 0x89, 0x9e,
+	0x16, 0xba, 0x82, 0xc4, 0xca, 0x62, 0xfe, 0xe9, 0xc2, 0x0c, 0x0e, 0x8e, 0x7e, 0x0c, 0x35, 0xe5,
+	0x6c, 0xa8, 0x29, 0x7f, 0x86, 0x9a, 0xf2, 0x79, 0xa4, 0x2d, 0x9c, 0x8d, 0xb4, 0x85, 0xdf, 0x23,
+	0x6d, 0xe1, 0x6d, 0xc3, 0x76, 0xa2, 0xf7, 0x71, 0xd7, 0x30, 0x99, 0x87, 0x4d, 0xc6, 0x3d, 0xc6,
+	0x13, 0x86, 0x1d, 0x9b, 0xe1, 0x5e, 0xad, 0x86, 0x3d, 0x66, 0xc5, 0x2e, 0xe5, 0x29, 0x61, 0xbd,
+	0xb9, 0x93, 0x70, 0x46, 0xa7, 0x01, 0xe5, 0xdd, 0x92, 0x78, 0xa9, 0x77, 0xff, 0x0d, 0x00, 0xf7,
+	0x2c, 0x43, 0x45, 0x78, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -284,11 +481,15 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params queries all parameters of the 27-gmp module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// AccountAddress queries the interchain account address for a given client_id, sender, and salt.
 	// If the account is not registered, the address is computed deterministically
 	AccountAddress(ctx context.Context, in *QueryAccountAddressRequest, opts ...grpc.CallOption) (*QueryAccountAddressResponse, error)
 	// AccountIdentifier queries the account identifier for a given interchain account address.
 	AccountIdentifier(ctx context.Context, in *QueryAccountIdentifierRequest, opts ...grpc.CallOption) (*QueryAccountIdentifierResponse, error)
+	// CallResult queries the result of the call sent with the packet of a given client_id and sequence.
+	CallResult(ctx context.Context, in *QueryCallResultRequest, opts ...grpc.CallOption) (*QueryCallResultResponse, error)
 }
 
 type queryClient struct {
@@ -299,6 +500,15 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.gmp.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AccountAddress(ctx context.Context, in *QueryAccountAddressRequest, opts ...grpc.CallOption) (*QueryAccountAddressResponse, error) {
 	out := new(QueryAccountAddressResponse)
 	err := c.cc.Invoke(ctx, "/ibc.applications.gmp.v1.Query/AccountAddress", in, out, opts...)
@@ -317,30 +527,67 @@ func (c *queryClient) AccountIdentifier(ctx context.Context, in *QueryAccountIde
 	return out, nil
 }
 
+func (c *queryClient) CallResult(ctx context.Context, in *QueryCallResultRequest, opts ...grpc.CallOption) (*QueryCallResultResponse, error) {
+	out := new(QueryCallResultResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.gmp.v1.Query/CallResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params queries all parameters of the 27-gmp module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// AccountAddress queries the interchain account address for a given client_id, sender, and salt.
 	// If the account is not registered, the address is computed deterministically
 	AccountAddress(context.Context, *QueryAccountAddressRequest) (*QueryAccountAddressResponse, error)
 	// AccountIdentifier queries the account identifier for a given interchain account address.
 	AccountIdentifier(context.Context, *QueryAccountIdentifierRequest) (*QueryAccountIdentifierResponse, error)
+	// CallResult queries the result of the call sent with the packet of a given client_id and sequence.
+	CallResult(context.Context, *QueryCallResultRequest) (*QueryCallResultResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) AccountAddress(ctx context.Context, req *QueryAccountAddressRequest) (*QueryAccountAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountAddress not implemented")
 }
 func (*UnimplementedQueryServer) AccountIdentifier(ctx context.Context, req *QueryAccountIdentifierRequest) (*QueryAccountIdentifierResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountIdentifier not implemented")
 }
+func (*UnimplementedQueryServer) CallResult(ctx context.Context, req *QueryCallResultRequest) (*QueryCallResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallResult not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.gmp.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AccountAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAccountAddressRequest)
 	if err := dec(in); err != nil {
@@ -377,11 +624,33 @@ func _Query_AccountIdentifier_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CallResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCallResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CallResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.gmp.v1.Query/CallResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CallResult(ctx, req.(*QueryCallResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.gmp.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "AccountAddress",
 			Handler:    _Query_AccountAddress_Handler,
@@ -390,11 +659,73 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountIdentifier",
 			Handler:    _Query_AccountIdentifier_Handler,
 		},
+		{
+			MethodName: "CallResult",
+			Handler:    _Query_CallResult_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/gmp/v1/query.proto",
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAccountAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -534,42 +865,134 @@ func (m *QueryAccountIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCallResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryCallResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCallResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CallResult != nil {
+		{
+			size, err := m.CallResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.AccountAddress)
@@ -605,12 +1028,177 @@ func (m *QueryAccountIdentifierResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCallResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryCallResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallResult != nil {
+		l = m.CallResult.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAccountAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1007,6 +1595,193 @@ func (m *QueryAccountIdentifierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCallResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCallResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallResult == nil {
+				m.CallResult = &CallResult{}
+			}
+			if err := m.CallResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -121,42 +121,129 @@ func (m *MsgSendCallResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendCallResponse proto.InternalMessageInfo
 
+// MsgUpdateParams defines the payload for Msg/UpdateParams
+type MsgUpdateParams struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// params defines the 27-gmp parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bfd6a386d18ce5, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse defines the response for Msg/UpdateParams
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bfd6a386d18ce5, []int{3}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSendCall)(nil), "ibc.applications.gmp.v1.MsgSendCall")
 	proto.RegisterType((*MsgSendCallResponse)(nil), "ibc.applications.gmp.v1.MsgSendCallResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.gmp.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.gmp.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("ibc/applications/gmp/v1/tx.proto", fileDescriptor_32bfd6a386d18ce5) }
 
 var fileDescriptor_32bfd6a386d18ce5 = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xb1, 0x6e, 0x14, 0x31,
-	0x10, 0xbd, 0x4d, 0x36, 0x97, 0xc3, 0x04, 0x01, 0x06, 0x11, 0x6b, 0x8b, 0xe5, 0x14, 0x28, 0x4e,
-	0x81, 0xac, 0x75, 0x41, 0x22, 0x52, 0x4a, 0x52, 0x47, 0x42, 0x0b, 0x15, 0x05, 0xd1, 0xae, 0x77,
-	0x64, 0x2c, 0xad, 0xd7, 0x66, 0xc7, 0x7b, 0x22, 0x1d, 0x4a, 0x45, 0xc9, 0x27, 0xf0, 0x09, 0xf9,
-	0x0c, 0xca, 0x94, 0x94, 0xe8, 0xae, 0xc8, 0x6f, 0x20, 0xfb, 0x6e, 0x4f, 0xd7, 0x20, 0xa5, 0xf2,
-	0xbc, 0x79, 0x4f, 0xcf, 0x4f, 0x33, 0x43, 0xc6, 0xaa, 0x14, 0xbc, 0xb0, 0xb6, 0x56, 0xa2, 0x70,
-	0xca, 0x34, 0xc8, 0xa5, 0xb6, 0x7c, 0x36, 0xe5, 0xee, 0x5b, 0x66, 0x5b, 0xe3, 0x0c, 0xdd, 0x57,
-	0xa5, 0xc8, 0x36, 0x15, 0x99, 0xd4, 0x36, 0x9b, 0x4d, 0x93, 0xa7, 0xd2, 0x48, 0x13, 0x34, 0xdc,
-	0x57, 0x4b, 0x79, 0xb2, 0x2f, 0x0c, 0x6a, 0x83, 0x5c, 0xa3, 0xf4, 0x36, 0x1a, 0xe5, 0x92, 0x38,
-	0xb8, 0xda, 0x22, 0xf7, 0xcf, 0x51, 0x7e, 0x80, 0xa6, 0x3a, 0x2b, 0xea, 0x9a, 0xbe, 0x20, 0x0f,
-	0xd0, 0x74, 0xad, 0x80, 0x0b, 0x51, 0x2b, 0x68, 0x1c, 0x8b, 0xc6, 0xd1, 0xe4, 0x5e, 0xbe, 0xb7,
-	0x6c, 0x9e, 0x85, 0x1e, 0x7d, 0x46, 0x86, 0x08, 0x4d, 0x05, 0x2d, 0xdb, 0x0a, 0xec, 0x0a, 0xd1,
-	0x84, 0x8c, 0x5a, 0x10, 0xa0, 0x66, 0xd0, 0xb2, 0xed, 0xc0, 0xac, 0x31, 0xa5, 0x24, 0xc6, 0xa2,
-	0x76, 0x2c, 0x1e, 0x47, 0x93, 0xbd, 0x3c, 0xd4, 0x94, 0x91, 0x5d, 0x5b, 0x5c, 0xd6, 0xa6, 0xa8,
-	0xd8, 0x4e, 0x68, 0xf7, 0x90, 0xbe, 0x22, 0x8f, 0x9d, 0xd2, 0x60, 0x3a, 0x77, 0xe1, 0x5f, 0x74,
-	0x85, 0xb6, 0x6c, 0x38, 0x8e, 0x26, 0x71, 0xfe, 0x68, 0x45, 0x7c, 0xec, 0xfb, 0xde, 0x5a, 0x83,
-	0x36, 0x6c, 0x37, 0x7c, 0x19, 0x6a, 0x1f, 0x05, 0x1a, 0x61, 0x2a, 0xd5, 0x48, 0x36, 0x5a, 0x46,
-	0xe9, 0xf1, 0xe9, 0xc3, 0x1f, 0xbf, 0x9e, 0x0f, 0xae, 0x6e, 0xaf, 0x0f, 0x57, 0xb9, 0x0f, 0x4e,
-	0xc8, 0x93, 0x8d, 0x19, 0xe4, 0x80, 0xd6, 0x34, 0x08, 0xde, 0x03, 0xe1, 0x6b, 0x07, 0x8d, 0x80,
-	0x30, 0x86, 0x38, 0x5f, 0xe3, 0xd3, 0xd8, 0x7b, 0x1c, 0xd7, 0x64, 0xfb, 0x1c, 0x25, 0xfd, 0x4c,
-	0x46, 0xeb, 0x01, 0xbe, 0xcc, 0xfe, 0xb3, 0x99, 0x6c, 0xe3, 0x8b, 0xe4, 0xf5, 0x5d, 0x54, 0x7d,
-	0x90, 0x64, 0xe7, 0xfb, 0xed, 0xf5, 0x61, 0xf4, 0xee, 0xfd, 0xef, 0x79, 0x1a, 0xdd, 0xcc, 0xd3,
-	0xe8, 0xef, 0x3c, 0x8d, 0x7e, 0x2e, 0xd2, 0xc1, 0xcd, 0x22, 0x1d, 0xfc, 0x59, 0xa4, 0x83, 0x4f,
-	0x6f, 0xa5, 0x72, 0x5f, 0xba, 0x32, 0x13, 0x46, 0xf3, 0xd5, 0xa6, 0x55, 0x29, 0x8e, 0xa4, 0xe1,
-	0xb3, 0xe9, 0x94, 0x6b, 0x53, 0x75, 0x35, 0xa0, 0x3f, 0x28, 0xe4, 0xc7, 0x27, 0x47, 0xfe, 0x96,
-	0xdc, 0xa5, 0x05, 0x2c, 0x87, 0xe1, 0x08, 0xde, 0xfc, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x03,
-	0x35, 0x7b, 0x70, 0x02, 0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0xb6, 0xac, 0x1b, 0x5e, 0xd1, 0x20, 0x20, 0x1a, 0x72, 0x48, 0xab, 0xb2, 0x43, 0x35,
+	0x58, 0x4c, 0x8b, 0xc4, 0xa4, 0x49, 0x5c, 0xb6, 0x73, 0xa5, 0x29, 0xc0, 0x85, 0x03, 0x93, 0xeb,
+	0x3c, 0x19, 0xa3, 0x38, 0x36, 0xb1, 0x5b, 0xd1, 0x1b, 0xda, 0x89, 0x23, 0x3f, 0x81, 0x9f, 0xb0,
+	0x9f, 0xb1, 0xe3, 0x8e, 0x3b, 0x21, 0xd4, 0x1e, 0xf6, 0x37, 0x50, 0x9c, 0xb4, 0x0a, 0x48, 0x45,
+	0x3b, 0xf9, 0x7d, 0xef, 0x7d, 0xfe, 0xde, 0xf3, 0x67, 0x3d, 0xd4, 0xe5, 0x63, 0x8a, 0x89, 0x52,
+	0x29, 0xa7, 0xc4, 0x70, 0x99, 0x69, 0xcc, 0x84, 0xc2, 0xd3, 0x01, 0x36, 0x5f, 0x23, 0x95, 0x4b,
+	0x23, 0xbd, 0x36, 0x1f, 0xd3, 0xa8, 0xce, 0x88, 0x98, 0x50, 0xd1, 0x74, 0x10, 0x3c, 0x66, 0x92,
+	0x49, 0xcb, 0xc1, 0x45, 0x54, 0xd2, 0x83, 0x36, 0x95, 0x5a, 0x48, 0x8d, 0x85, 0x66, 0x85, 0x8c,
+	0xd0, 0xac, 0x2a, 0xec, 0xaf, 0xeb, 0xa4, 0x48, 0x4e, 0x84, 0x2e, 0x59, 0xbd, 0x8b, 0x0d, 0xb4,
+	0x3b, 0xd2, 0xec, 0x2d, 0x64, 0xc9, 0x29, 0x49, 0x53, 0xef, 0x19, 0xba, 0xaf, 0xe5, 0x24, 0xa7,
+	0x70, 0x4e, 0x53, 0x0e, 0x99, 0xf1, 0x9d, 0xae, 0xd3, 0xbf, 0x17, 0xb7, 0xca, 0xe4, 0xa9, 0xcd,
+	0x79, 0x4f, 0x50, 0x53, 0x43, 0x96, 0x40, 0xee, 0x6f, 0xd8, 0x6a, 0x85, 0xbc, 0x00, 0xed, 0xe4,
+	0x40, 0x81, 0x4f, 0x21, 0xf7, 0x37, 0x6d, 0x65, 0x85, 0x3d, 0x0f, 0xb9, 0x9a, 0xa4, 0xc6, 0x77,
+	0xbb, 0x4e, 0xbf, 0x15, 0xdb, 0xd8, 0xf3, 0xd1, 0xb6, 0x22, 0xb3, 0x54, 0x92, 0xc4, 0xdf, 0xb2,
+	0xe9, 0x25, 0xf4, 0x9e, 0xa3, 0x87, 0x86, 0x0b, 0x90, 0x13, 0x73, 0x5e, 0x9c, 0xda, 0x10, 0xa1,
+	0xfc, 0x66, 0xd7, 0xe9, 0xbb, 0xf1, 0x83, 0xaa, 0xf0, 0x6e, 0x99, 0x2f, 0xa4, 0x05, 0x08, 0xe9,
+	0x6f, 0xdb, 0x96, 0x36, 0x2e, 0x46, 0x81, 0x8c, 0xca, 0x84, 0x67, 0xcc, 0xdf, 0x29, 0x47, 0x59,
+	0xe2, 0xe3, 0xbd, 0xef, 0x3f, 0x3b, 0x8d, 0x8b, 0xdb, 0xcb, 0x83, 0x6a, 0xee, 0xde, 0x11, 0x7a,
+	0x54, 0xf3, 0x20, 0x06, 0xad, 0x64, 0xa6, 0xa1, 0xd0, 0xd0, 0xf0, 0x65, 0x02, 0x19, 0x05, 0x6b,
+	0x83, 0x1b, 0xaf, 0xf0, 0xb1, 0x5b, 0x68, 0xf4, 0x66, 0x68, 0x6f, 0xa4, 0xd9, 0x7b, 0x95, 0x10,
+	0x03, 0x67, 0xd6, 0x56, 0xeb, 0x0d, 0x67, 0x19, 0xe4, 0x95, 0x73, 0x15, 0xf2, 0xde, 0xa0, 0x66,
+	0x69, 0xbc, 0xf5, 0x6c, 0x77, 0xd8, 0x89, 0xd6, 0xfc, 0x73, 0x54, 0x0a, 0x9d, 0xb8, 0x57, 0xbf,
+	0x3a, 0x8d, 0xb8, 0xba, 0x54, 0x9f, 0xd9, 0xea, 0xf5, 0x9e, 0xa2, 0xf6, 0x3f, 0xad, 0x97, 0x73,
+	0x0f, 0x6f, 0x1c, 0xb4, 0x39, 0xd2, 0xcc, 0xfb, 0x88, 0x76, 0x56, 0xff, 0xba, 0xbf, 0xb6, 0x5d,
+	0xed, 0xe5, 0xc1, 0x8b, 0xbb, 0xb0, 0x56, 0xfe, 0x7c, 0x46, 0xad, 0xbf, 0x9e, 0xde, 0xff, 0xdf,
+	0xed, 0x3a, 0x33, 0x78, 0x79, 0x57, 0xe6, 0xb2, 0x57, 0xb0, 0xf5, 0xed, 0xf6, 0xf2, 0xc0, 0x39,
+	0x39, 0xbb, 0x9a, 0x87, 0xce, 0xf5, 0x3c, 0x74, 0x7e, 0xcf, 0x43, 0xe7, 0xc7, 0x22, 0x6c, 0x5c,
+	0x2f, 0xc2, 0xc6, 0xcd, 0x22, 0x6c, 0x7c, 0x78, 0xcd, 0xb8, 0xf9, 0x34, 0x19, 0x47, 0x54, 0x0a,
+	0x5c, 0xad, 0x04, 0x1f, 0xd3, 0x43, 0x26, 0xf1, 0x74, 0x30, 0xc0, 0x42, 0x26, 0x93, 0x14, 0x74,
+	0xb1, 0x0f, 0x1a, 0x0f, 0x8f, 0x0e, 0x8b, 0x55, 0x30, 0x33, 0x05, 0x7a, 0xdc, 0xb4, 0x7b, 0xf0,
+	0xea, 0xcf, 0x00, 0xb1, 0x35, 0xdd, 0xde, 0x99, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -173,6 +260,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// SendCall defines a rpc handler method for MsgSendCall.
 	SendCall(ctx context.Context, in *MsgSendCall, opts ...grpc.CallOption) (*MsgSendCallResponse, error)
+	// UpdateParams defines a rpc handler method for MsgUpdateParams.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -192,10 +281,21 @@ func (c *msgClient) SendCall(ctx context.Context, in *MsgSendCall, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.gmp.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SendCall defines a rpc handler method for MsgSendCall.
 	SendCall(context.Context, *MsgSendCall) (*MsgSendCallResponse, error)
+	// UpdateParams defines a rpc handler method for MsgUpdateParams.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -205,6 +305,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SendCall(ctx context.Context, req *MsgSendCall) (*MsgSendCallResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendCall not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -228,6 +331,24 @@ func _Msg_SendCall_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.gmp.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.gmp.v1.Msg",
@@ -237,6 +358,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendCall",
 			Handler:    _Msg_SendCall_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/gmp/v1/tx.proto",
@@ -347,6 +472,69 @@ func (m *MsgSendCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -410,6 +598,30 @@ func (m *MsgSendCallResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -782,6 +994,171 @@ func (m *MsgSendCallResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -20,6 +20,9 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryInterchainAccount(),
 		GetCmdParams(),
+		GetCmdQueryTxResult(),
+		GetCmdQueryTxResults(),
+		GetCmdQueryOwnership(),
 	)
 
 	return queryCmd
@@ -38,6 +41,7 @@ func NewTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		newRegisterInterchainAccountCmd(),
 		newSendTxCmd(),
+		newTransferOwnershipCmd(),
 	)
 
 	return cmd
@@ -4,6 +4,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -76,3 +77,132 @@ func GetCmdParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryTxResult returns the command handler for querying the result of a transaction sent to an interchain account.
+func GetCmdQueryTxResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tx-result [owner] [connection-id] [sequence]",
+		Short:   "Query the result of a transaction sent by a given owner on a particular connection",
+		Long:    "Query the controller submodule for the result of the transaction sent by a given owner on a particular connection and channel with the provided packet sequence. The channel is omitted for transactions sent over IBC v2, whose source client is passed as the connection.",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query interchain-accounts controller tx-result cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0 1 --%s channel-0", version.AppName, flagChannelID),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			channelID, err := cmd.Flags().GetString(flagChannelID)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryTxResultRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+				ChannelId:    channelID,
+				Sequence:     seq,
+			}
+
+			res, err := queryClient.TxResult(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagChannelID, "", "Channel identifier the transaction was sent on")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTxResults returns the command handler for querying the stored results of the transactions sent to an interchain account.
+func GetCmdQueryTxResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tx-results [owner] [connection-id]",
+		Short:   "Query the results of the transactions sent by a given owner on a particular connection",
+		Long:    "Query the controller submodule for the stored results of the transactions sent by a given owner on a particular connection, optionally restricted to a single channel",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller tx-results cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			channelID, err := cmd.Flags().GetString(flagChannelID)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryTxResultsRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+				ChannelId:    channelID,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.TxResults(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagChannelID, "", "Only return the results of the transactions sent on the given channel")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tx results")
+
+	return cmd
+}
+
+// GetCmdQueryOwnership returns the command handler for querying the owners of an interchain account.
+func GetCmdQueryOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ownership [port-id] [connection-id]",
+		Short:   "Query the owners of the interchain account of a controller port on a particular connection",
+		Long:    "Query the controller submodule for the owners authorized to control the interchain account of a controller port on a particular connection, and their threshold. The ownership of an interchain account controlled over IBC v2 is queried by passing its source client as the connection.",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller ownership icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryOwnershipRequest{
+				PortId:       args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.Ownership(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
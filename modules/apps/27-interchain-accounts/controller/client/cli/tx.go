@@ -27,6 +27,14 @@ const (
 	// The channel ordering
 	flagOrdering               = "ordering"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	// The controller port of an interchain account whose ownership has been transferred
+	flagPortID = "port-id"
+	// The additional owners signing a message
+	flagCoSigners = "co-signers"
+	// The threshold of owners required to control an interchain account
+	flagThreshold = "threshold"
+	// The controller channel the results of transactions are queried for
+	flagChannelID = "channel-id"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -43,7 +51,9 @@ func newRegisterInterchainAccountCmd() *cobra.Command {
 connection id from the source chain. Connection identifier should be for the source chain 
 and the interchain account will be created on the counterparty chain. Callers are expected to 
 provide the appropriate application version string via {version} flag and the desired ordering
-via the {ordering} flag. Generates a new port identifier using the provided owner string.`),
+via the {ordering} flag. Generates a new port identifier using the provided owner string, unless the
+controller port of an interchain account whose ownership has been transferred is provided via the {port-id} flag.
+The sender and the co-signers provided via the {co-signers} flag must reach the threshold of its ownership.`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -65,12 +75,24 @@ via the {ordering} flag. Generates a new port identifier using the provided owne
 
 			msg := types.NewMsgRegisterInterchainAccount(connectionID, owner, version, order)
 
+			msg.PortId, err = cmd.Flags().GetString(flagPortID)
+			if err != nil {
+				return err
+			}
+
+			msg.CoSigners, err = cmd.Flags().GetStringSlice(flagCoSigners)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().String(flagVersion, "", "Controller chain channel version")
 	cmd.Flags().String(flagOrdering, channeltypes.UNORDERED.String(), fmt.Sprintf("Channel ordering, can be one of: %s", strings.Join(connectiontypes.SupportedOrderings, ", ")))
+	cmd.Flags().String(flagPortID, "", "Controller port of the interchain account, defaults to the controller port of the sender")
+	cmd.Flags().StringSlice(flagCoSigners, nil, "Comma separated list of additional owners signing the registration")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -116,11 +138,69 @@ An absolute timeout is calculated on chain using the current block time. If no t
 
 			msg := types.NewMsgSendTx(owner, connectionID, timeoutTimestamp, icaMsgData)
 
+			msg.PortId, err = cmd.Flags().GetString(flagPortID)
+			if err != nil {
+				return err
+			}
+
+			msg.CoSigners, err = cmd.Flags().GetStringSlice(flagCoSigners)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes.")
+	cmd.Flags().String(flagPortID, "", "Controller port of the interchain account, defaults to the controller port of the sender")
+	cmd.Flags().StringSlice(flagCoSigners, nil, "Comma separated list of additional owners signing the transaction")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func newTransferOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-ownership [port-id] [connection-id] [new-owners]",
+		Short: "Transfer the ownership of an interchain account to a new set of owners.",
+		Long: strings.TrimSpace(`Transfers the ownership of the interchain account of the provided controller port on the provided connection 
+to the comma separated list of new owners, a threshold of which is required to control the interchain account. The threshold can be provided 
+using the flag {threshold} and defaults to 1. The sender and the co-signers provided using the flag {co-signers} must reach the threshold of the 
+current owners. The interchain account remains identified by its controller port and keeps its address on the host chain. 
+The ownership of an interchain account controlled over IBC v2 is transferred by passing its source client as the connection.`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress().String()
+			portID, connectionID := args[0], args[1]
+			newOwners := strings.Split(args[2], ",")
+
+			coSigners, err := cmd.Flags().GetStringSlice(flagCoSigners)
+			if err != nil {
+				return err
+			}
+
+			threshold, err := cmd.Flags().GetUint32(flagThreshold)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferOwnership(owner, coSigners, portID, connectionID, newOwners, threshold)
+			if types.IsClientIdentifier(connectionID) {
+				msg = types.NewMsgTransferClientOwnership(owner, coSigners, portID, connectionID, newOwners, threshold)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagCoSigners, nil, "Comma separated list of additional current owners signing the transfer")
+	cmd.Flags().Uint32(flagThreshold, 1, "Threshold of new owners required to control the interchain account")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"strconv"
 	"testing"
+	"time"
 
 	testifysuite "github.com/stretchr/testify/suite"
 
@@ -976,6 +977,158 @@ func (s *InterchainAccountsTestSuite) TestClosedChannelReopensWithMsgServer() {
 	}
 }
 
+func (s *InterchainAccountsTestSuite) TestClosedOrderedChannelReopensAutomatically() {
+	s.SetupTest() // reset
+
+	owner := s.chainA.SenderAccount.GetAddress().String()
+
+	path := NewICAPath(s.chainA, s.chainB, channeltypes.ORDERED)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, owner)
+	s.Require().NoError(err)
+
+	s.chainA.GetSimApp().ICAControllerKeeper.SetParams(s.chainA.GetContext(), types.Params{ControllerEnabled: true, MaxTxResults: types.DefaultMaxTxResults, ReopenClosedChannels: true, MaxQueuedTxs: types.DefaultMaxQueuedTxs})
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	// send a transaction which times out, closing the ORDERED channel
+	res, err := s.chainA.SendMsgs(types.NewMsgSendTx(owner, path.EndpointA.ConnectionID, 1, packetData))
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParseV1PacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	err = path.EndpointA.UpdateClient()
+	s.Require().NoError(err)
+
+	res, err = path.EndpointA.TimeoutPacketWithResult(packet)
+	s.Require().NoError(err)
+	s.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+
+	reopening, found := s.chainA.GetSimApp().ICAControllerKeeper.GetChannelReopening(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+	s.Require().True(found)
+	s.Require().NotEqual(path.EndpointA.ChannelID, reopening.ChannelId)
+	s.Require().Empty(reopening.QueuedTxs)
+
+	expectedEvents := sdk.Events{
+		sdk.NewEvent(
+			icatypes.EventTypeChannelReopenInit,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, path.EndpointA.ChannelConfig.PortID),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, path.EndpointA.ConnectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyClosedChannelID, path.EndpointA.ChannelID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, reopening.ChannelId),
+			sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, strconv.FormatBool(true)),
+		),
+	}.ToABCIEvents()
+
+	expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+	ibctesting.AssertEvents(&s.Suite, expectedEvents, res.GetEvents())
+
+	// the next transaction is queued until the channel is open again
+	res, err = s.chainA.SendMsgs(types.NewMsgSendTx(owner, path.EndpointA.ConnectionID, uint64(time.Minute), packetData))
+	s.Require().NoError(err)
+
+	expectedEvents = sdk.Events{
+		sdk.NewEvent(
+			icatypes.EventTypeTxQueued,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, path.EndpointA.ChannelConfig.PortID),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, path.EndpointA.ConnectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, reopening.ChannelId),
+			sdk.NewAttribute(icatypes.AttributeKeyQueuedTxs, "1"),
+		),
+	}.ToABCIEvents()
+
+	expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+	ibctesting.AssertEvents(&s.Suite, expectedEvents, res.GetEvents())
+
+	_, err = ibctesting.ParseV1PacketFromEvents(res.GetEvents())
+	s.Require().Error(err)
+
+	reopening, found = s.chainA.GetSimApp().ICAControllerKeeper.GetChannelReopening(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+	s.Require().True(found)
+	s.Require().Len(reopening.QueuedTxs, 1)
+
+	// a queued transaction which expires before the channel is open is dropped
+	reopening.QueuedTxs = append(reopening.QueuedTxs, types.QueuedTx{PacketData: packetData, TimeoutTimestamp: 1})
+	s.chainA.GetSimApp().ICAControllerKeeper.SetChannelReopening(s.chainA.GetContext(), reopening)
+
+	// the host closes its channel end before the channel handshake is completed
+	path.EndpointB.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+	path.EndpointA.ChannelID = reopening.ChannelId
+	path.EndpointB.ChannelID = ""
+
+	err = path.EndpointB.ChanOpenTry()
+	s.Require().NoError(err)
+
+	err = path.EndpointA.ChanOpenAck()
+	s.Require().NoError(err)
+
+	err = path.EndpointB.ChanOpenConfirm()
+	s.Require().NoError(err)
+
+	// the queued transaction is sent on the reopened channel
+	_, found = s.chainA.GetSimApp().ICAControllerKeeper.GetChannelReopening(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+	s.Require().False(found)
+
+	activeChannelID, found := s.chainA.GetSimApp().ICAControllerKeeper.GetOpenActiveChannel(s.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	s.Require().True(found)
+	s.Require().Equal(reopening.ChannelId, activeChannelID)
+
+	commitment := s.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, activeChannelID, 1)
+	s.Require().NotEmpty(commitment)
+
+	commitment = s.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, activeChannelID, 2)
+	s.Require().Empty(commitment)
+
+	// the result of the transaction which timed out on the closed channel is kept
+	result, found := s.chainA.GetSimApp().ICAControllerKeeper.GetTxResult(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, packet.SourceChannel, packet.Sequence)
+	s.Require().True(found)
+	s.Require().Equal(types.TX_RESULT_TIMEOUT, result.Status)
+}
+
+func (s *InterchainAccountsTestSuite) TestClosedChannelIsNotReopenedWhenDisabled() {
+	s.SetupTest() // reset
+
+	owner := s.chainA.SenderAccount.GetAddress().String()
+
+	path := NewICAPath(s.chainA, s.chainB, channeltypes.ORDERED)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, owner)
+	s.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	res, err := s.chainA.SendMsgs(types.NewMsgSendTx(owner, path.EndpointA.ConnectionID, 1, packetData))
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParseV1PacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	err = path.EndpointA.UpdateClient()
+	s.Require().NoError(err)
+
+	err = path.EndpointA.TimeoutPacket(packet)
+	s.Require().NoError(err)
+
+	_, found := s.chainA.GetSimApp().ICAControllerKeeper.GetChannelReopening(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+	s.Require().False(found)
+
+	// the transaction is rejected rather than queued
+	_, err = s.chainA.SendMsgs(types.NewMsgSendTx(owner, path.EndpointA.ConnectionID, uint64(time.Minute), packetData))
+	s.Require().ErrorContains(err, icatypes.ErrActiveChannelNotFound.Error())
+}
+
 func (s *InterchainAccountsTestSuite) TestPacketDataUnmarshalerInterface() {
 	for _, ordering := range []channeltypes.Order{channeltypes.UNORDERED, channeltypes.ORDERED} {
 		s.SetupTest() // reset
@@ -3,10 +3,13 @@
 package keeper
 
 import (
+	"encoding/json"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v11/modules/core/exported"
@@ -34,3 +37,158 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet channeltypes.Packet, ack e
 		),
 	)
 }
+
+// msgResponse is the JSON representation of a message response in the tx result event. The responses are not resolved
+// against the interface registry, as the message types of the host chain may be unknown to the controller chain.
+type msgResponse struct {
+	TypeURL string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+// EmitTxResultEvent emits an event signalling the result of a transaction sent to an interchain account and including
+// the decoded responses of its messages and the error details if any.
+func EmitTxResultEvent(ctx sdk.Context, result types.TxResult) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyOwner, result.Owner),
+		sdk.NewAttribute(icatypes.AttributeKeyConnectionID, result.ConnectionId),
+		sdk.NewAttribute(icatypes.AttributeKeySequence, strconv.FormatUint(result.Sequence, 10)),
+		sdk.NewAttribute(icatypes.AttributeKeyTxResultStatus, result.Status.String()),
+	}
+
+	// transactions sent over IBC v2 are identified by the source client of their packet
+	if result.ClientId != "" {
+		attributes[2] = sdk.NewAttribute(icatypes.AttributeKeyClientID, result.ClientId)
+	}
+
+	if result.ChannelId != "" {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, result.ChannelId))
+	}
+
+	if len(result.MsgResponses) > 0 {
+		responses := make([]msgResponse, len(result.MsgResponses))
+		for i, response := range result.MsgResponses {
+			responses[i] = msgResponse{TypeURL: response.TypeUrl, Value: response.Value}
+		}
+
+		bz, err := json.Marshal(responses)
+		if err != nil {
+			panic(err)
+		}
+
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyMsgResponses, string(bz)))
+	}
+
+	if result.Error != "" {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyAckError, result.Error))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeTxResult,
+			attributes...,
+		),
+	)
+}
+
+// EmitOwnershipTransferredEvent emits an event signalling the transfer of the ownership of an interchain account
+func EmitOwnershipTransferredEvent(ctx sdk.Context, ownership types.InterchainAccountOwnership) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, ownership.PortId),
+		sdk.NewAttribute(icatypes.AttributeKeyConnectionID, ownership.ConnectionId),
+		sdk.NewAttribute(icatypes.AttributeKeyOwners, strings.Join(ownership.Owners, ",")),
+		sdk.NewAttribute(icatypes.AttributeKeyThreshold, strconv.FormatUint(uint64(ownership.Threshold), 10)),
+	}
+
+	// interchain accounts controlled over IBC v2 are identified by their source client
+	if ownership.ClientId != "" {
+		attributes[2] = sdk.NewAttribute(icatypes.AttributeKeyClientID, ownership.ClientId)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeOwnershipTransferred,
+			attributes...,
+		),
+	)
+}
+
+// EmitChannelReopenInitEvent emits an event signalling the initiation of the reopening of the closed channel of an
+// interchain account and including the error details if the reopening failed.
+func EmitChannelReopenInitEvent(ctx sdk.Context, portID, connectionID, closedChannelID, channelID string, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, portID),
+		sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+		sdk.NewAttribute(icatypes.AttributeKeyClosedChannelID, closedChannelID),
+		sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, channelID),
+		sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, strconv.FormatBool(err == nil)),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyAckError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeChannelReopenInit,
+			attributes...,
+		),
+	)
+}
+
+// EmitTxQueuedEvent emits an event signalling that a transaction has been queued until the reopening channel of an
+// interchain account is open
+func EmitTxQueuedEvent(ctx sdk.Context, reopening types.ChannelReopening) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeTxQueued,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, reopening.PortId),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, reopening.ConnectionId),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, reopening.ChannelId),
+			sdk.NewAttribute(icatypes.AttributeKeyQueuedTxs, strconv.Itoa(len(reopening.QueuedTxs))),
+		),
+	)
+}
+
+// EmitChannelReopenedEvent emits an event signalling that the reopened channel of an interchain account is open and
+// including the number of queued transactions to be sent
+func EmitChannelReopenedEvent(ctx sdk.Context, reopening types.ChannelReopening, channelID string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeChannelReopened,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, reopening.PortId),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, reopening.ConnectionId),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, channelID),
+			sdk.NewAttribute(icatypes.AttributeKeyQueuedTxs, strconv.Itoa(len(reopening.QueuedTxs))),
+		),
+	)
+}
+
+// EmitQueuedTxSentEvent emits an event signalling that a queued transaction has been sent on the reopened channel of an
+// interchain account, or including the error details if it could not be sent.
+func EmitQueuedTxSentEvent(ctx sdk.Context, portID, connectionID, channelID string, sequence uint64, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, portID),
+		sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+		sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, channelID),
+		sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, strconv.FormatBool(err == nil)),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyAckError, err.Error()))
+	} else {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeySequence, strconv.FormatUint(sequence, 10)))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeQueuedTxSent,
+			attributes...,
+		),
+	)
+}
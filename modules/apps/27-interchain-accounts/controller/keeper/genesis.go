@@ -3,8 +3,11 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
 	genesistypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/genesis/types"
 )
 
@@ -29,14 +32,24 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.ControllerGe
 	}
 
 	keeper.SetParams(ctx, state.Params)
+
+	if err := types.ValidateOwnerships(state.Ownerships); err != nil {
+		panic(fmt.Errorf("could not set ica controller ownerships at genesis: %w", err))
+	}
+	for _, ownership := range state.Ownerships {
+		keeper.SetOwnership(ctx, ownership)
+	}
 }
 
 // ExportGenesis returns the interchain accounts controller exported genesis
 func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.ControllerGenesisState {
-	return genesistypes.NewControllerGenesisState(
+	genesisState := genesistypes.NewControllerGenesisState(
 		keeper.GetAllActiveChannels(ctx),
 		keeper.GetAllInterchainAccounts(ctx),
 		keeper.GetAllPorts(ctx),
 		keeper.GetParams(ctx),
 	)
+	genesisState.Ownerships = keeper.GetAllOwnerships(ctx)
+
+	return genesisState
 }
@@ -47,6 +47,9 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Ports: ports,
+		Ownerships: []types.InterchainAccountOwnership{
+			types.NewInterchainAccountOwnership(TestPortID, ibctesting.FirstConnectionID, []string{TestOwnerAddress, s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()}, 1),
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
@@ -70,7 +73,11 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 			s.Require().True(found)
 			s.Require().Equal(interchainAccAddr.String(), accountAdrr)
 
-			expParams := types.NewParams(false)
+			ownership, found := s.chainA.GetSimApp().ICAControllerKeeper.GetOwnership(s.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID)
+			s.Require().True(found)
+			s.Require().Equal(genesisState.Ownerships[0], ownership)
+
+			expParams := types.Params{}
 			params := s.chainA.GetSimApp().ICAControllerKeeper.GetParams(s.chainA.GetContext())
 			s.Require().Equal(expParams, params)
 
@@ -95,6 +102,9 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		interchainAccAddr, exists := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 		s.Require().True(exists)
 
+		ownership := types.NewInterchainAccountOwnership(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, []string{s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()}, 1)
+		s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+
 		genesisState := keeper.ExportGenesis(s.chainA.GetContext(), *s.chainA.GetSimApp().ICAControllerKeeper)
 
 		s.Require().Equal(path.EndpointA.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -105,6 +115,7 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		s.Require().Equal(path.EndpointA.ChannelConfig.PortID, genesisState.InterchainAccounts[0].PortId)
 
 		s.Require().Equal([]string{TestPortID}, genesisState.GetPorts())
+		s.Require().Equal([]types.InterchainAccountOwnership{ownership}, genesisState.Ownerships)
 
 		expParams := types.DefaultParams()
 		s.Require().Equal(expParams, genesisState.GetParams())
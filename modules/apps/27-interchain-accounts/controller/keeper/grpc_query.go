@@ -8,7 +8,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
@@ -48,3 +51,107 @@ func (k *Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*ty
 		Params: &params,
 	}, nil
 }
+
+// TxResult implements the Query/TxResult gRPC method
+func (k *Keeper) TxResult(goCtx context.Context, req *types.QueryTxResultRequest) (*types.QueryTxResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+	}
+
+	if _, err := types.TxResultChannelSequence(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, found := k.GetTxResult(ctx, portID, req.ConnectionId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve tx result for %s on connection %s and channel %s with sequence %d", portID, req.ConnectionId, req.ChannelId, req.Sequence)
+	}
+
+	return &types.QueryTxResultResponse{
+		TxResult: result,
+	}, nil
+}
+
+// TxResults implements the Query/TxResults gRPC method. The results of the transactions sent on all channels of the
+// connection are returned in the order in which the channels were created, unless a channel is provided.
+func (k *Keeper) TxResults(goCtx context.Context, req *types.QueryTxResultsRequest) (*types.QueryTxResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+	}
+
+	keyPrefix := types.KeyTxResultPrefix(portID, req.ConnectionId)
+	if req.ChannelId != "" {
+		channelSequence, err := types.TxResultChannelSequence(req.ChannelId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		keyPrefix = types.KeyTxResultChannelPrefix(portID, req.ConnectionId, channelSequence)
+	}
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+
+	var results []types.TxResult
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var result types.TxResult
+		if err := k.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTxResultsResponse{
+		TxResults:  results,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Ownership implements the Query/Ownership gRPC method. The default ownership of the owner of the controller port is
+// returned for interchain accounts whose ownership has never been transferred. The ownership of an interchain account
+// controlled over IBC v2 is queried by passing its source client as the connection.
+func (k *Keeper) Ownership(goCtx context.Context, req *types.QueryOwnershipRequest) (*types.QueryOwnershipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateControllerPortID(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// interchain accounts controlled over IBC v2 are not registered on the controller chain and are queried by the
+	// source client of their packets
+	if types.IsClientIdentifier(req.ConnectionId) {
+		return &types.QueryOwnershipResponse{
+			Ownership: k.GetEffectiveClientOwnership(ctx, req.PortId, req.ConnectionId),
+		}, nil
+	}
+
+	if _, found := k.GetInterchainAccountAddress(ctx, req.ConnectionId, req.PortId); !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve account address for %s on connection %s", req.PortId, req.ConnectionId)
+	}
+
+	return &types.QueryOwnershipResponse{
+		Ownership: k.GetEffectiveOwnership(ctx, req.PortId, req.ConnectionId),
+	}, nil
+}
@@ -3,6 +3,8 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
 	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v11/testing"
@@ -84,3 +86,298 @@ func (s *KeeperTestSuite) TestQueryParams() {
 	res, _ := s.chainA.GetSimApp().ICAControllerKeeper.Params(ctx, &types.QueryParamsRequest{})
 	s.Require().Equal(&expParams, res.Params)
 }
+
+func (s *KeeperTestSuite) TestQueryOwnership() {
+	var (
+		req          *types.QueryOwnershipRequest
+		expOwnership types.InterchainAccountOwnership
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success: default ownership of the port owner",
+			func() {},
+			"",
+		},
+		{
+			"success: transferred ownership",
+			func() {
+				expOwnership = types.NewInterchainAccountOwnership(req.PortId, req.ConnectionId, []string{TestOwnerAddress, s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()}, 2)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), expOwnership)
+			},
+			"",
+		},
+		{
+			"success: default ownership of an interchain account controlled over IBC v2",
+			func() {
+				req.ConnectionId = ibctesting.FirstClientID
+				expOwnership = types.DefaultInterchainAccountClientOwnership(req.PortId, ibctesting.FirstClientID)
+			},
+			"",
+		},
+		{
+			"success: transferred ownership of an interchain account controlled over IBC v2",
+			func() {
+				req.ConnectionId = ibctesting.FirstClientID
+				expOwnership = types.NewInterchainAccountClientOwnership(req.PortId, ibctesting.FirstClientID, []string{s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()}, 1)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), expOwnership)
+			},
+			"",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"empty request",
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ibctesting.MockPort
+			},
+			"expected icacontroller-{owner-account-address}",
+		},
+		{
+			"invalid connection, account address not found",
+			func() {
+				req.ConnectionId = ibctesting.InvalidID
+			},
+			"failed to retrieve account address",
+		},
+	}
+
+	for _, ordering := range []channeltypes.Order{channeltypes.UNORDERED, channeltypes.ORDERED} {
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				s.SetupTest()
+
+				path := NewICAPath(s.chainA, s.chainB, ordering)
+				path.SetupConnections()
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				s.Require().NoError(err)
+
+				req = &types.QueryOwnershipRequest{
+					PortId:       path.EndpointA.ChannelConfig.PortID,
+					ConnectionId: path.EndpointA.ConnectionID,
+				}
+				expOwnership = types.NewInterchainAccountOwnership(req.PortId, req.ConnectionId, []string{TestOwnerAddress}, 1)
+
+				tc.malleate()
+
+				res, err := s.chainA.GetSimApp().ICAControllerKeeper.Ownership(s.chainA.GetContext(), req)
+
+				if tc.errMsg == "" {
+					s.Require().NoError(err)
+					s.Require().Equal(expOwnership, res.Ownership)
+				} else {
+					s.Require().ErrorContains(err, tc.errMsg)
+				}
+			})
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryTxResult() {
+	var req *types.QueryTxResultRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"empty request",
+		},
+		{
+			"empty owner address",
+			func() {
+				req.Owner = ""
+			},
+			"failed to generate portID from owner address: owner address cannot be empty: invalid account address",
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			"channel identifier is not in the format",
+		},
+		{
+			"tx result not found",
+			func() {
+				req.Sequence = 2
+			},
+			"failed to retrieve tx result",
+		},
+		{
+			"tx result not found on another channel",
+			func() {
+				req.ChannelId = channeltypes.FormatChannelIdentifier(1)
+			},
+			"failed to retrieve tx result",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path := NewICAPath(s.chainA, s.chainB, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, ibctesting.TestAccAddress)
+			s.Require().NoError(err)
+
+			expResult := types.TxResult{
+				Owner:        ibctesting.TestAccAddress,
+				ConnectionId: ibctesting.FirstConnectionID,
+				ChannelId:    path.EndpointA.ChannelID,
+				Sequence:     1,
+				Status:       types.TX_RESULT_FAILURE,
+				Error:        "error",
+			}
+			s.chainA.GetSimApp().ICAControllerKeeper.SetTxResult(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, expResult)
+
+			req = &types.QueryTxResultRequest{
+				Owner:        ibctesting.TestAccAddress,
+				ConnectionId: ibctesting.FirstConnectionID,
+				ChannelId:    path.EndpointA.ChannelID,
+				Sequence:     1,
+			}
+
+			tc.malleate()
+
+			res, err := s.chainA.GetSimApp().ICAControllerKeeper.TxResult(s.chainA.GetContext(), req)
+
+			if tc.errMsg == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(expResult, res.TxResult)
+			} else {
+				s.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryTxResults() {
+	var (
+		req        *types.QueryTxResultsRequest
+		expResults []types.TxResult
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1}
+				expResults = expResults[:1]
+			},
+			"",
+		},
+		{
+			"success: with channel",
+			func() {
+				req.ChannelId = channeltypes.FormatChannelIdentifier(10)
+				expResults = expResults[3:]
+			},
+			"",
+		},
+		{
+			"success: no tx results on connection",
+			func() {
+				req.ConnectionId = ibctesting.InvalidID
+				expResults = nil
+			},
+			"",
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			"channel identifier is not in the format",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"empty request",
+		},
+		{
+			"empty owner address",
+			func() {
+				req.Owner = ""
+			},
+			"failed to generate portID from owner address: owner address cannot be empty: invalid account address",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path := NewICAPath(s.chainA, s.chainB, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, ibctesting.TestAccAddress)
+			s.Require().NoError(err)
+
+			// the results of a newer channel are returned after the results of the previous channel
+			expResults = nil
+			for _, channelID := range []string{path.EndpointA.ChannelID, channeltypes.FormatChannelIdentifier(10)} {
+				for seq := uint64(1); seq <= 3; seq++ {
+					result := types.TxResult{
+						Owner:        ibctesting.TestAccAddress,
+						ConnectionId: ibctesting.FirstConnectionID,
+						ChannelId:    channelID,
+						Sequence:     seq,
+						Status:       types.TX_RESULT_TIMEOUT,
+					}
+					s.chainA.GetSimApp().ICAControllerKeeper.SetTxResult(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, result)
+					expResults = append(expResults, result)
+				}
+			}
+
+			req = &types.QueryTxResultsRequest{
+				Owner:        ibctesting.TestAccAddress,
+				ConnectionId: ibctesting.FirstConnectionID,
+			}
+
+			tc.malleate()
+
+			res, err := s.chainA.GetSimApp().ICAControllerKeeper.TxResults(s.chainA.GetContext(), req)
+
+			if tc.errMsg == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(expResults, res.TxResults)
+			} else {
+				s.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
@@ -87,7 +87,8 @@ func (k *Keeper) OnChanOpenInit(
 }
 
 // OnChanOpenAck sets the active channel for the interchain account/owner pair
-// and stores the associated interchain account address in state keyed by it's corresponding port identifier
+// and stores the associated interchain account address in state keyed by it's corresponding port identifier.
+// The results of transactions sent by the owner on a previous channel of the connection are deleted.
 func (k *Keeper) OnChanOpenAck(
 	ctx sdk.Context,
 	portID,
@@ -126,6 +127,9 @@ func (k *Keeper) OnChanOpenAck(
 	k.SetActiveChannelID(ctx, metadata.ControllerConnectionId, portID, channelID)
 	k.SetInterchainAccountAddress(ctx, metadata.ControllerConnectionId, portID, metadata.Address)
 
+	// the transactions queued while the closed channel was reopening are sent on the open channel
+	k.sendQueuedTxs(ctx, metadata.ControllerConnectionId, portID, channelID)
+
 	return nil
 }
 
@@ -308,7 +308,7 @@ func (s *KeeperTestSuite) TestSetAndGetParams() {
 		input types.Params
 	}{
 		{"success: set params false", types.NewParams(false)},
-		{"success: set params true", types.NewParams(true)},
+		{"success: set params true", types.Params{ControllerEnabled: true, ReopenClosedChannels: true}},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// MigrateParams sets the controller params added after the controller enabled param to their default values. The
+// migration is a no-op if the controller submodule is not wired.
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	params := m.keeper.GetParams(ctx)
+	params.MaxTxResults = types.DefaultMaxTxResults
+	params.ReopenClosedChannels = types.DefaultReopenClosedChannels
+	params.MaxQueuedTxs = types.DefaultMaxQueuedTxs
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
+)
+
+func (s *KeeperTestSuite) TestMigrateParams() {
+	testCases := []struct {
+		name      string
+		params    types.Params
+		expParams types.Params
+	}{
+		{
+			"success: params of a previous version are set to their defaults",
+			types.Params{ControllerEnabled: false},
+			types.NewParams(false),
+		},
+		{
+			"success: default params are unchanged",
+			types.DefaultParams(),
+			types.DefaultParams(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			ctx := s.chainA.GetContext()
+			s.chainA.GetSimApp().ICAControllerKeeper.SetParams(ctx, tc.params)
+
+			migrator := keeper.NewMigrator(s.chainA.GetSimApp().ICAControllerKeeper)
+			err := migrator.MigrateParams(ctx)
+			s.Require().NoError(err)
+
+			params := s.chainA.GetSimApp().ICAControllerKeeper.GetParams(ctx)
+			s.Require().Equal(tc.expParams, params)
+		})
+	}
+
+	s.Run("success: no-op without a controller keeper", func() {
+		err := keeper.NewMigrator(nil).MigrateParams(s.chainA.GetContext())
+		s.Require().NoError(err)
+	})
+}
@@ -26,12 +26,22 @@ func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 	return &msgServer{Keeper: keeper}
 }
 
-// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount
+// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount. The signers must reach the threshold
+// of the ownership of the interchain account, which defaults to the owner of the controller port.
 func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(msg.Owner)
-	if err != nil {
+	portID := msg.PortId
+	if portID == "" {
+		var err error
+		portID, err = icatypes.NewControllerPortID(msg.Owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the channel of an interchain account whose ownership has been transferred is reopened by its current owners
+	if err := s.authorizeOwners(ctx, portID, msg.ConnectionId, msg.Signers()); err != nil {
 		return nil, err
 	}
 
@@ -61,18 +71,41 @@ func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.M
 	}, nil
 }
 
-// SendTx defines a rpc handler for MsgSendTx
+// SendTx defines a rpc handler for MsgSendTx. The signers must reach the threshold of the ownership of the interchain
+// account, which defaults to the owner of the controller port. If the channel of the interchain account is closed and
+// reopening, or the ReopenClosedChannels param is enabled, the transaction is queued until the channel is reopened.
 func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(msg.Owner)
-	if err != nil {
+	portID := msg.PortId
+	if portID == "" {
+		var err error
+		portID, err = icatypes.NewControllerPortID(msg.Owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.authorizeOwners(ctx, portID, msg.ConnectionId, msg.Signers()); err != nil {
 		return nil, err
 	}
 
 	// the absolute timeout value is calculated using the controller chain block time + the relative timeout value
 	// this assumes time synchrony to a certain degree between the controller and counterparty host chain
 	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
+
+	// transactions sent while the closed channel of the interchain account is reopening are queued until it is open
+	if s.IsActiveChannelClosed(ctx, msg.ConnectionId, portID) {
+		queued, err := s.queueTx(ctx, msg.ConnectionId, portID, types.QueuedTx{PacketData: msg.PacketData, TimeoutTimestamp: absoluteTimeout})
+		if err != nil {
+			return nil, err
+		}
+
+		if queued {
+			return &types.MsgSendTxResponse{Queued: true}, nil
+		}
+	}
+
 	seq, err := s.sendTx(ctx, msg.ConnectionId, portID, msg.PacketData, absoluteTimeout)
 	if err != nil {
 		return nil, err
@@ -81,6 +114,43 @@ func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.M
 	return &types.MsgSendTxResponse{Sequence: seq}, nil
 }
 
+// TransferOwnership defines a rpc handler for MsgTransferOwnership. The signers must reach the threshold of the current
+// ownership of the interchain account, which is replaced by the new owners and threshold. The interchain account
+// remains identified by its controller port, such that the host chain account is unchanged.
+func (s msgServer) TransferOwnership(goCtx context.Context, msg *types.MsgTransferOwnership) (*types.MsgTransferOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// interchain accounts controlled over IBC v2 are not registered on the controller chain, the signer of each packet
+	// being authorized by the ownership of the source client of the packet
+	if msg.ClientId != "" {
+		if err := s.GetEffectiveClientOwnership(ctx, msg.PortId, msg.ClientId).Authorize(msg.Signers()); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, found := s.GetInterchainAccountAddress(ctx, msg.ConnectionId, msg.PortId); !found {
+			return nil, errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account for port %s on connection %s", msg.PortId, msg.ConnectionId)
+		}
+
+		// interchain accounts controlled by an underlying application are authenticated by the application
+		if s.IsMiddlewareEnabled(ctx, msg.PortId, msg.ConnectionId) {
+			return nil, errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot transfer the ownership of an interchain account controlled by an underlying application")
+		}
+
+		if err := s.authorizeOwners(ctx, msg.PortId, msg.ConnectionId, msg.Signers()); err != nil {
+			return nil, err
+		}
+	}
+
+	ownership := msg.NewOwnership()
+	s.SetOwnership(ctx, ownership)
+
+	EmitOwnershipTransferredEvent(ctx, ownership)
+
+	s.Logger(ctx).Info("successfully transferred interchain account ownership", "port-id", msg.PortId, "connection-id", msg.ConnectionId, "client-id", msg.ClientId)
+
+	return &types.MsgTransferOwnershipResponse{}, nil
+}
+
 // UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ica/controller submodule's parameters.
 func (k *Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -3,6 +3,7 @@
 package keeper_test
 
 import (
+	"math"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -18,6 +19,7 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	connectiontypes "github.com/cosmos/ibc-go/v11/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v11/testing"
 )
 
@@ -67,6 +69,69 @@ func (s *KeeperTestSuite) TestRegisterInterchainAccount_MsgServer() {
 			},
 			icatypes.ErrInvalidAccountAddress,
 		},
+		{
+			"ownership of the interchain account has been transferred",
+			func() {
+				portID, err := icatypes.NewControllerPortID(msg.Owner)
+				s.Require().NoError(err)
+
+				newOwner := s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+				ownership := types.NewInterchainAccountOwnership(portID, msg.ConnectionId, []string{newOwner}, 1)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"success: the new owner registers the interchain account of the controller port of the original owner",
+			func() {
+				portID, err := icatypes.NewControllerPortID(msg.Owner)
+				s.Require().NoError(err)
+
+				newOwner := s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+				ownership := types.NewInterchainAccountOwnership(portID, msg.ConnectionId, []string{newOwner}, 1)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+
+				msg.Owner = newOwner
+				msg.PortId = portID
+			},
+			nil,
+		},
+		{
+			"success: the co-signers reach the threshold of the ownership",
+			func() {
+				portID, err := icatypes.NewControllerPortID(msg.Owner)
+				s.Require().NoError(err)
+
+				coSigner := s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+				ownership := types.NewInterchainAccountOwnership(portID, msg.ConnectionId, []string{msg.Owner, coSigner}, 2)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+
+				msg.CoSigners = []string{coSigner}
+			},
+			nil,
+		},
+		{
+			"the signers do not reach the threshold of the ownership",
+			func() {
+				portID, err := icatypes.NewControllerPortID(msg.Owner)
+				s.Require().NoError(err)
+
+				coSigner := s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+				ownership := types.NewInterchainAccountOwnership(portID, msg.ConnectionId, []string{msg.Owner, coSigner}, 2)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"the owner does not control the interchain account of the provided controller port",
+			func() {
+				portID, err := icatypes.NewControllerPortID(s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String())
+				s.Require().NoError(err)
+
+				msg.PortId = portID
+			},
+			ibcerrors.ErrUnauthorized,
+		},
 	}
 
 	for _, ordering := range []channeltypes.Order{channeltypes.UNORDERED, channeltypes.ORDERED} {
@@ -97,6 +162,10 @@ func (s *KeeperTestSuite) TestRegisterInterchainAccount_MsgServer() {
 					s.Require().Equal(events[0].Type, channeltypes.EventTypeChannelOpenInit)
 					s.Require().Equal(sdk.EventTypeMessage, events[1].Type)
 
+					if msg.PortId != "" {
+						s.Require().Equal(msg.PortId, res.PortId)
+					}
+
 					path.EndpointA.ChannelConfig.PortID = res.PortId
 					path.EndpointA.ChannelID = res.ChannelId
 					channel := path.EndpointA.GetChannel()
@@ -112,8 +181,9 @@ func (s *KeeperTestSuite) TestRegisterInterchainAccount_MsgServer() {
 
 func (s *KeeperTestSuite) TestSubmitTx() {
 	var (
-		path *ibctesting.Path
-		msg  *types.MsgSendTx
+		path     *ibctesting.Path
+		msg      *types.MsgSendTx
+		newOwner string
 	)
 
 	testCases := []struct {
@@ -145,6 +215,68 @@ func (s *KeeperTestSuite) TestSubmitTx() {
 			},
 			icatypes.ErrActiveChannelNotFound,
 		},
+		{
+			"success - sent by the new owner after an ownership transfer", func() {
+				ownership := types.NewInterchainAccountOwnership(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, []string{newOwner}, 1)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+
+				msg.Owner = newOwner
+				msg.PortId = path.EndpointA.ChannelConfig.PortID
+			},
+			nil,
+		},
+		{
+			"success - threshold of owners reached by co-signers", func() {
+				ownership := types.NewInterchainAccountOwnership(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, []string{TestOwnerAddress, newOwner}, 2)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+
+				msg.CoSigners = []string{newOwner}
+			},
+			nil,
+		},
+		{
+			"failure - threshold of owners not reached", func() {
+				ownership := types.NewInterchainAccountOwnership(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, []string{TestOwnerAddress, newOwner}, 2)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure - sent by the original owner after an ownership transfer", func() {
+				ownership := types.NewInterchainAccountOwnership(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, []string{newOwner}, 1)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"success - queued while the closed channel is reopening", func() {
+				s.chainA.GetSimApp().ICAControllerKeeper.SetParams(s.chainA.GetContext(), types.Params{ControllerEnabled: true, MaxTxResults: types.DefaultMaxTxResults, ReopenClosedChannels: true, MaxQueuedTxs: types.DefaultMaxQueuedTxs})
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			nil,
+		},
+		{
+			"failure - active channel is closed and reopening is disabled", func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"failure - active channel is closed and the controller submodule is disabled", func() {
+				s.chainA.GetSimApp().ICAControllerKeeper.SetParams(s.chainA.GetContext(), types.Params{ControllerEnabled: false, MaxTxResults: types.DefaultMaxTxResults, ReopenClosedChannels: true, MaxQueuedTxs: types.DefaultMaxQueuedTxs})
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			types.ErrControllerSubModuleDisabled,
+		},
+		{
+			"failure - sent by the new owner without the port id", func() {
+				ownership := types.NewInterchainAccountOwnership(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, []string{newOwner}, 1)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+
+				msg.Owner = newOwner
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
 	}
 
 	for _, ordering := range []channeltypes.Order{channeltypes.UNORDERED, channeltypes.ORDERED} {
@@ -186,6 +318,7 @@ func (s *KeeperTestSuite) TestSubmitTx() {
 				connectionID := path.EndpointA.ConnectionID
 
 				msg = types.NewMsgSendTx(owner, connectionID, timeoutTimestamp, packetData)
+				newOwner = s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
 
 				tc.malleate() // malleate mutates test data
 
@@ -205,7 +338,234 @@ func (s *KeeperTestSuite) TestSubmitTx() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSubmitTxQueue() {
+	var (
+		path      *ibctesting.Path
+		reopening types.ChannelReopening
+		params    types.Params
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expQueued   int
+		expReplaced bool
+		expErr      error
+	}{
+		{
+			"success: queued while the channel is reopening",
+			func() {},
+			2,
+			false,
+			nil,
+		},
+		{
+			"success: expired transactions are dropped from the queue",
+			func() {
+				reopening.QueuedTxs = append(reopening.QueuedTxs, types.QueuedTx{TimeoutTimestamp: 1})
+			},
+			2,
+			false,
+			nil,
+		},
+		{
+			"success: a stalled reopening whose queued transactions have all expired is replaced",
+			func() {
+				reopening.QueuedTxs = []types.QueuedTx{{TimeoutTimestamp: 1}}
+			},
+			1,
+			true,
+			nil,
+		},
+		{
+			"failure: the maximum number of queued transactions is reached",
+			func() {
+				params.MaxQueuedTxs = 1
+			},
+			1,
+			false,
+			types.ErrMaxQueuedTxs,
+		},
+		{
+			"failure: queueing is disabled",
+			func() {
+				params.MaxQueuedTxs = 0
+			},
+			1,
+			false,
+			types.ErrMaxQueuedTxs,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path = NewICAPath(s.chainA, s.chainB, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			s.Require().NoError(err)
+
+			params = types.DefaultParams()
+			params.ReopenClosedChannels = true
+
+			path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+			reopening = types.ChannelReopening{
+				PortId:       path.EndpointA.ChannelConfig.PortID,
+				ConnectionId: path.EndpointA.ConnectionID,
+				ChannelId:    "channel-5",
+				QueuedTxs:    []types.QueuedTx{{TimeoutTimestamp: math.MaxUint64}},
+			}
+
+			tc.malleate()
+
+			s.chainA.GetSimApp().ICAControllerKeeper.SetParams(s.chainA.GetContext(), params)
+			s.chainA.GetSimApp().ICAControllerKeeper.SetChannelReopening(s.chainA.GetContext(), reopening)
+
+			packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+			msg := types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute), packetData)
+
+			ctx := s.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(s.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.SendTx(ctx, msg)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().True(res.Queued)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Nil(res)
+			}
+
+			queued, found := s.chainA.GetSimApp().ICAControllerKeeper.GetChannelReopening(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+			s.Require().True(found)
+			s.Require().Len(queued.QueuedTxs, tc.expQueued)
+			s.Require().Equal(tc.expReplaced, queued.ChannelId != reopening.ChannelId)
+		})
+	}
+}
+
 // TestUpdateParams tests UpdateParams rpc handler
+func (s *KeeperTestSuite) TestTransferOwnership() {
+	var (
+		path      *ibctesting.Path
+		msg       *types.MsgTransferOwnership
+		newOwners []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {},
+			nil,
+		},
+		{
+			"success - transferred again by the threshold of the new owners", func() {
+				ownership := types.NewInterchainAccountOwnership(msg.PortId, msg.ConnectionId, newOwners, 2)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+
+				msg.Owner = newOwners[0]
+				msg.CoSigners = []string{newOwners[1]}
+				msg.NewOwners = []string{TestOwnerAddress}
+			},
+			nil,
+		},
+		{
+			"failure - signed by the original owner after an ownership transfer", func() {
+				ownership := types.NewInterchainAccountOwnership(msg.PortId, msg.ConnectionId, newOwners, 1)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure - signed by an account which is not an owner", func() {
+				msg.Owner = newOwners[0]
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure - interchain account does not exist for connection ID", func() {
+				msg.ConnectionId = "connection-100"
+			},
+			icatypes.ErrInterchainAccountNotFound,
+		},
+		{
+			"failure - interchain account is controlled by an underlying application", func() {
+				s.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareEnabled(s.chainA.GetContext(), msg.PortId, msg.ConnectionId)
+			},
+			icatypes.ErrInvalidChannelFlow,
+		},
+		{
+			"success - interchain account controlled over IBC v2", func() {
+				msg = types.NewMsgTransferClientOwnership(TestOwnerAddress, nil, msg.PortId, ibctesting.FirstClientID, newOwners, 1)
+			},
+			nil,
+		},
+		{
+			"failure - signed by the original owner after an ownership transfer of an interchain account controlled over IBC v2", func() {
+				msg = types.NewMsgTransferClientOwnership(TestOwnerAddress, nil, msg.PortId, ibctesting.FirstClientID, newOwners, 1)
+
+				ownership := types.NewInterchainAccountClientOwnership(msg.PortId, msg.ClientId, newOwners, 1)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, ordering := range []channeltypes.Order{channeltypes.UNORDERED, channeltypes.ORDERED} {
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				s.SetupTest()
+
+				path = NewICAPath(s.chainA, s.chainB, ordering)
+				path.SetupConnections()
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				s.Require().NoError(err)
+
+				s.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareDisabled(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+
+				newOwners = []string{
+					s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+					s.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(),
+				}
+
+				msg = types.NewMsgTransferOwnership(TestOwnerAddress, nil, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, newOwners, 1)
+
+				tc.malleate() // malleate mutates test data
+
+				ctx := s.chainA.GetContext()
+				msgServer := keeper.NewMsgServerImpl(s.chainA.GetSimApp().ICAControllerKeeper)
+				res, err := msgServer.TransferOwnership(ctx, msg)
+
+				if tc.expErr == nil {
+					s.Require().NoError(err)
+					s.Require().NotNil(res)
+
+					expOwnership := msg.NewOwnership()
+					ownership, found := s.chainA.GetSimApp().ICAControllerKeeper.GetOwnership(ctx, msg.PortId, expOwnership.GetConnectionOrClientID())
+					s.Require().True(found)
+					s.Require().Equal(expOwnership, ownership)
+
+					// the interchain account address is unchanged
+					if msg.ClientId == "" {
+						_, found = s.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(ctx, msg.ConnectionId, msg.PortId)
+						s.Require().True(found)
+					}
+				} else {
+					s.Require().ErrorIs(err, tc.expErr)
+					s.Require().Nil(res)
+				}
+			})
+		}
+	}
+}
+
 func (s *KeeperTestSuite) TestUpdateParams() {
 	signer := s.chainA.GetSimApp().TransferKeeper.GetAuthority()
 	testCases := []struct {
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
+)
+
+// GetOwnership retrieves the registered ownership of the interchain account of the provided portID and connectionID,
+// or source clientID for interchain accounts controlled over IBC v2
+func (k *Keeper) GetOwnership(ctx sdk.Context, portID, connectionOrClientID string) (types.InterchainAccountOwnership, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyOwnership(portID, connectionOrClientID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.InterchainAccountOwnership{}, false
+	}
+
+	var ownership types.InterchainAccountOwnership
+	k.cdc.MustUnmarshal(bz, &ownership)
+	return ownership, true
+}
+
+// GetEffectiveOwnership returns the registered ownership of the interchain account of the provided portID and
+// connectionID, or the default ownership of the owner of the port if its ownership has never been transferred.
+func (k *Keeper) GetEffectiveOwnership(ctx sdk.Context, portID, connectionID string) types.InterchainAccountOwnership {
+	ownership, found := k.GetOwnership(ctx, portID, connectionID)
+	if !found {
+		return types.DefaultInterchainAccountOwnership(portID, connectionID)
+	}
+
+	return ownership
+}
+
+// GetEffectiveClientOwnership returns the registered ownership of the interchain account controlled over IBC v2 from
+// the provided portID and source clientID, or the default ownership of the owner of the port if its ownership has
+// never been transferred.
+func (k *Keeper) GetEffectiveClientOwnership(ctx sdk.Context, portID, clientID string) types.InterchainAccountOwnership {
+	ownership, found := k.GetOwnership(ctx, portID, clientID)
+	if !found {
+		return types.DefaultInterchainAccountClientOwnership(portID, clientID)
+	}
+
+	return ownership
+}
+
+// SetOwnership stores the ownership of an interchain account, keyed by its portID and connectionID or source clientID
+func (k *Keeper) SetOwnership(ctx sdk.Context, ownership types.InterchainAccountOwnership) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&ownership)
+	if err := store.Set(types.KeyOwnership(ownership.PortId, ownership.GetConnectionOrClientID()), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllOwnerships returns the registered ownerships of all interchain accounts. Used in ExportGenesis
+func (k *Keeper) GetAllOwnerships(ctx sdk.Context) []types.InterchainAccountOwnership {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.OwnershipKeyPrefix))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var ownerships []types.InterchainAccountOwnership
+	for ; iterator.Valid(); iterator.Next() {
+		var ownership types.InterchainAccountOwnership
+		k.cdc.MustUnmarshal(iterator.Value(), &ownership)
+
+		ownerships = append(ownerships, ownership)
+	}
+
+	return ownerships
+}
+
+// authorizeOwners returns an error if the signers do not reach the threshold of the effective ownership of the
+// interchain account of the provided portID and connectionID
+func (k *Keeper) authorizeOwners(ctx sdk.Context, portID, connectionID string, signers []string) error {
+	return k.GetEffectiveOwnership(ctx, portID, connectionID).Authorize(signers)
+}
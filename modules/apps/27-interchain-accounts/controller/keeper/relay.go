@@ -3,6 +3,10 @@
 package keeper
 
 import (
+	"bytes"
+
+	"github.com/cosmos/gogoproto/proto"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +15,7 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
 )
 
 // SendTx takes pre-built packet data containing messages to be executed on the host chain from an authentication module and attempts to send the packet.
@@ -55,8 +60,99 @@ func (k *Keeper) sendTx(ctx sdk.Context, connectionID, portID string,
 	return sequence, nil
 }
 
-// OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
-func (*Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+// OnAcknowledgementPacket records the result of the transaction of the acknowledged packet. The acknowledgement result of a
+// successful transaction contains the proto encoded sdk.TxMsgData of the executed messages. An acknowledgement which
+// cannot be decoded is logged and not recorded, such that the acknowledgement of the packet is not blocked.
+func (k *Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	connectionID, err := k.GetConnectionID(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		k.Logger(ctx).Error("failed to decode acknowledgement", "error", err, "port_id", packet.SourcePort, "channel_id", packet.SourceChannel, "sequence", packet.Sequence)
+		return nil
+	}
+
+	result := types.TxResult{
+		ConnectionId: connectionID,
+		ChannelId:    packet.SourceChannel,
+		Sequence:     packet.Sequence,
+	}
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		var txMsgData sdk.TxMsgData
+		if err := proto.Unmarshal(resp.Result, &txMsgData); err != nil {
+			k.Logger(ctx).Error("failed to decode acknowledgement result", "error", err, "port_id", packet.SourcePort, "channel_id", packet.SourceChannel, "sequence", packet.Sequence)
+			return nil
+		}
+
+		result.Status = types.TX_RESULT_SUCCESS
+		result.MsgResponses = txMsgData.MsgResponses
+	case *channeltypes.Acknowledgement_Error:
+		result.Status = types.TX_RESULT_FAILURE
+		result.Error = resp.Error
+	default:
+		k.Logger(ctx).Error("invalid acknowledgement response", "port_id", packet.SourcePort, "channel_id", packet.SourceChannel, "sequence", packet.Sequence)
+		return nil
+	}
+
+	k.recordTxResult(ctx, packet.SourcePort, result)
+
+	return nil
+}
+
+// OnTimeoutPacket records the timeout of the transaction of the packet. The underlying channel end of an ORDERED
+// channel is closed due to the semantics of ORDERED channels, and is reopened if the ReopenClosedChannels param is enabled.
+func (k *Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	connectionID, err := k.GetConnectionID(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		return err
+	}
+
+	k.recordTxResult(ctx, packet.SourcePort, types.TxResult{
+		ConnectionId: connectionID,
+		ChannelId:    packet.SourceChannel,
+		Sequence:     packet.Sequence,
+		Status:       types.TX_RESULT_TIMEOUT,
+	})
+
+	k.reopenChannelOnTimeout(ctx, connectionID, packet.SourcePort)
+
 	return nil
 }
+
+// OnAcknowledgementPayload records the result of the transaction of a payload acknowledged over IBC v2. The results are
+// keyed by the source client of the packet. IBC v2 error acknowledgements do not carry the error of the transaction.
+func (k *Keeper) OnAcknowledgementPayload(ctx sdk.Context, sourceClient, sourcePort string, sequence uint64, acknowledgement []byte) {
+	result := types.TxResult{
+		ClientId: sourceClient,
+		Sequence: sequence,
+	}
+
+	if bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
+		result.Status = types.TX_RESULT_FAILURE
+	} else {
+		var txMsgData sdk.TxMsgData
+		if err := proto.Unmarshal(acknowledgement, &txMsgData); err != nil {
+			k.Logger(ctx).Error("failed to decode acknowledgement", "error", err, "source_client", sourceClient, "port_id", sourcePort, "sequence", sequence)
+			return
+		}
+
+		result.Status = types.TX_RESULT_SUCCESS
+		result.MsgResponses = txMsgData.MsgResponses
+	}
+
+	k.recordTxResult(ctx, sourcePort, result)
+}
+
+// OnTimeoutPayload records the timeout of the transaction of a payload sent over IBC v2
+func (k *Keeper) OnTimeoutPayload(ctx sdk.Context, sourceClient, sourcePort string, sequence uint64) {
+	k.recordTxResult(ctx, sourcePort, types.TxResult{
+		ClientId: sourceClient,
+		Sequence: sequence,
+		Status:   types.TX_RESULT_TIMEOUT,
+	})
+}
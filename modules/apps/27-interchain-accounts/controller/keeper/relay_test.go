@@ -5,6 +5,7 @@ package keeper_test
 import (
 	"github.com/cosmos/gogoproto/proto"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -170,7 +171,10 @@ func (s *KeeperTestSuite) TestSendTx() {
 }
 
 func (s *KeeperTestSuite) TestOnTimeoutPacket() {
-	var path *ibctesting.Path
+	var (
+		path         *ibctesting.Path
+		expReopening bool
+	)
 
 	testCases := []struct {
 		msg      string
@@ -182,6 +186,29 @@ func (s *KeeperTestSuite) TestOnTimeoutPacket() {
 			func() {},
 			nil,
 		},
+		{
+			"success: closed channel is not reopened when reopening is disabled",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			nil,
+		},
+		{
+			"success: closed channel is reopened",
+			func() {
+				s.chainA.GetSimApp().ICAControllerKeeper.SetParams(s.chainA.GetContext(), types.Params{ControllerEnabled: true, MaxTxResults: types.DefaultMaxTxResults, ReopenClosedChannels: true, MaxQueuedTxs: types.DefaultMaxQueuedTxs})
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+				expReopening = true
+			},
+			nil,
+		},
+		{
+			"success: open channel is not reopened",
+			func() {
+				s.chainA.GetSimApp().ICAControllerKeeper.SetParams(s.chainA.GetContext(), types.Params{ControllerEnabled: true, MaxTxResults: types.DefaultMaxTxResults, ReopenClosedChannels: true, MaxQueuedTxs: types.DefaultMaxQueuedTxs})
+			},
+			nil,
+		},
 	}
 
 	for _, ordering := range []channeltypes.Order{channeltypes.UNORDERED, channeltypes.ORDERED} {
@@ -195,6 +222,8 @@ func (s *KeeperTestSuite) TestOnTimeoutPacket() {
 				err := SetupICAPath(path, TestOwnerAddress)
 				s.Require().NoError(err)
 
+				expReopening = false
+
 				tc.malleate() // malleate mutates test data
 
 				packet := channeltypes.NewPacket(
@@ -212,6 +241,19 @@ func (s *KeeperTestSuite) TestOnTimeoutPacket() {
 
 				if tc.expErr == nil {
 					s.Require().NoError(err)
+
+					result, found := s.chainA.GetSimApp().ICAControllerKeeper.GetTxResult(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, packet.Sequence)
+					s.Require().True(found)
+					s.Require().Equal(types.TX_RESULT_TIMEOUT, result.Status)
+
+					reopening, found := s.chainA.GetSimApp().ICAControllerKeeper.GetChannelReopening(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+					s.Require().Equal(expReopening, found)
+					if expReopening {
+						channel, found := s.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetChannel(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, reopening.ChannelId)
+						s.Require().True(found)
+						s.Require().Equal(channeltypes.INIT, channel.State)
+						s.Require().Equal(ordering, channel.Ordering)
+					}
 				} else {
 					s.Require().Error(err)
 				}
@@ -219,3 +261,194 @@ func (s *KeeperTestSuite) TestOnTimeoutPacket() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	var (
+		path            *ibctesting.Path
+		packet          channeltypes.Packet
+		acknowledgement []byte
+	)
+
+	msgResponse, err := codectypes.NewAnyWithValue(&banktypes.MsgSendResponse{})
+	s.Require().NoError(err)
+
+	errorAck := channeltypes.NewErrorAcknowledgement(icatypes.ErrUnknownDataType)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expResult *types.TxResult
+		expErr    error
+	}{
+		{
+			"success",
+			func() {},
+			&types.TxResult{Status: types.TX_RESULT_SUCCESS, MsgResponses: []*codectypes.Any{msgResponse}},
+			nil,
+		},
+		{
+			"success: error acknowledgement",
+			func() {
+				acknowledgement = errorAck.Acknowledgement()
+			},
+			&types.TxResult{Status: types.TX_RESULT_FAILURE, Error: errorAck.GetError()},
+			nil,
+		},
+		{
+			"success: undecodable acknowledgement is not recorded",
+			func() {
+				acknowledgement = []byte("invalid")
+			},
+			nil,
+			nil,
+		},
+		{
+			"success: undecodable acknowledgement result is not recorded",
+			func() {
+				acknowledgement = channeltypes.NewResultAcknowledgement([]byte("invalid")).Acknowledgement()
+			},
+			nil,
+			nil,
+		},
+		{
+			"success: tx results are not stored when max tx results is zero",
+			func() {
+				s.chainA.GetSimApp().ICAControllerKeeper.SetParams(s.chainA.GetContext(), types.Params{ControllerEnabled: true})
+			},
+			nil,
+			nil,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				packet.SourceChannel = ibctesting.InvalidID
+			},
+			nil,
+			channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for _, ordering := range []channeltypes.Order{channeltypes.UNORDERED, channeltypes.ORDERED} {
+		for _, tc := range testCases {
+			s.Run(tc.msg, func() {
+				s.SetupTest() // reset
+
+				path = NewICAPath(s.chainA, s.chainB, ordering)
+				path.SetupConnections()
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				s.Require().NoError(err)
+
+				bz, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgResponse}})
+				s.Require().NoError(err)
+				acknowledgement = channeltypes.NewResultAcknowledgement(bz).Acknowledgement()
+
+				packet = channeltypes.NewPacket(
+					[]byte{},
+					1,
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					clienttypes.NewHeight(0, 100),
+					0,
+				)
+
+				tc.malleate() // malleate mutates test data
+
+				ctx := s.chainA.GetContext()
+				err = s.chainA.GetSimApp().ICAControllerKeeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+
+				if tc.expErr != nil {
+					s.Require().ErrorIs(err, tc.expErr)
+					return
+				}
+
+				s.Require().NoError(err)
+
+				result, found := s.chainA.GetSimApp().ICAControllerKeeper.GetTxResult(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, packet.Sequence)
+				if tc.expResult == nil {
+					s.Require().False(found)
+					return
+				}
+
+				s.Require().True(found)
+				s.Require().Equal(TestOwnerAddress, result.Owner)
+				s.Require().Equal(path.EndpointA.ConnectionID, result.ConnectionId)
+				s.Require().Equal(path.EndpointA.ChannelID, result.ChannelId)
+				s.Require().Equal(packet.Sequence, result.Sequence)
+				s.Require().Equal(tc.expResult.Status, result.Status)
+				s.Require().Equal(tc.expResult.Error, result.Error)
+				s.Require().Equal(ctx.BlockHeight(), result.Height)
+				s.Require().Len(result.MsgResponses, len(tc.expResult.MsgResponses))
+				for i, msgResponse := range tc.expResult.MsgResponses {
+					s.Require().Equal(msgResponse.TypeUrl, result.MsgResponses[i].TypeUrl)
+				}
+
+				expAttributes := []sdk.Attribute{
+					sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+					sdk.NewAttribute(icatypes.AttributeKeyOwner, TestOwnerAddress),
+					sdk.NewAttribute(icatypes.AttributeKeyConnectionID, path.EndpointA.ConnectionID),
+					sdk.NewAttribute(icatypes.AttributeKeySequence, "1"),
+					sdk.NewAttribute(icatypes.AttributeKeyTxResultStatus, tc.expResult.Status.String()),
+					sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, path.EndpointA.ChannelID),
+				}
+
+				events := ctx.EventManager().Events()
+				s.Require().Len(events, 1)
+				s.Require().Equal(icatypes.EventTypeTxResult, events[0].Type)
+				for _, attr := range expAttributes {
+					s.Require().Contains(events[0].Attributes, attr.ToKVPair())
+				}
+			})
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestTxResultRetention() {
+	s.SetupTest()
+
+	path := NewICAPath(s.chainA, s.chainB, channeltypes.ORDERED)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	s.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.MaxTxResults = 2
+	s.chainA.GetSimApp().ICAControllerKeeper.SetParams(s.chainA.GetContext(), params)
+
+	for seq := uint64(1); seq <= 3; seq++ {
+		packet := channeltypes.NewPacket([]byte{}, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+		err = s.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(s.chainA.GetContext(), packet)
+		s.Require().NoError(err)
+	}
+
+	_, found := s.chainA.GetSimApp().ICAControllerKeeper.GetTxResult(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, 1)
+	s.Require().False(found, "result of the oldest transaction should be pruned")
+
+	for seq := uint64(2); seq <= 3; seq++ {
+		_, found = s.chainA.GetSimApp().ICAControllerKeeper.GetTxResult(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, seq)
+		s.Require().True(found)
+	}
+
+	// packet sequences restart on a newer channel of the interchain account, whose results are kept over the
+	// results of the previous channel
+	newChannelID := channeltypes.FormatChannelIdentifier(10)
+	s.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.SetChannel(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, newChannelID, path.EndpointA.GetChannel())
+
+	packet := channeltypes.NewPacket([]byte{}, 1, path.EndpointA.ChannelConfig.PortID, newChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	err = s.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(s.chainA.GetContext(), packet)
+	s.Require().NoError(err)
+
+	_, found = s.chainA.GetSimApp().ICAControllerKeeper.GetTxResult(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, 2)
+	s.Require().False(found, "result of the oldest transaction should be pruned")
+
+	_, found = s.chainA.GetSimApp().ICAControllerKeeper.GetTxResult(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, 3)
+	s.Require().True(found)
+
+	result, found := s.chainA.GetSimApp().ICAControllerKeeper.GetTxResult(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, newChannelID, 1)
+	s.Require().True(found)
+	s.Require().Equal(newChannelID, result.ChannelId)
+}
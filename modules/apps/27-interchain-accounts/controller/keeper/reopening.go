@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+)
+
+// GetChannelReopening retrieves the in-flight reopening of the closed channel of the interchain account of the provided
+// portID and connectionID
+func (k *Keeper) GetChannelReopening(ctx sdk.Context, portID, connectionID string) (types.ChannelReopening, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyChannelReopening(portID, connectionID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.ChannelReopening{}, false
+	}
+
+	var reopening types.ChannelReopening
+	k.cdc.MustUnmarshal(bz, &reopening)
+	return reopening, true
+}
+
+// SetChannelReopening stores the reopening of the closed channel of an interchain account, keyed by its portID and
+// connectionID
+func (k *Keeper) SetChannelReopening(ctx sdk.Context, reopening types.ChannelReopening) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&reopening)
+	if err := store.Set(types.KeyChannelReopening(reopening.PortId, reopening.ConnectionId), bz); err != nil {
+		panic(err)
+	}
+}
+
+// deleteChannelReopening deletes the reopening of the closed channel of the interchain account of the provided portID
+// and connectionID
+func (k *Keeper) deleteChannelReopening(ctx sdk.Context, portID, connectionID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyChannelReopening(portID, connectionID)); err != nil {
+		panic(err)
+	}
+}
+
+// reopenChannel initiates the reopening of the closed active channel of the interchain account of the provided portID
+// and connectionID, using the version and ordering of the closed channel. The reopening is stored until the channel
+// handshake completes.
+func (k *Keeper) reopenChannel(ctx sdk.Context, connectionID, portID string) (types.ChannelReopening, error) {
+	closedChannelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return types.ChannelReopening{}, errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, closedChannelID)
+	if !found {
+		return types.ChannelReopening{}, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", closedChannelID, portID)
+	}
+
+	if channel.State != channeltypes.CLOSED {
+		return types.ChannelReopening{}, errorsmod.Wrapf(channeltypes.ErrInvalidChannelState, "expected channel %s to be %s, got %s", closedChannelID, channeltypes.CLOSED, channel.State)
+	}
+
+	channelID, err := k.registerInterchainAccount(ctx, connectionID, portID, channel.Version, channel.Ordering)
+	if err != nil {
+		return types.ChannelReopening{}, err
+	}
+
+	reopening := types.ChannelReopening{
+		PortId:       portID,
+		ConnectionId: connectionID,
+		ChannelId:    channelID,
+	}
+	k.SetChannelReopening(ctx, reopening)
+
+	EmitChannelReopenInitEvent(ctx, portID, connectionID, closedChannelID, channelID, nil)
+
+	k.Logger(ctx).Info("initiated the reopening of a closed interchain account channel", "port-id", portID, "connection-id", connectionID, "closed-channel-id", closedChannelID, "channel-id", channelID)
+
+	return reopening, nil
+}
+
+// reopenChannelOnTimeout initiates the reopening of the active channel of the interchain account of the provided portID
+// and connectionID if it has been closed by a timeout and is not already reopening. A failure to reopen the channel is
+// logged and emitted, such that the timeout of the packet is not blocked.
+func (k *Keeper) reopenChannelOnTimeout(ctx sdk.Context, connectionID, portID string) {
+	if !k.GetParams(ctx).ReopenClosedChannels || !k.IsActiveChannelClosed(ctx, connectionID, portID) {
+		return
+	}
+
+	if _, found := k.GetChannelReopening(ctx, portID, connectionID); found {
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if _, err := k.reopenChannel(cacheCtx, connectionID, portID); err != nil {
+		k.Logger(ctx).Error("failed to reopen closed interchain account channel", "error", err, "port-id", portID, "connection-id", connectionID)
+		activeChannelID, _ := k.GetActiveChannelID(ctx, connectionID, portID)
+		EmitChannelReopenInitEvent(ctx, portID, connectionID, activeChannelID, "", err)
+		return
+	}
+
+	writeFn()
+}
+
+// queueTx queues the transaction until the closed active channel of the interchain account of the provided portID and
+// connectionID is reopened, initiating the reopening of the channel if it is not already in flight. Expired queued
+// transactions are dropped, and a stalled reopening whose queued transactions have all expired is replaced by a new
+// reopening. An error is returned if the MaxQueuedTxs param is reached. False is returned if the channel is not
+// reopening and the reopening of closed channels is disabled.
+func (k *Keeper) queueTx(ctx sdk.Context, connectionID, portID string, tx types.QueuedTx) (bool, error) {
+	params := k.GetParams(ctx)
+	if !params.ControllerEnabled {
+		return false, types.ErrControllerSubModuleDisabled
+	}
+
+	reopening, found := k.GetChannelReopening(ctx, portID, connectionID)
+	if found && k.dropExpiredTxs(ctx, &reopening) {
+		k.Logger(ctx).Info("replacing stalled interchain account channel reopening", "port-id", portID, "connection-id", connectionID, "channel-id", reopening.ChannelId)
+		k.deleteChannelReopening(ctx, portID, connectionID)
+		found = false
+	}
+
+	if !found {
+		if !params.ReopenClosedChannels {
+			return false, nil
+		}
+
+		var err error
+		reopening, err = k.reopenChannel(ctx, connectionID, portID)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if uint64(len(reopening.QueuedTxs)) >= params.MaxQueuedTxs {
+		return false, errorsmod.Wrapf(types.ErrMaxQueuedTxs, "%d transactions are queued for port %s on connection %s", len(reopening.QueuedTxs), portID, connectionID)
+	}
+
+	reopening.QueuedTxs = append(reopening.QueuedTxs, tx)
+	k.SetChannelReopening(ctx, reopening)
+
+	EmitTxQueuedEvent(ctx, reopening)
+
+	return true, nil
+}
+
+// dropExpiredTxs removes the expired transactions from the queue of the provided reopening, emitting an event with the
+// error for each of them. True is returned if the reopening is stalled, that is all of its queued transactions expired.
+func (k *Keeper) dropExpiredTxs(ctx sdk.Context, reopening *types.ChannelReopening) bool {
+	queuedTxs := reopening.QueuedTxs
+	reopening.QueuedTxs = slices.DeleteFunc(slices.Clone(queuedTxs), func(tx types.QueuedTx) bool {
+		return tx.IsExpired(ctx.BlockTime())
+	})
+
+	for range len(queuedTxs) - len(reopening.QueuedTxs) {
+		EmitQueuedTxSentEvent(ctx, reopening.PortId, reopening.ConnectionId, reopening.ChannelId, 0, types.ErrQueuedTxExpired)
+	}
+
+	return len(queuedTxs) > 0 && len(reopening.QueuedTxs) == 0
+}
+
+// sendQueuedTxs completes the reopening of the channel of the interchain account of the provided portID and
+// connectionID once the channel with the provided channelID is open, sending the queued transactions in order. The
+// number of transactions sent is bounded by the MaxQueuedTxs param. Transactions which have expired or cannot be sent
+// are dropped, emitting an event with the error.
+func (k *Keeper) sendQueuedTxs(ctx sdk.Context, connectionID, portID, channelID string) {
+	reopening, found := k.GetChannelReopening(ctx, portID, connectionID)
+	if !found {
+		return
+	}
+
+	k.deleteChannelReopening(ctx, portID, connectionID)
+
+	EmitChannelReopenedEvent(ctx, reopening, channelID)
+
+	for _, tx := range reopening.QueuedTxs {
+		var (
+			sequence uint64
+			err      error
+		)
+
+		if tx.IsExpired(ctx.BlockTime()) {
+			err = types.ErrQueuedTxExpired
+		} else {
+			cacheCtx, writeFn := ctx.CacheContext()
+			sequence, err = k.sendTx(cacheCtx, connectionID, portID, tx.PacketData, tx.TimeoutTimestamp)
+			if err == nil {
+				writeFn()
+			}
+		}
+
+		if err != nil {
+			k.Logger(ctx).Error("failed to send queued interchain account transaction", "error", err, "port-id", portID, "connection-id", connectionID, "channel-id", channelID)
+		}
+
+		EmitQueuedTxSentEvent(ctx, portID, connectionID, channelID, sequence, err)
+	}
+}
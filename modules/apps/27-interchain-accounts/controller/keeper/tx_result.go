@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+)
+
+// GetTxResult retrieves the result of the transaction sent with the provided sequence on the provided portID,
+// connectionID and channelID, or source clientID and an empty channelID for transactions sent over IBC v2
+func (k *Keeper) GetTxResult(ctx sdk.Context, portID, connectionOrClientID, channelID string, sequence uint64) (types.TxResult, bool) {
+	channelSequence, err := types.TxResultChannelSequence(channelID)
+	if err != nil {
+		return types.TxResult{}, false
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyTxResult(portID, connectionOrClientID, channelSequence, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.TxResult{}, false
+	}
+
+	var result types.TxResult
+	k.cdc.MustUnmarshal(bz, &result)
+	return result, true
+}
+
+// SetTxResult stores the result of a transaction sent on the provided portID, keyed by its connectionID and channelID
+// or source clientID, and sequence
+func (k *Keeper) SetTxResult(ctx sdk.Context, portID string, result types.TxResult) {
+	channelSequence, err := types.TxResultChannelSequence(result.ChannelId)
+	if err != nil {
+		panic(err)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&result)
+	if err := store.Set(types.KeyTxResult(portID, result.GetConnectionOrClientID(), channelSequence, result.Sequence), bz); err != nil {
+		panic(err)
+	}
+}
+
+// pruneTxResults deletes the results of the transactions sent on the provided portID and connectionID or source
+// clientID on the oldest channels and with the lowest sequences, such that at most maxTxResults results are kept.
+func (k *Keeper) pruneTxResults(ctx sdk.Context, portID, connectionOrClientID string, maxTxResults uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	keys := k.getTxResultKeys(ctx, portID, connectionOrClientID)
+	if uint64(len(keys)) <= maxTxResults {
+		return
+	}
+
+	for _, key := range keys[:uint64(len(keys))-maxTxResults] {
+		store.Delete(key)
+	}
+}
+
+// recordTxResult emits an event for the result of a transaction sent on the provided portID. The result is stored if
+// the MaxTxResults param is non-zero, pruning the results of the oldest transactions sent by the owner on the
+// connection or source client beyond the limit.
+func (k *Keeper) recordTxResult(ctx sdk.Context, portID string, result types.TxResult) {
+	result.Owner = strings.TrimPrefix(portID, icatypes.ControllerPortPrefix)
+	result.Height = ctx.BlockHeight()
+
+	EmitTxResultEvent(ctx, result)
+
+	maxTxResults := k.GetParams(ctx).MaxTxResults
+	if maxTxResults == 0 {
+		return
+	}
+
+	k.SetTxResult(ctx, portID, result)
+	k.pruneTxResults(ctx, portID, result.GetConnectionOrClientID(), maxTxResults)
+}
+
+// getTxResultKeys returns the store keys of the results of the transactions sent on the provided portID and
+// connectionID or source clientID, ordered by channel and sequence
+func (k *Keeper) getTxResultKeys(ctx sdk.Context, portID, connectionOrClientID string) [][]byte {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyTxResultPrefix(portID, connectionOrClientID))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	return keys
+}
@@ -32,6 +32,11 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgSendTx{}),
 			nil,
 		},
+		{
+			"success: MsgTransferOwnership",
+			sdk.MsgTypeURL(&types.MsgTransferOwnership{}),
+			nil,
+		},
 		{
 			"success: MsgUpdateParams",
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
@@ -5,7 +5,10 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -22,11 +25,57 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TxResultStatus defines the outcome of a transaction sent to an interchain account.
+type TxResultStatus int32
+
+const (
+	// Default zero value enumeration
+	TX_RESULT_UNSPECIFIED TxResultStatus = 0
+	// The transaction was executed successfully on the host chain
+	TX_RESULT_SUCCESS TxResultStatus = 1
+	// The host chain returned an error acknowledgement for the transaction
+	TX_RESULT_FAILURE TxResultStatus = 2
+	// The packet of the transaction timed out before being received by the host chain
+	TX_RESULT_TIMEOUT TxResultStatus = 3
+)
+
+var TxResultStatus_name = map[int32]string{
+	0: "TX_RESULT_STATUS_UNSPECIFIED",
+	1: "TX_RESULT_STATUS_SUCCESS",
+	2: "TX_RESULT_STATUS_FAILURE",
+	3: "TX_RESULT_STATUS_TIMEOUT",
+}
+
+var TxResultStatus_value = map[string]int32{
+	"TX_RESULT_STATUS_UNSPECIFIED": 0,
+	"TX_RESULT_STATUS_SUCCESS":     1,
+	"TX_RESULT_STATUS_FAILURE":     2,
+	"TX_RESULT_STATUS_TIMEOUT":     3,
+}
+
+func (x TxResultStatus) String() string {
+	return proto.EnumName(TxResultStatus_name, int32(x))
+}
+
+func (TxResultStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{0}
+}
+
 // Params defines the set of on-chain interchain accounts parameters.
 // The following parameters may be used to disable the controller submodule.
 type Params struct {
 	// controller_enabled enables or disables the controller submodule.
 	ControllerEnabled bool `protobuf:"varint,1,opt,name=controller_enabled,json=controllerEnabled,proto3" json:"controller_enabled,omitempty"`
+	// max_tx_results defines the maximum number of transaction results stored per owner and connection, the results of
+	// the oldest transactions are pruned once exceeded. A zero value disables storing transaction results.
+	MaxTxResults uint64 `protobuf:"varint,2,opt,name=max_tx_results,json=maxTxResults,proto3" json:"max_tx_results,omitempty"`
+	// reopen_closed_channels enables the automatic reopening of the closed ORDERED channel of an interchain account on
+	// the same connection, upon a timeout or the next MsgSendTx. Transactions sent while the channel is reopening are
+	// queued until it is open again.
+	ReopenClosedChannels bool `protobuf:"varint,3,opt,name=reopen_closed_channels,json=reopenClosedChannels,proto3" json:"reopen_closed_channels,omitempty"`
+	// max_queued_txs defines the maximum number of transactions queued per interchain account while its channel is
+	// reopening, bounding the transactions sent once the channel is open. A zero value disables queueing transactions.
+	MaxQueuedTxs uint64 `protobuf:"varint,4,opt,name=max_queued_txs,json=maxQueuedTxs,proto3" json:"max_queued_txs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,8 +118,367 @@ func (m *Params) GetControllerEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxTxResults() uint64 {
+	if m != nil {
+		return m.MaxTxResults
+	}
+	return 0
+}
+
+func (m *Params) GetReopenClosedChannels() bool {
+	if m != nil {
+		return m.ReopenClosedChannels
+	}
+	return false
+}
+
+func (m *Params) GetMaxQueuedTxs() uint64 {
+	if m != nil {
+		return m.MaxQueuedTxs
+	}
+	return 0
+}
+
+// TxResult defines the result of a transaction sent by an owner to its interchain account.
+type TxResult struct {
+	// owner address of the interchain account
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection identifier of the channel the transaction was sent on, empty for transactions sent over IBC v2
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// sequence of the packet of the transaction
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// status of the transaction
+	Status TxResultStatus `protobuf:"varint,4,opt,name=status,proto3,enum=ibc.applications.interchain_accounts.controller.v1.TxResultStatus" json:"status,omitempty"`
+	// msg_responses contains the responses of the messages of a successful transaction
+	MsgResponses []*types.Any `protobuf:"bytes,5,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+	// error contains the error of the acknowledgement of a failed transaction
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// height of the controller chain at which the acknowledgement or the timeout was processed
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// source client identifier of the packet of a transaction sent over IBC v2, empty for transactions sent on a channel
+	ClientId string `protobuf:"bytes,8,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// channel identifier the transaction was sent on, empty for transactions sent over IBC v2
+	ChannelId string `protobuf:"bytes,9,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *TxResult) Reset()         { *m = TxResult{} }
+func (m *TxResult) String() string { return proto.CompactTextString(m) }
+func (*TxResult) ProtoMessage()    {}
+func (*TxResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{1}
+}
+func (m *TxResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxResult.Merge(m, src)
+}
+func (m *TxResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxResult proto.InternalMessageInfo
+
+func (m *TxResult) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TxResult) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *TxResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TxResult) GetStatus() TxResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TX_RESULT_UNSPECIFIED
+}
+
+func (m *TxResult) GetMsgResponses() []*types.Any {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
+func (m *TxResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *TxResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TxResult) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *TxResult) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// InterchainAccountOwnership defines the owners authorized to control an interchain account registered on a connection,
+// or controlled over IBC v2 from a source client, from the controller port of its original owner. Interchain accounts
+// without a registered ownership are controlled by the owner of their controller port.
+type InterchainAccountOwnership struct {
+	// controller port identifier of the interchain account, {ControllerPortPrefix}{original owner}
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// connection identifier of the interchain account, empty for interchain accounts controlled over IBC v2
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// owners authorized to control the interchain account
+	Owners []string `protobuf:"bytes,3,rep,name=owners,proto3" json:"owners,omitempty"`
+	// threshold of owners required to sign messages controlling the interchain account
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// source client identifier of the interchain account controlled over IBC v2, empty for interchain accounts
+	// registered on a connection
+	ClientId string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *InterchainAccountOwnership) Reset()         { *m = InterchainAccountOwnership{} }
+func (m *InterchainAccountOwnership) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountOwnership) ProtoMessage()    {}
+func (*InterchainAccountOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{2}
+}
+func (m *InterchainAccountOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountOwnership.Merge(m, src)
+}
+func (m *InterchainAccountOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountOwnership proto.InternalMessageInfo
+
+func (m *InterchainAccountOwnership) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InterchainAccountOwnership) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *InterchainAccountOwnership) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *InterchainAccountOwnership) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *InterchainAccountOwnership) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// ChannelReopening defines the reopening of the closed channel of an interchain account, along with the transactions
+// queued until the channel is open again.
+type ChannelReopening struct {
+	// controller port identifier of the interchain account
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// connection identifier of the interchain account
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// channel identifier of the channel opened to replace the closed channel
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// transactions queued until the channel is open, sent in order once it is open. A reopening whose queued
+	// transactions have all expired is stalled, and is replaced by a new reopening upon the next queued transaction.
+	QueuedTxs []QueuedTx `protobuf:"bytes,4,rep,name=queued_txs,json=queuedTxs,proto3" json:"queued_txs"`
+}
+
+func (m *ChannelReopening) Reset()         { *m = ChannelReopening{} }
+func (m *ChannelReopening) String() string { return proto.CompactTextString(m) }
+func (*ChannelReopening) ProtoMessage()    {}
+func (*ChannelReopening) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{3}
+}
+func (m *ChannelReopening) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelReopening) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelReopening.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelReopening) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelReopening.Merge(m, src)
+}
+func (m *ChannelReopening) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelReopening) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelReopening.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelReopening proto.InternalMessageInfo
+
+func (m *ChannelReopening) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelReopening) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ChannelReopening) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelReopening) GetQueuedTxs() []QueuedTx {
+	if m != nil {
+		return m.QueuedTxs
+	}
+	return nil
+}
+
+// QueuedTx defines a transaction queued until the channel of its interchain account is open.
+type QueuedTx struct {
+	PacketData types1.InterchainAccountPacketData `protobuf:"bytes,1,opt,name=packet_data,json=packetData,proto3" json:"packet_data"`
+	// absolute timeout timestamp of the packet of the transaction, from the block time at which it was queued. Expired
+	// transactions are dropped from the queue.
+	TimeoutTimestamp uint64 `protobuf:"varint,2,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *QueuedTx) Reset()         { *m = QueuedTx{} }
+func (m *QueuedTx) String() string { return proto.CompactTextString(m) }
+func (*QueuedTx) ProtoMessage()    {}
+func (*QueuedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{4}
+}
+func (m *QueuedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedTx.Merge(m, src)
+}
+func (m *QueuedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedTx proto.InternalMessageInfo
+
+func (m *QueuedTx) GetPacketData() types1.InterchainAccountPacketData {
+	if m != nil {
+		return m.PacketData
+	}
+	return types1.InterchainAccountPacketData{}
+}
+
+func (m *QueuedTx) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ibc.applications.interchain_accounts.controller.v1.TxResultStatus", TxResultStatus_name, TxResultStatus_value)
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
+	proto.RegisterType((*TxResult)(nil), "ibc.applications.interchain_accounts.controller.v1.TxResult")
+	proto.RegisterType((*InterchainAccountOwnership)(nil), "ibc.applications.interchain_accounts.controller.v1.InterchainAccountOwnership")
+	proto.RegisterType((*ChannelReopening)(nil), "ibc.applications.interchain_accounts.controller.v1.ChannelReopening")
+	proto.RegisterType((*QueuedTx)(nil), "ibc.applications.interchain_accounts.controller.v1.QueuedTx")
 }
 
 func init() {
@@ -78,21 +486,59 @@ func init() {
 }
 
 var fileDescriptor_177fd0fec5eb3400 = []byte{
-	// 220 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x8f, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0xe3, 0xa5, 0x42, 0xd9, 0xc8, 0xc4, 0x64, 0x21, 0x26, 0x96, 0xf8, 0x94, 0x32, 0x74,
-	0x07, 0xb1, 0x03, 0x23, 0x4b, 0x65, 0x5f, 0xad, 0xf6, 0x84, 0xe3, 0x8b, 0x7c, 0x4e, 0x24, 0xde,
-	0x82, 0xc7, 0x62, 0xec, 0xc8, 0x88, 0x92, 0x17, 0x41, 0xc0, 0x90, 0x0c, 0x19, 0x4f, 0x9f, 0xee,
-	0xd3, 0xff, 0x95, 0x0f, 0xe4, 0x10, 0x6c, 0xd7, 0x05, 0x42, 0x9b, 0x89, 0xa3, 0x00, 0xc5, 0xec,
-	0x13, 0x9e, 0x2c, 0xc5, 0xbd, 0x45, 0xe4, 0x3e, 0x66, 0x01, 0xe4, 0x98, 0x13, 0x87, 0xe0, 0x13,
-	0x0c, 0xcd, 0xe2, 0x32, 0x5d, 0xe2, 0xcc, 0xd5, 0x96, 0x1c, 0x9a, 0xa5, 0xc4, 0xac, 0x48, 0xcc,
-	0xe2, 0x6d, 0x68, 0x6e, 0x76, 0xe5, 0xe6, 0xc9, 0x26, 0xdb, 0x4a, 0x55, 0x97, 0xd5, 0x8c, 0xf6,
-	0x3e, 0x5a, 0x17, 0xfc, 0xe1, 0x4a, 0x5d, 0xab, 0xdb, 0x8b, 0x97, 0xcb, 0x99, 0x3c, 0xfe, 0x83,
-	0xfb, 0xb7, 0xcf, 0x51, 0xab, 0xf3, 0xa8, 0xd5, 0xf7, 0xa8, 0xd5, 0xc7, 0xa4, 0x8b, 0xf3, 0xa4,
-	0x8b, 0xaf, 0x49, 0x17, 0xaf, 0xcf, 0x47, 0xca, 0xa7, 0xde, 0x19, 0xe4, 0x16, 0x90, 0xa5, 0x65,
-	0x01, 0x72, 0x58, 0x1f, 0x19, 0x86, 0xa6, 0x81, 0x96, 0x0f, 0x7d, 0xf0, 0xf2, 0x1b, 0x2b, 0xb0,
-	0xdd, 0xd5, 0xf3, 0xc4, 0x7a, 0xad, 0x33, 0xbf, 0x77, 0x5e, 0xdc, 0xe6, 0x2f, 0xf0, 0xee, 0x27,
-	0x00, 0x00, 0xff, 0xff, 0x18, 0xdd, 0xe1, 0x90, 0x27, 0x01, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6e, 0xdb, 0x46,
+	0x14, 0x16, 0x2d, 0x59, 0x91, 0xc6, 0x3f, 0x90, 0x07, 0x8e, 0xcb, 0xa8, 0xa9, 0x2a, 0xb8, 0x5d,
+	0x18, 0x2d, 0x44, 0x42, 0x4a, 0x80, 0xa2, 0x68, 0x37, 0xb2, 0xac, 0x00, 0x04, 0xd2, 0xc6, 0x21,
+	0x29, 0xa0, 0xc8, 0x86, 0x18, 0x0d, 0xa7, 0x24, 0x61, 0x72, 0x86, 0xe6, 0x0c, 0x55, 0xf9, 0x06,
+	0x45, 0x56, 0xbd, 0x40, 0x56, 0xed, 0xb6, 0x07, 0xe8, 0x0d, 0xb2, 0x0c, 0xba, 0xea, 0xaa, 0x28,
+	0xe4, 0x1b, 0xf4, 0x04, 0x05, 0x87, 0xa4, 0x7e, 0x1c, 0x2d, 0xdc, 0x76, 0x25, 0xbd, 0xf7, 0xf1,
+	0xfb, 0xe6, 0xbd, 0xef, 0x3d, 0x0e, 0xc1, 0x28, 0x98, 0x62, 0x1d, 0xc5, 0x71, 0x18, 0x60, 0x24,
+	0x02, 0x46, 0xb9, 0x1e, 0x50, 0x41, 0x12, 0xec, 0xa3, 0x80, 0x3a, 0x08, 0x63, 0x96, 0x52, 0xc1,
+	0x75, 0xcc, 0xa8, 0x48, 0x58, 0x18, 0x92, 0x44, 0x9f, 0xf5, 0xd7, 0x22, 0x2d, 0x4e, 0x98, 0x60,
+	0x70, 0x10, 0x4c, 0xb1, 0xb6, 0x2e, 0xa2, 0x6d, 0x11, 0xd1, 0xd6, 0x68, 0xb3, 0x7e, 0xfb, 0xd8,
+	0x63, 0x1e, 0x93, 0x74, 0x3d, 0xfb, 0x97, 0x2b, 0xb5, 0x1f, 0x79, 0x8c, 0x79, 0x21, 0xd1, 0x65,
+	0x34, 0x4d, 0xbf, 0xd7, 0x11, 0xbd, 0x29, 0xa0, 0xa7, 0xf7, 0xaa, 0x74, 0xd6, 0xd7, 0x63, 0x84,
+	0xaf, 0x88, 0xc8, 0x59, 0xa7, 0xbf, 0x29, 0xa0, 0x7e, 0x89, 0x12, 0x14, 0x71, 0xd8, 0x03, 0x70,
+	0x55, 0x82, 0x43, 0x28, 0x9a, 0x86, 0xc4, 0x55, 0x95, 0xae, 0x72, 0xd6, 0x30, 0x8f, 0x56, 0xc8,
+	0x38, 0x07, 0xe0, 0xa7, 0xe0, 0x30, 0x42, 0x73, 0x47, 0xcc, 0x9d, 0x84, 0xf0, 0x34, 0x14, 0x5c,
+	0xdd, 0xe9, 0x2a, 0x67, 0x35, 0x73, 0x3f, 0x42, 0x73, 0x7b, 0x6e, 0xe6, 0x39, 0xf8, 0x14, 0x9c,
+	0x24, 0x84, 0xc5, 0x84, 0x3a, 0x38, 0x64, 0x9c, 0xb8, 0x0e, 0xf6, 0x11, 0xa5, 0x24, 0xe4, 0x6a,
+	0x55, 0x0a, 0x1f, 0xe7, 0xe8, 0x48, 0x82, 0xa3, 0x02, 0x2b, 0xb5, 0xaf, 0x53, 0x92, 0x12, 0xd7,
+	0x11, 0x73, 0xae, 0xd6, 0x96, 0xda, 0x2f, 0x65, 0xd2, 0x9e, 0xf3, 0xd3, 0xc5, 0x0e, 0x68, 0x94,
+	0x27, 0xc1, 0x63, 0xb0, 0xcb, 0x7e, 0xa0, 0x24, 0x91, 0x05, 0x37, 0xcd, 0x3c, 0x80, 0x9f, 0x80,
+	0x03, 0xcc, 0x28, 0x25, 0x38, 0x73, 0xc4, 0x09, 0x5c, 0x59, 0x63, 0xd3, 0xdc, 0x5f, 0x25, 0x0d,
+	0x17, 0xb6, 0x41, 0x83, 0x93, 0xeb, 0x94, 0x50, 0x4c, 0x64, 0x55, 0x35, 0x73, 0x19, 0xc3, 0x57,
+	0xa0, 0xce, 0x05, 0x12, 0x69, 0x5e, 0xc1, 0xe1, 0xe0, 0x5c, 0xfb, 0xf7, 0xb3, 0xd4, 0xca, 0x22,
+	0x2d, 0xa9, 0x64, 0x16, 0x8a, 0xf0, 0x4b, 0x70, 0x10, 0x71, 0x2f, 0xb3, 0x2f, 0x66, 0x94, 0x13,
+	0xae, 0xee, 0x76, 0xab, 0x67, 0x7b, 0x83, 0x63, 0x2d, 0x1f, 0xb2, 0x56, 0x0e, 0x59, 0x1b, 0xd2,
+	0x1b, 0x73, 0x3f, 0xe2, 0x9e, 0x59, 0x3e, 0x99, 0x75, 0x4b, 0x92, 0x84, 0x25, 0x6a, 0x3d, 0xef,
+	0x56, 0x06, 0xf0, 0x04, 0xd4, 0x7d, 0x12, 0x78, 0xbe, 0x50, 0x1f, 0x74, 0x95, 0xb3, 0xaa, 0x59,
+	0x44, 0xf0, 0x43, 0xd0, 0xc4, 0x61, 0x40, 0xa8, 0xc8, 0x1c, 0x68, 0x48, 0x46, 0x23, 0x4f, 0x18,
+	0x2e, 0xfc, 0x08, 0x80, 0x62, 0x26, 0x19, 0xda, 0x94, 0x68, 0xb3, 0xc8, 0x18, 0xee, 0xe9, 0xaf,
+	0x0a, 0x68, 0x1b, 0xcb, 0x0e, 0x87, 0x79, 0x83, 0x2f, 0x32, 0x73, 0xb9, 0x1f, 0xc4, 0xf0, 0x03,
+	0xf0, 0x20, 0x66, 0x89, 0x14, 0xce, 0x8d, 0xaf, 0x67, 0xa1, 0xe1, 0xde, 0xcf, 0xf9, 0x13, 0x50,
+	0x97, 0x73, 0xca, 0xb6, 0xa1, 0x9a, 0x91, 0xf3, 0x08, 0x3e, 0x06, 0x4d, 0xe1, 0x27, 0x84, 0xfb,
+	0x2c, 0x74, 0xa5, 0xf1, 0x07, 0xe6, 0x2a, 0xb1, 0xd9, 0xce, 0xee, 0x66, 0x3b, 0xa7, 0xbf, 0x2b,
+	0xa0, 0x55, 0xec, 0x91, 0x29, 0x57, 0x2b, 0xa0, 0xde, 0xff, 0xac, 0x72, 0xd3, 0xa1, 0xea, 0x1d,
+	0x87, 0x20, 0x02, 0x60, 0x63, 0x51, 0xb3, 0x19, 0x7e, 0xfd, 0x5f, 0xd6, 0xa4, 0xdc, 0xec, 0xf3,
+	0xda, 0xdb, 0x3f, 0x3f, 0xae, 0x98, 0xcd, 0xeb, 0xe5, 0xa6, 0xff, 0xa2, 0x80, 0x46, 0x89, 0xc2,
+	0x2b, 0xb0, 0x97, 0xbf, 0xc2, 0x8e, 0x8b, 0x04, 0x92, 0x0d, 0xed, 0x0d, 0x2e, 0xee, 0x77, 0xe0,
+	0xac, 0xaf, 0xbd, 0x37, 0xcc, 0x4b, 0x29, 0x76, 0x81, 0x04, 0x2a, 0x0e, 0x06, 0xf1, 0x32, 0x03,
+	0x3f, 0x07, 0x47, 0x22, 0x88, 0x08, 0x4b, 0x85, 0x93, 0xfd, 0x72, 0x81, 0xa2, 0xb8, 0x78, 0xd1,
+	0x5b, 0x05, 0x60, 0x97, 0xf9, 0xcf, 0xfe, 0x56, 0xc0, 0xe1, 0xe6, 0xae, 0xc3, 0xaf, 0xc0, 0x63,
+	0xfb, 0x3b, 0xc7, 0x1c, 0x5b, 0x93, 0xe7, 0xb6, 0x63, 0xd9, 0x43, 0x7b, 0x62, 0x39, 0x93, 0x6f,
+	0xad, 0xcb, 0xf1, 0xc8, 0x78, 0x66, 0x8c, 0x2f, 0x5a, 0x95, 0xf6, 0xa3, 0xd7, 0x6f, 0xba, 0x0f,
+	0x57, 0xcf, 0xac, 0x81, 0xf0, 0x09, 0x50, 0xdf, 0x23, 0x5b, 0x93, 0xd1, 0x68, 0x6c, 0x59, 0x2d,
+	0xa5, 0xfd, 0xf0, 0xf5, 0x9b, 0xee, 0xd1, 0x1a, 0x9e, 0x03, 0x5b, 0x49, 0xcf, 0x86, 0xc6, 0xf3,
+	0x89, 0x39, 0x6e, 0xed, 0xdc, 0x25, 0x15, 0xc0, 0x56, 0x92, 0x6d, 0x7c, 0x33, 0x7e, 0x31, 0xb1,
+	0x5b, 0xd5, 0xbb, 0xa4, 0x02, 0x68, 0xd7, 0x7e, 0xfc, 0xb9, 0x53, 0x39, 0xbf, 0x7a, 0xbb, 0xe8,
+	0x28, 0xef, 0x16, 0x1d, 0xe5, 0xaf, 0x45, 0x47, 0xf9, 0xe9, 0xb6, 0x53, 0x79, 0x77, 0xdb, 0xa9,
+	0xfc, 0x71, 0xdb, 0xa9, 0xbc, 0x7a, 0xe9, 0x05, 0xc2, 0x4f, 0xa7, 0x1a, 0x66, 0x91, 0x8e, 0x19,
+	0x8f, 0x18, 0xd7, 0x83, 0x29, 0xee, 0x79, 0x4c, 0x9f, 0xf5, 0xfb, 0x7a, 0xc4, 0xdc, 0x34, 0x24,
+	0x3c, 0xbb, 0xb2, 0xb9, 0x3e, 0xf8, 0xa2, 0xb7, 0x1a, 0x57, 0x6f, 0xdb, 0x77, 0x45, 0xdc, 0xc4,
+	0x84, 0x4f, 0xeb, 0xf2, 0x4e, 0x78, 0xf2, 0xcf, 0x00, 0x71, 0xaf, 0x3f, 0x25, 0x97, 0x06, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -115,6 +561,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxQueuedTxs != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxQueuedTxs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReopenClosedChannels {
+		i--
+		if m.ReopenClosedChannels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTxResults != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxTxResults))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ControllerEnabled {
 		i--
 		if m.ControllerEnabled {
@@ -128,33 +594,398 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintController(dAtA []byte, offset int, v uint64) int {
-	offset -= sovController(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TxResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TxResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ControllerEnabled {
-		n += 2
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Height != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovController(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozController(x uint64) (n int) {
+func (m *InterchainAccountOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintController(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelReopening) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelReopening) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelReopening) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueuedTxs) > 0 {
+		for iNdEx := len(m.QueuedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.PacketData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintController(dAtA []byte, offset int, v uint64) int {
+	offset -= sovController(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ControllerEnabled {
+		n += 2
+	}
+	if m.MaxTxResults != 0 {
+		n += 1 + sovController(uint64(m.MaxTxResults))
+	}
+	if m.ReopenClosedChannels {
+		n += 2
+	}
+	if m.MaxQueuedTxs != 0 {
+		n += 1 + sovController(uint64(m.MaxQueuedTxs))
+	}
+	return n
+}
+
+func (m *TxResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovController(uint64(m.Sequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovController(uint64(m.Status))
+	}
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovController(uint64(m.Height))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func (m *InterchainAccountOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovController(uint64(m.Threshold))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelReopening) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if len(m.QueuedTxs) > 0 {
+		for _, e := range m.QueuedTxs {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueuedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketData.Size()
+	n += 1 + l + sovController(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovController(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func sovController(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozController(x uint64) (n int) {
 	return sovController(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
@@ -206,6 +1037,844 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ControllerEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxResults", wireType)
+			}
+			m.MaxTxResults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxResults |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReopenClosedChannels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReopenClosedChannels = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueuedTxs", wireType)
+			}
+			m.MaxQueuedTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueuedTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TxResultStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterchainAccountOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelReopening) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelReopening: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelReopening: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedTxs = append(m.QueuedTxs, QueuedTx{})
+			if err := m.QueuedTxs[len(m.QueuedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipController(dAtA[iNdEx:])
@@ -9,4 +9,6 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrMaxQueuedTxs                = errorsmod.Register(SubModuleName, 3, "maximum number of queued transactions reached")
+	ErrQueuedTxExpired             = errorsmod.Register(SubModuleName, 4, "queued transaction expired")
 )
@@ -2,6 +2,12 @@
 
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// SubModuleName defines the interchain accounts controller module name
 	SubModuleName = "icacontroller"
@@ -11,6 +17,45 @@ const (
 
 	// ParamsKey is the store key for the interchain accounts controller parameters
 	ParamsKey = "params"
+
+	// TxResultKeyPrefix defines the key prefix used to store the results of transactions sent to interchain accounts
+	TxResultKeyPrefix = "txResult"
+
+	// OwnershipKeyPrefix defines the key prefix used to store the ownerships of interchain accounts
+	OwnershipKeyPrefix = "accountOwnership"
+
+	// ChannelReopeningKeyPrefix defines the key prefix used to store the reopenings of the closed channels of interchain accounts
+	ChannelReopeningKeyPrefix = "channelReopening"
 )
 
 var KeyControllerEnabled = []byte("ControllerEnabled")
+
+// KeyTxResultPrefix creates and returns the key prefix of the transaction results for the provided portID and
+// connectionID, or source clientID for transactions sent over IBC v2
+func KeyTxResultPrefix(portID, connectionOrClientID string) []byte {
+	return fmt.Appendf(nil, "%s/%s/%s/", TxResultKeyPrefix, portID, connectionOrClientID)
+}
+
+// KeyTxResultChannelPrefix creates and returns the key prefix of the transaction results for the provided portID and
+// connectionID sent on the channel with the provided channel sequence. The channel sequence is big endian encoded so
+// that the results of the channels of a connection are iterated in the order in which the channels were created.
+// Transactions sent over IBC v2 are stored under the zero channel sequence of their source clientID.
+func KeyTxResultChannelPrefix(portID, connectionOrClientID string, channelSequence uint64) []byte {
+	return append(KeyTxResultPrefix(portID, connectionOrClientID), sdk.Uint64ToBigEndian(channelSequence)...)
+}
+
+// KeyTxResult creates and returns a new key used for transaction result store operations. The sequence is big endian
+// encoded so that the results of a channel are iterated in the order of their sequences.
+func KeyTxResult(portID, connectionOrClientID string, channelSequence, sequence uint64) []byte {
+	return append(KeyTxResultChannelPrefix(portID, connectionOrClientID, channelSequence), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// KeyOwnership creates and returns a new key used for interchain account ownership store operations
+func KeyOwnership(portID, connectionOrClientID string) []byte {
+	return fmt.Appendf(nil, "%s/%s/%s", OwnershipKeyPrefix, portID, connectionOrClientID)
+}
+
+// KeyChannelReopening creates and returns a new key used for channel reopening store operations
+func KeyChannelReopening(portID, connectionID string) []byte {
+	return fmt.Appendf(nil, "%s/%s/%s", ChannelReopeningKeyPrefix, portID, connectionID)
+}
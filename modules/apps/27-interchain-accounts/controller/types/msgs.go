@@ -22,10 +22,12 @@ var (
 	_ sdk.Msg = (*MsgRegisterInterchainAccount)(nil)
 	_ sdk.Msg = (*MsgSendTx)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgTransferOwnership)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterInterchainAccount)(nil)
 	_ sdk.HasValidateBasic = (*MsgSendTx)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgTransferOwnership)(nil)
 )
 
 // NewMsgRegisterInterchainAccount creates a new instance of MsgRegisterInterchainAccount
@@ -56,7 +58,18 @@ func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "owner address must not exceed %d bytes", MaximumOwnerLength)
 	}
 
-	return nil
+	if msg.PortId != "" {
+		if err := ValidateControllerPortID(msg.PortId); err != nil {
+			return err
+		}
+	}
+
+	return validateCoSigners(msg.Owner, msg.CoSigners)
+}
+
+// Signers returns the owner and the co-signers of the registration
+func (msg MsgRegisterInterchainAccount) Signers() []string {
+	return append([]string{msg.Owner}, msg.CoSigners...)
 }
 
 // NewMsgSendTx creates a new instance of MsgSendTx
@@ -91,7 +104,18 @@ func (msg MsgSendTx) ValidateBasic() error {
 		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "relative timeout cannot be zero")
 	}
 
-	return nil
+	if msg.PortId != "" {
+		if err := ValidateControllerPortID(msg.PortId); err != nil {
+			return err
+		}
+	}
+
+	return validateCoSigners(msg.Owner, msg.CoSigners)
+}
+
+// Signers returns the owner and the co-signers of the transaction
+func (msg MsgSendTx) Signers() []string {
+	return append([]string{msg.Owner}, msg.CoSigners...)
 }
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -111,3 +135,69 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgTransferOwnership creates a new MsgTransferOwnership instance
+func NewMsgTransferOwnership(owner string, coSigners []string, portID, connectionID string, newOwners []string, threshold uint32) *MsgTransferOwnership {
+	return &MsgTransferOwnership{
+		Owner:        owner,
+		CoSigners:    coSigners,
+		PortId:       portID,
+		ConnectionId: connectionID,
+		NewOwners:    newOwners,
+		Threshold:    threshold,
+	}
+}
+
+// NewMsgTransferClientOwnership creates a new MsgTransferOwnership instance for an interchain account controlled
+// over IBC v2 from the provided source clientID
+func NewMsgTransferClientOwnership(owner string, coSigners []string, portID, clientID string, newOwners []string, threshold uint32) *MsgTransferOwnership {
+	return &MsgTransferOwnership{
+		Owner:     owner,
+		CoSigners: coSigners,
+		PortId:    portID,
+		ClientId:  clientID,
+		NewOwners: newOwners,
+		Threshold: threshold,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTransferOwnership) ValidateBasic() error {
+	if strings.TrimSpace(msg.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if err := validateCoSigners(msg.Owner, msg.CoSigners); err != nil {
+		return err
+	}
+
+	return msg.NewOwnership().Validate()
+}
+
+// NewOwnership returns the ownership of the interchain account transferred to the new owners
+func (msg MsgTransferOwnership) NewOwnership() InterchainAccountOwnership {
+	ownership := NewInterchainAccountOwnership(msg.PortId, msg.ConnectionId, msg.NewOwners, msg.Threshold)
+	ownership.ClientId = msg.ClientId
+
+	return ownership
+}
+
+// Signers returns the owner and the co-signers of the transfer
+func (msg MsgTransferOwnership) Signers() []string {
+	return append([]string{msg.Owner}, msg.CoSigners...)
+}
+
+// validateCoSigners validates that the co-signers are unique and distinct from the owner
+func validateCoSigners(owner string, coSigners []string) error {
+	for i, coSigner := range coSigners {
+		if strings.TrimSpace(coSigner) == "" {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "co-signer address cannot be empty")
+		}
+
+		if coSigner == owner || slices.Contains(coSigners[:i], coSigner) {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "duplicate signer %s", coSigner)
+		}
+	}
+
+	return nil
+}
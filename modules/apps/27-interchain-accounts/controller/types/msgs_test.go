@@ -22,6 +22,11 @@ import (
 	ibctesting "github.com/cosmos/ibc-go/v11/testing"
 )
 
+var (
+	testPortID   = icatypes.ControllerPortPrefix + ibctesting.TestAccAddress
+	testCoSigner = sdk.AccAddress("co-signer-address---").String()
+)
+
 func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
 	var msg *types.MsgRegisterInterchainAccount
 
@@ -70,6 +75,28 @@ func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
 			},
 			channeltypes.ErrInvalidChannelOrdering,
 		},
+		{
+			"success: with port id and co-signers",
+			func() {
+				msg.PortId = testPortID
+				msg.CoSigners = []string{testCoSigner}
+			},
+			nil,
+		},
+		{
+			"port id is not a controller port",
+			func() {
+				msg.PortId = icatypes.HostPortID
+			},
+			icatypes.ErrInvalidControllerPort,
+		},
+		{
+			"co-signer is the owner",
+			func() {
+				msg.CoSigners = []string{ibctesting.TestAccAddress}
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -99,7 +126,15 @@ func TestMsgRegisterInterchainAccountGetSigners(t *testing.T) {
 	encodingCfg := moduletestutil.MakeTestEncodingConfig(ica.AppModuleBasic{})
 	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
 	require.NoError(t, err)
-	require.Equal(t, expSigner.Bytes(), signers[0])
+	require.Equal(t, [][]byte{expSigner.Bytes()}, signers)
+
+	expCoSigner, err := sdk.AccAddressFromBech32(testCoSigner)
+	require.NoError(t, err)
+
+	msg.CoSigners = []string{testCoSigner}
+	signers, _, err = encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{expSigner.Bytes(), expCoSigner.Bytes()}, signers)
 }
 
 func TestMsgSendTxValidateBasic(t *testing.T) {
@@ -150,6 +185,35 @@ func TestMsgSendTxValidateBasic(t *testing.T) {
 			},
 			icatypes.ErrInvalidOutgoingData,
 		},
+		{
+			"success: with port id and co-signers",
+			func() {
+				msg.PortId = testPortID
+				msg.CoSigners = []string{testCoSigner}
+			},
+			nil,
+		},
+		{
+			"port id is not a controller port",
+			func() {
+				msg.PortId = icatypes.HostPortID
+			},
+			icatypes.ErrInvalidControllerPort,
+		},
+		{
+			"co-signer is the owner",
+			func() {
+				msg.CoSigners = []string{ibctesting.TestAccAddress}
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"co-signer is empty",
+			func() {
+				msg.CoSigners = []string{""}
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -215,7 +279,15 @@ func TestMsgSendTxGetSigners(t *testing.T) {
 	)
 	signers, _, err := encodingConfig.Codec.GetMsgV1Signers(msg)
 	require.NoError(t, err)
-	require.Equal(t, expSigner.Bytes(), signers[0])
+	require.Equal(t, [][]byte{expSigner.Bytes()}, signers)
+
+	expCoSigner, err := sdk.AccAddressFromBech32(testCoSigner)
+	require.NoError(t, err)
+
+	msg.CoSigners = []string{testCoSigner}
+	signers, _, err = encodingConfig.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{expSigner.Bytes(), expCoSigner.Bytes()}, signers)
 }
 
 // TestMsgUpdateParamsValidateBasic tests ValidateBasic for MsgUpdateParams
@@ -267,3 +339,132 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgTransferOwnershipValidateBasic(t *testing.T) {
+	var msg *types.MsgTransferOwnership
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: with co-signers and threshold of two owners",
+			func() {
+				msg.CoSigners = []string{testCoSigner}
+				msg.NewOwners = []string{ibctesting.TestAccAddress, testCoSigner}
+				msg.Threshold = 2
+			},
+			nil,
+		},
+		{
+			"owner address is empty",
+			func() {
+				msg.Owner = ""
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"duplicate co-signer",
+			func() {
+				msg.CoSigners = []string{testCoSigner, testCoSigner}
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"port id is not a controller port",
+			func() {
+				msg.PortId = icatypes.HostPortID
+			},
+			icatypes.ErrInvalidControllerPort,
+		},
+		{
+			"connection id is invalid",
+			func() {
+				msg.ConnectionId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"success: client id of an interchain account controlled over IBC v2",
+			func() {
+				msg = types.NewMsgTransferClientOwnership(msg.Owner, nil, msg.PortId, ibctesting.FirstClientID, msg.NewOwners, msg.Threshold)
+			},
+			nil,
+		},
+		{
+			"connection id and client id are both set",
+			func() {
+				msg.ClientId = ibctesting.FirstClientID
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"new owners are empty",
+			func() {
+				msg.NewOwners = nil
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"new owner is not an address",
+			func() {
+				msg.NewOwners = []string{"invalid"}
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"duplicate new owner",
+			func() {
+				msg.NewOwners = []string{testCoSigner, testCoSigner}
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"threshold is zero",
+			func() {
+				msg.Threshold = 0
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"threshold exceeds the number of new owners",
+			func() {
+				msg.Threshold = 2
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgTransferOwnership(ibctesting.TestAccAddress, nil, testPortID, ibctesting.FirstConnectionID, []string{testCoSigner}, 1)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgTransferOwnershipGetSigners(t *testing.T) {
+	expSigner, err := sdk.AccAddressFromBech32(ibctesting.TestAccAddress)
+	require.NoError(t, err)
+	expCoSigner, err := sdk.AccAddressFromBech32(testCoSigner)
+	require.NoError(t, err)
+
+	msg := types.NewMsgTransferOwnership(ibctesting.TestAccAddress, []string{testCoSigner}, testPortID, ibctesting.FirstConnectionID, []string{testCoSigner}, 1)
+
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(ica.AppModuleBasic{})
+	signers, _, err := encodingConfig.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{expSigner.Bytes(), expCoSigner.Bytes()}, signers)
+}
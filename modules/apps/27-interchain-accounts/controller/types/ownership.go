@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v11/modules/core/03-connection/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+)
+
+// NewInterchainAccountOwnership creates a new InterchainAccountOwnership instance
+func NewInterchainAccountOwnership(portID, connectionID string, owners []string, threshold uint32) InterchainAccountOwnership {
+	return InterchainAccountOwnership{
+		PortId:       portID,
+		ConnectionId: connectionID,
+		Owners:       owners,
+		Threshold:    threshold,
+	}
+}
+
+// NewInterchainAccountClientOwnership creates a new InterchainAccountOwnership instance for an interchain account
+// controlled over IBC v2 from the provided source clientID
+func NewInterchainAccountClientOwnership(portID, clientID string, owners []string, threshold uint32) InterchainAccountOwnership {
+	return InterchainAccountOwnership{
+		PortId:    portID,
+		ClientId:  clientID,
+		Owners:    owners,
+		Threshold: threshold,
+	}
+}
+
+// DefaultInterchainAccountOwnership returns the ownership of an interchain account which has not been transferred,
+// controlled by the owner of its controller port alone.
+func DefaultInterchainAccountOwnership(portID, connectionID string) InterchainAccountOwnership {
+	return NewInterchainAccountOwnership(portID, connectionID, []string{strings.TrimPrefix(portID, icatypes.ControllerPortPrefix)}, 1)
+}
+
+// DefaultInterchainAccountClientOwnership returns the ownership of an interchain account controlled over IBC v2 which
+// has not been transferred, controlled by the owner of its controller port alone.
+func DefaultInterchainAccountClientOwnership(portID, clientID string) InterchainAccountOwnership {
+	return NewInterchainAccountClientOwnership(portID, clientID, []string{strings.TrimPrefix(portID, icatypes.ControllerPortPrefix)}, 1)
+}
+
+// GetConnectionOrClientID returns the connectionID of the interchain account of the ownership, or its source clientID
+// for interchain accounts controlled over IBC v2.
+func (o InterchainAccountOwnership) GetConnectionOrClientID() string {
+	if o.ConnectionId != "" {
+		return o.ConnectionId
+	}
+
+	return o.ClientId
+}
+
+// Validate performs basic validation of the ownership. The owners must be valid and unique account addresses, and
+// the threshold must be reachable by the owners.
+func (o InterchainAccountOwnership) Validate() error {
+	if err := ValidateControllerPortID(o.PortId); err != nil {
+		return err
+	}
+
+	if o.ClientId != "" {
+		if o.ConnectionId != "" {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "connection ID and client ID cannot both be set")
+		}
+
+		if !clienttypes.IsValidClientID(o.ClientId) {
+			return errorsmod.Wrapf(host.ErrInvalidID, "invalid client ID %s", o.ClientId)
+		}
+	} else if err := host.ConnectionIdentifierValidator(o.ConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid connection ID")
+	}
+
+	if err := validateOwners(o.Owners); err != nil {
+		return err
+	}
+
+	if o.Threshold == 0 || int(o.Threshold) > len(o.Owners) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "threshold must be between 1 and the number of owners %d, got %d", len(o.Owners), o.Threshold)
+	}
+
+	return nil
+}
+
+// Authorize returns an error if the distinct signers amongst the owners do not reach the threshold of the ownership
+func (o InterchainAccountOwnership) Authorize(signers []string) error {
+	var approvals []string
+	for _, signer := range signers {
+		if slices.Contains(o.Owners, signer) && !slices.Contains(approvals, signer) {
+			approvals = append(approvals, signer)
+		}
+	}
+
+	if len(approvals) < int(o.Threshold) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%d of the %d owners required to control the interchain account of port %s on %s signed", len(approvals), o.Threshold, o.PortId, o.GetConnectionOrClientID())
+	}
+
+	return nil
+}
+
+// IsClientIdentifier returns true if the provided identifier of an interchain account is the source client identifier
+// of an interchain account controlled over IBC v2 rather than a connection identifier
+func IsClientIdentifier(connectionOrClientID string) bool {
+	return !strings.HasPrefix(connectionOrClientID, connectiontypes.ConnectionPrefix) && clienttypes.IsValidClientID(connectionOrClientID)
+}
+
+// ValidateControllerPortID validates the identifier of the controller port of an interchain account
+func ValidateControllerPortID(portID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !strings.HasPrefix(portID, icatypes.ControllerPortPrefix) {
+		return errorsmod.Wrapf(icatypes.ErrInvalidControllerPort, "expected %s{owner-account-address}, got %s", icatypes.ControllerPortPrefix, portID)
+	}
+
+	return nil
+}
+
+// validateOwners validates that the owners are a non-empty list of unique account addresses
+func validateOwners(owners []string) error {
+	if len(owners) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owners cannot be empty")
+	}
+
+	for i, owner := range owners {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+		}
+
+		if slices.Contains(owners[:i], owner) {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "duplicate owner %s", owner)
+		}
+	}
+
+	return nil
+}
+
+// ValidateOwnerships validates the ownerships and ensures at most one ownership is set per port and connection or client
+func ValidateOwnerships(ownerships []InterchainAccountOwnership) error {
+	accounts := make(map[string]bool, len(ownerships))
+	for _, ownership := range ownerships {
+		if err := ownership.Validate(); err != nil {
+			return err
+		}
+
+		account := ownership.PortId + "/" + ownership.GetConnectionOrClientID()
+		if accounts[account] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate ownership for port %s on %s", ownership.PortId, ownership.GetConnectionOrClientID())
+		}
+		accounts[account] = true
+	}
+
+	return nil
+}
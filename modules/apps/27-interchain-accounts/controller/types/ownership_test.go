@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func TestDefaultInterchainAccountOwnership(t *testing.T) {
+	ownership := types.DefaultInterchainAccountOwnership(testPortID, ibctesting.FirstConnectionID)
+
+	require.Equal(t, []string{ibctesting.TestAccAddress}, ownership.Owners)
+	require.Equal(t, uint32(1), ownership.Threshold)
+	require.NoError(t, ownership.Validate())
+}
+
+func TestInterchainAccountOwnershipValidate(t *testing.T) {
+	var ownership types.InterchainAccountOwnership
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: threshold of all owners",
+			func() {
+				ownership.Threshold = 2
+			},
+			nil,
+		},
+		{
+			"port id is not a controller port",
+			func() {
+				ownership.PortId = icatypes.HostPortID
+			},
+			icatypes.ErrInvalidControllerPort,
+		},
+		{
+			"port id is invalid",
+			func() {
+				ownership.PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"connection id is invalid",
+			func() {
+				ownership.ConnectionId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"success: client id of an interchain account controlled over IBC v2",
+			func() {
+				ownership = types.NewInterchainAccountClientOwnership(testPortID, ibctesting.FirstClientID, ownership.Owners, ownership.Threshold)
+			},
+			nil,
+		},
+		{
+			"client id is invalid",
+			func() {
+				ownership = types.NewInterchainAccountClientOwnership(testPortID, ibctesting.InvalidID, ownership.Owners, ownership.Threshold)
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"connection id and client id are both set",
+			func() {
+				ownership.ClientId = ibctesting.FirstClientID
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"owners are empty",
+			func() {
+				ownership.Owners = nil
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"owner is not an address",
+			func() {
+				ownership.Owners = []string{"invalid"}
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"duplicate owner",
+			func() {
+				ownership.Owners = []string{testCoSigner, testCoSigner}
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"threshold is zero",
+			func() {
+				ownership.Threshold = 0
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"threshold exceeds the number of owners",
+			func() {
+				ownership.Threshold = 3
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ownership = types.NewInterchainAccountOwnership(testPortID, ibctesting.FirstConnectionID, []string{ibctesting.TestAccAddress, testCoSigner}, 1)
+
+			tc.malleate()
+
+			err := ownership.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestInterchainAccountOwnershipAuthorize(t *testing.T) {
+	ownership := types.NewInterchainAccountOwnership(testPortID, ibctesting.FirstConnectionID, []string{ibctesting.TestAccAddress, testCoSigner}, 2)
+
+	testCases := []struct {
+		name    string
+		signers []string
+		expErr  error
+	}{
+		{
+			"success: all owners signed",
+			[]string{ibctesting.TestAccAddress, testCoSigner},
+			nil,
+		},
+		{
+			"threshold not reached",
+			[]string{ibctesting.TestAccAddress},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"duplicate signers are counted once",
+			[]string{testCoSigner, testCoSigner},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"signer is not an owner",
+			[]string{ibctesting.TestAccAddress, testPortID},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ownership.Authorize(tc.signers)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestValidateOwnerships(t *testing.T) {
+	ownership := types.NewInterchainAccountOwnership(testPortID, ibctesting.FirstConnectionID, []string{testCoSigner}, 1)
+
+	clientOwnership := types.NewInterchainAccountClientOwnership(testPortID, ibctesting.FirstClientID, []string{testCoSigner}, 1)
+
+	require.NoError(t, types.ValidateOwnerships([]types.InterchainAccountOwnership{ownership, clientOwnership}))
+	require.ErrorIs(t, types.ValidateOwnerships([]types.InterchainAccountOwnership{ownership, ownership}), ibcerrors.ErrInvalidRequest)
+	require.ErrorIs(t, types.ValidateOwnerships([]types.InterchainAccountOwnership{clientOwnership, clientOwnership}), ibcerrors.ErrInvalidRequest)
+}
+
+func TestIsClientIdentifier(t *testing.T) {
+	require.True(t, types.IsClientIdentifier(ibctesting.FirstClientID))
+	require.False(t, types.IsClientIdentifier(ibctesting.FirstConnectionID))
+	require.False(t, types.IsClientIdentifier(ibctesting.InvalidID))
+}
@@ -5,12 +5,25 @@ package types
 const (
 	// DefaultControllerEnabled is the default value for the controller param (set to true)
 	DefaultControllerEnabled = true
+
+	// DefaultMaxTxResults is the default value for the max tx results param
+	DefaultMaxTxResults = 100
+
+	// DefaultReopenClosedChannels is the default value for the reopen closed channels param (set to false)
+	DefaultReopenClosedChannels = false
+
+	// DefaultMaxQueuedTxs is the default value for the max queued txs param
+	DefaultMaxQueuedTxs = 10
 )
 
-// NewParams creates a new parameter configuration for the controller submodule
+// NewParams creates a new parameter configuration for the controller submodule. The remaining params are set to
+// their default values.
 func NewParams(enableController bool) Params {
 	return Params{
-		ControllerEnabled: enableController,
+		ControllerEnabled:    enableController,
+		MaxTxResults:         DefaultMaxTxResults,
+		ReopenClosedChannels: DefaultReopenClosedChannels,
+		MaxQueuedTxs:         DefaultMaxQueuedTxs,
 	}
 }
 
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -209,11 +211,359 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryTxResultRequest is the request type for the Query/TxResult RPC method.
+type QueryTxResultRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection identifier of the transactions, or the source client identifier for transactions sent over IBC v2
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Sequence     uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// channel identifier of the transaction, empty for transactions sent over IBC v2
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryTxResultRequest) Reset()         { *m = QueryTxResultRequest{} }
+func (m *QueryTxResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxResultRequest) ProtoMessage()    {}
+func (*QueryTxResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{4}
+}
+func (m *QueryTxResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxResultRequest.Merge(m, src)
+}
+func (m *QueryTxResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxResultRequest proto.InternalMessageInfo
+
+func (m *QueryTxResultRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryTxResultRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryTxResultRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *QueryTxResultRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryTxResultResponse is the response type for the Query/TxResult RPC method.
+type QueryTxResultResponse struct {
+	TxResult TxResult `protobuf:"bytes,1,opt,name=tx_result,json=txResult,proto3" json:"tx_result"`
+}
+
+func (m *QueryTxResultResponse) Reset()         { *m = QueryTxResultResponse{} }
+func (m *QueryTxResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxResultResponse) ProtoMessage()    {}
+func (*QueryTxResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{5}
+}
+func (m *QueryTxResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxResultResponse.Merge(m, src)
+}
+func (m *QueryTxResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxResultResponse proto.InternalMessageInfo
+
+func (m *QueryTxResultResponse) GetTxResult() TxResult {
+	if m != nil {
+		return m.TxResult
+	}
+	return TxResult{}
+}
+
+// QueryTxResultsRequest is the request type for the Query/TxResults RPC method.
+type QueryTxResultsRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection identifier of the transactions, or the source client identifier for transactions sent over IBC v2
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// optional channel identifier to only return the results of the transactions sent on the given channel
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryTxResultsRequest) Reset()         { *m = QueryTxResultsRequest{} }
+func (m *QueryTxResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxResultsRequest) ProtoMessage()    {}
+func (*QueryTxResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{6}
+}
+func (m *QueryTxResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxResultsRequest.Merge(m, src)
+}
+func (m *QueryTxResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxResultsRequest proto.InternalMessageInfo
+
+func (m *QueryTxResultsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryTxResultsRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryTxResultsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryTxResultsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryTxResultsResponse is the response type for the Query/TxResults RPC method.
+type QueryTxResultsResponse struct {
+	TxResults []TxResult `protobuf:"bytes,1,rep,name=tx_results,json=txResults,proto3" json:"tx_results"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTxResultsResponse) Reset()         { *m = QueryTxResultsResponse{} }
+func (m *QueryTxResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxResultsResponse) ProtoMessage()    {}
+func (*QueryTxResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{7}
+}
+func (m *QueryTxResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxResultsResponse.Merge(m, src)
+}
+func (m *QueryTxResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxResultsResponse proto.InternalMessageInfo
+
+func (m *QueryTxResultsResponse) GetTxResults() []TxResult {
+	if m != nil {
+		return m.TxResults
+	}
+	return nil
+}
+
+func (m *QueryTxResultsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOwnershipRequest is the request type for the Query/Ownership RPC method.
+type QueryOwnershipRequest struct {
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// connection identifier of the interchain account, or the source client identifier of an interchain account
+	// controlled over IBC v2
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *QueryOwnershipRequest) Reset()         { *m = QueryOwnershipRequest{} }
+func (m *QueryOwnershipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnershipRequest) ProtoMessage()    {}
+func (*QueryOwnershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{8}
+}
+func (m *QueryOwnershipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnershipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnershipRequest.Merge(m, src)
+}
+func (m *QueryOwnershipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnershipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnershipRequest proto.InternalMessageInfo
+
+func (m *QueryOwnershipRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryOwnershipRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryOwnershipResponse is the response type for the Query/Ownership RPC method.
+type QueryOwnershipResponse struct {
+	Ownership InterchainAccountOwnership `protobuf:"bytes,1,opt,name=ownership,proto3" json:"ownership"`
+}
+
+func (m *QueryOwnershipResponse) Reset()         { *m = QueryOwnershipResponse{} }
+func (m *QueryOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnershipResponse) ProtoMessage()    {}
+func (*QueryOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{9}
+}
+func (m *QueryOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnershipResponse.Merge(m, src)
+}
+func (m *QueryOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnershipResponse proto.InternalMessageInfo
+
+func (m *QueryOwnershipResponse) GetOwnership() InterchainAccountOwnership {
+	if m != nil {
+		return m.Ownership
+	}
+	return InterchainAccountOwnership{}
+}
+
 func init() {
 	proto.RegisterType((*QueryInterchainAccountRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountRequest")
 	proto.RegisterType((*QueryInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryTxResultRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryTxResultRequest")
+	proto.RegisterType((*QueryTxResultResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryTxResultResponse")
+	proto.RegisterType((*QueryTxResultsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryTxResultsRequest")
+	proto.RegisterType((*QueryTxResultsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryTxResultsResponse")
+	proto.RegisterType((*QueryOwnershipRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryOwnershipRequest")
+	proto.RegisterType((*QueryOwnershipResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryOwnershipResponse")
 }
 
 func init() {
@@ -221,35 +571,57 @@ func init() {
 }
 
 var fileDescriptor_df0d8b259d72854e = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x8b, 0xd4, 0x30,
-	0x14, 0xc7, 0xa7, 0x23, 0x3b, 0x62, 0xd4, 0x83, 0x71, 0x0f, 0x43, 0xd1, 0x22, 0xf5, 0xe2, 0x65,
-	0x12, 0x5a, 0x05, 0x61, 0x0e, 0x82, 0x0a, 0xca, 0xde, 0x9c, 0x1e, 0x44, 0xf6, 0xe0, 0x92, 0xa6,
-	0xa1, 0x1b, 0x6c, 0xf3, 0xba, 0x49, 0x3a, 0xb2, 0x2c, 0x7b, 0xf1, 0x13, 0x08, 0xde, 0xfc, 0x44,
-	0x1e, 0x17, 0x44, 0xf0, 0x28, 0x33, 0x7e, 0x0c, 0x0f, 0x32, 0x69, 0xb4, 0x3b, 0xb8, 0x8a, 0x3b,
-	0x7a, 0x2a, 0x79, 0xe1, 0xfd, 0xfe, 0xef, 0xfd, 0xff, 0x29, 0x7a, 0x20, 0x73, 0x4e, 0x59, 0xd3,
-	0x54, 0x92, 0x33, 0x2b, 0x41, 0x19, 0x2a, 0x95, 0x15, 0x9a, 0xef, 0x33, 0xa9, 0xf6, 0x18, 0xe7,
-	0xd0, 0x2a, 0x6b, 0x28, 0x07, 0x65, 0x35, 0x54, 0x95, 0xd0, 0x74, 0x9e, 0xd0, 0x83, 0x56, 0xe8,
-	0x43, 0xd2, 0x68, 0xb0, 0x80, 0x53, 0x99, 0x73, 0x72, 0xba, 0x9f, 0x9c, 0xd1, 0x4f, 0xfa, 0x7e,
-	0x32, 0x4f, 0xc2, 0xc7, 0x1b, 0x68, 0x9e, 0x22, 0x38, 0xe1, 0xf0, 0x46, 0x09, 0x50, 0x56, 0x82,
-	0xb2, 0x46, 0x52, 0xa6, 0x14, 0x58, 0x2f, 0xef, 0x6e, 0xe3, 0x5d, 0x74, 0x73, 0xb6, 0x9a, 0x72,
-	0xe7, 0x27, 0xf8, 0x61, 0xc7, 0xcd, 0xc4, 0x41, 0x2b, 0x8c, 0xc5, 0xdb, 0x68, 0x0b, 0x5e, 0x2b,
-	0xa1, 0xc7, 0xc1, 0xad, 0xe0, 0xce, 0xa5, 0xac, 0x3b, 0xe0, 0xdb, 0xe8, 0x2a, 0x07, 0xa5, 0x04,
-	0x5f, 0xb1, 0xf6, 0x64, 0x31, 0x1e, 0xba, 0xdb, 0x2b, 0x7d, 0x71, 0xa7, 0x88, 0xa7, 0x28, 0xfa,
-	0x1d, 0xdb, 0x34, 0xa0, 0x8c, 0xc0, 0x63, 0x74, 0x91, 0x15, 0x85, 0x16, 0xc6, 0x78, 0xfc, 0x8f,
-	0x63, 0xbc, 0x8d, 0xb0, 0xeb, 0x7d, 0xc6, 0x34, 0xab, 0x8d, 0x1f, 0x26, 0x96, 0xe8, 0xfa, 0x5a,
-	0xd5, 0x63, 0x32, 0x34, 0x6a, 0x5c, 0xc5, 0x51, 0x2e, 0xa7, 0x53, 0x72, 0x7e, 0xb3, 0x89, 0x67,
-	0x7a, 0x52, 0xfa, 0xed, 0x02, 0xda, 0x72, 0x5a, 0xf8, 0xfd, 0x10, 0x5d, 0xfb, 0x65, 0x05, 0x3c,
-	0xdb, 0x44, 0xe3, 0x8f, 0x56, 0x87, 0xd9, 0xff, 0x44, 0x76, 0xd6, 0xc4, 0x2f, 0xdf, 0x7c, 0xfc,
-	0xfa, 0x6e, 0xf8, 0x02, 0x3f, 0xa7, 0xfe, 0x2d, 0xfd, 0xcd, 0x1b, 0x72, 0x19, 0x1b, 0x7a, 0xe4,
-	0xbe, 0xc7, 0xb4, 0x0f, 0xd5, 0xd0, 0xa3, 0xb5, 0xd8, 0x8f, 0xf1, 0xa7, 0x00, 0x8d, 0x3a, 0xe7,
-	0xf0, 0x93, 0x8d, 0xc7, 0x5f, 0x0b, 0x39, 0x7c, 0xfa, 0xcf, 0x1c, 0xbf, 0xfb, 0xd4, 0xed, 0x7e,
-	0x0f, 0xa7, 0xe7, 0xd9, 0xbd, 0x8b, 0xff, 0xd1, 0xab, 0x0f, 0x8b, 0x28, 0x38, 0x59, 0x44, 0xc1,
-	0x97, 0x45, 0x14, 0xbc, 0x5d, 0x46, 0x83, 0x93, 0x65, 0x34, 0xf8, 0xbc, 0x8c, 0x06, 0xbb, 0xb3,
-	0x52, 0xda, 0xfd, 0x36, 0x27, 0x1c, 0x6a, 0xca, 0xc1, 0xd4, 0x60, 0x56, 0xf8, 0x49, 0x09, 0x74,
-	0x9e, 0x24, 0xb4, 0x86, 0xa2, 0xad, 0x84, 0xe9, 0xd4, 0xd2, 0xfb, 0x93, 0x5e, 0x70, 0x72, 0x96,
-	0xa0, 0x3d, 0x6c, 0x84, 0xc9, 0x47, 0xee, 0x5f, 0xbc, 0xfb, 0x3d, 0x00, 0x00, 0xff, 0xff, 0x7b,
-	0x3f, 0x4b, 0x8f, 0x64, 0x04, 0x00, 0x00,
+	// 785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6b, 0x13, 0x4d,
+	0x18, 0xce, 0xa6, 0x6d, 0xda, 0x4c, 0xbf, 0xef, 0xf0, 0xcd, 0x97, 0xef, 0x33, 0x04, 0x1b, 0xcb,
+	0x0a, 0x5a, 0x84, 0xee, 0x90, 0x28, 0x08, 0x45, 0x04, 0x2b, 0xb4, 0xa6, 0x07, 0x6d, 0x17, 0x15,
+	0xe9, 0xc1, 0x30, 0x99, 0x0c, 0x9b, 0xc5, 0xcd, 0xcc, 0x76, 0x67, 0x12, 0x5b, 0x42, 0x2f, 0x9e,
+	0x2b, 0x08, 0xe2, 0xc5, 0xbf, 0xc3, 0x8b, 0x77, 0x0f, 0x3d, 0x16, 0x44, 0xf0, 0x24, 0xd2, 0xfa,
+	0x87, 0xc8, 0xce, 0xce, 0x6e, 0x7e, 0xb4, 0xf6, 0x47, 0x92, 0x9e, 0xba, 0x33, 0xdb, 0xf7, 0x79,
+	0x9f, 0xe7, 0x79, 0x67, 0x9e, 0x2c, 0xb8, 0xef, 0xd6, 0x08, 0xc2, 0xbe, 0xef, 0xb9, 0x04, 0x4b,
+	0x97, 0x33, 0x81, 0x5c, 0x26, 0x69, 0x40, 0x1a, 0xd8, 0x65, 0x55, 0x4c, 0x08, 0x6f, 0x31, 0x29,
+	0x10, 0xe1, 0x4c, 0x06, 0xdc, 0xf3, 0x68, 0x80, 0xda, 0x25, 0xb4, 0xd5, 0xa2, 0xc1, 0x8e, 0xe5,
+	0x07, 0x5c, 0x72, 0x58, 0x76, 0x6b, 0xc4, 0xea, 0xad, 0xb7, 0x4e, 0xa8, 0xb7, 0xba, 0xf5, 0x56,
+	0xbb, 0x54, 0x78, 0x38, 0x44, 0xcf, 0x1e, 0x04, 0xd5, 0xb8, 0x70, 0x8b, 0x70, 0xd1, 0xe4, 0x02,
+	0xd5, 0xb0, 0xa0, 0x11, 0x23, 0xd4, 0x2e, 0xd5, 0xa8, 0xc4, 0x25, 0xe4, 0x63, 0xc7, 0x65, 0x0a,
+	0x59, 0xff, 0x6f, 0xce, 0xe1, 0x0e, 0x57, 0x8f, 0x28, 0x7c, 0xd2, 0xbb, 0x57, 0x1d, 0xce, 0x1d,
+	0x8f, 0x22, 0xec, 0xbb, 0x08, 0x33, 0xc6, 0xa5, 0x16, 0xa0, 0xde, 0x9a, 0x9b, 0x60, 0x6e, 0x23,
+	0x44, 0xad, 0x24, 0xd4, 0x1e, 0x44, 0xcc, 0x6c, 0xba, 0xd5, 0xa2, 0x42, 0xc2, 0x1c, 0x98, 0xe2,
+	0xaf, 0x19, 0x0d, 0xf2, 0xc6, 0xbc, 0xb1, 0x90, 0xb5, 0xa3, 0x05, 0xbc, 0x0e, 0xfe, 0x26, 0x9c,
+	0x31, 0x4a, 0x42, 0xac, 0xaa, 0x5b, 0xcf, 0xa7, 0xd5, 0xdb, 0xbf, 0xba, 0x9b, 0x95, 0xba, 0xb9,
+	0x04, 0x8a, 0x7f, 0xc2, 0x16, 0x3e, 0x67, 0x82, 0xc2, 0x3c, 0x98, 0xc6, 0xf5, 0x7a, 0x40, 0x85,
+	0xd0, 0xf0, 0xf1, 0xd2, 0xcc, 0x01, 0xa8, 0x6a, 0xd7, 0x71, 0x80, 0x9b, 0x42, 0x93, 0x31, 0x5d,
+	0xf0, 0x6f, 0xdf, 0xae, 0x86, 0xb1, 0x41, 0xc6, 0x57, 0x3b, 0x0a, 0x65, 0xb6, 0xbc, 0x64, 0x5d,
+	0x7c, 0x5c, 0x96, 0xc6, 0xd4, 0x48, 0xe6, 0x9e, 0x01, 0x72, 0xaa, 0xd7, 0xd3, 0x6d, 0x9b, 0x8a,
+	0x96, 0x37, 0x06, 0x43, 0x60, 0x01, 0xcc, 0x88, 0x10, 0x85, 0x11, 0x9a, 0x9f, 0x98, 0x37, 0x16,
+	0x26, 0xed, 0x64, 0x0d, 0xe7, 0x00, 0x20, 0x0d, 0xcc, 0x18, 0xf5, 0xc2, 0xea, 0x49, 0x55, 0x9d,
+	0xd5, 0x3b, 0x95, 0xba, 0xb9, 0x0d, 0xfe, 0x1b, 0x60, 0xa3, 0xb5, 0x57, 0x41, 0x56, 0x6e, 0x57,
+	0x03, 0xb5, 0xa9, 0xe5, 0xdf, 0x1b, 0x46, 0x7e, 0x0c, 0xbc, 0x3c, 0xb9, 0xff, 0xe3, 0x5a, 0xca,
+	0x9e, 0x91, 0x7a, 0x6d, 0x7e, 0x36, 0x06, 0x5a, 0x8b, 0x31, 0x38, 0xb1, 0x02, 0x40, 0xf7, 0xf8,
+	0x2a, 0x2f, 0x66, 0xcb, 0x37, 0xac, 0xe8, 0xac, 0x5b, 0xe1, 0x59, 0xb7, 0xa2, 0xdb, 0xa7, 0xcf,
+	0xba, 0xb5, 0x8e, 0x1d, 0xaa, 0xdb, 0xda, 0x3d, 0x95, 0x67, 0xb9, 0xf6, 0xc5, 0x00, 0xff, 0x0f,
+	0x72, 0xd7, 0xbe, 0x61, 0x00, 0x12, 0xdf, 0xc2, 0x73, 0x33, 0x31, 0x26, 0xe3, 0xb2, 0xb1, 0x71,
+	0x02, 0xae, 0xf6, 0x89, 0x4c, 0x2b, 0x91, 0x37, 0xcf, 0x14, 0x19, 0xf1, 0xeb, 0x55, 0x69, 0x3e,
+	0xd3, 0x13, 0x78, 0x12, 0x1a, 0x2c, 0x1a, 0xae, 0x1f, 0x4f, 0xe0, 0x0a, 0x98, 0xf6, 0x79, 0x20,
+	0x43, 0xed, 0xd1, 0x0c, 0x32, 0xe1, 0xb2, 0x52, 0x3f, 0xdf, 0xfd, 0xdc, 0x8b, 0xdd, 0xe9, 0xc1,
+	0xd5, 0xee, 0x04, 0x20, 0xcb, 0xe3, 0x4d, 0x7d, 0xaa, 0x1e, 0x0f, 0x63, 0xce, 0xb1, 0xab, 0x9f,
+	0xb4, 0x8a, 0xed, 0x4a, 0xda, 0x94, 0x3f, 0x01, 0x30, 0xa5, 0xe8, 0xc0, 0x8f, 0x69, 0xf0, 0xcf,
+	0xb1, 0x4a, 0xb8, 0x31, 0x0c, 0x81, 0x53, 0xc3, 0xad, 0x60, 0x8f, 0x13, 0x32, 0xb2, 0xce, 0x7c,
+	0xf9, 0xe6, 0xeb, 0xaf, 0xf7, 0xe9, 0x17, 0xf0, 0x39, 0xd2, 0xf9, 0x7f, 0x9e, 0xdc, 0x8f, 0x5c,
+	0x40, 0x1d, 0xf5, 0x77, 0x17, 0x75, 0xc7, 0x24, 0x50, 0xa7, 0x6f, 0x90, 0xbb, 0xf0, 0x9b, 0x01,
+	0x32, 0x51, 0x56, 0xc1, 0x95, 0xa1, 0xe9, 0xf7, 0xc5, 0x6a, 0x61, 0x75, 0x64, 0x1c, 0xad, 0x7d,
+	0x49, 0x69, 0xbf, 0x03, 0xcb, 0x17, 0xd1, 0x1e, 0x05, 0x2e, 0xfc, 0x90, 0x06, 0x33, 0xf1, 0x5d,
+	0x82, 0x8f, 0x86, 0x66, 0x34, 0x10, 0xd7, 0x85, 0xca, 0x18, 0x90, 0xb4, 0x3a, 0xa9, 0xd4, 0x31,
+	0xe8, 0x5d, 0xce, 0x64, 0x51, 0x37, 0x8f, 0x50, 0x27, 0xfe, 0x5d, 0xd8, 0x85, 0x6f, 0xd3, 0x20,
+	0x9b, 0xc4, 0x17, 0x1c, 0x5d, 0x4e, 0x32, 0xf5, 0xb5, 0x71, 0x40, 0x69, 0x6b, 0x5c, 0x65, 0x0d,
+	0x81, 0xf8, 0xd2, 0xad, 0x51, 0x7e, 0x24, 0x29, 0x32, 0x82, 0x1f, 0x83, 0x61, 0x5a, 0x58, 0x1b,
+	0x07, 0xd4, 0x28, 0x7e, 0x84, 0xd9, 0x2d, 0x50, 0x47, 0x27, 0xfa, 0xe9, 0x86, 0x24, 0xb1, 0xb9,
+	0xfc, 0x6a, 0xff, 0xb0, 0x68, 0x1c, 0x1c, 0x16, 0x8d, 0x9f, 0x87, 0x45, 0xe3, 0xdd, 0x51, 0x31,
+	0x75, 0x70, 0x54, 0x4c, 0x7d, 0x3f, 0x2a, 0xa6, 0x36, 0x37, 0x1c, 0x57, 0x36, 0x5a, 0x35, 0x8b,
+	0xf0, 0x26, 0xd2, 0x9f, 0x91, 0x6e, 0x8d, 0x2c, 0x3a, 0x1c, 0xb5, 0x4b, 0x25, 0xd4, 0xe4, 0xf5,
+	0x96, 0x47, 0x45, 0x44, 0xae, 0x7c, 0x77, 0xb1, 0xcb, 0x6f, 0xf1, 0x24, 0x7e, 0x72, 0xc7, 0xa7,
+	0xa2, 0x96, 0x51, 0x5f, 0x8d, 0xb7, 0x7f, 0x0f, 0x00, 0x5b, 0x50, 0x7d, 0xc0, 0x50, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +640,13 @@ type QueryClient interface {
 	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TxResult returns the result of a transaction sent by an owner on a given connection
+	TxResult(ctx context.Context, in *QueryTxResultRequest, opts ...grpc.CallOption) (*QueryTxResultResponse, error)
+	// TxResults returns the stored results of the transactions sent by an owner on a given connection
+	TxResults(ctx context.Context, in *QueryTxResultsRequest, opts ...grpc.CallOption) (*QueryTxResultsResponse, error)
+	// Ownership returns the owners authorized to control the interchain account of a given controller port on a given
+	// connection
+	Ownership(ctx context.Context, in *QueryOwnershipRequest, opts ...grpc.CallOption) (*QueryOwnershipResponse, error)
 }
 
 type queryClient struct {
@@ -296,12 +675,46 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TxResult(ctx context.Context, in *QueryTxResultRequest, opts ...grpc.CallOption) (*QueryTxResultResponse, error) {
+	out := new(QueryTxResultResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/TxResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxResults(ctx context.Context, in *QueryTxResultsRequest, opts ...grpc.CallOption) (*QueryTxResultsResponse, error) {
+	out := new(QueryTxResultsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/TxResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Ownership(ctx context.Context, in *QueryOwnershipRequest, opts ...grpc.CallOption) (*QueryOwnershipResponse, error) {
+	out := new(QueryOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/Ownership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// InterchainAccount returns the interchain account address for a given owner address on a given connection
 	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TxResult returns the result of a transaction sent by an owner on a given connection
+	TxResult(context.Context, *QueryTxResultRequest) (*QueryTxResultResponse, error)
+	// TxResults returns the stored results of the transactions sent by an owner on a given connection
+	TxResults(context.Context, *QueryTxResultsRequest) (*QueryTxResultsResponse, error)
+	// Ownership returns the owners authorized to control the interchain account of a given controller port on a given
+	// connection
+	Ownership(context.Context, *QueryOwnershipRequest) (*QueryOwnershipResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -314,6 +727,15 @@ func (*UnimplementedQueryServer) InterchainAccount(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) TxResult(ctx context.Context, req *QueryTxResultRequest) (*QueryTxResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxResult not implemented")
+}
+func (*UnimplementedQueryServer) TxResults(ctx context.Context, req *QueryTxResultsRequest) (*QueryTxResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxResults not implemented")
+}
+func (*UnimplementedQueryServer) Ownership(ctx context.Context, req *QueryOwnershipRequest) (*QueryOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ownership not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -355,35 +777,101 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "InterchainAccount",
-			Handler:    _Query_InterchainAccount_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/applications/interchain_accounts/controller/v1/query.proto",
-}
-
-func (m *QueryInterchainAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TxResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxResultRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TxResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/TxResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxResult(ctx, req.(*QueryTxResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInterchainAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func _Query_TxResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/TxResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxResults(ctx, req.(*QueryTxResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Ownership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/Ownership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Ownership(ctx, req.(*QueryOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InterchainAccount",
+			Handler:    _Query_InterchainAccount_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "TxResult",
+			Handler:    _Query_TxResult_Handler,
+		},
+		{
+			MethodName: "TxResults",
+			Handler:    _Query_TxResults_Handler,
+		},
+		{
+			MethodName: "Ownership",
+			Handler:    _Query_Ownership_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/interchain_accounts/controller/v1/query.proto",
+}
+
+func (m *QueryInterchainAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -498,6 +986,263 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTxResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TxResult.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxResults) > 0 {
+		for iNdEx := len(m.TxResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Ownership.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -543,31 +1288,718 @@ func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TxResult.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTxResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxResults) > 0 {
+		for _, e := range m.TxResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Ownership.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTxResultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -590,10 +2022,10 @@ func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxResultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -660,6 +2092,74 @@ func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -681,7 +2181,7 @@ func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxResultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -704,17 +2204,17 @@ func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxResultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResults", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -724,23 +2224,61 @@ func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.TxResults = append(m.TxResults, TxResult{})
+			if err := m.TxResults[len(m.TxResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -763,7 +2301,7 @@ func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOwnershipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -786,12 +2324,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOwnershipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -813,7 +2415,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -836,15 +2438,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ownership", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -871,10 +2473,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ownership.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"time"
+)
+
+// IsExpired returns true if the timeout timestamp of the queued transaction has elapsed at the provided block time
+func (tx QueuedTx) IsExpired(blockTime time.Time) bool {
+	return uint64(blockTime.UnixNano()) >= tx.TimeoutTimestamp
+}
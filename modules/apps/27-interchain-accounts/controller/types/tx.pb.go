@@ -37,6 +37,11 @@ type MsgRegisterInterchainAccount struct {
 	ConnectionId string      `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 	Version      string      `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 	Ordering     types.Order `protobuf:"varint,4,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
+	// controller port identifier of the interchain account, defaults to the controller port of the owner. An interchain
+	// account whose ownership has been transferred remains identified by the controller port of its original owner.
+	PortId string `protobuf:"bytes,5,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// additional owners of the interchain account signing the registration to reach the threshold of its ownership
+	CoSigners []string `protobuf:"bytes,6,rep,name=co_signers,json=coSigners,proto3" json:"co_signers,omitempty"`
 }
 
 func (m *MsgRegisterInterchainAccount) Reset()         { *m = MsgRegisterInterchainAccount{} }
@@ -119,6 +124,11 @@ type MsgSendTx struct {
 	// Relative timeout timestamp provided will be added to the current block time during transaction execution.
 	// The timeout timestamp must be non-zero.
 	RelativeTimeout uint64 `protobuf:"varint,4,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+	// controller port identifier of the interchain account, defaults to the controller port of the owner. An interchain
+	// account whose ownership has been transferred remains identified by the controller port of its original owner.
+	PortId string `protobuf:"bytes,5,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// additional owners of the interchain account signing the transaction to reach the threshold of its ownership
+	CoSigners []string `protobuf:"bytes,6,rep,name=co_signers,json=coSigners,proto3" json:"co_signers,omitempty"`
 }
 
 func (m *MsgSendTx) Reset()         { *m = MsgSendTx{} }
@@ -157,6 +167,9 @@ var xxx_messageInfo_MsgSendTx proto.InternalMessageInfo
 // MsgSendTxResponse defines the response for MsgSendTx
 type MsgSendTxResponse struct {
 	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// queued is true if the transaction was queued until the closed channel of the interchain account is reopened, in
+	// which case the sequence is not set.
+	Queued bool `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
 }
 
 func (m *MsgSendTxResponse) Reset()         { *m = MsgSendTxResponse{} }
@@ -272,6 +285,95 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgTransferOwnership defines the payload for Msg/TransferOwnership
+type MsgTransferOwnership struct {
+	// current owner of the interchain account
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// additional current owners of the interchain account signing the transfer to reach the threshold of its ownership
+	CoSigners []string `protobuf:"bytes,2,rep,name=co_signers,json=coSigners,proto3" json:"co_signers,omitempty"`
+	// controller port identifier of the interchain account
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// connection identifier of the interchain account, empty for interchain accounts controlled over IBC v2
+	ConnectionId string `protobuf:"bytes,4,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// new owners of the interchain account
+	NewOwners []string `protobuf:"bytes,5,rep,name=new_owners,json=newOwners,proto3" json:"new_owners,omitempty"`
+	// threshold of new owners required to sign messages controlling the interchain account
+	Threshold uint32 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// source client identifier of the interchain account controlled over IBC v2, empty for interchain accounts
+	// registered on a connection
+	ClientId string `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *MsgTransferOwnership) Reset()         { *m = MsgTransferOwnership{} }
+func (m *MsgTransferOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferOwnership) ProtoMessage()    {}
+func (*MsgTransferOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{6}
+}
+func (m *MsgTransferOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferOwnership.Merge(m, src)
+}
+func (m *MsgTransferOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferOwnership proto.InternalMessageInfo
+
+// MsgTransferOwnershipResponse defines the response for Msg/TransferOwnership
+type MsgTransferOwnershipResponse struct {
+}
+
+func (m *MsgTransferOwnershipResponse) Reset()         { *m = MsgTransferOwnershipResponse{} }
+func (m *MsgTransferOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{7}
+}
+func (m *MsgTransferOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferOwnershipResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterInterchainAccount)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccount")
 	proto.RegisterType((*MsgRegisterInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccountResponse")
@@ -279,6 +381,8 @@ func init() {
 	proto.RegisterType((*MsgSendTxResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSendTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgTransferOwnership)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgTransferOwnership")
+	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgTransferOwnershipResponse")
 }
 
 func init() {
@@ -286,49 +390,60 @@ func init() {
 }
 
 var fileDescriptor_7def041328c84a30 = []byte{
-	// 672 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0x14, 0x4b,
-	0x18, 0x9d, 0xbe, 0x0c, 0x03, 0x14, 0x5c, 0xb8, 0xb7, 0x43, 0x2e, 0x43, 0xe7, 0x3a, 0xe0, 0xe8,
-	0x02, 0x49, 0xa8, 0x4a, 0x8f, 0x7f, 0xc9, 0x18, 0x17, 0x02, 0x2e, 0x26, 0x66, 0x22, 0xb6, 0x98,
-	0x10, 0x37, 0x93, 0x9a, 0xea, 0x4a, 0x51, 0x61, 0xba, 0xaa, 0xad, 0xaa, 0x69, 0x71, 0x67, 0x5c,
-	0x19, 0x17, 0xc6, 0x85, 0x0f, 0xc0, 0x23, 0xb0, 0xf7, 0x01, 0x64, 0xc9, 0xd2, 0x95, 0x31, 0xb0,
-	0x60, 0xe7, 0x33, 0x98, 0xfe, 0x99, 0x1e, 0x14, 0x24, 0x38, 0xb0, 0xeb, 0xef, 0xab, 0x3a, 0xe7,
-	0x3b, 0xe7, 0x54, 0x75, 0x81, 0x7b, 0xbc, 0x4d, 0x10, 0x0e, 0xc3, 0x0e, 0x27, 0xd8, 0x70, 0x29,
-	0x34, 0xe2, 0xc2, 0x50, 0x45, 0x36, 0x31, 0x17, 0x2d, 0x4c, 0x88, 0xec, 0x0a, 0xa3, 0x11, 0x91,
-	0xc2, 0x28, 0xd9, 0xe9, 0x50, 0x85, 0x22, 0x17, 0x99, 0x6d, 0x18, 0x2a, 0x69, 0xa4, 0x5d, 0xe3,
-	0x6d, 0x02, 0x8f, 0x83, 0xe1, 0x29, 0x60, 0xd8, 0x07, 0xc3, 0xc8, 0x75, 0xa6, 0x99, 0x64, 0x32,
-	0x81, 0xa3, 0xf8, 0x2b, 0x65, 0x72, 0x6e, 0x9d, 0x4b, 0x46, 0xe4, 0xa2, 0x10, 0x93, 0x2d, 0x6a,
-	0x32, 0xd4, 0xca, 0x00, 0xe2, 0x8f, 0xa9, 0x49, 0x49, 0x66, 0x88, 0xd4, 0x81, 0xd4, 0x28, 0xd0,
-	0x2c, 0x5e, 0x0f, 0x34, 0xcb, 0x16, 0xae, 0xc6, 0xec, 0x44, 0x2a, 0x8a, 0xc8, 0x26, 0x16, 0x82,
-	0x76, 0x12, 0x78, 0xfa, 0x99, 0x6e, 0xa9, 0x7e, 0xb2, 0xc0, 0xff, 0x4d, 0xcd, 0x3c, 0xca, 0xb8,
-	0x36, 0x54, 0x35, 0xf2, 0xe9, 0x0f, 0xd2, 0xe1, 0xf6, 0x34, 0x18, 0x96, 0x2f, 0x05, 0x55, 0x65,
-	0x6b, 0xde, 0x5a, 0x18, 0xf3, 0xd2, 0xc2, 0xbe, 0x06, 0xfe, 0x26, 0x52, 0x08, 0x4a, 0x62, 0xd1,
-	0x2d, 0xee, 0x97, 0xff, 0x4a, 0x56, 0x27, 0xfa, 0xcd, 0x86, 0x6f, 0x97, 0xc1, 0x48, 0x44, 0x95,
-	0xe6, 0x52, 0x94, 0x87, 0x92, 0xe5, 0x5e, 0x69, 0xdf, 0x01, 0xa3, 0x52, 0xf9, 0x54, 0x71, 0xc1,
-	0xca, 0xc5, 0x79, 0x6b, 0x61, 0xb2, 0xe6, 0xc0, 0xf8, 0x24, 0x62, 0xad, 0xb0, 0x27, 0x30, 0x72,
-	0xe1, 0xe3, 0x78, 0x93, 0x97, 0xef, 0xad, 0x4f, 0xbe, 0xdd, 0x99, 0x2b, 0xbc, 0x39, 0xda, 0x5d,
-	0x4c, 0x65, 0x54, 0x7d, 0x70, 0xfd, 0x2c, 0xf1, 0x1e, 0xd5, 0xa1, 0x14, 0x9a, 0xda, 0x57, 0x00,
-	0xc8, 0x58, 0x63, 0xad, 0xa9, 0x93, 0xb1, 0xac, 0xd3, 0xf0, 0xed, 0x19, 0x30, 0x12, 0x4a, 0x65,
-	0xfa, 0x3e, 0x4a, 0x71, 0xd9, 0xf0, 0xeb, 0xc5, 0x78, 0x5e, 0xf5, 0xbb, 0x05, 0xc6, 0x9a, 0x9a,
-	0x3d, 0xa5, 0xc2, 0x5f, 0xdf, 0xbe, 0x48, 0x20, 0x5b, 0x60, 0x3c, 0x3d, 0xfd, 0x96, 0x8f, 0x0d,
-	0x4e, 0x42, 0x19, 0xaf, 0xad, 0xc2, 0x73, 0xdd, 0xc1, 0xc8, 0x85, 0x27, 0xfc, 0xad, 0x25, 0x64,
-	0xab, 0xd8, 0xe0, 0xe5, 0xe2, 0xde, 0xd7, 0xb9, 0x82, 0x07, 0xc2, 0xbc, 0x63, 0xdf, 0x00, 0xff,
-	0x28, 0xda, 0xc1, 0x86, 0x47, 0xb4, 0x65, 0x78, 0x40, 0x65, 0xd7, 0x24, 0x59, 0x17, 0xbd, 0xa9,
-	0x5e, 0x7f, 0x3d, 0x6d, 0x9f, 0x88, 0xf5, 0x36, 0xf8, 0x37, 0xf7, 0x9b, 0x67, 0xe8, 0x80, 0x51,
-	0x4d, 0x5f, 0x74, 0xa9, 0x20, 0x34, 0xb1, 0x5e, 0xf4, 0xf2, 0x3a, 0xcb, 0xe9, 0xa3, 0x05, 0xa6,
-	0x9a, 0x9a, 0x3d, 0x0b, 0x7d, 0x6c, 0xe8, 0x1a, 0x56, 0x38, 0xd0, 0xf6, 0x7f, 0xa0, 0xa4, 0x39,
-	0xeb, 0xc7, 0x95, 0x55, 0xf6, 0x06, 0x28, 0x85, 0xc9, 0x8e, 0x24, 0xa8, 0xf1, 0x5a, 0x1d, 0xfe,
-	0xf9, 0x9f, 0x08, 0xd3, 0x19, 0x99, 0xf7, 0x8c, 0xaf, 0x3e, 0xd5, 0x33, 0x93, 0x8d, 0xaa, 0xce,
-	0x82, 0x99, 0x5f, 0x54, 0xf5, 0x3c, 0xd5, 0xde, 0x15, 0xc1, 0x50, 0x53, 0x33, 0xfb, 0xb3, 0x05,
-	0x66, 0x7f, 0xff, 0x0b, 0xac, 0x0d, 0xa2, 0xed, 0xac, 0x7b, 0xe9, 0x6c, 0x5c, 0x36, 0x63, 0x7e,
-	0x4a, 0xef, 0x2d, 0x50, 0xca, 0x2e, 0xea, 0xfd, 0x01, 0x87, 0xa4, 0x70, 0xe7, 0xe1, 0x85, 0xe0,
-	0xb9, 0xa0, 0x1d, 0x0b, 0x4c, 0xfc, 0x74, 0x23, 0x56, 0x06, 0xe4, 0x3d, 0x4e, 0xe2, 0x3c, 0xba,
-	0x04, 0x92, 0x9e, 0x44, 0x67, 0xf8, 0xf5, 0xd1, 0xee, 0xa2, 0xb5, 0xbc, 0xb5, 0x77, 0x50, 0xb1,
-	0xf6, 0x0f, 0x2a, 0xd6, 0xb7, 0x83, 0x8a, 0xf5, 0xe1, 0xb0, 0x52, 0xd8, 0x3f, 0xac, 0x14, 0xbe,
-	0x1c, 0x56, 0x0a, 0xcf, 0x9f, 0x30, 0x6e, 0x36, 0xbb, 0x6d, 0x48, 0x64, 0x80, 0xb2, 0xb7, 0x96,
-	0xb7, 0xc9, 0x12, 0x93, 0x28, 0x72, 0x5d, 0x14, 0x48, 0xbf, 0xdb, 0xa1, 0x3a, 0x7e, 0xc6, 0x35,
-	0xaa, 0xdd, 0x5d, 0xea, 0x0b, 0x59, 0x3a, 0xed, 0x05, 0x37, 0xaf, 0x42, 0xaa, 0xdb, 0xa5, 0xe4,
-	0xf9, 0xbd, 0xf9, 0x23, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xe7, 0x58, 0xf9, 0xbe, 0x06, 0x00, 0x00,
+	// 834 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xf3, 0xab, 0xc9, 0x74, 0x97, 0xb2, 0x56, 0x45, 0xbd, 0xa6, 0xcd, 0x86, 0xc0, 0x21,
+	0xac, 0x54, 0x5b, 0x09, 0x08, 0xa4, 0x20, 0x0e, 0xec, 0x2e, 0x12, 0x11, 0x8a, 0x36, 0x78, 0x8b,
+	0xb4, 0xe2, 0x12, 0x39, 0xe3, 0xc1, 0x19, 0xd5, 0x99, 0xf1, 0xce, 0x4c, 0xdc, 0xe5, 0x86, 0xf6,
+	0xc4, 0x09, 0x71, 0xe0, 0x0f, 0xd8, 0xff, 0x80, 0x5e, 0xb8, 0x71, 0xa7, 0xc7, 0x1e, 0x39, 0x21,
+	0xd4, 0x1e, 0xfa, 0x6f, 0xa0, 0x99, 0x71, 0x9c, 0xb4, 0x49, 0x4b, 0x49, 0xbb, 0x37, 0xbf, 0x37,
+	0xf3, 0xbe, 0xef, 0xf3, 0xf7, 0xde, 0x8c, 0x0d, 0x3e, 0xc3, 0x43, 0xe8, 0xfa, 0x71, 0x1c, 0x61,
+	0xe8, 0x0b, 0x4c, 0x09, 0x77, 0x31, 0x11, 0x88, 0xc1, 0x91, 0x8f, 0xc9, 0xc0, 0x87, 0x90, 0x4e,
+	0x88, 0xe0, 0x2e, 0xa4, 0x44, 0x30, 0x1a, 0x45, 0x88, 0xb9, 0x49, 0xcb, 0x15, 0x2f, 0x9d, 0x98,
+	0x51, 0x41, 0xcd, 0x36, 0x1e, 0x42, 0x67, 0xbe, 0xd8, 0x59, 0x52, 0xec, 0xcc, 0x8a, 0x9d, 0xa4,
+	0x65, 0x6f, 0x86, 0x34, 0xa4, 0xaa, 0xdc, 0x95, 0x4f, 0x1a, 0xc9, 0xfe, 0xf8, 0x5a, 0x32, 0x92,
+	0x96, 0x1b, 0xfb, 0x70, 0x1f, 0x89, 0xb4, 0xea, 0xf1, 0x0a, 0xe2, 0x67, 0x51, 0x0a, 0xb2, 0x05,
+	0x29, 0x1f, 0x53, 0xee, 0x8e, 0x79, 0x28, 0xd7, 0xc7, 0x3c, 0x4c, 0x17, 0xde, 0x93, 0xe8, 0x90,
+	0x32, 0xe4, 0xc2, 0x91, 0x4f, 0x08, 0x8a, 0x54, 0xb9, 0x7e, 0xd4, 0x5b, 0x1a, 0xaf, 0xf2, 0x60,
+	0xbb, 0xc7, 0x43, 0x0f, 0x85, 0x98, 0x0b, 0xc4, 0xba, 0x19, 0xfb, 0x17, 0x9a, 0xdc, 0xdc, 0x04,
+	0x25, 0x7a, 0x40, 0x10, 0xb3, 0x8c, 0xba, 0xd1, 0xac, 0x7a, 0x3a, 0x30, 0xdf, 0x07, 0x77, 0x21,
+	0x25, 0x04, 0x41, 0x29, 0x7a, 0x80, 0x03, 0x2b, 0xaf, 0x56, 0xef, 0xcc, 0x92, 0xdd, 0xc0, 0xb4,
+	0xc0, 0x5a, 0x82, 0x18, 0xc7, 0x94, 0x58, 0x05, 0xb5, 0x3c, 0x0d, 0xcd, 0x4f, 0x40, 0x85, 0xb2,
+	0x00, 0x31, 0x4c, 0x42, 0xab, 0x58, 0x37, 0x9a, 0x6f, 0xb5, 0x6d, 0x47, 0x76, 0x42, 0x6a, 0x75,
+	0xa6, 0x02, 0x93, 0x96, 0xf3, 0x54, 0x6e, 0xf2, 0xb2, 0xbd, 0xe6, 0x16, 0x58, 0x8b, 0x29, 0x13,
+	0x92, 0xb0, 0xa4, 0x10, 0xcb, 0x32, 0xec, 0x06, 0xe6, 0x0e, 0x00, 0x90, 0x0e, 0x38, 0x0e, 0x09,
+	0x62, 0xdc, 0x2a, 0xd7, 0x0b, 0xcd, 0xaa, 0x57, 0x85, 0xf4, 0x99, 0x4e, 0x74, 0x76, 0x7e, 0x7a,
+	0xfd, 0x20, 0xf7, 0xea, 0xec, 0xf0, 0xa1, 0x96, 0x2f, 0x9f, 0xe6, 0xf6, 0x37, 0x02, 0xf0, 0xc1,
+	0x55, 0x1e, 0x78, 0x88, 0xc7, 0x94, 0x70, 0xa4, 0x58, 0xb4, 0x38, 0xa9, 0x40, 0x1b, 0x52, 0x4d,
+	0x33, 0xdd, 0x60, 0x5e, 0x5d, 0x7e, 0x5e, 0x5d, 0xa7, 0x28, 0xe9, 0x1b, 0xbf, 0xe7, 0x41, 0xb5,
+	0xc7, 0xc3, 0x67, 0x88, 0x04, 0x7b, 0x2f, 0x6f, 0xe2, 0xeb, 0x3e, 0x58, 0xd7, 0x43, 0x34, 0x08,
+	0x7c, 0xe1, 0x2b, 0x6f, 0xd7, 0xdb, 0x4f, 0x9c, 0x6b, 0x8d, 0x72, 0xd2, 0x72, 0x16, 0xde, 0xaf,
+	0xaf, 0xc0, 0x9e, 0xf8, 0xc2, 0x7f, 0x54, 0x3c, 0xfa, 0xfb, 0x41, 0xce, 0x03, 0x71, 0x96, 0x31,
+	0x3f, 0x04, 0x6f, 0x33, 0x14, 0xf9, 0x02, 0x27, 0x68, 0x20, 0xf0, 0x18, 0xd1, 0x89, 0x50, 0x2d,
+	0x2b, 0x7a, 0x1b, 0xd3, 0xfc, 0x9e, 0x4e, 0xbf, 0xa9, 0xee, 0xf4, 0xc0, 0xbd, 0xcc, 0xb6, 0xac,
+	0x15, 0x36, 0xa8, 0x70, 0xf4, 0x62, 0x82, 0x08, 0x44, 0xca, 0xc1, 0xa2, 0x97, 0xc5, 0xe6, 0x3b,
+	0xa0, 0xfc, 0x62, 0x82, 0x26, 0x48, 0xbb, 0x57, 0xf1, 0xd2, 0x28, 0x6d, 0xc3, 0xaf, 0x06, 0xd8,
+	0xe8, 0xf1, 0xf0, 0xdb, 0x38, 0xf0, 0x05, 0xea, 0xfb, 0xcc, 0x1f, 0x73, 0x59, 0xa1, 0xd9, 0xd2,
+	0x6e, 0xa4, 0x91, 0xf9, 0x1c, 0x94, 0x63, 0xb5, 0x43, 0x21, 0xad, 0xb7, 0x3b, 0xce, 0xff, 0xbf,
+	0x2f, 0x1c, 0xcd, 0x91, 0x5a, 0x9b, 0xe2, 0x75, 0x36, 0xa6, 0xef, 0x9c, 0x52, 0x35, 0xee, 0x83,
+	0xad, 0x0b, 0xaa, 0xa6, 0xef, 0x2a, 0xcf, 0xe8, 0x66, 0x8f, 0x87, 0x7b, 0xcc, 0x27, 0xfc, 0x7b,
+	0xc4, 0x9e, 0x4a, 0x93, 0xf8, 0x08, 0xc7, 0x97, 0xcc, 0xd0, 0x79, 0xb7, 0xf3, 0x17, 0xdc, 0x9e,
+	0xef, 0x52, 0xe1, 0x5c, 0x97, 0x16, 0x66, 0xaf, 0xb8, 0x64, 0xf6, 0x76, 0x00, 0x20, 0xe8, 0x60,
+	0xa0, 0x98, 0xb8, 0x55, 0xd2, 0xe0, 0x04, 0x1d, 0x68, 0x51, 0xe6, 0x36, 0xa8, 0x8a, 0x11, 0x43,
+	0x7c, 0x44, 0xa3, 0xc0, 0x2a, 0xd7, 0x8d, 0xe6, 0x5d, 0x6f, 0x96, 0x30, 0xdf, 0x05, 0x55, 0x18,
+	0x61, 0x44, 0x14, 0xf9, 0x9a, 0x42, 0xaf, 0xe8, 0x44, 0x37, 0xf8, 0xaf, 0x29, 0xa8, 0x81, 0xed,
+	0x65, 0x1e, 0x4c, 0x4d, 0x6a, 0xff, 0x51, 0x02, 0x85, 0x1e, 0x0f, 0xcd, 0x3f, 0x0d, 0x70, 0xff,
+	0xf2, 0xdb, 0xac, 0xbf, 0x4a, 0x03, 0xaf, 0xba, 0x1b, 0xec, 0xe7, 0xb7, 0x8d, 0x98, 0x8d, 0xf8,
+	0xcf, 0x06, 0x28, 0xa7, 0x97, 0xc5, 0xe7, 0x2b, 0x92, 0xe8, 0x72, 0xfb, 0xcb, 0x1b, 0x95, 0x67,
+	0x82, 0x5e, 0x1b, 0xe0, 0xce, 0xb9, 0x63, 0xf3, 0x78, 0x45, 0xdc, 0x79, 0x10, 0xfb, 0xeb, 0x5b,
+	0x00, 0xc9, 0x24, 0xfe, 0x66, 0x80, 0x7b, 0x8b, 0xe7, 0xe4, 0xab, 0x15, 0x29, 0x16, 0x90, 0xec,
+	0xfe, 0x6d, 0x21, 0x4d, 0x15, 0xdb, 0xa5, 0x1f, 0xcf, 0x0e, 0x1f, 0x1a, 0x8f, 0xf6, 0x8f, 0x4e,
+	0x6a, 0xc6, 0xf1, 0x49, 0xcd, 0xf8, 0xe7, 0xa4, 0x66, 0xfc, 0x72, 0x5a, 0xcb, 0x1d, 0x9f, 0xd6,
+	0x72, 0x7f, 0x9d, 0xd6, 0x72, 0xdf, 0x7d, 0x13, 0x62, 0x31, 0x9a, 0x0c, 0x1d, 0x48, 0xc7, 0x6e,
+	0xfa, 0xa1, 0xc7, 0x43, 0xb8, 0x1b, 0x52, 0x37, 0x69, 0xb5, 0xdc, 0x31, 0x0d, 0x26, 0x11, 0xe2,
+	0xf2, 0x1f, 0x82, 0xbb, 0xed, 0x4f, 0x77, 0x67, 0x6a, 0x76, 0x97, 0xfd, 0x3e, 0x88, 0x1f, 0x62,
+	0xc4, 0x87, 0x65, 0xf5, 0xed, 0xff, 0xe8, 0xdf, 0x01, 0x00, 0x0a, 0xe0, 0x50, 0x8e, 0x3b, 0x09,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -349,6 +464,8 @@ type MsgClient interface {
 	SendTx(ctx context.Context, in *MsgSendTx, opts ...grpc.CallOption) (*MsgSendTxResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// TransferOwnership defines a rpc handler for MsgTransferOwnership.
+	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
 }
 
 type msgClient struct {
@@ -386,6 +503,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error) {
+	out := new(MsgTransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/TransferOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount.
@@ -394,6 +520,8 @@ type MsgServer interface {
 	SendTx(context.Context, *MsgSendTx) (*MsgSendTxResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// TransferOwnership defines a rpc handler for MsgTransferOwnership.
+	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -409,6 +537,9 @@ func (*UnimplementedMsgServer) SendTx(ctx context.Context, req *MsgSendTx) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -468,6 +599,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/TransferOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferOwnership(ctx, req.(*MsgTransferOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Msg",
@@ -485,6 +634,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _Msg_TransferOwnership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/controller/v1/tx.proto",
@@ -510,6 +663,22 @@ func (m *MsgRegisterInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.CoSigners) > 0 {
+		for iNdEx := len(m.CoSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoSigners[iNdEx])
+			copy(dAtA[i:], m.CoSigners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CoSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Ordering != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Ordering))
 		i--
@@ -596,6 +765,22 @@ func (m *MsgSendTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CoSigners) > 0 {
+		for iNdEx := len(m.CoSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoSigners[iNdEx])
+			copy(dAtA[i:], m.CoSigners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CoSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.RelativeTimeout != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.RelativeTimeout))
 		i--
@@ -648,6 +833,16 @@ func (m *MsgSendTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
 		i--
@@ -719,6 +914,103 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NewOwners) > 0 {
+		for iNdEx := len(m.NewOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewOwners[iNdEx])
+			copy(dAtA[i:], m.NewOwners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CoSigners) > 0 {
+		for iNdEx := len(m.CoSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoSigners[iNdEx])
+			copy(dAtA[i:], m.CoSigners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CoSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -751,6 +1043,16 @@ func (m *MsgRegisterInterchainAccount) Size() (n int) {
 	if m.Ordering != 0 {
 		n += 1 + sovTx(uint64(m.Ordering))
 	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoSigners) > 0 {
+		for _, s := range m.CoSigners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -790,6 +1092,16 @@ func (m *MsgSendTx) Size() (n int) {
 	if m.RelativeTimeout != 0 {
 		n += 1 + sovTx(uint64(m.RelativeTimeout))
 	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoSigners) > 0 {
+		for _, s := range m.CoSigners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -802,6 +1114,9 @@ func (m *MsgSendTxResponse) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovTx(uint64(m.Sequence))
 	}
+	if m.Queued {
+		n += 2
+	}
 	return n
 }
 
@@ -829,6 +1144,55 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoSigners) > 0 {
+		for _, s := range m.CoSigners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NewOwners) > 0 {
+		for _, s := range m.NewOwners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -979,59 +1343,9 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterInterchainAccountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1059,11 +1373,11 @@ func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoSigners", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1091,7 +1405,121 @@ func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.CoSigners = append(m.CoSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1259,6 +1687,70 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoSigners = append(m.CoSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1328,6 +1820,26 @@ func (m *MsgSendTxResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1514,6 +2026,317 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoSigners = append(m.CoSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwners = append(m.NewOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
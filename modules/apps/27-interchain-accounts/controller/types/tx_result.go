@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+)
+
+// GetConnectionOrClientID returns the connectionID of the channel the transaction of the result was sent on, or the
+// source clientID of its packet for transactions sent over IBC v2.
+func (r TxResult) GetConnectionOrClientID() string {
+	if r.ConnectionId != "" {
+		return r.ConnectionId
+	}
+
+	return r.ClientId
+}
+
+// TxResultChannelSequence returns the channel sequence the results of the transactions sent on the provided channelID
+// are keyed by. Transactions sent over IBC v2 have no channel and are keyed by the zero channel sequence.
+func TxResultChannelSequence(channelID string) (uint64, error) {
+	if channelID == "" {
+		return 0, nil
+	}
+
+	return channeltypes.ParseChannelSequence(channelID)
+}
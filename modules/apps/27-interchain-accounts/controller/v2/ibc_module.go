@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/api"
+)
+
+var (
+	_ api.IBCModule             = (*IBCModule)(nil)
+	_ api.PacketDataUnmarshaler = (*IBCModule)(nil)
+)
+
+// IBCModule implements the IBC v2 application interface for the interchain accounts controller submodule.
+// Packets are sent from the controller port of their signer, {ControllerPortPrefix}{owner}, to the host port,
+// and control the interchain account of the owner on the client of the controller chain on the host chain.
+// The router entry of the module must be a prefix route matching all controller ports.
+type IBCModule struct {
+	keeper *keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper
+func NewIBCModule(k *keeper.Keeper) *IBCModule {
+	return &IBCModule{
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface. The signer must be authorized by the ownership of the interchain
+// account of the source port on the source client, by default the owner of the controller port.
+func (im *IBCModule) OnSendPacket(ctx sdk.Context, sourceClient string, destinationClient string, _ uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	if !im.keeper.GetParams(ctx).ControllerEnabled {
+		return types.ErrControllerSubModuleDisabled
+	}
+
+	if err := types.ValidateControllerPortID(payload.SourcePort); err != nil {
+		return err
+	}
+	if payload.DestinationPort != icatypes.HostPortID {
+		return errorsmod.Wrapf(icatypes.ErrInvalidHostPort, "expected %s, got %s", icatypes.HostPortID, payload.DestinationPort)
+	}
+	if !clienttypes.IsValidClientID(sourceClient) || !clienttypes.IsValidClientID(destinationClient) {
+		return errorsmod.Wrapf(channeltypesv2.ErrInvalidPacket, "client IDs must be in valid format: {string}-{number}")
+	}
+
+	// packets carry a single signer, which must reach the threshold of the ownership on the source client alone
+	if err := im.keeper.GetEffectiveClientOwnership(ctx, payload.SourcePort, sourceClient).Authorize([]string{signer.String()}); err != nil {
+		return err
+	}
+
+	data, err := icatypes.UnmarshalPayloadValue(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return errorsmod.Wrapf(err, "failed to validate %s packet data", icatypes.Version)
+	}
+
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. A controller chain does not receive packets.
+func (im *IBCModule) OnRecvPacket(ctx sdk.Context, _, destinationClient string, sequence uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	err := errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive packet on a controller port, a host chain does not send packets")
+	im.keeper.Logger(ctx).Error("recv packet failed", "error", err, "sequence", sequence, "destination_client", destinationClient)
+
+	return channeltypesv2.RecvPacketResult{
+		Status: channeltypesv2.PacketStatus_Failure,
+	}
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The timeout of the transaction is recorded for the owner.
+func (im *IBCModule) OnTimeoutPacket(ctx sdk.Context, sourceClient, _ string, sequence uint64, payload channeltypesv2.Payload, _ sdk.AccAddress) error {
+	im.keeper.OnTimeoutPayload(ctx, sourceClient, payload.SourcePort, sequence)
+
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The result of the transaction is recorded for the owner.
+func (im *IBCModule) OnAcknowledgementPacket(ctx sdk.Context, sourceClient, _ string, sequence uint64, acknowledgement []byte, payload channeltypesv2.Payload, _ sdk.AccAddress) error {
+	im.keeper.OnAcknowledgementPayload(ctx, sourceClient, payload.SourcePort, sequence, acknowledgement)
+
+	return nil
+}
+
+// UnmarshalPacketData unmarshals the interchain account packet data from the payload.
+// This method implements the PacketDataUnmarshaler interface required for callbacks middleware support.
+func (*IBCModule) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	data, err := icatypes.UnmarshalPayloadValue(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
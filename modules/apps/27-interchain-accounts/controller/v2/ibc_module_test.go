@@ -0,0 +1,326 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
+	v2 "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/v2"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+type IBCModuleTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func TestIBCModuleTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(IBCModuleTestSuite))
+}
+
+func (s *IBCModuleTestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func (s *IBCModuleTestSuite) TestOnSendPacket() {
+	var (
+		payload      channeltypesv2.Payload
+		signer       sdk.AccAddress
+		sourceClient string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: abi encoding",
+			func() {
+				packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+				bz, err := icatypes.MarshalPayloadValue(packetData, icatypes.Version, icatypes.PayloadEncodingABI)
+				s.Require().NoError(err)
+
+				payload.Encoding = icatypes.PayloadEncodingABI
+				payload.Value = bz
+			},
+			nil,
+		},
+		{
+			"failure: controller submodule disabled",
+			func() {
+				s.chainA.GetSimApp().ICAControllerKeeper.SetParams(s.chainA.GetContext(), types.NewParams(false))
+			},
+			types.ErrControllerSubModuleDisabled,
+		},
+		{
+			"failure: source port is not the controller port of the signer",
+			func() {
+				signer = s.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"success: signer is an owner of the transferred ownership of the source client",
+			func() {
+				signer = s.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+				ownership := types.NewInterchainAccountClientOwnership(payload.SourcePort, sourceClient, []string{signer.String()}, 1)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+			},
+			nil,
+		},
+		{
+			"failure: signer transferred the ownership of the source client",
+			func() {
+				ownership := types.NewInterchainAccountClientOwnership(payload.SourcePort, sourceClient, []string{s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()}, 1)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: signer alone does not reach the threshold of the ownership of the source client",
+			func() {
+				owners := []string{signer.String(), s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()}
+				ownership := types.NewInterchainAccountClientOwnership(payload.SourcePort, sourceClient, owners, 2)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"success: ownership transferred on a connection does not apply to the source client",
+			func() {
+				ownership := types.NewInterchainAccountOwnership(payload.SourcePort, ibctesting.FirstConnectionID, []string{s.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()}, 1)
+				s.chainA.GetSimApp().ICAControllerKeeper.SetOwnership(s.chainA.GetContext(), ownership)
+			},
+			nil,
+		},
+		{
+			"failure: source port is not a controller port",
+			func() {
+				payload.SourcePort = "transfer"
+			},
+			icatypes.ErrInvalidControllerPort,
+		},
+		{
+			"failure: invalid destination port",
+			func() {
+				payload.DestinationPort = "transfer"
+			},
+			icatypes.ErrInvalidHostPort,
+		},
+		{
+			"failure: invalid source client ID",
+			func() {
+				sourceClient = "invalid"
+			},
+			channeltypesv2.ErrInvalidPacket,
+		},
+		{
+			"failure: invalid version",
+			func() {
+				payload.Version = "ics20-1"
+			},
+			icatypes.ErrInvalidVersion,
+		},
+		{
+			"failure: invalid encoding",
+			func() {
+				payload.Encoding = icatypes.EncodingProtobuf
+			},
+			icatypes.ErrInvalidCodec,
+		},
+		{
+			"failure: invalid packet data",
+			func() {
+				payload.Value = []byte("invalid")
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: empty packet data",
+			func() {
+				bz, err := icatypes.MarshalPayloadValue(icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX}, icatypes.Version, icatypes.PayloadEncodingProtobuf)
+				s.Require().NoError(err)
+
+				payload.Value = bz
+			},
+			icatypes.ErrInvalidOutgoingData,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			signer = s.chainA.SenderAccount.GetAddress()
+			sourceClient = ibctesting.FirstClientID
+
+			portID, err := icatypes.NewControllerPortID(signer.String())
+			s.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+			bz, err := icatypes.MarshalPayloadValue(packetData, icatypes.Version, icatypes.PayloadEncodingProtobuf)
+			s.Require().NoError(err)
+
+			payload = channeltypesv2.NewPayload(portID, icatypes.HostPortID, icatypes.Version, icatypes.PayloadEncodingProtobuf, bz)
+
+			tc.malleate()
+
+			module := v2.NewIBCModule(s.chainA.GetSimApp().ICAControllerKeeper)
+			err = module.OnSendPacket(s.chainA.GetContext(), sourceClient, ibctesting.FirstClientID, 1, payload, signer)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *IBCModuleTestSuite) TestOnRecvPacket() {
+	module := v2.NewIBCModule(s.chainA.GetSimApp().ICAControllerKeeper)
+
+	result := module.OnRecvPacket(s.chainA.GetContext(), ibctesting.FirstClientID, ibctesting.FirstClientID, 1, channeltypesv2.Payload{}, s.chainA.SenderAccount.GetAddress())
+	s.Require().Equal(channeltypesv2.PacketStatus_Failure, result.Status)
+}
+
+func (s *IBCModuleTestSuite) TestOnAcknowledgementPacket() {
+	var acknowledgement []byte
+
+	msgResponse, err := codectypes.NewAnyWithValue(&banktypes.MsgSendResponse{})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expResult *types.TxResult
+	}{
+		{
+			"success",
+			func() {},
+			&types.TxResult{Status: types.TX_RESULT_SUCCESS, MsgResponses: []*codectypes.Any{msgResponse}},
+		},
+		{
+			"success: error acknowledgement",
+			func() {
+				acknowledgement = channeltypesv2.ErrorAcknowledgement[:]
+			},
+			&types.TxResult{Status: types.TX_RESULT_FAILURE},
+		},
+		{
+			"success: undecodable acknowledgement is not recorded",
+			func() {
+				acknowledgement = []byte("invalid")
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			portID, err := icatypes.NewControllerPortID(s.chainA.SenderAccount.GetAddress().String())
+			s.Require().NoError(err)
+
+			bz, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgResponse}})
+			s.Require().NoError(err)
+			acknowledgement = bz
+
+			tc.malleate()
+
+			module := v2.NewIBCModule(s.chainA.GetSimApp().ICAControllerKeeper)
+			payload := channeltypesv2.NewPayload(portID, icatypes.HostPortID, icatypes.Version, icatypes.PayloadEncodingJSON, nil)
+
+			err = module.OnAcknowledgementPacket(s.chainA.GetContext(), ibctesting.FirstClientID, ibctesting.FirstClientID, 1, acknowledgement, payload, s.chainA.SenderAccount.GetAddress())
+			s.Require().NoError(err)
+
+			result, found := s.chainA.GetSimApp().ICAControllerKeeper.GetTxResult(s.chainA.GetContext(), portID, ibctesting.FirstClientID, "", 1)
+			if tc.expResult == nil {
+				s.Require().False(found)
+				return
+			}
+
+			s.Require().True(found)
+			s.Require().Equal(s.chainA.SenderAccount.GetAddress().String(), result.Owner)
+			s.Require().Empty(result.ConnectionId)
+			s.Require().Equal(ibctesting.FirstClientID, result.ClientId)
+			s.Require().Equal(uint64(1), result.Sequence)
+			s.Require().Equal(tc.expResult.Status, result.Status)
+			s.Require().Len(result.MsgResponses, len(tc.expResult.MsgResponses))
+			for i, msgResponse := range tc.expResult.MsgResponses {
+				s.Require().Equal(msgResponse.TypeUrl, result.MsgResponses[i].TypeUrl)
+			}
+		})
+	}
+}
+
+func (s *IBCModuleTestSuite) TestOnTimeoutPacket() {
+	portID, err := icatypes.NewControllerPortID(s.chainA.SenderAccount.GetAddress().String())
+	s.Require().NoError(err)
+
+	module := v2.NewIBCModule(s.chainA.GetSimApp().ICAControllerKeeper)
+	payload := channeltypesv2.NewPayload(portID, icatypes.HostPortID, icatypes.Version, icatypes.PayloadEncodingJSON, nil)
+
+	ctx := s.chainA.GetContext()
+	err = module.OnTimeoutPacket(ctx, ibctesting.FirstClientID, ibctesting.FirstClientID, 1, payload, s.chainA.SenderAccount.GetAddress())
+	s.Require().NoError(err)
+
+	result, found := s.chainA.GetSimApp().ICAControllerKeeper.GetTxResult(ctx, portID, ibctesting.FirstClientID, "", 1)
+	s.Require().True(found)
+	s.Require().Equal(ibctesting.FirstClientID, result.ClientId)
+	s.Require().Equal(types.TX_RESULT_TIMEOUT, result.Status)
+
+	expAttributes := []sdk.Attribute{
+		sdk.NewAttribute(icatypes.AttributeKeyClientID, ibctesting.FirstClientID),
+		sdk.NewAttribute(icatypes.AttributeKeySequence, "1"),
+		sdk.NewAttribute(icatypes.AttributeKeyTxResultStatus, types.TX_RESULT_TIMEOUT.String()),
+	}
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(icatypes.EventTypeTxResult, events[0].Type)
+	for _, attr := range expAttributes {
+		s.Require().Contains(events[0].Attributes, attr.ToKVPair())
+	}
+}
+
+func (s *IBCModuleTestSuite) TestUnmarshalPacketData() {
+	module := v2.NewIBCModule(s.chainA.GetSimApp().ICAControllerKeeper)
+
+	expPacketData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data"), Memo: "memo"}
+	bz, err := icatypes.MarshalPayloadValue(expPacketData, icatypes.Version, icatypes.PayloadEncodingJSON)
+	s.Require().NoError(err)
+
+	data, err := module.UnmarshalPacketData(channeltypesv2.NewPayload("icacontroller-owner", icatypes.HostPortID, icatypes.Version, icatypes.PayloadEncodingJSON, bz))
+	s.Require().NoError(err)
+	s.Require().Equal(expPacketData, data)
+
+	_, err = module.UnmarshalPacketData(channeltypesv2.NewPayload("icacontroller-owner", icatypes.HostPortID, icatypes.Version, icatypes.PayloadEncodingJSON, []byte("invalid")))
+	s.Require().ErrorIs(err, ibcerrors.ErrInvalidType)
+}
@@ -79,7 +79,7 @@ func (gs ControllerGenesisState) Validate() error {
 		}
 	}
 
-	return nil
+	return controllertypes.ValidateOwnerships(gs.Ownerships)
 }
 
 // DefaultHostGenesis creates and returns the default interchain accounts HostGenesisState
@@ -126,5 +126,13 @@ func (gs HostGenesisState) Validate() error {
 		return err
 	}
 
+	if err := hosttypes.ValidateMessagePolicies(gs.MessagePolicies); err != nil {
+		return err
+	}
+
+	if err := hosttypes.ValidateMigratedAccounts(gs.MigratedAccounts); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
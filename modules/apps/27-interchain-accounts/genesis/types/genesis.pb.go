@@ -80,10 +80,11 @@ func (m *GenesisState) GetHostGenesisState() HostGenesisState {
 
 // ControllerGenesisState defines the interchain accounts controller genesis state
 type ControllerGenesisState struct {
-	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
-	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
-	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
-	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ActiveChannels     []ActiveChannel                    `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
+	InterchainAccounts []RegisteredInterchainAccount      `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
+	Ports              []string                           `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	Params             types.Params                       `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	Ownerships         []types.InterchainAccountOwnership `protobuf:"bytes,5,rep,name=ownerships,proto3" json:"ownerships"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
@@ -147,12 +148,21 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 	return types.Params{}
 }
 
+func (m *ControllerGenesisState) GetOwnerships() []types.InterchainAccountOwnership {
+	if m != nil {
+		return m.Ownerships
+	}
+	return nil
+}
+
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
-	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
-	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
-	Port               string                        `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
-	Params             types1.Params                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ActiveChannels     []ActiveChannel                    `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
+	InterchainAccounts []RegisteredInterchainAccount      `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
+	Port               string                             `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	Params             types1.Params                      `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	MessagePolicies    []types1.ScopedMessagePolicy       `protobuf:"bytes,5,rep,name=message_policies,json=messagePolicies,proto3" json:"message_policies"`
+	MigratedAccounts   []types1.MigratedInterchainAccount `protobuf:"bytes,6,rep,name=migrated_accounts,json=migratedAccounts,proto3" json:"migrated_accounts"`
 }
 
 func (m *HostGenesisState) Reset()         { *m = HostGenesisState{} }
@@ -216,6 +226,20 @@ func (m *HostGenesisState) GetParams() types1.Params {
 	return types1.Params{}
 }
 
+func (m *HostGenesisState) GetMessagePolicies() []types1.ScopedMessagePolicy {
+	if m != nil {
+		return m.MessagePolicies
+	}
+	return nil
+}
+
+func (m *HostGenesisState) GetMigratedAccounts() []types1.MigratedInterchainAccount {
+	if m != nil {
+		return m.MigratedAccounts
+	}
+	return nil
+}
+
 // ActiveChannel contains a connection ID, port ID and associated active channel ID, as well as a boolean flag to
 // indicate if the channel is middleware enabled
 type ActiveChannel struct {
@@ -360,44 +384,50 @@ func init() {
 }
 
 var fileDescriptor_d4aa48c8e29a1947 = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0x4f, 0x8b, 0xd3, 0x4e,
-	0x18, 0xc7, 0x9b, 0x74, 0x7f, 0xfd, 0xd9, 0xd9, 0x3f, 0x2e, 0xb3, 0xeb, 0x1a, 0x56, 0x8c, 0xa5,
-	0x1e, 0xec, 0xa5, 0x09, 0xad, 0xc2, 0x82, 0xa0, 0xd0, 0x2d, 0xb2, 0x16, 0x54, 0x24, 0x5e, 0xc4,
-	0x4b, 0x98, 0x4e, 0x86, 0x64, 0x20, 0xc9, 0x84, 0x3c, 0xd3, 0x8a, 0x67, 0x05, 0x8f, 0xfa, 0x12,
-	0x7c, 0x39, 0x7b, 0xdc, 0xa3, 0x27, 0x91, 0xf6, 0x05, 0xf8, 0x0a, 0x04, 0x99, 0x49, 0xb6, 0xad,
-	0xb5, 0x4a, 0x8b, 0x47, 0x4f, 0x99, 0x79, 0xbe, 0x79, 0xbe, 0xcf, 0x27, 0xcf, 0x93, 0x64, 0xd0,
-	0x03, 0x3e, 0xa4, 0x2e, 0xc9, 0xb2, 0x98, 0x53, 0x22, 0xb9, 0x48, 0xc1, 0xe5, 0xa9, 0x64, 0x39,
-	0x8d, 0x08, 0x4f, 0x7d, 0x42, 0xa9, 0x18, 0xa5, 0x12, 0xdc, 0x90, 0xa5, 0x0c, 0x38, 0xb8, 0xe3,
-	0xce, 0xe5, 0xd2, 0xc9, 0x72, 0x21, 0x05, 0x76, 0xf9, 0x90, 0x3a, 0x8b, 0xe9, 0xce, 0x8a, 0x74,
-	0xe7, 0x32, 0x67, 0xdc, 0x39, 0x3e, 0x0c, 0x45, 0x28, 0x74, 0xae, 0xab, 0x56, 0x85, 0xcd, 0x71,
-	0x7f, 0x2d, 0x0a, 0x2a, 0x52, 0x99, 0x8b, 0x38, 0x66, 0xb9, 0x02, 0x99, 0xef, 0x4a, 0x93, 0x93,
-	0xb5, 0x4c, 0x22, 0x01, 0x52, 0xa5, 0xab, 0x6b, 0x91, 0xd8, 0xfc, 0x60, 0xa2, 0x9d, 0xb3, 0x02,
-	0xf1, 0x85, 0x24, 0x92, 0xe1, 0xf7, 0x06, 0xb2, 0xe6, 0xf6, 0x7e, 0x89, 0xef, 0x83, 0x12, 0x2d,
-	0xa3, 0x61, 0xb4, 0xb6, 0xbb, 0x67, 0xce, 0x86, 0x4f, 0xee, 0xf4, 0x67, 0x86, 0x8b, 0xb5, 0x4e,
-	0xb7, 0xce, 0xbf, 0xdc, 0xaa, 0x78, 0x47, 0x74, 0xa5, 0x8a, 0x47, 0x08, 0x2b, 0xd0, 0x25, 0x04,
-	0x53, 0x23, 0xf4, 0x36, 0x46, 0x78, 0x2c, 0x40, 0xae, 0x28, 0xbe, 0x1f, 0x2d, 0xc5, 0x9b, 0xdf,
-	0x4d, 0x74, 0xb4, 0x9a, 0x17, 0x27, 0xe8, 0x2a, 0xa1, 0x92, 0x8f, 0x99, 0x4f, 0x23, 0x92, 0xa6,
-	0x2c, 0x06, 0xcb, 0x68, 0x54, 0x5b, 0xdb, 0xdd, 0x87, 0x1b, 0xe3, 0xf4, 0xb4, 0x4f, 0xbf, 0xb0,
-	0x29, 0x59, 0xf6, 0xc8, 0x62, 0x10, 0xf0, 0x5b, 0x03, 0x1d, 0xac, 0xb0, 0xb1, 0x4c, 0x5d, 0xf3,
-	0xc9, 0xc6, 0x35, 0x3d, 0x16, 0x72, 0x90, 0x2c, 0x67, 0xc1, 0x60, 0x76, 0x63, 0xaf, 0xb8, 0xaf,
-	0x24, 0xc0, 0x7c, 0x59, 0x00, 0x7c, 0x88, 0xfe, 0xcb, 0x44, 0x2e, 0xc1, 0xaa, 0x36, 0xaa, 0xad,
-	0xba, 0x57, 0x6c, 0xf0, 0x4b, 0x54, 0xcb, 0x48, 0x4e, 0x12, 0xb0, 0xb6, 0xf4, 0x40, 0xee, 0xaf,
-	0x47, 0xb3, 0xf0, 0xe2, 0x8e, 0x3b, 0xce, 0x73, 0xed, 0x50, 0xd6, 0x2e, 0xfd, 0x9a, 0xdf, 0x4c,
-	0xb4, 0xbf, 0x3c, 0xac, 0x7f, 0xb3, 0xf3, 0x18, 0x6d, 0xa9, 0x66, 0x5b, 0xd5, 0x86, 0xd1, 0xaa,
-	0x7b, 0x7a, 0x8d, 0xbd, 0xa5, 0xbe, 0xdf, 0x5b, 0x8f, 0x45, 0x7f, 0xf1, 0xbf, 0xeb, 0xf8, 0x27,
-	0x03, 0xed, 0xfe, 0xd4, 0x15, 0x7c, 0x1b, 0xed, 0x52, 0x91, 0xa6, 0x8c, 0x2a, 0x47, 0x9f, 0x07,
-	0xfa, 0xc3, 0xaf, 0x7b, 0x3b, 0xf3, 0xe0, 0x20, 0xc0, 0xd7, 0xd1, 0xff, 0x0a, 0x49, 0xc9, 0xa6,
-	0x96, 0x6b, 0x6a, 0x3b, 0x08, 0xf0, 0x4d, 0x84, 0xca, 0x29, 0x29, 0xad, 0xa0, 0xaf, 0x97, 0x91,
-	0x41, 0x80, 0xbb, 0xe8, 0x1a, 0x07, 0x3f, 0xe1, 0x41, 0x10, 0xb3, 0xd7, 0x24, 0x67, 0x3e, 0x4b,
-	0xc9, 0x30, 0x66, 0x81, 0x7e, 0xa2, 0x2b, 0xde, 0x01, 0x87, 0xa7, 0x33, 0xed, 0x51, 0x21, 0x35,
-	0xdf, 0x19, 0xe8, 0xc6, 0x1f, 0x9a, 0xf8, 0x97, 0xc0, 0x77, 0xd4, 0xdb, 0xa5, 0x8d, 0x7c, 0x12,
-	0x04, 0x39, 0x03, 0x28, 0xa9, 0xf7, 0xca, 0x70, 0xaf, 0x88, 0x9e, 0x46, 0xe7, 0x13, 0xdb, 0xb8,
-	0x98, 0xd8, 0xc6, 0xd7, 0x89, 0x6d, 0x7c, 0x9c, 0xda, 0x95, 0x8b, 0xa9, 0x5d, 0xf9, 0x3c, 0xb5,
-	0x2b, 0xaf, 0x9e, 0x85, 0x5c, 0x46, 0xa3, 0xa1, 0x43, 0x45, 0xe2, 0x52, 0x01, 0x89, 0x00, 0x75,
-	0x3c, 0xb4, 0x43, 0xe1, 0x8e, 0x3b, 0x1d, 0x37, 0x11, 0xc1, 0x28, 0x66, 0xa0, 0xfe, 0xd0, 0xe0,
-	0x76, 0x4f, 0xda, 0xf3, 0x11, 0xb5, 0x7f, 0x39, 0x67, 0xe4, 0x9b, 0x8c, 0xc1, 0xb0, 0xa6, 0x7f,
-	0xcf, 0x77, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x72, 0x3b, 0x4e, 0xa4, 0x06, 0x00, 0x00,
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xcd, 0x6e, 0x13, 0x3b,
+	0x14, 0xce, 0x24, 0x69, 0xee, 0x8d, 0xfb, 0x97, 0xeb, 0xf6, 0xf6, 0x8e, 0x7a, 0x45, 0x88, 0xc2,
+	0x82, 0x6c, 0x3a, 0xa3, 0x04, 0xa4, 0x4a, 0x48, 0x20, 0xa5, 0x15, 0x2a, 0x91, 0x68, 0xa9, 0xd2,
+	0x0d, 0x62, 0x33, 0x72, 0x3c, 0xd6, 0xc4, 0xd2, 0xcc, 0x78, 0x34, 0xc7, 0x49, 0x55, 0xb6, 0x20,
+	0xb1, 0x84, 0x47, 0xe0, 0x71, 0xba, 0xec, 0x92, 0x15, 0xa0, 0xf6, 0x11, 0x78, 0x01, 0x64, 0x8f,
+	0xd3, 0x84, 0x34, 0x45, 0x09, 0x2c, 0x59, 0xc5, 0x3e, 0x67, 0xce, 0xf7, 0x7d, 0xf6, 0x77, 0xe2,
+	0x83, 0x1e, 0xf3, 0x1e, 0x75, 0x49, 0x92, 0x84, 0x9c, 0x12, 0xc9, 0x45, 0x0c, 0x2e, 0x8f, 0x25,
+	0x4b, 0x69, 0x9f, 0xf0, 0xd8, 0x23, 0x94, 0x8a, 0x41, 0x2c, 0xc1, 0x0d, 0x58, 0xcc, 0x80, 0x83,
+	0x3b, 0x6c, 0x8e, 0x96, 0x4e, 0x92, 0x0a, 0x29, 0xb0, 0xcb, 0x7b, 0xd4, 0x99, 0x2c, 0x77, 0x66,
+	0x94, 0x3b, 0xa3, 0x9a, 0x61, 0x73, 0x7b, 0x33, 0x10, 0x81, 0xd0, 0xb5, 0xae, 0x5a, 0x65, 0x30,
+	0xdb, 0xfb, 0x73, 0xa9, 0xa0, 0x22, 0x96, 0xa9, 0x08, 0x43, 0x96, 0x2a, 0x21, 0xe3, 0x9d, 0x01,
+	0xd9, 0x9d, 0x0b, 0xa4, 0x2f, 0x40, 0xaa, 0x72, 0xf5, 0x9b, 0x15, 0xd6, 0xdf, 0xe7, 0xd1, 0xca,
+	0x41, 0x26, 0xf1, 0x44, 0x12, 0xc9, 0xf0, 0x3b, 0x0b, 0xd9, 0x63, 0x78, 0xcf, 0xc8, 0xf7, 0x40,
+	0x25, 0x6d, 0xab, 0x66, 0x35, 0x96, 0x5b, 0x07, 0xce, 0x82, 0x27, 0x77, 0xf6, 0xaf, 0x01, 0x27,
+	0xb9, 0xf6, 0x8a, 0xe7, 0x9f, 0xef, 0xe6, 0xba, 0x5b, 0x74, 0x66, 0x16, 0x0f, 0x10, 0x56, 0x42,
+	0xa7, 0x24, 0xe4, 0xb5, 0x84, 0xf6, 0xc2, 0x12, 0x9e, 0x09, 0x90, 0x33, 0xc8, 0x2b, 0xfd, 0xa9,
+	0x78, 0xfd, 0x5b, 0x01, 0x6d, 0xcd, 0xd6, 0x8b, 0x23, 0xb4, 0x4e, 0xa8, 0xe4, 0x43, 0xe6, 0xd1,
+	0x3e, 0x89, 0x63, 0x16, 0x82, 0x6d, 0xd5, 0x0a, 0x8d, 0xe5, 0xd6, 0x93, 0x85, 0xe5, 0xb4, 0x35,
+	0xce, 0x7e, 0x06, 0x63, 0xb4, 0xac, 0x91, 0xc9, 0x20, 0xe0, 0x37, 0x16, 0xda, 0x98, 0x01, 0x63,
+	0xe7, 0x35, 0xe7, 0xf3, 0x85, 0x39, 0xbb, 0x2c, 0xe0, 0x20, 0x59, 0xca, 0xfc, 0xce, 0xf5, 0x87,
+	0xed, 0xec, 0x3b, 0xa3, 0x00, 0xf3, 0xe9, 0x04, 0xe0, 0x4d, 0xb4, 0x94, 0x88, 0x54, 0x82, 0x5d,
+	0xa8, 0x15, 0x1a, 0xe5, 0x6e, 0xb6, 0xc1, 0x2f, 0x51, 0x29, 0x21, 0x29, 0x89, 0xc0, 0x2e, 0x6a,
+	0x43, 0x1e, 0xcd, 0xa7, 0x66, 0xa2, 0x71, 0x87, 0x4d, 0xe7, 0x58, 0x23, 0x18, 0x6e, 0x83, 0x87,
+	0x25, 0x42, 0xe2, 0x34, 0x66, 0x29, 0xf4, 0x79, 0x02, 0xf6, 0x92, 0x3e, 0xeb, 0xd1, 0xaf, 0xa0,
+	0xdf, 0x38, 0xe4, 0x8b, 0x11, 0xac, 0x61, 0x9c, 0xe0, 0xa9, 0x7f, 0x29, 0xa2, 0xca, 0x74, 0x8b,
+	0xfc, 0x99, 0x7e, 0x63, 0x54, 0x54, 0x16, 0xdb, 0x85, 0x9a, 0xd5, 0x28, 0x77, 0xf5, 0x1a, 0x77,
+	0xa7, 0xdc, 0x7e, 0x38, 0x9f, 0x16, 0xfd, 0xce, 0xdc, 0xe6, 0x73, 0x8a, 0x2a, 0x11, 0x03, 0x20,
+	0x01, 0xf3, 0x12, 0x11, 0x72, 0xca, 0xd9, 0xc8, 0xed, 0xf6, 0x62, 0xe8, 0x27, 0x54, 0x24, 0xcc,
+	0x3f, 0xcc, 0xb0, 0x8e, 0x15, 0xd4, 0x99, 0xa1, 0x5a, 0x8f, 0x26, 0x82, 0x9c, 0x01, 0x7e, 0x8d,
+	0xfe, 0x89, 0x78, 0x90, 0x12, 0xc9, 0xfc, 0xf1, 0xf5, 0x96, 0x6a, 0x85, 0xf9, 0x1f, 0xb5, 0x11,
+	0xe9, 0xa1, 0x81, 0xb9, 0xed, 0x66, 0x2b, 0x23, 0x1e, 0x13, 0x86, 0xfa, 0x47, 0x0b, 0xad, 0xfe,
+	0xd0, 0x05, 0xf8, 0x1e, 0x5a, 0xa5, 0x22, 0x8e, 0x19, 0x55, 0x74, 0x1e, 0xf7, 0xf5, 0xf3, 0x5a,
+	0xee, 0xae, 0x8c, 0x83, 0x1d, 0x1f, 0xff, 0x87, 0xfe, 0x52, 0x16, 0xa8, 0x74, 0x5e, 0xa7, 0x4b,
+	0x6a, 0xdb, 0xf1, 0xf1, 0x1d, 0x84, 0x4c, 0x57, 0xaa, 0x5c, 0xe6, 0x56, 0xd9, 0x44, 0x3a, 0x3e,
+	0x6e, 0xa1, 0x7f, 0x39, 0x78, 0x11, 0xf7, 0xfd, 0x90, 0x9d, 0x92, 0x94, 0x79, 0x2c, 0x26, 0xbd,
+	0x90, 0xf9, 0xda, 0xc1, 0xbf, 0xbb, 0x1b, 0x1c, 0x0e, 0xaf, 0x73, 0x4f, 0xb3, 0x54, 0xfd, 0xad,
+	0x85, 0xfe, 0xff, 0x49, 0xd3, 0xfc, 0xa6, 0xe0, 0xfb, 0xea, 0xdf, 0xa4, 0x81, 0x3c, 0xe2, 0xfb,
+	0x29, 0x03, 0x30, 0xaa, 0xd7, 0x4c, 0xb8, 0x9d, 0x45, 0xf7, 0xfa, 0xe7, 0x97, 0x55, 0xeb, 0xe2,
+	0xb2, 0x6a, 0x7d, 0xbd, 0xac, 0x5a, 0x1f, 0xae, 0xaa, 0xb9, 0x8b, 0xab, 0x6a, 0xee, 0xd3, 0x55,
+	0x35, 0xf7, 0xea, 0x28, 0xe0, 0xb2, 0x3f, 0xe8, 0x39, 0x54, 0x44, 0x2e, 0x15, 0x10, 0x09, 0x50,
+	0x43, 0x78, 0x27, 0x10, 0xee, 0xb0, 0xd9, 0x74, 0x23, 0xe1, 0x0f, 0x42, 0x06, 0x6a, 0x0e, 0x82,
+	0xdb, 0xda, 0xdd, 0x19, 0xfb, 0xb7, 0x73, 0x63, 0x9a, 0xcb, 0xb3, 0x84, 0x41, 0xaf, 0xa4, 0x87,
+	0xe0, 0x83, 0xef, 0x03, 0x00, 0xec, 0x3d, 0xb9, 0xd4, 0x0a, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -463,6 +493,20 @@ func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Ownerships) > 0 {
+		for iNdEx := len(m.Ownerships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ownerships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -533,6 +577,34 @@ func (m *HostGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MigratedAccounts) > 0 {
+		for iNdEx := len(m.MigratedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigratedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MessagePolicies) > 0 {
+		for iNdEx := len(m.MessagePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessagePolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -729,6 +801,12 @@ func (m *ControllerGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Ownerships) > 0 {
+		for _, e := range m.Ownerships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -756,6 +834,18 @@ func (m *HostGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MessagePolicies) > 0 {
+		for _, e := range m.MessagePolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MigratedAccounts) > 0 {
+		for _, e := range m.MigratedAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1088,6 +1178,40 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ownerships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ownerships = append(m.Ownerships, types.InterchainAccountOwnership{})
+			if err := m.Ownerships[len(m.Ownerships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1271,6 +1395,74 @@ func (m *HostGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagePolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessagePolicies = append(m.MessagePolicies, types1.ScopedMessagePolicy{})
+			if err := m.MessagePolicies[len(m.MessagePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigratedAccounts = append(m.MigratedAccounts, types1.MigratedInterchainAccount{})
+			if err := m.MigratedAccounts[len(m.MigratedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
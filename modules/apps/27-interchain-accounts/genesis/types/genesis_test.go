@@ -12,6 +12,7 @@ import (
 	hosttypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v11/testing"
 )
 
@@ -189,6 +190,32 @@ func (s *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"success: ownership",
+			func() {
+				genesisState.Ownerships = []controllertypes.InterchainAccountOwnership{
+					controllertypes.NewInterchainAccountOwnership(TestPortID, ibctesting.FirstConnectionID, []string{TestOwnerAddress}, 1),
+				}
+			},
+			nil,
+		},
+		{
+			"failed to validate ownership - threshold exceeds the number of owners",
+			func() {
+				genesisState.Ownerships = []controllertypes.InterchainAccountOwnership{
+					controllertypes.NewInterchainAccountOwnership(TestPortID, ibctesting.FirstConnectionID, []string{TestOwnerAddress}, 2),
+				}
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failed to validate ownerships - duplicate ownership",
+			func() {
+				ownership := controllertypes.NewInterchainAccountOwnership(TestPortID, ibctesting.FirstConnectionID, []string{TestOwnerAddress}, 1)
+				genesisState.Ownerships = []controllertypes.InterchainAccountOwnership{ownership, ownership}
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -313,6 +340,25 @@ func (s *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"success: migrated accounts",
+			func() {
+				genesisState.MigratedAccounts = []hosttypes.MigratedInterchainAccount{
+					hosttypes.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, TestPortID, TestOwnerAddress, ibctesting.FirstClientID, TestOwnerAddress, []byte("salt")),
+				}
+			},
+			nil,
+		},
+		{
+			"failed to validate migrated accounts - duplicate interchain account",
+			func() {
+				genesisState.MigratedAccounts = []hosttypes.MigratedInterchainAccount{
+					hosttypes.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, TestPortID, TestOwnerAddress, ibctesting.FirstClientID, TestOwnerAddress, nil),
+					hosttypes.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, TestPortID, TestOwnerAddress, ibctesting.FirstClientID, TestOwnerAddress, []byte("salt")),
+				}
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -20,6 +20,8 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdParams(),
 		GetCmdPacketEvents(),
+		GetCmdQueryInterchainAccount(),
+		GetCmdQueryEffectiveMessagePolicy(),
 	)
 
 	return queryCmd
@@ -52,6 +52,73 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryInterchainAccount returns the command handler for querying the address of an interchain account controlled over IBC v2.
+func GetCmdQueryInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-account [owner] [client-id]",
+		Short:   "Query the interchain account address for a given owner on a particular client",
+		Long:    "Query the host submodule for the address of the interchain account controlled over IBC v2 by a given owner on a particular client of the controller chain",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-account cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs 07-tendermint-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryInterchainAccountRequest{
+				Owner:    args[0],
+				ClientId: args[1],
+			}
+
+			res, err := queryClient.InterchainAccount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEffectiveMessagePolicy returns the command handler for querying the message policy in effect for a connection.
+func GetCmdQueryEffectiveMessagePolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "effective-message-policy [connection-id]",
+		Short:   "Query the message policy in effect for the interchain accounts of a particular connection",
+		Long:    "Query the host submodule for the message policy in effect for the interchain accounts of a particular connection, along with whether it is set on the connection, on its client or derived from the params",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host effective-message-policy connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryEffectiveMessagePolicyRequest{
+				ConnectionId: args[0],
+			}
+
+			res, err := queryClient.EffectiveMessagePolicy(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdPacketEvents returns the command handler for the host packet events querying.
 func GetCmdPacketEvents() *cobra.Command {
 	cmd := &cobra.Command{
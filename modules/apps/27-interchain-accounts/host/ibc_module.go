@@ -113,7 +113,7 @@ func (im *IBCModule) OnRecvPacket(
 	ctx sdk.Context,
 	_ string,
 	packet channeltypes.Packet,
-	_ sdk.AccAddress,
+	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
 	if !im.keeper.GetParams(ctx).HostEnabled {
 		im.keeper.Logger(ctx).Info("host submodule is disabled")
@@ -121,7 +121,7 @@ func (im *IBCModule) OnRecvPacket(
 		return channeltypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
 	}
 
-	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
+	txResponse, err := im.keeper.OnRecvPacket(ctx, packet, relayer)
 	ack := channeltypes.NewResultAcknowledgement(txResponse)
 	if err != nil {
 		ack = channeltypes.NewErrorAcknowledgement(err)
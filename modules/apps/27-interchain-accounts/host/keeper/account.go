@@ -35,3 +35,51 @@ func (k *Keeper) createInterchainAccount(ctx sdk.Context, connectionID, controll
 
 	return accAddress, nil
 }
+
+// getOrCreateClientInterchainAccount returns the address of the interchain account registered over IBC v2 for the host clientID
+// and the controller portID, creating the account if it does not exist yet. The address is generated predictably from the
+// clientID and portID, thus it may be funded before the account is created. An existing base account at the generated address
+// is converted into an interchain account. Any other existing account, e.g. a vesting account created at the predictable
+// address by a third party, cannot be taken over, thus the interchain account is created at a fallback address generated
+// with block dependent information instead, as for interchain accounts registered over IBC v1.
+func (k *Keeper) getOrCreateClientInterchainAccount(ctx sdk.Context, clientID, controllerPortID string) (string, error) {
+	if address, found := k.GetClientInterchainAccountAddress(ctx, clientID, controllerPortID); found {
+		return address, nil
+	}
+
+	accAddress := icatypes.GenerateClientAddress(clientID, controllerPortID)
+
+	var interchainAccount *icatypes.InterchainAccount
+	switch acc := k.accountKeeper.GetAccount(ctx, accAddress).(type) {
+	case nil:
+		interchainAccount = icatypes.NewInterchainAccount(
+			authtypes.NewBaseAccountWithAddress(accAddress),
+			controllerPortID,
+		)
+
+		k.accountKeeper.NewAccount(ctx, interchainAccount)
+	case *authtypes.BaseAccount:
+		// the generated address has no private key, thus the account can only have been created by receiving funds
+		interchainAccount = icatypes.NewInterchainAccount(acc, controllerPortID)
+	default:
+		accAddress = icatypes.GenerateClientFallbackAddress(ctx, clientID, controllerPortID)
+		if acc := k.accountKeeper.GetAccount(ctx, accAddress); acc != nil {
+			return "", errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "existing account for generated interchain account address %s", accAddress)
+		}
+
+		k.Logger(ctx).Info("predictable interchain account address is taken, using fallback address", "client-id", clientID, "port-id", controllerPortID, "address", accAddress)
+
+		interchainAccount = icatypes.NewInterchainAccount(
+			authtypes.NewBaseAccountWithAddress(accAddress),
+			controllerPortID,
+		)
+
+		k.accountKeeper.NewAccount(ctx, interchainAccount)
+	}
+
+	k.accountKeeper.SetAccount(ctx, interchainAccount)
+
+	k.SetClientInterchainAccountAddress(ctx, clientID, controllerPortID, interchainAccount.Address)
+
+	return interchainAccount.Address, nil
+}
@@ -3,6 +3,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -45,3 +46,41 @@ func EmitHostDisabledEvent(ctx sdk.Context, packet channeltypes.Packet) {
 		),
 	)
 }
+
+// EmitPayloadAcknowledgementEvent emits an event signalling a successful or failed acknowledgement of an IBC v2 packet
+// and including the error details if any.
+func EmitPayloadAcknowledgementEvent(ctx sdk.Context, clientID, controllerPortID string, sequence uint64, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyHostClientID, clientID),
+		sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, controllerPortID),
+		sdk.NewAttribute(icatypes.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, strconv.FormatBool(err == nil)),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyAckError, err.Error()))
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypePacket,
+			attributes...,
+		),
+	)
+}
+
+// EmitAccountMigratedEvent emits an event signalling the migration of an interchain account to a 27-gmp account.
+func EmitAccountMigratedEvent(ctx sdk.Context, migratedAccount types.MigratedInterchainAccount) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeAccountMigrated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, migratedAccount.ConnectionId),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, migratedAccount.PortId),
+			sdk.NewAttribute(icatypes.AttributeKeyAccountAddress, migratedAccount.AccountAddress),
+			sdk.NewAttribute(icatypes.AttributeKeyGMPClientID, migratedAccount.ClientId),
+			sdk.NewAttribute(icatypes.AttributeKeyGMPSender, migratedAccount.Sender),
+			sdk.NewAttribute(icatypes.AttributeKeyGMPSalt, hex.EncodeToString(migratedAccount.Salt)),
+		),
+	)
+}
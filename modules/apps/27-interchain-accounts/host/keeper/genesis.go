@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	genesistypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/genesis/types"
+	hosttypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 )
 
@@ -27,14 +28,32 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.HostGenesisS
 		panic(fmt.Errorf("could not set ica host params at genesis: %w", err))
 	}
 	keeper.SetParams(ctx, state.Params)
+
+	if err := hosttypes.ValidateMessagePolicies(state.MessagePolicies); err != nil {
+		panic(fmt.Errorf("could not set ica host message policies at genesis: %w", err))
+	}
+	for _, policy := range state.MessagePolicies {
+		keeper.SetMessagePolicy(ctx, policy)
+	}
+
+	if err := hosttypes.ValidateMigratedAccounts(state.MigratedAccounts); err != nil {
+		panic(fmt.Errorf("could not set ica host migrated accounts at genesis: %w", err))
+	}
+	for _, migratedAccount := range state.MigratedAccounts {
+		keeper.SetMigratedAccount(ctx, migratedAccount)
+	}
 }
 
 // ExportGenesis returns the interchain accounts host exported genesis
 func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.HostGenesisState {
-	return genesistypes.NewHostGenesisState(
+	genesisState := genesistypes.NewHostGenesisState(
 		keeper.GetAllActiveChannels(ctx),
 		keeper.GetAllInterchainAccounts(ctx),
 		icatypes.HostPortID,
 		keeper.GetParams(ctx),
 	)
+	genesisState.MessagePolicies = keeper.GetAllMessagePolicies(ctx)
+	genesisState.MigratedAccounts = keeper.GetAllMigratedAccounts(ctx)
+
+	return genesisState
 }
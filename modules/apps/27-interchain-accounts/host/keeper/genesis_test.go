@@ -3,6 +3,10 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	genesistypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/genesis/types"
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
@@ -29,6 +33,12 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Port: icatypes.HostPortID,
+		MessagePolicies: []types.ScopedMessagePolicy{
+			types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", types.NewMessagePolicy([]string{"*"}, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, 0)),
+		},
+		MigratedAccounts: []types.MigratedInterchainAccount{
+			types.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, TestPortID, interchainAccAddr.String(), ibctesting.FirstClientID, TestOwnerAddress, []byte("salt")),
+		},
 	}
 
 	keeper.InitGenesis(s.chainA.GetContext(), *s.chainA.GetSimApp().ICAHostKeeper, genesisState)
@@ -45,6 +55,14 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	params := s.chainA.GetSimApp().ICAHostKeeper.GetParams(s.chainA.GetContext())
 	s.Require().Equal(expParams, params)
 
+	policy, found := s.chainA.GetSimApp().ICAHostKeeper.GetConnectionMessagePolicy(s.chainA.GetContext(), ibctesting.FirstConnectionID)
+	s.Require().True(found)
+	s.Require().Equal(genesisState.MessagePolicies[0].Policy, policy)
+
+	migratedAccount, found := s.chainA.GetSimApp().ICAHostKeeper.GetMigratedAccount(s.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+	s.Require().True(found)
+	s.Require().Equal(genesisState.MigratedAccounts[0], migratedAccount)
+
 	store := s.chainA.GetContext().KVStore(s.chainA.GetSimApp().GetKey(types.StoreKey))
 	s.Require().True(store.Has(icatypes.KeyPort(icatypes.HostPortID)))
 }
@@ -120,6 +138,12 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		interchainAccAddr, exists := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 		s.Require().True(exists)
 
+		expPolicy := types.NewScopedMessagePolicy("", ibctesting.FirstClientID, types.NewMessagePolicy(nil, nil, sdk.NewCoins(ibctesting.TestCoin), time.Hour))
+		s.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(s.chainB.GetContext(), expPolicy)
+
+		expMigratedAccount := types.NewMigratedInterchainAccount(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID, interchainAccAddr, ibctesting.FirstClientID, TestOwnerAddress, []byte("salt"))
+		s.chainB.GetSimApp().ICAHostKeeper.SetMigratedAccount(s.chainB.GetContext(), expMigratedAccount)
+
 		genesisState := keeper.ExportGenesis(s.chainB.GetContext(), *s.chainB.GetSimApp().ICAHostKeeper)
 
 		s.Require().Equal(path.EndpointB.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -132,5 +156,8 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 
 		expParams := types.DefaultParams()
 		s.Require().Equal(expParams, genesisState.GetParams())
+
+		s.Require().Equal([]types.ScopedMessagePolicy{expPolicy}, genesisState.MessagePolicies)
+		s.Require().Equal([]types.MigratedInterchainAccount{expMigratedAccount}, genesisState.MigratedAccounts)
 	}
 }
@@ -5,9 +5,15 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -21,3 +27,62 @@ func (k *Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*ty
 		Params: &params,
 	}, nil
 }
+
+// InterchainAccount implements the Query/InterchainAccount gRPC method. Addresses of interchain accounts controlled over
+// IBC v2 are predictable, thus the address is returned even if the account has not been created by a first packet yet,
+// unless the predictable address is taken by an account which cannot be converted into an interchain account. The
+// interchain account is then created at a fallback address on its first packet.
+func (k *Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+	}
+
+	addr, found := k.GetClientInterchainAccountAddress(ctx, req.ClientId, portID)
+	if !found {
+		accAddress := icatypes.GenerateClientAddress(req.ClientId, portID)
+		switch k.accountKeeper.GetAccount(ctx, accAddress).(type) {
+		case nil, *authtypes.BaseAccount:
+			addr = accAddress.String()
+		default:
+			return nil, status.Errorf(codes.NotFound, "predictable interchain account address %s is taken, the interchain account is created at a fallback address on its first packet", accAddress)
+		}
+	}
+
+	return &types.QueryInterchainAccountResponse{
+		Address: addr,
+	}, nil
+}
+
+// EffectiveMessagePolicy implements the Query/EffectiveMessagePolicy gRPC method
+func (k *Keeper) EffectiveMessagePolicy(goCtx context.Context, req *types.QueryEffectiveMessagePolicyRequest) (*types.QueryEffectiveMessagePolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policy, source, err := k.GetEffectiveMessagePolicy(ctx, req.ConnectionId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryEffectiveMessagePolicyResponse{
+		Policy: policy,
+		Source: source,
+	}, nil
+}
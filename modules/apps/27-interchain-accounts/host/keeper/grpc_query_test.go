@@ -3,7 +3,16 @@
 package keeper_test
 
 import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
 )
 
 func (s *KeeperTestSuite) TestQueryParams() {
@@ -12,3 +21,170 @@ func (s *KeeperTestSuite) TestQueryParams() {
 	res, _ := s.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	s.Require().Equal(&expParams, res.Params)
 }
+
+func (s *KeeperTestSuite) TestQueryInterchainAccount() {
+	var (
+		req     *types.QueryInterchainAccountRequest
+		expAddr string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: predicted address of account not created yet",
+			func() {},
+			nil,
+		},
+		{
+			"success: registered account",
+			func() {
+				expAddr = ibctesting.TestAccAddress
+				s.chainA.GetSimApp().ICAHostKeeper.SetClientInterchainAccountAddress(s.chainA.GetContext(), ibctesting.FirstClientID, TestPortID, expAddr)
+			},
+			nil,
+		},
+		{
+			"success: predicted address of account funded before its creation",
+			func() {
+				accAddress := icatypes.GenerateClientAddress(ibctesting.FirstClientID, TestPortID)
+				s.chainA.GetSimApp().AccountKeeper.SetAccount(s.chainA.GetContext(), s.chainA.GetSimApp().AccountKeeper.NewAccountWithAddress(s.chainA.GetContext(), accAddress))
+			},
+			nil,
+		},
+		{
+			"failure: predictable address taken by a vesting account",
+			func() {
+				accAddress := icatypes.GenerateClientAddress(ibctesting.FirstClientID, TestPortID)
+				vestingAcc, err := vestingtypes.NewPermanentLockedAccount(authtypes.NewBaseAccountWithAddress(accAddress), sdk.NewCoins(ibctesting.TestCoin))
+				s.Require().NoError(err)
+				s.chainA.GetSimApp().AccountKeeper.SetAccount(s.chainA.GetContext(), s.chainA.GetSimApp().AccountKeeper.NewAccount(s.chainA.GetContext(), vestingAcc))
+			},
+			status.Errorf(codes.NotFound, "predictable interchain account address %s is taken, the interchain account is created at a fallback address on its first packet", icatypes.GenerateClientAddress(ibctesting.FirstClientID, TestPortID)),
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"failure: empty owner",
+			func() {
+				req.Owner = " "
+			},
+			status.Error(codes.InvalidArgument, "failed to generate portID from owner address: owner address cannot be empty: invalid account address"),
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				req.ClientId = "/"
+			},
+			status.Error(codes.InvalidArgument, "identifier / cannot contain separator '/': invalid identifier"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			req = &types.QueryInterchainAccountRequest{
+				Owner:    TestOwnerAddress,
+				ClientId: ibctesting.FirstClientID,
+			}
+			expAddr = icatypes.GenerateClientAddress(ibctesting.FirstClientID, TestPortID).String()
+
+			tc.malleate()
+
+			res, err := s.chainA.GetSimApp().ICAHostKeeper.InterchainAccount(s.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(expAddr, res.Address)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryEffectiveMessagePolicy() {
+	var (
+		req       *types.QueryEffectiveMessagePolicyRequest
+		expPolicy types.MessagePolicy
+		expSource types.MessagePolicySource
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: params",
+			func() {},
+			nil,
+		},
+		{
+			"success: connection policy",
+			func() {
+				expPolicy = types.NewMessagePolicy([]string{"*"}, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, 0)
+				expSource = types.POLICY_SOURCE_CONNECTION
+
+				s.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(s.chainB.GetContext(), types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", expPolicy))
+			},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"failure: invalid connection ID",
+			func() {
+				req.ConnectionId = "/"
+			},
+			status.Error(codes.InvalidArgument, "identifier / cannot contain separator '/': invalid identifier"),
+		},
+		{
+			"failure: connection not found",
+			func() {
+				req.ConnectionId = "connection-100"
+			},
+			status.Error(codes.NotFound, "connection-id: connection-100: connection not found"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			path := ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupConnections()
+
+			req = &types.QueryEffectiveMessagePolicyRequest{
+				ConnectionId: path.EndpointB.ConnectionID,
+			}
+			expPolicy = types.NewMessagePolicy(types.DefaultParams().AllowMessages, nil, nil, 0)
+			expSource = types.POLICY_SOURCE_PARAMS
+
+			tc.malleate()
+
+			res, err := s.chainB.GetSimApp().ICAHostKeeper.EffectiveMessagePolicy(s.chainB.GetContext(), req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(expPolicy, res.Policy)
+				s.Require().Equal(expSource, res.Source)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
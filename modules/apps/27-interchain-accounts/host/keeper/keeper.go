@@ -39,6 +39,8 @@ type Keeper struct {
 	ics4Wrapper   porttypes.ICS4Wrapper
 	channelKeeper icatypes.ChannelKeeper
 	accountKeeper icatypes.AccountKeeper
+	bankKeeper    types.BankKeeper
+	gmpKeeper     types.GMPKeeper
 
 	msgRouter   icatypes.MessageRouter
 	queryRouter icatypes.QueryRouter
@@ -88,6 +90,18 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// WithBankKeeper sets the bank keeper. This function may be used after the keepers creation to enforce the send limits
+// of message policies and to pay the relayer fees of packets, which cannot be set without it.
+func (k *Keeper) WithBankKeeper(bankKeeper types.BankKeeper) {
+	k.bankKeeper = bankKeeper
+}
+
+// WithGMPKeeper sets the 27-gmp keeper. This function may be used after the keepers creation to enable the migration
+// of interchain accounts to 27-gmp accounts.
+func (k *Keeper) WithGMPKeeper(gmpKeeper types.GMPKeeper) {
+	k.gmpKeeper = gmpKeeper
+}
+
 // GetICS4Wrapper returns the ICS4Wrapper.
 func (k *Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
@@ -234,6 +248,32 @@ func (k *Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, port
 	}
 }
 
+// GetClientInterchainAccountAddress retrieves the InterchainAccount address registered over IBC v2 from the store associated
+// with the provided clientID and portID
+func (k *Keeper) GetClientInterchainAccountAddress(ctx sdk.Context, clientID, portID string) (string, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	key := icatypes.KeyClientOwnerAccount(portID, clientID)
+
+	bz, err := store.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetClientInterchainAccountAddress stores the InterchainAccount address registered over IBC v2, keyed by the associated
+// clientID and portID
+func (k *Keeper) SetClientInterchainAccountAddress(ctx sdk.Context, clientID, portID, address string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(icatypes.KeyClientOwnerAccount(portID, clientID), []byte(address)); err != nil {
+		panic(err)
+	}
+}
+
 // GetAuthority returns the 27-interchain-accounts host submodule's authority.
 func (k *Keeper) GetAuthority() string {
 	return k.authority
@@ -341,6 +341,25 @@ func (s *KeeperTestSuite) TestSetInterchainAccountAddress() {
 	s.Require().Equal(expectedAccAddr, retrievedAddr)
 }
 
+func (s *KeeperTestSuite) TestSetClientInterchainAccountAddress() {
+	var (
+		expectedAccAddr = "test-acc-addr"
+		expectedPortID  = "test-port"
+	)
+
+	_, found := s.chainB.GetSimApp().ICAHostKeeper.GetClientInterchainAccountAddress(s.chainB.GetContext(), ibctesting.FirstClientID, expectedPortID)
+	s.Require().False(found)
+
+	s.chainB.GetSimApp().ICAHostKeeper.SetClientInterchainAccountAddress(s.chainB.GetContext(), ibctesting.FirstClientID, expectedPortID, expectedAccAddr)
+
+	retrievedAddr, found := s.chainB.GetSimApp().ICAHostKeeper.GetClientInterchainAccountAddress(s.chainB.GetContext(), ibctesting.FirstClientID, expectedPortID)
+	s.Require().True(found)
+	s.Require().Equal(expectedAccAddr, retrievedAddr)
+
+	// accounts registered over IBC v2 are not exported as IBC v1 accounts
+	s.Require().Empty(s.chainB.GetSimApp().ICAHostKeeper.GetAllInterchainAccounts(s.chainB.GetContext()))
+}
+
 func (s *KeeperTestSuite) TestMetadataNotFound() {
 	var (
 		invalidPortID    = "invalid-port"
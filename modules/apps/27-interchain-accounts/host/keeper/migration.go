@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+)
+
+// GetMigratedAccount returns the migration of the interchain account of the provided controller port on the provided
+// connection, if the interchain account has been migrated to a 27-gmp account
+func (k *Keeper) GetMigratedAccount(ctx sdk.Context, connectionID, portID string) (types.MigratedInterchainAccount, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyMigratedAccount(portID, connectionID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.MigratedInterchainAccount{}, false
+	}
+
+	var migratedAccount types.MigratedInterchainAccount
+	k.cdc.MustUnmarshal(bz, &migratedAccount)
+	return migratedAccount, true
+}
+
+// IsAccountMigrated returns true if the interchain account of the provided controller port on the provided connection
+// has been migrated to a 27-gmp account, otherwise false
+func (k *Keeper) IsAccountMigrated(ctx sdk.Context, connectionID, portID string) bool {
+	_, found := k.GetMigratedAccount(ctx, connectionID, portID)
+	return found
+}
+
+// SetMigratedAccount stores the migration of an interchain account to a 27-gmp account
+func (k *Keeper) SetMigratedAccount(ctx sdk.Context, migratedAccount types.MigratedInterchainAccount) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&migratedAccount)
+	if err := store.Set(types.KeyMigratedAccount(migratedAccount.PortId, migratedAccount.ConnectionId), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllMigratedAccounts returns all interchain accounts migrated to 27-gmp accounts
+func (k *Keeper) GetAllMigratedAccounts(ctx sdk.Context) []types.MigratedInterchainAccount {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.MigratedAccountKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var migratedAccounts []types.MigratedInterchainAccount
+	for ; iterator.Valid(); iterator.Next() {
+		var migratedAccount types.MigratedInterchainAccount
+		k.cdc.MustUnmarshal(iterator.Value(), &migratedAccount)
+		migratedAccounts = append(migratedAccounts, migratedAccount)
+	}
+
+	return migratedAccounts
+}
+
+// migrateToGMPAccount links the interchain account of the migration to its 27-gmp account identifier. The interchain
+// account keeps its address and balances, and is controlled by the GMP packets of the account identifier from then on.
+func (k *Keeper) migrateToGMPAccount(ctx sdk.Context, migratedAccount types.MigratedInterchainAccount) error {
+	if k.gmpKeeper == nil {
+		return errorsmod.Wrap(types.ErrGMPNotEnabled, "cannot migrate interchain account to 27-gmp account")
+	}
+
+	if k.IsAccountMigrated(ctx, migratedAccount.ConnectionId, migratedAccount.PortId) {
+		return errorsmod.Wrapf(types.ErrAccountMigrated, "interchain account of port %s on connection %s", migratedAccount.PortId, migratedAccount.ConnectionId)
+	}
+
+	accountID := migratedAccount.AccountIdentifier()
+	if err := k.gmpKeeper.LinkAccount(ctx, &accountID, sdk.MustAccAddressFromBech32(migratedAccount.AccountAddress)); err != nil {
+		return errorsmod.Wrap(err, "failed to link interchain account to 27-gmp account identifier")
+	}
+
+	k.SetMigratedAccount(ctx, migratedAccount)
+	EmitAccountMigratedEvent(ctx, migratedAccount)
+
+	k.Logger(ctx).Info("migrated interchain account to 27-gmp account", "host-connection-id", migratedAccount.ConnectionId, "port-id", migratedAccount.PortId, "address", migratedAccount.AccountAddress)
+	return nil
+}
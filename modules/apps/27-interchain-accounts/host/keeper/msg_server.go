@@ -13,6 +13,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
 )
 
@@ -74,3 +75,54 @@ func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetMessagePolicy sets the message policy of a connection or client.
+func (m msgServer) SetMessagePolicy(goCtx context.Context, msg *types.MsgSetMessagePolicy) (*types.MsgSetMessagePolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, m.GetAuthority(), msg.Signer); err != nil {
+		return nil, err
+	}
+	m.Keeper.SetMessagePolicy(ctx, msg.ScopedPolicy)
+
+	return &types.MsgSetMessagePolicyResponse{}, nil
+}
+
+// RemoveMessagePolicy removes the message policy of a connection or client.
+func (m msgServer) RemoveMessagePolicy(goCtx context.Context, msg *types.MsgRemoveMessagePolicy) (*types.MsgRemoveMessagePolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, m.GetAuthority(), msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if _, found := m.getMessagePolicy(ctx, messagePolicyKey(msg.ConnectionId, msg.ClientId)); !found {
+		return nil, errorsmod.Wrapf(types.ErrMessagePolicyNotFound, "connection ID %q, client ID %q", msg.ConnectionId, msg.ClientId)
+	}
+	m.DeleteMessagePolicy(ctx, msg.ConnectionId, msg.ClientId)
+
+	return &types.MsgRemoveMessagePolicyResponse{}, nil
+}
+
+// MigrateToGMPAccount migrates the interchain account signing the message to a 27-gmp account. The message must be
+// executed by the interchain account of the controller port on the host connection, thus it can only be authorized by
+// the interchain accounts controller.
+func (m msgServer) MigrateToGMPAccount(goCtx context.Context, msg *types.MsgMigrateToGMPAccount) (*types.MsgMigrateToGMPAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	interchainAccountAddr, found := m.GetInterchainAccountAddress(ctx, msg.ConnectionId, msg.PortId)
+	if !found {
+		return nil, errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s and connection %s", msg.PortId, msg.ConnectionId)
+	}
+
+	if interchainAccountAddr != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected interchain account %s as signer, got %s", interchainAccountAddr, msg.Signer)
+	}
+
+	migratedAccount := types.NewMigratedInterchainAccount(msg.ConnectionId, msg.PortId, interchainAccountAddr, msg.ClientId, msg.Sender, msg.Salt)
+	if err := m.migrateToGMPAccount(ctx, migratedAccount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateToGMPAccountResponse{}, nil
+}
@@ -3,6 +3,10 @@
 package keeper_test
 
 import (
+	"encoding/hex"
+
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -10,10 +14,14 @@ import (
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	gmptypes "github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
 )
 
 func (s *KeeperTestSuite) TestModuleQuerySafe() {
@@ -219,3 +227,219 @@ func (s *KeeperTestSuite) TestUpdateParamsAuthority() {
 		s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
 	})
 }
+
+func (s *KeeperTestSuite) TestSetMessagePolicy() {
+	scopedPolicy := types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", types.NewMessagePolicy([]string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}, nil, nil, 0))
+
+	testCases := []struct {
+		name   string
+		signer string
+		expErr error
+	}{
+		{
+			"success",
+			s.chainA.GetSimApp().ICAHostKeeper.GetAuthority(),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			"signer",
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(s.chainA.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.SetMessagePolicy(ctx, types.NewMsgSetMessagePolicy(tc.signer, scopedPolicy))
+
+			policy, found := s.chainA.GetSimApp().ICAHostKeeper.GetConnectionMessagePolicy(ctx, ibctesting.FirstConnectionID)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().True(found)
+				s.Require().Equal(scopedPolicy.Policy, policy)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Nil(res)
+				s.Require().False(found)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRemoveMessagePolicy() {
+	var msg *types.MsgRemoveMessagePolicy
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "signer"
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: message policy not found",
+			func() {
+				msg.ConnectionId = ""
+				msg.ClientId = ibctesting.FirstClientID
+			},
+			types.ErrMessagePolicyNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.chainA.GetContext()
+			s.chainA.GetSimApp().ICAHostKeeper.SetMessagePolicy(ctx, types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", types.NewMessagePolicy([]string{"*"}, nil, nil, 0)))
+
+			msg = types.NewMsgRemoveMessagePolicy(s.chainA.GetSimApp().ICAHostKeeper.GetAuthority(), ibctesting.FirstConnectionID, "")
+
+			tc.malleate()
+
+			msgServer := keeper.NewMsgServerImpl(s.chainA.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.RemoveMessagePolicy(ctx, msg)
+
+			_, found := s.chainA.GetSimApp().ICAHostKeeper.GetConnectionMessagePolicy(ctx, ibctesting.FirstConnectionID)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().False(found)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Nil(res)
+				s.Require().True(found)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMigrateToGMPAccount() {
+	var (
+		path                  *ibctesting.Path
+		interchainAccountAddr string
+		msg                   *types.MsgMigrateToGMPAccount
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: interchain account not found",
+			func() {
+				msg.PortId = "icacontroller-unknown"
+			},
+			icatypes.ErrInterchainAccountNotFound,
+		},
+		{
+			"failure: signer is not the interchain account",
+			func() {
+				msg.Signer = s.chainB.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: interchain account already migrated",
+			func() {
+				migratedAccount := types.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, interchainAccountAddr, ibctesting.FirstClientID, TestOwnerAddress, nil)
+				s.chainB.GetSimApp().ICAHostKeeper.SetMigratedAccount(s.chainB.GetContext(), migratedAccount)
+			},
+			types.ErrAccountMigrated,
+		},
+		{
+			"failure: 27-gmp account already exists for account identifier",
+			func() {
+				ics27Account := gmptypes.NewICS27Account(s.chainB.SenderAccount.GetAddress().String(), &gmptypes.AccountIdentifier{ClientId: msg.ClientId, Sender: msg.Sender, Salt: msg.Salt})
+				err := s.chainB.GetSimApp().GMPKeeper.Accounts.Set(s.chainB.GetContext(), collections.Join3(msg.ClientId, msg.Sender, msg.Salt), ics27Account)
+				s.Require().NoError(err)
+			},
+			gmptypes.ErrAccountAlreadyExists,
+		},
+		{
+			"failure: 27-gmp keeper not set",
+			func() {
+				s.chainB.GetSimApp().ICAHostKeeper.WithGMPKeeper(nil)
+			},
+			types.ErrGMPNotEnabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path = NewICAPath(s.chainA, s.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			s.Require().NoError(err)
+
+			var found bool
+			interchainAccountAddr, found = s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			s.Require().True(found)
+
+			msg = types.NewMsgMigrateToGMPAccount(interchainAccountAddr, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, ibctesting.FirstClientID, TestOwnerAddress, []byte("salt"))
+
+			tc.malleate()
+
+			ctx := s.chainB.GetContext()
+			msgServer := keeper.NewMsgServerImpl(s.chainB.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.MigrateToGMPAccount(ctx, msg)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+
+				expMigratedAccount := types.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, interchainAccountAddr, ibctesting.FirstClientID, TestOwnerAddress, []byte("salt"))
+				migratedAccount, found := s.chainB.GetSimApp().ICAHostKeeper.GetMigratedAccount(ctx, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				s.Require().True(found)
+				s.Require().Equal(expMigratedAccount, migratedAccount)
+
+				// the account identifier resolves to the interchain account address
+				accountID := expMigratedAccount.AccountIdentifier()
+				gmpAddr, err := s.chainB.GetSimApp().GMPKeeper.GetOrComputeICS27Address(ctx, &accountID)
+				s.Require().NoError(err)
+				s.Require().Equal(interchainAccountAddr, gmpAddr)
+
+				expEvents := sdk.Events{
+					sdk.NewEvent(
+						icatypes.EventTypeAccountMigrated,
+						sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+						sdk.NewAttribute(icatypes.AttributeKeyConnectionID, ibctesting.FirstConnectionID),
+						sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, path.EndpointA.ChannelConfig.PortID),
+						sdk.NewAttribute(icatypes.AttributeKeyAccountAddress, interchainAccountAddr),
+						sdk.NewAttribute(icatypes.AttributeKeyGMPClientID, ibctesting.FirstClientID),
+						sdk.NewAttribute(icatypes.AttributeKeyGMPSender, TestOwnerAddress),
+						sdk.NewAttribute(icatypes.AttributeKeyGMPSalt, hex.EncodeToString([]byte("salt"))),
+					),
+				}.ToABCIEvents()
+				ibctesting.AssertEvents(&s.Suite, sdk.MarkEventsToIndex(expEvents, map[string]struct{}{}), ctx.EventManager().Events().ToABCIEvents())
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+)
+
+// GetConnectionMessagePolicy returns the message policy of the provided connection, if set
+func (k *Keeper) GetConnectionMessagePolicy(ctx sdk.Context, connectionID string) (types.MessagePolicy, bool) {
+	return k.getMessagePolicy(ctx, types.KeyConnectionMessagePolicy(connectionID))
+}
+
+// GetClientMessagePolicy returns the message policy of the provided client, if set
+func (k *Keeper) GetClientMessagePolicy(ctx sdk.Context, clientID string) (types.MessagePolicy, bool) {
+	return k.getMessagePolicy(ctx, types.KeyClientMessagePolicy(clientID))
+}
+
+// SetMessagePolicy stores the message policy of the connection or client of the scoped message policy
+func (k *Keeper) SetMessagePolicy(ctx sdk.Context, scopedPolicy types.ScopedMessagePolicy) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&scopedPolicy.Policy)
+	if err := store.Set(messagePolicyKey(scopedPolicy.ConnectionId, scopedPolicy.ClientId), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteMessagePolicy deletes the message policy of the provided connection or client
+func (k *Keeper) DeleteMessagePolicy(ctx sdk.Context, connectionID, clientID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(messagePolicyKey(connectionID, clientID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllMessagePolicies returns all message policies along with the connection or client they apply to
+func (k *Keeper) GetAllMessagePolicies(ctx sdk.Context) []types.ScopedMessagePolicy {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.MessagePolicyKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var policies []types.ScopedMessagePolicy
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		var scopedPolicy types.ScopedMessagePolicy
+		switch keySplit[1] {
+		case "connection":
+			scopedPolicy.ConnectionId = keySplit[2]
+		case "client":
+			scopedPolicy.ClientId = keySplit[2]
+		default:
+			continue
+		}
+
+		k.cdc.MustUnmarshal(iterator.Value(), &scopedPolicy.Policy)
+		policies = append(policies, scopedPolicy)
+	}
+
+	return policies
+}
+
+// GetEffectiveMessagePolicy returns the message policy in effect for the interchain accounts of the provided connection
+// along with its source. The policy of the connection takes precedence over the policy of the client of the connection.
+// If neither is set, the policy only allows the messages of the AllowMessages parameter.
+func (k *Keeper) GetEffectiveMessagePolicy(ctx sdk.Context, connectionID string) (types.MessagePolicy, types.MessagePolicySource, error) {
+	if policy, found := k.GetConnectionMessagePolicy(ctx, connectionID); found {
+		return k.withDefaultAllowMessages(ctx, policy), types.POLICY_SOURCE_CONNECTION, nil
+	}
+
+	connection, err := k.channelKeeper.GetConnection(ctx, connectionID)
+	if err != nil {
+		return types.MessagePolicy{}, types.POLICY_SOURCE_UNSPECIFIED, err
+	}
+
+	policy, source := k.GetEffectiveClientMessagePolicy(ctx, connection.ClientId)
+	return policy, source, nil
+}
+
+// GetEffectiveClientMessagePolicy returns the message policy in effect for the interchain accounts of the provided client
+// along with its source. If the client has no policy, the policy only allows the messages of the AllowMessages parameter.
+func (k *Keeper) GetEffectiveClientMessagePolicy(ctx sdk.Context, clientID string) (types.MessagePolicy, types.MessagePolicySource) {
+	if policy, found := k.GetClientMessagePolicy(ctx, clientID); found {
+		return k.withDefaultAllowMessages(ctx, policy), types.POLICY_SOURCE_CLIENT
+	}
+
+	return k.withDefaultAllowMessages(ctx, types.MessagePolicy{}), types.POLICY_SOURCE_PARAMS
+}
+
+// withDefaultAllowMessages sets the allowed messages of the policy to the AllowMessages parameter if the policy does not
+// set any allowed messages.
+func (k *Keeper) withDefaultAllowMessages(ctx sdk.Context, policy types.MessagePolicy) types.MessagePolicy {
+	if len(policy.AllowMessages) == 0 {
+		policy.AllowMessages = k.GetParams(ctx).AllowMessages
+	}
+
+	return policy
+}
+
+// checkMessagePolicy ensures the msgs are allowed and not denied by the policy. The messages nested in messages which
+// execute other messages, such as the authz MsgExec, must not be denied by the policy either.
+func checkMessagePolicy(policy types.MessagePolicy, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if types.ContainsMsgType(policy.DenyMessages, msg) {
+			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message type denied: %s", sdk.MsgTypeURL(msg))
+		}
+
+		if !types.ContainsMsgType(policy.AllowMessages, msg) {
+			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+		}
+
+		if err := checkNestedMessagesDenied(policy.DenyMessages, msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkNestedMessagesDenied ensures the messages nested in the provided message, at any depth, are not denied by the
+// provided deny list. Nested messages are not required to be allowed, as the allowed messages of a policy authorize
+// the messages executed by the interchain account, e.g. a gov MsgSubmitProposal carrying messages to be executed
+// by the gov module.
+func checkNestedMessagesDenied(denyMessages []string, msg sdk.Msg) error {
+	if len(denyMessages) == 0 {
+		return nil
+	}
+
+	nestedMsgs, err := types.GetNestedMsgs(msg)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "failed to get the nested messages of message type %s: %s", sdk.MsgTypeURL(msg), err)
+	}
+
+	for _, nestedMsg := range nestedMsgs {
+		if types.ContainsMsgType(denyMessages, nestedMsg) {
+			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "nested message type denied: %s", sdk.MsgTypeURL(nestedMsg))
+		}
+
+		if err := checkNestedMessagesDenied(denyMessages, nestedMsg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSendLimitUsage returns the coins sent by the provided interchain account within the current send limit window, if set
+func (k *Keeper) GetSendLimitUsage(ctx sdk.Context, address string) (types.SendLimitUsage, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeySendLimitUsage(address))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.SendLimitUsage{}, false
+	}
+
+	var usage types.SendLimitUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage, true
+}
+
+// SetSendLimitUsage stores the coins sent by the provided interchain account within the current send limit window
+func (k *Keeper) SetSendLimitUsage(ctx sdk.Context, address string, usage types.SendLimitUsage) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&usage)
+	if err := store.Set(types.KeySendLimitUsage(address), bz); err != nil {
+		panic(err)
+	}
+}
+
+// getSendLimitBalance returns the balance of the interchain account which is measured against the send limit of the
+// policy, or nil if the policy has no send limit.
+func (k *Keeper) getSendLimitBalance(ctx sdk.Context, policy types.MessagePolicy, interchainAccountAddr string) (sdk.Coins, error) {
+	if policy.SendLimit.Empty() {
+		return nil, nil
+	}
+
+	if k.bankKeeper == nil {
+		return nil, errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "send limits cannot be enforced: bank keeper is not set")
+	}
+
+	return k.bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(interchainAccountAddr)), nil
+}
+
+// trackSendLimit accumulates the coins which left the balance of the interchain account since the provided balance was
+// measured, whichever messages moved them, against the send limit of the policy. The coins sent are accumulated over
+// the send limit window of the policy, starting at the first send after the previous window has elapsed. An error is
+// returned if the coins sent within the window exceed the send limit.
+func (k *Keeper) trackSendLimit(ctx sdk.Context, policy types.MessagePolicy, interchainAccountAddr string, balance sdk.Coins) error {
+	if policy.SendLimit.Empty() {
+		return nil
+	}
+
+	sent := sdk.NewCoins()
+	balanceAfter := k.bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(interchainAccountAddr))
+	for _, coin := range balance {
+		if decrease := coin.Amount.Sub(balanceAfter.AmountOf(coin.Denom)); decrease.IsPositive() {
+			sent = sent.Add(sdk.NewCoin(coin.Denom, decrease))
+		}
+	}
+
+	if sent.IsZero() {
+		return nil
+	}
+
+	usage, found := k.GetSendLimitUsage(ctx, interchainAccountAddr)
+	if !found || !ctx.BlockTime().Before(usage.WindowStart.Add(policy.SendLimitWindow)) {
+		usage = types.SendLimitUsage{WindowStart: ctx.BlockTime()}
+	}
+
+	usage.Sent = usage.Sent.Add(sent...)
+	if !usage.Sent.IsAllLTE(policy.SendLimit) {
+		return errorsmod.Wrapf(types.ErrSendLimitExceeded, "interchain account %s sends %s since %s, exceeding the limit of %s", interchainAccountAddr, usage.Sent, usage.WindowStart, policy.SendLimit)
+	}
+
+	k.SetSendLimitUsage(ctx, interchainAccountAddr, usage)
+
+	return nil
+}
+
+// getMessagePolicy returns the message policy stored under the provided key, if set
+func (k *Keeper) getMessagePolicy(ctx sdk.Context, key []byte) (types.MessagePolicy, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.MessagePolicy{}, false
+	}
+
+	var policy types.MessagePolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// messagePolicyKey returns the store key of the message policy of the connection, or of the client if the connectionID is empty
+func messagePolicyKey(connectionID, clientID string) []byte {
+	if connectionID != "" {
+		return types.KeyConnectionMessagePolicy(connectionID)
+	}
+
+	return types.KeyClientMessagePolicy(clientID)
+}
@@ -0,0 +1,410 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v11/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func (s *KeeperTestSuite) TestGetAllMessagePolicies() {
+	s.SetupTest()
+
+	expPolicies := []types.ScopedMessagePolicy{
+		types.NewScopedMessagePolicy("", ibctesting.FirstClientID, types.NewMessagePolicy([]string{"*"}, []string{"/cosmos.bank.v1beta1.MsgMultiSend"}, nil, 0)),
+		types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", types.NewMessagePolicy(nil, nil, sdk.NewCoins(ibctesting.TestCoin), time.Hour)),
+	}
+
+	for _, policy := range expPolicies {
+		s.chainA.GetSimApp().ICAHostKeeper.SetMessagePolicy(s.chainA.GetContext(), policy)
+	}
+
+	policies := s.chainA.GetSimApp().ICAHostKeeper.GetAllMessagePolicies(s.chainA.GetContext())
+	s.Require().Equal(expPolicies, policies)
+
+	s.chainA.GetSimApp().ICAHostKeeper.DeleteMessagePolicy(s.chainA.GetContext(), "", ibctesting.FirstClientID)
+
+	_, found := s.chainA.GetSimApp().ICAHostKeeper.GetClientMessagePolicy(s.chainA.GetContext(), ibctesting.FirstClientID)
+	s.Require().False(found)
+
+	policy, found := s.chainA.GetSimApp().ICAHostKeeper.GetConnectionMessagePolicy(s.chainA.GetContext(), ibctesting.FirstConnectionID)
+	s.Require().True(found)
+	s.Require().Equal(expPolicies[1].Policy, policy)
+}
+
+func (s *KeeperTestSuite) TestGetEffectiveMessagePolicy() {
+	var (
+		connectionID string
+		expPolicy    types.MessagePolicy
+		expSource    types.MessagePolicySource
+	)
+
+	allowMsgs := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: params",
+			func() {},
+			nil,
+		},
+		{
+			"success: client policy of the connection",
+			func() {
+				policy := types.NewMessagePolicy([]string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}, nil, nil, 0)
+				s.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(s.chainB.GetContext(), types.NewScopedMessagePolicy("", ibctesting.FirstClientID, policy))
+
+				expPolicy = policy
+				expSource = types.POLICY_SOURCE_CLIENT
+			},
+			nil,
+		},
+		{
+			"success: connection policy takes precedence over client policy",
+			func() {
+				clientPolicy := types.NewMessagePolicy([]string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}, nil, nil, 0)
+				s.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(s.chainB.GetContext(), types.NewScopedMessagePolicy("", ibctesting.FirstClientID, clientPolicy))
+
+				policy := types.NewMessagePolicy([]string{"*"}, allowMsgs, nil, 0)
+				s.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(s.chainB.GetContext(), types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", policy))
+
+				expPolicy = policy
+				expSource = types.POLICY_SOURCE_CONNECTION
+			},
+			nil,
+		},
+		{
+			"success: policy without allowed messages falls back to params",
+			func() {
+				policy := types.NewMessagePolicy(nil, nil, sdk.NewCoins(ibctesting.TestCoin), time.Hour)
+				s.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(s.chainB.GetContext(), types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", policy))
+
+				expPolicy = types.NewMessagePolicy(allowMsgs, nil, sdk.NewCoins(ibctesting.TestCoin), time.Hour)
+				expSource = types.POLICY_SOURCE_CONNECTION
+			},
+			nil,
+		},
+		{
+			"failure: connection not found",
+			func() {
+				connectionID = "connection-100"
+			},
+			connectiontypes.ErrConnectionNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path := ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupConnections()
+
+			connectionID = path.EndpointB.ConnectionID
+			s.chainB.GetSimApp().ICAHostKeeper.SetParams(s.chainB.GetContext(), types.NewParams(true, allowMsgs))
+
+			expPolicy = types.NewMessagePolicy(allowMsgs, nil, nil, 0)
+			expSource = types.POLICY_SOURCE_PARAMS
+
+			tc.malleate()
+
+			policy, source, err := s.chainB.GetSimApp().ICAHostKeeper.GetEffectiveMessagePolicy(s.chainB.GetContext(), connectionID)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(expPolicy, policy)
+				s.Require().Equal(expSource, source)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOnRecvPacketMessagePolicy() {
+	var (
+		path   *ibctesting.Path
+		msgs   []proto.Message
+		policy types.ScopedMessagePolicy
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(icaAddress string)
+		expErr   error
+	}{
+		{
+			"success: message allowed by connection policy",
+			func(icaAddress string) {},
+			nil,
+		},
+		{
+			"success: send within the send limit",
+			func(icaAddress string) {
+				policy.Policy.SendLimit = sdk.NewCoins(ibctesting.TestCoin)
+				policy.Policy.SendLimitWindow = time.Hour
+			},
+			nil,
+		},
+		{
+			"success: client policy allows message not allowed by params",
+			func(icaAddress string) {
+				policy = types.NewScopedMessagePolicy("", ibctesting.FirstClientID, types.NewMessagePolicy([]string{"*"}, nil, nil, 0))
+			},
+			nil,
+		},
+		{
+			"success: message nested in authz MsgExec is not required to be allowed by connection policy",
+			func(icaAddress string) {
+				policy.Policy.AllowMessages = []string{sdk.MsgTypeURL(&authz.MsgExec{})}
+				policy.Policy.DenyMessages = []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}
+
+				msgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(icaAddress), []sdk.Msg{msgs[0].(sdk.Msg)})
+				msgs = []proto.Message{&msgExec}
+			},
+			nil,
+		},
+		{
+			"failure: message not allowed by connection policy",
+			func(icaAddress string) {
+				policy.Policy.AllowMessages = []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: message denied by connection policy",
+			func(icaAddress string) {
+				policy.Policy.AllowMessages = []string{"*"}
+				policy.Policy.DenyMessages = []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: deny list of client policy applies to connection",
+			func(icaAddress string) {
+				policy = types.NewScopedMessagePolicy("", ibctesting.FirstClientID, types.NewMessagePolicy(nil, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, 0))
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: sends exceed the send limit",
+			func(icaAddress string) {
+				policy.Policy.SendLimit = sdk.NewCoins(ibctesting.TestCoin)
+				policy.Policy.SendLimitWindow = time.Hour
+				msgs = append(msgs, &banktypes.MsgSend{
+					FromAddress: icaAddress,
+					ToAddress:   s.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))),
+				})
+			},
+			types.ErrSendLimitExceeded,
+		},
+		{
+			"failure: multi send exceeds the send limit",
+			func(icaAddress string) {
+				policy.Policy.AllowMessages = []string{"*"}
+				policy.Policy.SendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1)))
+				policy.Policy.SendLimitWindow = time.Hour
+
+				coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(2)))
+				msgs = []proto.Message{&banktypes.MsgMultiSend{
+					Inputs:  []banktypes.Input{banktypes.NewInput(sdk.MustAccAddressFromBech32(icaAddress), coins)},
+					Outputs: []banktypes.Output{banktypes.NewOutput(s.chainB.SenderAccount.GetAddress(), coins)},
+				}}
+			},
+			types.ErrSendLimitExceeded,
+		},
+		{
+			"failure: delegation exceeds the send limit",
+			func(icaAddress string) {
+				policy.Policy.AllowMessages = []string{"*"}
+				policy.Policy.SendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1)))
+				policy.Policy.SendLimitWindow = time.Hour
+
+				msgs = []proto.Message{&stakingtypes.MsgDelegate{
+					DelegatorAddress: icaAddress,
+					ValidatorAddress: sdk.ValAddress(s.chainB.Vals.Validators[0].Address).String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(2)),
+				}}
+			},
+			types.ErrSendLimitExceeded,
+		},
+		{
+			"failure: message nested in authz MsgExec denied by connection policy",
+			func(icaAddress string) {
+				policy.Policy.AllowMessages = []string{"*"}
+				policy.Policy.DenyMessages = []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+
+				msgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(icaAddress), []sdk.Msg{msgs[0].(sdk.Msg)})
+				msgs = []proto.Message{&msgExec}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: message nested twice in authz MsgExec denied by connection policy",
+			func(icaAddress string) {
+				policy.Policy.AllowMessages = []string{"*"}
+				policy.Policy.DenyMessages = []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+
+				innerMsgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(icaAddress), []sdk.Msg{msgs[0].(sdk.Msg)})
+				msgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(icaAddress), []sdk.Msg{&innerMsgExec})
+				msgs = []proto.Message{&msgExec}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: denom not included in the send limit",
+			func(icaAddress string) {
+				policy.Policy.SendLimit = sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(100)))
+				policy.Policy.SendLimitWindow = time.Hour
+			},
+			types.ErrSendLimitExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path = NewICAPath(s.chainA, s.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			s.Require().NoError(err)
+
+			icaAddress, found := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			s.Require().True(found)
+
+			s.fundICAWallet(s.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000))))
+
+			// params do not allow any messages, only the message policies do
+			s.chainB.GetSimApp().ICAHostKeeper.SetParams(s.chainB.GetContext(), types.NewParams(true, []string{}))
+
+			msgs = []proto.Message{&banktypes.MsgSend{
+				FromAddress: icaAddress,
+				ToAddress:   s.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(ibctesting.TestCoin),
+			}}
+			policy = types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", types.NewMessagePolicy([]string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, nil, 0))
+
+			tc.malleate(icaAddress)
+
+			s.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(s.chainB.GetContext(), policy)
+
+			data, err := icatypes.SerializeCosmosTx(s.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			s.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				s.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			txResponse, err := s.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(s.chainB.GetContext(), packet, s.chainB.SenderAccount.GetAddress())
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(txResponse)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOnRecvPacketSendLimitWindow() {
+	s.SetupTest()
+
+	path := NewICAPath(s.chainA, s.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	s.Require().NoError(err)
+
+	icaAddress, found := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	s.Require().True(found)
+
+	s.fundICAWallet(s.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000))))
+
+	// the send limit allows a single send of the test coin within the window
+	policy := types.NewMessagePolicy([]string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, sdk.NewCoins(ibctesting.TestCoin), time.Hour)
+	s.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(s.chainB.GetContext(), types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", policy))
+
+	data, err := icatypes.SerializeCosmosTx(s.chainA.GetSimApp().AppCodec(), []proto.Message{&banktypes.MsgSend{
+		FromAddress: icaAddress,
+		ToAddress:   s.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(ibctesting.TestCoin),
+	}}, icatypes.EncodingProtobuf)
+	s.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	recvPacket := func(ctx sdk.Context, sequence uint64) error {
+		packet := channeltypes.NewPacket(
+			icaPacketData.GetBytes(),
+			sequence,
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID,
+			path.EndpointB.ChannelID,
+			s.chainB.GetTimeoutHeight(),
+			0,
+		)
+
+		_, err := s.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet, s.chainB.SenderAccount.GetAddress())
+		return err
+	}
+
+	ctx := s.chainB.GetContext()
+	s.Require().NoError(recvPacket(ctx, 1))
+
+	usage, found := s.chainB.GetSimApp().ICAHostKeeper.GetSendLimitUsage(ctx, icaAddress)
+	s.Require().True(found)
+	s.Require().Equal(ctx.BlockTime(), usage.WindowStart)
+	s.Require().Equal(sdk.NewCoins(ibctesting.TestCoin), usage.Sent)
+
+	// the sends of separate transactions accumulate within the window
+	err = recvPacket(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour-time.Second)), 2)
+	s.Require().ErrorIs(err, types.ErrSendLimitExceeded)
+
+	// a new window starts once the window has elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(recvPacket(ctx, 2))
+
+	usage, found = s.chainB.GetSimApp().ICAHostKeeper.GetSendLimitUsage(ctx, icaAddress)
+	s.Require().True(found)
+	s.Require().Equal(ctx.BlockTime(), usage.WindowStart)
+	s.Require().Equal(sdk.NewCoins(ibctesting.TestCoin), usage.Sent)
+}
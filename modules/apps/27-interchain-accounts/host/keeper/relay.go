@@ -16,9 +16,10 @@ import (
 	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
 )
 
-// OnRecvPacket handles a given interchain accounts packet on a destination host chain.
-// If the transaction is successfully executed, the transaction response bytes will be returned.
-func (k *Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+// OnRecvPacket handles a given interchain accounts packet on a destination host chain. The relayer fee of the execution
+// options in the packet data is paid to the relayer. If the transaction is successfully executed, the transaction
+// response bytes will be returned.
+func (k *Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ([]byte, error) {
 	var data icatypes.InterchainAccountPacketData
 	err := data.UnmarshalJSON(packet.GetData())
 	if err != nil {
@@ -38,7 +39,12 @@ func (k *Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]by
 			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		options, err := icatypes.GetExecutionOptions(data)
+		if err != nil {
+			return nil, err
+		}
+
+		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs, options, relayer)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
 		}
@@ -48,17 +54,85 @@ func (k *Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]by
 	}
 }
 
-// executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer.
-// If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
-// into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
-// execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k *Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+// OnRecvPayload handles a given interchain accounts packet received over IBC v2 on a destination host chain. The interchain
+// account of the controller portID on the host clientID is created on its first packet. The CosmosTx of the packet data is
+// decoded with the encoding matching the payload encoding. The relayer fee of the execution options in the packet data
+// is paid to the relayer. If the transaction is successfully executed, the transaction response bytes will be returned.
+func (k *Keeper) OnRecvPayload(ctx sdk.Context, clientID, controllerPortID string, data icatypes.InterchainAccountPacketData, payloadEncoding string, relayer sdk.AccAddress) ([]byte, error) {
+	switch data.Type {
+	case icatypes.EXECUTE_TX:
+		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data, icatypes.CosmosTxEncoding(payloadEncoding))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
+		}
+
+		options, err := icatypes.GetExecutionOptions(data)
+		if err != nil {
+			return nil, err
+		}
+
+		interchainAccountAddr, err := k.getOrCreateClientInterchainAccount(ctx, clientID, controllerPortID)
+		if err != nil {
+			return nil, err
+		}
+
+		policy, _ := k.GetEffectiveClientMessagePolicy(ctx, clientID)
+		txResponse, err := k.executeAccountTx(ctx, policy, interchainAccountAddr, msgs, options, relayer)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
+		}
+		return txResponse, nil
+	default:
+		return nil, icatypes.ErrUnknownDataType
+	}
+}
+
+// executeTx attempts to execute the provided transaction with the interchain account of the controller port on the
+// connection of the provided host channel, under the message policy in effect for the connection. Interchain accounts
+// migrated to 27-gmp accounts are no longer controlled by interchain accounts packets.
+func (k *Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg, options icatypes.ExecutionOptions, relayer sdk.AccAddress) ([]byte, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
 	if !found {
 		return nil, channeltypes.ErrChannelNotFound
 	}
 
-	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
+	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], sourcePort)
+	if !found {
+		return nil, errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", sourcePort)
+	}
+
+	if k.IsAccountMigrated(ctx, channel.ConnectionHops[0], sourcePort) {
+		return nil, errorsmod.Wrapf(types.ErrAccountMigrated, "interchain account %s is controlled by 27-gmp packets", interchainAccountAddr)
+	}
+
+	policy, _, err := k.GetEffectiveMessagePolicy(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return k.executeAccountTx(ctx, policy, interchainAccountAddr, msgs, options, relayer)
+}
+
+// executeAccountTx attempts to execute the provided transaction. It begins by authenticating the transaction signer
+// and checking the messages against the provided message policy, then pays the relayer fee of the execution options.
+// If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
+// into state. The messages are executed under a gas meter limited to the gas limit of the execution options, capped
+// at the MaxExecutionGas param. The coins which left the interchain account are then accumulated against the send
+// limit of the policy. The state changes will only be committed if all messages in the transaction succeed and the
+// send limit is not exceeded. Thus the execution of the transaction is atomic, all state changes are reverted if a
+// single message fails.
+func (k *Keeper) executeAccountTx(ctx sdk.Context, policy types.MessagePolicy, interchainAccountAddr string, msgs []sdk.Msg, options icatypes.ExecutionOptions, relayer sdk.AccAddress) ([]byte, error) {
+	if err := k.authenticateTx(msgs, policy, interchainAccountAddr); err != nil {
+		return nil, err
+	}
+
+	// the balance is measured before any coins leave the interchain account, including the relayer fee
+	balance, err := k.getSendLimitBalance(ctx, policy, interchainAccountAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.payRelayerFee(ctx, interchainAccountAddr, relayer, options.RelayerFee); err != nil {
 		return nil, err
 	}
 
@@ -69,19 +143,30 @@ func (k *Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel st
 	// CacheContext returns a new context with the multi-store branched into a cached storage object
 	// writeCache is called only if all msgs succeed, performing state transitions atomically
 	cacheCtx, writeCache := ctx.CacheContext()
-	for i, msg := range msgs {
-		if m, ok := msg.(sdk.HasValidateBasic); ok {
-			if err := m.ValidateBasic(); err != nil {
-				return nil, err
+	gasLimit := icatypes.ExecutionGasLimit(k.GetParams(ctx).MaxExecutionGas, options.GasLimit)
+	if err := icatypes.ExecuteWithGasLimit(cacheCtx, gasLimit, func(cacheCtx sdk.Context) error {
+		for i, msg := range msgs {
+			if m, ok := msg.(sdk.HasValidateBasic); ok {
+				if err := m.ValidateBasic(); err != nil {
+					return err
+				}
 			}
-		}
 
-		protoAny, err := k.executeMsg(cacheCtx, msg)
-		if err != nil {
-			return nil, err
+			protoAny, err := k.executeMsg(cacheCtx, msg)
+			if err != nil {
+				return err
+			}
+
+			txMsgData.MsgResponses[i] = protoAny
 		}
 
-		txMsgData.MsgResponses[i] = protoAny
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.trackSendLimit(cacheCtx, policy, interchainAccountAddr, balance); err != nil {
+		return nil, err
 	}
 
 	writeCache()
@@ -94,20 +179,14 @@ func (k *Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel st
 	return txResponse, nil
 }
 
-// authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
-// from state using the provided controller port identifier
-func (k *Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
-	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
-	if !found {
-		return errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
+// authenticateTx ensures the provided msgs are permitted by the message policy and only contain the provided
+// interchain account address as signer
+func (k *Keeper) authenticateTx(msgs []sdk.Msg, policy types.MessagePolicy, interchainAccountAddr string) error {
+	if err := checkMessagePolicy(policy, msgs); err != nil {
+		return err
 	}
 
-	allowMsgs := k.GetParams(ctx).AllowMessages
 	for _, msg := range msgs {
-		if !types.ContainsMsgType(allowMsgs, msg) {
-			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
-		}
-
 		// obtain the message signers using the proto signer annotations
 		// the msgv2 return value is discarded as it is not used
 		signers, _, err := k.cdc.GetMsgV1Signers(msg)
@@ -128,6 +207,27 @@ func (k *Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, p
 	return nil
 }
 
+// payRelayerFee sends the relayer fee from the interchain account to the relayer
+func (k *Keeper) payRelayerFee(ctx sdk.Context, interchainAccountAddr string, relayer sdk.AccAddress, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	if k.bankKeeper == nil {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "relayer fees cannot be paid: bank keeper is not set")
+	}
+
+	if relayer.Empty() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "relayer address cannot be empty when a relayer fee is set")
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sdk.MustAccAddressFromBech32(interchainAccountAddr), relayer, fee); err != nil {
+		return errorsmod.Wrapf(err, "failed to pay relayer fee %s", fee)
+	}
+
+	return nil
+}
+
 // Attempts to get the message handler from the router and if found will then execute the message.
 // If the message execution is successful, the proto marshaled message response will be returned.
 func (k *Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*codectypes.Any, error) {
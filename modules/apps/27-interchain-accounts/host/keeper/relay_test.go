@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -189,6 +190,31 @@ func (s *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			nil,
 		},
+		{
+			"interchain account successfully executes govtypesv1.MsgSubmitProposal with messages allowed by params",
+			func(encoding string) {
+				interchainAccountAddr, found := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				s.Require().True(found)
+
+				msg, err := govtypesv1.NewMsgSubmitProposal([]sdk.Msg{getTestProposalMessage()}, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000))), interchainAccountAddr, "metadata", "title", "summary", false)
+				s.Require().NoError(err)
+
+				data, err := icatypes.SerializeCosmosTx(s.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				s.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				// the messages of the proposal are executed by the gov module, thus only the proposal must be allowed
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				s.chainB.GetSimApp().ICAHostKeeper.SetParams(s.chainB.GetContext(), params)
+			},
+			nil,
+		},
 		{
 			"interchain account successfully executes govtypesv1.MsgVote",
 			func(encoding string) {
@@ -435,6 +461,58 @@ func (s *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			icatypes.ErrUnknownDataType,
 		},
+		{
+			"interchain account successfully executes types.MsgMigrateToGMPAccount",
+			func(encoding string) {
+				interchainAccountAddr, found := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				s.Require().True(found)
+
+				msg := types.NewMsgMigrateToGMPAccount(interchainAccountAddr, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, ibctesting.FirstClientID, TestOwnerAddress, []byte("salt"))
+
+				data, err := icatypes.SerializeCosmosTx(s.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				s.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				s.chainB.GetSimApp().ICAHostKeeper.SetParams(s.chainB.GetContext(), params)
+			},
+			nil,
+		},
+		{
+			"unauthorised: interchain account migrated to 27-gmp account",
+			func(encoding string) {
+				interchainAccountAddr, found := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				s.Require().True(found)
+
+				s.chainB.GetSimApp().ICAHostKeeper.SetMigratedAccount(s.chainB.GetContext(), types.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, interchainAccountAddr, ibctesting.FirstClientID, TestOwnerAddress, nil))
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   s.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(ibctesting.TestCoin),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(s.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				s.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"*"})
+				s.chainB.GetSimApp().ICAHostKeeper.SetParams(s.chainB.GetContext(), params)
+			},
+			types.ErrAccountMigrated,
+		},
 		{
 			"unauthorised: interchain account not found for controller port ID",
 			func(encoding string) {
@@ -540,7 +618,7 @@ func (s *KeeperTestSuite) TestOnRecvPacket() {
 						0,
 					)
 
-					txResponse, err := s.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(s.chainB.GetContext(), packet)
+					txResponse, err := s.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(s.chainB.GetContext(), packet, s.chainB.SenderAccount.GetAddress())
 
 					if tc.expErr == nil {
 						s.Require().NoError(err)
@@ -978,7 +1056,7 @@ func (s *KeeperTestSuite) TestJSONOnRecvPacket() {
 					0,
 				)
 
-				txResponse, err := s.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(s.chainB.GetContext(), packet)
+				txResponse, err := s.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(s.chainB.GetContext(), packet, s.chainB.SenderAccount.GetAddress())
 
 				if tc.expErr == nil {
 					s.Require().NoError(err)
@@ -992,6 +1070,159 @@ func (s *KeeperTestSuite) TestJSONOnRecvPacket() {
 	}
 }
 
+func (s *KeeperTestSuite) TestOnRecvPacketExecutionOptions() {
+	var (
+		path      *ibctesting.Path
+		memo      string
+		expFee    sdk.Coins
+		maxGas    uint64
+		relayer   sdk.AccAddress
+		sendCoins sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no execution options",
+			func() {},
+			nil,
+		},
+		{
+			"success: relayer fee paid to relayer",
+			func() {
+				expFee = sdk.NewCoins(ibctesting.TestCoin)
+				memo = fmt.Sprintf(`{"execution": {"relayer_fee": "%s"}}`, expFee)
+			},
+			nil,
+		},
+		{
+			"success: gas limit within max execution gas",
+			func() {
+				maxGas = 1_000_000
+				memo = `{"execution": {"gas_limit": "500000"}}`
+			},
+			nil,
+		},
+		{
+			"failure: execution exceeds gas limit",
+			func() {
+				memo = `{"execution": {"gas_limit": "100"}}`
+			},
+			sdkerrors.ErrOutOfGas,
+		},
+		{
+			"failure: execution exceeds max execution gas",
+			func() {
+				maxGas = 100
+				memo = `{"execution": {"gas_limit": "500000"}}`
+			},
+			sdkerrors.ErrOutOfGas,
+		},
+		{
+			"failure: insufficient funds for relayer fee",
+			func() {
+				memo = `{"execution": {"relayer_fee": "1000000000stake"}}`
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			"failure: empty relayer address with relayer fee",
+			func() {
+				relayer = nil
+				memo = fmt.Sprintf(`{"execution": {"relayer_fee": "%s"}}`, ibctesting.TestCoin)
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: relayer fee counts against the send limit",
+			func() {
+				memo = fmt.Sprintf(`{"execution": {"relayer_fee": "%s"}}`, ibctesting.TestCoin)
+
+				policy := types.NewMessagePolicy([]string{"*"}, nil, sendCoins, time.Hour)
+				s.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(s.chainB.GetContext(), types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", policy))
+			},
+			types.ErrSendLimitExceeded,
+		},
+		{
+			"failure: invalid execution options",
+			func() {
+				memo = `{"execution": {"relayer_fee": 100}}`
+			},
+			icatypes.ErrInvalidExecutionOptions,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path = NewICAPath(s.chainA, s.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			s.Require().NoError(err)
+
+			icaAddress, found := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			s.Require().True(found)
+
+			s.fundICAWallet(s.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000))))
+
+			memo, expFee, maxGas = "", sdk.NewCoins(), 0
+			relayer = s.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+			sendCoins = sdk.NewCoins(ibctesting.TestCoin)
+
+			tc.malleate()
+
+			params := types.NewParams(true, []string{"*"})
+			params.MaxExecutionGas = maxGas
+			s.chainB.GetSimApp().ICAHostKeeper.SetParams(s.chainB.GetContext(), params)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: icaAddress,
+				ToAddress:   s.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sendCoins,
+			}
+
+			data, err := icatypes.SerializeCosmosTx(s.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+			s.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+				Memo: memo,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				s.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			ctx := s.chainB.GetContext()
+			relayerBalance := s.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, relayer)
+
+			txResponse, err := s.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet, relayer)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(txResponse)
+				s.Require().Equal(relayerBalance.Add(expFee...), s.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, relayer))
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
 	interchainAccountAddr, found := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
 	s.Require().True(found)
@@ -14,6 +14,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgModuleQuerySafe{},
+		&MsgSetMessagePolicy{},
+		&MsgRemoveMessagePolicy{},
+		&MsgMigrateToGMPAccount{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
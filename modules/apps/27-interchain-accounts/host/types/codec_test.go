@@ -30,6 +30,21 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgModuleQuerySafe{}),
 			"",
 		},
+		{
+			"success: MsgSetMessagePolicy",
+			sdk.MsgTypeURL(&types.MsgSetMessagePolicy{}),
+			"",
+		},
+		{
+			"success: MsgRemoveMessagePolicy",
+			sdk.MsgTypeURL(&types.MsgRemoveMessagePolicy{}),
+			"",
+		},
+		{
+			"success: MsgMigrateToGMPAccount",
+			sdk.MsgTypeURL(&types.MsgMigrateToGMPAccount{}),
+			"",
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
@@ -9,4 +9,8 @@ import (
 // ICA Host sentinel errors
 var (
 	ErrHostSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrSendLimitExceeded     = errorsmod.Register(SubModuleName, 3, "send limit exceeded")
+	ErrMessagePolicyNotFound = errorsmod.Register(SubModuleName, 4, "message policy not found")
+	ErrAccountMigrated       = errorsmod.Register(SubModuleName, 5, "interchain account migrated to 27-gmp account")
+	ErrGMPNotEnabled         = errorsmod.Register(SubModuleName, 6, "27-gmp keeper not set")
 )
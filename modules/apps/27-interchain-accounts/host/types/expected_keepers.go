@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gmptypes "github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
+)
+
+// GMPKeeper defines the expected 27-gmp keeper used to migrate interchain accounts to 27-gmp accounts
+type GMPKeeper interface {
+	LinkAccount(ctx context.Context, accountID *gmptypes.AccountIdentifier, address sdk.AccAddress) error
+}
+
+// BankKeeper defines the expected bank keeper used to measure the coins sent by interchain accounts against the send
+// limits of message policies and to pay relayer fees from interchain accounts
+type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
@@ -5,16 +5,24 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,6 +30,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MessagePolicySource defines the scope of the message policy in effect for the interchain accounts of a connection.
+type MessagePolicySource int32
+
+const (
+	// Default zero value enumeration
+	POLICY_SOURCE_UNSPECIFIED MessagePolicySource = 0
+	// No message policy is set, the allow_messages parameter applies
+	POLICY_SOURCE_PARAMS MessagePolicySource = 1
+	// The message policy of the client of the connection applies
+	POLICY_SOURCE_CLIENT MessagePolicySource = 2
+	// The message policy of the connection applies
+	POLICY_SOURCE_CONNECTION MessagePolicySource = 3
+)
+
+var MessagePolicySource_name = map[int32]string{
+	0: "MESSAGE_POLICY_SOURCE_UNSPECIFIED",
+	1: "MESSAGE_POLICY_SOURCE_PARAMS",
+	2: "MESSAGE_POLICY_SOURCE_CLIENT",
+	3: "MESSAGE_POLICY_SOURCE_CONNECTION",
+}
+
+var MessagePolicySource_value = map[string]int32{
+	"MESSAGE_POLICY_SOURCE_UNSPECIFIED": 0,
+	"MESSAGE_POLICY_SOURCE_PARAMS":      1,
+	"MESSAGE_POLICY_SOURCE_CLIENT":      2,
+	"MESSAGE_POLICY_SOURCE_CONNECTION":  3,
+}
+
+func (x MessagePolicySource) String() string {
+	return proto.EnumName(MessagePolicySource_name, int32(x))
+}
+
+func (MessagePolicySource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{0}
+}
+
 // Params defines the set of on-chain interchain accounts parameters.
 // The following parameters may be used to disable the host submodule.
 type Params struct {
@@ -29,6 +73,9 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// max_execution_gas defines the maximum gas a transaction received from a controller chain may consume when
+	// executed on the host chain. A zero value does not limit the execution gas.
+	MaxExecutionGas uint64 `protobuf:"varint,3,opt,name=max_execution_gas,json=maxExecutionGas,proto3" json:"max_execution_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,6 +125,306 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetMaxExecutionGas() uint64 {
+	if m != nil {
+		return m.MaxExecutionGas
+	}
+	return 0
+}
+
+// MessagePolicy defines the messages the interchain accounts of a controller chain are allowed to execute on the
+// host chain.
+type MessagePolicy struct {
+	// allow_messages defines a list of sdk message typeURLs allowed to be executed. The allow_messages parameter
+	// applies if empty.
+	AllowMessages []string `protobuf:"bytes,1,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// deny_messages defines a list of sdk message typeURLs never allowed to be executed, taking precedence over
+	// the allowed messages.
+	DenyMessages []string `protobuf:"bytes,2,rep,name=deny_messages,json=denyMessages,proto3" json:"deny_messages,omitempty"`
+	// send_limit defines the maximum amount of coins which may leave the balance of an interchain account within a
+	// send limit window, through any message of its transactions. Coins of denominations missing from a non-empty
+	// limit may not be sent.
+	SendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=send_limit,json=sendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"send_limit"`
+	// send_limit_window defines the period over which the coins sent by an interchain account are accumulated against
+	// the send limit. It must be positive if a send limit is set.
+	SendLimitWindow time.Duration `protobuf:"bytes,4,opt,name=send_limit_window,json=sendLimitWindow,proto3,stdduration" json:"send_limit_window"`
+}
+
+func (m *MessagePolicy) Reset()         { *m = MessagePolicy{} }
+func (m *MessagePolicy) String() string { return proto.CompactTextString(m) }
+func (*MessagePolicy) ProtoMessage()    {}
+func (*MessagePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *MessagePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessagePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessagePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessagePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagePolicy.Merge(m, src)
+}
+func (m *MessagePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessagePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagePolicy proto.InternalMessageInfo
+
+func (m *MessagePolicy) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
+func (m *MessagePolicy) GetDenyMessages() []string {
+	if m != nil {
+		return m.DenyMessages
+	}
+	return nil
+}
+
+func (m *MessagePolicy) GetSendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SendLimit
+	}
+	return nil
+}
+
+func (m *MessagePolicy) GetSendLimitWindow() time.Duration {
+	if m != nil {
+		return m.SendLimitWindow
+	}
+	return 0
+}
+
+// SendLimitUsage defines the coins sent by an interchain account within the current window of the send limit of its
+// message policy.
+type SendLimitUsage struct {
+	// start time of the current send limit window
+	WindowStart time.Time `protobuf:"bytes,1,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// coins sent by the interchain account since the start of the window
+	Sent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=sent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"sent"`
+}
+
+func (m *SendLimitUsage) Reset()         { *m = SendLimitUsage{} }
+func (m *SendLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*SendLimitUsage) ProtoMessage()    {}
+func (*SendLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{2}
+}
+func (m *SendLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendLimitUsage.Merge(m, src)
+}
+func (m *SendLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendLimitUsage proto.InternalMessageInfo
+
+func (m *SendLimitUsage) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func (m *SendLimitUsage) GetSent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Sent
+	}
+	return nil
+}
+
+// ScopedMessagePolicy defines a message policy applied to the interchain accounts of either a host connection or a
+// host client of a controller chain.
+type ScopedMessagePolicy struct {
+	// connection identifier the policy applies to
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// client identifier the policy applies to, for all connections on the client and IBC v2 packets
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// message policy
+	Policy MessagePolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *ScopedMessagePolicy) Reset()         { *m = ScopedMessagePolicy{} }
+func (m *ScopedMessagePolicy) String() string { return proto.CompactTextString(m) }
+func (*ScopedMessagePolicy) ProtoMessage()    {}
+func (*ScopedMessagePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{3}
+}
+func (m *ScopedMessagePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopedMessagePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopedMessagePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopedMessagePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopedMessagePolicy.Merge(m, src)
+}
+func (m *ScopedMessagePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopedMessagePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopedMessagePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopedMessagePolicy proto.InternalMessageInfo
+
+func (m *ScopedMessagePolicy) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ScopedMessagePolicy) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ScopedMessagePolicy) GetPolicy() MessagePolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return MessagePolicy{}
+}
+
+// MigratedInterchainAccount defines an interchain account of a controller port on a host connection which has been
+// migrated to a 27-gmp account. The account is controlled by the GMP packets of the account identifier instead of the
+// interchain accounts packets of the controller port.
+type MigratedInterchainAccount struct {
+	// connection identifier of the interchain account on the host chain
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// controller port identifier of the interchain account
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// address of the interchain account
+	AccountAddress string `protobuf:"bytes,3,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	// client identifier of the 27-gmp account identifier
+	ClientId string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// sender of the 27-gmp account identifier
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// salt of the 27-gmp account identifier
+	Salt []byte `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (m *MigratedInterchainAccount) Reset()         { *m = MigratedInterchainAccount{} }
+func (m *MigratedInterchainAccount) String() string { return proto.CompactTextString(m) }
+func (*MigratedInterchainAccount) ProtoMessage()    {}
+func (*MigratedInterchainAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{4}
+}
+func (m *MigratedInterchainAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigratedInterchainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigratedInterchainAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigratedInterchainAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigratedInterchainAccount.Merge(m, src)
+}
+func (m *MigratedInterchainAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigratedInterchainAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigratedInterchainAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigratedInterchainAccount proto.InternalMessageInfo
+
+func (m *MigratedInterchainAccount) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MigratedInterchainAccount) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MigratedInterchainAccount) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *MigratedInterchainAccount) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *MigratedInterchainAccount) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MigratedInterchainAccount) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
 // QueryRequest defines the parameters for a particular query request
 // by an interchain account.
 type QueryRequest struct {
@@ -93,7 +440,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48e202774f13d08e, []int{1}
+	return fileDescriptor_48e202774f13d08e, []int{5}
 }
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,7 +484,12 @@ func (m *QueryRequest) GetData() []byte {
 }
 
 func init() {
+	proto.RegisterEnum("ibc.applications.interchain_accounts.host.v1.MessagePolicySource", MessagePolicySource_name, MessagePolicySource_value)
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*MessagePolicy)(nil), "ibc.applications.interchain_accounts.host.v1.MessagePolicy")
+	proto.RegisterType((*SendLimitUsage)(nil), "ibc.applications.interchain_accounts.host.v1.SendLimitUsage")
+	proto.RegisterType((*ScopedMessagePolicy)(nil), "ibc.applications.interchain_accounts.host.v1.ScopedMessagePolicy")
+	proto.RegisterType((*MigratedInterchainAccount)(nil), "ibc.applications.interchain_accounts.host.v1.MigratedInterchainAccount")
 	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryRequest")
 }
 
@@ -146,25 +498,60 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd0, 0x31, 0x4b, 0xc4, 0x30,
-	0x18, 0x06, 0xe0, 0xcb, 0x29, 0x87, 0x17, 0x4f, 0x87, 0x4e, 0x37, 0x85, 0xf3, 0x40, 0xb8, 0xc1,
-	0x36, 0x54, 0xc1, 0xdb, 0x05, 0x27, 0x11, 0x34, 0xa3, 0x4b, 0xf9, 0x92, 0x86, 0x36, 0xd0, 0x36,
-	0xb5, 0x5f, 0x5a, 0xb9, 0x7f, 0xe1, 0xcf, 0x72, 0xbc, 0xd1, 0x51, 0xda, 0x3f, 0x22, 0x8d, 0x82,
-	0x0a, 0x4e, 0x79, 0x79, 0xe0, 0x0d, 0x7c, 0x2f, 0xdd, 0x1a, 0xa9, 0x38, 0xd4, 0x75, 0x61, 0x14,
-	0x38, 0x63, 0x2b, 0xe4, 0xa6, 0x72, 0xba, 0x51, 0x39, 0x98, 0x2a, 0x01, 0xa5, 0x6c, 0x5b, 0x39,
-	0xe4, 0xb9, 0x45, 0xc7, 0xbb, 0xd8, 0xbf, 0x51, 0xdd, 0x58, 0x67, 0x83, 0x0b, 0x23, 0x55, 0xf4,
-	0xbb, 0x18, 0xfd, 0x53, 0x8c, 0x7c, 0xa1, 0x8b, 0xd7, 0x82, 0xce, 0x1e, 0xa0, 0x81, 0x12, 0x83,
-	0x33, 0xba, 0x18, 0x31, 0xd1, 0x15, 0xc8, 0x42, 0xa7, 0x4b, 0xb2, 0x22, 0x9b, 0x23, 0x71, 0x3c,
-	0xda, 0xed, 0x17, 0x05, 0xe7, 0xf4, 0x14, 0x8a, 0xc2, 0xbe, 0x24, 0xa5, 0x46, 0x84, 0x4c, 0xe3,
-	0x72, 0xba, 0x3a, 0xd8, 0xcc, 0xc5, 0x89, 0xd7, 0xfb, 0x6f, 0x5c, 0x5f, 0xd3, 0xc5, 0x63, 0xab,
-	0x9b, 0x9d, 0xd0, 0xcf, 0xad, 0x46, 0x17, 0x04, 0xf4, 0xb0, 0x06, 0x97, 0xfb, 0x1f, 0xe7, 0xc2,
-	0xe7, 0xd1, 0x52, 0x70, 0xb0, 0x9c, 0xae, 0xc8, 0x66, 0x21, 0x7c, 0xbe, 0xd1, 0x6f, 0x3d, 0x23,
-	0xfb, 0x9e, 0x91, 0x8f, 0x9e, 0x91, 0xd7, 0x81, 0x4d, 0xf6, 0x03, 0x9b, 0xbc, 0x0f, 0x6c, 0xf2,
-	0x74, 0x97, 0x19, 0x97, 0xb7, 0x32, 0x52, 0xb6, 0xe4, 0xca, 0x62, 0x69, 0x91, 0x1b, 0xa9, 0xc2,
-	0xcc, 0xf2, 0x2e, 0x8e, 0x79, 0x69, 0xd3, 0xb6, 0xd0, 0x38, 0xae, 0x85, 0xfc, 0x72, 0x1b, 0xfe,
-	0xdc, 0x1b, 0xfe, 0x1d, 0xca, 0xed, 0x6a, 0x8d, 0x72, 0xe6, 0x77, 0xba, 0xfa, 0x0c, 0x00, 0x00,
-	0xff, 0xff, 0xbb, 0x15, 0xbb, 0x17, 0x62, 0x01, 0x00, 0x00,
+	// 844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x93, 0x10, 0x9a, 0x49, 0xfa, 0xb1, 0xde, 0x15, 0xa4, 0x61, 0x49, 0xbd, 0x59, 0x21,
+	0xa2, 0x15, 0xb5, 0x49, 0x91, 0x58, 0x09, 0x4e, 0x49, 0x6a, 0x2a, 0x8b, 0x36, 0x09, 0x76, 0x2b,
+	0xb4, 0x5c, 0xac, 0xf1, 0x78, 0x70, 0x07, 0x6c, 0x8f, 0xf1, 0x8c, 0xfb, 0x71, 0xe1, 0x8c, 0x7a,
+	0xda, 0xe3, 0x5e, 0x7a, 0xe2, 0xc6, 0x91, 0x23, 0x7f, 0xc1, 0x4a, 0x5c, 0xf6, 0xc8, 0x89, 0x45,
+	0xed, 0x3f, 0x82, 0x66, 0xec, 0xb4, 0x9b, 0x36, 0x95, 0x40, 0xda, 0x93, 0x67, 0x7e, 0xef, 0xfd,
+	0x7e, 0xef, 0xcd, 0xfb, 0x90, 0xc1, 0x53, 0xe2, 0x21, 0x03, 0x26, 0x49, 0x48, 0x10, 0xe4, 0x84,
+	0xc6, 0xcc, 0x20, 0x31, 0xc7, 0x29, 0x3a, 0x84, 0x24, 0x76, 0x21, 0x42, 0x34, 0x8b, 0x39, 0x33,
+	0x0e, 0x29, 0xe3, 0xc6, 0x51, 0x5f, 0x7e, 0xf5, 0x24, 0xa5, 0x9c, 0xaa, 0x9f, 0x10, 0x0f, 0xe9,
+	0x6f, 0x12, 0xf5, 0x05, 0x44, 0x5d, 0x12, 0x8e, 0xfa, 0xed, 0x07, 0x01, 0x0d, 0xa8, 0x24, 0x1a,
+	0xe2, 0x94, 0x6b, 0xb4, 0x3b, 0x01, 0xa5, 0x41, 0x88, 0x0d, 0x79, 0xf3, 0xb2, 0xef, 0x0d, 0x3f,
+	0x4b, 0xa5, 0x58, 0x61, 0xdf, 0xb8, 0x69, 0xe7, 0x24, 0xc2, 0x8c, 0xc3, 0x28, 0x99, 0x09, 0x20,
+	0xca, 0x22, 0xca, 0x0c, 0x0f, 0x32, 0x6c, 0x1c, 0xf5, 0x3d, 0xcc, 0x61, 0xdf, 0x40, 0x94, 0x14,
+	0x02, 0xdd, 0x9f, 0x41, 0x6d, 0x0a, 0x53, 0x18, 0x31, 0xf5, 0x11, 0x68, 0x8a, 0x5c, 0x5c, 0x1c,
+	0x43, 0x2f, 0xc4, 0x7e, 0x4b, 0xd1, 0x94, 0xde, 0x92, 0xdd, 0x10, 0x98, 0x99, 0x43, 0xea, 0x47,
+	0x60, 0x05, 0x86, 0x21, 0x3d, 0x76, 0x23, 0xcc, 0x18, 0x0c, 0x30, 0x6b, 0x95, 0xb5, 0x4a, 0xaf,
+	0x6e, 0x2f, 0x4b, 0x74, 0xaf, 0x00, 0xd5, 0x27, 0xe0, 0x5e, 0x04, 0x4f, 0x5c, 0x7c, 0x82, 0x51,
+	0x26, 0x72, 0x75, 0x03, 0xc8, 0x5a, 0x15, 0x4d, 0xe9, 0x55, 0xed, 0xd5, 0x08, 0x9e, 0x98, 0x33,
+	0x7c, 0x07, 0xb2, 0xee, 0x8b, 0x32, 0x58, 0x2e, 0x88, 0x53, 0x1a, 0x12, 0x74, 0xba, 0x20, 0x88,
+	0xb2, 0x28, 0xc8, 0x63, 0xb0, 0xec, 0xe3, 0xf8, 0xf4, 0x66, 0x2a, 0x4d, 0x01, 0x5e, 0x39, 0xfd,
+	0x00, 0x00, 0xc3, 0xb1, 0xef, 0x86, 0x24, 0x22, 0xbc, 0x55, 0xd1, 0x2a, 0xbd, 0xc6, 0xd6, 0xba,
+	0x9e, 0x97, 0x44, 0x17, 0x25, 0xd1, 0x8b, 0x92, 0xe8, 0x23, 0x4a, 0xe2, 0xe1, 0xa7, 0x2f, 0xff,
+	0xde, 0x28, 0xfd, 0xf6, 0x7a, 0xa3, 0x17, 0x10, 0x7e, 0x98, 0x79, 0x3a, 0xa2, 0x91, 0x51, 0xd4,
+	0x2f, 0xff, 0x6c, 0x32, 0xff, 0x47, 0x83, 0x9f, 0x26, 0x98, 0x49, 0x02, 0xb3, 0xeb, 0x42, 0x7e,
+	0x57, 0xa8, 0xab, 0x13, 0x70, 0xef, 0x3a, 0x96, 0x7b, 0x4c, 0x62, 0x9f, 0x1e, 0xb7, 0xaa, 0x9a,
+	0x22, 0x43, 0xe6, 0x6d, 0xd2, 0x67, 0x6d, 0xd2, 0xb7, 0x8b, 0x36, 0x0e, 0x97, 0x44, 0xc8, 0x17,
+	0xaf, 0x37, 0x14, 0x7b, 0xf5, 0x4a, 0xea, 0x5b, 0xc9, 0xed, 0xfe, 0xa1, 0x80, 0x15, 0x67, 0x86,
+	0x1d, 0x88, 0x07, 0xa9, 0x3b, 0xa0, 0x99, 0x0b, 0xbb, 0x8c, 0xc3, 0x94, 0xcb, 0x1e, 0x35, 0xb6,
+	0xda, 0xb7, 0xe4, 0xf7, 0x67, 0x53, 0x90, 0xeb, 0x3f, 0x17, 0xfa, 0x8d, 0x9c, 0xe9, 0x08, 0xa2,
+	0xea, 0x82, 0x2a, 0xc3, 0x31, 0x97, 0x45, 0x7b, 0xcb, 0x25, 0x91, 0xc2, 0xdd, 0xdf, 0x15, 0x70,
+	0xdf, 0x41, 0x34, 0xc1, 0xfe, 0x7c, 0x77, 0x1f, 0x83, 0x65, 0x44, 0xe3, 0x18, 0x23, 0x39, 0x18,
+	0x24, 0x1f, 0xb3, 0xba, 0xdd, 0xbc, 0x06, 0x2d, 0x5f, 0xfd, 0x00, 0xd4, 0x51, 0x48, 0x70, 0xcc,
+	0x85, 0x43, 0x59, 0x3a, 0x2c, 0xe5, 0x80, 0xe5, 0xab, 0xcf, 0x40, 0x2d, 0x91, 0x5a, 0x72, 0xa4,
+	0x1a, 0x5b, 0x5f, 0xea, 0xff, 0x67, 0xcf, 0xf4, 0xb9, 0x74, 0x86, 0x55, 0xf1, 0x3c, 0xbb, 0x10,
+	0xec, 0xfe, 0xa9, 0x80, 0xf5, 0x3d, 0x12, 0xa4, 0x90, 0x63, 0xdf, 0xba, 0xd2, 0x18, 0xe4, 0x12,
+	0xff, 0x2d, 0xf5, 0xf7, 0xc1, 0xbb, 0x09, 0x4d, 0xdf, 0x48, 0xbc, 0x26, 0xae, 0x96, 0xaf, 0x7e,
+	0x0c, 0x56, 0x8b, 0x5c, 0x5c, 0xe8, 0xfb, 0x29, 0x66, 0xf9, 0x4a, 0xd4, 0xed, 0x95, 0x02, 0x1e,
+	0xe4, 0xe8, 0xfc, 0xe3, 0xab, 0x37, 0x1e, 0xff, 0x1e, 0xa8, 0x89, 0x31, 0xc1, 0x69, 0xeb, 0x9d,
+	0x5c, 0x3d, 0xbf, 0xa9, 0x2a, 0xa8, 0x32, 0x18, 0xf2, 0x56, 0x4d, 0x53, 0x7a, 0x4d, 0x5b, 0x9e,
+	0xbb, 0x9f, 0x83, 0xe6, 0x37, 0x19, 0x4e, 0x4f, 0x6d, 0xfc, 0x53, 0x86, 0x19, 0x17, 0x3e, 0x09,
+	0xe4, 0x87, 0x45, 0xda, 0xf2, 0x2c, 0x30, 0x1f, 0x72, 0x28, 0x73, 0x6d, 0xda, 0xf2, 0xfc, 0xe4,
+	0xbc, 0x0c, 0xee, 0xcf, 0x55, 0xc9, 0xa1, 0x59, 0x8a, 0xb0, 0xba, 0x0d, 0x1e, 0xed, 0x99, 0x8e,
+	0x33, 0xd8, 0x31, 0xdd, 0xe9, 0x64, 0xd7, 0x1a, 0x3d, 0x73, 0x9d, 0xc9, 0x81, 0x3d, 0x32, 0xdd,
+	0x83, 0xb1, 0x33, 0x35, 0x47, 0xd6, 0x57, 0x96, 0xb9, 0xbd, 0x56, 0x6a, 0x7f, 0x78, 0x76, 0xae,
+	0xad, 0xdf, 0xe9, 0xa0, 0x7e, 0x01, 0x1e, 0x2e, 0x56, 0x99, 0x0e, 0xec, 0xc1, 0x9e, 0xb3, 0xa6,
+	0xb4, 0x5b, 0x67, 0xe7, 0xda, 0x83, 0x45, 0xb6, 0xbb, 0xb9, 0xa3, 0x5d, 0xcb, 0x1c, 0xef, 0xaf,
+	0x95, 0x17, 0x71, 0x73, 0x9b, 0x3a, 0x04, 0xda, 0x1d, 0xdc, 0xc9, 0x78, 0x6c, 0x8e, 0xf6, 0xad,
+	0xc9, 0x78, 0xad, 0xd2, 0x7e, 0x78, 0x76, 0xae, 0xb5, 0xee, 0xb2, 0xb7, 0xab, 0xbf, 0xfc, 0xda,
+	0x29, 0x0d, 0xf1, 0xcb, 0x8b, 0x8e, 0xf2, 0xea, 0xa2, 0xa3, 0xfc, 0x73, 0xd1, 0x51, 0x9e, 0x5f,
+	0x76, 0x4a, 0xaf, 0x2e, 0x3b, 0xa5, 0xbf, 0x2e, 0x3b, 0xa5, 0xef, 0xbe, 0xbe, 0xbd, 0x24, 0xc4,
+	0x43, 0x9b, 0x01, 0x35, 0x8e, 0xfa, 0x7d, 0x23, 0xa2, 0x7e, 0x16, 0x62, 0x26, 0xfe, 0x25, 0xcc,
+	0xd8, 0x7a, 0xba, 0x79, 0x3d, 0xa5, 0x9b, 0xf3, 0xbf, 0x11, 0xb9, 0x4d, 0x5e, 0x4d, 0x6e, 0xf3,
+	0x67, 0xff, 0x0e, 0x00, 0x17, 0x62, 0xf0, 0x3d, 0x80, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -187,6 +574,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxExecutionGas != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxExecutionGas))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -209,7 +601,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
+func (m *MessagePolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -219,65 +611,386 @@ func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MessagePolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MessagePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintHost(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SendLimitWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SendLimitWindow):])
+	if err1 != nil {
+		return 0, err1
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintHost(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
+	i -= n1
+	i = encodeVarintHost(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.SendLimit) > 0 {
+		for iNdEx := len(m.SendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DenyMessages) > 0 {
+		for iNdEx := len(m.DenyMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenyMessages[iNdEx])
+			copy(dAtA[i:], m.DenyMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.DenyMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
-	offset -= sovHost(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SendLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SendLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HostEnabled {
-		n += 2
-	}
-	if len(m.AllowMessages) > 0 {
-		for _, s := range m.AllowMessages {
-			l = len(s)
-			n += 1 + l + sovHost(uint64(l))
+	if len(m.Sent) > 0 {
+		for iNdEx := len(m.Sent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintHost(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScopedMessagePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopedMessagePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopedMessagePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHost(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigratedInterchainAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigratedInterchainAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigratedInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.MaxExecutionGas != 0 {
+		n += 1 + sovHost(uint64(m.MaxExecutionGas))
+	}
+	return n
+}
+
+func (m *MessagePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.DenyMessages) > 0 {
+		for _, s := range m.DenyMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.SendLimit) > 0 {
+		for _, e := range m.SendLimit {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SendLimitWindow)
+	n += 1 + l + sovHost(uint64(l))
+	return n
+}
+
+func (m *SendLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovHost(uint64(l))
+	if len(m.Sent) > 0 {
+		for _, e := range m.Sent {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScopedMessagePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovHost(uint64(l))
+	return n
+}
+
+func (m *MigratedInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -379,6 +1092,714 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionGas", wireType)
+			}
+			m.MaxExecutionGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessagePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessagePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessagePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenyMessages = append(m.DenyMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendLimit = append(m.SendLimit, types.Coin{})
+			if err := m.SendLimit[len(m.SendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendLimitWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SendLimitWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sent = append(m.Sent, types.Coin{})
+			if err := m.Sent[len(m.Sent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopedMessagePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopedMessagePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopedMessagePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigratedInterchainAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigratedInterchainAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigratedInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -3,6 +3,7 @@
 package types
 
 import (
+	"fmt"
 	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +19,15 @@ const (
 	// ParamsKey is the key to use for the storing params.
 	ParamsKey = "params"
 
+	// MessagePolicyKeyPrefix is the key prefix used to store message policies
+	MessagePolicyKeyPrefix = "messagePolicy"
+
+	// MigratedAccountKeyPrefix is the key prefix used to store interchain accounts migrated to 27-gmp accounts
+	MigratedAccountKeyPrefix = "migratedAccount"
+
+	// SendLimitUsageKeyPrefix is the key prefix used to store the coins sent by interchain accounts against send limits
+	SendLimitUsageKeyPrefix = "sendLimitUsage"
+
 	// AllowAllHostMsgs holds the string key that allows all message types on interchain accounts host module
 	AllowAllHostMsgs = "*"
 )
@@ -29,6 +39,27 @@ var (
 	KeyAllowMessages = []byte("AllowMessages")
 )
 
+// KeyConnectionMessagePolicy returns the store key of the message policy of the provided connection
+func KeyConnectionMessagePolicy(connectionID string) []byte {
+	return fmt.Appendf(nil, "%s/connection/%s", MessagePolicyKeyPrefix, connectionID)
+}
+
+// KeyClientMessagePolicy returns the store key of the message policy of the provided client
+func KeyClientMessagePolicy(clientID string) []byte {
+	return fmt.Appendf(nil, "%s/client/%s", MessagePolicyKeyPrefix, clientID)
+}
+
+// KeyMigratedAccount returns the store key of the migration of the interchain account of the provided controller port
+// on the provided connection
+func KeyMigratedAccount(portID, connectionID string) []byte {
+	return fmt.Appendf(nil, "%s/%s/%s", MigratedAccountKeyPrefix, portID, connectionID)
+}
+
+// KeySendLimitUsage returns the store key of the send limit usage of the provided interchain account address
+func KeySendLimitUsage(address string) []byte {
+	return fmt.Appendf(nil, "%s/%s", SendLimitUsageKeyPrefix, address)
+}
+
 // ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 	// check that wildcard * option for allowing all message types is the only string in the array, if so, return true
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gmptypes "github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+)
+
+// NewMigratedInterchainAccount creates a new MigratedInterchainAccount instance
+func NewMigratedInterchainAccount(connectionID, portID, accountAddress, clientID, sender string, salt []byte) MigratedInterchainAccount {
+	return MigratedInterchainAccount{
+		ConnectionId:   connectionID,
+		PortId:         portID,
+		AccountAddress: accountAddress,
+		ClientId:       clientID,
+		Sender:         sender,
+		Salt:           salt,
+	}
+}
+
+// AccountIdentifier returns the 27-gmp account identifier the interchain account has been migrated to
+func (m MigratedInterchainAccount) AccountIdentifier() gmptypes.AccountIdentifier {
+	return gmptypes.NewAccountIdentifier(m.ClientId, m.Sender, m.Salt)
+}
+
+// Validate validates the interchain account identifiers and address, and the 27-gmp account identifier
+func (m MigratedInterchainAccount) Validate() error {
+	if err := host.ConnectionIdentifierValidator(m.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(m.PortId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.AccountAddress); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateGMPAccountIdentifier(m.ClientId, m.Sender, m.Salt)
+}
+
+// ValidateGMPAccountIdentifier validates the client identifier, sender and salt of a 27-gmp account identifier
+func ValidateGMPAccountIdentifier(clientID, sender string, salt []byte) error {
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(sender) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if len(sender) > gmptypes.MaximumSenderLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "sender address must not exceed %d bytes", gmptypes.MaximumSenderLength)
+	}
+
+	if len(salt) > gmptypes.MaximumSaltLength {
+		return errorsmod.Wrapf(gmptypes.ErrInvalidSalt, "salt must not exceed %d bytes", gmptypes.MaximumSaltLength)
+	}
+
+	return nil
+}
+
+// ValidateMigratedAccounts validates the migrated interchain accounts and ensures each interchain account and each
+// 27-gmp account identifier is migrated at most once
+func ValidateMigratedAccounts(accounts []MigratedInterchainAccount) error {
+	seenAccounts := make(map[string]struct{})
+	seenIdentifiers := make(map[string]struct{})
+	for _, acc := range accounts {
+		if err := acc.Validate(); err != nil {
+			return err
+		}
+
+		accountKey := string(KeyMigratedAccount(acc.PortId, acc.ConnectionId))
+		if _, found := seenAccounts[accountKey]; found {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate migration of the interchain account of port %s on connection %s", acc.PortId, acc.ConnectionId)
+		}
+		seenAccounts[accountKey] = struct{}{}
+
+		identifierKey := fmt.Sprintf("%s/%s/%x", acc.ClientId, acc.Sender, acc.Salt)
+		if _, found := seenIdentifiers[identifierKey]; found {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate migration to the 27-gmp account identifier of client %s, sender %s and salt %x", acc.ClientId, acc.Sender, acc.Salt)
+		}
+		seenIdentifiers[identifierKey] = struct{}{}
+	}
+
+	return nil
+}
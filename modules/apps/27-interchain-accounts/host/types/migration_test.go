@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gmptypes "github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func TestValidateMigratedAccounts(t *testing.T) {
+	address := sdk.AccAddress(ibctesting.TestAccAddress).String()
+
+	testCases := []struct {
+		name     string
+		accounts []types.MigratedInterchainAccount
+		expErr   error
+	}{
+		{
+			"success: migrated accounts",
+			[]types.MigratedInterchainAccount{
+				types.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, ibctesting.MockPort, address, ibctesting.FirstClientID, address, nil),
+				types.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, "icacontroller-owner", address, ibctesting.FirstClientID, address, []byte("salt")),
+			},
+			nil,
+		},
+		{
+			"success: no migrated accounts",
+			nil,
+			nil,
+		},
+		{
+			"failure: invalid connection ID",
+			[]types.MigratedInterchainAccount{types.NewMigratedInterchainAccount("", ibctesting.MockPort, address, ibctesting.FirstClientID, address, nil)},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid account address",
+			[]types.MigratedInterchainAccount{types.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, ibctesting.MockPort, "address", ibctesting.FirstClientID, address, nil)},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: salt too long",
+			[]types.MigratedInterchainAccount{types.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, ibctesting.MockPort, address, ibctesting.FirstClientID, address, make([]byte, gmptypes.MaximumSaltLength+1))},
+			gmptypes.ErrInvalidSalt,
+		},
+		{
+			"failure: duplicate interchain account",
+			[]types.MigratedInterchainAccount{
+				types.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, ibctesting.MockPort, address, ibctesting.FirstClientID, address, nil),
+				types.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, ibctesting.MockPort, address, ibctesting.FirstClientID, address, []byte("salt")),
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: duplicate account identifier",
+			[]types.MigratedInterchainAccount{
+				types.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, ibctesting.MockPort, address, ibctesting.FirstClientID, address, nil),
+				types.NewMigratedInterchainAccount(ibctesting.FirstConnectionID, "icacontroller-owner", address, ibctesting.FirstClientID, address, nil),
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateMigratedAccounts(tc.accounts)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
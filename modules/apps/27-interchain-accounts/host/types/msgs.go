@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
 )
 
@@ -16,6 +17,15 @@ var (
 
 	_ sdk.Msg              = (*MsgModuleQuerySafe)(nil)
 	_ sdk.HasValidateBasic = (*MsgModuleQuerySafe)(nil)
+
+	_ sdk.Msg              = (*MsgSetMessagePolicy)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetMessagePolicy)(nil)
+
+	_ sdk.Msg              = (*MsgRemoveMessagePolicy)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveMessagePolicy)(nil)
+
+	_ sdk.Msg              = (*MsgMigrateToGMPAccount)(nil)
+	_ sdk.HasValidateBasic = (*MsgMigrateToGMPAccount)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -57,3 +67,70 @@ func (msg MsgModuleQuerySafe) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetMessagePolicy creates a new MsgSetMessagePolicy instance
+func NewMsgSetMessagePolicy(signer string, scopedPolicy ScopedMessagePolicy) *MsgSetMessagePolicy {
+	return &MsgSetMessagePolicy{
+		Signer:       signer,
+		ScopedPolicy: scopedPolicy,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgSetMessagePolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.ScopedPolicy.Validate()
+}
+
+// NewMsgRemoveMessagePolicy creates a new MsgRemoveMessagePolicy instance
+func NewMsgRemoveMessagePolicy(signer, connectionID, clientID string) *MsgRemoveMessagePolicy {
+	return &MsgRemoveMessagePolicy{
+		Signer:       signer,
+		ConnectionId: connectionID,
+		ClientId:     clientID,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgRemoveMessagePolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateMessagePolicyScope(msg.ConnectionId, msg.ClientId)
+}
+
+// NewMsgMigrateToGMPAccount creates a new MsgMigrateToGMPAccount instance
+func NewMsgMigrateToGMPAccount(signer, connectionID, portID, clientID, sender string, salt []byte) *MsgMigrateToGMPAccount {
+	return &MsgMigrateToGMPAccount{
+		Signer:       signer,
+		ConnectionId: connectionID,
+		PortId:       portID,
+		ClientId:     clientID,
+		Sender:       sender,
+		Salt:         salt,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgMigrateToGMPAccount) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	return ValidateGMPAccountIdentifier(msg.ClientId, msg.Sender, msg.Salt)
+}
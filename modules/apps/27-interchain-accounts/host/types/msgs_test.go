@@ -3,6 +3,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -10,8 +11,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 
+	gmptypes "github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/types"
 	ica "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts"
 	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v11/testing"
 )
@@ -140,3 +143,141 @@ func TestMsgModuleQuerySafeGetSigners(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetMessagePolicyValidateBasic(t *testing.T) {
+	policy := types.NewMessagePolicy([]string{"/cosmos.staking.v1beta1.MsgDelegate"}, nil, nil, 0)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetMessagePolicy
+		expErr error
+	}{
+		{
+			"success: connection policy",
+			types.NewMsgSetMessagePolicy(sdk.AccAddress(ibctesting.TestAccAddress).String(), types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", policy)),
+			nil,
+		},
+		{
+			"success: client policy",
+			types.NewMsgSetMessagePolicy(sdk.AccAddress(ibctesting.TestAccAddress).String(), types.NewScopedMessagePolicy("", ibctesting.FirstClientID, policy)),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgSetMessagePolicy("signer", types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", policy)),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid scope",
+			types.NewMsgSetMessagePolicy(sdk.AccAddress(ibctesting.TestAccAddress).String(), types.NewScopedMessagePolicy("", "", policy)),
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err)
+		} else {
+			require.ErrorIs(t, err, tc.expErr)
+		}
+	}
+}
+
+func TestMsgRemoveMessagePolicyValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRemoveMessagePolicy
+		expErr error
+	}{
+		{
+			"success: valid signer address",
+			types.NewMsgRemoveMessagePolicy(sdk.AccAddress(ibctesting.TestAccAddress).String(), ibctesting.FirstConnectionID, ""),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgRemoveMessagePolicy("signer", ibctesting.FirstConnectionID, ""),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: both connection and client",
+			types.NewMsgRemoveMessagePolicy(sdk.AccAddress(ibctesting.TestAccAddress).String(), ibctesting.FirstConnectionID, ibctesting.FirstClientID),
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err)
+		} else {
+			require.ErrorIs(t, err, tc.expErr)
+		}
+	}
+}
+
+func TestMsgMigrateToGMPAccountValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgMigrateToGMPAccount
+		expErr error
+	}{
+		{
+			"success: valid message",
+			types.NewMsgMigrateToGMPAccount(signer, ibctesting.FirstConnectionID, ibctesting.MockPort, ibctesting.FirstClientID, signer, []byte("salt")),
+			nil,
+		},
+		{
+			"success: empty salt",
+			types.NewMsgMigrateToGMPAccount(signer, ibctesting.FirstConnectionID, ibctesting.MockPort, ibctesting.FirstClientID, signer, nil),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgMigrateToGMPAccount("signer", ibctesting.FirstConnectionID, ibctesting.MockPort, ibctesting.FirstClientID, signer, nil),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid connection ID",
+			types.NewMsgMigrateToGMPAccount(signer, "", ibctesting.MockPort, ibctesting.FirstClientID, signer, nil),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid port ID",
+			types.NewMsgMigrateToGMPAccount(signer, ibctesting.FirstConnectionID, "", ibctesting.FirstClientID, signer, nil),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid client ID",
+			types.NewMsgMigrateToGMPAccount(signer, ibctesting.FirstConnectionID, ibctesting.MockPort, "", signer, nil),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: empty sender",
+			types.NewMsgMigrateToGMPAccount(signer, ibctesting.FirstConnectionID, ibctesting.MockPort, ibctesting.FirstClientID, " ", nil),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: sender too long",
+			types.NewMsgMigrateToGMPAccount(signer, ibctesting.FirstConnectionID, ibctesting.MockPort, ibctesting.FirstClientID, strings.Repeat("a", gmptypes.MaximumSenderLength+1), nil),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: salt too long",
+			types.NewMsgMigrateToGMPAccount(signer, ibctesting.FirstConnectionID, ibctesting.MockPort, ibctesting.FirstClientID, signer, make([]byte, gmptypes.MaximumSaltLength+1)),
+			gmptypes.ErrInvalidSalt,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
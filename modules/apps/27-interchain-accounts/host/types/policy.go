@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+)
+
+// NewMessagePolicy creates a new MessagePolicy instance
+func NewMessagePolicy(allowMsgs, denyMsgs []string, sendLimit sdk.Coins, sendLimitWindow time.Duration) MessagePolicy {
+	return MessagePolicy{
+		AllowMessages:   allowMsgs,
+		DenyMessages:    denyMsgs,
+		SendLimit:       sendLimit,
+		SendLimitWindow: sendLimitWindow,
+	}
+}
+
+// Validate validates the allowed and denied message typeURLs and the send limit of the message policy
+func (p MessagePolicy) Validate() error {
+	if err := validateAllowlist(p.AllowMessages); err != nil {
+		return err
+	}
+
+	if len(p.DenyMessages) > MaxAllowListLength {
+		return fmt.Errorf("deny list length must not exceed %d items", MaxAllowListLength)
+	}
+
+	for _, typeURL := range p.DenyMessages {
+		if strings.TrimSpace(typeURL) == "" {
+			return fmt.Errorf("deny list must not contain empty strings: %s", p.DenyMessages)
+		}
+	}
+
+	if err := p.SendLimit.Validate(); err != nil {
+		return err
+	}
+
+	if p.SendLimitWindow < 0 {
+		return fmt.Errorf("send limit window cannot be negative: %s", p.SendLimitWindow)
+	}
+
+	if !p.SendLimit.Empty() && p.SendLimitWindow == 0 {
+		return errors.New("send limit window must be positive if a send limit is set")
+	}
+
+	return nil
+}
+
+// NewScopedMessagePolicy creates a new ScopedMessagePolicy instance. Exactly one of the connectionID and the clientID
+// must be provided.
+func NewScopedMessagePolicy(connectionID, clientID string, policy MessagePolicy) ScopedMessagePolicy {
+	return ScopedMessagePolicy{
+		ConnectionId: connectionID,
+		ClientId:     clientID,
+		Policy:       policy,
+	}
+}
+
+// Validate validates the scope and the message policy
+func (sp ScopedMessagePolicy) Validate() error {
+	if err := ValidateMessagePolicyScope(sp.ConnectionId, sp.ClientId); err != nil {
+		return err
+	}
+
+	return sp.Policy.Validate()
+}
+
+// ValidateMessagePolicyScope validates that exactly one of the connectionID and the clientID is provided, and that it
+// is a valid identifier.
+func ValidateMessagePolicyScope(connectionID, clientID string) error {
+	switch {
+	case connectionID != "" && clientID != "":
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "message policy scope must be either a connection or a client, not both")
+	case connectionID != "":
+		return host.ConnectionIdentifierValidator(connectionID)
+	case clientID != "":
+		return host.ClientIdentifierValidator(clientID)
+	default:
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "message policy scope must be either a connection or a client")
+	}
+}
+
+// ValidateMessagePolicies validates the scoped message policies and ensures at most one policy is set per scope
+func ValidateMessagePolicies(policies []ScopedMessagePolicy) error {
+	scopes := make(map[string]bool, len(policies))
+	for _, sp := range policies {
+		if err := sp.Validate(); err != nil {
+			return err
+		}
+
+		scope := sp.ConnectionId + "/" + sp.ClientId
+		if scopes[scope] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate message policy for connection %q, client %q", sp.ConnectionId, sp.ClientId)
+		}
+		scopes[scope] = true
+	}
+
+	return nil
+}
+
+// GetNestedMsgs returns the messages nested in a message which executes other messages, such as the authz MsgExec or
+// the gov MsgSubmitProposal, or nil for any other message.
+func GetNestedMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	switch msg := msg.(type) {
+	case interface{ GetMessages() ([]sdk.Msg, error) }:
+		return msg.GetMessages()
+	case interface{ GetMsgs() ([]sdk.Msg, error) }:
+		return msg.GetMsgs()
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func TestValidateMessagePolicy(t *testing.T) {
+	sendLimit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+
+	require.NoError(t, types.NewMessagePolicy(nil, nil, nil, 0).Validate())
+	require.NoError(t, types.NewMessagePolicy([]string{"*"}, []string{"/cosmos.bank.v1beta1.MsgMultiSend"}, sendLimit, time.Hour).Validate())
+	require.Error(t, types.NewMessagePolicy([]string{""}, nil, nil, 0).Validate())
+	require.Error(t, types.NewMessagePolicy(nil, []string{" "}, nil, 0).Validate())
+	require.Error(t, types.NewMessagePolicy(nil, make([]string, types.MaxAllowListLength+1), nil, 0).Validate())
+	require.Error(t, types.NewMessagePolicy(nil, nil, sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(-1)}}, time.Hour).Validate())
+	require.Error(t, types.NewMessagePolicy(nil, nil, sendLimit, 0).Validate())
+	require.Error(t, types.NewMessagePolicy(nil, nil, nil, -time.Hour).Validate())
+}
+
+func TestValidateMessagePolicies(t *testing.T) {
+	policy := types.NewMessagePolicy([]string{"/cosmos.staking.v1beta1.MsgDelegate"}, nil, nil, 0)
+
+	testCases := []struct {
+		name     string
+		policies []types.ScopedMessagePolicy
+		expErr   error
+	}{
+		{
+			"success: connection and client policies",
+			[]types.ScopedMessagePolicy{
+				types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", policy),
+				types.NewScopedMessagePolicy("", ibctesting.FirstClientID, policy),
+			},
+			nil,
+		},
+		{
+			"success: no policies",
+			nil,
+			nil,
+		},
+		{
+			"failure: both connection and client",
+			[]types.ScopedMessagePolicy{types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, ibctesting.FirstClientID, policy)},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: neither connection nor client",
+			[]types.ScopedMessagePolicy{types.NewScopedMessagePolicy("", "", policy)},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: invalid connection ID",
+			[]types.ScopedMessagePolicy{types.NewScopedMessagePolicy("invalid/connection", "", policy)},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: duplicate scope",
+			[]types.ScopedMessagePolicy{
+				types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", policy),
+				types.NewScopedMessagePolicy(ibctesting.FirstConnectionID, "", types.NewMessagePolicy(nil, nil, nil, 0)),
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateMessagePolicies(tc.policies)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
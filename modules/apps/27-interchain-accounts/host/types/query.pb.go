@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -111,9 +112,214 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryInterchainAccountRequest is the request type for the Query/InterchainAccount RPC method.
+type QueryInterchainAccountRequest struct {
+	// owner address of the interchain account on the controller chain
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// client identifier of the controller chain on the host chain
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryInterchainAccountRequest) Reset()         { *m = QueryInterchainAccountRequest{} }
+func (m *QueryInterchainAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{2}
+}
+func (m *QueryInterchainAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryInterchainAccountRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryInterchainAccountResponse the response type for the Query/InterchainAccount RPC method.
+type QueryInterchainAccountResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryInterchainAccountResponse) Reset()         { *m = QueryInterchainAccountResponse{} }
+func (m *QueryInterchainAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{3}
+}
+func (m *QueryInterchainAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryEffectiveMessagePolicyRequest is the request type for the Query/EffectiveMessagePolicy RPC method.
+type QueryEffectiveMessagePolicyRequest struct {
+	// connection identifier on the host chain
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *QueryEffectiveMessagePolicyRequest) Reset()         { *m = QueryEffectiveMessagePolicyRequest{} }
+func (m *QueryEffectiveMessagePolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMessagePolicyRequest) ProtoMessage()    {}
+func (*QueryEffectiveMessagePolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{4}
+}
+func (m *QueryEffectiveMessagePolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveMessagePolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMessagePolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveMessagePolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMessagePolicyRequest.Merge(m, src)
+}
+func (m *QueryEffectiveMessagePolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveMessagePolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMessagePolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveMessagePolicyRequest proto.InternalMessageInfo
+
+func (m *QueryEffectiveMessagePolicyRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryEffectiveMessagePolicyResponse the response type for the Query/EffectiveMessagePolicy RPC method.
+type QueryEffectiveMessagePolicyResponse struct {
+	// policy in effect, with the allow_messages parameter applied if the policy does not set allowed messages
+	Policy MessagePolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	// source defines the scope of the policy in effect
+	Source MessagePolicySource `protobuf:"varint,2,opt,name=source,proto3,enum=ibc.applications.interchain_accounts.host.v1.MessagePolicySource" json:"source,omitempty"`
+}
+
+func (m *QueryEffectiveMessagePolicyResponse) Reset()         { *m = QueryEffectiveMessagePolicyResponse{} }
+func (m *QueryEffectiveMessagePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMessagePolicyResponse) ProtoMessage()    {}
+func (*QueryEffectiveMessagePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{5}
+}
+func (m *QueryEffectiveMessagePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveMessagePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMessagePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveMessagePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMessagePolicyResponse.Merge(m, src)
+}
+func (m *QueryEffectiveMessagePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveMessagePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMessagePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveMessagePolicyResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveMessagePolicyResponse) GetPolicy() MessagePolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return MessagePolicy{}
+}
+
+func (m *QueryEffectiveMessagePolicyResponse) GetSource() MessagePolicySource {
+	if m != nil {
+		return m.Source
+	}
+	return POLICY_SOURCE_UNSPECIFIED
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryInterchainAccountRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountRequest")
+	proto.RegisterType((*QueryInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountResponse")
+	proto.RegisterType((*QueryEffectiveMessagePolicyRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryEffectiveMessagePolicyRequest")
+	proto.RegisterType((*QueryEffectiveMessagePolicyResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryEffectiveMessagePolicyResponse")
 }
 
 func init() {
@@ -121,27 +327,44 @@ func init() {
 }
 
 var fileDescriptor_e6b7e23fc90c353a = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x31, 0x4b, 0x33, 0x31,
-	0x18, 0xc7, 0x9b, 0x17, 0xde, 0x0e, 0x71, 0x8b, 0x0e, 0x52, 0x24, 0x48, 0x27, 0x87, 0x36, 0xe1,
-	0x6a, 0xa1, 0x8e, 0xea, 0xaa, 0x83, 0x3a, 0xba, 0x48, 0x2e, 0x86, 0x6b, 0xa0, 0x97, 0x27, 0xbd,
-	0xe4, 0x0e, 0xba, 0xfa, 0x09, 0x04, 0x3f, 0x92, 0x8b, 0x8b, 0x50, 0x70, 0x71, 0x94, 0x3b, 0x3f,
-	0x88, 0x5c, 0xee, 0x40, 0x8b, 0x22, 0x1c, 0xae, 0x4f, 0xf8, 0xfd, 0xfe, 0xcf, 0xff, 0x09, 0x3e,
-	0xd2, 0xb1, 0xe4, 0xc2, 0xda, 0x85, 0x96, 0xc2, 0x6b, 0x30, 0x8e, 0x6b, 0xe3, 0x55, 0x26, 0xe7,
-	0x42, 0x9b, 0x1b, 0x21, 0x25, 0xe4, 0xc6, 0x3b, 0x3e, 0x07, 0xe7, 0x79, 0x11, 0xf1, 0x65, 0xae,
-	0xb2, 0x15, 0xb3, 0x19, 0x78, 0x20, 0x23, 0x1d, 0x4b, 0xf6, 0x95, 0x64, 0x3f, 0x90, 0xac, 0x26,
-	0x59, 0x11, 0x0d, 0xf6, 0x12, 0x80, 0x64, 0xa1, 0xb8, 0xb0, 0x9a, 0x0b, 0x63, 0xc0, 0xb7, 0x4c,
-	0x70, 0x0d, 0x66, 0x9d, 0xb6, 0x08, 0xce, 0x00, 0x0e, 0x77, 0x30, 0xb9, 0xac, 0x77, 0xba, 0x10,
-	0x99, 0x48, 0xdd, 0x95, 0x5a, 0xe6, 0xca, 0xf9, 0xa1, 0xc4, 0xdb, 0x1b, 0x53, 0x67, 0xc1, 0x38,
-	0x45, 0xce, 0x71, 0xdf, 0x86, 0xc9, 0x2e, 0xda, 0x47, 0x07, 0x5b, 0x93, 0x29, 0xeb, 0x52, 0x81,
-	0xb5, 0xb6, 0xd6, 0x31, 0x79, 0x46, 0xf8, 0x7f, 0x48, 0x21, 0x8f, 0x08, 0xf7, 0x9b, 0x47, 0x72,
-	0xdc, 0x4d, 0xf9, 0x7d, 0xf7, 0xc1, 0xc9, 0x1f, 0x0c, 0x4d, 0xcf, 0xe1, 0xf4, 0xee, 0xe5, 0xfd,
-	0xe1, 0x1f, 0x23, 0x23, 0xde, 0x9e, 0xf5, 0xf7, 0x73, 0x36, 0x7d, 0x4e, 0xd5, 0x53, 0x49, 0xd1,
-	0xba, 0xa4, 0xe8, 0xad, 0xa4, 0xe8, 0xbe, 0xa2, 0xbd, 0x75, 0x45, 0x7b, 0xaf, 0x15, 0xed, 0x5d,
-	0x9f, 0x25, 0xda, 0xcf, 0xf3, 0x98, 0x49, 0x48, 0xb9, 0x04, 0x97, 0x82, 0xab, 0xc5, 0xe3, 0x04,
-	0x78, 0x11, 0x45, 0x3c, 0x85, 0xdb, 0x7c, 0xa1, 0x5c, 0x93, 0x33, 0x99, 0x8d, 0x3f, 0xa3, 0xc6,
-	0x9b, 0x51, 0x7e, 0x65, 0x95, 0x8b, 0xfb, 0xe1, 0xe3, 0x0e, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x44, 0x4b, 0x42, 0x57, 0x79, 0x02, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0xc1, 0x44, 0x3b, 0xfe, 0x00, 0xc7, 0x20, 0x21, 0xea, 0x2a, 0xdb, 0x8b, 0x87,
+	0x66, 0x87, 0xc4, 0x42, 0x45, 0x2f, 0xa6, 0x20, 0x18, 0x5b, 0x21, 0x5d, 0x2f, 0xea, 0x25, 0x6c,
+	0x66, 0xa7, 0x9b, 0x81, 0x64, 0xde, 0x76, 0x67, 0x37, 0x12, 0x4a, 0x40, 0xfc, 0x0b, 0x04, 0x0f,
+	0xfe, 0x41, 0x5e, 0x7a, 0x2c, 0x78, 0x11, 0x04, 0x91, 0xc4, 0x93, 0x7f, 0x85, 0xec, 0xcc, 0xb4,
+	0x69, 0x68, 0x1b, 0x9a, 0xb6, 0xa7, 0x64, 0xde, 0xec, 0xfb, 0xbc, 0xef, 0x7b, 0xfb, 0xbe, 0x8b,
+	0x9e, 0xf2, 0x0e, 0x25, 0x7e, 0x14, 0xf5, 0x38, 0xf5, 0x13, 0x0e, 0x42, 0x12, 0x2e, 0x12, 0x16,
+	0xd3, 0xae, 0xcf, 0x45, 0xdb, 0xa7, 0x14, 0x52, 0x91, 0x48, 0xd2, 0x05, 0x99, 0x90, 0x41, 0x8d,
+	0xec, 0xa4, 0x2c, 0x1e, 0xba, 0x51, 0x0c, 0x09, 0xe0, 0x15, 0xde, 0xa1, 0xee, 0xd1, 0x4c, 0xf7,
+	0x84, 0x4c, 0x37, 0xcb, 0x74, 0x07, 0xb5, 0x4a, 0x29, 0x84, 0x10, 0x54, 0x22, 0xc9, 0xfe, 0x69,
+	0x46, 0xe5, 0x7e, 0x08, 0x10, 0xf6, 0x18, 0xf1, 0x23, 0x4e, 0x7c, 0x21, 0x20, 0x31, 0x24, 0x7d,
+	0xbb, 0xb6, 0x90, 0x36, 0x55, 0x49, 0x25, 0x3a, 0x25, 0x84, 0xb7, 0x32, 0xa5, 0x2d, 0x3f, 0xf6,
+	0xfb, 0xd2, 0x63, 0x3b, 0x29, 0x93, 0x89, 0x43, 0xd1, 0x9d, 0x99, 0xa8, 0x8c, 0x40, 0x48, 0x86,
+	0x37, 0x51, 0x31, 0x52, 0x91, 0xb2, 0xf5, 0xc8, 0x7a, 0x7c, 0xbd, 0xbe, 0xea, 0x2e, 0xd2, 0x98,
+	0x6b, 0x68, 0x86, 0xe1, 0x78, 0xe8, 0x81, 0x2a, 0xd2, 0x3c, 0x4c, 0x69, 0xe8, 0x0c, 0xa3, 0x02,
+	0x97, 0x50, 0x01, 0x3e, 0x0a, 0x16, 0xab, 0x6a, 0x4b, 0x9e, 0x3e, 0xe0, 0x7b, 0x68, 0x89, 0xf6,
+	0x38, 0x13, 0x49, 0x9b, 0x07, 0xe5, 0xbc, 0xba, 0xb9, 0xa6, 0x03, 0xcd, 0xc0, 0x79, 0x86, 0xec,
+	0xd3, 0x98, 0xa6, 0x87, 0x32, 0xba, 0xea, 0x07, 0x41, 0xcc, 0xa4, 0x34, 0xd8, 0x83, 0xa3, 0xd3,
+	0x44, 0x8e, 0xca, 0x7d, 0xb9, 0xbd, 0xcd, 0x68, 0xc2, 0x07, 0xec, 0x0d, 0x93, 0xd2, 0x0f, 0x59,
+	0x0b, 0x7a, 0x9c, 0x0e, 0x0f, 0x44, 0x2d, 0xa3, 0x9b, 0x14, 0x84, 0xc8, 0x9e, 0x00, 0x91, 0x49,
+	0xd0, 0x94, 0x1b, 0xd3, 0x60, 0x33, 0x70, 0x7e, 0x59, 0x68, 0x79, 0x2e, 0xcb, 0x88, 0x79, 0x8f,
+	0x8a, 0x91, 0x8a, 0x98, 0x81, 0x3e, 0x5f, 0x6c, 0xa0, 0x33, 0xd0, 0xf5, 0x2b, 0x7b, 0xbf, 0x1f,
+	0xe6, 0x3c, 0x03, 0xcc, 0xd0, 0x12, 0xd2, 0x98, 0x32, 0x35, 0xa3, 0x5b, 0xf5, 0xc6, 0x05, 0xd0,
+	0x6f, 0x15, 0xc8, 0x33, 0xc0, 0xfa, 0xbf, 0x02, 0x2a, 0xa8, 0xee, 0xf0, 0x77, 0x0b, 0x15, 0xf5,
+	0x5b, 0xc5, 0x2f, 0x16, 0xe3, 0x1f, 0x5f, 0xba, 0x4a, 0xe3, 0x02, 0x04, 0x3d, 0x4f, 0x67, 0xf5,
+	0xf3, 0x8f, 0xbf, 0x5f, 0xf3, 0x2e, 0x5e, 0x21, 0xc6, 0x0f, 0xf3, 0x7d, 0xa0, 0x17, 0x11, 0x7f,
+	0xca, 0xa3, 0xdb, 0xc7, 0x16, 0x06, 0x6f, 0x9c, 0x43, 0xce, 0x69, 0xab, 0x5c, 0xd9, 0xbc, 0x1c,
+	0x98, 0x69, 0xb3, 0xa5, 0xda, 0x7c, 0x8d, 0x5f, 0x9d, 0xad, 0x4d, 0xe5, 0x1b, 0x49, 0x76, 0xd5,
+	0xef, 0x88, 0x68, 0xb3, 0x48, 0xb2, 0x7b, 0x68, 0xa3, 0x11, 0xfe, 0x96, 0x47, 0x77, 0x4f, 0xde,
+	0x55, 0xdc, 0x3a, 0x87, 0xf4, 0xb9, 0x16, 0xaa, 0x6c, 0x5d, 0x22, 0xd1, 0x4c, 0xe4, 0x9d, 0x9a,
+	0x88, 0x87, 0x5b, 0x67, 0x9b, 0xc8, 0xd4, 0xac, 0xd9, 0x18, 0x8e, 0xda, 0x79, 0x44, 0xfa, 0xba,
+	0x40, 0x5b, 0xfb, 0x68, 0x9d, 0xed, 0x8d, 0x6d, 0x6b, 0x7f, 0x6c, 0x5b, 0x7f, 0xc6, 0xb6, 0xf5,
+	0x65, 0x62, 0xe7, 0xf6, 0x27, 0x76, 0xee, 0xe7, 0xc4, 0xce, 0x7d, 0xd8, 0x08, 0x79, 0xd2, 0x4d,
+	0x3b, 0x2e, 0x85, 0x3e, 0xa1, 0x20, 0xfb, 0x20, 0xb3, 0xe2, 0xd5, 0x10, 0xc8, 0xa0, 0x56, 0x23,
+	0x7d, 0x08, 0xd2, 0x1e, 0x93, 0x5a, 0x4b, 0x7d, 0xad, 0x3a, 0x95, 0x53, 0x9d, 0x95, 0x93, 0x0c,
+	0x23, 0x26, 0x3b, 0x45, 0xf5, 0x39, 0x7e, 0xf2, 0x7f, 0x00, 0x0f, 0xad, 0x6a, 0x16, 0x65, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +381,11 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// InterchainAccount returns the address of the interchain account controlled over IBC v2 by a given owner address
+	// on a given host client
+	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
+	// EffectiveMessagePolicy returns the message policy in effect for the interchain accounts of a given connection
+	EffectiveMessagePolicy(ctx context.Context, in *QueryEffectiveMessagePolicyRequest, opts ...grpc.CallOption) (*QueryEffectiveMessagePolicyResponse, error)
 }
 
 type queryClient struct {
@@ -177,10 +405,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error) {
+	out := new(QueryInterchainAccountResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveMessagePolicy(ctx context.Context, in *QueryEffectiveMessagePolicyRequest, opts ...grpc.CallOption) (*QueryEffectiveMessagePolicyResponse, error) {
+	out := new(QueryEffectiveMessagePolicyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/EffectiveMessagePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// InterchainAccount returns the address of the interchain account controlled over IBC v2 by a given owner address
+	// on a given host client
+	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
+	// EffectiveMessagePolicy returns the message policy in effect for the interchain accounts of a given connection
+	EffectiveMessagePolicy(context.Context, *QueryEffectiveMessagePolicyRequest) (*QueryEffectiveMessagePolicyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -190,6 +441,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) InterchainAccount(ctx context.Context, req *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccount not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveMessagePolicy(ctx context.Context, req *QueryEffectiveMessagePolicyRequest) (*QueryEffectiveMessagePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveMessagePolicy not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -213,6 +470,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InterchainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccount(ctx, req.(*QueryInterchainAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveMessagePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveMessagePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveMessagePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/EffectiveMessagePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveMessagePolicy(ctx, req.(*QueryEffectiveMessagePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.host.v1.Query",
@@ -222,6 +515,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "InterchainAccount",
+			Handler:    _Query_InterchainAccount_Handler,
+		},
+		{
+			MethodName: "EffectiveMessagePolicy",
+			Handler:    _Query_EffectiveMessagePolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/host/v1/query.proto",
@@ -285,6 +586,141 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInterchainAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMessagePolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMessagePolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMessagePolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMessagePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMessagePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMessagePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Source != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -318,14 +754,71 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (m *QueryInterchainAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveMessagePolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveMessagePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Source != 0 {
+		n += 1 + sovQuery(uint64(m.Source))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -460,6 +953,386 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveMessagePolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMessagePolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMessagePolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveMessagePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMessagePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMessagePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= MessagePolicySource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -205,11 +205,261 @@ func (m *MsgModuleQuerySafeResponse) GetResponses() [][]byte {
 	return nil
 }
 
+// MsgSetMessagePolicy defines the payload for Msg/SetMessagePolicy
+type MsgSetMessagePolicy struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// scoped_policy defines the message policy to set and the connection or client it applies to.
+	ScopedPolicy ScopedMessagePolicy `protobuf:"bytes,2,opt,name=scoped_policy,json=scopedPolicy,proto3" json:"scoped_policy"`
+}
+
+func (m *MsgSetMessagePolicy) Reset()         { *m = MsgSetMessagePolicy{} }
+func (m *MsgSetMessagePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMessagePolicy) ProtoMessage()    {}
+func (*MsgSetMessagePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{4}
+}
+func (m *MsgSetMessagePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMessagePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMessagePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMessagePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMessagePolicy.Merge(m, src)
+}
+func (m *MsgSetMessagePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMessagePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMessagePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMessagePolicy proto.InternalMessageInfo
+
+// MsgSetMessagePolicyResponse defines the response for Msg/SetMessagePolicy
+type MsgSetMessagePolicyResponse struct {
+}
+
+func (m *MsgSetMessagePolicyResponse) Reset()         { *m = MsgSetMessagePolicyResponse{} }
+func (m *MsgSetMessagePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMessagePolicyResponse) ProtoMessage()    {}
+func (*MsgSetMessagePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{5}
+}
+func (m *MsgSetMessagePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMessagePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMessagePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMessagePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMessagePolicyResponse.Merge(m, src)
+}
+func (m *MsgSetMessagePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMessagePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMessagePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMessagePolicyResponse proto.InternalMessageInfo
+
+// MsgRemoveMessagePolicy defines the payload for Msg/RemoveMessagePolicy
+type MsgRemoveMessagePolicy struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// connection identifier of the message policy to remove
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// client identifier of the message policy to remove
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *MsgRemoveMessagePolicy) Reset()         { *m = MsgRemoveMessagePolicy{} }
+func (m *MsgRemoveMessagePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMessagePolicy) ProtoMessage()    {}
+func (*MsgRemoveMessagePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{6}
+}
+func (m *MsgRemoveMessagePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMessagePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMessagePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMessagePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMessagePolicy.Merge(m, src)
+}
+func (m *MsgRemoveMessagePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMessagePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMessagePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMessagePolicy proto.InternalMessageInfo
+
+// MsgRemoveMessagePolicyResponse defines the response for Msg/RemoveMessagePolicy
+type MsgRemoveMessagePolicyResponse struct {
+}
+
+func (m *MsgRemoveMessagePolicyResponse) Reset()         { *m = MsgRemoveMessagePolicyResponse{} }
+func (m *MsgRemoveMessagePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMessagePolicyResponse) ProtoMessage()    {}
+func (*MsgRemoveMessagePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{7}
+}
+func (m *MsgRemoveMessagePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMessagePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMessagePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMessagePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMessagePolicyResponse.Merge(m, src)
+}
+func (m *MsgRemoveMessagePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMessagePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMessagePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMessagePolicyResponse proto.InternalMessageInfo
+
+// MsgMigrateToGMPAccount defines the payload for Msg/MigrateToGMPAccount
+type MsgMigrateToGMPAccount struct {
+	// signer address, the interchain account to migrate
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// connection identifier of the interchain account on the host chain
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// controller port identifier of the interchain account
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// client identifier of the 27-gmp account identifier
+	ClientId string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// sender of the 27-gmp account identifier
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// salt of the 27-gmp account identifier
+	Salt []byte `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (m *MsgMigrateToGMPAccount) Reset()         { *m = MsgMigrateToGMPAccount{} }
+func (m *MsgMigrateToGMPAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateToGMPAccount) ProtoMessage()    {}
+func (*MsgMigrateToGMPAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{8}
+}
+func (m *MsgMigrateToGMPAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateToGMPAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateToGMPAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateToGMPAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateToGMPAccount.Merge(m, src)
+}
+func (m *MsgMigrateToGMPAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateToGMPAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateToGMPAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateToGMPAccount proto.InternalMessageInfo
+
+// MsgMigrateToGMPAccountResponse defines the response for Msg/MigrateToGMPAccount
+type MsgMigrateToGMPAccountResponse struct {
+}
+
+func (m *MsgMigrateToGMPAccountResponse) Reset()         { *m = MsgMigrateToGMPAccountResponse{} }
+func (m *MsgMigrateToGMPAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateToGMPAccountResponse) ProtoMessage()    {}
+func (*MsgMigrateToGMPAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{9}
+}
+func (m *MsgMigrateToGMPAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateToGMPAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateToGMPAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateToGMPAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateToGMPAccountResponse.Merge(m, src)
+}
+func (m *MsgMigrateToGMPAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateToGMPAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateToGMPAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateToGMPAccountResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.interchain_accounts.host.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgModuleQuerySafe)(nil), "ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafe")
 	proto.RegisterType((*MsgModuleQuerySafeResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafeResponse")
+	proto.RegisterType((*MsgSetMessagePolicy)(nil), "ibc.applications.interchain_accounts.host.v1.MsgSetMessagePolicy")
+	proto.RegisterType((*MsgSetMessagePolicyResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgSetMessagePolicyResponse")
+	proto.RegisterType((*MsgRemoveMessagePolicy)(nil), "ibc.applications.interchain_accounts.host.v1.MsgRemoveMessagePolicy")
+	proto.RegisterType((*MsgRemoveMessagePolicyResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgRemoveMessagePolicyResponse")
+	proto.RegisterType((*MsgMigrateToGMPAccount)(nil), "ibc.applications.interchain_accounts.host.v1.MsgMigrateToGMPAccount")
+	proto.RegisterType((*MsgMigrateToGMPAccountResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgMigrateToGMPAccountResponse")
 }
 
 func init() {
@@ -217,36 +467,51 @@ func init() {
 }
 
 var fileDescriptor_fa437afde7f1e7ae = []byte{
-	// 457 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x33, 0x6d, 0x5d, 0xec, 0xb4, 0x50, 0x08, 0x62, 0x6b, 0x90, 0xb4, 0xec, 0x69, 0x29,
-	0xee, 0x0c, 0xbb, 0x2a, 0x85, 0x82, 0x20, 0x05, 0x41, 0x90, 0x05, 0x1d, 0xf1, 0x22, 0x82, 0x4c,
-	0xa6, 0xe3, 0x64, 0xa0, 0xc9, 0xc4, 0xbc, 0xc9, 0x62, 0x6f, 0xe2, 0xc9, 0x93, 0x78, 0xf0, 0x26,
-	0x82, 0x1f, 0xa1, 0xdf, 0xc1, 0x4b, 0x8f, 0x3d, 0x7a, 0x12, 0xd9, 0x3d, 0xf4, 0x6b, 0x48, 0x66,
-	0xa7, 0xad, 0x4d, 0xf5, 0x10, 0xf6, 0x96, 0x49, 0xe6, 0xff, 0x7b, 0xbf, 0x97, 0x99, 0x87, 0xef,
-	0xeb, 0x44, 0x50, 0x5e, 0x14, 0x07, 0x5a, 0x70, 0xab, 0x4d, 0x0e, 0x54, 0xe7, 0x56, 0x96, 0x22,
-	0xe5, 0x3a, 0x7f, 0xcd, 0x85, 0x30, 0x55, 0x6e, 0x81, 0xa6, 0x06, 0x2c, 0x1d, 0x0f, 0xa8, 0x7d,
-	0x47, 0x8a, 0xd2, 0x58, 0x13, 0xde, 0xd1, 0x89, 0x20, 0x7f, 0xc7, 0xc8, 0x3f, 0x62, 0xa4, 0x8e,
-	0x91, 0xf1, 0x20, 0xba, 0xa1, 0x8c, 0x32, 0x2e, 0x48, 0xeb, 0xa7, 0x19, 0x23, 0x5a, 0x17, 0x06,
-	0x32, 0x03, 0x34, 0x03, 0x55, 0xb3, 0x33, 0x50, 0xfe, 0xc3, 0x4e, 0x2b, 0x27, 0x57, 0xc4, 0x05,
-	0xbb, 0x9f, 0x10, 0x5e, 0x1b, 0x81, 0x7a, 0x51, 0xec, 0x73, 0x2b, 0x9f, 0xf2, 0x92, 0x67, 0x10,
-	0xde, 0xc4, 0x1d, 0xd0, 0x2a, 0x97, 0xe5, 0x06, 0xda, 0x42, 0xbd, 0x65, 0xe6, 0x57, 0x21, 0xc3,
-	0x9d, 0xc2, 0xed, 0xd8, 0x58, 0xd8, 0x42, 0xbd, 0x95, 0xe1, 0x3d, 0xd2, 0xa6, 0x25, 0x32, 0xa3,
-	0xef, 0x2d, 0x1d, 0xff, 0xda, 0x0c, 0x98, 0x27, 0xed, 0xae, 0x7d, 0xfc, 0xbe, 0x19, 0x7c, 0x38,
-	0x3d, 0xda, 0xf6, 0x45, 0xba, 0xb7, 0xf0, 0x7a, 0xc3, 0x87, 0x49, 0x28, 0x4c, 0x0e, 0xb2, 0xfb,
-	0x15, 0xe1, 0x70, 0x04, 0x6a, 0x64, 0xf6, 0xab, 0x03, 0xf9, 0xac, 0x92, 0xe5, 0xe1, 0x73, 0xfe,
-	0x46, 0xfe, 0x57, 0xf7, 0x15, 0xbe, 0x5e, 0xca, 0xb7, 0x95, 0x04, 0x5b, 0x0b, 0x2f, 0xf6, 0x56,
-	0x86, 0xbb, 0xed, 0x84, 0x5d, 0x09, 0x36, 0x43, 0x78, 0xed, 0x73, 0xe2, 0x55, 0x71, 0x86, 0xa3,
-	0xab, 0x72, 0x67, 0xee, 0xb5, 0x64, 0x2a, 0xb5, 0x4a, 0xad, 0x93, 0x5c, 0x62, 0x7e, 0x15, 0xde,
-	0xc6, 0xcb, 0xa5, 0xdf, 0x33, 0xb3, 0x5c, 0x65, 0x17, 0x2f, 0x86, 0x3f, 0x16, 0xf0, 0xe2, 0x08,
-	0x54, 0xf8, 0x05, 0xe1, 0xd5, 0x4b, 0x47, 0xf4, 0xa0, 0x5d, 0x27, 0x8d, 0x3f, 0x1a, 0x3d, 0x9a,
-	0x2b, 0x7e, 0xde, 0xd4, 0xb7, 0xfa, 0xf2, 0x34, 0x4e, 0xe3, 0x61, 0x6b, 0x74, 0x83, 0x10, 0x3d,
-	0x9e, 0x97, 0x70, 0xe6, 0x17, 0x5d, 0x7b, 0x7f, 0x7a, 0xb4, 0x8d, 0xf6, 0xe4, 0xf1, 0x24, 0x46,
-	0x27, 0x93, 0x18, 0xfd, 0x9e, 0xc4, 0xe8, 0xf3, 0x34, 0x0e, 0x4e, 0xa6, 0x71, 0xf0, 0x73, 0x1a,
-	0x07, 0x2f, 0x9f, 0x28, 0x6d, 0xd3, 0x2a, 0x21, 0xc2, 0x64, 0xd4, 0x8f, 0x96, 0x4e, 0x44, 0x5f,
-	0x19, 0x3a, 0x1e, 0x0c, 0x68, 0xe6, 0xb0, 0x50, 0xcf, 0x15, 0xd0, 0xe1, 0x4e, 0xff, 0xc2, 0xa2,
-	0x7f, 0x79, 0xa4, 0xec, 0x61, 0x21, 0x21, 0xe9, 0xb8, 0x89, 0xba, 0xfb, 0x27, 0x00, 0x00, 0xff,
-	0xff, 0xfd, 0x5f, 0xbe, 0x8a, 0x20, 0x04, 0x00, 0x00,
+	// 690 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0xec, 0x76, 0xed, 0x4e, 0xb7, 0x54, 0x52, 0x69, 0xd7, 0x54, 0xd3, 0x65, 0xbd,
+	0x2c, 0xc5, 0x26, 0xec, 0xaa, 0x14, 0x0a, 0x82, 0x2d, 0x8a, 0x16, 0x1b, 0xa8, 0xa9, 0x5e, 0x44,
+	0x28, 0xd9, 0xc9, 0x38, 0x3b, 0xb0, 0xc9, 0xc4, 0xcc, 0xec, 0x62, 0x6f, 0xa2, 0x17, 0x4f, 0xe2,
+	0xc1, 0x9b, 0x08, 0xbd, 0x0a, 0x1e, 0xfa, 0x09, 0x3c, 0xf7, 0xd8, 0xa3, 0x27, 0x91, 0xf6, 0xd0,
+	0xaf, 0x21, 0x99, 0xa4, 0x69, 0x37, 0x9b, 0x05, 0xc3, 0xf6, 0x96, 0x79, 0x79, 0xef, 0xff, 0x7e,
+	0xff, 0x24, 0x2f, 0x0f, 0xde, 0xa7, 0x6d, 0x64, 0xd8, 0xbe, 0xdf, 0xa5, 0xc8, 0x16, 0x94, 0x79,
+	0xdc, 0xa0, 0x9e, 0xc0, 0x01, 0xea, 0xd8, 0xd4, 0xdb, 0xb5, 0x11, 0x62, 0x3d, 0x4f, 0x70, 0xa3,
+	0xc3, 0xb8, 0x30, 0xfa, 0x4d, 0x43, 0xbc, 0xd3, 0xfd, 0x80, 0x09, 0xa6, 0xdc, 0xa1, 0x6d, 0xa4,
+	0x5f, 0x2c, 0xd3, 0x33, 0xca, 0xf4, 0xb0, 0x4c, 0xef, 0x37, 0xd5, 0xeb, 0x84, 0x11, 0x26, 0x0b,
+	0x8d, 0xf0, 0x2a, 0xd2, 0x50, 0x17, 0x10, 0xe3, 0x2e, 0xe3, 0x86, 0xcb, 0x49, 0xa8, 0xed, 0x72,
+	0x12, 0xdf, 0x58, 0xcd, 0xc5, 0x24, 0x9b, 0xc8, 0xc2, 0xfa, 0x67, 0x00, 0x67, 0x4d, 0x4e, 0x5e,
+	0xfa, 0x8e, 0x2d, 0xf0, 0xb6, 0x1d, 0xd8, 0x2e, 0x57, 0xe6, 0x61, 0x89, 0x53, 0xe2, 0xe1, 0xa0,
+	0x0a, 0x6a, 0xa0, 0x51, 0xb6, 0xe2, 0x93, 0x62, 0xc1, 0x92, 0x2f, 0x33, 0xaa, 0x57, 0x6a, 0xa0,
+	0x31, 0xdd, 0xba, 0xa7, 0xe7, 0xb1, 0xa4, 0x47, 0xea, 0x1b, 0xc5, 0xc3, 0x3f, 0x4b, 0x05, 0x2b,
+	0x56, 0x5a, 0x9b, 0xfd, 0xb4, 0xbf, 0x54, 0xf8, 0x70, 0x7a, 0xb0, 0x1c, 0x37, 0xa9, 0xdf, 0x80,
+	0x0b, 0x29, 0x1e, 0x0b, 0x73, 0x9f, 0x79, 0x1c, 0xd7, 0xbf, 0x01, 0xa8, 0x98, 0x9c, 0x98, 0xcc,
+	0xe9, 0x75, 0xf1, 0xf3, 0x1e, 0x0e, 0xf6, 0x76, 0xec, 0x37, 0x78, 0x24, 0xee, 0x6b, 0x38, 0x15,
+	0xe0, 0xb7, 0x3d, 0xcc, 0x45, 0x08, 0x3c, 0xd1, 0x98, 0x6e, 0xad, 0xe5, 0x03, 0x96, 0x2d, 0xac,
+	0x48, 0x22, 0xc6, 0x4e, 0x14, 0x87, 0xc1, 0x2d, 0xa8, 0x0e, 0xc3, 0x9d, 0xb1, 0x87, 0x90, 0x1d,
+	0x4c, 0x49, 0x47, 0x48, 0xc8, 0xa2, 0x15, 0x9f, 0x94, 0x9b, 0xb0, 0x1c, 0xc4, 0x39, 0x11, 0x65,
+	0xc5, 0x3a, 0x0f, 0xd4, 0x7f, 0x02, 0x38, 0x67, 0x72, 0xb2, 0x83, 0x85, 0x89, 0x39, 0xb7, 0x09,
+	0xde, 0x66, 0x5d, 0x8a, 0xf6, 0x46, 0x5a, 0xee, 0xc2, 0x19, 0x8e, 0x98, 0x8f, 0x9d, 0x5d, 0x5f,
+	0x26, 0xc6, 0x2f, 0x6a, 0x3d, 0x9f, 0xef, 0x1d, 0x29, 0x31, 0xd0, 0x31, 0xb6, 0x5f, 0x89, 0xd4,
+	0xa3, 0xd8, 0xf0, 0x23, 0xb8, 0x05, 0x17, 0x33, 0x68, 0x93, 0xf7, 0xf7, 0x11, 0xc0, 0x79, 0x93,
+	0x13, 0x0b, 0xbb, 0xac, 0x8f, 0xff, 0xcf, 0xd0, 0x6d, 0x38, 0x83, 0x98, 0xe7, 0x61, 0x14, 0x52,
+	0xef, 0x52, 0x47, 0x1a, 0x2a, 0x5b, 0x95, 0xf3, 0xe0, 0xa6, 0xa3, 0x2c, 0xc2, 0x32, 0xea, 0x52,
+	0xec, 0x89, 0x30, 0x61, 0x42, 0x26, 0x4c, 0x45, 0x81, 0x4d, 0x67, 0x18, 0xb2, 0x06, 0xb5, 0x6c,
+	0x88, 0x84, 0xf3, 0x30, 0xe2, 0x34, 0x29, 0x09, 0x6c, 0x81, 0x5f, 0xb0, 0x27, 0xe6, 0xf6, 0x7a,
+	0xf4, 0x8c, 0xc6, 0xe3, 0x5c, 0x80, 0x57, 0x7d, 0x16, 0x5c, 0xa0, 0x2c, 0x85, 0xc7, 0xb4, 0x81,
+	0xe2, 0xa0, 0x01, 0xd9, 0x12, 0x7b, 0x0e, 0x0e, 0xaa, 0x93, 0x71, 0x4b, 0x79, 0x52, 0x14, 0x58,
+	0xe4, 0x76, 0x57, 0x54, 0x4b, 0x35, 0xd0, 0xa8, 0x58, 0xf2, 0x7a, 0x94, 0xd9, 0x0c, 0x27, 0x67,
+	0x66, 0x5b, 0xbf, 0x4a, 0x70, 0xc2, 0xe4, 0x44, 0xf9, 0x0a, 0x60, 0x65, 0xe0, 0x2f, 0xf0, 0x20,
+	0xdf, 0x47, 0x93, 0x1a, 0x5a, 0xf5, 0xf1, 0x58, 0xe5, 0xc9, 0xdc, 0x7c, 0x0f, 0xff, 0x4f, 0xa9,
+	0x81, 0x7f, 0x98, 0x5b, 0x3a, 0xa5, 0xa0, 0x3e, 0x1d, 0x57, 0x21, 0xe1, 0xdb, 0x07, 0xf0, 0xda,
+	0xd0, 0x78, 0xae, 0xe7, 0x96, 0x4f, 0x4b, 0xa8, 0x9b, 0x63, 0x4b, 0x24, 0x88, 0x3f, 0x00, 0x9c,
+	0xcb, 0x9a, 0xb9, 0x47, 0xb9, 0x5b, 0x64, 0xa8, 0xa8, 0x5b, 0x97, 0xa1, 0x32, 0xc0, 0x9a, 0x35,
+	0x77, 0xf9, 0x59, 0x33, 0x54, 0xd4, 0xad, 0xcb, 0x50, 0x39, 0x63, 0x55, 0x27, 0xdf, 0x9f, 0x1e,
+	0x2c, 0x83, 0x0d, 0x7c, 0x78, 0xac, 0x81, 0xa3, 0x63, 0x0d, 0xfc, 0x3d, 0xd6, 0xc0, 0x97, 0x13,
+	0xad, 0x70, 0x74, 0xa2, 0x15, 0x7e, 0x9f, 0x68, 0x85, 0x57, 0xcf, 0x08, 0x15, 0x9d, 0x5e, 0x5b,
+	0x47, 0xcc, 0x35, 0xe2, 0xc5, 0x4d, 0xdb, 0x68, 0x85, 0x30, 0xa3, 0xdf, 0x6c, 0x1a, 0xae, 0xfc,
+	0xa2, 0x78, 0xb8, 0xb5, 0xb9, 0xd1, 0x5a, 0x5d, 0x39, 0x27, 0x59, 0x19, 0x5c, 0xd8, 0x62, 0xcf,
+	0xc7, 0xbc, 0x5d, 0x92, 0xfb, 0xfa, 0xee, 0xbf, 0x01, 0x00, 0x8d, 0x27, 0xbf, 0x8a, 0x7e, 0x08,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -265,6 +530,12 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// ModuleQuerySafe defines a rpc handler for MsgModuleQuerySafe.
 	ModuleQuerySafe(ctx context.Context, in *MsgModuleQuerySafe, opts ...grpc.CallOption) (*MsgModuleQuerySafeResponse, error)
+	// SetMessagePolicy defines a rpc handler for MsgSetMessagePolicy.
+	SetMessagePolicy(ctx context.Context, in *MsgSetMessagePolicy, opts ...grpc.CallOption) (*MsgSetMessagePolicyResponse, error)
+	// RemoveMessagePolicy defines a rpc handler for MsgRemoveMessagePolicy.
+	RemoveMessagePolicy(ctx context.Context, in *MsgRemoveMessagePolicy, opts ...grpc.CallOption) (*MsgRemoveMessagePolicyResponse, error)
+	// MigrateToGMPAccount defines a rpc handler for MsgMigrateToGMPAccount.
+	MigrateToGMPAccount(ctx context.Context, in *MsgMigrateToGMPAccount, opts ...grpc.CallOption) (*MsgMigrateToGMPAccountResponse, error)
 }
 
 type msgClient struct {
@@ -293,12 +564,45 @@ func (c *msgClient) ModuleQuerySafe(ctx context.Context, in *MsgModuleQuerySafe,
 	return out, nil
 }
 
+func (c *msgClient) SetMessagePolicy(ctx context.Context, in *MsgSetMessagePolicy, opts ...grpc.CallOption) (*MsgSetMessagePolicyResponse, error) {
+	out := new(MsgSetMessagePolicyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/SetMessagePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMessagePolicy(ctx context.Context, in *MsgRemoveMessagePolicy, opts ...grpc.CallOption) (*MsgRemoveMessagePolicyResponse, error) {
+	out := new(MsgRemoveMessagePolicyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/RemoveMessagePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateToGMPAccount(ctx context.Context, in *MsgMigrateToGMPAccount, opts ...grpc.CallOption) (*MsgMigrateToGMPAccountResponse, error) {
+	out := new(MsgMigrateToGMPAccountResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/MigrateToGMPAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// ModuleQuerySafe defines a rpc handler for MsgModuleQuerySafe.
 	ModuleQuerySafe(context.Context, *MsgModuleQuerySafe) (*MsgModuleQuerySafeResponse, error)
+	// SetMessagePolicy defines a rpc handler for MsgSetMessagePolicy.
+	SetMessagePolicy(context.Context, *MsgSetMessagePolicy) (*MsgSetMessagePolicyResponse, error)
+	// RemoveMessagePolicy defines a rpc handler for MsgRemoveMessagePolicy.
+	RemoveMessagePolicy(context.Context, *MsgRemoveMessagePolicy) (*MsgRemoveMessagePolicyResponse, error)
+	// MigrateToGMPAccount defines a rpc handler for MsgMigrateToGMPAccount.
+	MigrateToGMPAccount(context.Context, *MsgMigrateToGMPAccount) (*MsgMigrateToGMPAccountResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -311,6 +615,15 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) ModuleQuerySafe(ctx context.Context, req *MsgModuleQuerySafe) (*MsgModuleQuerySafeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleQuerySafe not implemented")
 }
+func (*UnimplementedMsgServer) SetMessagePolicy(ctx context.Context, req *MsgSetMessagePolicy) (*MsgSetMessagePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMessagePolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMessagePolicy(ctx context.Context, req *MsgRemoveMessagePolicy) (*MsgRemoveMessagePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMessagePolicy not implemented")
+}
+func (*UnimplementedMsgServer) MigrateToGMPAccount(ctx context.Context, req *MsgMigrateToGMPAccount) (*MsgMigrateToGMPAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateToGMPAccount not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -352,6 +665,60 @@ func _Msg_ModuleQuerySafe_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetMessagePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMessagePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMessagePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/SetMessagePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMessagePolicy(ctx, req.(*MsgSetMessagePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMessagePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMessagePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMessagePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/RemoveMessagePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMessagePolicy(ctx, req.(*MsgRemoveMessagePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateToGMPAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateToGMPAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateToGMPAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/MigrateToGMPAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateToGMPAccount(ctx, req.(*MsgMigrateToGMPAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.host.v1.Msg",
@@ -365,6 +732,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleQuerySafe",
 			Handler:    _Msg_ModuleQuerySafe_Handler,
 		},
+		{
+			MethodName: "SetMessagePolicy",
+			Handler:    _Msg_SetMessagePolicy_Handler,
+		},
+		{
+			MethodName: "RemoveMessagePolicy",
+			Handler:    _Msg_RemoveMessagePolicy_Handler,
+		},
+		{
+			MethodName: "MigrateToGMPAccount",
+			Handler:    _Msg_MigrateToGMPAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/host/v1/tx.proto",
@@ -514,59 +893,277 @@ func (m *MsgModuleQuerySafeResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetMessagePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetMessagePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModuleQuerySafe) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetMessagePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ScopedPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
-}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMessagePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMessagePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMessagePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMessagePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMessagePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMessagePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMessagePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMessagePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMessagePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateToGMPAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateToGMPAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateToGMPAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateToGMPAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateToGMPAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateToGMPAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModuleQuerySafe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
 
 func (m *MsgModuleQuerySafeResponse) Size() (n int) {
 	if m == nil {
@@ -583,16 +1180,659 @@ func (m *MsgModuleQuerySafeResponse) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgSetMessagePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ScopedPolicy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMessagePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMessagePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveMessagePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateToGMPAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateToGMPAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModuleQuerySafe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModuleQuerySafe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModuleQuerySafe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, QueryRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModuleQuerySafeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModuleQuerySafeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModuleQuerySafeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, make([]byte, postIndex-iNdEx))
+			copy(m.Responses[len(m.Responses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMessagePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMessagePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMessagePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopedPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopedPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMessagePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMessagePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMessagePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMessagePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -615,10 +1855,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMessagePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMessagePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -655,9 +1895,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -667,24 +1907,55 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -707,7 +1978,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMessagePolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -730,10 +2001,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMessagePolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMessagePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -757,7 +2028,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModuleQuerySafe) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateToGMPAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -780,10 +2051,10 @@ func (m *MsgModuleQuerySafe) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModuleQuerySafe: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateToGMPAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModuleQuerySafe: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateToGMPAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -820,9 +2091,9 @@ func (m *MsgModuleQuerySafe) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -832,81 +2103,93 @@ func (m *MsgModuleQuerySafe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, QueryRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgModuleQuerySafeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModuleQuerySafeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModuleQuerySafeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Height = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -916,14 +2199,27 @@ func (m *MsgModuleQuerySafeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -950,8 +2246,10 @@ func (m *MsgModuleQuerySafeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Responses = append(m.Responses, make([]byte, postIndex-iNdEx))
-			copy(m.Responses[len(m.Responses)-1], dAtA[iNdEx:postIndex])
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -974,6 +2272,56 @@ func (m *MsgModuleQuerySafeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMigrateToGMPAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateToGMPAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateToGMPAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/api"
+)
+
+var (
+	_ api.IBCModule             = (*IBCModule)(nil)
+	_ api.PacketDataUnmarshaler = (*IBCModule)(nil)
+)
+
+// IBCModule implements the IBC v2 application interface for the interchain accounts host submodule.
+// Interchain accounts are identified by the host client ID and the controller port ID of the packet,
+// in place of the connection ID and controller port ID used over IBC v1 channels.
+type IBCModule struct {
+	keeper *keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper
+func NewIBCModule(k *keeper.Keeper) *IBCModule {
+	return &IBCModule{
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface. A host chain does not send packets.
+func (*IBCModule) OnSendPacket(_ sdk.Context, _, _ string, _ uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot send a packet from the interchain accounts host port")
+}
+
+// OnRecvPacket implements the IBCModule interface. The transaction in the packet data is executed by the interchain
+// account of the controller port on the destination client, which is created on the first packet it receives.
+func (im *IBCModule) OnRecvPacket(ctx sdk.Context, _, destinationClient string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	txResponse, err := im.onRecvPacket(ctx, destinationClient, payload, relayer)
+
+	keeper.EmitPayloadAcknowledgementEvent(ctx, destinationClient, payload.SourcePort, sequence, err)
+
+	if err != nil {
+		im.keeper.Logger(ctx).Error("recv packet failed", "error", err, "sequence", sequence, "destination_client", destinationClient)
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	im.keeper.Logger(ctx).Info("successfully handled packet", "sequence", sequence, "destination_client", destinationClient)
+
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Success,
+		Acknowledgement: txResponse,
+	}
+}
+
+// onRecvPacket validates the payload and executes its packet data, returning the proto encoded sdk.TxMsgData
+// of the executed transaction.
+func (im *IBCModule) onRecvPacket(ctx sdk.Context, destinationClient string, payload channeltypesv2.Payload, relayer sdk.AccAddress) ([]byte, error) {
+	if !im.keeper.GetParams(ctx).HostEnabled {
+		return nil, types.ErrHostSubModuleDisabled
+	}
+
+	if payload.DestinationPort != icatypes.HostPortID {
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidHostPort, "expected %s, got %s", icatypes.HostPortID, payload.DestinationPort)
+	}
+	if !strings.HasPrefix(payload.SourcePort, icatypes.ControllerPortPrefix) {
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidControllerPort, "expected %s{owner-account-address}, got %s", icatypes.ControllerPortPrefix, payload.SourcePort)
+	}
+
+	data, err := icatypes.UnmarshalPayloadValue(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to validate %s packet data", icatypes.Version)
+	}
+
+	return im.keeper.OnRecvPayload(ctx, destinationClient, payload.SourcePort, data, payload.Encoding, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. A host chain does not send packets.
+func (*IBCModule) OnTimeoutPacket(_ sdk.Context, _, _ string, _ uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot cause a packet timeout on a host port, a host chain does not send packets")
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. A host chain does not send packets.
+func (*IBCModule) OnAcknowledgementPacket(_ sdk.Context, _, _ string, _ uint64, _ []byte, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive acknowledgement on a host port, a host chain does not send packets")
+}
+
+// UnmarshalPacketData unmarshals the interchain account packet data from the payload.
+// This method implements the PacketDataUnmarshaler interface required for callbacks middleware support.
+func (*IBCModule) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	data, err := icatypes.UnmarshalPayloadValue(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,326 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+	v2 "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/v2"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+type IBCModuleTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func TestIBCModuleTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(IBCModuleTestSuite))
+}
+
+func (s *IBCModuleTestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	s.path.SetupV2()
+}
+
+func (s *IBCModuleTestSuite) TestOnRecvPacket() {
+	var (
+		payload           channeltypesv2.Payload
+		packetData        icatypes.InterchainAccountPacketData
+		interchainAccAddr sdk.AccAddress
+	)
+
+	controllerPortID, err := icatypes.NewControllerPortID(s.chainA.SenderAccount.GetAddress().String())
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus channeltypesv2.PacketStatus
+	}{
+		{
+			"success",
+			func() {},
+			channeltypesv2.PacketStatus_Success,
+		},
+		{
+			"success: json encoding",
+			func() {
+				payload.Encoding = icatypes.PayloadEncodingJSON
+				packetData.Data = s.serializeMsgs(icatypes.EncodingProto3JSON, s.newMsgSend(interchainAccAddr))
+			},
+			channeltypesv2.PacketStatus_Success,
+		},
+		{
+			"success: abi encoding",
+			func() {
+				payload.Encoding = icatypes.PayloadEncodingABI
+			},
+			channeltypesv2.PacketStatus_Success,
+		},
+		{
+			"success: pre-funded base account is converted",
+			func() {
+				// the account is created on funding and must not prevent the creation of the interchain account
+				s.Require().NotNil(s.chainB.GetSimApp().AccountKeeper.GetAccount(s.chainB.GetContext(), interchainAccAddr))
+			},
+			channeltypesv2.PacketStatus_Success,
+		},
+		{
+			"failure: host submodule disabled",
+			func() {
+				s.chainB.GetSimApp().ICAHostKeeper.SetParams(s.chainB.GetContext(), types.NewParams(false, []string{}))
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"failure: invalid destination port",
+			func() {
+				payload.DestinationPort = "invalid"
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"failure: source port is not a controller port",
+			func() {
+				payload.SourcePort = "transfer"
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"failure: invalid version",
+			func() {
+				payload.Version = "ics20-1"
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"failure: invalid packet data",
+			func() {
+				payload.Value = []byte("invalid")
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"failure: unspecified packet data type",
+			func() {
+				packetData.Type = icatypes.UNSPECIFIED
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"failure: message not signed by the interchain account",
+			func() {
+				packetData.Data = s.serializeMsgs(icatypes.EncodingProtobuf, s.newMsgSend(s.chainB.SenderAccount.GetAddress()))
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"failure: interchain account of another client",
+			func() {
+				interchainAccAddr = icatypes.GenerateClientAddress(ibctesting.SecondClientID, controllerPortID)
+				packetData.Data = s.serializeMsgs(icatypes.EncodingProtobuf, s.newMsgSend(interchainAccAddr))
+			},
+			channeltypesv2.PacketStatus_Failure,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			interchainAccAddr = icatypes.GenerateClientAddress(s.path.EndpointB.ClientID, controllerPortID)
+			s.fundAccount(interchainAccAddr)
+
+			packetData = icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: s.serializeMsgs(icatypes.EncodingProtobuf, s.newMsgSend(interchainAccAddr)),
+			}
+			payload = channeltypesv2.NewPayload(controllerPortID, icatypes.HostPortID, icatypes.Version, icatypes.PayloadEncodingProtobuf, nil)
+
+			tc.malleate()
+
+			if payload.Value == nil {
+				payload.Value, err = icatypes.MarshalPayloadValue(packetData, icatypes.Version, payload.Encoding)
+				s.Require().NoError(err)
+			}
+
+			module := v2.NewIBCModule(s.chainB.GetSimApp().ICAHostKeeper)
+			result := module.OnRecvPacket(
+				s.chainB.GetContext(),
+				s.path.EndpointA.ClientID,
+				s.path.EndpointB.ClientID,
+				1,
+				payload,
+				s.chainB.SenderAccount.GetAddress(),
+			)
+
+			s.Require().Equal(tc.expStatus, result.Status)
+
+			if tc.expStatus == channeltypesv2.PacketStatus_Success {
+				_, found := s.chainB.GetSimApp().ICAHostKeeper.GetClientInterchainAccountAddress(s.chainB.GetContext(), s.path.EndpointB.ClientID, controllerPortID)
+				s.Require().True(found)
+
+				var txMsgData sdk.TxMsgData
+				s.Require().NoError(proto.Unmarshal(result.Acknowledgement, &txMsgData))
+				s.Require().Len(txMsgData.MsgResponses, 1)
+
+				acc := s.chainB.GetSimApp().AccountKeeper.GetAccount(s.chainB.GetContext(), interchainAccAddr)
+				interchainAccount, ok := acc.(*icatypes.InterchainAccount)
+				s.Require().True(ok)
+				s.Require().Equal(controllerPortID, interchainAccount.AccountOwner)
+			} else {
+				s.Require().Empty(result.Acknowledgement)
+			}
+		})
+	}
+}
+
+func (s *IBCModuleTestSuite) TestOnRecvPacketExistingAccount() {
+	controllerPortID, err := icatypes.NewControllerPortID(s.chainA.SenderAccount.GetAddress().String())
+	s.Require().NoError(err)
+
+	// an account which cannot be converted into an interchain account, e.g. a vesting account, may be created by anyone at
+	// the predictable address, it must not prevent the registration of the interchain account
+	predictableAddr := icatypes.GenerateClientAddress(s.path.EndpointB.ClientID, controllerPortID)
+	vestingAcc, err := vestingtypes.NewPermanentLockedAccount(authtypes.NewBaseAccountWithAddress(predictableAddr), sdk.NewCoins(ibctesting.TestCoin))
+	s.Require().NoError(err)
+	s.chainB.GetSimApp().AccountKeeper.SetAccount(s.chainB.GetContext(), s.chainB.GetSimApp().AccountKeeper.NewAccount(s.chainB.GetContext(), vestingAcc))
+
+	_, err = s.chainB.GetSimApp().ICAHostKeeper.InterchainAccount(s.chainB.GetContext(), &types.QueryInterchainAccountRequest{
+		Owner:    s.chainA.SenderAccount.GetAddress().String(),
+		ClientId: s.path.EndpointB.ClientID,
+	})
+	s.Require().Error(err)
+
+	// the interchain account is registered at the fallback address by a first packet without messages, whose transaction
+	// is JSON encoded as the protobuf encoding of an empty transaction is empty
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: s.serializeMsgs(icatypes.EncodingProto3JSON),
+	}
+	bz, err := icatypes.MarshalPayloadValue(packetData, icatypes.Version, icatypes.PayloadEncodingJSON)
+	s.Require().NoError(err)
+
+	module := v2.NewIBCModule(s.chainB.GetSimApp().ICAHostKeeper)
+	result := module.OnRecvPacket(
+		s.chainB.GetContext(),
+		s.path.EndpointA.ClientID,
+		s.path.EndpointB.ClientID,
+		1,
+		channeltypesv2.NewPayload(controllerPortID, icatypes.HostPortID, icatypes.Version, icatypes.PayloadEncodingJSON, bz),
+		s.chainB.SenderAccount.GetAddress(),
+	)
+	s.Require().Equal(channeltypesv2.PacketStatus_Success, result.Status)
+
+	expAddr := icatypes.GenerateClientFallbackAddress(s.chainB.GetContext(), s.path.EndpointB.ClientID, controllerPortID)
+	addr, found := s.chainB.GetSimApp().ICAHostKeeper.GetClientInterchainAccountAddress(s.chainB.GetContext(), s.path.EndpointB.ClientID, controllerPortID)
+	s.Require().True(found)
+	s.Require().Equal(expAddr.String(), addr)
+
+	acc := s.chainB.GetSimApp().AccountKeeper.GetAccount(s.chainB.GetContext(), expAddr)
+	interchainAccount, ok := acc.(*icatypes.InterchainAccount)
+	s.Require().True(ok)
+	s.Require().Equal(controllerPortID, interchainAccount.AccountOwner)
+
+	// the vesting account is left untouched
+	_, ok = s.chainB.GetSimApp().AccountKeeper.GetAccount(s.chainB.GetContext(), predictableAddr).(*vestingtypes.PermanentLockedAccount)
+	s.Require().True(ok)
+}
+
+func (s *IBCModuleTestSuite) TestOnSendAcknowledgementTimeoutPacket() {
+	module := v2.NewIBCModule(s.chainB.GetSimApp().ICAHostKeeper)
+	ctx := s.chainB.GetContext()
+
+	err := module.OnSendPacket(ctx, s.path.EndpointB.ClientID, s.path.EndpointA.ClientID, 1, channeltypesv2.Payload{}, s.chainB.SenderAccount.GetAddress())
+	s.Require().ErrorIs(err, icatypes.ErrInvalidChannelFlow)
+
+	err = module.OnAcknowledgementPacket(ctx, s.path.EndpointB.ClientID, s.path.EndpointA.ClientID, 1, []byte("ack"), channeltypesv2.Payload{}, s.chainB.SenderAccount.GetAddress())
+	s.Require().ErrorIs(err, icatypes.ErrInvalidChannelFlow)
+
+	err = module.OnTimeoutPacket(ctx, s.path.EndpointB.ClientID, s.path.EndpointA.ClientID, 1, channeltypesv2.Payload{}, s.chainB.SenderAccount.GetAddress())
+	s.Require().ErrorIs(err, icatypes.ErrInvalidChannelFlow)
+}
+
+// TestInterchainAccountsV2Flow sends a transaction from the controller port of the chainA sender to the host of chainB
+// and relays it over IBC v2.
+func (s *IBCModuleTestSuite) TestInterchainAccountsV2Flow() {
+	owner := s.chainA.SenderAccount.GetAddress().String()
+	controllerPortID, err := icatypes.NewControllerPortID(owner)
+	s.Require().NoError(err)
+
+	res, err := s.chainB.GetSimApp().ICAHostKeeper.InterchainAccount(s.chainB.GetContext(), &types.QueryInterchainAccountRequest{
+		Owner:    owner,
+		ClientId: s.path.EndpointB.ClientID,
+	})
+	s.Require().NoError(err)
+
+	interchainAccAddr, err := sdk.AccAddressFromBech32(res.Address)
+	s.Require().NoError(err)
+	s.fundAccount(interchainAccAddr)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: s.serializeMsgs(icatypes.EncodingProtobuf, s.newMsgSend(interchainAccAddr)),
+	}
+	bz, err := icatypes.MarshalPayloadValue(packetData, icatypes.Version, icatypes.PayloadEncodingProtobuf)
+	s.Require().NoError(err)
+
+	payload := channeltypesv2.NewPayload(controllerPortID, icatypes.HostPortID, icatypes.Version, icatypes.PayloadEncodingProtobuf, bz)
+	timeout := uint64(s.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+
+	packet, err := s.path.EndpointA.MsgSendPacket(timeout, payload)
+	s.Require().NoError(err)
+
+	err = s.path.EndpointA.RelayPacket(packet)
+	s.Require().NoError(err)
+
+	addr, found := s.chainB.GetSimApp().ICAHostKeeper.GetClientInterchainAccountAddress(s.chainB.GetContext(), s.path.EndpointB.ClientID, controllerPortID)
+	s.Require().True(found)
+	s.Require().Equal(res.Address, addr)
+
+	balance := s.chainB.GetSimApp().BankKeeper.GetBalance(s.chainB.GetContext(), interchainAccAddr, ibctesting.TestCoin.Denom)
+	s.Require().True(balance.IsZero())
+}
+
+func (s *IBCModuleTestSuite) fundAccount(addr sdk.AccAddress) {
+	err := s.chainB.GetSimApp().BankKeeper.SendCoins(s.chainB.GetContext(), s.chainB.SenderAccount.GetAddress(), addr, sdk.NewCoins(ibctesting.TestCoin))
+	s.Require().NoError(err)
+}
+
+func (s *IBCModuleTestSuite) newMsgSend(from sdk.AccAddress) *banktypes.MsgSend {
+	return &banktypes.MsgSend{
+		FromAddress: from.String(),
+		ToAddress:   s.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(ibctesting.TestCoin),
+	}
+}
+
+func (s *IBCModuleTestSuite) serializeMsgs(encoding string, msgs ...proto.Message) []byte {
+	bz, err := icatypes.SerializeCosmosTx(s.chainB.GetSimApp().AppCodec(), msgs, encoding)
+	s.Require().NoError(err)
+	return bz
+}
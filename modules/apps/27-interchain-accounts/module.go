@@ -134,6 +134,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 		hosttypes.RegisterMsgServer(cfg.MsgServer(), hostkeeper.NewMsgServerImpl(am.hostKeeper))
 		hosttypes.RegisterQueryServer(cfg.QueryServer(), am.hostKeeper)
 	}
+
+	controllerMigrator := controllerkeeper.NewMigrator(am.controllerKeeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 3, controllerMigrator.MigrateParams); err != nil {
+		panic(fmt.Errorf("failed to migrate interchainaccounts app from version 3 to 4 (controller params migration): %w", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the interchain accounts module.
@@ -172,7 +177,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 3 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // AppModuleSimulation functions
 
@@ -55,6 +55,21 @@ func GenerateAddress(ctx sdk.Context, connectionID, portID string) sdk.AccAddres
 	return sdkaddress.Derive(hostModuleAcc, buf)
 }
 
+// GenerateClientAddress returns a predictable sdk.AccAddress for an interchain account registered over IBC v2, derived using
+// the host module account address, the host client ID and the controller portID. The sdk.AccAddress returned is a sub-address
+// of the host module account, and differs from the addresses generated for the same controller portID on other clients.
+func GenerateClientAddress(clientID, portID string) sdk.AccAddress {
+	return sdkaddress.Module(ModuleName, []byte(hostAccountsKey), []byte(clientID), []byte(portID))
+}
+
+// GenerateClientFallbackAddress returns an sdk.AccAddress for an interchain account registered over IBC v2, derived using the
+// predictable address of GenerateClientAddress and block dependent information. It is used if an account which cannot be
+// converted into an interchain account has been created at the predictable address before the interchain account.
+func GenerateClientFallbackAddress(ctx sdk.Context, clientID, portID string) sdk.AccAddress {
+	header := ctx.BlockHeader()
+	return sdkaddress.Derive(GenerateClientAddress(clientID, portID), header.AppHash)
+}
+
 // ValidateAccountAddress performs basic validation of interchain account addresses, enforcing constraints
 // on address length and character set
 func ValidateAccountAddress(addr string) error {
@@ -53,6 +53,16 @@ func (s *TypesTestSuite) TestGenerateAddress() {
 	s.Require().NotEmpty(accAddr)
 }
 
+func (s *TypesTestSuite) TestGenerateClientAddress() {
+	addr := types.GenerateClientAddress("07-tendermint-0", "test-port-id")
+	s.Require().NotEmpty(addr)
+
+	// the address is predictable and unique per client and port
+	s.Require().Equal(addr, types.GenerateClientAddress("07-tendermint-0", "test-port-id"))
+	s.Require().NotEqual(addr, types.GenerateClientAddress("07-tendermint-1", "test-port-id"))
+	s.Require().NotEqual(addr, types.GenerateClientAddress("07-tendermint-0", "test-port-id-1"))
+}
+
 func (s *TypesTestSuite) TestValidateAccountAddress() {
 	testCases := []struct {
 		name     string
@@ -25,4 +25,7 @@ var (
 	ErrInvalidTimeoutTimestamp     = errorsmod.Register(ModuleName, 17, "timeout timestamp must be in the future")
 	ErrInvalidCodec                = errorsmod.Register(ModuleName, 18, "codec is not supported")
 	ErrInvalidAccountReopening     = errorsmod.Register(ModuleName, 19, "invalid account reopening")
+	ErrAbiEncoding                 = errorsmod.Register(ModuleName, 20, "abi encoding error")
+	ErrAbiDecoding                 = errorsmod.Register(ModuleName, 21, "abi decoding error")
+	ErrInvalidExecutionOptions     = errorsmod.Register(ModuleName, 22, "invalid execution options")
 )
@@ -4,10 +4,36 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket   = "ics27_packet"
+	EventTypeTxResult = "ics27_tx_result"
+
+	EventTypeOwnershipTransferred = "ics27_ownership_transferred"
+
+	EventTypeChannelReopenInit = "ics27_channel_reopen_init"
+	EventTypeChannelReopened   = "ics27_channel_reopened"
+	EventTypeTxQueued          = "ics27_tx_queued"
+	EventTypeQueuedTxSent      = "ics27_queued_tx_sent"
+
+	EventTypeAccountMigrated = "ics27_account_migrated"
 
 	AttributeKeyAckError            = "error"
 	AttributeKeyHostChannelID       = "host_channel_id"
 	AttributeKeyControllerChannelID = "controller_channel_id"
+	AttributeKeyHostClientID        = "host_client_id"
+	AttributeKeyControllerPortID    = "controller_port_id"
+	AttributeKeySequence            = "sequence"
 	AttributeKeyAckSuccess          = "success"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyConnectionID        = "connection_id"
+	AttributeKeyClientID            = "client_id"
+	AttributeKeyTxResultStatus      = "status"
+	AttributeKeyMsgResponses        = "msg_responses"
+	AttributeKeyOwners              = "owners"
+	AttributeKeyThreshold           = "threshold"
+	AttributeKeyClosedChannelID     = "closed_channel_id"
+	AttributeKeyQueuedTxs           = "queued_txs"
+	AttributeKeyAccountAddress      = "account_address"
+	AttributeKeyGMPClientID         = "gmp_client_id"
+	AttributeKeyGMPSender           = "gmp_sender"
+	AttributeKeyGMPSalt             = "gmp_salt"
 )
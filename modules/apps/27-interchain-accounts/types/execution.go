@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ibcexported "github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+const (
+	// ExecutionMemoKey is the key of the execution options in the memo of the packet data
+	ExecutionMemoKey = "execution"
+	// ExecutionGasLimitKey is the key of the gas limit in the execution options
+	ExecutionGasLimitKey = "gas_limit"
+	// ExecutionRelayerFeeKey is the key of the relayer fee in the execution options
+	ExecutionRelayerFeeKey = "relayer_fee"
+)
+
+// ExecutionOptions defines the options of the execution of a transaction on a host chain, provided by the controller
+// in the memo of the packet data in the following format:
+//
+//	{ "execution": { "gas_limit": "200000", "relayer_fee": "100stake" } }
+//
+// Both options are optional. The gas limit and relayer fee must be set as strings and not json numbers or objects.
+type ExecutionOptions struct {
+	// GasLimit is the gas limit of the execution of the transaction, 0 if unset.
+	GasLimit uint64
+	// RelayerFee is the fee paid by the account executing the transaction to the relayer of the packet.
+	RelayerFee sdk.Coins
+}
+
+// GetExecutionOptions returns the execution options of the provided packet data. Empty execution options are returned
+// if the memo does not contain the execution options.
+func GetExecutionOptions(packetData ibcexported.PacketDataProvider) (ExecutionOptions, error) {
+	executionData := packetData.GetCustomPacketData(ExecutionMemoKey)
+	if executionData == nil {
+		return ExecutionOptions{}, nil
+	}
+
+	executionOptions, ok := executionData.(map[string]any)
+	if !ok {
+		return ExecutionOptions{}, errorsmod.Wrapf(ErrInvalidExecutionOptions, "execution options must be a json object, got %T", executionData)
+	}
+
+	var options ExecutionOptions
+	if gasLimit, found := executionOptions[ExecutionGasLimitKey]; found {
+		gasLimitStr, ok := gasLimit.(string)
+		if !ok {
+			return ExecutionOptions{}, errorsmod.Wrapf(ErrInvalidExecutionOptions, "gas limit [%v] must be a string", gasLimit)
+		}
+
+		var err error
+		options.GasLimit, err = strconv.ParseUint(gasLimitStr, 10, 64)
+		if err != nil {
+			return ExecutionOptions{}, errorsmod.Wrapf(ErrInvalidExecutionOptions, "gas limit must be a valid uint64: %s", err)
+		}
+	}
+
+	if relayerFee, found := executionOptions[ExecutionRelayerFeeKey]; found {
+		relayerFeeStr, ok := relayerFee.(string)
+		if !ok {
+			return ExecutionOptions{}, errorsmod.Wrapf(ErrInvalidExecutionOptions, "relayer fee [%v] must be a string", relayerFee)
+		}
+
+		var err error
+		options.RelayerFee, err = sdk.ParseCoinsNormalized(relayerFeeStr)
+		if err != nil {
+			return ExecutionOptions{}, errorsmod.Wrapf(ErrInvalidExecutionOptions, "invalid relayer fee: %s", err)
+		}
+	}
+
+	return options, nil
+}
+
+// ExecutionGasLimit returns the gas limit of the execution of a transaction given the maximum execution gas of the
+// host chain and the gas limit requested by the controller, 0 meaning no limit. The requested gas limit is capped
+// at the maximum execution gas.
+func ExecutionGasLimit(maxExecutionGas, requestedGasLimit uint64) uint64 {
+	if requestedGasLimit == 0 || (maxExecutionGas != 0 && requestedGasLimit > maxExecutionGas) {
+		return maxExecutionGas
+	}
+
+	return requestedGasLimit
+}
+
+// ExecuteWithGasLimit runs the provided execution with a gas meter limited to the provided gas limit, returning an out
+// of gas error if the execution exceeds the limit. The gas consumed by the execution is consumed from the gas meter of
+// the provided context. A zero gas limit does not limit the execution.
+func ExecuteWithGasLimit(ctx sdk.Context, gasLimit uint64, execute func(sdk.Context) error) (err error) {
+	if gasLimit == 0 {
+		return execute(ctx)
+	}
+
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s; gas limit: %d", outOfGas.Descriptor, gasLimit)
+		}
+
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "interchain account transaction execution")
+	}()
+
+	return execute(ctx.WithGasMeter(gasMeter))
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func (s *TypesTestSuite) TestGetExecutionOptions() {
+	testCases := []struct {
+		name       string
+		memo       string
+		expOptions types.ExecutionOptions
+		expErr     error
+	}{
+		{
+			"success: empty memo",
+			"",
+			types.ExecutionOptions{},
+			nil,
+		},
+		{
+			"success: memo without execution options",
+			`{"src_callback": {"address": "cosmos1"}}`,
+			types.ExecutionOptions{},
+			nil,
+		},
+		{
+			"success: gas limit and relayer fee",
+			`{"execution": {"gas_limit": "200000", "relayer_fee": "100stake"}}`,
+			types.ExecutionOptions{GasLimit: 200_000, RelayerFee: sdk.NewCoins(ibctesting.TestCoin)},
+			nil,
+		},
+		{
+			"failure: execution options are not an object",
+			`{"execution": "200000"}`,
+			types.ExecutionOptions{},
+			types.ErrInvalidExecutionOptions,
+		},
+		{
+			"failure: gas limit is a json number",
+			`{"execution": {"gas_limit": 200000}}`,
+			types.ExecutionOptions{},
+			types.ErrInvalidExecutionOptions,
+		},
+		{
+			"failure: gas limit is not a uint64",
+			`{"execution": {"gas_limit": "-1"}}`,
+			types.ExecutionOptions{},
+			types.ErrInvalidExecutionOptions,
+		},
+		{
+			"failure: invalid relayer fee",
+			`{"execution": {"relayer_fee": "stake"}}`,
+			types.ExecutionOptions{},
+			types.ErrInvalidExecutionOptions,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			packetData := types.InterchainAccountPacketData{Type: types.EXECUTE_TX, Data: []byte("data"), Memo: tc.memo}
+
+			options, err := types.GetExecutionOptions(packetData)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expOptions, options)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *TypesTestSuite) TestExecutionGasLimit() {
+	s.Require().Equal(uint64(0), types.ExecutionGasLimit(0, 0))
+	s.Require().Equal(uint64(100), types.ExecutionGasLimit(0, 100))
+	s.Require().Equal(uint64(100), types.ExecutionGasLimit(100, 0))
+	s.Require().Equal(uint64(50), types.ExecutionGasLimit(100, 50))
+	s.Require().Equal(uint64(100), types.ExecutionGasLimit(100, 200))
+}
+
+func (s *TypesTestSuite) TestExecuteWithGasLimit() {
+	testCases := []struct {
+		name        string
+		gasLimit    uint64
+		consumedGas uint64
+		expErr      error
+	}{
+		{
+			"success: no gas limit",
+			0,
+			1_000,
+			nil,
+		},
+		{
+			"success: within gas limit",
+			1_000,
+			1_000,
+			nil,
+		},
+		{
+			"failure: out of gas",
+			1_000,
+			1_001,
+			sdkerrors.ErrOutOfGas,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.chainA.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+			err := types.ExecuteWithGasLimit(ctx, tc.gasLimit, func(ctx sdk.Context) error {
+				ctx.GasMeter().ConsumeGas(tc.consumedGas, "test")
+				return nil
+			})
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.consumedGas, ctx.GasMeter().GasConsumed())
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Equal(tc.gasLimit, ctx.GasMeter().GasConsumed())
+			}
+		})
+	}
+}
@@ -36,6 +36,9 @@ var (
 	// OwnerKeyPrefix defines the key prefix used to store interchain accounts
 	OwnerKeyPrefix = "owner"
 
+	// ClientOwnerKeyPrefix defines the key prefix used to store interchain accounts registered over IBC v2
+	ClientOwnerKeyPrefix = "clientOwner"
+
 	// PortKeyPrefix defines the key prefix used to store ports
 	PortKeyPrefix = "port"
 
@@ -59,6 +62,11 @@ func KeyOwnerAccount(portID, connectionID string) []byte {
 	return fmt.Appendf(nil, "%s/%s/%s", OwnerKeyPrefix, portID, connectionID)
 }
 
+// KeyClientOwnerAccount creates and returns a new key used for IBC v2 interchain account store operations
+func KeyClientOwnerAccount(portID, clientID string) []byte {
+	return fmt.Appendf(nil, "%s/%s/%s", ClientOwnerKeyPrefix, portID, clientID)
+}
+
 // KeyPort creates and returns a new key used for port store operations
 func KeyPort(portID string) []byte {
 	return fmt.Appendf(nil, "%s/%s", PortKeyPrefix, portID)
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+)
+
+const (
+	// PayloadEncodingJSON defines the JSON encoding of IBC v2 interchain account payloads
+	PayloadEncodingJSON = "application/json"
+	// PayloadEncodingProtobuf defines the protobuf encoding of IBC v2 interchain account payloads
+	PayloadEncodingProtobuf = "application/x-protobuf"
+	// PayloadEncodingABI defines the solidity ABI encoding of IBC v2 interchain account payloads
+	PayloadEncodingABI = "application/x-solidity-abi"
+)
+
+// MarshalPayloadValue marshals the provided InterchainAccountPacketData into the value of an IBC v2 payload
+// with the provided payload encoding.
+func MarshalPayloadValue(data InterchainAccountPacketData, version, encoding string) ([]byte, error) {
+	if version != Version {
+		return nil, errorsmod.Wrapf(ErrInvalidVersion, "expected %s, got %s", Version, version)
+	}
+
+	switch encoding {
+	case PayloadEncodingJSON:
+		return ModuleCdc.MarshalJSON(&data)
+	case PayloadEncodingProtobuf:
+		return proto.Marshal(&data)
+	case PayloadEncodingABI:
+		return EncodeABIInterchainAccountPacketData(data)
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidCodec, "invalid payload encoding provided, must be one of [%q, %q, %q], got %s", PayloadEncodingJSON, PayloadEncodingProtobuf, PayloadEncodingABI, encoding)
+	}
+}
+
+// UnmarshalPayloadValue unmarshals the value of an IBC v2 payload into an InterchainAccountPacketData using
+// the provided payload encoding. Protobuf and ABI encoded values must be canonically encoded.
+func UnmarshalPayloadValue(bz []byte, version, encoding string) (InterchainAccountPacketData, error) {
+	if version != Version {
+		return InterchainAccountPacketData{}, errorsmod.Wrapf(ErrInvalidVersion, "expected %s, got %s", Version, version)
+	}
+
+	var data InterchainAccountPacketData
+	switch encoding {
+	case PayloadEncodingJSON:
+		if err := data.UnmarshalJSON(bz); err != nil {
+			return InterchainAccountPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "failed to unmarshal json packet data: %s", err)
+		}
+
+		return data, nil
+	case PayloadEncodingProtobuf:
+		if err := unknownproto.RejectUnknownFieldsStrict(bz, &data, unknownproto.DefaultAnyResolver{}); err != nil {
+			return InterchainAccountPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "failed to unmarshal protobuf packet data: %s", err)
+		}
+
+		if err := proto.Unmarshal(bz, &data); err != nil {
+			return InterchainAccountPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "failed to unmarshal protobuf packet data: %s", err)
+		}
+	case PayloadEncodingABI:
+		var err error
+		data, err = DecodeABIInterchainAccountPacketData(bz)
+		if err != nil {
+			return InterchainAccountPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "failed to unmarshal ABI packet data: %s", err)
+		}
+	default:
+		return InterchainAccountPacketData{}, errorsmod.Wrapf(ErrInvalidCodec, "invalid payload encoding provided, must be one of [%q, %q, %q], got %s", PayloadEncodingJSON, PayloadEncodingProtobuf, PayloadEncodingABI, encoding)
+	}
+
+	reserializedBz, err := MarshalPayloadValue(data, version, encoding)
+	if err != nil {
+		return InterchainAccountPacketData{}, err
+	}
+	if !bytes.Equal(reserializedBz, bz) {
+		return InterchainAccountPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "packet data did not marshal to expected bytes: %X != %X", reserializedBz, bz)
+	}
+
+	return data, nil
+}
+
+// CosmosTxEncoding returns the encoding of the CosmosTx carried in the data of an IBC v2 interchain account
+// payload with the provided payload encoding. JSON payloads carry proto3 JSON encoded transactions, all other
+// payload encodings carry protobuf encoded transactions.
+func CosmosTxEncoding(payloadEncoding string) string {
+	if payloadEncoding == PayloadEncodingJSON {
+		return EncodingProto3JSON
+	}
+
+	return EncodingProtobuf
+}
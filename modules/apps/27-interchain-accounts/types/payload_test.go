@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+)
+
+func (s *TypesTestSuite) TestMarshalUnmarshalPayloadValue() {
+	packetData := types.InterchainAccountPacketData{
+		Type: types.EXECUTE_TX,
+		Data: []byte("data"),
+		Memo: "memo",
+	}
+
+	testCases := []struct {
+		name     string
+		encoding string
+		malleate func(bz []byte) []byte
+		expErr   error
+	}{
+		{
+			"success: json",
+			types.PayloadEncodingJSON,
+			func(bz []byte) []byte { return bz },
+			nil,
+		},
+		{
+			"success: protobuf",
+			types.PayloadEncodingProtobuf,
+			func(bz []byte) []byte { return bz },
+			nil,
+		},
+		{
+			"success: abi",
+			types.PayloadEncodingABI,
+			func(bz []byte) []byte { return bz },
+			nil,
+		},
+		{
+			"failure: invalid json",
+			types.PayloadEncodingJSON,
+			func([]byte) []byte { return []byte("invalid") },
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: protobuf with unknown field",
+			types.PayloadEncodingProtobuf,
+			func(bz []byte) []byte { return append(bz, 0x20, 0x01) },
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: abi with trailing bytes",
+			types.PayloadEncodingABI,
+			func(bz []byte) []byte { return append(bz, make([]byte, 32)...) },
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: invalid abi",
+			types.PayloadEncodingABI,
+			func([]byte) []byte { return []byte("invalid") },
+			ibcerrors.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			bz, err := types.MarshalPayloadValue(packetData, types.Version, tc.encoding)
+			s.Require().NoError(err)
+
+			data, err := types.UnmarshalPayloadValue(tc.malleate(bz), types.Version, tc.encoding)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(packetData, data)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+
+	_, err := types.MarshalPayloadValue(packetData, types.Version, types.EncodingProtobuf)
+	s.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	_, err = types.UnmarshalPayloadValue([]byte("data"), "ics20-1", types.PayloadEncodingJSON)
+	s.Require().ErrorIs(err, types.ErrInvalidVersion)
+}
+
+func (s *TypesTestSuite) TestCosmosTxEncoding() {
+	s.Require().Equal(types.EncodingProto3JSON, types.CosmosTxEncoding(types.PayloadEncodingJSON))
+	s.Require().Equal(types.EncodingProtobuf, types.CosmosTxEncoding(types.PayloadEncodingProtobuf))
+	s.Require().Equal(types.EncodingProtobuf, types.CosmosTxEncoding(types.PayloadEncodingABI))
+}
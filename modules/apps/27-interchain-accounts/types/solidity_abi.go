@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// getICS27PacketABI returns an abi.Arguments slice describing the Solidity types of the InterchainAccountPacketData.
+func getICS27PacketABI() abi.Arguments {
+	// Create the ABI types for each field.
+	// The Solidity types used are:
+	// - uint8 for Type.
+	// - bytes for Data.
+	// - string for Memo.
+	tupleType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{
+			Name: "type",
+			Type: "uint8",
+		},
+		{
+			Name: "data",
+			Type: "bytes",
+		},
+		{
+			Name: "memo",
+			Type: "string",
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// Create an ABI argument representing our struct as a single tuple argument.
+	arguments := abi.Arguments{
+		{
+			Type: tupleType,
+		},
+	}
+
+	return arguments
+}
+
+// DecodeABIInterchainAccountPacketData decodes a solidity ABI encoded interchain account packet and converts it into an
+// ibc-go InterchainAccountPacketData.
+func DecodeABIInterchainAccountPacketData(data []byte) (InterchainAccountPacketData, error) {
+	arguments := getICS27PacketABI()
+
+	packetDataI, err := arguments.Unpack(data)
+	if err != nil {
+		return InterchainAccountPacketData{}, errorsmod.Wrapf(ErrAbiDecoding, "failed to unpack data: %s", err)
+	}
+
+	packetData, ok := packetDataI[0].(struct {
+		Type uint8  `json:"type"`
+		Data []byte `json:"data"`
+		Memo string `json:"memo"`
+	})
+	if !ok {
+		return InterchainAccountPacketData{}, errorsmod.Wrapf(ErrAbiDecoding, "failed to parse packet data")
+	}
+
+	return InterchainAccountPacketData{
+		Type: Type(packetData.Type),
+		Data: packetData.Data,
+		Memo: packetData.Memo,
+	}, nil
+}
+
+// EncodeABIInterchainAccountPacketData encodes an InterchainAccountPacketData into a solidity ABI encoded byte array.
+func EncodeABIInterchainAccountPacketData(data InterchainAccountPacketData) ([]byte, error) {
+	if data.Type < 0 || data.Type > 255 {
+		return nil, errorsmod.Wrapf(ErrAbiEncoding, "packet data type %d cannot be encoded as uint8", data.Type)
+	}
+
+	packetData := struct {
+		Type uint8  `json:"type"`
+		Data []byte `json:"data"`
+		Memo string `json:"memo"`
+	}{
+		uint8(data.Type),
+		data.Data,
+		data.Memo,
+	}
+
+	arguments := getICS27PacketABI()
+	// Pack the values in the order defined in the ABI.
+	encodedData, err := arguments.Pack(packetData)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrAbiEncoding, "failed to pack data: %s", err)
+	}
+
+	return encodedData, nil
+}
@@ -230,6 +230,13 @@ func (s *CallbacksTestSuite) ExecuteGMPWithSenderAndEvents(memo, sender string)
 
 	err = s.path.EndpointA.MsgAcknowledgePacket(packet, ack)
 	s.Require().NoError(err)
+
+	// the result of the call is recorded on the source chain
+	expStatus := types.CALL_SUCCESS
+	if !ack.Success() {
+		expStatus = types.CALL_FAILURE
+	}
+	s.requireCallResult(packet, expStatus)
 }
 
 func (s *CallbacksTestSuite) ExecuteGMPWithSender(memo, sender string) {
@@ -304,6 +311,14 @@ func (s *CallbacksTestSuite) ExecuteGMPTimeout(memo string) {
 
 	err = s.path.EndpointA.MsgTimeoutPacket(packet)
 	s.Require().NoError(err)
+
+	s.requireCallResult(packet, types.CALL_TIMEOUT)
+}
+
+func (s *CallbacksTestSuite) requireCallResult(packet channeltypesv2.Packet, expStatus types.CallStatus) {
+	callResult, err := GetSimApp(s.chainA).GMPKeeper.GetCallResult(s.chainA.GetContext(), packet.SourceClient, packet.Sequence)
+	s.Require().NoError(err)
+	s.Require().Equal(expStatus, callResult.Status)
 }
 
 func (s *CallbacksTestSuite) fundGMPAccount(addr sdk.AccAddress) {
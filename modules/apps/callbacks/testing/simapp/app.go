@@ -102,6 +102,9 @@ import (
 	solomachine "github.com/cosmos/ibc-go/v11/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
 	"github.com/cosmos/ibc-go/v11/modules/light-clients/attestations"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/ethereum"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/quorum"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/zk"
 	ibcmock "github.com/cosmos/ibc-go/v11/testing/mock"
 )
 
@@ -369,6 +372,9 @@ func NewSimApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// Enable the migration of interchain accounts to 27-gmp accounts
+	app.ICAHostKeeper.WithGMPKeeper(app.GMPKeeper)
+
 	// Create IBC Router
 	ibcRouter := porttypes.NewRouter()
 	ibcRouterV2 := ibcapi.NewRouter()
@@ -474,6 +480,15 @@ func NewSimApp(
 	attestationsLightClientModule := attestations.NewLightClientModule(appCodec, storeProvider)
 	clientKeeper.AddRoute(attestations.ModuleName, &attestationsLightClientModule)
 
+	ethereumLightClientModule := ethereum.NewLightClientModule(appCodec, storeProvider)
+	clientKeeper.AddRoute(ethereum.ModuleName, &ethereumLightClientModule)
+
+	quorumLightClientModule := quorum.NewLightClientModule(appCodec, storeProvider, clientKeeper)
+	clientKeeper.AddRoute(quorum.ModuleName, &quorumLightClientModule)
+
+	zkLightClientModule := zk.NewLightClientModule(appCodec, storeProvider, authtypes.NewModuleAddress(govtypes.ModuleName).String(), zk.DefaultVerifiers())
+	clientKeeper.AddRoute(zk.ModuleName, &zkLightClientModule)
+
 	// ****  Module Options ****
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -505,6 +520,9 @@ func NewSimApp(
 		ibctm.NewAppModule(tmLightClientModule),
 		solomachine.NewAppModule(smLightClientModule),
 		attestations.NewAppModule(attestationsLightClientModule),
+		ethereum.NewAppModule(ethereumLightClientModule),
+		quorum.NewAppModule(quorumLightClientModule),
+		zk.NewAppModule(zkLightClientModule),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -10,8 +10,13 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
 )
 
-// BeginBlocker is used to perform IBC client upgrades
+// clientStatusTrackingLimit is the maximum number of clients whose status is tracked in each block
+const clientStatusTrackingLimit = 50
+
+// BeginBlocker is used to perform IBC client upgrades and to track changes in client statuses
 func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
+	k.TrackClientStatuses(ctx, clientStatusTrackingLimit)
+
 	plan, err := k.GetUpgradePlan(ctx)
 	if err == nil {
 		// Once we are at the last block this chain will commit, set the upgraded consensus state
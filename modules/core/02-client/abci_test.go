@@ -115,6 +115,34 @@ func (s *ClientTestSuite) TestBeginBlockerUpgradeEventsAbsence() {
 	s.requireContainsEvent(cacheCtx.EventManager().Events(), types.EventTypeUpgradeChain, false)
 }
 
+func (s *ClientTestSuite) TestBeginBlockerClientStatusChangeEvents() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupClients()
+
+	// the client status is tracked at the beginning of each committed block
+	cacheCtx, writeCache := s.chainA.GetContext().CacheContext()
+	client.BeginBlocker(cacheCtx, s.chainA.App.GetIBCKeeper().ClientKeeper)
+	writeCache()
+	s.requireContainsEvent(cacheCtx.EventManager().Events(), types.EventTypeClientStatusChange, false)
+
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	s.Require().True(ok)
+
+	clientState.FrozenHeight = types.NewHeight(0, 1)
+	path.EndpointA.SetClientState(clientState)
+
+	cacheCtx, writeCache = s.chainA.GetContext().CacheContext()
+	client.BeginBlocker(cacheCtx, s.chainA.App.GetIBCKeeper().ClientKeeper)
+	writeCache()
+	s.requireContainsEvent(cacheCtx.EventManager().Events(), types.EventTypeClientStatusChange, true)
+
+	// the status change is only reported once
+	cacheCtx, writeCache = s.chainA.GetContext().CacheContext()
+	client.BeginBlocker(cacheCtx, s.chainA.App.GetIBCKeeper().ClientKeeper)
+	writeCache()
+	s.requireContainsEvent(cacheCtx.EventManager().Events(), types.EventTypeClientStatusChange, false)
+}
+
 // requireContainsEvent verifies if an event of a specific type was emitted.
 func (s *ClientTestSuite) requireContainsEvent(events sdk.Events, eventType string, shouldContain bool) {
 	found := false
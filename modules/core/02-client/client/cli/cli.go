@@ -25,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryCounterpartyInfo(),
 		GetCmdQueryClientState(),
 		GetCmdQueryClientStatus(),
+		GetCmdQueryClientsNearingExpiry(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusStateHeights(),
 		GetCmdQueryConsensusState(),
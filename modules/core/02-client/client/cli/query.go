@@ -20,6 +20,7 @@ import (
 
 const (
 	flagLatestHeight = "latest-height"
+	flagWindow       = "window"
 )
 
 // GetCmdQueryClientStates defines the command to query all the light clients
@@ -232,6 +233,57 @@ func GetCmdQueryClientStatus() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryClientsNearingExpiry defines the command to query the active clients which expire within a given window
+func GetCmdQueryClientsNearingExpiry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nearing-expiry",
+		Short:   "Query the clients nearing expiry",
+		Long:    "Query the active 07-tendermint clients whose trusting period expires within a given window, along with the time remaining until expiry",
+		Example: fmt.Sprintf("%s query %s %s nearing-expiry --%s 24h", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagWindow),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			window, err := cmd.Flags().GetDuration(flagWindow)
+			if err != nil {
+				return err
+			}
+
+			flagSet, err := client.FlagSetWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(flagSet)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClientsNearingExpiryRequest{
+				Window:     window,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ClientsNearingExpiry(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(flagWindow, types.DefaultExpiryWindow, "window before expiry within which clients are returned")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "clients nearing expiry")
+
+	return cmd
+}
+
 // GetCmdQueryConsensusStates defines the command to query all the consensus states from a given
 // client state.
 func GetCmdQueryConsensusStates() *cobra.Command {
@@ -51,6 +51,10 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 		}
 	}
 
+	for _, prunedClient := range gs.PrunedClients {
+		k.SetPrunedClient(ctx, prunedClient.ClientId, prunedClient.PruneTime)
+	}
+
 	k.SetNextClientSequence(ctx, gs.NextClientSequence)
 }
 
@@ -71,5 +75,6 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 		// Warning: CreateLocalhost is deprecated
 		CreateLocalhost:    false,
 		NextClientSequence: k.GetNextClientSequence(ctx),
+		PrunedClients:      k.GetAllPrunedClients(ctx),
 	}
 }
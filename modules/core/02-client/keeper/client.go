@@ -23,6 +23,9 @@ func (k *Keeper) CreateClient(ctx sdk.Context, clientType string, clientState, c
 	}
 
 	clientID := k.GenerateClientIdentifier(ctx, clientType)
+	if k.IsClientPruned(ctx, clientID) {
+		return "", errorsmod.Wrapf(types.ErrClientPruned, "cannot create client with pruned identifier %s", clientID)
+	}
 
 	clientModule, err := k.Route(ctx, clientID)
 	if err != nil {
@@ -37,6 +40,8 @@ func (k *Keeper) CreateClient(ctx sdk.Context, clientType string, clientState, c
 		return "", errorsmod.Wrapf(types.ErrClientNotActive, "cannot create client (%s) with status %s", clientID, status)
 	}
 
+	k.trackClientStatus(ctx, clientID, clientType)
+
 	initialHeight := clientModule.LatestHeight(ctx, clientID)
 	k.Logger(ctx).Info("client created at height", "client-id", clientID, "height", initialHeight.String())
 
@@ -68,6 +73,8 @@ func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg export
 		k.Logger(ctx).Info("client frozen due to misbehaviour", "client-id", clientID)
 
 		clientType := types.MustParseClientIdentifier(clientID)
+		k.trackClientStatus(ctx, clientID, clientType)
+
 		defer telemetry.ReportUpdateClient(foundMisbehaviour, clientType, clientID)
 		emitSubmitMisbehaviourEvent(ctx, clientID, clientType)
 
@@ -79,6 +86,8 @@ func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg export
 	k.Logger(ctx).Info("client state updated", "client-id", clientID, "heights", consensusHeights)
 
 	clientType := types.MustParseClientIdentifier(clientID)
+	k.trackClientStatus(ctx, clientID, clientType)
+
 	defer telemetry.ReportUpdateClient(foundMisbehaviour, clientType, clientID)
 	emitUpdateClientEvent(ctx, clientID, clientType, consensusHeights, k.cdc, clientMsg)
 
@@ -109,6 +118,8 @@ func (k *Keeper) UpgradeClient(
 	k.Logger(ctx).Info("client state upgraded", "client-id", clientID, "height", latestHeight.String())
 
 	clientType := types.MustParseClientIdentifier(clientID)
+	k.trackClientStatus(ctx, clientID, clientType)
+
 	defer telemetry.ReportUpgradeClient(clientType, clientID)
 	emitUpgradeClientEvent(ctx, clientID, clientType, latestHeight)
 
@@ -121,6 +132,14 @@ func (k *Keeper) UpgradeClient(
 // as well as copying the necessary consensus states from the substitute to the subject client store.
 // The substitute must be Active and the subject must not be Active.
 func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClientID string) error {
+	if k.IsClientPruned(ctx, subjectClientID) {
+		return errorsmod.Wrapf(types.ErrClientPruned, "cannot recover pruned subject client (%s)", subjectClientID)
+	}
+
+	if k.IsClientPruned(ctx, substituteClientID) {
+		return errorsmod.Wrapf(types.ErrClientPruned, "cannot recover client using pruned substitute client (%s)", substituteClientID)
+	}
+
 	clientModule, err := k.Route(ctx, subjectClientID)
 	if err != nil {
 		return errorsmod.Wrap(types.ErrRouteNotFound, subjectClientID)
@@ -147,6 +166,8 @@ func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClien
 	k.Logger(ctx).Info("client recovered", "client-id", subjectClientID)
 
 	clientType := types.MustParseClientIdentifier(subjectClientID)
+	k.trackClientStatus(ctx, subjectClientID, clientType)
+
 	defer telemetry.ReportRecoverClient(clientType, subjectClientID)
 	emitRecoverClientEvent(ctx, subjectClientID, clientType)
 
@@ -56,6 +56,18 @@ func (s *KeeperTestSuite) TestCreateClient() {
 			exported.Tendermint,
 			errorsmod.Wrapf(clienttypes.ErrClientNotActive, "cannot create client (07-tendermint-0) with status Frozen"),
 		},
+		{
+			"failure: client identifier has been pruned",
+			func() {
+				tmClientState := ibctm.NewClientState(testChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+				clientState = s.chainA.App.AppCodec().MustMarshal(tmClientState)
+				consensusState = s.chainA.App.AppCodec().MustMarshal(s.consensusState)
+
+				s.chainA.App.GetIBCKeeper().ClientKeeper.SetPrunedClient(s.chainA.GetContext(), clienttypes.FormatClientIdentifier(exported.Tendermint, 0), s.chainA.GetContext().BlockTime())
+			},
+			exported.Tendermint,
+			clienttypes.ErrClientPruned,
+		},
 		{
 			"success: 06-solomachine client type supported",
 			func() {
@@ -296,6 +308,10 @@ func (s *KeeperTestSuite) TestUpdateClientTendermint() {
 
 				if tc.expFreeze {
 					s.Require().False(newClientState.FrozenHeight.IsZero(), "client did not freeze after conflicting header was submitted to UpdateClient")
+
+					// the status change is tracked when the misbehaviour is handled
+					clientStore := s.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(s.chainA.GetContext(), path.EndpointA.ClientID)
+					s.Require().Equal(exported.Frozen.String(), string(clientStore.Get(clienttypes.StatusKey())))
 				} else {
 					expConsensusState := &ibctm.ConsensusState{
 						Timestamp:          updateHeader.GetTime(),
@@ -592,6 +608,20 @@ func (s *KeeperTestSuite) TestRecoverClient() {
 			},
 			clienttypes.ErrClientNotActive,
 		},
+		{
+			"subject client has been pruned",
+			func() {
+				s.chainA.App.GetIBCKeeper().ClientKeeper.SetPrunedClient(s.chainA.GetContext(), subject, s.chainA.GetContext().BlockTime())
+			},
+			clienttypes.ErrClientPruned,
+		},
+		{
+			"substitute client has been pruned",
+			func() {
+				s.chainA.App.GetIBCKeeper().ClientKeeper.SetPrunedClient(s.chainA.GetContext(), substitute, s.chainA.GetContext().BlockTime())
+			},
+			clienttypes.ErrClientPruned,
+		},
 		{
 			"subject and substitute have equal latest height",
 			func() {
@@ -135,3 +135,35 @@ func EmitUpgradeChainEvent(ctx sdk.Context, height int64) {
 		),
 	})
 }
+
+// emitClientStatusChangeEvent emits a client status change event
+func emitClientStatusChangeEvent(ctx sdk.Context, clientID, clientType string, previousStatus, status exported.Status) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClientStatusChange,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+			sdk.NewAttribute(types.AttributeKeyPreviousStatus, previousStatus.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitPruneClientEvent emits a prune client event
+func emitPruneClientEvent(ctx sdk.Context, clientID, clientType string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePruneClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
 )
 
 var _ types.QueryServer = (*queryServer)(nil)
@@ -265,6 +266,76 @@ func (q *queryServer) ClientStatus(goCtx context.Context, req *types.QueryClient
 	}, nil
 }
 
+// ClientsNearingExpiry implements the Query/ClientsNearingExpiry gRPC method
+func (q *queryServer) ClientsNearingExpiry(goCtx context.Context, req *types.QueryClientsNearingExpiryRequest) (*types.QueryClientsNearingExpiryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Window < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "expiry window cannot be negative: %s", req.Window)
+	}
+
+	window := req.Window
+	if window == 0 {
+		window = types.DefaultExpiryWindow
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var clients []types.ClientExpiry
+	store := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), host.KeyClientStorePrefix)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// filter any metadata stored under client state key
+		keySplit := strings.Split(string(key), "/")
+		if keySplit[len(keySplit)-1] != "clientState" {
+			return false, nil
+		}
+
+		clientState, err := types.UnmarshalClientState(q.cdc, value)
+		if err != nil {
+			return false, err
+		}
+
+		// only 07-tendermint clients expire once their trusting period has elapsed
+		tmClientState, ok := clientState.(*ibctm.ClientState)
+		if !ok {
+			return false, nil
+		}
+
+		clientID := keySplit[1]
+		if q.GetClientStatus(ctx, clientID) != exported.Active {
+			return false, nil
+		}
+
+		consensusState, found := ibctm.GetConsensusState(q.ClientStore(ctx, clientID), q.cdc, tmClientState.LatestHeight)
+		if !found {
+			return false, nil
+		}
+
+		expiry := consensusState.Timestamp.Add(tmClientState.TrustingPeriod)
+		timeRemaining := expiry.Sub(ctx.BlockTime())
+		if timeRemaining > window {
+			return false, nil
+		}
+
+		if accumulate {
+			clients = append(clients, types.NewClientExpiry(clientID, expiry, timeRemaining))
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClientsNearingExpiryResponse{
+		Clients:    clients,
+		Pagination: pageRes,
+	}, nil
+}
+
 // ClientCreator implements the Query/ClientCreator gRPC method
 func (q *queryServer) ClientCreator(goCtx context.Context, req *types.QueryClientCreatorRequest) (*types.QueryClientCreatorResponse, error) {
 	if req == nil {
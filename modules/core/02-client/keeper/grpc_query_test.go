@@ -5,6 +5,7 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -595,6 +596,116 @@ func (s *KeeperTestSuite) TestQueryClientStatus() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryClientsNearingExpiry() {
+	var (
+		req        *types.QueryClientsNearingExpiryRequest
+		path       *ibctesting.Path
+		expiry     time.Time
+		blockTime  time.Time
+		expClients []types.ClientExpiry
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"negative window",
+			func() {
+				req.Window = -time.Hour
+			},
+			status.Error(codes.InvalidArgument, "expiry window cannot be negative: -1h0m0s"),
+		},
+		{
+			"success: client expires within the default window",
+			func() {
+				blockTime = expiry.Add(-types.DefaultExpiryWindow)
+				expClients = []types.ClientExpiry{types.NewClientExpiry(path.EndpointA.ClientID, expiry, types.DefaultExpiryWindow)}
+			},
+			nil,
+		},
+		{
+			"success: client expires within the requested window",
+			func() {
+				req.Window = ibctesting.TrustingPeriod
+				expClients = []types.ClientExpiry{types.NewClientExpiry(path.EndpointA.ClientID, expiry, expiry.Sub(blockTime))}
+			},
+			nil,
+		},
+		{
+			"success: client expires after the default window",
+			func() {
+				blockTime = expiry.Add(-types.DefaultExpiryWindow - time.Second)
+			},
+			nil,
+		},
+		{
+			"success: expired client is not returned",
+			func() {
+				req.Window = ibctesting.TrustingPeriod
+				blockTime = expiry
+			},
+			nil,
+		},
+		{
+			"success: frozen client is not returned",
+			func() {
+				req.Window = ibctesting.TrustingPeriod
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				s.Require().True(ok)
+
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			s.SetupTest() // reset
+			expClients = nil
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupClients()
+
+			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			s.Require().True(ok)
+
+			consensusState, ok := path.EndpointA.GetConsensusState(clientState.LatestHeight).(*ibctm.ConsensusState)
+			s.Require().True(ok)
+
+			expiry = consensusState.Timestamp.Add(clientState.TrustingPeriod)
+			blockTime = s.chainA.GetContext().BlockTime()
+			req = &types.QueryClientsNearingExpiryRequest{}
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext().WithBlockTime(blockTime)
+			queryServer := keeper.NewQueryServer(s.chainA.GetSimApp().IBCKeeper.ClientKeeper)
+			res, err := queryServer.ClientsNearingExpiry(ctx, req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().Equal(expClients, res.Clients)
+			} else {
+				s.Require().Error(err)
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryUpgradedClientState() {
 	var (
 		req            *types.QueryUpgradedClientStateRequest
@@ -3,9 +3,11 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
@@ -140,6 +142,63 @@ func (k *Keeper) DeleteClientCreator(ctx sdk.Context, clientID string) {
 	store.Delete(types.CreatorKey())
 }
 
+// getLastClientStatus returns the client status last tracked
+func (k *Keeper) getLastClientStatus(ctx sdk.Context, clientID string) (exported.Status, bool) {
+	store := k.ClientStore(ctx, clientID)
+	bz := store.Get(types.StatusKey())
+	if len(bz) == 0 {
+		return "", false
+	}
+	return exported.Status(bz), true
+}
+
+// getLastClientStatusTime returns the block time at which the last observed client status was first observed
+func (k *Keeper) getLastClientStatusTime(ctx sdk.Context, clientID string) (time.Time, bool) {
+	store := k.ClientStore(ctx, clientID)
+	bz := store.Get(types.StatusTimeKey())
+	if len(bz) == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(0, int64(sdk.BigEndianToUint64(bz))), true
+}
+
+// setLastClientStatus sets the tracked client status along with the current block time
+func (k *Keeper) setLastClientStatus(ctx sdk.Context, clientID string, status exported.Status) {
+	store := k.ClientStore(ctx, clientID)
+	store.Set(types.StatusKey(), []byte(status))
+	store.Set(types.StatusTimeKey(), sdk.Uint64ToBigEndian(uint64(ctx.BlockTime().UnixNano())))
+}
+
+// IsClientPruned returns true if the client with the given identifier has been pruned
+func (k *Keeper) IsClientPruned(ctx sdk.Context, clientID string) bool {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return store.Has(types.PrunedClientKey(clientID))
+}
+
+// SetPrunedClient tombstones the identifier of a client pruned at the given time
+func (k *Keeper) SetPrunedClient(ctx sdk.Context, clientID string, pruneTime time.Time) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.PrunedClientKey(clientID), sdk.Uint64ToBigEndian(uint64(pruneTime.UnixNano())))
+}
+
+// GetAllPrunedClients returns the tombstones of all pruned clients
+func (k *Keeper) GetAllPrunedClients(ctx sdk.Context) []types.PrunedClient {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	keyPrefix := []byte(types.KeyPrunedClientPrefix + "/")
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var prunedClients []types.PrunedClient
+	for ; iterator.Valid(); iterator.Next() {
+		prunedClients = append(prunedClients, types.PrunedClient{
+			ClientId:  string(bytes.TrimPrefix(iterator.Key(), keyPrefix)),
+			PruneTime: time.Unix(0, int64(sdk.BigEndianToUint64(iterator.Value()))).UTC(),
+		})
+	}
+
+	return prunedClients
+}
+
 // GetClientConsensusState gets the stored consensus state from a client at a given height.
 func (k *Keeper) GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool) {
 	store := k.ClientStore(ctx, clientID)
@@ -361,6 +420,35 @@ func (k *Keeper) VerifyNonMembership(ctx sdk.Context, clientID string, height ex
 	return clientModule.VerifyNonMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path)
 }
 
+// VerifyBatchMembership retrieves the light client module for the clientID and verifies a combined proof of the existence of many
+// key-value pairs at a specified height. If the light client module does not implement exported.BatchVerifier, a batch of a single
+// pair is verified with VerifyMembership and larger batches are rejected.
+func (k *Keeper) VerifyBatchMembership(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, paths []exported.Path, values [][]byte) error {
+	if len(paths) == 0 || len(paths) != len(values) {
+		return errorsmod.Wrapf(types.ErrFailedMembershipVerification, "batch must contain the same non-zero number of paths and values, got %d paths and %d values", len(paths), len(values))
+	}
+
+	clientModule, err := k.Route(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := clientModule.Status(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot call verify batch membership on client (%s) with status %s", clientID, status)
+	}
+
+	batchVerifier, ok := clientModule.(exported.BatchVerifier)
+	if !ok {
+		if len(paths) != 1 {
+			return errorsmod.Wrapf(types.ErrBatchVerificationNotSupported, "client (%s) cannot verify a batch of %d paths", clientID, len(paths))
+		}
+
+		return clientModule.VerifyMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, paths[0], values[0])
+	}
+
+	return batchVerifier.VerifyBatchMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, paths, values)
+}
+
 // GetUpgradePlan executes the upgrade keeper GetUpgradePlan function.
 func (k *Keeper) GetUpgradePlan(ctx sdk.Context) (upgradetypes.Plan, error) {
 	return k.upgradeKeeper.GetUpgradePlan(ctx)
@@ -405,6 +493,180 @@ func (k *Keeper) IterateClientStates(ctx sdk.Context, storePrefix []byte, cb fun
 	}
 }
 
+// TrackClientStatuses compares the status of at most limit clients with the status observed when they were last tracked,
+// continuing from the client following the last one tracked in the previous call and wrapping around once all clients
+// have been tracked. Status changes caused by client messages are tracked when the messages are handled, so this only
+// needs to catch up with changes caused by the passage of time or by parameter changes, such as clients expiring.
+func (k *Keeper) TrackClientStatuses(ctx sdk.Context, limit uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	clientsPrefix := fmt.Appendf(nil, "%s/", host.KeyClientStorePrefix)
+
+	start := clientsPrefix
+	if bz := store.Get([]byte(types.KeyClientStatusCursor)); len(bz) != 0 {
+		start = bz
+	}
+
+	for range limit {
+		clientID, found := k.nextClientID(ctx, start, storetypes.PrefixEndBytes(clientsPrefix))
+		if !found {
+			// all clients have been tracked, start again from the first client in the next call
+			store.Delete([]byte(types.KeyClientStatusCursor))
+			return
+		}
+
+		// the client store of a pruned client is empty, so every client found has a client type
+		if clientType, _, err := types.ParseClientIdentifier(clientID); err == nil {
+			k.trackClientStatus(ctx, clientID, clientType)
+		}
+
+		// seek past the remaining keys of the client store, such as its consensus states
+		start = storetypes.PrefixEndBytes(fmt.Appendf(nil, "%s/%s/", host.KeyClientStorePrefix, clientID))
+	}
+
+	store.Set([]byte(types.KeyClientStatusCursor), start)
+}
+
+// nextClientID returns the identifier of the first client with a key in the range [start, end) of the IBC store.
+func (k *Keeper) nextClientID(ctx sdk.Context, start, end []byte) (string, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := store.Iterator(start, end)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	if !iterator.Valid() {
+		return "", false
+	}
+
+	// client store keys have the format clients/{client-id}/{key}
+	keySplit := strings.Split(string(iterator.Key()), "/")
+	if len(keySplit) < 3 {
+		return "", false
+	}
+
+	return keySplit[1], true
+}
+
+// trackClientStatus compares the status of the client with the status observed when it was last tracked and emits a
+// client status change event if it differs. The status of a client tracked for the first time is recorded without
+// emitting an event. The block time at which a status is recorded is stored along with it.
+func (k *Keeper) trackClientStatus(ctx sdk.Context, clientID, clientType string) {
+	status := k.GetClientStatus(ctx, clientID)
+
+	previousStatus, found := k.getLastClientStatus(ctx, clientID)
+	if found && previousStatus == status {
+		return
+	}
+
+	k.setLastClientStatus(ctx, clientID, status)
+
+	if found {
+		emitClientStatusChangeEvent(ctx, clientID, clientType, previousStatus, status)
+	}
+}
+
+// PruneClient deletes the client store of a client which has been expired or frozen for at least the ClientPruneDelay param,
+// as tracked by the keeper, and tombstones its identifier so that it is never reused. The caller is responsible
+// for ensuring that the client is no longer referenced.
+func (k *Keeper) PruneClient(ctx sdk.Context, clientID string) error {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrClientNotFound, "client (%s) not found", clientID)
+	}
+
+	status := k.GetClientStatus(ctx, clientID)
+	if status != exported.Expired && status != exported.Frozen {
+		return errorsmod.Wrapf(types.ErrClientNotPrunable, "cannot prune client (%s) with status %s", clientID, status)
+	}
+
+	lastStatus, found := k.getLastClientStatus(ctx, clientID)
+	if !found || lastStatus != status {
+		return errorsmod.Wrapf(types.ErrClientNotPrunable, "status %s of client (%s) has not been tracked yet", status, clientID)
+	}
+
+	pruneDelay := k.GetParams(ctx).ClientPruneDelay
+	statusTime, found := k.getLastClientStatusTime(ctx, clientID)
+	if !found || ctx.BlockTime().Before(statusTime.Add(pruneDelay)) {
+		return errorsmod.Wrapf(types.ErrClientNotPrunable, "client (%s) must have status %s for at least %s", clientID, status, pruneDelay)
+	}
+
+	// collect the keys before deleting them, as the client store cannot be written to while iterating
+	clientStore := k.ClientStore(ctx, clientID)
+	iterator := clientStore.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		clientStore.Delete(key)
+	}
+
+	k.SetPrunedClient(ctx, clientID, ctx.BlockTime())
+
+	k.Logger(ctx).Info("client pruned", "client-id", clientID, "status", status)
+
+	emitPruneClientEvent(ctx, clientID, clientState.ClientType())
+
+	return nil
+}
+
+// PruneTendermintConsensusStates prunes the expired consensus states of 07-tendermint clients and enforces their
+// consensus state retention policies, starting from the client with the provided identifier, or the first client if it
+// is empty. Clients are processed in store order. At most limit clients are visited and at most limit consensus states
+// are pruned. The total number of consensus states pruned is returned along with the identifier of the client to
+// continue from in a subsequent call, which is empty once all clients have been processed.
+func (k *Keeper) PruneTendermintConsensusStates(ctx sdk.Context, startClientID string, limit uint64) (uint64, string) {
+	tmClientsPrefix := host.PrefixedClientStoreKey([]byte(exported.Tendermint))
+	end := storetypes.PrefixEndBytes(tmClientsPrefix)
+
+	start := tmClientsPrefix
+	if startClientID != "" {
+		start = fmt.Appendf(nil, "%s/%s/", host.KeyClientStorePrefix, startClientID)
+	}
+
+	var totalPruned uint64
+	for range limit {
+		// client stores are seeked one after the other, without iterating over their consensus states
+		clientID, found := k.nextClientID(ctx, start, end)
+		if !found {
+			return totalPruned, ""
+		}
+
+		if clientState, ok := k.getTendermintClientState(ctx, clientID); ok {
+			totalPruned += uint64(ibctm.PruneConsensusStates(ctx, k.ClientStore(ctx, clientID), k.cdc, clientState, int(limit-totalPruned)))
+			if totalPruned == limit {
+				// the client may have more consensus states to prune
+				return totalPruned, clientID
+			}
+		}
+
+		start = storetypes.PrefixEndBytes(fmt.Appendf(nil, "%s/%s/", host.KeyClientStorePrefix, clientID))
+	}
+
+	clientID, found := k.nextClientID(ctx, start, end)
+	if !found {
+		return totalPruned, ""
+	}
+
+	return totalPruned, clientID
+}
+
+// getTendermintClientState returns the client state of the client with the provided identifier if it is a 07-tendermint
+// client state
+func (k *Keeper) getTendermintClientState(ctx sdk.Context, clientID string) (*ibctm.ClientState, bool) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return nil, false
+	}
+
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	return tmClientState, ok
+}
+
 // GetAllClients returns all stored light client State objects.
 func (k *Keeper) GetAllClients(ctx sdk.Context) []exported.ClientState {
 	var states []exported.ClientState
@@ -30,6 +30,7 @@ import (
 	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v11/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
+	localhost "github.com/cosmos/ibc-go/v11/modules/light-clients/09-localhost"
 	ibctesting "github.com/cosmos/ibc-go/v11/testing"
 	"github.com/cosmos/ibc-go/v11/testing/simapp"
 )
@@ -350,6 +351,356 @@ func (s *KeeperTestSuite) TestIterateClientStates() {
 	}
 }
 
+func (s *KeeperTestSuite) TestPruneTendermintConsensusStates() {
+	paths := []*ibctesting.Path{
+		ibctesting.NewPath(s.chainA, s.chainB),
+		ibctesting.NewPath(s.chainA, s.chainB),
+		ibctesting.NewPath(s.chainA, s.chainB),
+	}
+
+	// create tendermint clients with three consensus states and a retention policy retaining a single consensus state
+	for _, path := range paths {
+		path.SetupClients()
+		for range 2 {
+			s.Require().NoError(path.EndpointA.UpdateClient())
+		}
+
+		clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+		s.Require().True(ok)
+		clientState.RetentionPolicy = ibctm.NewRetentionPolicy(1, 0, 0)
+		path.EndpointA.SetClientState(clientState)
+	}
+
+	// solo machine clients are not processed
+	ibctesting.NewSolomachine(s.T(), s.chainA.Codec, ibctesting.DefaultSolomachineClientID, "testing", 1).CreateClient(s.chainA)
+
+	clientKeeper := s.chainA.App.GetIBCKeeper().ClientKeeper
+
+	// the limit of consensus states is reached within the second client, which is continued from
+	totalPruned, nextClientID := clientKeeper.PruneTendermintConsensusStates(s.chainA.GetContext(), "", 3)
+	s.Require().Equal(uint64(3), totalPruned)
+	s.Require().Equal(paths[1].EndpointA.ClientID, nextClientID)
+
+	totalPruned, nextClientID = clientKeeper.PruneTendermintConsensusStates(s.chainA.GetContext(), nextClientID, 3)
+	s.Require().Equal(uint64(3), totalPruned)
+	s.Require().Equal(paths[2].EndpointA.ClientID, nextClientID)
+
+	totalPruned, nextClientID = clientKeeper.PruneTendermintConsensusStates(s.chainA.GetContext(), nextClientID, 3)
+	s.Require().Zero(totalPruned)
+	s.Require().Empty(nextClientID)
+
+	for _, path := range paths {
+		var numConsensusStates int
+		ibctm.IterateConsensusStateAscending(clientKeeper.ClientStore(s.chainA.GetContext(), path.EndpointA.ClientID), func(_ exported.Height) bool {
+			numConsensusStates++
+			return false
+		})
+		s.Require().Equal(1, numConsensusStates)
+	}
+
+	// pruning again is a no-op
+	totalPruned, nextClientID = clientKeeper.PruneTendermintConsensusStates(s.chainA.GetContext(), "", 10)
+	s.Require().Zero(totalPruned)
+	s.Require().Empty(nextClientID)
+
+	// at most limit clients are visited
+	totalPruned, nextClientID = clientKeeper.PruneTendermintConsensusStates(s.chainA.GetContext(), "", 1)
+	s.Require().Zero(totalPruned)
+	s.Require().Equal(paths[1].EndpointA.ClientID, nextClientID)
+}
+
+func (s *KeeperTestSuite) TestTrackClientStatuses() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expEvents sdk.Events
+	}{
+		{
+			"unchanged status",
+			func() {},
+			nil,
+		},
+		{
+			"client is frozen",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				s.Require().True(ok)
+
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeClientStatusChange,
+					sdk.NewAttribute(types.AttributeKeyClientID, ibctesting.FirstClientID),
+					sdk.NewAttribute(types.AttributeKeyClientType, exported.Tendermint),
+					sdk.NewAttribute(types.AttributeKeyPreviousStatus, exported.Active.String()),
+					sdk.NewAttribute(types.AttributeKeyStatus, exported.Frozen.String()),
+				),
+			},
+		},
+		{
+			"client is expired",
+			func() {
+				s.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+			},
+			sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeClientStatusChange,
+					sdk.NewAttribute(types.AttributeKeyClientID, ibctesting.FirstClientID),
+					sdk.NewAttribute(types.AttributeKeyClientType, exported.Tendermint),
+					sdk.NewAttribute(types.AttributeKeyPreviousStatus, exported.Active.String()),
+					sdk.NewAttribute(types.AttributeKeyStatus, exported.Expired.String()),
+				),
+			},
+		},
+		{
+			"client type is no longer allowed",
+			func() {
+				s.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(s.chainA.GetContext(), types.NewParams(exported.Solomachine))
+			},
+			sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeClientStatusChange,
+					sdk.NewAttribute(types.AttributeKeyClientID, ibctesting.FirstClientID),
+					sdk.NewAttribute(types.AttributeKeyClientType, exported.Tendermint),
+					sdk.NewAttribute(types.AttributeKeyPreviousStatus, exported.Active.String()),
+					sdk.NewAttribute(types.AttributeKeyStatus, exported.Unauthorized.String()),
+				),
+			},
+		},
+		{
+			"status of a newly tracked client is recorded without an event",
+			func() {
+				store := s.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(s.chainA.GetContext(), path.EndpointA.ClientID)
+				store.Delete(types.StatusKey())
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				s.Require().True(ok)
+
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupClients()
+
+			clientKeeper := s.chainA.App.GetIBCKeeper().ClientKeeper
+			clientKeeper.TrackClientStatuses(s.chainA.GetContext(), 10)
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			clientKeeper.TrackClientStatuses(ctx, 10)
+
+			var events sdk.Events
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeClientStatusChange {
+					events = append(events, event)
+				}
+			}
+			s.Require().Equal(tc.expEvents, events)
+
+			// the tracked status is updated so the change is only reported once
+			ctx = s.chainA.GetContext()
+			clientKeeper.TrackClientStatuses(ctx, 10)
+			s.Require().Empty(ctx.EventManager().Events())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTrackClientStatusesPagination() {
+	var paths []*ibctesting.Path
+	for range 3 {
+		path := ibctesting.NewPath(s.chainA, s.chainB)
+		path.SetupClients()
+		paths = append(paths, path)
+	}
+
+	// freeze the clients once they have all been created, so that the change is not tracked in a block in between
+	var clientIDs []string
+	for _, path := range paths {
+		clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+		s.Require().True(ok)
+
+		clientState.FrozenHeight = types.NewHeight(0, 1)
+		path.EndpointA.SetClientState(clientState)
+
+		clientIDs = append(clientIDs, path.EndpointA.ClientID)
+	}
+
+	clientKeeper := s.chainA.App.GetIBCKeeper().ClientKeeper
+
+	trackedClientIDs := func(ctx sdk.Context) []string {
+		var tracked []string
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeClientStatusChange {
+				continue
+			}
+
+			attribute, found := event.GetAttribute(types.AttributeKeyClientID)
+			s.Require().True(found)
+			tracked = append(tracked, attribute.Value)
+		}
+		return tracked
+	}
+
+	// the first call tracks the first two clients only
+	ctx := s.chainA.GetContext()
+	clientKeeper.TrackClientStatuses(ctx, 2)
+	s.Require().Equal(clientIDs[:2], trackedClientIDs(ctx))
+
+	// the second call continues from the third client and reaches the end of the clients
+	ctx = s.chainA.GetContext()
+	clientKeeper.TrackClientStatuses(ctx, 2)
+	s.Require().Equal(clientIDs[2:], trackedClientIDs(ctx))
+
+	// the third call starts again from the first client, whose status has already been tracked
+	ctx = s.chainA.GetContext()
+	clientKeeper.TrackClientStatuses(ctx, 2)
+	s.Require().Empty(trackedClientIDs(ctx))
+}
+
+func (s *KeeperTestSuite) TestPruneClient() {
+	var (
+		path      *ibctesting.Path
+		pruneTime time.Time
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: frozen client",
+			func() {},
+			nil,
+		},
+		{
+			"success: expired client",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				s.Require().True(ok)
+
+				clientState.FrozenHeight = types.ZeroHeight()
+				path.EndpointA.SetClientState(clientState)
+
+				consensusState, ok := path.EndpointA.GetConsensusState(clientState.LatestHeight).(*ibctm.ConsensusState)
+				s.Require().True(ok)
+
+				expiry := consensusState.Timestamp.Add(clientState.TrustingPeriod)
+				s.chainA.App.GetIBCKeeper().ClientKeeper.TrackClientStatuses(s.chainA.GetContext().WithBlockTime(expiry), 10)
+				pruneTime = expiry.Add(types.DefaultClientPruneDelay)
+			},
+			nil,
+		},
+		{
+			"failure: client not found",
+			func() {
+				path.EndpointA.ClientID = types.FormatClientIdentifier(exported.Tendermint, 10)
+			},
+			types.ErrClientNotFound,
+		},
+		{
+			"failure: client is active",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				s.Require().True(ok)
+
+				clientState.FrozenHeight = types.ZeroHeight()
+				path.EndpointA.SetClientState(clientState)
+			},
+			types.ErrClientNotPrunable,
+		},
+		{
+			"failure: client status has not been tracked",
+			func() {
+				store := s.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(s.chainA.GetContext(), path.EndpointA.ClientID)
+				store.Delete(types.StatusKey())
+			},
+			types.ErrClientNotPrunable,
+		},
+		{
+			"success: shorter client prune delay param",
+			func() {
+				params := s.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(s.chainA.GetContext())
+				params.ClientPruneDelay = time.Hour
+				s.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(s.chainA.GetContext(), params)
+
+				pruneTime = s.chainA.GetContext().BlockTime().Add(time.Hour)
+			},
+			nil,
+		},
+		{
+			"failure: client prune delay has not elapsed",
+			func() {
+				pruneTime = pruneTime.Add(-time.Second)
+			},
+			types.ErrClientNotPrunable,
+		},
+		{
+			"failure: longer client prune delay param has not elapsed",
+			func() {
+				params := s.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(s.chainA.GetContext())
+				params.ClientPruneDelay = types.DefaultClientPruneDelay + time.Hour
+				s.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(s.chainA.GetContext(), params)
+			},
+			types.ErrClientNotPrunable,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupClients()
+
+			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			s.Require().True(ok)
+
+			clientState.FrozenHeight = types.NewHeight(0, 1)
+			path.EndpointA.SetClientState(clientState)
+
+			clientKeeper := s.chainA.App.GetIBCKeeper().ClientKeeper
+			clientKeeper.TrackClientStatuses(s.chainA.GetContext(), 10)
+			pruneTime = s.chainA.GetContext().BlockTime().Add(types.DefaultClientPruneDelay)
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext().WithBlockTime(pruneTime)
+			err := clientKeeper.PruneClient(ctx, path.EndpointA.ClientID)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+
+				iterator := clientKeeper.ClientStore(ctx, path.EndpointA.ClientID).Iterator(nil, nil)
+				defer iterator.Close()
+				s.Require().False(iterator.Valid(), "client store is not empty")
+
+				s.Require().True(clientKeeper.IsClientPruned(ctx, path.EndpointA.ClientID))
+				s.Require().Equal(exported.Unknown, clientKeeper.GetClientStatus(ctx, path.EndpointA.ClientID))
+
+				expPrunedClient := types.PrunedClient{ClientId: path.EndpointA.ClientID, PruneTime: pruneTime.UTC()}
+				s.Require().Equal([]types.PrunedClient{expPrunedClient}, clientKeeper.GetAllPrunedClients(ctx))
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().False(clientKeeper.IsClientPruned(ctx, path.EndpointA.ClientID))
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetClientLatestHeight() {
 	var path *ibctesting.Path
 
@@ -544,6 +895,134 @@ func (s *KeeperTestSuite) TestVerifyMembership() {
 	}
 }
 
+func (s *KeeperTestSuite) TestVerifyBatchMembership() {
+	var (
+		path        *ibctesting.Path
+		clientID    string
+		proof       []byte
+		proofHeight exported.Height
+		paths       []exported.Path
+		values      [][]byte
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: single path with a client not implementing batch verification",
+			func() {
+				key := host.FullClientStateKey(path.EndpointA.ClientID)
+				merklePath, err := commitmenttypes.ApplyPrefix(s.chainA.GetPrefix(), commitmenttypes.NewMerklePath(key))
+				s.Require().NoError(err)
+
+				clientID = exported.LocalhostClientID
+				proof = localhost.SentinelProof
+				proofHeight = types.GetSelfHeight(s.chainA.GetContext())
+				paths = []exported.Path{merklePath}
+				values = [][]byte{s.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(s.chainA.GetContext(), path.EndpointA.ClientID).Get(host.ClientStateKey())}
+			},
+			nil,
+		},
+		{
+			"failure: many paths with a client not implementing batch verification",
+			func() {
+				clientID = exported.LocalhostClientID
+			},
+			types.ErrBatchVerificationNotSupported,
+		},
+		{
+			"failure: number of paths and values differ",
+			func() {
+				values = values[1:]
+			},
+			types.ErrFailedMembershipVerification,
+		},
+		{
+			"failure: empty batch",
+			func() {
+				paths = nil
+				values = nil
+			},
+			types.ErrFailedMembershipVerification,
+		},
+		{
+			"invalid client id",
+			func() {
+				clientID = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: client is frozen",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				s.Require().True(ok)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			types.ErrClientNotActive,
+		},
+		{
+			"failure: invalid batch proof",
+			func() {
+				values[1] = []byte("invalid value")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.Setup()
+			clientID = path.EndpointA.ClientID
+
+			// create default batch proof of the client state and connection of chainB, which passes
+			clientStateKey := host.FullClientStateKey(path.EndpointB.ClientID)
+			connectionKey := host.ConnectionKey(path.EndpointB.ConnectionID)
+
+			paths = nil
+			for _, key := range [][]byte{clientStateKey, connectionKey} {
+				merklePrefixPath, err := commitmenttypes.ApplyPrefix(s.chainB.GetPrefix(), commitmenttypes.NewMerklePath(key))
+				s.Require().NoError(err)
+				paths = append(paths, merklePrefixPath)
+			}
+
+			proof, proofHeight = s.chainB.QueryBatchProof([][]byte{clientStateKey, connectionKey})
+
+			clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			s.Require().True(ok)
+			clientStateBz, err := s.chainB.Codec.MarshalInterface(clientState)
+			s.Require().NoError(err)
+
+			connection := path.EndpointB.GetConnection()
+			connectionBz, err := s.chainB.Codec.Marshal(&connection)
+			s.Require().NoError(err)
+
+			values = [][]byte{clientStateBz, connectionBz}
+
+			tc.malleate()
+
+			err = s.chainA.App.GetIBCKeeper().ClientKeeper.VerifyBatchMembership(s.chainA.GetContext(), clientID, proofHeight, 0, 0, proof, paths, values)
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestVerifyNonMembership() {
 	var path *ibctesting.Path
 
@@ -5,8 +5,10 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	attestationsmigrations "github.com/cosmos/ibc-go/v11/modules/light-clients/attestations/migrations"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -28,3 +30,18 @@ func (m Migrator) MigrateToStatelessLocalhost(ctx sdk.Context) error {
 	clientStore.Delete(host.ClientStateKey())
 	return nil
 }
+
+// MigrateAttestationsLatestHeights migrates the latest heights of the attestations client states created before
+// revision number support, see the attestations migrations package.
+func (m Migrator) MigrateAttestationsLatestHeights(ctx sdk.Context) error {
+	_, err := attestationsmigrations.MigrateLatestHeights(ctx, m.keeper.cdc, m.keeper)
+	return err
+}
+
+// MigrateClientPruneDelay sets the ClientPruneDelay param, added after the allowed clients param, to its default value.
+func (m Migrator) MigrateClientPruneDelay(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.ClientPruneDelay = types.DefaultClientPruneDelay
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
@@ -4,8 +4,10 @@ package keeper_test
 
 import (
 	"github.com/cosmos/ibc-go/v11/modules/core/02-client/keeper"
+	"github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 	ibcexported "github.com/cosmos/ibc-go/v11/modules/core/exported"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/attestations"
 )
 
 func (s *KeeperTestSuite) TestMigrateToStatelessLocalhost() {
@@ -23,3 +25,38 @@ func (s *KeeperTestSuite) TestMigrateToStatelessLocalhost() {
 	s.Require().NoError(err)
 	s.Require().False(clientStore.Has(host.ClientStateKey()))
 }
+
+func (s *KeeperTestSuite) TestMigrateAttestationsLatestHeights() {
+	ctx := s.chainA.GetContext()
+	cdc := s.chainA.App.AppCodec()
+
+	clientID := types.FormatClientIdentifier(attestations.ModuleName, 0)
+	legacyClientState := &attestations.ClientState{
+		AttestorAddresses:  []string{"0x0000000000000000000000000000000000000001"},
+		MinRequiredSigs:    1,
+		LegacyLatestHeight: 100,
+	}
+	clientStore := s.chainA.GetSimApp().IBCKeeper.ClientKeeper.ClientStore(ctx, clientID)
+	clientStore.Set(host.ClientStateKey(), types.MustMarshalClientState(cdc, legacyClientState))
+
+	m := keeper.NewMigrator(s.chainA.GetSimApp().IBCKeeper.ClientKeeper)
+	err := m.MigrateAttestationsLatestHeights(ctx)
+	s.Require().NoError(err)
+
+	clientState, found := s.chainA.GetSimApp().IBCKeeper.ClientKeeper.GetClientState(ctx, clientID)
+	s.Require().True(found)
+	s.Require().Equal(types.NewHeight(0, 100), clientState.(*attestations.ClientState).LatestHeight)
+}
+
+func (s *KeeperTestSuite) TestMigrateClientPruneDelay() {
+	clientKeeper := s.chainA.GetSimApp().IBCKeeper.ClientKeeper
+
+	// params stored before the client prune delay was added
+	clientKeeper.SetParams(s.chainA.GetContext(), types.Params{AllowedClients: []string{ibcexported.Tendermint}})
+
+	m := keeper.NewMigrator(clientKeeper)
+	err := m.MigrateClientPruneDelay(s.chainA.GetContext())
+	s.Require().NoError(err)
+
+	s.Require().Equal(types.NewParams(ibcexported.Tendermint), clientKeeper.GetParams(s.chainA.GetContext()))
+}
@@ -8,15 +8,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -250,6 +254,9 @@ type Params struct {
 	// and interacted with. If a client type is removed from the allowed clients list, usage
 	// of this client will be disabled until it is added again to the list.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty"`
+	// client_prune_delay defines the minimum period a client must have been expired or frozen for
+	// before it can be pruned.
+	ClientPruneDelay time.Duration `protobuf:"bytes,2,opt,name=client_prune_delay,json=clientPruneDelay,proto3,stdduration" json:"client_prune_delay"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -292,6 +299,13 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetClientPruneDelay() time.Duration {
+	if m != nil {
+		return m.ClientPruneDelay
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
@@ -303,36 +317,39 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0xa5, 0x55, 0xd4, 0x5c, 0x50, 0x82, 0x4c, 0x2b, 0x99, 0x20, 0xd9, 0x51, 0x16, 0x32,
-	0xd0, 0x3b, 0x1c, 0x06, 0x7e, 0x08, 0x06, 0xd2, 0x85, 0x2e, 0x08, 0xb9, 0x03, 0x12, 0x12, 0x8a,
-	0xec, 0xf3, 0xd5, 0x39, 0xc9, 0xbe, 0xab, 0x7c, 0x67, 0xa3, 0xfc, 0x07, 0x4c, 0x08, 0x89, 0x85,
-	0xb1, 0x7f, 0x4e, 0xc7, 0x8e, 0x4c, 0x15, 0x4a, 0x36, 0xfe, 0x0a, 0xe4, 0xbb, 0x8b, 0x2a, 0x97,
-	0x82, 0xd8, 0xde, 0xbd, 0xef, 0x7b, 0xef, 0xfb, 0xbe, 0x97, 0x18, 0x06, 0x2c, 0x21, 0x98, 0x88,
-	0x92, 0x62, 0x92, 0x33, 0xca, 0x15, 0xae, 0x43, 0x5b, 0xa1, 0xb3, 0x52, 0x28, 0xe1, 0xba, 0x2c,
-	0x21, 0xa8, 0x21, 0x20, 0xdb, 0xae, 0xc3, 0xd1, 0x7e, 0x26, 0x32, 0xa1, 0x61, 0xdc, 0x54, 0x86,
-	0x39, 0xba, 0x9f, 0x09, 0x91, 0xe5, 0x14, 0xeb, 0x57, 0x52, 0x9d, 0xe2, 0x98, 0xaf, 0x0c, 0x34,
-	0x29, 0xe0, 0xc1, 0x71, 0x4a, 0xb9, 0x62, 0xa7, 0x8c, 0xa6, 0x47, 0x7a, 0xcf, 0x89, 0x8a, 0x15,
-	0x75, 0x1f, 0xc0, 0x9e, 0x59, 0xbb, 0x60, 0xa9, 0x07, 0xc6, 0x60, 0xda, 0x8b, 0xf6, 0x4c, 0xe3,
-	0x38, 0x75, 0x9f, 0xc2, 0x3b, 0x16, 0x94, 0x0d, 0xd9, 0xeb, 0x8c, 0xc1, 0xb4, 0x3f, 0xdb, 0x47,
-	0x46, 0x07, 0x6d, 0x75, 0xd0, 0x6b, 0xbe, 0x8a, 0xfa, 0xe4, 0x7a, 0xeb, 0xe4, 0x1b, 0x80, 0xde,
-	0x91, 0xe0, 0x92, 0x72, 0x59, 0x49, 0xdd, 0x7a, 0xcf, 0xd4, 0xf2, 0x0d, 0x65, 0xd9, 0x52, 0xb9,
-	0xcf, 0x60, 0x77, 0xa9, 0x2b, 0xad, 0xd7, 0x9f, 0x8d, 0xd0, 0x9f, 0x09, 0x91, 0xe1, 0xce, 0x77,
-	0x2f, 0xae, 0x02, 0x27, 0xb2, 0x7c, 0xf7, 0x15, 0x1c, 0x92, 0xed, 0xd6, 0xff, 0xb0, 0x34, 0x20,
-	0x2d, 0x0b, 0x8d, 0xab, 0x03, 0x93, 0xbd, 0xed, 0x4d, 0xfe, 0xfb, 0x0a, 0x1f, 0xe1, 0xdd, 0x1b,
-	0xaa, 0xd2, 0xeb, 0x8c, 0x77, 0xa6, 0xfd, 0xd9, 0xa3, 0xdb, 0x9c, 0xff, 0x2d, 0xb7, 0xcd, 0x32,
-	0x6c, 0x9b, 0x92, 0x93, 0x2f, 0x00, 0x76, 0xed, 0x65, 0x5e, 0xc2, 0x61, 0x49, 0x6b, 0x26, 0x99,
-	0xe0, 0x0b, 0x5e, 0x15, 0x09, 0x2d, 0xb5, 0x99, 0xdd, 0xf9, 0xbd, 0x5f, 0x57, 0xc1, 0x4d, 0x28,
-	0x1a, 0x6c, 0x1b, 0x6f, 0xf5, 0xbb, 0x35, 0x6d, 0x0f, 0xdc, 0xb9, 0x65, 0xda, 0x40, 0xd7, 0xd3,
-	0x46, 0xfb, 0xc5, 0xde, 0xe7, 0xf3, 0xc0, 0xf9, 0x7e, 0x1e, 0x38, 0x93, 0x10, 0x76, 0xdf, 0xc5,
-	0x65, 0x5c, 0x48, 0xf7, 0x21, 0x1c, 0xc6, 0x79, 0x2e, 0x3e, 0xd1, 0x74, 0x61, 0xf2, 0x49, 0x0f,
-	0x8c, 0x77, 0xa6, 0xbd, 0x68, 0x60, 0xdb, 0xe6, 0x9a, 0x72, 0x7e, 0x72, 0xb1, 0xf6, 0xc1, 0xe5,
-	0xda, 0x07, 0x3f, 0xd7, 0x3e, 0xf8, 0xba, 0xf1, 0x9d, 0xcb, 0x8d, 0xef, 0xfc, 0xd8, 0xf8, 0xce,
-	0x87, 0xe7, 0x19, 0x53, 0xcb, 0x2a, 0x41, 0x44, 0x14, 0x98, 0x08, 0x59, 0x08, 0x89, 0x59, 0x42,
-	0x0e, 0x33, 0x81, 0xeb, 0x30, 0xc4, 0x85, 0x48, 0xab, 0x9c, 0x4a, 0xf3, 0xff, 0x7f, 0x3c, 0x3b,
-	0xb4, 0x9f, 0x80, 0x5a, 0x9d, 0x51, 0x99, 0x74, 0xf5, 0x8f, 0xf9, 0xe4, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x11, 0xba, 0x0d, 0x18, 0x22, 0x03, 0x00, 0x00,
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xbf, 0x6f, 0x13, 0x31,
+	0x18, 0x3d, 0xa7, 0x55, 0x94, 0x38, 0x28, 0xa9, 0x8e, 0x56, 0x4a, 0x83, 0x74, 0x17, 0x65, 0x21,
+	0x03, 0xb5, 0x49, 0x18, 0xf8, 0x21, 0x18, 0x48, 0x3b, 0xd0, 0x05, 0x95, 0xeb, 0x80, 0x84, 0x84,
+	0xa2, 0x3b, 0x9f, 0x7b, 0xb1, 0x74, 0x67, 0x47, 0x67, 0x5f, 0x50, 0x76, 0x06, 0x26, 0x84, 0xc4,
+	0xd2, 0xb1, 0x7f, 0x4e, 0xc7, 0x8e, 0x4c, 0x05, 0x25, 0x1b, 0x7f, 0x05, 0x3a, 0xdb, 0x51, 0x95,
+	0xb4, 0x20, 0xb6, 0xef, 0xbe, 0xf7, 0x3d, 0xbf, 0xf7, 0x3d, 0x9f, 0xa1, 0xcf, 0x22, 0x82, 0x89,
+	0xc8, 0x29, 0x26, 0x29, 0xa3, 0x5c, 0xe1, 0xd9, 0xc0, 0x56, 0x68, 0x9a, 0x0b, 0x25, 0x5c, 0x97,
+	0x45, 0x04, 0x95, 0x03, 0xc8, 0xb6, 0x67, 0x83, 0xce, 0x6e, 0x22, 0x12, 0xa1, 0x61, 0x5c, 0x56,
+	0x66, 0xb2, 0xb3, 0x9f, 0x08, 0x91, 0xa4, 0x14, 0xeb, 0xaf, 0xa8, 0x38, 0xc3, 0x21, 0x9f, 0x5b,
+	0xc8, 0xdb, 0x84, 0xe2, 0x22, 0x0f, 0x15, 0x13, 0xdc, 0xe0, 0xbd, 0x0c, 0xee, 0x1d, 0xc7, 0x94,
+	0x2b, 0x76, 0xc6, 0x68, 0x7c, 0xa8, 0x75, 0x4e, 0x55, 0xa8, 0xa8, 0xfb, 0x00, 0xd6, 0x8d, 0xec,
+	0x98, 0xc5, 0x6d, 0xd0, 0x05, 0xfd, 0x7a, 0x50, 0x33, 0x8d, 0xe3, 0xd8, 0x7d, 0x0a, 0xef, 0x59,
+	0x50, 0x96, 0xc3, 0xed, 0x4a, 0x17, 0xf4, 0x1b, 0xc3, 0x5d, 0x64, 0xc4, 0xd0, 0x4a, 0x0c, 0xbd,
+	0xe6, 0xf3, 0xa0, 0x41, 0x6e, 0x4e, 0xed, 0x7d, 0x07, 0xb0, 0x7d, 0x28, 0xb8, 0xa4, 0x5c, 0x16,
+	0x52, 0xb7, 0xde, 0x33, 0x35, 0x79, 0x43, 0x59, 0x32, 0x51, 0xee, 0x33, 0x58, 0x9d, 0xe8, 0x4a,
+	0xeb, 0x35, 0x86, 0x1d, 0x74, 0x3b, 0x01, 0x64, 0x66, 0x47, 0xdb, 0x97, 0xd7, 0xbe, 0x13, 0xd8,
+	0x79, 0xf7, 0x15, 0x6c, 0x91, 0xd5, 0xa9, 0xff, 0x61, 0xa9, 0x49, 0xd6, 0x2c, 0x94, 0xae, 0xf6,
+	0xcc, 0xee, 0xeb, 0xde, 0xe4, 0xbf, 0x53, 0xf8, 0x08, 0x77, 0x36, 0x54, 0x65, 0xbb, 0xd2, 0xdd,
+	0xea, 0x37, 0x86, 0x8f, 0xee, 0x72, 0xfe, 0xb7, 0xbd, 0xed, 0x2e, 0xad, 0x75, 0x53, 0xb2, 0xf7,
+	0x15, 0xc0, 0xaa, 0x4d, 0xe6, 0x25, 0x6c, 0xe5, 0x74, 0xc6, 0x24, 0x13, 0x7c, 0xcc, 0x8b, 0x2c,
+	0xa2, 0xb9, 0x36, 0xb3, 0x3d, 0xba, 0xff, 0xfb, 0xda, 0xdf, 0x84, 0x82, 0xe6, 0xaa, 0xf1, 0x56,
+	0x7f, 0xaf, 0xb1, 0x6d, 0xc0, 0x95, 0x3b, 0xd8, 0x06, 0xba, 0x61, 0x1b, 0xed, 0x17, 0xb5, 0x2f,
+	0x17, 0xbe, 0x73, 0x7e, 0xe1, 0x3b, 0xbd, 0xcf, 0x00, 0x56, 0x4f, 0xc2, 0x3c, 0xcc, 0xa4, 0xfb,
+	0x10, 0xb6, 0xc2, 0x34, 0x15, 0x9f, 0x68, 0x3c, 0x36, 0x0b, 0xca, 0x36, 0xe8, 0x6e, 0xf5, 0xeb,
+	0x41, 0xd3, 0xb6, 0x4d, 0x9c, 0xd2, 0x7d, 0x07, 0x5d, 0x1b, 0xe0, 0x34, 0x2f, 0x38, 0x1d, 0xc7,
+	0x34, 0x0d, 0xe7, 0xf6, 0x72, 0xf6, 0x6f, 0x5d, 0xce, 0x91, 0xfd, 0x39, 0x47, 0xb5, 0x32, 0x92,
+	0xf3, 0x9f, 0x3e, 0x08, 0x76, 0x0c, 0xfd, 0xa4, 0x64, 0x1f, 0x95, 0xe4, 0xd1, 0xe9, 0xe5, 0xc2,
+	0x03, 0x57, 0x0b, 0x0f, 0xfc, 0x5a, 0x78, 0xe0, 0xdb, 0xd2, 0x73, 0xae, 0x96, 0x9e, 0xf3, 0x63,
+	0xe9, 0x39, 0x1f, 0x9e, 0x27, 0x4c, 0x4d, 0x8a, 0x08, 0x11, 0x91, 0x61, 0x22, 0x64, 0x26, 0x24,
+	0x66, 0x11, 0x39, 0x48, 0x04, 0x9e, 0x0d, 0x06, 0x38, 0x13, 0x71, 0x91, 0x52, 0x69, 0xde, 0xdc,
+	0xe3, 0xe1, 0x81, 0x7d, 0x76, 0x6a, 0x3e, 0xa5, 0x32, 0xaa, 0x6a, 0x0f, 0x4f, 0xfe, 0x0c, 0x00,
+	0xdf, 0x3f, 0xf4, 0x7d, 0x96, 0x03, 0x00, 0x00,
 }
 
 func (m *IdentifiedClientState) Marshal() (dAtA []byte, err error) {
@@ -519,6 +536,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ClientPruneDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientPruneDelay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintClient(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
 	if len(m.AllowedClients) > 0 {
 		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedClients[iNdEx])
@@ -620,6 +645,8 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientPruneDelay)
+	n += 1 + l + sovClient(uint64(l))
 	return n
 }
 
@@ -1131,6 +1158,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPruneDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ClientPruneDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
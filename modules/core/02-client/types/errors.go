@@ -40,4 +40,7 @@ var (
 	ErrFailedNonMembershipVerification        = errorsmod.Register(SubModuleName, 31, "non-membership verification failed")
 	ErrRouteNotFound                          = errorsmod.Register(SubModuleName, 32, "light client module route not found")
 	ErrClientTypeNotSupported                 = errorsmod.Register(SubModuleName, 33, "client type not supported")
+	ErrBatchVerificationNotSupported          = errorsmod.Register(SubModuleName, 34, "batch verification not supported")
+	ErrClientNotPrunable                      = errorsmod.Register(SubModuleName, 35, "light client cannot be pruned")
+	ErrClientPruned                           = errorsmod.Register(SubModuleName, 36, "light client has been pruned")
 )
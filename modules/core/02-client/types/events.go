@@ -18,6 +18,8 @@ const (
 	AttributeKeyUpgradeStore      = "upgrade_store"
 	AttributeKeyUpgradePlanHeight = "upgrade_plan_height"
 	AttributeKeyUpgradePlanTitle  = "title"
+	AttributeKeyPreviousStatus    = "previous_status"
+	AttributeKeyStatus            = "status"
 )
 
 // IBC client events vars
@@ -29,6 +31,8 @@ var (
 	EventTypeRecoverClient              = "recover_client"
 	EventTypeScheduleIBCSoftwareUpgrade = "schedule_ibc_software_upgrade"
 	EventTypeUpgradeChain               = "upgrade_chain"
+	EventTypeClientStatusChange         = "client_status_change"
+	EventTypePruneClient                = "prune_client"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
@@ -203,6 +203,27 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	prunedClients := make(map[string]bool)
+	for i, prunedClient := range gs.PrunedClients {
+		_, sequence, err := ParseClientIdentifier(prunedClient.ClientId)
+		if err != nil {
+			return fmt.Errorf("invalid pruned client identifier %s index %d: %w", prunedClient.ClientId, i, err)
+		}
+
+		if _, ok := validClients[prunedClient.ClientId]; ok {
+			return fmt.Errorf("pruned client %s is also a genesis client", prunedClient.ClientId)
+		}
+
+		if prunedClients[prunedClient.ClientId] {
+			return fmt.Errorf("duplicate pruned client %s", prunedClient.ClientId)
+		}
+		prunedClients[prunedClient.ClientId] = true
+
+		if sequence > maxSequence {
+			maxSequence = sequence
+		}
+	}
+
 	if maxSequence != 0 && maxSequence >= gs.NextClientSequence {
 		return fmt.Errorf("next client identifier sequence %d must be greater than the maximum sequence used in the provided client identifiers %d", gs.NextClientSequence, maxSequence)
 	}
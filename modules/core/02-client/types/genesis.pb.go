@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,6 +41,8 @@ type GenesisState struct {
 	CreateLocalhost bool `protobuf:"varint,5,opt,name=create_localhost,json=createLocalhost,proto3" json:"create_localhost,omitempty"` // Deprecated: Do not use.
 	// the sequence for the next generated client identifier
 	NextClientSequence uint64 `protobuf:"varint,6,opt,name=next_client_sequence,json=nextClientSequence,proto3" json:"next_client_sequence,omitempty"`
+	// the tombstones of the pruned clients
+	PrunedClients []PrunedClient `protobuf:"bytes,7,rep,name=pruned_clients,json=prunedClients,proto3" json:"pruned_clients"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +121,68 @@ func (m *GenesisState) GetNextClientSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPrunedClients() []PrunedClient {
+	if m != nil {
+		return m.PrunedClients
+	}
+	return nil
+}
+
+// PrunedClient defines the tombstone of a pruned client, whose identifier is never reused.
+type PrunedClient struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// block time at which the client was pruned
+	PruneTime time.Time `protobuf:"bytes,2,opt,name=prune_time,json=pruneTime,proto3,stdtime" json:"prune_time"`
+}
+
+func (m *PrunedClient) Reset()         { *m = PrunedClient{} }
+func (m *PrunedClient) String() string { return proto.CompactTextString(m) }
+func (*PrunedClient) ProtoMessage()    {}
+func (*PrunedClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcd0c0f1f2e6a91a, []int{1}
+}
+func (m *PrunedClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrunedClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrunedClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrunedClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrunedClient.Merge(m, src)
+}
+func (m *PrunedClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrunedClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrunedClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrunedClient proto.InternalMessageInfo
+
+func (m *PrunedClient) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *PrunedClient) GetPruneTime() time.Time {
+	if m != nil {
+		return m.PruneTime
+	}
+	return time.Time{}
+}
+
 // GenesisMetadata defines the genesis type for metadata that will be used
 // to export all client store keys that are not client or consensus states.
 type GenesisMetadata struct {
@@ -128,7 +196,7 @@ func (m *GenesisMetadata) Reset()         { *m = GenesisMetadata{} }
 func (m *GenesisMetadata) String() string { return proto.CompactTextString(m) }
 func (*GenesisMetadata) ProtoMessage()    {}
 func (*GenesisMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcd0c0f1f2e6a91a, []int{1}
+	return fileDescriptor_bcd0c0f1f2e6a91a, []int{2}
 }
 func (m *GenesisMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,7 +236,7 @@ func (m *IdentifiedGenesisMetadata) Reset()         { *m = IdentifiedGenesisMeta
 func (m *IdentifiedGenesisMetadata) String() string { return proto.CompactTextString(m) }
 func (*IdentifiedGenesisMetadata) ProtoMessage()    {}
 func (*IdentifiedGenesisMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcd0c0f1f2e6a91a, []int{2}
+	return fileDescriptor_bcd0c0f1f2e6a91a, []int{3}
 }
 func (m *IdentifiedGenesisMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,6 +281,7 @@ func (m *IdentifiedGenesisMetadata) GetClientMetadata() []GenesisMetadata {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.client.v1.GenesisState")
+	proto.RegisterType((*PrunedClient)(nil), "ibc.core.client.v1.PrunedClient")
 	proto.RegisterType((*GenesisMetadata)(nil), "ibc.core.client.v1.GenesisMetadata")
 	proto.RegisterType((*IdentifiedGenesisMetadata)(nil), "ibc.core.client.v1.IdentifiedGenesisMetadata")
 }
@@ -220,37 +289,43 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/genesis.proto", fileDescriptor_bcd0c0f1f2e6a91a) }
 
 var fileDescriptor_bcd0c0f1f2e6a91a = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0x25, 0x69, 0x68, 0xaf, 0x15, 0x09, 0xa7, 0x08, 0x99, 0x20, 0x39, 0x56, 0x58, 0xcc,
-	0x10, 0xbb, 0x09, 0x0b, 0xb0, 0x20, 0xa5, 0x03, 0xaa, 0x04, 0x12, 0x72, 0x37, 0x06, 0x2c, 0xfb,
-	0xfc, 0xe1, 0x5a, 0xd8, 0xbe, 0x90, 0x3b, 0x5b, 0xf4, 0x1f, 0x30, 0x30, 0xf0, 0x13, 0x98, 0xf9,
-	0x25, 0x1d, 0x3b, 0x32, 0x01, 0x4a, 0x24, 0x7e, 0x07, 0xf2, 0xdd, 0x99, 0x4a, 0xc1, 0xed, 0xf6,
-	0xe5, 0xbd, 0xf7, 0xbd, 0x97, 0x7b, 0xd6, 0x87, 0xed, 0x34, 0xa2, 0x1e, 0x65, 0x6b, 0xf0, 0x68,
-	0x96, 0x42, 0x21, 0xbc, 0x6a, 0xee, 0x25, 0x50, 0x00, 0x4f, 0xb9, 0xbb, 0x5a, 0x33, 0xc1, 0x08,
-	0x49, 0x23, 0xea, 0xd6, 0x0a, 0x57, 0x29, 0xdc, 0x6a, 0x3e, 0x9e, 0xb4, 0x6c, 0x69, 0x56, 0x2e,
-	0x8d, 0x47, 0x09, 0x4b, 0x98, 0x1c, 0xbd, 0x7a, 0x52, 0xe8, 0xf4, 0x4f, 0x17, 0x1f, 0xbd, 0x54,
-	0xe6, 0x67, 0x22, 0x14, 0x40, 0x28, 0xbe, 0xa3, 0xd6, 0xb8, 0x89, 0xec, 0xae, 0x73, 0xb8, 0x78,
-	0xec, 0xfe, 0x9f, 0xe6, 0x9e, 0xc6, 0x50, 0x88, 0xf4, 0x7d, 0x0a, 0xf1, 0x89, 0xc4, 0xe4, 0xee,
-	0xd2, 0xba, 0xfc, 0x39, 0x31, 0xbe, 0xff, 0x9a, 0xdc, 0x6f, 0xa5, 0xb9, 0xdf, 0x38, 0x93, 0x0a,
-	0xdf, 0xd3, 0x63, 0x40, 0x59, 0xc1, 0xa1, 0xe0, 0x25, 0x37, 0x3b, 0x37, 0xc7, 0x29, 0x97, 0x93,
-	0x46, 0xaa, 0xec, 0xae, 0xe3, 0x14, 0xcd, 0x77, 0x78, 0x7f, 0x48, 0x77, 0x70, 0xf2, 0x0e, 0x37,
-	0x58, 0x90, 0x83, 0x08, 0xe3, 0x50, 0x84, 0x66, 0x57, 0xc6, 0xce, 0x6e, 0x7f, 0xa5, 0xae, 0xe8,
-	0xb5, 0x5e, 0x5a, 0xf6, 0xea, 0x68, 0x7f, 0xa0, 0xcd, 0x1a, 0x98, 0x3c, 0xc5, 0xfd, 0x55, 0xb8,
-	0x0e, 0x73, 0x6e, 0xf6, 0x6c, 0xe4, 0x1c, 0x2e, 0xc6, 0x6d, 0xae, 0x6f, 0xa4, 0x42, 0x5b, 0x68,
-	0x3d, 0x99, 0xe1, 0x21, 0x5d, 0x43, 0x28, 0x20, 0xc8, 0x18, 0x0d, 0xb3, 0x73, 0xc6, 0x85, 0xb9,
-	0x67, 0x23, 0x67, 0x7f, 0xd9, 0x31, 0x91, 0x3f, 0x50, 0xdc, 0xab, 0x86, 0x22, 0xc7, 0x78, 0x54,
-	0xc0, 0x27, 0x11, 0x28, 0xd7, 0x80, 0xc3, 0xc7, 0x12, 0x0a, 0x0a, 0x66, 0xdf, 0x46, 0x4e, 0xcf,
-	0x27, 0x35, 0xa7, 0x9b, 0xd7, 0xcc, 0xf4, 0x05, 0x1e, 0xec, 0x3c, 0x82, 0x0c, 0x71, 0xf7, 0x03,
-	0x5c, 0x98, 0xc8, 0x46, 0xce, 0x91, 0x5f, 0x8f, 0x64, 0x84, 0xf7, 0xaa, 0x30, 0x2b, 0xc1, 0xec,
-	0x48, 0x4c, 0xfd, 0x78, 0xde, 0xfb, 0xfc, 0x6d, 0x62, 0x4c, 0xbf, 0x20, 0xfc, 0xe0, 0xc6, 0x42,
-	0xc8, 0x43, 0x7c, 0xa0, 0xff, 0x4b, 0x1a, 0x4b, 0xc7, 0x03, 0x7f, 0x5f, 0x01, 0xa7, 0x31, 0xf1,
-	0xb1, 0x6e, 0xea, 0xba, 0x75, 0xf5, 0xb1, 0x1f, 0xb5, 0xf5, 0xd3, 0xde, 0xf5, 0x5d, 0x25, 0xf8,
-	0x87, 0x9e, 0x5d, 0x6e, 0x2c, 0x74, 0xb5, 0xb1, 0xd0, 0xef, 0x8d, 0x85, 0xbe, 0x6e, 0x2d, 0xe3,
-	0x6a, 0x6b, 0x19, 0x3f, 0xb6, 0x96, 0xf1, 0xf6, 0x59, 0x92, 0x8a, 0xf3, 0x32, 0x72, 0x29, 0xcb,
-	0x3d, 0xca, 0x78, 0xce, 0xb8, 0x97, 0x46, 0x74, 0x96, 0x30, 0xaf, 0x9a, 0xcf, 0xbd, 0x9c, 0xc5,
-	0x65, 0x06, 0x5c, 0x9d, 0xca, 0xf1, 0x62, 0xa6, 0xaf, 0x45, 0x5c, 0xac, 0x80, 0x47, 0x7d, 0x79,
-	0x14, 0x4f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x7b, 0xfe, 0x4a, 0x83, 0x03, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xcd, 0xb5, 0x69, 0x9b, 0x5c, 0x43, 0x13, 0x4e, 0x11, 0x32, 0x41, 0xb2, 0xad, 0xb0, 0x84,
+	0x21, 0xbe, 0x26, 0x2c, 0xc0, 0x82, 0x94, 0x0c, 0xa8, 0x12, 0x95, 0x90, 0xcb, 0xc4, 0x80, 0x65,
+	0x9f, 0xaf, 0xae, 0x85, 0xed, 0x33, 0xb9, 0x73, 0x44, 0xff, 0x01, 0x03, 0x43, 0x7f, 0x02, 0x33,
+	0x3b, 0xff, 0xa1, 0x63, 0x47, 0x26, 0x8a, 0x92, 0x3f, 0x82, 0x7c, 0x77, 0xa6, 0x55, 0x70, 0xbb,
+	0x9d, 0xdf, 0x7b, 0xdf, 0x7b, 0x77, 0xdf, 0xf7, 0xc9, 0xd0, 0x8e, 0x03, 0x82, 0x09, 0x5b, 0x50,
+	0x4c, 0x92, 0x98, 0x66, 0x02, 0x2f, 0x27, 0x38, 0xa2, 0x19, 0xe5, 0x31, 0x77, 0xf2, 0x05, 0x13,
+	0x0c, 0xa1, 0x38, 0x20, 0x4e, 0xa9, 0x70, 0x94, 0xc2, 0x59, 0x4e, 0x06, 0x56, 0x4d, 0x95, 0x66,
+	0x65, 0xd1, 0xa0, 0x1f, 0xb1, 0x88, 0xc9, 0x23, 0x2e, 0x4f, 0x1a, 0xb5, 0x22, 0xc6, 0xa2, 0x84,
+	0x62, 0xf9, 0x15, 0x14, 0xa7, 0x58, 0xc4, 0x29, 0xe5, 0xc2, 0x4f, 0x73, 0x25, 0x18, 0xfe, 0x6c,
+	0xc2, 0xce, 0x1b, 0x95, 0x7e, 0x22, 0x7c, 0x41, 0x11, 0x81, 0x7b, 0xca, 0x97, 0x1b, 0xc0, 0xde,
+	0x1e, 0xed, 0x4f, 0x9f, 0x39, 0xff, 0x5f, 0xc7, 0x39, 0x0a, 0x69, 0x26, 0xe2, 0xd3, 0x98, 0x86,
+	0x73, 0x89, 0xc9, 0xda, 0x99, 0x79, 0xf9, 0xdb, 0x6a, 0xfc, 0xb8, 0xb6, 0x1e, 0xd5, 0xd2, 0xdc,
+	0xad, 0x9c, 0xd1, 0x12, 0x3e, 0xd4, 0x47, 0x8f, 0xb0, 0x8c, 0xd3, 0x8c, 0x17, 0xdc, 0xd8, 0xba,
+	0x3b, 0x4e, 0xb9, 0xcc, 0x2b, 0xa9, 0xb2, 0xbb, 0x89, 0x53, 0x34, 0xdf, 0xe0, 0xdd, 0x1e, 0xd9,
+	0xc0, 0xd1, 0x47, 0x58, 0x61, 0x5e, 0x4a, 0x85, 0x1f, 0xfa, 0xc2, 0x37, 0xb6, 0x65, 0xec, 0xf8,
+	0xfe, 0x57, 0xea, 0x16, 0x1d, 0xeb, 0xa2, 0x59, 0xb3, 0x8c, 0x76, 0xbb, 0xda, 0xac, 0x82, 0xd1,
+	0x0b, 0xb8, 0x9b, 0xfb, 0x0b, 0x3f, 0xe5, 0x46, 0xd3, 0x06, 0xa3, 0xfd, 0xe9, 0xa0, 0xce, 0xf5,
+	0x9d, 0x54, 0x68, 0x0b, 0xad, 0x47, 0x63, 0xd8, 0x23, 0x0b, 0xea, 0x0b, 0xea, 0x25, 0x8c, 0xf8,
+	0xc9, 0x19, 0xe3, 0xc2, 0xd8, 0xb1, 0xc1, 0xa8, 0x35, 0xdb, 0x32, 0x80, 0xdb, 0x55, 0xdc, 0xdb,
+	0x8a, 0x42, 0x87, 0xb0, 0x9f, 0xd1, 0x2f, 0xc2, 0x53, 0xae, 0x1e, 0xa7, 0x9f, 0x0b, 0x9a, 0x11,
+	0x6a, 0xec, 0xda, 0x60, 0xd4, 0x74, 0x51, 0xc9, 0xe9, 0xce, 0x6b, 0x06, 0x1d, 0xc3, 0x83, 0x7c,
+	0x51, 0x64, 0x34, 0xf4, 0xaa, 0xf1, 0xee, 0xc9, 0x87, 0xdb, 0xb5, 0x57, 0x94, 0x4a, 0xe5, 0xa0,
+	0x2f, 0xfa, 0x20, 0xbf, 0x85, 0xf1, 0x61, 0x0e, 0x3b, 0xb7, 0x45, 0xe8, 0x09, 0x6c, 0xeb, 0xbb,
+	0xc4, 0xa1, 0x01, 0x6c, 0x30, 0x6a, 0xbb, 0x2d, 0x05, 0x1c, 0x85, 0x68, 0x0e, 0xa1, 0xac, 0xf6,
+	0xca, 0xed, 0x33, 0xb6, 0x74, 0x6b, 0xd4, 0x6a, 0x3a, 0xd5, 0x6a, 0x3a, 0xef, 0xab, 0xd5, 0x9c,
+	0xb5, 0xca, 0xc4, 0x8b, 0x6b, 0x0b, 0xb8, 0x6d, 0x59, 0x57, 0x32, 0xc3, 0xd7, 0xb0, 0xbb, 0x31,
+	0x05, 0xd4, 0x83, 0xdb, 0x9f, 0xe8, 0xb9, 0x8c, 0xeb, 0xb8, 0xe5, 0x11, 0xf5, 0xe1, 0xce, 0xd2,
+	0x4f, 0x0a, 0x15, 0xd2, 0x71, 0xd5, 0xc7, 0xab, 0xe6, 0xd7, 0xef, 0x56, 0x63, 0xf8, 0x0d, 0xc0,
+	0xc7, 0x77, 0x4e, 0xf4, 0xfe, 0x07, 0xb8, 0x50, 0x8f, 0xfa, 0x66, 0x6d, 0xd4, 0xb6, 0x3e, 0xad,
+	0xeb, 0x5e, 0xfd, 0xb2, 0x1c, 0x28, 0xc1, 0x3f, 0xf4, 0xe4, 0x72, 0x65, 0x82, 0xab, 0x95, 0x09,
+	0xfe, 0xac, 0x4c, 0x70, 0xb1, 0x36, 0x1b, 0x57, 0x6b, 0xb3, 0xf1, 0x6b, 0x6d, 0x36, 0x3e, 0xbc,
+	0x8c, 0x62, 0x71, 0x56, 0x04, 0x0e, 0x61, 0x29, 0x26, 0x8c, 0xa7, 0x8c, 0xe3, 0x38, 0x20, 0xe3,
+	0x88, 0xe1, 0xe5, 0x64, 0x82, 0x53, 0x16, 0x16, 0x09, 0xe5, 0xea, 0x67, 0x70, 0x38, 0x1d, 0xeb,
+	0xff, 0x81, 0x38, 0xcf, 0x29, 0x0f, 0x76, 0x65, 0x37, 0x9f, 0xff, 0x1d, 0x00, 0xf6, 0x70, 0xf5,
+	0xa9, 0x65, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -273,6 +348,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PrunedClients) > 0 {
+		for iNdEx := len(m.PrunedClients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrunedClients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.NextClientSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextClientSequence))
 		i--
@@ -343,6 +432,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PrunedClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrunedClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrunedClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PruneTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -467,6 +594,27 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextClientSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextClientSequence))
 	}
+	if len(m.PrunedClients) > 0 {
+		for _, e := range m.PrunedClients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrunedClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTime)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -715,6 +863,155 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedClients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrunedClients = append(m.PrunedClients, PrunedClient{})
+			if err := m.PrunedClients[len(m.PrunedClients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrunedClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrunedClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrunedClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PruneTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -476,6 +476,65 @@ func (s *TypesTestSuite) TestValidateGenesis() {
 			),
 			expError: errors.New("consensus state in genesis has a client id 07-tendermint-0 that does not map to a genesis client"),
 		},
+		{
+			name: "valid pruned clients",
+			genState: func() types.GenesisState {
+				genState := types.DefaultGenesisState()
+				genState.PrunedClients = []types.PrunedClient{{ClientId: tmClientID0, PruneTime: now}, {ClientId: tmClientID1, PruneTime: now}}
+				genState.NextClientSequence = 2
+				return genState
+			}(),
+			expError: nil,
+		},
+		{
+			name: "invalid pruned client identifier",
+			genState: func() types.GenesisState {
+				genState := types.DefaultGenesisState()
+				genState.PrunedClients = []types.PrunedClient{{ClientId: ibctesting.InvalidID, PruneTime: now}}
+				return genState
+			}(),
+			expError: errors.New("invalid pruned client identifier IDisInvalid index 0"),
+		},
+		{
+			name: "duplicate pruned client",
+			genState: func() types.GenesisState {
+				genState := types.DefaultGenesisState()
+				genState.PrunedClients = []types.PrunedClient{{ClientId: tmClientID0, PruneTime: now}, {ClientId: tmClientID0, PruneTime: now}}
+				genState.NextClientSequence = 1
+				return genState
+			}(),
+			expError: errors.New("duplicate pruned client 07-tendermint-0"),
+		},
+		{
+			name: "pruned client is also a genesis client",
+			genState: func() types.GenesisState {
+				genState := types.NewGenesisState(
+					[]types.IdentifiedClientState{
+						types.NewIdentifiedClientState(
+							tmClientID0, ibctm.NewClientState(s.chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath),
+						),
+					},
+					nil,
+					nil,
+					types.NewParams(exported.Tendermint),
+					false,
+					1,
+				)
+				genState.PrunedClients = []types.PrunedClient{{ClientId: tmClientID0, PruneTime: now}}
+				return genState
+			}(),
+			expError: errors.New("pruned client 07-tendermint-0 is also a genesis client"),
+		},
+		{
+			name: "next sequence too small for pruned client",
+			genState: func() types.GenesisState {
+				genState := types.DefaultGenesisState()
+				genState.PrunedClients = []types.PrunedClient{{ClientId: tmClientID1, PruneTime: now}}
+				genState.NextClientSequence = 1
+				return genState
+			}(),
+			expError: errors.New("next client identifier sequence 1 must be greater than the maximum sequence used in the provided client identifiers 1"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -34,6 +34,18 @@ const (
 	// KeyCreator is the key for the creator in the client-specific store
 	KeyCreator = "creator"
 
+	// KeyStatus is the key for the last tracked status in the client-specific store
+	KeyStatus = "status"
+
+	// KeyStatusTime is the key for the block time at which the last observed status was first observed in the client-specific store
+	KeyStatusTime = "statusTime"
+
+	// KeyClientStatusCursor is the key under which the client store key to continue tracking client statuses from is stored
+	KeyClientStatusCursor = "clientStatusCursor"
+
+	// KeyPrunedClientPrefix is the key prefix under which the identifiers of pruned clients are tombstoned
+	KeyPrunedClientPrefix = "prunedClients"
+
 	// AllowAllClients is the value that if set in AllowedClients param
 	// would allow any wired up light client modules to be allowed
 	AllowAllClients = "*"
@@ -103,3 +115,18 @@ func MustParseClientIdentifier(clientID string) string {
 func CreatorKey() []byte {
 	return []byte(KeyCreator)
 }
+
+// StatusKey returns the key under which the last observed client status is stored in the client store
+func StatusKey() []byte {
+	return []byte(KeyStatus)
+}
+
+// StatusTimeKey returns the key under which the time the last observed client status was first observed is stored in the client store
+func StatusTimeKey() []byte {
+	return []byte(KeyStatusTime)
+}
+
+// PrunedClientKey returns the key under which the tombstone of a pruned client is stored
+func PrunedClientKey(clientID string) []byte {
+	return fmt.Appendf(nil, "%s/%s", KeyPrunedClientPrefix, clientID)
+}
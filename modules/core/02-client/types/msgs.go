@@ -22,6 +22,8 @@ var (
 	_ sdk.Msg = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.Msg = (*MsgRecoverClient)(nil)
 	_ sdk.Msg = (*MsgDeleteClientCreator)(nil)
+	_ sdk.Msg = (*MsgPruneConsensusStates)(nil)
+	_ sdk.Msg = (*MsgPruneClient)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgCreateClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateClient)(nil)
@@ -30,6 +32,8 @@ var (
 	_ sdk.HasValidateBasic = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.HasValidateBasic = (*MsgRecoverClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgDeleteClientCreator)(nil)
+	_ sdk.HasValidateBasic = (*MsgPruneConsensusStates)(nil)
+	_ sdk.HasValidateBasic = (*MsgPruneClient)(nil)
 
 	_ codectypes.UnpackInterfacesMessage = (*MsgCreateClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateClient)(nil)
@@ -319,3 +323,54 @@ func (msg *MsgDeleteClientCreator) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgPruneConsensusStates creates a new instance of MsgPruneConsensusStates.
+func NewMsgPruneConsensusStates(signer, startClientID string, limit uint64) *MsgPruneConsensusStates {
+	return &MsgPruneConsensusStates{
+		Signer:        signer,
+		StartClientId: startClientID,
+		Limit:         limit,
+	}
+}
+
+// ValidateBasic performs basic validation of the MsgPruneConsensusStates fields.
+func (msg *MsgPruneConsensusStates) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if msg.Limit == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "limit must be greater than zero")
+	}
+	if msg.StartClientId != "" {
+		clientType, _, err := ParseClientIdentifier(msg.StartClientId)
+		if err != nil {
+			return err
+		}
+		if clientType != exported.Tendermint {
+			return errorsmod.Wrapf(ErrInvalidClientType, "expected start client ID of type %s, got %s", exported.Tendermint, clientType)
+		}
+	}
+	return nil
+}
+
+// NewMsgPruneClient creates a new instance of MsgPruneClient.
+func NewMsgPruneClient(signer, clientID string) *MsgPruneClient {
+	return &MsgPruneClient{
+		Signer:   signer,
+		ClientId: clientID,
+	}
+}
+
+// ValidateBasic performs basic validation of the MsgPruneClient fields.
+func (msg *MsgPruneClient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+	if !IsValidClientID(msg.ClientId) {
+		return errorsmod.Wrapf(host.ErrInvalidID, "client ID %s must be in valid format: {string}-{number}", msg.ClientId)
+	}
+	return nil
+}
@@ -836,3 +836,97 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgPruneConsensusStatesValidateBasic tests ValidateBasic for MsgPruneConsensusStates
+func (s *TypesTestSuite) TestMsgPruneConsensusStatesValidateBasic() {
+	signer := s.chainA.App.GetIBCKeeper().GetAuthority()
+	testCases := []struct {
+		name   string
+		msg    *types.MsgPruneConsensusStates
+		expErr error
+	}{
+		{
+			"success: empty start client ID",
+			types.NewMsgPruneConsensusStates(signer, "", 10),
+			nil,
+		},
+		{
+			"success: tendermint start client ID",
+			types.NewMsgPruneConsensusStates(signer, ibctesting.FirstClientID, 10),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgPruneConsensusStates("invalid", "", 10),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: zero limit",
+			types.NewMsgPruneConsensusStates(signer, "", 0),
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: invalid start client ID",
+			types.NewMsgPruneConsensusStates(signer, ibctesting.InvalidID, 10),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: start client ID is not a tendermint client",
+			types.NewMsgPruneConsensusStates(signer, ibctesting.DefaultSolomachineClientID, 10),
+			types.ErrInvalidClientType,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestMsgPruneClientValidateBasic tests ValidateBasic for MsgPruneClient
+func (s *TypesTestSuite) TestMsgPruneClientValidateBasic() {
+	signer := s.chainA.App.GetIBCKeeper().GetAuthority()
+	testCases := []struct {
+		name   string
+		msg    *types.MsgPruneClient
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgPruneClient(signer, ibctesting.FirstClientID),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgPruneClient("invalid", ibctesting.FirstClientID),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: empty client ID",
+			types.NewMsgPruneClient(signer, ""),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid client ID",
+			types.NewMsgPruneClient(signer, ibctesting.InvalidID),
+			host.ErrInvalidID,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
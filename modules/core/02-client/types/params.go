@@ -3,9 +3,11 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 )
 
 // Maximum length of the allowed clients list
@@ -15,10 +17,14 @@ const MaxAllowedClientsLength = 200
 // By default it allows all client types.
 var DefaultAllowedClients = []string{AllowAllClients}
 
-// NewParams creates a new parameter configuration for the ibc client module
+// DefaultClientPruneDelay is the default value for the ClientPruneDelay parameter.
+const DefaultClientPruneDelay = 30 * 24 * time.Hour
+
+// NewParams creates a new parameter configuration for the ibc client module with the default client prune delay
 func NewParams(allowedClients ...string) Params {
 	return Params{
-		AllowedClients: allowedClients,
+		AllowedClients:   allowedClients,
+		ClientPruneDelay: DefaultClientPruneDelay,
 	}
 }
 
@@ -29,6 +35,10 @@ func DefaultParams() Params {
 
 // Validate all ibc-client module parameters
 func (p Params) Validate() error {
+	if p.ClientPruneDelay < 0 {
+		return errors.New("client prune delay cannot be negative")
+	}
+
 	return validateClients(p.AllowedClients)
 }
 
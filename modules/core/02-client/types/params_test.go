@@ -5,6 +5,7 @@ package types_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -43,6 +44,8 @@ func TestValidateParams(t *testing.T) {
 		{"blank client", types.NewParams(" "), errors.New("client type 0 cannot be blank")},
 		{"duplicate clients", types.NewParams(exported.Tendermint, exported.Tendermint), errors.New("duplicate client type: 07-tendermint")},
 		{"allow all clients plus valid client", types.NewParams(types.AllowAllClients, exported.Tendermint), errors.New("allow list must have only one element because the allow all clients wildcard (*) is present")},
+		{"zero client prune delay", types.Params{AllowedClients: types.DefaultAllowedClients}, nil},
+		{"negative client prune delay", types.Params{AllowedClients: types.DefaultAllowedClients, ClientPruneDelay: -time.Second}, errors.New("client prune delay cannot be negative")},
 		{"too many allowed clients", types.NewParams(make([]string, types.MaxAllowedClientsLength+1)...), errors.New("allowed clients length must not exceed 200 items")},
 	}
 
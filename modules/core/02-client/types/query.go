@@ -3,11 +3,16 @@
 package types
 
 import (
+	"time"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
 	"github.com/cosmos/ibc-go/v11/modules/core/exported"
 )
 
+// DefaultExpiryWindow is the window used by the ClientsNearingExpiry query when none is provided.
+const DefaultExpiryWindow = 72 * time.Hour
+
 var (
 	_ codectypes.UnpackInterfacesMessage = (*QueryClientStateResponse)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*QueryClientStatesResponse)(nil)
@@ -66,3 +71,12 @@ func NewQueryConsensusStateResponse(
 func (qcsr QueryConsensusStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(qcsr.ConsensusState, new(exported.ConsensusState))
 }
+
+// NewClientExpiry creates a new ClientExpiry instance.
+func NewClientExpiry(clientID string, expiry time.Time, timeRemaining time.Duration) ClientExpiry {
+	return ClientExpiry{
+		ClientId:      clientID,
+		Expiry:        expiry,
+		TimeRemaining: timeRemaining,
+	}
+}
@@ -11,20 +11,25 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	v2 "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types/v2"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -706,6 +711,182 @@ func (m *QueryClientStatusResponse) GetStatus() string {
 	return ""
 }
 
+// QueryClientsNearingExpiryRequest is the request type for the Query/ClientsNearingExpiry RPC
+// method
+type QueryClientsNearingExpiryRequest struct {
+	// window before expiry within which clients are returned, DefaultExpiryWindow is used if unset
+	Window time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClientsNearingExpiryRequest) Reset()         { *m = QueryClientsNearingExpiryRequest{} }
+func (m *QueryClientsNearingExpiryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientsNearingExpiryRequest) ProtoMessage()    {}
+func (*QueryClientsNearingExpiryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{12}
+}
+func (m *QueryClientsNearingExpiryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientsNearingExpiryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientsNearingExpiryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientsNearingExpiryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientsNearingExpiryRequest.Merge(m, src)
+}
+func (m *QueryClientsNearingExpiryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientsNearingExpiryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientsNearingExpiryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientsNearingExpiryRequest proto.InternalMessageInfo
+
+func (m *QueryClientsNearingExpiryRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *QueryClientsNearingExpiryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryClientsNearingExpiryResponse is the response type for the Query/ClientsNearingExpiry RPC
+// method.
+type QueryClientsNearingExpiryResponse struct {
+	// list of clients expiring within the requested window.
+	Clients []ClientExpiry `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClientsNearingExpiryResponse) Reset()         { *m = QueryClientsNearingExpiryResponse{} }
+func (m *QueryClientsNearingExpiryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientsNearingExpiryResponse) ProtoMessage()    {}
+func (*QueryClientsNearingExpiryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{13}
+}
+func (m *QueryClientsNearingExpiryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientsNearingExpiryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientsNearingExpiryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientsNearingExpiryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientsNearingExpiryResponse.Merge(m, src)
+}
+func (m *QueryClientsNearingExpiryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientsNearingExpiryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientsNearingExpiryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientsNearingExpiryResponse proto.InternalMessageInfo
+
+func (m *QueryClientsNearingExpiryResponse) GetClients() []ClientExpiry {
+	if m != nil {
+		return m.Clients
+	}
+	return nil
+}
+
+func (m *QueryClientsNearingExpiryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ClientExpiry defines the time at which an active client expires if it is not updated.
+type ClientExpiry struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// time at which the trusting period of the latest consensus state elapses
+	Expiry time.Time `protobuf:"bytes,2,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// time remaining until expiry, relative to the current block time
+	TimeRemaining time.Duration `protobuf:"bytes,3,opt,name=time_remaining,json=timeRemaining,proto3,stdduration" json:"time_remaining"`
+}
+
+func (m *ClientExpiry) Reset()         { *m = ClientExpiry{} }
+func (m *ClientExpiry) String() string { return proto.CompactTextString(m) }
+func (*ClientExpiry) ProtoMessage()    {}
+func (*ClientExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{14}
+}
+func (m *ClientExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientExpiry.Merge(m, src)
+}
+func (m *ClientExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientExpiry proto.InternalMessageInfo
+
+func (m *ClientExpiry) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientExpiry) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *ClientExpiry) GetTimeRemaining() time.Duration {
+	if m != nil {
+		return m.TimeRemaining
+	}
+	return 0
+}
+
 // QueryClientParamsRequest is the request type for the Query/ClientParams RPC
 // method.
 type QueryClientParamsRequest struct {
@@ -715,7 +896,7 @@ func (m *QueryClientParamsRequest) Reset()         { *m = QueryClientParamsReque
 func (m *QueryClientParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClientParamsRequest) ProtoMessage()    {}
 func (*QueryClientParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{12}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{15}
 }
 func (m *QueryClientParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +936,7 @@ func (m *QueryClientParamsResponse) Reset()         { *m = QueryClientParamsResp
 func (m *QueryClientParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClientParamsResponse) ProtoMessage()    {}
 func (*QueryClientParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{13}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{16}
 }
 func (m *QueryClientParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,7 +983,7 @@ func (m *QueryClientCreatorRequest) Reset()         { *m = QueryClientCreatorReq
 func (m *QueryClientCreatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClientCreatorRequest) ProtoMessage()    {}
 func (*QueryClientCreatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{14}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{17}
 }
 func (m *QueryClientCreatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -849,7 +1030,7 @@ func (m *QueryClientCreatorResponse) Reset()         { *m = QueryClientCreatorRe
 func (m *QueryClientCreatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClientCreatorResponse) ProtoMessage()    {}
 func (*QueryClientCreatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{15}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{18}
 }
 func (m *QueryClientCreatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,7 +1075,7 @@ func (m *QueryUpgradedClientStateRequest) Reset()         { *m = QueryUpgradedCl
 func (m *QueryUpgradedClientStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedClientStateRequest) ProtoMessage()    {}
 func (*QueryUpgradedClientStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{16}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{19}
 }
 func (m *QueryUpgradedClientStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -934,7 +1115,7 @@ func (m *QueryUpgradedClientStateResponse) Reset()         { *m = QueryUpgradedC
 func (m *QueryUpgradedClientStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedClientStateResponse) ProtoMessage()    {}
 func (*QueryUpgradedClientStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{17}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{20}
 }
 func (m *QueryUpgradedClientStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,7 +1160,7 @@ func (m *QueryUpgradedConsensusStateRequest) Reset()         { *m = QueryUpgrade
 func (m *QueryUpgradedConsensusStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedConsensusStateRequest) ProtoMessage()    {}
 func (*QueryUpgradedConsensusStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{18}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{21}
 }
 func (m *QueryUpgradedConsensusStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1200,7 @@ func (m *QueryUpgradedConsensusStateResponse) Reset()         { *m = QueryUpgrad
 func (m *QueryUpgradedConsensusStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedConsensusStateResponse) ProtoMessage()    {}
 func (*QueryUpgradedConsensusStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{19}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{22}
 }
 func (m *QueryUpgradedConsensusStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1077,7 +1258,7 @@ func (m *QueryVerifyMembershipRequest) Reset()         { *m = QueryVerifyMembers
 func (m *QueryVerifyMembershipRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVerifyMembershipRequest) ProtoMessage()    {}
 func (*QueryVerifyMembershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{20}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{23}
 }
 func (m *QueryVerifyMembershipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1165,7 +1346,7 @@ func (m *QueryVerifyMembershipResponse) Reset()         { *m = QueryVerifyMember
 func (m *QueryVerifyMembershipResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVerifyMembershipResponse) ProtoMessage()    {}
 func (*QueryVerifyMembershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{21}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{24}
 }
 func (m *QueryVerifyMembershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1214,6 +1395,9 @@ func init() {
 	proto.RegisterType((*QueryConsensusStateHeightsResponse)(nil), "ibc.core.client.v1.QueryConsensusStateHeightsResponse")
 	proto.RegisterType((*QueryClientStatusRequest)(nil), "ibc.core.client.v1.QueryClientStatusRequest")
 	proto.RegisterType((*QueryClientStatusResponse)(nil), "ibc.core.client.v1.QueryClientStatusResponse")
+	proto.RegisterType((*QueryClientsNearingExpiryRequest)(nil), "ibc.core.client.v1.QueryClientsNearingExpiryRequest")
+	proto.RegisterType((*QueryClientsNearingExpiryResponse)(nil), "ibc.core.client.v1.QueryClientsNearingExpiryResponse")
+	proto.RegisterType((*ClientExpiry)(nil), "ibc.core.client.v1.ClientExpiry")
 	proto.RegisterType((*QueryClientParamsRequest)(nil), "ibc.core.client.v1.QueryClientParamsRequest")
 	proto.RegisterType((*QueryClientParamsResponse)(nil), "ibc.core.client.v1.QueryClientParamsResponse")
 	proto.RegisterType((*QueryClientCreatorRequest)(nil), "ibc.core.client.v1.QueryClientCreatorRequest")
@@ -1229,89 +1413,100 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/query.proto", fileDescriptor_dc42cdfd1d52d76e) }
 
 var fileDescriptor_dc42cdfd1d52d76e = []byte{
-	// 1308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xa4, 0x49, 0x9a, 0x3e, 0xbb, 0x4d, 0x35, 0x6d, 0x53, 0x77, 0x93, 0x3a, 0xc9, 0x06,
-	0x48, 0x1a, 0x92, 0xdd, 0xd8, 0xa1, 0x49, 0x5a, 0x09, 0x09, 0x12, 0x54, 0x1a, 0xa4, 0x96, 0xe0,
-	0x8a, 0x0f, 0x21, 0x21, 0x6b, 0xbd, 0x9e, 0xd8, 0xab, 0xda, 0xbb, 0xee, 0xce, 0xae, 0xa5, 0xa8,
-	0xca, 0xa5, 0xa7, 0xde, 0x40, 0x42, 0xe2, 0x8a, 0xc4, 0x81, 0x03, 0x48, 0x55, 0x0f, 0x48, 0x9c,
-	0x90, 0x38, 0x41, 0x8e, 0x95, 0xe0, 0xc0, 0x89, 0xa2, 0x04, 0x89, 0x7f, 0x03, 0xed, 0xcc, 0xac,
-	0xbd, 0x6b, 0x8f, 0xe3, 0x35, 0x6a, 0xb9, 0x79, 0xde, 0xe7, 0xef, 0x7d, 0xec, 0x7b, 0x4f, 0x86,
-	0xac, 0x55, 0x32, 0x75, 0xd3, 0x71, 0x89, 0x6e, 0xd6, 0x2c, 0x62, 0x7b, 0x7a, 0x33, 0xa7, 0x3f,
-	0xf0, 0x89, 0xbb, 0xaf, 0x35, 0x5c, 0xc7, 0x73, 0x30, 0xb6, 0x4a, 0xa6, 0x16, 0xf0, 0x35, 0xce,
-	0xd7, 0x9a, 0x39, 0x65, 0xc9, 0x74, 0x68, 0xdd, 0xa1, 0x7a, 0xc9, 0xa0, 0x84, 0x0b, 0xeb, 0xcd,
-	0x5c, 0x89, 0x78, 0x46, 0x4e, 0x6f, 0x18, 0x15, 0xcb, 0x36, 0x3c, 0xcb, 0xb1, 0xb9, 0xbe, 0x32,
-	0x25, 0x64, 0x43, 0xb1, 0xa8, 0x71, 0x65, 0x46, 0xe2, 0x5c, 0xb8, 0xe1, 0x02, 0x0b, 0x6d, 0x01,
-	0xa7, 0x5e, 0xb7, 0xbc, 0x3a, 0x13, 0xca, 0x47, 0x5e, 0x42, 0xf0, 0x4a, 0xc5, 0x71, 0x2a, 0x35,
-	0xa2, 0xb3, 0x57, 0xc9, 0xdf, 0xd3, 0x0d, 0x3b, 0x74, 0x32, 0x2d, 0x58, 0x46, 0xc3, 0xd2, 0x0d,
-	0xdb, 0x76, 0x3c, 0x06, 0x8f, 0x0a, 0xee, 0xc5, 0x8a, 0x53, 0x71, 0xd8, 0x4f, 0x3d, 0xf8, 0xc5,
-	0xa9, 0xea, 0x3a, 0x5c, 0xfe, 0x20, 0xc0, 0xb9, 0xcd, 0xc0, 0xdc, 0xf3, 0x0c, 0x8f, 0x14, 0xc8,
-	0x03, 0x9f, 0x50, 0x0f, 0x4f, 0xc1, 0x19, 0x0e, 0xb1, 0x68, 0x95, 0x33, 0x68, 0x16, 0x2d, 0x9e,
-	0x29, 0x8c, 0x73, 0xc2, 0x4e, 0x59, 0x7d, 0x82, 0x20, 0xd3, 0xad, 0x48, 0x1b, 0x8e, 0x4d, 0x09,
-	0xde, 0x80, 0xb4, 0xd0, 0xa4, 0x01, 0x9d, 0x29, 0xa7, 0xf2, 0x17, 0x35, 0x8e, 0x4f, 0x0b, 0xa1,
-	0x6b, 0x6f, 0xdb, 0xfb, 0x85, 0x94, 0xd9, 0x36, 0x80, 0x2f, 0xc2, 0x68, 0xc3, 0x75, 0x9c, 0xbd,
-	0xcc, 0xf0, 0x2c, 0x5a, 0x4c, 0x17, 0xf8, 0x03, 0x6f, 0x43, 0x9a, 0xfd, 0x28, 0x56, 0x89, 0x55,
-	0xa9, 0x7a, 0x99, 0x53, 0xcc, 0x9c, 0xa2, 0x75, 0x17, 0x4c, 0xbb, 0xcd, 0x24, 0xb6, 0x46, 0x0e,
-	0xff, 0x9c, 0x19, 0x2a, 0xa4, 0x98, 0x16, 0x27, 0xa9, 0xa5, 0x6e, 0xbc, 0x34, 0x8c, 0xf4, 0x16,
-	0x40, 0xbb, 0x9c, 0x02, 0xed, 0x6b, 0x1a, 0xaf, 0xa7, 0x16, 0xd4, 0x5e, 0xe3, 0xb5, 0x14, 0xb5,
-	0xd7, 0x76, 0x8d, 0x4a, 0x98, 0xa5, 0x42, 0x44, 0x53, 0xfd, 0x1d, 0xc1, 0x15, 0x89, 0x13, 0x91,
-	0x15, 0x1b, 0xce, 0x46, 0xb3, 0x42, 0x33, 0x68, 0xf6, 0xd4, 0x62, 0x2a, 0x7f, 0x4d, 0x16, 0xc7,
-	0x4e, 0x99, 0xd8, 0x9e, 0xb5, 0x67, 0x91, 0x72, 0xc4, 0xd4, 0x56, 0x36, 0x08, 0xeb, 0xbb, 0xe7,
-	0x33, 0x93, 0x52, 0x36, 0x2d, 0xa4, 0x23, 0xb9, 0xa4, 0xf8, 0xdd, 0x58, 0x54, 0xc3, 0x2c, 0xaa,
-	0x85, 0xbe, 0x51, 0x71, 0xb0, 0xb1, 0xb0, 0x9e, 0x22, 0x50, 0x78, 0x58, 0x01, 0xcb, 0xa6, 0x3e,
-	0x4d, 0xdc, 0x27, 0x78, 0x01, 0x26, 0x5c, 0xd2, 0xb4, 0xa8, 0xe5, 0xd8, 0x45, 0xdb, 0xaf, 0x97,
-	0x88, 0xcb, 0x90, 0x8c, 0x14, 0xce, 0x85, 0xe4, 0xbb, 0x8c, 0x1a, 0x13, 0x8c, 0xd4, 0x39, 0x22,
-	0xc8, 0x0b, 0x89, 0xe7, 0xe1, 0x6c, 0x2d, 0x88, 0xcf, 0x0b, 0xc5, 0x46, 0x66, 0xd1, 0xe2, 0x78,
-	0x21, 0xcd, 0x89, 0xa2, 0xda, 0x3f, 0x22, 0x98, 0x92, 0x42, 0x16, 0xb5, 0x78, 0x13, 0x26, 0xcc,
-	0x90, 0x93, 0xa0, 0x49, 0xcf, 0x99, 0x31, 0x33, 0x2f, 0xb3, 0x4f, 0x1f, 0xc9, 0x91, 0xd3, 0x44,
-	0xd9, 0xbe, 0x25, 0x29, 0xf9, 0x7f, 0x69, 0xe4, 0x5f, 0x10, 0x4c, 0xcb, 0x41, 0x88, 0xfc, 0x7d,
-	0x06, 0xe7, 0x3b, 0xf2, 0x17, 0xb6, 0xf3, 0xb2, 0x2c, 0xdc, 0xb8, 0x99, 0x8f, 0x2d, 0xaf, 0x1a,
-	0x4b, 0xc0, 0x44, 0x3c, 0xbd, 0x2f, 0xb0, 0x75, 0x1f, 0x23, 0x98, 0x93, 0x04, 0xc2, 0xbd, 0xff,
-	0xbf, 0x39, 0xfd, 0x15, 0x81, 0x7a, 0x12, 0x14, 0x91, 0xd9, 0x4f, 0xe0, 0x72, 0x47, 0x66, 0x45,
-	0x3b, 0x85, 0x09, 0xee, 0xdf, 0x4f, 0x97, 0x4c, 0x99, 0x87, 0x17, 0x97, 0xd4, 0x8d, 0xae, 0x51,
-	0xea, 0x27, 0x4a, 0xa5, 0xba, 0xd6, 0x35, 0x1e, 0xfd, 0x76, 0xe0, 0x93, 0x30, 0x46, 0x19, 0x45,
-	0xa8, 0x89, 0x97, 0xaa, 0xc4, 0xbc, 0xed, 0x1a, 0xae, 0x51, 0x0f, 0xbd, 0xa9, 0xef, 0xc7, 0x0c,
-	0x86, 0x3c, 0x61, 0x30, 0x0f, 0x63, 0x0d, 0x46, 0x11, 0x9f, 0xb6, 0x34, 0x71, 0x42, 0x47, 0x48,
-	0xaa, 0x9b, 0x31, 0x83, 0xdb, 0x2e, 0x31, 0x3c, 0xc7, 0x4d, 0x14, 0xdb, 0x7a, 0x38, 0x23, 0xe3,
-	0x9a, 0x02, 0x4b, 0x06, 0x4e, 0x9b, 0x9c, 0x24, 0x14, 0xc3, 0xa7, 0x3a, 0x07, 0x33, 0x4c, 0xef,
-	0xc3, 0x46, 0xc5, 0x35, 0xca, 0xb1, 0x81, 0x1e, 0x46, 0x59, 0x83, 0xd9, 0xde, 0x22, 0xc2, 0xc1,
-	0x6d, 0xb8, 0xe4, 0x0b, 0x76, 0x31, 0xf1, 0xee, 0xbd, 0xe0, 0x77, 0x5b, 0x54, 0x5f, 0x11, 0x6d,
-	0xda, 0xf2, 0x26, 0x1b, 0xfa, 0xaa, 0x0f, 0xf3, 0x27, 0x4a, 0x09, 0x58, 0x77, 0x21, 0xd3, 0x86,
-	0x35, 0xc0, 0xc0, 0x9d, 0xf4, 0xa5, 0x76, 0xd5, 0x9f, 0x86, 0xc5, 0x60, 0xfa, 0x88, 0xb8, 0xd6,
-	0xde, 0xfe, 0x1d, 0x12, 0xec, 0x0e, 0x5a, 0xb5, 0x1a, 0x89, 0x3e, 0xe5, 0x97, 0x37, 0xb6, 0x03,
-	0xd3, 0x4d, 0xa3, 0xe6, 0x93, 0xcc, 0x28, 0x37, 0xcd, 0x1e, 0xf8, 0x2a, 0x80, 0x67, 0xd5, 0x49,
-	0xb1, 0x4c, 0x6a, 0xc6, 0x7e, 0x66, 0x8c, 0xed, 0xb3, 0x33, 0x01, 0xe5, 0x9d, 0x80, 0x80, 0x67,
-	0x20, 0x55, 0xaa, 0x39, 0xe6, 0x7d, 0xc1, 0x3f, 0xcd, 0xf8, 0xc0, 0x48, 0x5c, 0x60, 0x07, 0x52,
-	0x75, 0xe2, 0xde, 0xaf, 0x91, 0x62, 0xc3, 0xf0, 0xaa, 0x99, 0x71, 0x86, 0x4c, 0x8d, 0x20, 0x6b,
-	0x5f, 0x87, 0xcd, 0xbc, 0x76, 0x87, 0x89, 0xee, 0x1a, 0x5e, 0x55, 0x20, 0x84, 0x7a, 0x8b, 0xf2,
-	0xde, 0xc8, 0xf8, 0xc8, 0xf9, 0x51, 0xf5, 0x06, 0x5c, 0xed, 0x91, 0xbe, 0x76, 0xa3, 0x52, 0xdf,
-	0x34, 0x09, 0xe5, 0x5f, 0xcd, 0x78, 0x21, 0x7c, 0xe6, 0xbf, 0x9f, 0x80, 0x51, 0xa6, 0x8b, 0xbf,
-	0x46, 0x90, 0x8a, 0x74, 0x0c, 0x7e, 0x5d, 0x96, 0xaa, 0x1e, 0x57, 0xa5, 0xb2, 0x9c, 0x4c, 0x98,
-	0xc3, 0x51, 0xaf, 0x3f, 0xfa, 0xed, 0xef, 0x2f, 0x87, 0x75, 0xbc, 0xa2, 0xf7, 0x3c, 0xa0, 0xc5,
-	0xfa, 0xd1, 0x1f, 0xb6, 0xea, 0x7e, 0x80, 0xbf, 0x42, 0x90, 0x8e, 0x5e, 0x46, 0x38, 0x91, 0xd7,
-	0x70, 0xac, 0x28, 0x2b, 0x09, 0xa5, 0x05, 0xc8, 0x6b, 0x0c, 0xe4, 0x3c, 0x9e, 0xeb, 0x0b, 0x12,
-	0x3f, 0x47, 0x70, 0x2e, 0xde, 0xd2, 0x58, 0xeb, 0xed, 0x4c, 0xf6, 0xe5, 0x29, 0x7a, 0x62, 0x79,
-	0x01, 0xaf, 0xc6, 0xe0, 0xed, 0xe1, 0xb2, 0x14, 0x5e, 0xc7, 0x16, 0x8f, 0xa6, 0x51, 0x0f, 0x2f,
-	0x2f, 0xfd, 0x61, 0xc7, 0x0d, 0x77, 0xa0, 0xf3, 0x6f, 0x25, 0xc2, 0xe0, 0x84, 0x03, 0xfc, 0x04,
-	0xc1, 0x44, 0xc7, 0xd5, 0x80, 0x93, 0x42, 0x6e, 0x15, 0x60, 0x35, 0xb9, 0x82, 0x08, 0x72, 0x93,
-	0x05, 0x99, 0xc7, 0xab, 0x83, 0x06, 0x89, 0x0f, 0x11, 0x5c, 0x92, 0xae, 0x64, 0x7c, 0x3d, 0x21,
-	0x8a, 0xf8, 0x35, 0xa1, 0xac, 0x0f, 0xaa, 0x26, 0x42, 0x78, 0x8b, 0x85, 0x70, 0x13, 0x6f, 0x0e,
-	0x5c, 0x27, 0x71, 0x20, 0xe0, 0x6f, 0x62, 0x6d, 0xef, 0x27, 0x6b, 0x7b, 0x7f, 0xa0, 0xb6, 0x6f,
-	0x2f, 0xec, 0xc4, 0xdf, 0xa6, 0x1f, 0xcf, 0xf7, 0xe7, 0x2d, 0x90, 0x7c, 0xf7, 0xf6, 0x05, 0x19,
-	0x5b, 0xf9, 0x7d, 0x41, 0xc6, 0x8f, 0x00, 0x55, 0x65, 0x20, 0xa7, 0xb1, 0x22, 0x03, 0xc9, 0x97,
-	0x3e, 0xfe, 0x16, 0xc1, 0xd9, 0xd8, 0xda, 0xc6, 0xfd, 0x9c, 0xc4, 0x0f, 0x03, 0x45, 0x4b, 0x2a,
-	0x2e, 0x40, 0xad, 0x33, 0x50, 0xab, 0x58, 0x3b, 0x21, 0x73, 0xe2, 0x3e, 0x88, 0xa5, 0xee, 0x07,
-	0x04, 0x17, 0x24, 0x47, 0x00, 0x5e, 0xeb, 0xe9, 0xbf, 0xf7, 0x55, 0xa1, 0xbc, 0x31, 0x98, 0x92,
-	0x80, 0x9e, 0x67, 0xd0, 0x97, 0xf1, 0x92, 0x0c, 0xba, 0xf4, 0x02, 0xa1, 0xf8, 0x67, 0x04, 0x93,
-	0xf2, 0x3b, 0x01, 0xaf, 0xf7, 0x07, 0x21, 0x1d, 0x82, 0x1b, 0x03, 0xeb, 0x25, 0x69, 0xda, 0x5e,
-	0xa7, 0x0a, 0x0d, 0xa6, 0xda, 0xf9, 0xce, 0x9d, 0x89, 0x7b, 0x4f, 0xa9, 0x1e, 0xd7, 0x89, 0x92,
-	0x1b, 0x40, 0x23, 0x04, 0xfc, 0xf8, 0x9f, 0xa7, 0x4b, 0x88, 0xa1, 0x5e, 0xba, 0x89, 0x96, 0xd4,
-	0x57, 0x65, 0xc0, 0x9b, 0x4c, 0xbb, 0x58, 0x6f, 0xa9, 0x6f, 0xdd, 0x3b, 0x3c, 0xca, 0xa2, 0x67,
-	0x47, 0x59, 0xf4, 0xd7, 0x51, 0x16, 0x7d, 0x71, 0x9c, 0x1d, 0x7a, 0x76, 0x9c, 0x1d, 0xfa, 0xe3,
-	0x38, 0x3b, 0xf4, 0xe9, 0x8d, 0x8a, 0xe5, 0x55, 0xfd, 0x52, 0x70, 0x3b, 0xe8, 0xe2, 0x2f, 0x2b,
-	0xab, 0x64, 0xae, 0x54, 0x1c, 0xbd, 0x99, 0xcb, 0xe9, 0x75, 0xa7, 0xec, 0xd7, 0x08, 0xe5, 0x0e,
-	0x56, 0xf3, 0x2b, 0xc2, 0x87, 0xb7, 0xdf, 0x20, 0xb4, 0x34, 0xc6, 0x6e, 0xb4, 0xb5, 0x7f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0xd1, 0x06, 0x08, 0x32, 0x4b, 0x13, 0x00, 0x00,
+	// 1483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0x84, 0x24, 0x84, 0xe7, 0x7c, 0x69, 0x08, 0xc1, 0x59, 0xc0, 0x36, 0x4b, 0x5b, 0x42,
+	0x4a, 0x76, 0x63, 0x03, 0xe1, 0xa3, 0xad, 0x44, 0x13, 0x4a, 0x01, 0x09, 0x4a, 0x4d, 0xbf, 0x54,
+	0xa9, 0xb2, 0xd6, 0xeb, 0x89, 0xbd, 0xc2, 0xde, 0x35, 0x3b, 0xbb, 0xa6, 0x11, 0xe2, 0xc2, 0x89,
+	0x5b, 0x91, 0x2a, 0x55, 0xbd, 0x55, 0xea, 0xa1, 0x95, 0x7a, 0x40, 0x1c, 0x5a, 0x71, 0xaa, 0xd4,
+	0x53, 0xcb, 0x11, 0xa9, 0x3d, 0xf4, 0x54, 0x2a, 0xd2, 0xaa, 0xff, 0x46, 0xb5, 0x33, 0xb3, 0xce,
+	0xae, 0x3d, 0x8e, 0xd7, 0x55, 0xe8, 0x6d, 0xf7, 0xcd, 0xfb, 0xcd, 0xfb, 0xbd, 0x8f, 0xd9, 0xf9,
+	0xd9, 0x90, 0xb1, 0xca, 0xa6, 0x6e, 0x3a, 0x2e, 0xd1, 0xcd, 0xba, 0x45, 0x6c, 0x4f, 0x6f, 0xe5,
+	0xf5, 0x5b, 0x3e, 0x71, 0x37, 0xb4, 0xa6, 0xeb, 0x78, 0x0e, 0xc6, 0x56, 0xd9, 0xd4, 0x82, 0x75,
+	0x8d, 0xaf, 0x6b, 0xad, 0xbc, 0xb2, 0x68, 0x3a, 0xb4, 0xe1, 0x50, 0xbd, 0x6c, 0x50, 0xc2, 0x9d,
+	0xf5, 0x56, 0xbe, 0x4c, 0x3c, 0x23, 0xaf, 0x37, 0x8d, 0xaa, 0x65, 0x1b, 0x9e, 0xe5, 0xd8, 0x1c,
+	0xaf, 0x1c, 0x10, 0xbe, 0xa1, 0x5b, 0x74, 0x73, 0x25, 0x2b, 0x09, 0x2e, 0xc2, 0x70, 0x87, 0xa3,
+	0x5b, 0x0e, 0x4e, 0xa3, 0x61, 0x79, 0x0d, 0xe6, 0x54, 0x88, 0xbc, 0x09, 0xc7, 0xf9, 0xaa, 0xe3,
+	0x54, 0xeb, 0x44, 0x67, 0x6f, 0x65, 0x7f, 0x5d, 0x37, 0xec, 0x30, 0x48, 0xa6, 0x73, 0xa9, 0xe2,
+	0xbb, 0x51, 0x86, 0xd9, 0xce, 0x75, 0xcf, 0x6a, 0x10, 0xea, 0x19, 0x8d, 0xa6, 0x70, 0x38, 0x28,
+	0x1c, 0x8c, 0xa6, 0xa5, 0x1b, 0xb6, 0xed, 0x78, 0x0c, 0x4d, 0xc5, 0xea, 0x6c, 0xd5, 0xa9, 0x3a,
+	0xec, 0x51, 0x0f, 0x9e, 0xb8, 0x55, 0x5d, 0x81, 0xfd, 0xef, 0x06, 0x89, 0xae, 0xb1, 0x6c, 0x6e,
+	0x78, 0x86, 0x47, 0x8a, 0xe4, 0x96, 0x4f, 0xa8, 0x87, 0x0f, 0xc0, 0x1e, 0x9e, 0x63, 0xc9, 0xaa,
+	0xa4, 0x51, 0x0e, 0x2d, 0xec, 0x29, 0x8e, 0x73, 0xc3, 0xe5, 0x8a, 0xfa, 0x10, 0x41, 0xba, 0x1b,
+	0x48, 0x9b, 0x8e, 0x4d, 0x09, 0x3e, 0x0d, 0x13, 0x02, 0x49, 0x03, 0x3b, 0x03, 0xa7, 0x0a, 0xb3,
+	0x1a, 0xe7, 0xa7, 0x85, 0x09, 0x68, 0x6f, 0xda, 0x1b, 0xc5, 0x94, 0xb9, 0xb5, 0x01, 0x9e, 0x85,
+	0xd1, 0xa6, 0xeb, 0x38, 0xeb, 0xe9, 0xe1, 0x1c, 0x5a, 0x98, 0x28, 0xf2, 0x17, 0xbc, 0x06, 0x13,
+	0xec, 0xa1, 0x54, 0x23, 0x56, 0xb5, 0xe6, 0xa5, 0x77, 0xb1, 0xed, 0x14, 0xad, 0xbb, 0xe3, 0xda,
+	0x25, 0xe6, 0xb1, 0x3a, 0xf2, 0xe4, 0x8f, 0xec, 0x50, 0x31, 0xc5, 0x50, 0xdc, 0xa4, 0x96, 0xbb,
+	0xf9, 0xd2, 0x30, 0xd3, 0x8b, 0x00, 0x5b, 0xf3, 0x20, 0xd8, 0xbe, 0xa2, 0xf1, 0x81, 0xd0, 0x82,
+	0xe1, 0xd1, 0xf8, 0x30, 0x88, 0xe1, 0xd1, 0xae, 0x1b, 0xd5, 0xb0, 0x4a, 0xc5, 0x08, 0x52, 0xfd,
+	0x0d, 0xc1, 0xbc, 0x24, 0x88, 0xa8, 0x8a, 0x0d, 0x93, 0xd1, 0xaa, 0xd0, 0x34, 0xca, 0xed, 0x5a,
+	0x48, 0x15, 0x8e, 0xc9, 0xf2, 0xb8, 0x5c, 0x21, 0xb6, 0x67, 0xad, 0x5b, 0xa4, 0x12, 0xd9, 0x6a,
+	0x35, 0x13, 0xa4, 0xf5, 0xdd, 0xb3, 0xec, 0x9c, 0x74, 0x99, 0x16, 0x27, 0x22, 0xb5, 0xa4, 0xf8,
+	0xed, 0x58, 0x56, 0xc3, 0x2c, 0xab, 0xa3, 0x7d, 0xb3, 0xe2, 0x64, 0x63, 0x69, 0x3d, 0x42, 0xa0,
+	0xf0, 0xb4, 0x82, 0x25, 0x9b, 0xfa, 0x34, 0xf1, 0x9c, 0xe0, 0xa3, 0x30, 0xed, 0x92, 0x96, 0x45,
+	0x2d, 0xc7, 0x2e, 0xd9, 0x7e, 0xa3, 0x4c, 0x5c, 0xc6, 0x64, 0xa4, 0x38, 0x15, 0x9a, 0xaf, 0x31,
+	0x6b, 0xcc, 0x31, 0xd2, 0xe7, 0x88, 0x23, 0x6f, 0x24, 0x3e, 0x02, 0x93, 0xf5, 0x20, 0x3f, 0x2f,
+	0x74, 0x1b, 0xc9, 0xa1, 0x85, 0xf1, 0xe2, 0x04, 0x37, 0x8a, 0x6e, 0x3f, 0x46, 0x70, 0x40, 0x4a,
+	0x59, 0xf4, 0xe2, 0x0d, 0x98, 0x36, 0xc3, 0x95, 0x04, 0x43, 0x3a, 0x65, 0xc6, 0xb6, 0x79, 0x91,
+	0x73, 0x7a, 0x4f, 0xce, 0x9c, 0x26, 0xaa, 0xf6, 0x45, 0x49, 0xcb, 0xff, 0xcb, 0x20, 0xff, 0x8c,
+	0xe0, 0xa0, 0x9c, 0x84, 0xa8, 0xdf, 0x27, 0x30, 0xd3, 0x51, 0xbf, 0x70, 0x9c, 0x8f, 0xcb, 0xd2,
+	0x8d, 0x6f, 0xf3, 0xa1, 0xe5, 0xd5, 0x62, 0x05, 0x98, 0x8e, 0x97, 0x77, 0x07, 0x47, 0xf7, 0x3e,
+	0x82, 0xc3, 0x92, 0x44, 0x78, 0xf4, 0xff, 0xb7, 0xa6, 0xbf, 0x20, 0x50, 0xb7, 0xa3, 0x22, 0x2a,
+	0xfb, 0x11, 0xec, 0xef, 0xa8, 0xac, 0x18, 0xa7, 0xb0, 0xc0, 0xfd, 0xe7, 0x69, 0x9f, 0x29, 0x8b,
+	0xb0, 0x73, 0x45, 0x3d, 0xdd, 0xf5, 0x29, 0xf5, 0x13, 0x95, 0x52, 0x3d, 0x01, 0xf3, 0x12, 0xa0,
+	0x48, 0x7c, 0x0e, 0xc6, 0x28, 0xb3, 0x08, 0x98, 0x78, 0x53, 0xbf, 0x45, 0x90, 0x8b, 0xa0, 0xe8,
+	0x35, 0x62, 0xb8, 0x96, 0x5d, 0x7d, 0xeb, 0xd3, 0xa6, 0xe5, 0x6e, 0x84, 0x61, 0x5f, 0x83, 0xb1,
+	0xdb, 0x96, 0x5d, 0x71, 0x6e, 0x8b, 0x63, 0x3c, 0xdf, 0x75, 0x8c, 0x2f, 0x88, 0xcb, 0x74, 0x75,
+	0x3c, 0xa8, 0xd1, 0x97, 0xcf, 0xb2, 0xa8, 0x28, 0x20, 0x3b, 0xd6, 0xe1, 0x87, 0xed, 0x61, 0x93,
+	0x32, 0x15, 0x79, 0x9e, 0x87, 0xdd, 0xbc, 0x20, 0x61, 0x43, 0x73, 0xd2, 0x13, 0xc3, 0x9e, 0x38,
+	0x54, 0xb4, 0x35, 0x84, 0xed, 0x5c, 0x23, 0x7f, 0x40, 0x30, 0x11, 0x0d, 0xb4, 0xfd, 0x41, 0x78,
+	0x1d, 0xc6, 0x08, 0x73, 0x13, 0x21, 0x95, 0xae, 0x1a, 0xbf, 0x17, 0x0a, 0x12, 0x5e, 0xe4, 0x07,
+	0xac, 0xc8, 0x1c, 0x83, 0xaf, 0xc0, 0x54, 0xa0, 0x57, 0x4a, 0x2e, 0x69, 0x18, 0x96, 0x6d, 0xd9,
+	0xd5, 0xf4, 0xae, 0xe4, 0x9d, 0x9a, 0x0c, 0xa0, 0xc5, 0x10, 0xa9, 0x2a, 0xb1, 0x01, 0xbc, 0x6e,
+	0xb8, 0x46, 0x23, 0x1c, 0x40, 0xf5, 0x1d, 0x98, 0x97, 0xac, 0x89, 0xda, 0x17, 0x60, 0xac, 0xc9,
+	0x2c, 0x62, 0x4c, 0xa4, 0x67, 0x49, 0x60, 0x84, 0xa7, 0x7a, 0x26, 0xb6, 0xe1, 0x9a, 0x4b, 0x0c,
+	0xcf, 0x71, 0x13, 0x8d, 0xfb, 0x4a, 0x78, 0x6d, 0xc6, 0x91, 0x82, 0x4b, 0x1a, 0x76, 0x9b, 0xdc,
+	0x24, 0x80, 0xe1, 0xab, 0x7a, 0x18, 0xb2, 0x0c, 0xf7, 0x7e, 0xb3, 0xea, 0x1a, 0x95, 0xd8, 0x1d,
+	0x1f, 0x66, 0x59, 0x87, 0x5c, 0x6f, 0x17, 0x11, 0xe0, 0x12, 0xec, 0xf3, 0xc5, 0x72, 0x29, 0xb1,
+	0x1c, 0xdb, 0xeb, 0x77, 0xef, 0xa8, 0xbe, 0x04, 0x6a, 0x3c, 0x9a, 0x4c, 0x07, 0xa8, 0x3e, 0x1c,
+	0xd9, 0xd6, 0x4b, 0xd0, 0xba, 0x06, 0xe9, 0x2d, 0x5a, 0x03, 0xdc, 0xc1, 0x73, 0xbe, 0x74, 0x5f,
+	0xf5, 0xc7, 0x61, 0x71, 0x57, 0x7d, 0x40, 0x5c, 0x6b, 0x7d, 0xe3, 0x2a, 0x09, 0xe4, 0x04, 0xad,
+	0x59, 0xcd, 0x44, 0x5f, 0xf7, 0x17, 0x77, 0x93, 0x07, 0x5b, 0xb7, 0x8c, 0xba, 0x4f, 0xd2, 0xa3,
+	0x7c, 0x6b, 0xf6, 0x82, 0x0f, 0x01, 0xb0, 0x73, 0x50, 0x21, 0x75, 0x63, 0x23, 0x3d, 0xc6, 0x24,
+	0xce, 0x9e, 0xc0, 0x72, 0x21, 0x30, 0xe0, 0x2c, 0xa4, 0xca, 0x75, 0xc7, 0xbc, 0x29, 0xd6, 0x77,
+	0xb3, 0x75, 0x60, 0x26, 0xee, 0x70, 0x19, 0x52, 0x0d, 0xe2, 0xde, 0xac, 0x93, 0x52, 0xd3, 0xf0,
+	0x6a, 0xe9, 0x71, 0xc6, 0x4c, 0x8d, 0x30, 0xdb, 0xfa, 0xc5, 0xd1, 0x2a, 0x68, 0x57, 0x99, 0xeb,
+	0x75, 0xc3, 0xab, 0x09, 0x86, 0xd0, 0x68, 0x5b, 0xae, 0x8c, 0x8c, 0x8f, 0xcc, 0x8c, 0xaa, 0x67,
+	0xe1, 0x50, 0x8f, 0xf2, 0x6d, 0x0d, 0x2a, 0xf5, 0x4d, 0x93, 0x50, 0x7e, 0x6a, 0xc6, 0x8b, 0xe1,
+	0x6b, 0xe1, 0xef, 0x19, 0x18, 0x65, 0x58, 0xfc, 0x15, 0x82, 0x54, 0x64, 0x62, 0xf0, 0xab, 0xb2,
+	0x52, 0xf5, 0xf8, 0xa1, 0xa1, 0x1c, 0x4f, 0xe6, 0xcc, 0xe9, 0xa8, 0xa7, 0xee, 0xfd, 0xfa, 0xd7,
+	0xe7, 0xc3, 0x3a, 0x5e, 0xd2, 0x7b, 0xfe, 0x28, 0x13, 0x8a, 0x44, 0xbf, 0xd3, 0xee, 0xfb, 0x5d,
+	0xfc, 0x45, 0xfb, 0x5b, 0x27, 0x34, 0x46, 0xa2, 0xa8, 0xe1, 0x67, 0x45, 0x59, 0x4a, 0xe8, 0x2d,
+	0x48, 0x1e, 0x63, 0x24, 0x8f, 0xe0, 0xc3, 0x7d, 0x49, 0xe2, 0x67, 0x08, 0xa6, 0xe2, 0x23, 0x8d,
+	0xb5, 0xde, 0xc1, 0x64, 0x27, 0x4f, 0xd1, 0x13, 0xfb, 0x0b, 0x7a, 0x75, 0x46, 0x6f, 0x1d, 0x57,
+	0xa4, 0xf4, 0x3a, 0x84, 0x5d, 0xb4, 0x8c, 0x7a, 0x28, 0xc6, 0xf5, 0x3b, 0x1d, 0xb2, 0xfe, 0xae,
+	0xce, 0xcf, 0x4a, 0x64, 0x81, 0x1b, 0xee, 0xe2, 0x87, 0x08, 0xa6, 0xd7, 0x3a, 0x14, 0x5e, 0x52,
+	0xca, 0xed, 0x06, 0x2c, 0x27, 0x07, 0x88, 0x24, 0xcf, 0xb0, 0x24, 0x0b, 0x78, 0x79, 0xd0, 0x24,
+	0xf1, 0x13, 0x04, 0xfb, 0xa4, 0x2a, 0x0d, 0x9f, 0x4a, 0xc8, 0x22, 0x2e, 0x30, 0x95, 0x95, 0x41,
+	0x61, 0x22, 0x85, 0xf3, 0x2c, 0x85, 0x73, 0xf8, 0xcc, 0xc0, 0x7d, 0x12, 0x9a, 0x11, 0x7f, 0x1d,
+	0x1b, 0x7b, 0x3f, 0xd9, 0xd8, 0xfb, 0x03, 0x8d, 0xbd, 0x4f, 0x07, 0x3e, 0x9b, 0x7e, 0xbc, 0xde,
+	0x8f, 0x11, 0xcc, 0xca, 0x34, 0x13, 0x3e, 0xd9, 0x27, 0xbc, 0x54, 0x0c, 0x2a, 0xa7, 0x06, 0x44,
+	0x09, 0xf2, 0x05, 0x46, 0xfe, 0x38, 0x5e, 0xec, 0x4d, 0x9e, 0x96, 0x6c, 0x0e, 0x2d, 0x09, 0x55,
+	0xf3, 0x59, 0xbb, 0xbc, 0x5c, 0x35, 0xf4, 0x2d, 0x6f, 0x4c, 0xac, 0x28, 0x4b, 0x09, 0xbd, 0x05,
+	0x43, 0x95, 0x31, 0x3c, 0x88, 0x15, 0x19, 0x43, 0x2e, 0x57, 0xf0, 0x37, 0x08, 0x26, 0x63, 0x82,
+	0x03, 0xf7, 0x0b, 0x12, 0x97, 0x34, 0x8a, 0x96, 0xd4, 0x5d, 0x90, 0x5a, 0x61, 0xa4, 0x96, 0xb1,
+	0xb6, 0x4d, 0xcf, 0x85, 0xb2, 0x89, 0x35, 0xfd, 0x7b, 0x04, 0x7b, 0x25, 0xf2, 0x05, 0x9f, 0xe8,
+	0x19, 0xbf, 0xb7, 0x1e, 0x52, 0x4e, 0x0e, 0x06, 0x4a, 0xd2, 0x71, 0xa9, 0x76, 0xa2, 0xf8, 0x27,
+	0x04, 0x73, 0x72, 0x85, 0x83, 0x57, 0xfa, 0x93, 0x90, 0x7e, 0xbe, 0x4f, 0x0f, 0x8c, 0x4b, 0x72,
+	0xdc, 0x7a, 0x89, 0x2c, 0x1a, 0x7c, 0x8f, 0x67, 0x3a, 0x6f, 0x7b, 0xdc, 0xfb, 0xfb, 0xda, 0x43,
+	0x57, 0x29, 0xf9, 0x01, 0x10, 0x21, 0xe1, 0xfb, 0xff, 0x3c, 0x5a, 0x44, 0x8c, 0xf5, 0xa2, 0xfa,
+	0xb2, 0x8c, 0x75, 0x8b, 0x41, 0x4b, 0x8d, 0x36, 0xf6, 0x1c, 0x5a, 0x5c, 0xbd, 0xf1, 0xe4, 0x79,
+	0x06, 0x3d, 0x7d, 0x9e, 0x41, 0x7f, 0x3e, 0xcf, 0xa0, 0x07, 0x9b, 0x99, 0xa1, 0xa7, 0x9b, 0x99,
+	0xa1, 0xdf, 0x37, 0x33, 0x43, 0x1f, 0x9f, 0xad, 0x5a, 0x5e, 0xcd, 0x2f, 0x07, 0xaa, 0x47, 0x17,
+	0x7f, 0xe0, 0x5a, 0x65, 0x73, 0xa9, 0xea, 0xe8, 0xad, 0x7c, 0x5e, 0x6f, 0x38, 0x15, 0xbf, 0x4e,
+	0x28, 0x8f, 0xb1, 0x5c, 0x58, 0x12, 0x61, 0xbc, 0x8d, 0x26, 0xa1, 0xe5, 0x31, 0xa6, 0x2e, 0x4f,
+	0xfc, 0x3b, 0x00, 0x6a, 0xa3, 0xca, 0x7d, 0x59, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1340,6 +1535,8 @@ type QueryClient interface {
 	ConsensusStateHeights(ctx context.Context, in *QueryConsensusStateHeightsRequest, opts ...grpc.CallOption) (*QueryConsensusStateHeightsResponse, error)
 	// Status queries the status of an IBC client.
 	ClientStatus(ctx context.Context, in *QueryClientStatusRequest, opts ...grpc.CallOption) (*QueryClientStatusResponse, error)
+	// ClientsNearingExpiry queries the active 07-tendermint clients whose trusting period expires within a given window.
+	ClientsNearingExpiry(ctx context.Context, in *QueryClientsNearingExpiryRequest, opts ...grpc.CallOption) (*QueryClientsNearingExpiryResponse, error)
 	// ClientParams queries all parameters of the ibc client submodule.
 	ClientParams(ctx context.Context, in *QueryClientParamsRequest, opts ...grpc.CallOption) (*QueryClientParamsResponse, error)
 	// ClientCreator queries the creator of a given client.
@@ -1414,6 +1611,15 @@ func (c *queryClient) ClientStatus(ctx context.Context, in *QueryClientStatusReq
 	return out, nil
 }
 
+func (c *queryClient) ClientsNearingExpiry(ctx context.Context, in *QueryClientsNearingExpiryRequest, opts ...grpc.CallOption) (*QueryClientsNearingExpiryResponse, error) {
+	out := new(QueryClientsNearingExpiryResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/ClientsNearingExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ClientParams(ctx context.Context, in *QueryClientParamsRequest, opts ...grpc.CallOption) (*QueryClientParamsResponse, error) {
 	out := new(QueryClientParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/ClientParams", in, out, opts...)
@@ -1475,6 +1681,8 @@ type QueryServer interface {
 	ConsensusStateHeights(context.Context, *QueryConsensusStateHeightsRequest) (*QueryConsensusStateHeightsResponse, error)
 	// Status queries the status of an IBC client.
 	ClientStatus(context.Context, *QueryClientStatusRequest) (*QueryClientStatusResponse, error)
+	// ClientsNearingExpiry queries the active 07-tendermint clients whose trusting period expires within a given window.
+	ClientsNearingExpiry(context.Context, *QueryClientsNearingExpiryRequest) (*QueryClientsNearingExpiryResponse, error)
 	// ClientParams queries all parameters of the ibc client submodule.
 	ClientParams(context.Context, *QueryClientParamsRequest) (*QueryClientParamsResponse, error)
 	// ClientCreator queries the creator of a given client.
@@ -1509,6 +1717,9 @@ func (*UnimplementedQueryServer) ConsensusStateHeights(ctx context.Context, req
 func (*UnimplementedQueryServer) ClientStatus(ctx context.Context, req *QueryClientStatusRequest) (*QueryClientStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClientStatus not implemented")
 }
+func (*UnimplementedQueryServer) ClientsNearingExpiry(ctx context.Context, req *QueryClientsNearingExpiryRequest) (*QueryClientsNearingExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientsNearingExpiry not implemented")
+}
 func (*UnimplementedQueryServer) ClientParams(ctx context.Context, req *QueryClientParamsRequest) (*QueryClientParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClientParams not implemented")
 }
@@ -1637,6 +1848,24 @@ func _Query_ClientStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClientsNearingExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientsNearingExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientsNearingExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Query/ClientsNearingExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientsNearingExpiry(ctx, req.(*QueryClientsNearingExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ClientParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryClientParamsRequest)
 	if err := dec(in); err != nil {
@@ -1756,6 +1985,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClientStatus",
 			Handler:    _Query_ClientStatus_Handler,
 		},
+		{
+			MethodName: "ClientsNearingExpiry",
+			Handler:    _Query_ClientsNearingExpiry_Handler,
+		},
 		{
 			MethodName: "ClientParams",
 			Handler:    _Query_ClientParams_Handler,
@@ -2291,7 +2524,7 @@ func (m *QueryClientStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClientParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClientsNearingExpiryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2301,20 +2534,40 @@ func (m *QueryClientParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryClientParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClientsNearingExpiryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClientParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClientsNearingExpiryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClientParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClientsNearingExpiryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2324,19 +2577,19 @@ func (m *QueryClientParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryClientParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClientsNearingExpiryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClientParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClientsNearingExpiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2344,12 +2597,26 @@ func (m *QueryClientParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClientCreatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *ClientExpiry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2359,16 +2626,32 @@ func (m *QueryClientCreatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryClientCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClientExpiry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClientCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClientExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeRemaining, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeRemaining):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintQuery(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x12
 	if len(m.ClientId) > 0 {
 		i -= len(m.ClientId)
 		copy(dAtA[i:], m.ClientId)
@@ -2379,7 +2662,7 @@ func (m *QueryClientCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClientCreatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClientParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2389,27 +2672,20 @@ func (m *QueryClientCreatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryClientCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClientParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClientCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClientParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUpgradedClientStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClientParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2419,20 +2695,32 @@ func (m *QueryUpgradedClientStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryUpgradedClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClientParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUpgradedClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClientParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUpgradedClientStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClientCreatorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2442,32 +2730,27 @@ func (m *QueryUpgradedClientStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryUpgradedClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClientCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUpgradedClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClientCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UpgradedClientState != nil {
-		{
-			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUpgradedConsensusStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClientCreatorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2477,21 +2760,109 @@ func (m *QueryUpgradedConsensusStateRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryUpgradedConsensusStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClientCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUpgradedConsensusStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClientCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryUpgradedConsensusStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradedClientStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradedClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradedClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradedClientStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradedClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradedClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradedClientState != nil {
+		{
+			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradedConsensusStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradedConsensusStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradedConsensusStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradedConsensusStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -2846,6 +3217,57 @@ func (m *QueryClientStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryClientsNearingExpiryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientsNearingExpiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for _, e := range m.Clients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ClientExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeRemaining)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryClientParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4361,6 +4783,393 @@ func (m *QueryClientStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryClientsNearingExpiryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientsNearingExpiryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientsNearingExpiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientsNearingExpiryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientsNearingExpiryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientsNearingExpiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clients = append(m.Clients, ClientExpiry{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeRemaining, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryClientParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
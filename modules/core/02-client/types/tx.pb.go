@@ -629,6 +629,189 @@ func (m *MsgDeleteClientCreatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteClientCreatorResponse proto.InternalMessageInfo
 
+// MsgPruneConsensusStates defines the sdk.Msg type to prune the consensus states
+// of 07-tendermint clients in bounded batches. The expired consensus states of
+// each client are pruned and its consensus state retention policy is enforced.
+type MsgPruneConsensusStates struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// identifier of the client to start pruning from, the first client is used
+	// if empty
+	StartClientId string `protobuf:"bytes,2,opt,name=start_client_id,json=startClientId,proto3" json:"start_client_id,omitempty"`
+	// maximum number of clients visited and of consensus states pruned
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *MsgPruneConsensusStates) Reset()         { *m = MsgPruneConsensusStates{} }
+func (m *MsgPruneConsensusStates) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneConsensusStates) ProtoMessage()    {}
+func (*MsgPruneConsensusStates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{14}
+}
+func (m *MsgPruneConsensusStates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneConsensusStates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneConsensusStates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneConsensusStates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneConsensusStates.Merge(m, src)
+}
+func (m *MsgPruneConsensusStates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneConsensusStates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneConsensusStates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneConsensusStates proto.InternalMessageInfo
+
+// MsgPruneConsensusStatesResponse defines the Msg/PruneConsensusStates response type.
+type MsgPruneConsensusStatesResponse struct {
+	// total number of consensus states pruned
+	TotalPruned uint64 `protobuf:"varint,1,opt,name=total_pruned,json=totalPruned,proto3" json:"total_pruned,omitempty"`
+	// identifier of the client to continue pruning from in a subsequent message,
+	// which is the last client visited if the limit of consensus states pruned was
+	// reached, empty if all clients have been pruned
+	NextClientId string `protobuf:"bytes,2,opt,name=next_client_id,json=nextClientId,proto3" json:"next_client_id,omitempty"`
+}
+
+func (m *MsgPruneConsensusStatesResponse) Reset()         { *m = MsgPruneConsensusStatesResponse{} }
+func (m *MsgPruneConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneConsensusStatesResponse) ProtoMessage()    {}
+func (*MsgPruneConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{15}
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneConsensusStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneConsensusStatesResponse.Merge(m, src)
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneConsensusStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneConsensusStatesResponse proto.InternalMessageInfo
+
+func (m *MsgPruneConsensusStatesResponse) GetTotalPruned() uint64 {
+	if m != nil {
+		return m.TotalPruned
+	}
+	return 0
+}
+
+func (m *MsgPruneConsensusStatesResponse) GetNextClientId() string {
+	if m != nil {
+		return m.NextClientId
+	}
+	return ""
+}
+
+// MsgPruneClient defines the sdk.Msg type to delete the stores of a client which
+// has been expired or frozen for longer than the client prune delay. The client
+// identifier is tombstoned so that it is never reused.
+type MsgPruneClient struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// client unique identifier
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *MsgPruneClient) Reset()         { *m = MsgPruneClient{} }
+func (m *MsgPruneClient) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneClient) ProtoMessage()    {}
+func (*MsgPruneClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{16}
+}
+func (m *MsgPruneClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneClient.Merge(m, src)
+}
+func (m *MsgPruneClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneClient proto.InternalMessageInfo
+
+// MsgPruneClientResponse defines the Msg/PruneClient response type.
+type MsgPruneClientResponse struct {
+}
+
+func (m *MsgPruneClientResponse) Reset()         { *m = MsgPruneClientResponse{} }
+func (m *MsgPruneClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneClientResponse) ProtoMessage()    {}
+func (*MsgPruneClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{17}
+}
+func (m *MsgPruneClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneClientResponse.Merge(m, src)
+}
+func (m *MsgPruneClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneClientResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClient)(nil), "ibc.core.client.v1.MsgCreateClient")
 	proto.RegisterType((*MsgCreateClientResponse)(nil), "ibc.core.client.v1.MsgCreateClientResponse")
@@ -644,65 +827,77 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.client.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgDeleteClientCreator)(nil), "ibc.core.client.v1.MsgDeleteClientCreator")
 	proto.RegisterType((*MsgDeleteClientCreatorResponse)(nil), "ibc.core.client.v1.MsgDeleteClientCreatorResponse")
+	proto.RegisterType((*MsgPruneConsensusStates)(nil), "ibc.core.client.v1.MsgPruneConsensusStates")
+	proto.RegisterType((*MsgPruneConsensusStatesResponse)(nil), "ibc.core.client.v1.MsgPruneConsensusStatesResponse")
+	proto.RegisterType((*MsgPruneClient)(nil), "ibc.core.client.v1.MsgPruneClient")
+	proto.RegisterType((*MsgPruneClientResponse)(nil), "ibc.core.client.v1.MsgPruneClientResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/tx.proto", fileDescriptor_cb5dc4651eb49a04) }
 
 var fileDescriptor_cb5dc4651eb49a04 = []byte{
-	// 841 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x3f, 0x6f, 0xdb, 0x46,
-	0x14, 0x17, 0x65, 0x59, 0xa8, 0xcf, 0xb2, 0x55, 0x9f, 0x65, 0x5b, 0xa6, 0x6b, 0x49, 0x50, 0x3d,
-	0xb8, 0x6a, 0x4d, 0x5a, 0x2a, 0xd0, 0x3f, 0x6e, 0x3b, 0xd8, 0xea, 0x50, 0x0f, 0x02, 0x0c, 0x19,
-	0x5d, 0x3a, 0x54, 0x26, 0xa9, 0x13, 0xcb, 0x56, 0xe4, 0x11, 0x3c, 0x52, 0x8d, 0xb7, 0x20, 0x53,
-	0x90, 0x29, 0x43, 0x3e, 0x40, 0x3e, 0x82, 0x91, 0x2d, 0x4b, 0xb6, 0x00, 0x1e, 0x3d, 0x66, 0x0a,
-	0x02, 0x7b, 0xf0, 0x94, 0xef, 0x10, 0xe8, 0xee, 0x48, 0x93, 0x34, 0xc9, 0x28, 0xc8, 0x22, 0x88,
-	0x7c, 0xbf, 0xf7, 0xde, 0xef, 0xf7, 0xfe, 0x81, 0x60, 0xcb, 0x50, 0x35, 0x59, 0xc3, 0x0e, 0x92,
-	0xb5, 0xb1, 0x81, 0x2c, 0x57, 0x9e, 0xb4, 0x65, 0xf7, 0x81, 0x64, 0x3b, 0xd8, 0xc5, 0x10, 0x1a,
-	0xaa, 0x26, 0x4d, 0x8d, 0x12, 0x33, 0x4a, 0x93, 0xb6, 0xb8, 0xa2, 0x98, 0x86, 0x85, 0x65, 0xfa,
-	0xcb, 0x60, 0xe2, 0x86, 0x86, 0x89, 0x89, 0x89, 0x6c, 0x12, 0x7d, 0xea, 0x6e, 0x12, 0x9d, 0x1b,
-	0x76, 0xb8, 0xc1, 0xb3, 0x75, 0x47, 0x19, 0x22, 0x79, 0xd2, 0x56, 0x91, 0xab, 0xb4, 0xfd, 0x67,
-	0x8e, 0xaa, 0xe8, 0x58, 0xc7, 0xf4, 0xaf, 0x3c, 0xfd, 0xc7, 0xdf, 0x6e, 0xea, 0x18, 0xeb, 0x63,
-	0x24, 0xd3, 0x27, 0xd5, 0x1b, 0xc9, 0x8a, 0x75, 0xce, 0x4d, 0xf5, 0x04, 0xce, 0x9c, 0x20, 0x05,
-	0x34, 0x5f, 0x08, 0xa0, 0xdc, 0x23, 0x7a, 0xd7, 0x41, 0x8a, 0x8b, 0xba, 0xd4, 0x02, 0x7f, 0x04,
-	0x25, 0x86, 0x19, 0x10, 0x57, 0x71, 0x51, 0x55, 0x68, 0x08, 0xbb, 0x8b, 0x9d, 0x8a, 0xc4, 0xd2,
-	0x48, 0x7e, 0x1a, 0xe9, 0xd0, 0x3a, 0xef, 0x2f, 0x32, 0xe4, 0xe9, 0x14, 0x08, 0x7f, 0x03, 0x65,
-	0x0d, 0x5b, 0x04, 0x59, 0xc4, 0x23, 0xdc, 0x37, 0x9f, 0xe1, 0xbb, 0x1c, 0x80, 0x99, 0xfb, 0x3a,
-	0x28, 0x12, 0x43, 0xb7, 0x90, 0x53, 0x9d, 0x6b, 0x08, 0xbb, 0x0b, 0x7d, 0xfe, 0x74, 0x50, 0x7e,
-	0xfc, 0xbc, 0x9e, 0x7b, 0x74, 0x7b, 0xd1, 0xe2, 0x2f, 0x9a, 0xbf, 0x82, 0x8d, 0x18, 0xe7, 0x3e,
-	0x22, 0xf6, 0x34, 0x18, 0xdc, 0x02, 0x0b, 0x9c, 0xbb, 0x31, 0xa4, 0xc4, 0x17, 0xfa, 0x5f, 0xb0,
-	0x17, 0xc7, 0xc3, 0x83, 0xc2, 0x34, 0x50, 0xf3, 0x19, 0x93, 0xfc, 0xa7, 0x3d, 0xbc, 0x93, 0x9c,
-	0xe5, 0x06, 0x7f, 0x01, 0xcb, 0xdc, 0x68, 0x22, 0x42, 0x14, 0x3d, 0x5b, 0xd5, 0x12, 0xc3, 0xf6,
-	0x18, 0x74, 0x76, 0x51, 0x9b, 0x54, 0x54, 0x98, 0x95, 0x2f, 0xaa, 0xf9, 0x3a, 0x0f, 0xbe, 0xa4,
-	0x36, 0x3a, 0x0b, 0xb3, 0x50, 0x8e, 0xb7, 0x30, 0xff, 0x19, 0x2d, 0x9c, 0xfb, 0x84, 0x16, 0xee,
-	0x83, 0x8a, 0xed, 0x60, 0x3c, 0x1a, 0xf0, 0xb9, 0x1d, 0xb0, 0xd8, 0xd5, 0x42, 0x43, 0xd8, 0x2d,
-	0xf5, 0x21, 0xb5, 0x45, 0x65, 0x1c, 0x82, 0xed, 0x98, 0x47, 0x2c, 0xfd, 0x3c, 0x75, 0x15, 0x23,
-	0xae, 0x69, 0x73, 0x53, 0xcc, 0x2e, 0xb1, 0x08, 0xaa, 0xf1, 0x32, 0x06, 0x35, 0x7e, 0x29, 0xd0,
-	0x1a, 0xf7, 0x91, 0x86, 0x27, 0xc8, 0xe1, 0xe4, 0x5a, 0x60, 0x85, 0x78, 0xea, 0xbf, 0x48, 0x73,
-	0x07, 0xf1, 0x5a, 0x97, 0xb9, 0xa1, 0xeb, 0x97, 0x7c, 0x1f, 0x54, 0x88, 0xa7, 0x12, 0xd7, 0x70,
-	0x3d, 0x17, 0x85, 0xe0, 0x79, 0x0a, 0x87, 0x77, 0xb6, 0xc0, 0x23, 0x6d, 0x34, 0xe4, 0x18, 0xef,
-	0x27, 0xb7, 0x17, 0xad, 0x2d, 0x76, 0x1f, 0xf6, 0xc8, 0xf0, 0x3f, 0x39, 0x4e, 0x93, 0xeb, 0x8a,
-	0xbc, 0x0b, 0x74, 0xbd, 0x12, 0xc0, 0x5a, 0x8f, 0xe8, 0xc7, 0x47, 0xdd, 0x53, 0x3c, 0x72, 0xff,
-	0x57, 0x1c, 0xc4, 0xf5, 0xc3, 0x1f, 0x40, 0xc1, 0x1e, 0x2b, 0x16, 0x5f, 0xef, 0xaf, 0x24, 0x96,
-	0x41, 0xf2, 0x2f, 0x0e, 0xbf, 0x40, 0xd2, 0xc9, 0x58, 0xb1, 0x8e, 0x0a, 0x97, 0x6f, 0xeb, 0xb9,
-	0x3e, 0xc5, 0xc3, 0x3f, 0xc0, 0x1a, 0xc7, 0x0c, 0x07, 0x33, 0x0f, 0xd9, 0xaa, 0xef, 0xd2, 0x0d,
-	0x0d, 0x5b, 0x5a, 0x01, 0x16, 0xc3, 0x4d, 0xab, 0x83, 0xed, 0x44, 0xfe, 0x81, 0x42, 0x37, 0xb4,
-	0xce, 0x27, 0x8a, 0xa3, 0x98, 0x24, 0x14, 0x58, 0x08, 0x07, 0x86, 0x3f, 0x81, 0xa2, 0x4d, 0x11,
-	0x9c, 0xab, 0x28, 0xdd, 0x3f, 0xdb, 0x12, 0x8b, 0xc1, 0x25, 0x73, 0x7c, 0xf6, 0xba, 0x32, 0x8f,
-	0x80, 0xd0, 0xdf, 0x60, 0xbd, 0x47, 0xf4, 0xdf, 0xd1, 0x18, 0xf9, 0xcd, 0xa6, 0xa7, 0x0a, 0x3b,
-	0xd9, 0x3b, 0x7b, 0x47, 0x3a, 0x9f, 0x3d, 0xc6, 0x0d, 0x50, 0x4b, 0x8e, 0xef, 0x33, 0xe8, 0xbc,
-	0x9f, 0x07, 0x73, 0x3d, 0xa2, 0xc3, 0x33, 0x50, 0x8a, 0x5c, 0xf6, 0xaf, 0x93, 0xf4, 0xc6, 0x4e,
-	0xa9, 0xf8, 0xed, 0x0c, 0xa0, 0xe0, 0xde, 0x9e, 0x81, 0x52, 0xe4, 0x90, 0xa6, 0x65, 0x08, 0x83,
-	0x52, 0x33, 0x24, 0x1d, 0x3f, 0xa8, 0x81, 0xa5, 0xe8, 0xc5, 0xd8, 0x49, 0xf5, 0x0e, 0xa1, 0xc4,
-	0xef, 0x66, 0x41, 0x85, 0x93, 0x44, 0x37, 0x3f, 0x2d, 0x49, 0x04, 0x95, 0x9a, 0x24, 0x71, 0x15,
-	0xa1, 0x03, 0x60, 0xc2, 0x1a, 0x7e, 0x93, 0x12, 0xe3, 0x3e, 0x54, 0x6c, 0xcf, 0x0c, 0x0d, 0x72,
-	0x8e, 0x00, 0x0c, 0x57, 0x95, 0xef, 0x47, 0x76, 0x97, 0x18, 0xe8, 0x23, 0x5d, 0x8a, 0xce, 0x3c,
-	0xf4, 0xc0, 0x6a, 0xd2, 0xc0, 0xb7, 0x52, 0x62, 0x24, 0x60, 0xc5, 0xce, 0xec, 0x58, 0x3f, 0xad,
-	0x38, 0xff, 0xf0, 0xf6, 0xa2, 0x25, 0x1c, 0x9d, 0x5e, 0x5e, 0xd7, 0x84, 0xab, 0xeb, 0x9a, 0xf0,
-	0xee, 0xba, 0x26, 0x3c, 0xbd, 0xa9, 0xe5, 0xae, 0x6e, 0x6a, 0xb9, 0x37, 0x37, 0xb5, 0xdc, 0x5f,
-	0x3f, 0xeb, 0x86, 0xfb, 0x8f, 0xa7, 0x4a, 0x1a, 0x36, 0x65, 0xfe, 0x89, 0x65, 0xa8, 0xda, 0x9e,
-	0x8e, 0xe5, 0x49, 0xbb, 0x2d, 0x9b, 0x78, 0xe8, 0x8d, 0x11, 0x61, 0x5f, 0x48, 0xfb, 0x9d, 0x3d,
-	0xfe, 0x91, 0xe4, 0x9e, 0xdb, 0x88, 0xa8, 0x45, 0x7a, 0xc0, 0xbe, 0xff, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0xe6, 0x29, 0xc2, 0xfe, 0xf8, 0x09, 0x00, 0x00,
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xd3, 0xb4, 0xd0, 0x97, 0xb4, 0x61, 0xbd, 0xd9, 0x36, 0xeb, 0xb2, 0x49, 0x09, 0x15,
+	0x2a, 0xe9, 0xd6, 0x6e, 0xba, 0x12, 0x7f, 0x16, 0x38, 0x6c, 0xc3, 0x81, 0x3d, 0x44, 0xaa, 0x52,
+	0xc1, 0x01, 0x09, 0xb2, 0x8e, 0x33, 0x35, 0x5e, 0x62, 0x8f, 0xe5, 0x19, 0x87, 0xf6, 0xb6, 0xe2,
+	0x84, 0x38, 0x71, 0xe0, 0x03, 0xf0, 0x11, 0x2a, 0x6e, 0x5c, 0xb8, 0x81, 0xf6, 0xb8, 0x47, 0x4e,
+	0x08, 0xb5, 0x87, 0x7e, 0x0d, 0x94, 0x99, 0xb1, 0x6b, 0x3b, 0xb6, 0x71, 0xc5, 0x25, 0xca, 0xcc,
+	0xfb, 0xbd, 0xf7, 0x7e, 0xef, 0xef, 0x18, 0xb6, 0xac, 0xb1, 0xa1, 0x19, 0xd8, 0x43, 0x9a, 0x31,
+	0xb5, 0x90, 0x43, 0xb5, 0x59, 0x4f, 0xa3, 0x67, 0xaa, 0xeb, 0x61, 0x8a, 0x65, 0xd9, 0x1a, 0x1b,
+	0xea, 0x5c, 0xa8, 0x72, 0xa1, 0x3a, 0xeb, 0x29, 0x77, 0x74, 0xdb, 0x72, 0xb0, 0xc6, 0x7e, 0x39,
+	0x4c, 0xd9, 0x34, 0x30, 0xb1, 0x31, 0xd1, 0x6c, 0x62, 0xce, 0xd5, 0x6d, 0x62, 0x0a, 0xc1, 0x8e,
+	0x10, 0xf8, 0xae, 0xe9, 0xe9, 0x13, 0xa4, 0xcd, 0x7a, 0x63, 0x44, 0xf5, 0x5e, 0x70, 0x16, 0xa8,
+	0x86, 0x89, 0x4d, 0xcc, 0xfe, 0x6a, 0xf3, 0x7f, 0xe2, 0xf6, 0xbe, 0x89, 0xb1, 0x39, 0x45, 0x1a,
+	0x3b, 0x8d, 0xfd, 0x53, 0x4d, 0x77, 0xce, 0x85, 0xa8, 0x9d, 0xc2, 0x59, 0x10, 0x64, 0x80, 0xce,
+	0xaf, 0x12, 0xd4, 0x07, 0xc4, 0xec, 0x7b, 0x48, 0xa7, 0xa8, 0xcf, 0x24, 0xf2, 0xfb, 0x50, 0xe3,
+	0x98, 0x11, 0xa1, 0x3a, 0x45, 0x4d, 0x69, 0x5b, 0xda, 0xad, 0x1e, 0x36, 0x54, 0xee, 0x46, 0x0d,
+	0xdc, 0xa8, 0x4f, 0x9c, 0xf3, 0x61, 0x95, 0x23, 0x4f, 0xe6, 0x40, 0xf9, 0x13, 0xa8, 0x1b, 0xd8,
+	0x21, 0xc8, 0x21, 0x3e, 0x11, 0xba, 0xe5, 0x1c, 0xdd, 0xf5, 0x10, 0xcc, 0xd5, 0x37, 0x60, 0x85,
+	0x58, 0xa6, 0x83, 0xbc, 0xe6, 0xd2, 0xb6, 0xb4, 0xbb, 0x3a, 0x14, 0xa7, 0xc7, 0xf5, 0x1f, 0x7e,
+	0x69, 0x97, 0xbe, 0xbf, 0xbe, 0xe8, 0x8a, 0x8b, 0xce, 0xc7, 0xb0, 0x99, 0xe0, 0x3c, 0x44, 0xc4,
+	0x9d, 0x1b, 0x93, 0xb7, 0x60, 0x55, 0x70, 0xb7, 0x26, 0x8c, 0xf8, 0xea, 0xf0, 0x75, 0x7e, 0xf1,
+	0x74, 0xf2, 0xb8, 0x32, 0x37, 0xd4, 0xf9, 0x99, 0x87, 0xfc, 0xb9, 0x3b, 0xb9, 0x09, 0x39, 0x4f,
+	0x4d, 0xfe, 0x08, 0xd6, 0x85, 0xd0, 0x46, 0x84, 0xe8, 0x66, 0x7e, 0x54, 0x6b, 0x1c, 0x3b, 0xe0,
+	0xd0, 0xe2, 0x41, 0xdd, 0x87, 0xcd, 0x04, 0xab, 0x20, 0xa8, 0xce, 0x1f, 0x65, 0x78, 0x83, 0xc9,
+	0x58, 0x2f, 0x14, 0xa1, 0x9c, 0x2c, 0x61, 0xf9, 0x7f, 0x94, 0x70, 0xe9, 0x16, 0x25, 0x3c, 0x80,
+	0x86, 0xeb, 0x61, 0x7c, 0x3a, 0x12, 0x7d, 0x3b, 0xe2, 0xb6, 0x9b, 0x95, 0x6d, 0x69, 0xb7, 0x36,
+	0x94, 0x99, 0x2c, 0x1e, 0xc6, 0x13, 0x78, 0x90, 0xd0, 0x48, 0xb8, 0x5f, 0x66, 0xaa, 0x4a, 0x4c,
+	0x35, 0xab, 0x6f, 0x56, 0xf2, 0x53, 0xac, 0x40, 0x33, 0x99, 0xc6, 0x30, 0xc7, 0xbf, 0x49, 0x2c,
+	0xc7, 0x43, 0x64, 0xe0, 0x19, 0xf2, 0x04, 0xb9, 0x2e, 0xdc, 0x21, 0xfe, 0xf8, 0x39, 0x32, 0xe8,
+	0x28, 0x99, 0xeb, 0xba, 0x10, 0xf4, 0x83, 0x94, 0x1f, 0x40, 0x83, 0xf8, 0x63, 0x42, 0x2d, 0xea,
+	0x53, 0x14, 0x81, 0x97, 0x19, 0x5c, 0xbe, 0x91, 0x85, 0x1a, 0x59, 0xad, 0xa1, 0x25, 0x78, 0xff,
+	0x78, 0x7d, 0xd1, 0xdd, 0xe2, 0xfb, 0x61, 0x9f, 0x4c, 0xbe, 0xd5, 0x92, 0x34, 0x45, 0x5c, 0xb1,
+	0xbb, 0x30, 0xae, 0xdf, 0x25, 0xb8, 0x37, 0x20, 0xe6, 0xd3, 0xa3, 0xfe, 0x09, 0x3e, 0xa5, 0xdf,
+	0xe9, 0x1e, 0x12, 0xf1, 0xcb, 0xef, 0x41, 0xc5, 0x9d, 0xea, 0x8e, 0x18, 0xef, 0x37, 0x55, 0xee,
+	0x41, 0x0d, 0x36, 0x8e, 0xd8, 0x40, 0xea, 0xf1, 0x54, 0x77, 0x8e, 0x2a, 0x2f, 0xff, 0x6e, 0x97,
+	0x86, 0x0c, 0x2f, 0x7f, 0x06, 0xf7, 0x04, 0x66, 0x32, 0x2a, 0xdc, 0x64, 0x77, 0x03, 0x95, 0x7e,
+	0xa4, 0xd9, 0xb2, 0x12, 0x50, 0x8d, 0x16, 0xad, 0x0d, 0x0f, 0x52, 0xf9, 0x87, 0x11, 0xd2, 0xc8,
+	0x38, 0x1f, 0xeb, 0x9e, 0x6e, 0x93, 0x88, 0x61, 0x29, 0x6a, 0x58, 0xfe, 0x00, 0x56, 0x5c, 0x86,
+	0x10, 0x5c, 0x15, 0x75, 0x71, 0x6d, 0xab, 0xdc, 0x86, 0x08, 0x59, 0xe0, 0xf3, 0xc7, 0x95, 0x6b,
+	0x84, 0x84, 0xbe, 0x86, 0x8d, 0x01, 0x31, 0x3f, 0x45, 0x53, 0x14, 0x14, 0x9b, 0xad, 0x2a, 0xec,
+	0xe5, 0xcf, 0xec, 0x0d, 0xe9, 0x72, 0x7e, 0x1b, 0x6f, 0x43, 0x2b, 0xdd, 0x7e, 0xc8, 0xe0, 0x85,
+	0xc4, 0xd8, 0x1d, 0x7b, 0xbe, 0x93, 0x18, 0x96, 0xec, 0xdc, 0xbc, 0x03, 0x75, 0x42, 0x75, 0x8f,
+	0x2e, 0xb4, 0xee, 0x1a, 0xbb, 0x0e, 0xbb, 0xb6, 0x01, 0xcb, 0x53, 0xcb, 0xb6, 0x28, 0xab, 0x59,
+	0x65, 0xc8, 0x0f, 0x8b, 0x24, 0x9f, 0x43, 0x3b, 0x83, 0x41, 0xb8, 0xab, 0xdf, 0x82, 0x1a, 0xc5,
+	0x54, 0x9f, 0x8e, 0xdc, 0x39, 0x8a, 0x27, 0xa4, 0x32, 0xac, 0xb2, 0x3b, 0xa6, 0x38, 0x91, 0x77,
+	0x60, 0xdd, 0x41, 0x67, 0x8b, 0x9c, 0x6a, 0xf3, 0xdb, 0x80, 0x52, 0xe7, 0x0b, 0x58, 0x0f, 0x7d,
+	0xf1, 0xc1, 0xcd, 0x0a, 0x32, 0x56, 0x80, 0x72, 0xe2, 0x79, 0x58, 0x88, 0xa1, 0x09, 0x1b, 0x71,
+	0xbb, 0x01, 0xf5, 0xc3, 0x3f, 0x5f, 0x83, 0xa5, 0x01, 0x31, 0xe5, 0x67, 0x50, 0x8b, 0x3d, 0x9d,
+	0x6f, 0xa7, 0x35, 0x54, 0xe2, 0xad, 0x52, 0xf6, 0x0a, 0x80, 0xc2, 0x24, 0x3d, 0x83, 0x5a, 0xec,
+	0xa5, 0xca, 0xf2, 0x10, 0x05, 0x29, 0x7b, 0x05, 0x40, 0xa1, 0x07, 0x03, 0xd6, 0xe2, 0x2b, 0x79,
+	0x27, 0x53, 0x3b, 0x82, 0x52, 0x1e, 0x16, 0x41, 0x45, 0x9d, 0xc4, 0x57, 0x6b, 0x96, 0x93, 0x18,
+	0x4a, 0x79, 0x58, 0x04, 0x15, 0x3a, 0xf1, 0x40, 0x4e, 0xd9, 0x73, 0xef, 0x66, 0xd8, 0x58, 0x84,
+	0x2a, 0xbd, 0xc2, 0xd0, 0xd0, 0xe7, 0x29, 0xc8, 0xd1, 0xac, 0x8a, 0x05, 0x94, 0x5f, 0x25, 0x0e,
+	0x52, 0xf6, 0x0a, 0x80, 0x42, 0x3f, 0x3e, 0xdc, 0x4d, 0xdb, 0x28, 0xdd, 0x0c, 0x1b, 0x29, 0x58,
+	0xe5, 0xb0, 0x38, 0x36, 0x74, 0x7b, 0x06, 0x8d, 0xd4, 0x2d, 0x92, 0xc5, 0x3d, 0x0d, 0xac, 0x3c,
+	0xba, 0x05, 0x38, 0xf4, 0xfc, 0x15, 0x54, 0xa3, 0x13, 0xdd, 0xc9, 0xb5, 0xc1, 0xbb, 0xa5, 0xfb,
+	0xdf, 0x98, 0xc0, 0xbc, 0xb2, 0xfc, 0xe2, 0xfa, 0xa2, 0x2b, 0x1d, 0x9d, 0xbc, 0xbc, 0x6c, 0x49,
+	0xaf, 0x2e, 0x5b, 0xd2, 0x3f, 0x97, 0x2d, 0xe9, 0xa7, 0xab, 0x56, 0xe9, 0xd5, 0x55, 0xab, 0xf4,
+	0xd7, 0x55, 0xab, 0xf4, 0xe5, 0x87, 0xa6, 0x45, 0xbf, 0xf1, 0xc7, 0xaa, 0x81, 0x6d, 0x4d, 0x7c,
+	0x9c, 0x5b, 0x63, 0x63, 0xdf, 0xc4, 0xda, 0xac, 0xd7, 0xd3, 0x6c, 0x3c, 0xf1, 0xa7, 0x88, 0xf0,
+	0x6f, 0xeb, 0x83, 0xc3, 0x7d, 0xf1, 0x79, 0x4d, 0xcf, 0x5d, 0x44, 0xc6, 0x2b, 0xec, 0xe9, 0x7b,
+	0xf4, 0xef, 0x00, 0xcc, 0x50, 0x19, 0xf6, 0x32, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -731,6 +926,10 @@ type MsgClient interface {
 	UpdateClientParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// DeleteClientCreator defines a rpc handler method for MsgDeleteClientCreator.
 	DeleteClientCreator(ctx context.Context, in *MsgDeleteClientCreator, opts ...grpc.CallOption) (*MsgDeleteClientCreatorResponse, error)
+	// PruneConsensusStates defines a rpc handler method for MsgPruneConsensusStates.
+	PruneConsensusStates(ctx context.Context, in *MsgPruneConsensusStates, opts ...grpc.CallOption) (*MsgPruneConsensusStatesResponse, error)
+	// PruneClient defines a rpc handler method for MsgPruneClient.
+	PruneClient(ctx context.Context, in *MsgPruneClient, opts ...grpc.CallOption) (*MsgPruneClientResponse, error)
 }
 
 type msgClient struct {
@@ -804,6 +1003,24 @@ func (c *msgClient) DeleteClientCreator(ctx context.Context, in *MsgDeleteClient
 	return out, nil
 }
 
+func (c *msgClient) PruneConsensusStates(ctx context.Context, in *MsgPruneConsensusStates, opts ...grpc.CallOption) (*MsgPruneConsensusStatesResponse, error) {
+	out := new(MsgPruneConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/PruneConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PruneClient(ctx context.Context, in *MsgPruneClient, opts ...grpc.CallOption) (*MsgPruneClientResponse, error) {
+	out := new(MsgPruneClientResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/PruneClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClient defines a rpc handler method for MsgCreateClient.
@@ -820,6 +1037,10 @@ type MsgServer interface {
 	UpdateClientParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// DeleteClientCreator defines a rpc handler method for MsgDeleteClientCreator.
 	DeleteClientCreator(context.Context, *MsgDeleteClientCreator) (*MsgDeleteClientCreatorResponse, error)
+	// PruneConsensusStates defines a rpc handler method for MsgPruneConsensusStates.
+	PruneConsensusStates(context.Context, *MsgPruneConsensusStates) (*MsgPruneConsensusStatesResponse, error)
+	// PruneClient defines a rpc handler method for MsgPruneClient.
+	PruneClient(context.Context, *MsgPruneClient) (*MsgPruneClientResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -847,6 +1068,12 @@ func (*UnimplementedMsgServer) UpdateClientParams(ctx context.Context, req *MsgU
 func (*UnimplementedMsgServer) DeleteClientCreator(ctx context.Context, req *MsgDeleteClientCreator) (*MsgDeleteClientCreatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientCreator not implemented")
 }
+func (*UnimplementedMsgServer) PruneConsensusStates(ctx context.Context, req *MsgPruneConsensusStates) (*MsgPruneConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneConsensusStates not implemented")
+}
+func (*UnimplementedMsgServer) PruneClient(ctx context.Context, req *MsgPruneClient) (*MsgPruneClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneClient not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -978,6 +1205,42 @@ func _Msg_DeleteClientCreator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PruneConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneConsensusStates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/PruneConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneConsensusStates(ctx, req.(*MsgPruneConsensusStates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/PruneClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneClient(ctx, req.(*MsgPruneClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.client.v1.Msg",
@@ -1011,6 +1274,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteClientCreator",
 			Handler:    _Msg_DeleteClientCreator_Handler,
 		},
+		{
+			MethodName: "PruneConsensusStates",
+			Handler:    _Msg_PruneConsensusStates_Handler,
+		},
+		{
+			MethodName: "PruneClient",
+			Handler:    _Msg_PruneClient_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/client/v1/tx.proto",
@@ -1535,6 +1806,143 @@ func (m *MsgDeleteClientCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPruneConsensusStates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneConsensusStates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneConsensusStates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StartClientId) > 0 {
+		i -= len(m.StartClientId)
+		copy(dAtA[i:], m.StartClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StartClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextClientId) > 0 {
+		i -= len(m.NextClientId)
+		copy(dAtA[i:], m.NextClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NextClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TotalPruned != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalPruned))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1760,9 +2168,71 @@ func (m *MsgDeleteClientCreatorResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *MsgPruneConsensusStates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StartClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgPruneConsensusStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalPruned != 0 {
+		n += 1 + sovTx(uint64(m.TotalPruned))
+	}
+	l = len(m.NextClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -3232,6 +3702,404 @@ func (m *MsgDeleteClientCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPruneConsensusStates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneConsensusStates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneConsensusStates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneConsensusStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneConsensusStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPruned", wireType)
+			}
+			m.TotalPruned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPruned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
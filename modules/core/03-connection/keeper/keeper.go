@@ -99,6 +99,14 @@ func (k *Keeper) SetConnection(ctx sdk.Context, connectionID string, connection
 	}
 }
 
+// DeleteConnection deletes the connection with the given identifier from the store
+func (k *Keeper) DeleteConnection(ctx sdk.Context, connectionID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(host.ConnectionKey(connectionID)); err != nil {
+		panic(err)
+	}
+}
+
 // GetClientConnectionPaths returns all the connection paths stored under a
 // particular client
 func (k *Keeper) GetClientConnectionPaths(ctx sdk.Context, clientID string) ([]string, bool) {
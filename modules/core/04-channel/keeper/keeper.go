@@ -105,13 +105,45 @@ func (k *Keeper) GetChannel(ctx sdk.Context, portID, channelID string) (types.Ch
 	return channel, true
 }
 
-// SetChannel sets a channel to the store
+// SetChannel sets a channel to the store, indexed by the first connection hop of the channel end
 func (k *Keeper) SetChannel(ctx sdk.Context, portID, channelID string, channel types.Channel) {
 	store := k.storeService.OpenKVStore(ctx)
 	bz := k.cdc.MustMarshal(&channel)
 	if err := store.Set(host.ChannelKey(portID, channelID), bz); err != nil {
 		panic(err)
 	}
+
+	if len(channel.ConnectionHops) > 0 {
+		k.setConnectionChannel(ctx, channel.ConnectionHops[0], portID, channelID)
+	}
+}
+
+// DeleteChannel deletes a channel end from the store along with its connection index entry. The caller is
+// responsible for ensuring that the channel is closed and has no packets in flight.
+func (k *Keeper) DeleteChannel(ctx sdk.Context, portID, channelID string) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(host.ChannelKey(portID, channelID)); err != nil {
+		panic(err)
+	}
+
+	if len(channel.ConnectionHops) > 0 {
+		if err := store.Delete(types.ConnectionChannelKey(channel.ConnectionHops[0], portID, channelID)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// setConnectionChannel indexes a channel by the first connection hop of its channel end
+func (k *Keeper) setConnectionChannel(ctx sdk.Context, connectionID, portID, channelID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.ConnectionChannelKey(connectionID, portID, channelID), []byte{byte(1)}); err != nil {
+		panic(err)
+	}
 }
 
 // GetAppVersion gets the version for the specified channel.
@@ -486,6 +518,27 @@ func (k *Keeper) IterateChannels(ctx sdk.Context, cb func(types.IdentifiedChanne
 	}
 }
 
+// IterateConnectionChannels provides an iterator over the channels whose channel end has the given connection as first
+// connection hop, using the connection index of the channels. If the cb returns true, the iterator will close and stop.
+func (k *Keeper) IterateConnectionChannels(ctx sdk.Context, connectionID string, cb func(types.IdentifiedChannel) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	keyPrefix := types.ConnectionChannelsPrefixKey(connectionID)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID := host.MustParseChannelPath(string(iterator.Key()[len(keyPrefix):]))
+		channel, found := k.GetChannel(ctx, portID, channelID)
+		if !found {
+			continue
+		}
+
+		if cb(types.NewIdentifiedChannel(portID, channelID, channel)) {
+			break
+		}
+	}
+}
+
 // GetAllChannelsWithPortPrefix returns all channels with the specified port prefix. If an empty prefix is provided
 // all channels will be returned.
 func (k *Keeper) GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []types.IdentifiedChannel {
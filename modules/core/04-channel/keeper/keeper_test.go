@@ -71,6 +71,42 @@ func (s *KeeperTestSuite) TestSetChannel() {
 	s.Equal(expectedCounterparty, storedChannel.Counterparty)
 }
 
+// TestIterateConnectionChannels verifies that only the channels of the given connection are iterated, and that
+// deleted channels are removed from the connection index.
+func (s *KeeperTestSuite) TestIterateConnectionChannels() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.Setup()
+
+	// second channel on a different connection
+	path2 := ibctesting.NewPath(s.chainA, s.chainC)
+	path2.Setup()
+
+	ctx := s.chainA.GetContext()
+	channelKeeper := s.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	var channels []types.IdentifiedChannel
+	channelKeeper.IterateConnectionChannels(ctx, path.EndpointA.ConnectionID, func(channel types.IdentifiedChannel) bool {
+		channels = append(channels, channel)
+		return false
+	})
+
+	expChannel := types.NewIdentifiedChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointA.GetChannel())
+	s.Require().Equal([]types.IdentifiedChannel{expChannel}, channels)
+
+	channelKeeper.DeleteChannel(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	s.Require().False(channelKeeper.HasChannel(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+	channels = nil
+	channelKeeper.IterateConnectionChannels(ctx, path.EndpointA.ConnectionID, func(channel types.IdentifiedChannel) bool {
+		channels = append(channels, channel)
+		return false
+	})
+	s.Require().Empty(channels)
+
+	// the channel of the other connection is unaffected
+	s.Require().True(channelKeeper.HasChannel(ctx, path2.EndpointA.ChannelConfig.PortID, path2.EndpointA.ChannelID))
+}
+
 func (s *KeeperTestSuite) TestGetAppVersion() {
 	// create client and connections on both chains
 	path := ibctesting.NewPath(s.chainA, s.chainB)
@@ -27,3 +27,16 @@ func NewMigrator(keeper *Keeper) Migrator {
 func (m *Migrator) Migrate7To8(ctx sdk.Context) error {
 	return v10.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc, m.keeper)
 }
+
+// MigrateConnectionChannels indexes the existing channels by the first connection hop of their channel ends, such that
+// the channels of a connection are iterated without iterating over all channels.
+func (m *Migrator) MigrateConnectionChannels(ctx sdk.Context) error {
+	// collect the channels before indexing them, as the store cannot be written to while iterating
+	for _, channel := range m.keeper.GetAllChannels(ctx) {
+		if len(channel.ConnectionHops) > 0 {
+			m.keeper.setConnectionChannel(ctx, channel.ConnectionHops[0], channel.PortId, channel.ChannelId)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v11/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v11/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func (s *KeeperTestSuite) TestMigrateConnectionChannels() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.Setup()
+
+	ctx := s.chainA.GetContext()
+	channelKeeper := s.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	// channels stored before they were indexed by connection
+	store := ctx.KVStore(s.chainA.GetSimApp().GetKey(ibcexported.StoreKey))
+	store.Delete(types.ConnectionChannelKey(path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+	m := keeper.NewMigrator(channelKeeper)
+	err := m.MigrateConnectionChannels(ctx)
+	s.Require().NoError(err)
+
+	var channels []types.IdentifiedChannel
+	channelKeeper.IterateConnectionChannels(ctx, path.EndpointA.ConnectionID, func(channel types.IdentifiedChannel) bool {
+		channels = append(channels, channel)
+		return false
+	})
+
+	expChannel := types.NewIdentifiedChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointA.GetChannel())
+	s.Require().Equal([]types.IdentifiedChannel{expChannel}, channels)
+}
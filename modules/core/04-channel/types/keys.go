@@ -30,6 +30,10 @@ const (
 
 	// ChannelPrefix is the prefix used when creating a channel identifier
 	ChannelPrefix = "channel-"
+
+	// KeyConnectionChannelsPrefix is the key prefix under which channels are indexed by the first connection hop of
+	// their channel end
+	KeyConnectionChannelsPrefix = "connectionChannels"
 )
 
 // FormatChannelIdentifier returns the channel identifier with the sequence appended.
@@ -67,3 +71,14 @@ func ParseChannelSequence(channelID string) (uint64, error) {
 func FilteredPortPrefix(portPrefix string) []byte {
 	return fmt.Appendf(nil, "%s/%s/%s", host.KeyChannelEndPrefix, host.KeyPortPrefix, portPrefix)
 }
+
+// ConnectionChannelsPrefixKey returns the prefix of the keys under which the channels of the given connection are indexed
+func ConnectionChannelsPrefixKey(connectionID string) []byte {
+	return fmt.Appendf(nil, "%s/%s/", KeyConnectionChannelsPrefix, connectionID)
+}
+
+// ConnectionChannelKey returns the key under which a channel is indexed by the first connection hop of its channel end,
+// suffixed by the key of the channel end
+func ConnectionChannelKey(connectionID, portID, channelID string) []byte {
+	return append(ConnectionChannelsPrefixKey(connectionID), host.ChannelKey(portID, channelID)...)
+}
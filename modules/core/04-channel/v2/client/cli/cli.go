@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 		getCmdQueryNextSequenceSend(),
 		getCmdQueryPacketCommitment(),
 		getCmdQueryPacketCommitments(),
+		getCmdQueryPacketCommitmentsWithProof(),
 		getCmdQueryPacketAcknowledgement(),
 		getCmdQueryPacketReceipt(),
 		getCmdQueryUnreceivedPackets(),
@@ -117,6 +117,48 @@ func getCmdQueryPacketCommitment() *cobra.Command {
 	return cmd
 }
 
+func getCmdQueryPacketCommitmentsWithProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-commitments-with-proof [client-id]",
+		Short: "Query channel/v2 packet commitments with a single batch proof",
+		Long:  "Query the channel/v2 packet commitments of a list of sequences, proven together by a single batch proof",
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-commitments-with-proof [client-id] --sequences=1,2,3", version.AppName, exported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			seqSlice, err := cmd.Flags().GetInt64Slice(flagSequences)
+			if err != nil {
+				return err
+			}
+
+			seqs := make([]uint64, len(seqSlice))
+			for i := range seqSlice {
+				seqs[i] = uint64(seqSlice[i])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PacketCommitmentsWithProof(cmd.Context(), types.NewQueryPacketCommitmentsWithProofRequest(clientID, seqs))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64Slice(flagSequences, []int64{}, "comma separated list of packet sequence numbers")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func getCmdQueryPacketCommitments() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "packet-commitments [client-id]",
@@ -4,6 +4,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -12,13 +14,18 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+
 	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 	hostv2 "github.com/cosmos/ibc-go/v11/modules/core/24-host/v2"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
 )
 
 var _ types.QueryServer = (*queryServer)(nil)
@@ -120,6 +127,106 @@ func (q *queryServer) PacketCommitments(goCtx context.Context, req *types.QueryP
 	}, nil
 }
 
+// PacketCommitmentsWithProof implements the Query/PacketCommitmentsWithProof gRPC method. The commitments of all
+// requested sequences must exist, and at most MaxPacketCommitmentsWithProof sequences may be requested. The commitments are read from the committed IBC store at the height of the query
+// and proven together by a single merkle proof whose lowest proof is a compressed ICS-23 batch proof.
+func (q *queryServer) PacketCommitmentsWithProof(goCtx context.Context, req *types.QueryPacketCommitmentsWithProofRequest) (*types.QueryPacketCommitmentsWithProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.Sequences) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequences cannot be empty")
+	}
+
+	if len(req.Sequences) > types.MaxPacketCommitmentsWithProof {
+		return nil, status.Errorf(codes.InvalidArgument, "number of packet sequences %d exceeds the maximum of %d", len(req.Sequences), types.MaxPacketCommitmentsWithProof)
+	}
+
+	seen := make(map[uint64]struct{}, len(req.Sequences))
+	keys := make([][]byte, len(req.Sequences))
+	for i, seq := range req.Sequences {
+		if seq == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "packet sequence %d cannot be 0", i)
+		}
+
+		if _, ok := seen[seq]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate packet sequence %d", seq)
+		}
+		seen[seq] = struct{}{}
+
+		keys[i] = hostv2.PacketCommitmentKey(req.ClientId, seq)
+	}
+
+	if q.storeQuerier == nil {
+		return nil, status.Error(codes.Unimplemented, "packet commitment proofs are not supported: store querier is not set")
+	}
+
+	// the query context is created at the version of the committed store which is queried
+	version := ctx.BlockHeight()
+	values, proof, err := q.queryBatchProof(version, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	commitments := make([]types.PacketState, len(req.Sequences))
+	for i, value := range values {
+		if len(value) == 0 {
+			return nil, status.Errorf(codes.NotFound, "packet commitment hash not found for sequence %d", req.Sequences[i])
+		}
+
+		commitments[i] = types.NewPacketState(req.ClientId, req.Sequences[i], value)
+	}
+
+	// the state root of the store version is committed to in the header of the next block
+	proofHeight := clienttypes.NewHeight(clienttypes.ParseChainID(ctx.ChainID()), uint64(version)+1)
+	return types.NewQueryPacketCommitmentsWithProofResponse(commitments, proof, proofHeight), nil
+}
+
+// queryBatchProof reads the values of the given keys from the IBC store at the provided committed version and
+// combines their proofs into a single proto encoded merkle proof. The proof can only be verified if all keys exist.
+func (q *queryServer) queryBatchProof(version int64, keys [][]byte) ([][]byte, []byte, error) {
+	values := make([][]byte, len(keys))
+	proofOps := make([]*crypto.ProofOps, len(keys))
+	for i, key := range keys {
+		res, err := q.storeQuerier.Query(&storetypes.RequestQuery{
+			Path:   fmt.Sprintf("/%s/key", exported.StoreKey),
+			Data:   key,
+			Height: version,
+			Prove:  true,
+		})
+		if err != nil {
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+
+		values[i] = res.Value
+		proofOps[i] = res.ProofOps
+	}
+
+	if slices.ContainsFunc(values, func(value []byte) bool { return len(value) == 0 }) {
+		// missing keys are reported by the caller and cannot be proven by a batch existence proof
+		return values, nil, nil
+	}
+
+	merkleProof, err := commitmenttypes.ConvertBatchProofs(proofOps)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	proof, err := q.cdc.Marshal(&merkleProof)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return values, proof, nil
+}
+
 // PacketAcknowledgement implements the Query/PacketAcknowledgement gRPC method.
 func (q *queryServer) PacketAcknowledgement(goCtx context.Context, req *types.QueryPacketAcknowledgementRequest) (*types.QueryPacketAcknowledgementResponse, error) {
 	if req == nil {
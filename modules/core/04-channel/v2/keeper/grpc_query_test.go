@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/keeper"
 	"github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
+	hostv2 "github.com/cosmos/ibc-go/v11/modules/core/24-host/v2"
 	ibctesting "github.com/cosmos/ibc-go/v11/testing"
 )
 
@@ -209,6 +210,124 @@ func (s *KeeperTestSuite) TestQueryPacketCommitments() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryPacketCommitmentsWithProof() {
+	var (
+		req            *types.QueryPacketCommitmentsWithProofRequest
+		expCommitments []types.PacketState
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				expCommitments = []types.PacketState{
+					types.NewPacketState(ibctesting.FirstClientID, 3, []byte("commitmentHash3")),
+					types.NewPacketState(ibctesting.FirstClientID, 1, []byte("commitmentHash1")),
+				}
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid client ID",
+			func() {
+				req.ClientId = ""
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"empty sequences",
+			func() {
+				req.Sequences = nil
+			},
+			status.Error(codes.InvalidArgument, "packet sequences cannot be empty"),
+		},
+		{
+			"too many sequences",
+			func() {
+				req.Sequences = make([]uint64, types.MaxPacketCommitmentsWithProof+1)
+				for i := range req.Sequences {
+					req.Sequences[i] = uint64(i + 1)
+				}
+			},
+			status.Errorf(codes.InvalidArgument, "number of packet sequences %d exceeds the maximum of %d", types.MaxPacketCommitmentsWithProof+1, types.MaxPacketCommitmentsWithProof),
+		},
+		{
+			"invalid sequence",
+			func() {
+				req.Sequences = []uint64{1, 0}
+			},
+			status.Error(codes.InvalidArgument, "packet sequence 1 cannot be 0"),
+		},
+		{
+			"duplicate sequence",
+			func() {
+				req.Sequences = []uint64{1, 1}
+			},
+			status.Error(codes.InvalidArgument, "duplicate packet sequence 1"),
+		},
+		{
+			"commitment not found",
+			func() {
+				req.Sequences = []uint64{1, 2}
+			},
+			status.Error(codes.NotFound, "packet commitment hash not found for sequence 2"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			s.SetupTest() // reset
+
+			path := ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupV2()
+
+			for _, seq := range []uint64{1, 3} {
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetPacketCommitment(s.chainA.GetContext(), path.EndpointA.ClientID, seq, fmt.Appendf(nil, "commitmentHash%d", seq))
+			}
+
+			// commit the packet commitments so that they can be proven
+			s.coordinator.CommitBlock(s.chainA)
+
+			req = types.NewQueryPacketCommitmentsWithProofRequest(path.EndpointA.ClientID, []uint64{3, 1})
+
+			tc.malleate()
+
+			// the query context of a gRPC query is at the height of the queried store version
+			version := s.chainA.App.LastBlockHeight()
+			ctx := s.chainA.GetContext().WithBlockHeight(version)
+
+			queryServer := keeper.NewQueryServer(s.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2)
+			res, err := queryServer.PacketCommitmentsWithProof(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().Equal(expCommitments, res.Commitments)
+
+				keys := [][]byte{hostv2.PacketCommitmentKey(path.EndpointA.ClientID, 3), hostv2.PacketCommitmentKey(path.EndpointA.ClientID, 1)}
+				expProof, expProofHeight := s.chainA.QueryBatchProofAtHeight(keys, version+1)
+				s.Require().Equal(expProof, res.Proof)
+				s.Require().Equal(expProofHeight, res.ProofHeight)
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+				s.Require().Nil(res)
+			}
+		})
+	}
+}
+
 // TestQueryPacketCommitmentsWithSlashByteSequences verifies that the
 // PacketCommitments pagination query correctly handles sequence numbers whose
 // big-endian encoding contains the byte 0x2F (ASCII "/"). Before the fix, the
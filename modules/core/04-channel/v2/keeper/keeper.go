@@ -34,6 +34,10 @@ type Keeper struct {
 	// Router is used to route messages to the appropriate module callbacks
 	// NOTE: it must be explicitly set before usage.
 	Router *api.Router
+
+	// storeQuerier is used to prove the committed state of the IBC store in queries.
+	// NOTE: it must be explicitly set for the proofs of PacketCommitmentsWithProof.
+	storeQuerier storetypes.Queryable
 }
 
 // NewKeeper creates a new channel v2 keeper
@@ -53,6 +57,12 @@ func NewKeeper(
 	}
 }
 
+// SetStoreQuerier sets the querier of the committed multistore, typically the root multistore of the app,
+// which is used to prove the results of queries such as PacketCommitmentsWithProof.
+func (k *Keeper) SetStoreQuerier(storeQuerier storetypes.Queryable) {
+	k.storeQuerier = storeQuerier
+}
+
 // Logger returns a module-specific logger.
 func (*Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+exported.ModuleName+"/"+types.SubModuleName)
@@ -144,6 +154,43 @@ func (k *Keeper) DeletePacketCommitment(ctx sdk.Context, clientID string, sequen
 	}
 }
 
+// HasInflightPackets returns true if there are packet commitments stored for the specified
+// client, and false otherwise.
+func (k *Keeper) HasInflightPackets(ctx sdk.Context, clientID string) bool {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, hostv2.PacketCommitmentPrefixKey(clientID))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	return iterator.Valid()
+}
+
+// DeletePacketStateForClient deletes the packet receipts, acknowledgements, async packets and next send sequence
+// stored for the specified client. It is used when pruning a client without packets in flight.
+func (k *Keeper) DeletePacketStateForClient(ctx sdk.Context, clientID string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	for _, prefixFn := range []prefixKeyConstructor{
+		hostv2.PacketReceiptPrefixKey,
+		hostv2.PacketAcknowledgementPrefixKey,
+		types.AsyncPacketPrefixKey,
+	} {
+		// collect the keys before deleting them, as the store cannot be written to while iterating
+		iterator := storetypes.KVStorePrefixIterator(store, prefixFn(clientID))
+
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	store.Delete(hostv2.NextSequenceSendKey(clientID))
+}
+
 // GetNextSequenceSend returns the next send sequence from the sequence path
 func (k *Keeper) GetNextSequenceSend(ctx sdk.Context, clientID string) (uint64, bool) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -80,74 +80,10 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *types.MsgRecvPacket) (*t
 		return nil, errorsmod.Wrap(err, "receive packet verification failed")
 	}
 
-	// build up the recv results for each application callback.
-	ack := types.Acknowledgement{
-		AppAcknowledgements: [][]byte{},
-	}
-
-	var isAsync bool
-	isSuccess := true
-	for _, pd := range msg.Packet.Payloads {
-		cb := k.Router.Route(pd.DestinationPort)
-		res := cb.OnRecvPacket(cacheCtx, msg.Packet.SourceClient, msg.Packet.DestinationClient, msg.Packet.Sequence, pd, signer)
-
-		if res.Status == types.PacketStatus_Failure {
-			isSuccess = false
-			// construct acknowledgement with single app acknowledgement that is the sentinel error acknowledgement
-			ack = types.Acknowledgement{
-				AppAcknowledgements: [][]byte{types.ErrorAcknowledgement[:]},
-			}
-			// Modify events in cached context to reflect unsuccessful acknowledgement
-			ctx.EventManager().EmitEvents(internalerrors.ConvertToErrorEvents(cacheCtx.EventManager().Events()))
-			break
-		}
-
-		// successful app acknowledgement cannot equal sentinel error acknowledgement
-		if bytes.Equal(res.GetAcknowledgement(), types.ErrorAcknowledgement[:]) {
-			return nil, errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "application acknowledgement cannot be sentinel error acknowledgement")
-		}
-		// append app acknowledgement to the overall acknowledgement
-		ack.AppAcknowledgements = append(ack.AppAcknowledgements, res.Acknowledgement)
-
-		if res.Status == types.PacketStatus_Async {
-			// Set packet acknowledgement to async if any of the acknowledgements are async.
-			isAsync = true
-			// Return error if there is more than 1 payload
-			// TODO: Handle case where there are multiple payloads
-			if len(msg.Packet.Payloads) > 1 {
-				return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "packet with multiple payloads cannot have async acknowledgement")
-			}
-		}
-	}
-
-	// write application state changes for asynchronous and successful acknowledgements
-	// if any application returns a failure, then we discard all state changes
-	// to ensure an atomic execution of all payloads
-	if isSuccess {
-		writeFn()
-	}
-
-	if !isAsync {
-		// sanity check to ensure returned acknowledgement and calculated isSuccess boolean matches
-		if ack.Success() != isSuccess {
-			panic("acknowledgement success does not match isSuccess")
-		}
-
-		// Set packet acknowledgement only if the acknowledgement is not async.
-		// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
-		// acknowledgement is async.
-		if err := k.writeAcknowledgement(ctx, msg.Packet, ack); err != nil {
-			return nil, err
-		}
-	} else {
-		// store the packet temporarily until the application returns an acknowledgement
-		k.SetAsyncPacket(ctx, msg.Packet.DestinationClient, msg.Packet.Sequence, msg.Packet)
+	if err := k.recvPacketCallbacks(ctx, cacheCtx, writeFn, msg.Packet, signer); err != nil {
+		return nil, err
 	}
 
-	// TODO: store the packet for async applications to access if required.
-	defer telemetry.ReportRecvPacket(msg.Packet)
-
-	ctx.Logger().Info("receive packet callback succeeded", "source-client", msg.Packet.SourceClient, "dest-client", msg.Packet.DestinationClient, "result", types.SUCCESS.String())
 	return &types.MsgRecvPacketResponse{Result: types.SUCCESS}, nil
 }
 
@@ -180,27 +116,10 @@ func (k *Keeper) Acknowledgement(goCtx context.Context, msg *types.MsgAcknowledg
 		return nil, errorsmod.Wrap(err, "acknowledge packet verification failed")
 	}
 
-	recvSuccess := !bytes.Equal(msg.Acknowledgement.AppAcknowledgements[0], types.ErrorAcknowledgement[:])
-	for i, pd := range msg.Packet.Payloads {
-		cbs := k.Router.Route(pd.SourcePort)
-		var ack []byte
-		// if recv was successful, each payload should have its own acknowledgement so we send each individual acknowledgment to the application
-		// otherwise, the acknowledgement only contains the sentinel error acknowledgement which we send to the application. The application is responsible
-		// for knowing that this is an error acknowledgement and executing the appropriate logic.
-		if recvSuccess {
-			ack = msg.Acknowledgement.AppAcknowledgements[i]
-		} else {
-			ack = types.ErrorAcknowledgement[:]
-		}
-		err := cbs.OnAcknowledgementPacket(ctx, msg.Packet.SourceClient, msg.Packet.DestinationClient,
-			msg.Packet.Sequence, ack, pd, relayer)
-		if err != nil {
-			return nil, errorsmod.Wrapf(err, "failed OnAcknowledgementPacket for source port %s, source client %s, destination client %s", pd.SourcePort, msg.Packet.SourceClient, msg.Packet.DestinationClient)
-		}
+	if err := k.acknowledgementCallbacks(ctx, msg.Packet, msg.Acknowledgement, relayer); err != nil {
+		return nil, err
 	}
 
-	defer telemetry.ReportAcknowledgePacket(msg.Packet)
-
 	return &types.MsgAcknowledgementResponse{Result: types.SUCCESS}, nil
 }
 
@@ -247,3 +166,202 @@ func (k *Keeper) Timeout(goCtx context.Context, timeout *types.MsgTimeout) (*typ
 
 	return &types.MsgTimeoutResponse{Result: types.SUCCESS}, nil
 }
+
+// RecvPackets implements the PacketMsgServer RecvPackets method.
+func (k *Keeper) RecvPackets(goCtx context.Context, msg *types.MsgRecvPackets) (*types.MsgRecvPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "error", errorsmod.Wrap(err, "invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "invalid address for msg Signer")
+	}
+
+	if len(msg.Packets) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacket, "packets can not be empty")
+	}
+
+	// check if this client is allowed to update if v2 config are set
+	destinationClient := msg.Packets[0].DestinationClient
+	config := k.clientV2Keeper.GetConfig(ctx, destinationClient)
+	if !config.IsAllowedRelayer(signer) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "relayer %s is not authorized to update client %s", msg.Signer, destinationClient)
+	}
+
+	// Perform TAO verification of all packets with a single combined proof
+	//
+	// Packets which were already received are skipped
+	// Use a cached context to prevent accidental state changes
+	cacheCtx, writeFn := ctx.CacheContext()
+	received, err := k.recvPackets(cacheCtx, msg.Packets, msg.ProofCommitments, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "dest-client", destinationClient, "error", errorsmod.Wrap(err, "receive packets verification failed"))
+		return nil, errorsmod.Wrap(err, "receive packets verification failed")
+	}
+	writeFn()
+
+	results := make([]types.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		if !received[i] {
+			ctx.Logger().Debug("no-op on redundant relay", "source-client", packet.SourceClient, "sequence", packet.Sequence)
+			results[i] = types.NOOP
+			continue
+		}
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		if err := k.recvPacketCallbacks(ctx, cacheCtx, writeFn, packet, signer); err != nil {
+			return nil, err
+		}
+
+		results[i] = types.SUCCESS
+	}
+
+	return &types.MsgRecvPacketsResponse{Results: results}, nil
+}
+
+// Acknowledgements implements the PacketMsgServer Acknowledgements method.
+func (k *Keeper) Acknowledgements(goCtx context.Context, msg *types.MsgAcknowledgements) (*types.MsgAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	if len(msg.Packets) == 0 || len(msg.Packets) != len(msg.Acknowledgements) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "number of acknowledgements %d does not match non-zero number of packets %d", len(msg.Acknowledgements), len(msg.Packets))
+	}
+
+	// check if this client is allowed to update if v2 config are set
+	sourceClient := msg.Packets[0].SourceClient
+	config := k.clientV2Keeper.GetConfig(ctx, sourceClient)
+	if !config.IsAllowedRelayer(relayer) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "relayer %s is not authorized to update client %s", msg.Signer, sourceClient)
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	acknowledged, err := k.acknowledgePackets(cacheCtx, msg.Packets, msg.Acknowledgements, msg.ProofAcked, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "source-client", sourceClient, "error", errorsmod.Wrap(err, "acknowledge packets verification failed"))
+		return nil, errorsmod.Wrap(err, "acknowledge packets verification failed")
+	}
+	writeFn()
+
+	results := make([]types.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		if !acknowledged[i] {
+			ctx.Logger().Debug("no-op on redundant relay", "source-client", packet.SourceClient, "sequence", packet.Sequence)
+			results[i] = types.NOOP
+			continue
+		}
+
+		if err := k.acknowledgementCallbacks(ctx, packet, msg.Acknowledgements[i], relayer); err != nil {
+			return nil, err
+		}
+
+		results[i] = types.SUCCESS
+	}
+
+	return &types.MsgAcknowledgementsResponse{Results: results}, nil
+}
+
+// recvPacketCallbacks executes the application callbacks of a received packet on the cached context and writes its
+// acknowledgement, unless it is acknowledged asynchronously. The application state changes are only written if all
+// applications succeed.
+func (k *Keeper) recvPacketCallbacks(ctx, cacheCtx sdk.Context, writeFn func(), packet types.Packet, signer sdk.AccAddress) error {
+	// build up the recv results for each application callback.
+	ack := types.Acknowledgement{
+		AppAcknowledgements: [][]byte{},
+	}
+
+	var isAsync bool
+	isSuccess := true
+	for _, pd := range packet.Payloads {
+		cb := k.Router.Route(pd.DestinationPort)
+		res := cb.OnRecvPacket(cacheCtx, packet.SourceClient, packet.DestinationClient, packet.Sequence, pd, signer)
+
+		if res.Status == types.PacketStatus_Failure {
+			isSuccess = false
+			// construct acknowledgement with single app acknowledgement that is the sentinel error acknowledgement
+			ack = types.Acknowledgement{
+				AppAcknowledgements: [][]byte{types.ErrorAcknowledgement[:]},
+			}
+			// Modify events in cached context to reflect unsuccessful acknowledgement
+			ctx.EventManager().EmitEvents(internalerrors.ConvertToErrorEvents(cacheCtx.EventManager().Events()))
+			break
+		}
+
+		// successful app acknowledgement cannot equal sentinel error acknowledgement
+		if bytes.Equal(res.GetAcknowledgement(), types.ErrorAcknowledgement[:]) {
+			return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "application acknowledgement cannot be sentinel error acknowledgement")
+		}
+		// append app acknowledgement to the overall acknowledgement
+		ack.AppAcknowledgements = append(ack.AppAcknowledgements, res.Acknowledgement)
+
+		if res.Status == types.PacketStatus_Async {
+			// Set packet acknowledgement to async if any of the acknowledgements are async.
+			isAsync = true
+			// Return error if there is more than 1 payload
+			// TODO: Handle case where there are multiple payloads
+			if len(packet.Payloads) > 1 {
+				return errorsmod.Wrapf(types.ErrInvalidPacket, "packet with multiple payloads cannot have async acknowledgement")
+			}
+		}
+	}
+
+	// write application state changes for asynchronous and successful acknowledgements
+	// if any application returns a failure, then we discard all state changes
+	// to ensure an atomic execution of all payloads
+	if isSuccess {
+		writeFn()
+	}
+
+	if !isAsync {
+		// sanity check to ensure returned acknowledgement and calculated isSuccess boolean matches
+		if ack.Success() != isSuccess {
+			panic("acknowledgement success does not match isSuccess")
+		}
+
+		// Set packet acknowledgement only if the acknowledgement is not async.
+		// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
+		// acknowledgement is async.
+		if err := k.writeAcknowledgement(ctx, packet, ack); err != nil {
+			return err
+		}
+	} else {
+		// store the packet temporarily until the application returns an acknowledgement
+		k.SetAsyncPacket(ctx, packet.DestinationClient, packet.Sequence, packet)
+	}
+
+	// TODO: store the packet for async applications to access if required.
+	defer telemetry.ReportRecvPacket(packet)
+
+	ctx.Logger().Info("receive packet callback succeeded", "source-client", packet.SourceClient, "dest-client", packet.DestinationClient, "result", types.SUCCESS.String())
+	return nil
+}
+
+// acknowledgementCallbacks executes the application callbacks of an acknowledged packet.
+func (k *Keeper) acknowledgementCallbacks(ctx sdk.Context, packet types.Packet, acknowledgement types.Acknowledgement, relayer sdk.AccAddress) error {
+	recvSuccess := !bytes.Equal(acknowledgement.AppAcknowledgements[0], types.ErrorAcknowledgement[:])
+	for i, pd := range packet.Payloads {
+		cbs := k.Router.Route(pd.SourcePort)
+		var ack []byte
+		// if recv was successful, each payload should have its own acknowledgement so we send each individual acknowledgment to the application
+		// otherwise, the acknowledgement only contains the sentinel error acknowledgement which we send to the application. The application is responsible
+		// for knowing that this is an error acknowledgement and executing the appropriate logic.
+		if recvSuccess {
+			ack = acknowledgement.AppAcknowledgements[i]
+		} else {
+			ack = types.ErrorAcknowledgement[:]
+		}
+		err := cbs.OnAcknowledgementPacket(ctx, packet.SourceClient, packet.DestinationClient,
+			packet.Sequence, ack, pd, relayer)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed OnAcknowledgementPacket for source port %s, source client %s, destination client %s", pd.SourcePort, packet.SourceClient, packet.DestinationClient)
+		}
+	}
+
+	defer telemetry.ReportAcknowledgePacket(packet)
+
+	return nil
+}
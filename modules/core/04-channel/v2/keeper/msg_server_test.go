@@ -5,6 +5,7 @@ package keeper_test
 import (
 	"bytes"
 	"errors"
+	"slices"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,6 +14,7 @@ import (
 	clientv2types "github.com/cosmos/ibc-go/v11/modules/core/02-client/v2/types"
 	"github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+	hostv2 "github.com/cosmos/ibc-go/v11/modules/core/24-host/v2"
 	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v11/testing"
 	mockv1 "github.com/cosmos/ibc-go/v11/testing/mock"
@@ -709,3 +711,227 @@ func (s *KeeperTestSuite) TestMsgTimeout() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgRecvPackets() {
+	var (
+		path    *ibctesting.Path
+		packets []types.Packet
+		msg     *types.MsgRecvPackets
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expError   error
+		expResults []types.ResponseResultType
+	}{
+		{
+			name:       "success",
+			malleate:   func() {},
+			expResults: []types.ResponseResultType{types.SUCCESS, types.SUCCESS, types.SUCCESS},
+		},
+		{
+			name: "success: already received packet is a no-op",
+			malleate: func() {
+				s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(s.chainB.GetContext(), packets[1].DestinationClient, packets[1].Sequence)
+			},
+			expResults: []types.ResponseResultType{types.SUCCESS, types.NOOP, types.SUCCESS},
+		},
+		{
+			name: "success: all packets already received",
+			malleate: func() {
+				for _, packet := range packets {
+					s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(s.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+				}
+			},
+			expResults: []types.ResponseResultType{types.NOOP, types.NOOP, types.NOOP},
+		},
+		{
+			name: "failure: relayer not permissioned",
+			malleate: func() {
+				creator := s.chainB.SenderAccount.GetAddress()
+				msg := clientv2types.NewMsgUpdateClientConfig(path.EndpointB.ClientID, creator.String(), clientv2types.NewConfig(s.chainA.SenderAccount.GetAddress().String()))
+				_, err := s.chainB.App.GetIBCKeeper().UpdateClientConfig(s.chainB.GetContext(), msg)
+				s.Require().NoError(err)
+			},
+			expError: ibcerrors.ErrUnauthorized,
+		},
+		{
+			name: "failure: packet commitment is not proven",
+			malleate: func() {
+				msg.Packets[2].Sequence = 10
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: counterparty not found",
+			malleate: func() {
+				msg.Packets[1].DestinationClient = ibctesting.InvalidID
+			},
+			expError: clientv2types.ErrCounterpartyNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupV2()
+
+			timeoutTimestamp := s.chainA.GetTimeoutTimestampSecs()
+
+			packets = nil
+			packetKeys := make([][]byte, 3)
+			for i := range packetKeys {
+				packet, err := path.EndpointA.MsgSendPacket(timeoutTimestamp, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				s.Require().NoError(err)
+
+				packets = append(packets, packet)
+				packetKeys[i] = hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence)
+			}
+
+			proof, proofHeight := s.chainA.QueryBatchProof(packetKeys)
+			msg = types.NewMsgRecvPackets(slices.Clone(packets), proof, proofHeight, s.chainB.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			ctx := s.chainB.GetContext()
+			res, err := s.chainB.App.GetIBCKeeper().ChannelKeeperV2.RecvPackets(ctx, msg)
+			ck := s.chainB.App.GetIBCKeeper().ChannelKeeperV2
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResults, res.Results)
+
+				for i, packet := range packets {
+					s.Require().True(ck.HasPacketReceipt(ctx, packet.DestinationClient, packet.Sequence))
+
+					// acknowledgements are only written for packets received by the message
+					ackWritten := ck.HasPacketAcknowledgement(ctx, packet.DestinationClient, packet.Sequence)
+					s.Require().Equal(tc.expResults[i] == types.SUCCESS, ackWritten)
+				}
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+				s.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgAcknowledgements() {
+	var (
+		path    *ibctesting.Path
+		packets []types.Packet
+		msg     *types.MsgAcknowledgements
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expError   error
+		expResults []types.ResponseResultType
+	}{
+		{
+			name:       "success",
+			malleate:   func() {},
+			expResults: []types.ResponseResultType{types.SUCCESS, types.SUCCESS, types.SUCCESS},
+		},
+		{
+			name: "success: already acknowledged packet is a no-op",
+			malleate: func() {
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.DeletePacketCommitment(s.chainA.GetContext(), packets[0].SourceClient, packets[0].Sequence)
+
+				// Modify the callback to return an error.
+				// This way, we can verify that the callback is not executed in a No-op case.
+				path.EndpointA.Chain.GetSimApp().MockModuleV2A.IBCApp.OnAcknowledgementPacket = func(_ sdk.Context, _, _ string, sequence uint64, _ types.Payload, _ []byte, _ sdk.AccAddress) error {
+					if sequence == packets[0].Sequence {
+						return mockv1.MockApplicationCallbackError
+					}
+					return nil
+				}
+			},
+			expResults: []types.ResponseResultType{types.NOOP, types.SUCCESS, types.SUCCESS},
+		},
+		{
+			name: "failure: relayer not permissioned",
+			malleate: func() {
+				creator := s.chainA.SenderAccount.GetAddress()
+				msg := clientv2types.NewMsgUpdateClientConfig(path.EndpointA.ClientID, creator.String(), clientv2types.NewConfig(s.chainB.SenderAccount.GetAddress().String()))
+				_, err := s.chainA.App.GetIBCKeeper().UpdateClientConfig(s.chainA.GetContext(), msg)
+				s.Require().NoError(err)
+			},
+			expError: ibcerrors.ErrUnauthorized,
+		},
+		{
+			name: "failure: acknowledgement is not proven",
+			malleate: func() {
+				msg.Acknowledgements[2] = types.NewAcknowledgement([]byte("other acknowledgement"))
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: invalid packet commitment",
+			malleate: func() {
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetPacketCommitment(s.chainA.GetContext(), packets[1].SourceClient, packets[1].Sequence, []byte("foo"))
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: callback fails",
+			malleate: func() {
+				path.EndpointA.Chain.GetSimApp().MockModuleV2A.IBCApp.OnAcknowledgementPacket = func(sdk.Context, string, string, uint64, types.Payload, []byte, sdk.AccAddress) error {
+					return mockv1.MockApplicationCallbackError
+				}
+			},
+			expError: mockv1.MockApplicationCallbackError,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupV2()
+
+			timeoutTimestamp := s.chainA.GetTimeoutTimestampSecs()
+
+			packets = nil
+			var acks []types.Acknowledgement
+			ackKeys := make([][]byte, 3)
+			for i := range ackKeys {
+				packet, err := path.EndpointA.MsgSendPacket(timeoutTimestamp, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				s.Require().NoError(err)
+
+				ack, err := path.EndpointB.MsgRecvPacketWithAck(packet)
+				s.Require().NoError(err)
+
+				packets = append(packets, packet)
+				acks = append(acks, ack)
+				ackKeys[i] = hostv2.PacketAcknowledgementKey(packet.DestinationClient, packet.Sequence)
+			}
+
+			proof, proofHeight := s.chainB.QueryBatchProof(ackKeys)
+			msg = types.NewMsgAcknowledgements(slices.Clone(packets), acks, proof, proofHeight, s.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			res, err := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.Acknowledgements(ctx, msg)
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResults, res.Results)
+
+				for _, packet := range packets {
+					commitment := s.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetPacketCommitment(ctx, packet.SourceClient, packet.Sequence)
+					s.Require().Empty(commitment)
+				}
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+				s.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package keeper
 
 import (
 	"bytes"
+	"errors"
 	"strconv"
 	"time"
 
@@ -115,21 +116,108 @@ func (k *Keeper) recvPacket(
 	proof []byte,
 	proofHeight exported.Height,
 ) error {
+	clientID, merklePath, commitment, err := k.validateRecvPacket(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ClientKeeper.VerifyMembership(
+		ctx,
+		clientID,
+		proofHeight,
+		0, 0,
+		proof,
+		merklePath,
+		commitment,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet commitment verification for client (%s)", clientID)
+	}
+
+	k.setPacketReceived(ctx, packet)
+
+	return nil
+}
+
+// recvPackets implements the packet receiving logic of recvPacket for packets sent between the same
+// pair of clients, verifying their packet commitments with a single combined proof. Packets which have
+// already been received are excluded from the verified batch and are reported as not received. An error
+// is returned if any other packet cannot be received.
+func (k *Keeper) recvPackets(
+	ctx sdk.Context,
+	packets []types.Packet,
+	proof []byte,
+	proofHeight exported.Height,
+) ([]bool, error) {
+	var (
+		clientID    string
+		paths       []exported.Path
+		commitments [][]byte
+	)
+
+	received := make([]bool, len(packets))
+	for i, packet := range packets {
+		packetClientID, merklePath, commitment, err := k.validateRecvPacket(ctx, packet)
+		switch {
+		case err == nil:
+		case errors.Is(err, types.ErrNoOpMsg):
+			continue
+		default:
+			return nil, errorsmod.Wrapf(err, "packet at index %d", i)
+		}
+
+		if clientID != "" && packetClientID != clientID {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "packet at index %d is not received on client %s", i, clientID)
+		}
+
+		clientID = packetClientID
+		paths = append(paths, merklePath)
+		commitments = append(commitments, commitment)
+		received[i] = true
+	}
+
+	if len(paths) == 0 {
+		return received, nil
+	}
+
+	if err := k.ClientKeeper.VerifyBatchMembership(
+		ctx,
+		clientID,
+		proofHeight,
+		0, 0,
+		proof,
+		paths,
+		commitments,
+	); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed packet commitments verification for client (%s)", clientID)
+	}
+
+	for i, packet := range packets {
+		if received[i] {
+			k.setPacketReceived(ctx, packet)
+		}
+	}
+
+	return received, nil
+}
+
+// validateRecvPacket checks the packet can be received and returns the client verifying its packet commitment,
+// with the merkle path and value of the commitment. If the packet has already been received a no-op error is returned.
+func (k *Keeper) validateRecvPacket(ctx sdk.Context, packet types.Packet) (string, exported.Path, []byte, error) {
 	// lookup counterparty from packet identifiers
 	// note this will be either the client identifier for IBC V2 paths
 	// or an aliased channel identifier for IBC V1 paths
 	counterparty, ok := k.clientV2Keeper.GetClientCounterparty(ctx, packet.DestinationClient)
 	if !ok {
-		return errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.DestinationClient)
+		return "", nil, nil, errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.DestinationClient)
 	}
 
 	if counterparty.ClientId != packet.SourceClient {
-		return errorsmod.Wrapf(clientv2types.ErrInvalidCounterparty, "counterparty id (%s) does not match packet source id (%s)", counterparty.ClientId, packet.SourceClient)
+		return "", nil, nil, errorsmod.Wrapf(clientv2types.ErrInvalidCounterparty, "counterparty id (%s) does not match packet source id (%s)", counterparty.ClientId, packet.SourceClient)
 	}
 
 	currentTimestamp := uint64(ctx.BlockTime().Unix())
 	if currentTimestamp >= packet.TimeoutTimestamp {
-		return errorsmod.Wrapf(types.ErrTimeoutElapsed, "current timestamp: %d, timeout timestamp: %d", currentTimestamp, packet.TimeoutTimestamp)
+		return "", nil, nil, errorsmod.Wrapf(types.ErrTimeoutElapsed, "current timestamp: %d, timeout timestamp: %d", currentTimestamp, packet.TimeoutTimestamp)
 	}
 
 	// REPLAY PROTECTION: Packet receipts will indicate that a packet has already been received
@@ -139,7 +227,7 @@ func (k *Keeper) recvPacket(
 		// This error indicates that the packet has already been relayed. Core IBC will
 		// treat this error as a no-op in order to prevent an entire relay transaction
 		// from failing and consuming unnecessary fees.
-		return types.ErrNoOpMsg
+		return "", nil, nil, types.ErrNoOpMsg
 	}
 
 	path := hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence)
@@ -153,26 +241,17 @@ func (k *Keeper) recvPacket(
 		clientID = underlyingClientID
 	}
 
-	if err := k.ClientKeeper.VerifyMembership(
-		ctx,
-		clientID,
-		proofHeight,
-		0, 0,
-		proof,
-		merklePath,
-		commitment,
-	); err != nil {
-		return errorsmod.Wrapf(err, "failed packet commitment verification for client (%s)", clientID)
-	}
+	return clientID, merklePath, commitment, nil
+}
 
+// setPacketReceived stores the receipt of a verified packet and emits the events of its receipt.
+func (k *Keeper) setPacketReceived(ctx sdk.Context, packet types.Packet) {
 	// Set Packet Receipt to prevent timeout from occurring on counterparty
 	k.SetPacketReceipt(ctx, packet.DestinationClient, packet.Sequence)
 
 	k.Logger(ctx).Info("packet received", "sequence", strconv.FormatUint(packet.Sequence, 10), "src_client_id", packet.SourceClient, "dst_client_id", packet.DestinationClient)
 
 	emitRecvPacketEvents(ctx, packet)
-
-	return nil
 }
 
 // writeAcknowledgement writes the acknowledgement to the store and emits the packet and acknowledgement
@@ -253,16 +332,105 @@ func (k *Keeper) WriteAcknowledgement(ctx sdk.Context, clientID string, sequence
 }
 
 func (k *Keeper) acknowledgePacket(ctx sdk.Context, packet types.Packet, acknowledgement types.Acknowledgement, proof []byte, proofHeight exported.Height) error {
+	clientID, merklePath, ackCommitment, err := k.validateAcknowledgePacket(ctx, packet, acknowledgement)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ClientKeeper.VerifyMembership(
+		ctx,
+		clientID,
+		proofHeight,
+		0, 0,
+		proof,
+		merklePath,
+		ackCommitment,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet acknowledgement verification for client (%s)", clientID)
+	}
+
+	k.setPacketAcknowledged(ctx, packet)
+
+	return nil
+}
+
+// acknowledgePackets implements the acknowledgement logic of acknowledgePacket for packets sent between the
+// same pair of clients, verifying their acknowledgements with a single combined proof. Packets which have
+// already been acknowledged are excluded from the verified batch and are reported as not acknowledged. An
+// error is returned if any other packet cannot be acknowledged.
+func (k *Keeper) acknowledgePackets(
+	ctx sdk.Context,
+	packets []types.Packet,
+	acknowledgements []types.Acknowledgement,
+	proof []byte,
+	proofHeight exported.Height,
+) ([]bool, error) {
+	var (
+		clientID       string
+		paths          []exported.Path
+		ackCommitments [][]byte
+	)
+
+	acknowledged := make([]bool, len(packets))
+	for i, packet := range packets {
+		packetClientID, merklePath, ackCommitment, err := k.validateAcknowledgePacket(ctx, packet, acknowledgements[i])
+		switch {
+		case err == nil:
+		case errors.Is(err, types.ErrNoOpMsg):
+			continue
+		default:
+			return nil, errorsmod.Wrapf(err, "packet at index %d", i)
+		}
+
+		if clientID != "" && packetClientID != clientID {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "packet at index %d is not sent on client %s", i, clientID)
+		}
+
+		clientID = packetClientID
+		paths = append(paths, merklePath)
+		ackCommitments = append(ackCommitments, ackCommitment)
+		acknowledged[i] = true
+	}
+
+	if len(paths) == 0 {
+		return acknowledged, nil
+	}
+
+	if err := k.ClientKeeper.VerifyBatchMembership(
+		ctx,
+		clientID,
+		proofHeight,
+		0, 0,
+		proof,
+		paths,
+		ackCommitments,
+	); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed packet acknowledgements verification for client (%s)", clientID)
+	}
+
+	for i, packet := range packets {
+		if acknowledged[i] {
+			k.setPacketAcknowledged(ctx, packet)
+		}
+	}
+
+	return acknowledged, nil
+}
+
+// validateAcknowledgePacket checks the packet can be acknowledged and returns the client verifying its acknowledgement,
+// with the merkle path and value of the acknowledgement commitment. If the packet has already been acknowledged a no-op
+// error is returned.
+func (k *Keeper) validateAcknowledgePacket(ctx sdk.Context, packet types.Packet, acknowledgement types.Acknowledgement) (string, exported.Path, []byte, error) {
 	// lookup counterparty from packet identifiers
 	// note this will be either the client identifier for IBC V2 paths
 	// or an aliased channel identifier for IBC V1 paths
 	counterparty, ok := k.clientV2Keeper.GetClientCounterparty(ctx, packet.SourceClient)
 	if !ok {
-		return errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.SourceClient)
+		return "", nil, nil, errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.SourceClient)
 	}
 
 	if counterparty.ClientId != packet.DestinationClient {
-		return errorsmod.Wrapf(clientv2types.ErrInvalidCounterparty, "counterparty id (%s) does not match packet destination id (%s)", counterparty.ClientId, packet.DestinationClient)
+		return "", nil, nil, errorsmod.Wrapf(clientv2types.ErrInvalidCounterparty, "counterparty id (%s) does not match packet destination id (%s)", counterparty.ClientId, packet.DestinationClient)
 	}
 
 	commitment := k.GetPacketCommitment(ctx, packet.SourceClient, packet.Sequence)
@@ -271,14 +439,14 @@ func (k *Keeper) acknowledgePacket(ctx sdk.Context, packet types.Packet, acknowl
 		// or there is a misconfigured relayer attempting to prove an acknowledgement
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
 		// prevent an entire relay transaction from failing and consuming unnecessary fees.
-		return types.ErrNoOpMsg
+		return "", nil, nil, types.ErrNoOpMsg
 	}
 
 	packetCommitment := types.CommitPacket(packet)
 
 	// verify we sent the packet and haven't cleared it out yet
 	if !bytes.Equal(commitment, packetCommitment) {
-		return errorsmod.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
+		return "", nil, nil, errorsmod.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
 	}
 
 	path := hostv2.PacketAcknowledgementKey(packet.DestinationClient, packet.Sequence)
@@ -290,25 +458,17 @@ func (k *Keeper) acknowledgePacket(ctx sdk.Context, packet types.Packet, acknowl
 		clientID = underlyingClientID
 	}
 
-	if err := k.ClientKeeper.VerifyMembership(
-		ctx,
-		clientID,
-		proofHeight,
-		0, 0,
-		proof,
-		merklePath,
-		types.CommitAcknowledgement(acknowledgement),
-	); err != nil {
-		return errorsmod.Wrapf(err, "failed packet acknowledgement verification for client (%s)", clientID)
-	}
+	return clientID, merklePath, types.CommitAcknowledgement(acknowledgement), nil
+}
 
+// setPacketAcknowledged deletes the commitment of a packet whose acknowledgement has been verified and emits the
+// events of its acknowledgement.
+func (k *Keeper) setPacketAcknowledged(ctx sdk.Context, packet types.Packet) {
 	k.DeletePacketCommitment(ctx, packet.SourceClient, packet.Sequence)
 
 	k.Logger(ctx).Info("packet acknowledged", "sequence", strconv.FormatUint(packet.GetSequence(), 10), "src_client_id", packet.GetSourceClient(), "dst_client_id", packet.GetDestinationClient())
 
 	emitAcknowledgePacketEvents(ctx, packet)
-
-	return nil
 }
 
 // timeoutPacket implements the timeout logic required by a packet handler.
@@ -12,6 +12,9 @@ import (
 type ClientKeeper interface {
 	// VerifyMembership retrieves the light client module for the clientID and verifies the proof of the existence of a key-value pair at a specified height.
 	VerifyMembership(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, path exported.Path, value []byte) error
+	// VerifyBatchMembership retrieves the light client module for the clientID and verifies a combined proof of the existence of many
+	// key-value pairs at a specified height.
+	VerifyBatchMembership(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, paths []exported.Path, values [][]byte) error
 	// VerifyNonMembership retrieves the light client module for the clientID and verifies the absence of a given key at a specified height.
 	VerifyNonMembership(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, path exported.Path) error
 	// GetClientStatus returns the status of a client given the client ID
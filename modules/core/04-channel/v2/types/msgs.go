@@ -29,6 +29,12 @@ var (
 
 	_ sdk.Msg              = (*MsgAcknowledgement)(nil)
 	_ sdk.HasValidateBasic = (*MsgAcknowledgement)(nil)
+
+	_ sdk.Msg              = (*MsgRecvPackets)(nil)
+	_ sdk.HasValidateBasic = (*MsgRecvPackets)(nil)
+
+	_ sdk.Msg              = (*MsgAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgAcknowledgements)(nil)
 )
 
 // NewMsgSendPacket creates a new MsgSendPacket instance.
@@ -145,3 +151,88 @@ func (msg *MsgTimeout) ValidateBasic() error {
 
 	return msg.Packet.ValidateBasic()
 }
+
+// NewMsgRecvPackets creates a new MsgRecvPackets instance.
+func NewMsgRecvPackets(packets []Packet, proofCommitments []byte, proofHeight clienttypes.Height, signer string) *MsgRecvPackets {
+	return &MsgRecvPackets{
+		Packets:          packets,
+		ProofCommitments: proofCommitments,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgRecvPackets.
+func (msg *MsgRecvPackets) ValidateBasic() error {
+	if len(msg.ProofCommitments) == 0 {
+		return errorsmod.Wrap(commitmenttypesv1.ErrInvalidProof, "proof commitments can not be empty")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validatePacketBatch(msg.Packets)
+}
+
+// NewMsgAcknowledgements creates a new MsgAcknowledgements instance.
+func NewMsgAcknowledgements(packets []Packet, acknowledgements []Acknowledgement, proofAcked []byte, proofHeight clienttypes.Height, signer string) *MsgAcknowledgements {
+	return &MsgAcknowledgements{
+		Packets:          packets,
+		Acknowledgements: acknowledgements,
+		ProofAcked:       proofAcked,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgAcknowledgements.
+func (msg *MsgAcknowledgements) ValidateBasic() error {
+	if len(msg.ProofAcked) == 0 {
+		return errorsmod.Wrap(commitmenttypesv1.ErrInvalidProof, "cannot submit an empty acknowledgements proof")
+	}
+
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return errorsmod.Wrapf(ErrInvalidAcknowledgement, "number of acknowledgements %d does not match number of packets %d", len(msg.Acknowledgements), len(msg.Packets))
+	}
+
+	for _, ack := range msg.Acknowledgements {
+		if err := ack.Validate(); err != nil {
+			return err
+		}
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validatePacketBatch(msg.Packets)
+}
+
+// validatePacketBatch checks that the batch holds at least one packet, that all packets are valid and sent
+// between the same pair of clients, and that no sequence is repeated.
+func validatePacketBatch(packets []Packet) error {
+	if len(packets) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "packets can not be empty")
+	}
+
+	sequences := make(map[uint64]struct{}, len(packets))
+	for i, packet := range packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet at index %d", i)
+		}
+
+		if packet.SourceClient != packets[0].SourceClient || packet.DestinationClient != packets[0].DestinationClient {
+			return errorsmod.Wrapf(ErrInvalidPacket, "packet at index %d is not sent between clients %s and %s", i, packets[0].SourceClient, packets[0].DestinationClient)
+		}
+
+		if _, found := sequences[packet.Sequence]; found {
+			return errorsmod.Wrapf(ErrInvalidPacket, "duplicate packet sequence %d", packet.Sequence)
+		}
+		sequences[packet.Sequence] = struct{}{}
+	}
+
+	return nil
+}
@@ -382,3 +382,162 @@ func (s *TypesTestSuite) TestMsgTimeoutValidateBasic() {
 		})
 	}
 }
+
+func (s *TypesTestSuite) TestMsgRecvPacketsValidateBasic() {
+	var msg *types.MsgRecvPackets
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: invalid proof commitments",
+			malleate: func() {
+				msg.ProofCommitments = []byte{}
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: empty packets",
+			malleate: func() {
+				msg.Packets = nil
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: invalid packet",
+			malleate: func() {
+				msg.Packets[1].Sequence = 0
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: packets sent between different clients",
+			malleate: func() {
+				msg.Packets[1].SourceClient = ibctesting.FirstClientID
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: duplicate packet sequence",
+			malleate: func() {
+				msg.Packets[1].Sequence = msg.Packets[0].Sequence
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: invalid signer",
+			malleate: func() {
+				msg.Signer = ""
+			},
+			expError: ibcerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg = types.NewMsgRecvPackets(
+				[]types.Packet{
+					types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+					types.NewPacket(2, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+				},
+				testProof,
+				clienttypes.ZeroHeight(),
+				s.chainA.SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TypesTestSuite) TestMsgAcknowledgementsValidateBasic() {
+	var msg *types.MsgAcknowledgements
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: invalid proof of acknowledgements",
+			malleate: func() {
+				msg.ProofAcked = []byte{}
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: number of acknowledgements does not match number of packets",
+			malleate: func() {
+				msg.Acknowledgements = msg.Acknowledgements[:1]
+			},
+			expError: types.ErrInvalidAcknowledgement,
+		},
+		{
+			name: "failure: invalid acknowledgement",
+			malleate: func() {
+				msg.Acknowledgements[1] = types.NewAcknowledgement([]byte(""))
+			},
+			expError: types.ErrInvalidAcknowledgement,
+		},
+		{
+			name: "failure: packets sent between different clients",
+			malleate: func() {
+				msg.Packets[1].DestinationClient = ibctesting.FirstClientID
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: duplicate packet sequence",
+			malleate: func() {
+				msg.Packets[1].Sequence = msg.Packets[0].Sequence
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: invalid signer",
+			malleate: func() {
+				msg.Signer = ""
+			},
+			expError: ibcerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg = types.NewMsgAcknowledgements(
+				[]types.Packet{
+					types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+					types.NewPacket(2, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+				},
+				[]types.Acknowledgement{types.NewAcknowledgement([]byte("appAck1")), types.NewAcknowledgement([]byte("appAck2"))},
+				testProof,
+				clienttypes.ZeroHeight(),
+				s.chainA.SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			expPass := tc.expError == nil
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
@@ -6,6 +6,10 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
 )
 
+// MaxPacketCommitmentsWithProof is the maximum number of packet sequences which may be requested by a single
+// PacketCommitmentsWithProof query.
+const MaxPacketCommitmentsWithProof = 100
+
 // NewQueryNextSequenceSendRequest creates a new next sequence send query.
 func NewQueryNextSequenceSendRequest(clientID string) *QueryNextSequenceSendRequest {
 	return &QueryNextSequenceSendRequest{
@@ -41,6 +45,23 @@ func NewQueryPacketCommitmentResponse(commitmentHash []byte, proof []byte, proof
 	}
 }
 
+// NewQueryPacketCommitmentsWithProofRequest creates and returns a new batch packet commitment query request.
+func NewQueryPacketCommitmentsWithProofRequest(clientID string, sequences []uint64) *QueryPacketCommitmentsWithProofRequest {
+	return &QueryPacketCommitmentsWithProofRequest{
+		ClientId:  clientID,
+		Sequences: sequences,
+	}
+}
+
+// NewQueryPacketCommitmentsWithProofResponse creates and returns a new batch packet commitment query response.
+func NewQueryPacketCommitmentsWithProofResponse(commitments []PacketState, proof []byte, proofHeight clienttypes.Height) *QueryPacketCommitmentsWithProofResponse {
+	return &QueryPacketCommitmentsWithProofResponse{
+		Commitments: commitments,
+		Proof:       proof,
+		ProofHeight: proofHeight,
+	}
+}
+
 // NewQueryPacketAcknowledgementRequest creates and returns a new packet acknowledgement query request.
 func NewQueryPacketAcknowledgementRequest(clientID string, sequence uint64) *QueryPacketAcknowledgementRequest {
 	return &QueryPacketAcknowledgementRequest{
@@ -379,6 +379,129 @@ func (m *QueryPacketCommitmentsResponse) GetHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryPacketCommitmentsWithProofRequest is the request type for the Query/PacketCommitmentsWithProof RPC method.
+type QueryPacketCommitmentsWithProofRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// list of packet sequences, of at most 100 sequences
+	Sequences []uint64 `protobuf:"varint,2,rep,packed,name=sequences,proto3" json:"sequences,omitempty"`
+}
+
+func (m *QueryPacketCommitmentsWithProofRequest) Reset() {
+	*m = QueryPacketCommitmentsWithProofRequest{}
+}
+func (m *QueryPacketCommitmentsWithProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketCommitmentsWithProofRequest) ProtoMessage()    {}
+func (*QueryPacketCommitmentsWithProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a328cba4986edcab, []int{6}
+}
+func (m *QueryPacketCommitmentsWithProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketCommitmentsWithProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketCommitmentsWithProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketCommitmentsWithProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketCommitmentsWithProofRequest.Merge(m, src)
+}
+func (m *QueryPacketCommitmentsWithProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketCommitmentsWithProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketCommitmentsWithProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketCommitmentsWithProofRequest proto.InternalMessageInfo
+
+func (m *QueryPacketCommitmentsWithProofRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryPacketCommitmentsWithProofRequest) GetSequences() []uint64 {
+	if m != nil {
+		return m.Sequences
+	}
+	return nil
+}
+
+// QueryPacketCommitmentsWithProofResponse is the response type for the Query/PacketCommitmentsWithProof RPC method.
+type QueryPacketCommitmentsWithProofResponse struct {
+	// packet commitments of the requested sequences, in the order of the request
+	Commitments []PacketState `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments"`
+	// merkle proof of existence of all commitments, whose lowest proof is a compressed ICS-23 batch proof
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryPacketCommitmentsWithProofResponse) Reset() {
+	*m = QueryPacketCommitmentsWithProofResponse{}
+}
+func (m *QueryPacketCommitmentsWithProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketCommitmentsWithProofResponse) ProtoMessage()    {}
+func (*QueryPacketCommitmentsWithProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a328cba4986edcab, []int{7}
+}
+func (m *QueryPacketCommitmentsWithProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketCommitmentsWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketCommitmentsWithProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketCommitmentsWithProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketCommitmentsWithProofResponse.Merge(m, src)
+}
+func (m *QueryPacketCommitmentsWithProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketCommitmentsWithProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketCommitmentsWithProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketCommitmentsWithProofResponse proto.InternalMessageInfo
+
+func (m *QueryPacketCommitmentsWithProofResponse) GetCommitments() []PacketState {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *QueryPacketCommitmentsWithProofResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryPacketCommitmentsWithProofResponse) GetProofHeight() types.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types.Height{}
+}
+
 // QueryPacketAcknowledgementRequest is the request type for the Query/PacketAcknowledgement RPC method.
 type QueryPacketAcknowledgementRequest struct {
 	// client unique identifier
@@ -391,7 +514,7 @@ func (m *QueryPacketAcknowledgementRequest) Reset()         { *m = QueryPacketAc
 func (m *QueryPacketAcknowledgementRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementRequest) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{6}
+	return fileDescriptor_a328cba4986edcab, []int{8}
 }
 func (m *QueryPacketAcknowledgementRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +571,7 @@ func (m *QueryPacketAcknowledgementResponse) Reset()         { *m = QueryPacketA
 func (m *QueryPacketAcknowledgementResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementResponse) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{7}
+	return fileDescriptor_a328cba4986edcab, []int{9}
 }
 func (m *QueryPacketAcknowledgementResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -513,7 +636,7 @@ func (m *QueryPacketAcknowledgementsRequest) Reset()         { *m = QueryPacketA
 func (m *QueryPacketAcknowledgementsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementsRequest) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{8}
+	return fileDescriptor_a328cba4986edcab, []int{10}
 }
 func (m *QueryPacketAcknowledgementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +700,7 @@ func (m *QueryPacketAcknowledgementsResponse) Reset()         { *m = QueryPacket
 func (m *QueryPacketAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementsResponse) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{9}
+	return fileDescriptor_a328cba4986edcab, []int{11}
 }
 func (m *QueryPacketAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +762,7 @@ func (m *QueryPacketReceiptRequest) Reset()         { *m = QueryPacketReceiptReq
 func (m *QueryPacketReceiptRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketReceiptRequest) ProtoMessage()    {}
 func (*QueryPacketReceiptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{10}
+	return fileDescriptor_a328cba4986edcab, []int{12}
 }
 func (m *QueryPacketReceiptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,7 +819,7 @@ func (m *QueryPacketReceiptResponse) Reset()         { *m = QueryPacketReceiptRe
 func (m *QueryPacketReceiptResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketReceiptResponse) ProtoMessage()    {}
 func (*QueryPacketReceiptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{11}
+	return fileDescriptor_a328cba4986edcab, []int{13}
 }
 func (m *QueryPacketReceiptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -758,7 +881,7 @@ func (m *QueryUnreceivedPacketsRequest) Reset()         { *m = QueryUnreceivedPa
 func (m *QueryUnreceivedPacketsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedPacketsRequest) ProtoMessage()    {}
 func (*QueryUnreceivedPacketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{12}
+	return fileDescriptor_a328cba4986edcab, []int{14}
 }
 func (m *QueryUnreceivedPacketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -813,7 +936,7 @@ func (m *QueryUnreceivedPacketsResponse) Reset()         { *m = QueryUnreceivedP
 func (m *QueryUnreceivedPacketsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedPacketsResponse) ProtoMessage()    {}
 func (*QueryUnreceivedPacketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{13}
+	return fileDescriptor_a328cba4986edcab, []int{15}
 }
 func (m *QueryUnreceivedPacketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -869,7 +992,7 @@ func (m *QueryUnreceivedAcksRequest) Reset()         { *m = QueryUnreceivedAcksR
 func (m *QueryUnreceivedAcksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedAcksRequest) ProtoMessage()    {}
 func (*QueryUnreceivedAcksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{14}
+	return fileDescriptor_a328cba4986edcab, []int{16}
 }
 func (m *QueryUnreceivedAcksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1048,7 @@ func (m *QueryUnreceivedAcksResponse) Reset()         { *m = QueryUnreceivedAcks
 func (m *QueryUnreceivedAcksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedAcksResponse) ProtoMessage()    {}
 func (*QueryUnreceivedAcksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{15}
+	return fileDescriptor_a328cba4986edcab, []int{17}
 }
 func (m *QueryUnreceivedAcksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,6 +1098,8 @@ func init() {
 	proto.RegisterType((*QueryPacketCommitmentResponse)(nil), "ibc.core.channel.v2.QueryPacketCommitmentResponse")
 	proto.RegisterType((*QueryPacketCommitmentsRequest)(nil), "ibc.core.channel.v2.QueryPacketCommitmentsRequest")
 	proto.RegisterType((*QueryPacketCommitmentsResponse)(nil), "ibc.core.channel.v2.QueryPacketCommitmentsResponse")
+	proto.RegisterType((*QueryPacketCommitmentsWithProofRequest)(nil), "ibc.core.channel.v2.QueryPacketCommitmentsWithProofRequest")
+	proto.RegisterType((*QueryPacketCommitmentsWithProofResponse)(nil), "ibc.core.channel.v2.QueryPacketCommitmentsWithProofResponse")
 	proto.RegisterType((*QueryPacketAcknowledgementRequest)(nil), "ibc.core.channel.v2.QueryPacketAcknowledgementRequest")
 	proto.RegisterType((*QueryPacketAcknowledgementResponse)(nil), "ibc.core.channel.v2.QueryPacketAcknowledgementResponse")
 	proto.RegisterType((*QueryPacketAcknowledgementsRequest)(nil), "ibc.core.channel.v2.QueryPacketAcknowledgementsRequest")
@@ -990,72 +1115,76 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v2/query.proto", fileDescriptor_a328cba4986edcab) }
 
 var fileDescriptor_a328cba4986edcab = []byte{
-	// 1033 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0xc4, 0x6e, 0x95, 0xbc, 0x4e, 0x7f, 0xbf, 0x74, 0x08, 0xc8, 0xdd, 0x04, 0xd7, 0x5d,
-	0x24, 0xb0, 0x10, 0xdd, 0x89, 0x5d, 0x04, 0x95, 0xaa, 0x02, 0x49, 0x44, 0x1b, 0x04, 0xaa, 0xca,
-	0x06, 0x54, 0x29, 0xaa, 0x64, 0xad, 0xd7, 0xc3, 0x66, 0xb1, 0xbd, 0xb3, 0xf5, 0xac, 0x4d, 0xaa,
-	0x2a, 0x17, 0xc4, 0x85, 0x1b, 0x52, 0x6f, 0x7c, 0x02, 0xf8, 0x10, 0x20, 0x71, 0x6b, 0x6f, 0x45,
-	0x08, 0x89, 0x13, 0x54, 0x09, 0x12, 0xdf, 0x80, 0x33, 0xf2, 0xcc, 0xd8, 0xde, 0xb5, 0xd7, 0x9b,
-	0xdd, 0xb4, 0x41, 0xdc, 0x66, 0xc7, 0xef, 0x9f, 0xe7, 0x79, 0xe7, 0x79, 0xe7, 0x9d, 0x04, 0x2e,
-	0xba, 0x0d, 0x9b, 0xd8, 0xac, 0x4b, 0x89, 0xbd, 0x67, 0x79, 0x1e, 0x6d, 0x93, 0x7e, 0x8d, 0xdc,
-	0xeb, 0xd1, 0xee, 0x7d, 0xc3, 0xef, 0xb2, 0x80, 0xe1, 0x17, 0xdc, 0x86, 0x6d, 0x0c, 0x0c, 0x0c,
-	0x65, 0x60, 0xf4, 0x6b, 0xda, 0xeb, 0x36, 0xe3, 0x1d, 0xc6, 0x49, 0xc3, 0xe2, 0x54, 0x5a, 0x93,
-	0x7e, 0xb5, 0x41, 0x03, 0xab, 0x4a, 0x7c, 0xcb, 0x71, 0x3d, 0x2b, 0x70, 0x99, 0x27, 0x03, 0x68,
-	0x97, 0xe2, 0x32, 0x38, 0xd4, 0xa3, 0xdc, 0xe5, 0xca, 0x24, 0x04, 0xa2, 0xed, 0x52, 0x2f, 0x20,
-	0xfd, 0xaa, 0x5a, 0x29, 0x83, 0x35, 0x87, 0x31, 0xa7, 0x4d, 0x89, 0xe5, 0xbb, 0xc4, 0xf2, 0x3c,
-	0x16, 0x88, 0x04, 0x43, 0xf7, 0x15, 0x87, 0x39, 0x4c, 0x2c, 0xc9, 0x60, 0x25, 0x77, 0xf5, 0x6b,
-	0xb0, 0xf6, 0xf1, 0x00, 0xd9, 0x2d, 0xba, 0x1f, 0xec, 0xd0, 0x7b, 0x3d, 0xea, 0xd9, 0x74, 0x87,
-	0x7a, 0x4d, 0x73, 0xb0, 0xe6, 0x01, 0x5e, 0x85, 0x45, 0x99, 0xa3, 0xee, 0x36, 0x8b, 0xa8, 0x8c,
-	0x2a, 0x8b, 0xe6, 0x82, 0xdc, 0xf8, 0xa0, 0xa9, 0x7f, 0x87, 0xe0, 0xe5, 0x19, 0xde, 0xdc, 0x67,
-	0x1e, 0xa7, 0xf8, 0x0d, 0xc0, 0x1e, 0xdd, 0x0f, 0xea, 0x5c, 0xfd, 0x58, 0xe7, 0xd4, 0x93, 0x71,
-	0xf2, 0xe6, 0xb2, 0x37, 0xe1, 0x85, 0x57, 0xe0, 0x8c, 0xdf, 0x65, 0xec, 0xb3, 0xe2, 0x7c, 0x19,
-	0x55, 0x96, 0x4c, 0xf9, 0x81, 0xb7, 0x60, 0x49, 0x2c, 0xea, 0x7b, 0xd4, 0x75, 0xf6, 0x82, 0x62,
-	0xae, 0x8c, 0x2a, 0x85, 0x9a, 0x66, 0x8c, 0x4b, 0x2e, 0x8b, 0xd0, 0xaf, 0x1a, 0xdb, 0xc2, 0x62,
-	0x33, 0xff, 0xe8, 0xf7, 0x8b, 0x73, 0x66, 0x41, 0x78, 0xc9, 0x2d, 0xfd, 0x8e, 0xe2, 0x79, 0xdb,
-	0xb2, 0x5b, 0x34, 0xd8, 0x62, 0x9d, 0x8e, 0x1b, 0x74, 0xa8, 0x17, 0xa4, 0xe1, 0x89, 0x35, 0x58,
-	0x18, 0x12, 0x10, 0xd0, 0xf2, 0xe6, 0xe8, 0x5b, 0xff, 0x76, 0x58, 0x83, 0xe9, 0xc8, 0xaa, 0x06,
-	0x25, 0x00, 0x7b, 0xb4, 0x2b, 0x62, 0x2f, 0x99, 0xa1, 0x9d, 0xd3, 0x64, 0xfd, 0xd5, 0x2c, 0x70,
-	0x3c, 0x15, 0xef, 0x1b, 0x00, 0x63, 0xa1, 0x0a, 0x78, 0x85, 0xda, 0xab, 0x86, 0x54, 0xb5, 0x31,
-	0x50, 0xb5, 0x21, 0x7b, 0x40, 0xa9, 0xda, 0xb8, 0x6d, 0x39, 0x54, 0x05, 0x36, 0x43, 0x9e, 0xfa,
-	0x5f, 0x08, 0x4a, 0xb3, 0x60, 0xa8, 0x22, 0x6d, 0x42, 0x61, 0x5c, 0x12, 0x5e, 0x44, 0xe5, 0x5c,
-	0xa5, 0x50, 0x2b, 0x1b, 0x31, 0x6d, 0x65, 0xc8, 0x20, 0x3b, 0x81, 0x15, 0x50, 0x33, 0xec, 0x84,
-	0x6f, 0xc6, 0xc0, 0x7d, 0xed, 0x58, 0xb8, 0x12, 0x40, 0x18, 0x2f, 0xbe, 0x0a, 0x67, 0x33, 0x56,
-	0x5d, 0xd9, 0xeb, 0x77, 0xe1, 0x52, 0x88, 0xe8, 0x86, 0xdd, 0xf2, 0xd8, 0x17, 0x6d, 0xda, 0x74,
-	0xe8, 0x73, 0xd1, 0xda, 0xf7, 0x08, 0xf4, 0xa4, 0xf0, 0xaa, 0x96, 0x15, 0xf8, 0xbf, 0x15, 0xfd,
-	0x49, 0xa9, 0x6e, 0x72, 0xfb, 0x34, 0xa5, 0xf7, 0x38, 0x11, 0xeb, 0xbf, 0xaa, 0x3f, 0xfc, 0x0e,
-	0xac, 0xfa, 0x02, 0x45, 0x7d, 0x2c, 0x97, 0xd1, 0x95, 0xc4, 0x8b, 0xb9, 0x72, 0xae, 0x92, 0x37,
-	0x2f, 0xf8, 0x13, 0xe2, 0x1c, 0x5e, 0x4d, 0x5c, 0xff, 0x1b, 0xc1, 0x2b, 0x89, 0x5c, 0x54, 0xe1,
-	0x3f, 0x82, 0xe5, 0x89, 0x0a, 0xa7, 0x57, 0xf2, 0x94, 0xe7, 0x7f, 0x41, 0xce, 0x9f, 0xc0, 0x85,
-	0x10, 0x6f, 0x93, 0xda, 0xd4, 0xf5, 0x9f, 0x5d, 0xc6, 0x0f, 0x11, 0x68, 0x71, 0x61, 0x55, 0x15,
-	0x35, 0x58, 0xe8, 0x0e, 0xb6, 0xfa, 0xb4, 0x29, 0x5c, 0x17, 0xcc, 0xd1, 0xf7, 0x58, 0xb0, 0xb9,
-	0x24, 0xc1, 0xe6, 0x4f, 0x22, 0xd8, 0x5d, 0x75, 0x55, 0x7e, 0xea, 0x0d, 0xb3, 0x49, 0x78, 0xe9,
-	0xa4, 0xba, 0x06, 0x8b, 0x63, 0x41, 0xcd, 0x0b, 0x41, 0x8d, 0x37, 0xf4, 0x7d, 0x75, 0xff, 0xc5,
-	0xc4, 0x56, 0xa4, 0x23, 0xfe, 0x68, 0xc2, 0x3f, 0x74, 0x82, 0xf3, 0x19, 0x4f, 0xb0, 0xa5, 0x4a,
-	0x3d, 0xce, 0xbc, 0x61, 0xb7, 0xd2, 0x51, 0x5a, 0x87, 0x15, 0xd5, 0x35, 0x96, 0xdd, 0xaa, 0x4f,
-	0xb2, 0xc3, 0xfe, 0xb0, 0x17, 0xc6, 0x7d, 0xd2, 0x83, 0xd5, 0xd8, 0x64, 0xa7, 0xcb, 0xb1, 0xf6,
-	0xf5, 0x39, 0x38, 0x23, 0xf2, 0xe2, 0x1f, 0x11, 0x2c, 0x4f, 0xbe, 0x45, 0x70, 0x35, 0xb6, 0xf7,
-	0x92, 0x5e, 0x3d, 0x5a, 0x2d, 0x8b, 0x8b, 0x64, 0xa7, 0x6f, 0x7d, 0xf9, 0xcb, 0x9f, 0x0f, 0xe7,
-	0xaf, 0xe3, 0x6b, 0x24, 0xee, 0x29, 0x27, 0x29, 0x70, 0xf2, 0x60, 0x54, 0xef, 0x03, 0x32, 0xfd,
-	0x32, 0xc2, 0x8f, 0x11, 0x2c, 0x4f, 0x0e, 0xc9, 0x24, 0x02, 0x33, 0x9e, 0x33, 0x49, 0x04, 0x66,
-	0xbd, 0x53, 0xf4, 0x5b, 0x82, 0xc0, 0x36, 0xbe, 0x91, 0x9a, 0xc0, 0xd4, 0xa5, 0xca, 0xc9, 0x83,
-	0x21, 0x9f, 0x03, 0xfc, 0x13, 0x82, 0xf3, 0x53, 0x03, 0x1f, 0x67, 0x40, 0x36, 0x94, 0xa9, 0x76,
-	0x25, 0x93, 0xcf, 0x89, 0xcf, 0x63, 0x9a, 0x0e, 0xfe, 0x19, 0xc1, 0x8b, 0xb1, 0x97, 0x3e, 0x7e,
-	0xeb, 0x38, 0x4c, 0xf1, 0xc3, 0x5f, 0x7b, 0x3b, 0xb3, 0x9f, 0xe2, 0x73, 0x53, 0xf0, 0xd9, 0xc0,
-	0xef, 0x66, 0xe5, 0x63, 0xd9, 0xad, 0xc8, 0xb9, 0xfc, 0x8a, 0xe0, 0xa5, 0xf8, 0x41, 0x86, 0xb3,
-	0x82, 0x1b, 0x9d, 0xd0, 0xd5, 0xec, 0x8e, 0x8a, 0xd6, 0xb6, 0xa0, 0xb5, 0x89, 0xdf, 0x3b, 0x01,
-	0xad, 0x28, 0xf8, 0x1f, 0x10, 0x9c, 0x8b, 0x4c, 0x14, 0x6c, 0x1c, 0x87, 0x2a, 0x3a, 0xd1, 0x34,
-	0x92, 0xda, 0x5e, 0x81, 0xff, 0x50, 0x80, 0x7f, 0x1f, 0x6f, 0x65, 0x05, 0xdf, 0x95, 0x81, 0x22,
-	0xe7, 0xf2, 0x14, 0xc1, 0xf9, 0xa9, 0x01, 0x91, 0xd4, 0x2f, 0xb3, 0x26, 0x55, 0x52, 0xbf, 0xcc,
-	0x9c, 0x40, 0x7a, 0x43, 0x70, 0xb9, 0x8b, 0x77, 0x9f, 0x4b, 0xfb, 0xf3, 0x03, 0xd2, 0x1b, 0xa5,
-	0xaa, 0xfb, 0x8a, 0xcc, 0x1f, 0x08, 0xfe, 0x17, 0x1d, 0x0e, 0x98, 0xa4, 0xc1, 0x1a, 0x9a, 0x59,
-	0xda, 0x7a, 0x7a, 0x07, 0xc5, 0xec, 0x73, 0xc1, 0xac, 0x89, 0x1b, 0xcf, 0xc4, 0x2c, 0x6e, 0x16,
-	0x46, 0x48, 0x0e, 0xfa, 0x6c, 0xf3, 0xce, 0xa3, 0xc3, 0x12, 0x7a, 0x72, 0x58, 0x42, 0x4f, 0x0f,
-	0x4b, 0xe8, 0x9b, 0xa3, 0xd2, 0xdc, 0x93, 0xa3, 0xd2, 0xdc, 0x6f, 0x47, 0xa5, 0xb9, 0xdd, 0xeb,
-	0x8e, 0x1b, 0xec, 0xf5, 0x1a, 0x86, 0xcd, 0x3a, 0x44, 0xfd, 0x63, 0xc0, 0x6d, 0xd8, 0x97, 0x1d,
-	0x46, 0xfa, 0xd5, 0x2a, 0xe9, 0xb0, 0x66, 0xaf, 0x4d, 0xb9, 0x44, 0xb7, 0xfe, 0xe6, 0xe5, 0x10,
-	0xc0, 0xe0, 0xbe, 0x4f, 0x79, 0xe3, 0xac, 0xf8, 0x7b, 0xfd, 0xca, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xc3, 0x6c, 0xe5, 0x6e, 0x8b, 0x10, 0x00, 0x00,
+	// 1097 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xd1, 0x6e, 0x1b, 0x45,
+	0x14, 0xcd, 0xc4, 0x6e, 0x95, 0x5e, 0x87, 0x92, 0x0e, 0x01, 0xb9, 0x9b, 0xe0, 0xba, 0x46, 0x6a,
+	0x2d, 0x44, 0x77, 0x62, 0x07, 0x41, 0xa5, 0x50, 0x20, 0x89, 0x68, 0x83, 0x40, 0x55, 0xd8, 0x14,
+	0x45, 0x8a, 0x2a, 0x59, 0xeb, 0xf5, 0xb0, 0x5e, 0x6c, 0xef, 0x6c, 0x3d, 0x6b, 0x37, 0x55, 0x95,
+	0x17, 0xc4, 0x07, 0x20, 0xf5, 0x8d, 0x2f, 0x80, 0x8f, 0x00, 0x89, 0xb7, 0xf6, 0x05, 0x15, 0x21,
+	0x24, 0x9e, 0xa0, 0x4a, 0x90, 0xf8, 0x00, 0x24, 0x5e, 0x41, 0x9e, 0x19, 0xdb, 0xeb, 0xf5, 0xda,
+	0xf1, 0xba, 0x09, 0xe2, 0x6d, 0x76, 0x3c, 0xe7, 0xce, 0x39, 0x77, 0xce, 0x9d, 0x3b, 0x32, 0x5c,
+	0x72, 0xca, 0x16, 0xb1, 0x58, 0x93, 0x12, 0xab, 0x6a, 0xba, 0x2e, 0xad, 0x93, 0x76, 0x91, 0xdc,
+	0x6b, 0xd1, 0xe6, 0x03, 0xdd, 0x6b, 0x32, 0x9f, 0xe1, 0x97, 0x9c, 0xb2, 0xa5, 0x77, 0x16, 0xe8,
+	0x6a, 0x81, 0xde, 0x2e, 0x6a, 0xaf, 0x5b, 0x8c, 0x37, 0x18, 0x27, 0x65, 0x93, 0x53, 0xb9, 0x9a,
+	0xb4, 0x0b, 0x65, 0xea, 0x9b, 0x05, 0xe2, 0x99, 0xb6, 0xe3, 0x9a, 0xbe, 0xc3, 0x5c, 0x19, 0x40,
+	0xbb, 0x1c, 0xb5, 0x83, 0x4d, 0x5d, 0xca, 0x1d, 0xae, 0x96, 0x04, 0x48, 0xd4, 0x1d, 0xea, 0xfa,
+	0xa4, 0x5d, 0x50, 0x23, 0xb5, 0x60, 0xd9, 0x66, 0xcc, 0xae, 0x53, 0x62, 0x7a, 0x0e, 0x31, 0x5d,
+	0x97, 0xf9, 0x62, 0x83, 0x2e, 0x7c, 0xd1, 0x66, 0x36, 0x13, 0x43, 0xd2, 0x19, 0xc9, 0xd9, 0xdc,
+	0x1a, 0x2c, 0x7f, 0xd2, 0x61, 0x76, 0x9b, 0xee, 0xfb, 0x3b, 0xf4, 0x5e, 0x8b, 0xba, 0x16, 0xdd,
+	0xa1, 0x6e, 0xc5, 0xe8, 0x8c, 0xb9, 0x8f, 0x97, 0xe0, 0x9c, 0xdc, 0xa3, 0xe4, 0x54, 0xd2, 0x28,
+	0x8b, 0xf2, 0xe7, 0x8c, 0x39, 0x39, 0xf1, 0x61, 0x25, 0xf7, 0x0d, 0x82, 0x57, 0x47, 0xa0, 0xb9,
+	0xc7, 0x5c, 0x4e, 0xf1, 0x1b, 0x80, 0x5d, 0xba, 0xef, 0x97, 0xb8, 0xfa, 0xb1, 0xc4, 0xa9, 0x2b,
+	0xe3, 0x24, 0x8d, 0x05, 0x37, 0x84, 0xc2, 0x8b, 0x70, 0xc6, 0x6b, 0x32, 0xf6, 0x59, 0x7a, 0x36,
+	0x8b, 0xf2, 0xf3, 0x86, 0xfc, 0xc0, 0x9b, 0x30, 0x2f, 0x06, 0xa5, 0x2a, 0x75, 0xec, 0xaa, 0x9f,
+	0x4e, 0x64, 0x51, 0x3e, 0x55, 0xd4, 0xf4, 0x7e, 0xca, 0x65, 0x12, 0xda, 0x05, 0x7d, 0x4b, 0xac,
+	0xd8, 0x48, 0x3e, 0xfe, 0xed, 0xd2, 0x8c, 0x91, 0x12, 0x28, 0x39, 0x95, 0xdb, 0x55, 0x3a, 0xb7,
+	0x4d, 0xab, 0x46, 0xfd, 0x4d, 0xd6, 0x68, 0x38, 0x7e, 0x83, 0xba, 0xfe, 0x24, 0x3a, 0xb1, 0x06,
+	0x73, 0x5d, 0x01, 0x82, 0x5a, 0xd2, 0xe8, 0x7d, 0xe7, 0xbe, 0xee, 0xe6, 0x60, 0x38, 0xb2, 0xca,
+	0x41, 0x06, 0xc0, 0xea, 0xcd, 0x8a, 0xd8, 0xf3, 0x46, 0x60, 0xe6, 0x34, 0x55, 0x7f, 0x39, 0x8a,
+	0x1c, 0x9f, 0x48, 0xf7, 0x4d, 0x80, 0xbe, 0x51, 0x05, 0xbd, 0x54, 0xf1, 0x8a, 0x2e, 0x5d, 0xad,
+	0x77, 0x5c, 0xad, 0xcb, 0x1a, 0x50, 0xae, 0xd6, 0xb7, 0x4d, 0x9b, 0xaa, 0xc0, 0x46, 0x00, 0x99,
+	0xfb, 0x13, 0x41, 0x66, 0x14, 0x0d, 0x95, 0xa4, 0x0d, 0x48, 0xf5, 0x53, 0xc2, 0xd3, 0x28, 0x9b,
+	0xc8, 0xa7, 0x8a, 0x59, 0x3d, 0xa2, 0xac, 0x74, 0x19, 0x64, 0xc7, 0x37, 0x7d, 0x6a, 0x04, 0x41,
+	0xf8, 0x56, 0x04, 0xdd, 0xab, 0xc7, 0xd2, 0x95, 0x04, 0x82, 0x7c, 0xf1, 0x75, 0x38, 0x1b, 0x33,
+	0xeb, 0x6a, 0x7d, 0xce, 0x82, 0x2b, 0xd1, 0x42, 0x77, 0x1d, 0xbf, 0xba, 0xdd, 0x39, 0x99, 0x89,
+	0x12, 0xbf, 0x0c, 0xe7, 0xba, 0x06, 0xe3, 0xe9, 0xd9, 0x6c, 0x22, 0x9f, 0x34, 0xfa, 0x13, 0xb9,
+	0x1f, 0x11, 0x5c, 0x3d, 0x76, 0x17, 0x95, 0xd7, 0xad, 0xa9, 0xf2, 0xda, 0xf5, 0x52, 0x30, 0xbb,
+	0xa7, 0x68, 0xd3, 0xbb, 0x70, 0x39, 0xa0, 0x67, 0xdd, 0xaa, 0xb9, 0xec, 0x7e, 0x9d, 0x56, 0x6c,
+	0x7a, 0x22, 0x15, 0xfa, 0x2d, 0x82, 0xdc, 0xb8, 0xf0, 0x2a, 0x53, 0x79, 0x78, 0xd1, 0x1c, 0xfc,
+	0x49, 0xd5, 0x6a, 0x78, 0xfa, 0x34, 0x33, 0xf1, 0x64, 0x2c, 0xd7, 0xff, 0xb4, 0x6a, 0xf1, 0xbb,
+	0xb0, 0xe4, 0x09, 0x16, 0xa5, 0xbe, 0x0d, 0x4a, 0x7d, 0x5b, 0x26, 0x84, 0x2d, 0x2f, 0x7a, 0x21,
+	0x0f, 0xee, 0xf4, 0x6c, 0xfa, 0x37, 0x82, 0xd7, 0xc6, 0x6a, 0x51, 0x89, 0xff, 0x18, 0x16, 0x42,
+	0x19, 0x9e, 0xbc, 0xfe, 0x87, 0x90, 0xff, 0x87, 0x4b, 0xe0, 0x0e, 0x5c, 0x0c, 0xe8, 0x36, 0xa8,
+	0x45, 0x1d, 0xef, 0xf9, 0x6d, 0xfc, 0x08, 0x81, 0x16, 0x15, 0x56, 0x65, 0x51, 0x83, 0xb9, 0x66,
+	0x67, 0xaa, 0x4d, 0x2b, 0x02, 0x3a, 0x67, 0xf4, 0xbe, 0xfb, 0x86, 0x4d, 0x8c, 0x33, 0x6c, 0x72,
+	0x1a, 0xc3, 0xee, 0xa9, 0x06, 0xf3, 0xa9, 0xdb, 0xdd, 0x4d, 0xd2, 0xe3, 0x27, 0x70, 0xcf, 0xed,
+	0x43, 0x66, 0x54, 0x6c, 0x25, 0x7a, 0x00, 0x8f, 0x42, 0xf8, 0xc0, 0x09, 0xce, 0xc6, 0x3c, 0xc1,
+	0x1a, 0x68, 0xa1, 0x9d, 0xd7, 0xad, 0xda, 0x64, 0x92, 0x56, 0x60, 0x51, 0x55, 0x8d, 0x69, 0xd5,
+	0x4a, 0x61, 0x75, 0xd8, 0xeb, 0xd6, 0x42, 0xbf, 0x4e, 0x5a, 0xb0, 0x14, 0xb9, 0xd9, 0xe9, 0x6a,
+	0x2c, 0xfe, 0x73, 0x1e, 0xce, 0x88, 0x7d, 0xf1, 0xf7, 0x08, 0x16, 0xc2, 0x2f, 0x38, 0x5c, 0x88,
+	0xac, 0xbd, 0x71, 0x6f, 0x45, 0xad, 0x18, 0x07, 0x22, 0xd5, 0xe5, 0x36, 0xbf, 0xf8, 0xf9, 0x8f,
+	0x47, 0xb3, 0x37, 0xf0, 0x1a, 0x89, 0x7a, 0x00, 0x4b, 0x09, 0x9c, 0x3c, 0xec, 0xe5, 0xfb, 0x80,
+	0x0c, 0xbf, 0x27, 0xf1, 0x13, 0x04, 0x0b, 0xe1, 0x5e, 0x38, 0x4e, 0xc0, 0x88, 0x47, 0xa0, 0x56,
+	0x8c, 0x03, 0x51, 0x02, 0x6e, 0x0b, 0x01, 0x5b, 0xf8, 0xe6, 0xc4, 0x02, 0x86, 0x2e, 0x55, 0x4e,
+	0x1e, 0x76, 0xf5, 0x1c, 0xe0, 0x1f, 0x10, 0x5c, 0x18, 0xea, 0xeb, 0x38, 0x06, 0xb3, 0xae, 0x4d,
+	0xb5, 0xd5, 0x58, 0x98, 0xa9, 0xcf, 0x63, 0x58, 0x0e, 0xfe, 0x0b, 0x81, 0x36, 0xfa, 0x6d, 0x82,
+	0xd7, 0x62, 0x10, 0x0b, 0xbf, 0x9b, 0xb4, 0x77, 0xa6, 0x03, 0x2b, 0x79, 0x7b, 0x42, 0xde, 0x1d,
+	0x6c, 0x9c, 0xc8, 0x69, 0xf1, 0x03, 0x72, 0xdf, 0xf1, 0xab, 0x25, 0x79, 0x9f, 0xfe, 0x84, 0xe0,
+	0xe5, 0xc8, 0x56, 0x87, 0xdf, 0x3a, 0x8e, 0x73, 0xf4, 0x93, 0x47, 0x7b, 0x3b, 0x36, 0x4e, 0xc9,
+	0xbc, 0x25, 0x64, 0xae, 0xe3, 0xf7, 0xe2, 0xca, 0x34, 0xad, 0xda, 0x80, 0x1b, 0x7f, 0x41, 0xf0,
+	0x4a, 0x74, 0xfb, 0xc6, 0x71, 0xc9, 0xf5, 0x7c, 0x79, 0x3d, 0x3e, 0x50, 0xc9, 0xda, 0x12, 0xb2,
+	0x36, 0xf0, 0xfb, 0x53, 0xc8, 0x1a, 0x24, 0xff, 0x1d, 0x82, 0x17, 0x06, 0xfa, 0x28, 0xd6, 0x8f,
+	0x63, 0x35, 0xd8, 0xc7, 0x35, 0x32, 0xf1, 0x7a, 0x45, 0xfe, 0x23, 0x41, 0xfe, 0x03, 0xbc, 0x19,
+	0x97, 0x7c, 0x53, 0x06, 0x1a, 0x38, 0x97, 0x67, 0x08, 0x2e, 0x0c, 0xb5, 0xc5, 0x71, 0xb7, 0xc4,
+	0xa8, 0xfe, 0xac, 0xad, 0xc6, 0xc2, 0x28, 0x2d, 0x65, 0xa1, 0xe5, 0x2e, 0xde, 0x3b, 0xa9, 0x32,
+	0x6a, 0xf5, 0xb6, 0x2a, 0x79, 0x4a, 0xcc, 0xef, 0x08, 0xce, 0x0f, 0xb6, 0x44, 0x4c, 0x26, 0xe1,
+	0x1a, 0xe8, 0xd4, 0xda, 0xca, 0xe4, 0x00, 0xa5, 0xec, 0x73, 0xa1, 0xac, 0x82, 0xcb, 0xcf, 0xa5,
+	0x2c, 0xea, 0x05, 0x30, 0x20, 0xb2, 0x53, 0x67, 0x1b, 0xbb, 0x8f, 0x0f, 0x33, 0xe8, 0xe9, 0x61,
+	0x06, 0x3d, 0x3b, 0xcc, 0xa0, 0xaf, 0x8e, 0x32, 0x33, 0x4f, 0x8f, 0x32, 0x33, 0xbf, 0x1e, 0x65,
+	0x66, 0xf6, 0x6e, 0xd8, 0x8e, 0x5f, 0x6d, 0x95, 0x75, 0x8b, 0x35, 0x88, 0xfa, 0x13, 0xc9, 0x29,
+	0x5b, 0xd7, 0x6c, 0x46, 0xda, 0x85, 0x02, 0x69, 0xb0, 0x4a, 0xab, 0x4e, 0xb9, 0x64, 0xb7, 0xf2,
+	0xe6, 0xb5, 0x00, 0x41, 0xff, 0x81, 0x47, 0x79, 0xf9, 0xac, 0xf8, 0x6f, 0x67, 0xf5, 0xdf, 0x01,
+	0x00, 0xd3, 0xeb, 0x5f, 0x33, 0xb7, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1076,6 +1205,9 @@ type QueryClient interface {
 	PacketCommitment(ctx context.Context, in *QueryPacketCommitmentRequest, opts ...grpc.CallOption) (*QueryPacketCommitmentResponse, error)
 	// PacketCommitments queries a stored packet commitment hash.
 	PacketCommitments(ctx context.Context, in *QueryPacketCommitmentsRequest, opts ...grpc.CallOption) (*QueryPacketCommitmentsResponse, error)
+	// PacketCommitmentsWithProof queries the stored packet commitment hashes of a list of sequences, which
+	// are proven together by a single batch proof.
+	PacketCommitmentsWithProof(ctx context.Context, in *QueryPacketCommitmentsWithProofRequest, opts ...grpc.CallOption) (*QueryPacketCommitmentsWithProofResponse, error)
 	// PacketAcknowledgement queries a stored acknowledgement commitment hash.
 	PacketAcknowledgement(ctx context.Context, in *QueryPacketAcknowledgementRequest, opts ...grpc.CallOption) (*QueryPacketAcknowledgementResponse, error)
 	// PacketAcknowledgements returns all packet acknowledgements associated with a channel.
@@ -1123,6 +1255,15 @@ func (c *queryClient) PacketCommitments(ctx context.Context, in *QueryPacketComm
 	return out, nil
 }
 
+func (c *queryClient) PacketCommitmentsWithProof(ctx context.Context, in *QueryPacketCommitmentsWithProofRequest, opts ...grpc.CallOption) (*QueryPacketCommitmentsWithProofResponse, error) {
+	out := new(QueryPacketCommitmentsWithProofResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Query/PacketCommitmentsWithProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) PacketAcknowledgement(ctx context.Context, in *QueryPacketAcknowledgementRequest, opts ...grpc.CallOption) (*QueryPacketAcknowledgementResponse, error) {
 	out := new(QueryPacketAcknowledgementResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Query/PacketAcknowledgement", in, out, opts...)
@@ -1176,6 +1317,9 @@ type QueryServer interface {
 	PacketCommitment(context.Context, *QueryPacketCommitmentRequest) (*QueryPacketCommitmentResponse, error)
 	// PacketCommitments queries a stored packet commitment hash.
 	PacketCommitments(context.Context, *QueryPacketCommitmentsRequest) (*QueryPacketCommitmentsResponse, error)
+	// PacketCommitmentsWithProof queries the stored packet commitment hashes of a list of sequences, which
+	// are proven together by a single batch proof.
+	PacketCommitmentsWithProof(context.Context, *QueryPacketCommitmentsWithProofRequest) (*QueryPacketCommitmentsWithProofResponse, error)
 	// PacketAcknowledgement queries a stored acknowledgement commitment hash.
 	PacketAcknowledgement(context.Context, *QueryPacketAcknowledgementRequest) (*QueryPacketAcknowledgementResponse, error)
 	// PacketAcknowledgements returns all packet acknowledgements associated with a channel.
@@ -1201,6 +1345,9 @@ func (*UnimplementedQueryServer) PacketCommitment(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) PacketCommitments(ctx context.Context, req *QueryPacketCommitmentsRequest) (*QueryPacketCommitmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PacketCommitments not implemented")
 }
+func (*UnimplementedQueryServer) PacketCommitmentsWithProof(ctx context.Context, req *QueryPacketCommitmentsWithProofRequest) (*QueryPacketCommitmentsWithProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketCommitmentsWithProof not implemented")
+}
 func (*UnimplementedQueryServer) PacketAcknowledgement(ctx context.Context, req *QueryPacketAcknowledgementRequest) (*QueryPacketAcknowledgementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PacketAcknowledgement not implemented")
 }
@@ -1275,6 +1422,24 @@ func _Query_PacketCommitments_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PacketCommitmentsWithProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketCommitmentsWithProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketCommitmentsWithProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Query/PacketCommitmentsWithProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketCommitmentsWithProof(ctx, req.(*QueryPacketCommitmentsWithProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_PacketAcknowledgement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPacketAcknowledgementRequest)
 	if err := dec(in); err != nil {
@@ -1382,6 +1547,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PacketCommitments",
 			Handler:    _Query_PacketCommitments_Handler,
 		},
+		{
+			MethodName: "PacketCommitmentsWithProof",
+			Handler:    _Query_PacketCommitmentsWithProof_Handler,
+		},
 		{
 			MethodName: "PacketAcknowledgement",
 			Handler:    _Query_PacketAcknowledgement_Handler,
@@ -1665,6 +1834,108 @@ func (m *QueryPacketCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPacketCommitmentsWithProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketCommitmentsWithProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketCommitmentsWithProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sequences) > 0 {
+		dAtA7 := make([]byte, len(m.Sequences)*10)
+		var j6 int
+		for _, num := range m.Sequences {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintQuery(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketCommitmentsWithProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketCommitmentsWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketCommitmentsWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryPacketAcknowledgementRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1768,20 +2039,20 @@ func (m *QueryPacketAcknowledgementsRequest) MarshalToSizedBuffer(dAtA []byte) (
 	var l int
 	_ = l
 	if len(m.PacketCommitmentSequences) > 0 {
-		dAtA8 := make([]byte, len(m.PacketCommitmentSequences)*10)
-		var j7 int
+		dAtA11 := make([]byte, len(m.PacketCommitmentSequences)*10)
+		var j10 int
 		for _, num := range m.PacketCommitmentSequences {
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j10++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintQuery(dAtA, i, uint64(j7))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintQuery(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1972,20 +2243,20 @@ func (m *QueryUnreceivedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	var l int
 	_ = l
 	if len(m.Sequences) > 0 {
-		dAtA14 := make([]byte, len(m.Sequences)*10)
-		var j13 int
+		dAtA17 := make([]byte, len(m.Sequences)*10)
+		var j16 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j13++
+				j16++
 			}
-			dAtA14[j13] = uint8(num)
-			j13++
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintQuery(dAtA, i, uint64(j13))
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintQuery(dAtA, i, uint64(j16))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2030,20 +2301,20 @@ func (m *QueryUnreceivedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	i--
 	dAtA[i] = 0x12
 	if len(m.Sequences) > 0 {
-		dAtA17 := make([]byte, len(m.Sequences)*10)
-		var j16 int
+		dAtA20 := make([]byte, len(m.Sequences)*10)
+		var j19 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j16++
+				j19++
 			}
-			dAtA17[j16] = uint8(num)
-			j16++
+			dAtA20[j19] = uint8(num)
+			j19++
 		}
-		i -= j16
-		copy(dAtA[i:], dAtA17[:j16])
-		i = encodeVarintQuery(dAtA, i, uint64(j16))
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintQuery(dAtA, i, uint64(j19))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2071,20 +2342,20 @@ func (m *QueryUnreceivedAcksRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	var l int
 	_ = l
 	if len(m.PacketAckSequences) > 0 {
-		dAtA19 := make([]byte, len(m.PacketAckSequences)*10)
-		var j18 int
+		dAtA22 := make([]byte, len(m.PacketAckSequences)*10)
+		var j21 int
 		for _, num := range m.PacketAckSequences {
 			for num >= 1<<7 {
-				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j18++
+				j21++
 			}
-			dAtA19[j18] = uint8(num)
-			j18++
+			dAtA22[j21] = uint8(num)
+			j21++
 		}
-		i -= j18
-		copy(dAtA[i:], dAtA19[:j18])
-		i = encodeVarintQuery(dAtA, i, uint64(j18))
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintQuery(dAtA, i, uint64(j21))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2129,20 +2400,20 @@ func (m *QueryUnreceivedAcksResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	i--
 	dAtA[i] = 0x12
 	if len(m.Sequences) > 0 {
-		dAtA22 := make([]byte, len(m.Sequences)*10)
-		var j21 int
+		dAtA25 := make([]byte, len(m.Sequences)*10)
+		var j24 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j21++
+				j24++
 			}
-			dAtA22[j21] = uint8(num)
-			j21++
+			dAtA25[j24] = uint8(num)
+			j24++
 		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintQuery(dAtA, i, uint64(j21))
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintQuery(dAtA, i, uint64(j24))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2264,7 +2535,7 @@ func (m *QueryPacketCommitmentsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryPacketAcknowledgementRequest) Size() (n int) {
+func (m *QueryPacketCommitmentsWithProofRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2274,21 +2545,62 @@ func (m *QueryPacketAcknowledgementRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if len(m.Sequences) > 0 {
+		l = 0
+		for _, e := range m.Sequences {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
 	}
 	return n
 }
 
-func (m *QueryPacketAcknowledgementResponse) Size() (n int) {
+func (m *QueryPacketCommitmentsWithProofResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Acknowledgement)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPacketAcknowledgementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryPacketAcknowledgementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	l = len(m.Proof)
 	if l > 0 {
@@ -3201,6 +3513,315 @@ func (m *QueryPacketCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPacketCommitmentsWithProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketCommitmentsWithProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketCommitmentsWithProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Sequences = append(m.Sequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Sequences) == 0 {
+					m.Sequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Sequences = append(m.Sequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketCommitmentsWithProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketCommitmentsWithProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketCommitmentsWithProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, PacketState{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryPacketAcknowledgementRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
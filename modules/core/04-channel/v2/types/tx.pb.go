@@ -383,6 +383,169 @@ func (m *MsgAcknowledgementResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAcknowledgementResponse proto.InternalMessageInfo
 
+// MsgRecvPackets receives many incoming IBC packets sent between the same pair of clients, with a single
+// combined proof of their packet commitments.
+type MsgRecvPackets struct {
+	Packets          []Packet     `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	ProofCommitments []byte       `protobuf:"bytes,2,opt,name=proof_commitments,json=proofCommitments,proto3" json:"proof_commitments,omitempty"`
+	ProofHeight      types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer           string       `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgRecvPackets) Reset()         { *m = MsgRecvPackets{} }
+func (m *MsgRecvPackets) String() string { return proto.CompactTextString(m) }
+func (*MsgRecvPackets) ProtoMessage()    {}
+func (*MsgRecvPackets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{8}
+}
+func (m *MsgRecvPackets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecvPackets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecvPackets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecvPackets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecvPackets.Merge(m, src)
+}
+func (m *MsgRecvPackets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecvPackets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecvPackets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecvPackets proto.InternalMessageInfo
+
+// MsgRecvPacketsResponse defines the Msg/RecvPackets response type.
+type MsgRecvPacketsResponse struct {
+	// results of the packets, in the order of the packets of the message
+	Results []ResponseResultType `protobuf:"varint,1,rep,packed,name=results,proto3,enum=ibc.core.channel.v2.ResponseResultType" json:"results,omitempty"`
+}
+
+func (m *MsgRecvPacketsResponse) Reset()         { *m = MsgRecvPacketsResponse{} }
+func (m *MsgRecvPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecvPacketsResponse) ProtoMessage()    {}
+func (*MsgRecvPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{9}
+}
+func (m *MsgRecvPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecvPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecvPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecvPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecvPacketsResponse.Merge(m, src)
+}
+func (m *MsgRecvPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecvPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecvPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecvPacketsResponse proto.InternalMessageInfo
+
+// MsgAcknowledgements receives many incoming IBC acknowledgements of packets sent between the same pair
+// of clients, with a single combined proof of the acknowledgements.
+type MsgAcknowledgements struct {
+	Packets          []Packet          `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	Acknowledgements []Acknowledgement `protobuf:"bytes,2,rep,name=acknowledgements,proto3" json:"acknowledgements"`
+	ProofAcked       []byte            `protobuf:"bytes,3,opt,name=proof_acked,json=proofAcked,proto3" json:"proof_acked,omitempty"`
+	ProofHeight      types.Height      `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer           string            `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgAcknowledgements) Reset()         { *m = MsgAcknowledgements{} }
+func (m *MsgAcknowledgements) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgements) ProtoMessage()    {}
+func (*MsgAcknowledgements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{10}
+}
+func (m *MsgAcknowledgements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcknowledgements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgements.Merge(m, src)
+}
+func (m *MsgAcknowledgements) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgements) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgements proto.InternalMessageInfo
+
+// MsgAcknowledgementsResponse defines the Msg/Acknowledgements response type.
+type MsgAcknowledgementsResponse struct {
+	// results of the packets, in the order of the packets of the message
+	Results []ResponseResultType `protobuf:"varint,1,rep,packed,name=results,proto3,enum=ibc.core.channel.v2.ResponseResultType" json:"results,omitempty"`
+}
+
+func (m *MsgAcknowledgementsResponse) Reset()         { *m = MsgAcknowledgementsResponse{} }
+func (m *MsgAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgementsResponse) ProtoMessage()    {}
+func (*MsgAcknowledgementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d421c7119e969b99, []int{11}
+}
+func (m *MsgAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcknowledgementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgementsResponse.Merge(m, src)
+}
+func (m *MsgAcknowledgementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgementsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v2.ResponseResultType", ResponseResultType_name, ResponseResultType_value)
 	proto.RegisterType((*MsgSendPacket)(nil), "ibc.core.channel.v2.MsgSendPacket")
@@ -393,63 +556,75 @@ func init() {
 	proto.RegisterType((*MsgTimeoutResponse)(nil), "ibc.core.channel.v2.MsgTimeoutResponse")
 	proto.RegisterType((*MsgAcknowledgement)(nil), "ibc.core.channel.v2.MsgAcknowledgement")
 	proto.RegisterType((*MsgAcknowledgementResponse)(nil), "ibc.core.channel.v2.MsgAcknowledgementResponse")
+	proto.RegisterType((*MsgRecvPackets)(nil), "ibc.core.channel.v2.MsgRecvPackets")
+	proto.RegisterType((*MsgRecvPacketsResponse)(nil), "ibc.core.channel.v2.MsgRecvPacketsResponse")
+	proto.RegisterType((*MsgAcknowledgements)(nil), "ibc.core.channel.v2.MsgAcknowledgements")
+	proto.RegisterType((*MsgAcknowledgementsResponse)(nil), "ibc.core.channel.v2.MsgAcknowledgementsResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v2/tx.proto", fileDescriptor_d421c7119e969b99) }
 
 var fileDescriptor_d421c7119e969b99 = []byte{
-	// 804 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x6f, 0xe2, 0x46,
-	0x18, 0xc7, 0x71, 0x70, 0x48, 0x3a, 0x90, 0x42, 0xdd, 0x37, 0xea, 0x46, 0x60, 0xd1, 0x4a, 0x49,
-	0xa9, 0x62, 0x17, 0xda, 0x1e, 0x12, 0xa9, 0xad, 0x12, 0xea, 0xa8, 0x91, 0xf2, 0x82, 0x6c, 0x50,
-	0xb5, 0xbb, 0xd1, 0x22, 0x30, 0xb3, 0xc6, 0x0a, 0xf6, 0x78, 0x19, 0xc3, 0x6e, 0x6e, 0xab, 0x3d,
-	0x45, 0x9c, 0xf6, 0x0b, 0x44, 0x5a, 0x69, 0xbf, 0x40, 0x0e, 0xfb, 0x21, 0xa2, 0x3d, 0xe5, 0x98,
-	0xd3, 0x2a, 0x0a, 0x87, 0x7c, 0x8d, 0x95, 0x67, 0x86, 0xd7, 0x98, 0x4d, 0xa4, 0x65, 0x4f, 0x78,
-	0xfe, 0xf3, 0x7b, 0x9e, 0x67, 0x9e, 0xff, 0x98, 0x19, 0x83, 0x65, 0xab, 0x66, 0x28, 0x06, 0x6a,
-	0x41, 0xc5, 0x68, 0x54, 0x1d, 0x07, 0x36, 0x95, 0x4e, 0x5e, 0xf1, 0x9e, 0xcb, 0x6e, 0x0b, 0x79,
-	0x48, 0xf8, 0xda, 0xaa, 0x19, 0xb2, 0x3f, 0x2b, 0xb3, 0x59, 0xb9, 0x93, 0x17, 0xbf, 0x31, 0x91,
-	0x89, 0xc8, 0xbc, 0xe2, 0x3f, 0x51, 0x54, 0xfc, 0xde, 0x40, 0xd8, 0x46, 0x58, 0xb1, 0xb1, 0xa9,
-	0x74, 0x72, 0xfe, 0x0f, 0x9b, 0x90, 0x82, 0x2a, 0xb8, 0x55, 0xe3, 0x08, 0x7a, 0x8c, 0x48, 0x0f,
-	0x89, 0xa6, 0x05, 0x1d, 0xcf, 0x8f, 0xa7, 0x4f, 0x14, 0xc8, 0xbc, 0xe3, 0xc0, 0xd2, 0x1e, 0x36,
-	0x75, 0xe8, 0xd4, 0x8b, 0x24, 0x50, 0xf8, 0x09, 0x2c, 0x61, 0xd4, 0x6e, 0x19, 0xb0, 0x42, 0xc1,
-	0x24, 0x27, 0x71, 0xab, 0x5f, 0x68, 0x31, 0x2a, 0x16, 0x88, 0x26, 0xfc, 0x0a, 0xbe, 0xf2, 0x2c,
-	0x1b, 0xa2, 0xb6, 0x57, 0xf1, 0x7f, 0xb1, 0x57, 0xb5, 0xdd, 0xe4, 0x9c, 0xc4, 0xad, 0xf2, 0x5a,
-	0x82, 0x4d, 0x94, 0xfa, 0xba, 0xf0, 0x37, 0x58, 0x74, 0xab, 0xc7, 0x4d, 0x54, 0xad, 0xe3, 0x64,
-	0x58, 0x0a, 0xaf, 0x46, 0xf3, 0xcb, 0x72, 0x40, 0xf7, 0x72, 0x91, 0x42, 0x5b, 0xfc, 0xf9, 0xfb,
-	0x74, 0x48, 0x1b, 0xc4, 0x08, 0xdf, 0x81, 0x08, 0xb6, 0x4c, 0x07, 0xb6, 0x92, 0x3c, 0x59, 0x0a,
-	0x1b, 0x6d, 0xc4, 0x4f, 0x5e, 0xa7, 0x43, 0x2f, 0x6f, 0xce, 0xb2, 0x4c, 0xc8, 0xac, 0x83, 0x6f,
-	0xc7, 0x7a, 0xd1, 0x20, 0x76, 0x91, 0x83, 0xa1, 0x20, 0x82, 0x45, 0x0c, 0x9f, 0xb6, 0xa1, 0x63,
-	0x40, 0xd2, 0x0e, 0xaf, 0x0d, 0xc6, 0x1b, 0xbc, 0x9f, 0x25, 0xd3, 0xa3, 0x3e, 0x68, 0xd0, 0xe8,
-	0x30, 0x1f, 0xd6, 0x41, 0x84, 0x5a, 0x49, 0x22, 0xa2, 0xf9, 0x1f, 0xa7, 0xac, 0xd9, 0x47, 0xd8,
-	0x92, 0x59, 0x80, 0xf0, 0x0b, 0x48, 0xb8, 0x2d, 0x84, 0x9e, 0x54, 0x0c, 0x64, 0xdb, 0x96, 0x67,
-	0xfb, 0x2e, 0xfa, 0xe6, 0xc4, 0xb4, 0x38, 0xd1, 0x0b, 0x03, 0x59, 0x28, 0x80, 0x18, 0x45, 0x1b,
-	0xd0, 0x32, 0x1b, 0x5e, 0x32, 0x4c, 0x6a, 0x89, 0x23, 0xb5, 0xe8, 0x6e, 0x75, 0x72, 0xf2, 0x7f,
-	0x84, 0x60, 0xa5, 0xa2, 0x24, 0x8a, 0x4a, 0xf7, 0x37, 0xe8, 0x31, 0x31, 0x68, 0xd8, 0xe4, 0xc0,
-	0xa0, 0x7f, 0x40, 0xa4, 0x05, 0x71, 0xbb, 0x49, 0x9b, 0xfd, 0x32, 0xbf, 0x12, 0xd8, 0x6c, 0x1f,
-	0xd7, 0x08, 0x5a, 0x3a, 0x76, 0xa1, 0xc6, 0xc2, 0x98, 0x8b, 0x57, 0x1c, 0x00, 0x7b, 0xd8, 0x2c,
-	0xd1, 0x37, 0x60, 0x26, 0x16, 0xb6, 0x9d, 0x16, 0x34, 0xa0, 0xd5, 0x81, 0xf5, 0x31, 0x0b, 0xcb,
-	0x03, 0x79, 0xd6, 0x16, 0xce, 0x7f, 0xdc, 0xc2, 0x47, 0x40, 0x18, 0x76, 0x38, 0x6b, 0xff, 0xde,
-	0xce, 0x91, 0xec, 0x9b, 0xc6, 0x91, 0x83, 0x9e, 0x35, 0x61, 0xdd, 0x84, 0xe4, 0x25, 0xf9, 0x04,
-	0x1f, 0x4b, 0x20, 0x5e, 0x1d, 0xcf, 0x46, 0x6c, 0x8c, 0xe6, 0x7f, 0x0e, 0xcc, 0x31, 0x51, 0x99,
-	0x25, 0x9b, 0x4c, 0x21, 0xa4, 0x01, 0x35, 0xaf, 0xe2, 0x17, 0xa9, 0x13, 0xc7, 0x63, 0x1a, 0x20,
-	0xd2, 0xa6, 0xaf, 0xdc, 0xda, 0x13, 0xfe, 0xb3, 0xee, 0x89, 0x01, 0xc4, 0xdb, 0xae, 0xcd, 0x78,
-	0x6f, 0xb2, 0x97, 0x1c, 0x10, 0x6e, 0x43, 0xc2, 0x9f, 0x40, 0xd2, 0x54, 0xbd, 0x78, 0xb0, 0xaf,
-	0xab, 0x15, 0x4d, 0xd5, 0xcb, 0xbb, 0xa5, 0x4a, 0xe9, 0x41, 0x51, 0xad, 0x94, 0xf7, 0xf5, 0xa2,
-	0x5a, 0xd8, 0xd9, 0xde, 0x51, 0xff, 0x4d, 0x84, 0xc4, 0x78, 0xf7, 0x54, 0x8a, 0x8e, 0x48, 0xc2,
-	0x0a, 0xf8, 0x21, 0x30, 0x6c, 0xff, 0xe0, 0xa0, 0x98, 0xe0, 0xc4, 0xc5, 0xee, 0xa9, 0xc4, 0xfb,
-	0xcf, 0xc2, 0x1a, 0x58, 0x0e, 0x04, 0xf5, 0x72, 0xa1, 0xa0, 0xea, 0x7a, 0x62, 0x4e, 0x8c, 0x76,
-	0x4f, 0xa5, 0x05, 0x36, 0x9c, 0x8a, 0x6f, 0x6f, 0xee, 0xec, 0x96, 0x35, 0x35, 0x11, 0xa6, 0x38,
-	0x1b, 0x8a, 0xfc, 0xc9, 0x9b, 0x54, 0x28, 0xdf, 0x0d, 0x83, 0xf0, 0x1e, 0x36, 0x85, 0x43, 0x00,
-	0x46, 0x2e, 0x82, 0x4c, 0xa0, 0x4f, 0x63, 0x07, 0xac, 0x98, 0xbd, 0x9b, 0x19, 0xec, 0xc3, 0x21,
-	0x00, 0x23, 0xc7, 0xeb, 0xd4, 0xec, 0x43, 0x66, 0x7a, 0xf6, 0x80, 0x13, 0x4c, 0x07, 0x0b, 0xfd,
-	0x63, 0x27, 0x3d, 0x2d, 0x8c, 0x01, 0xe2, 0xca, 0x1d, 0xc0, 0x20, 0xe9, 0x11, 0x88, 0x4f, 0xfe,
-	0x17, 0xa7, 0xc6, 0x4e, 0x80, 0xa2, 0x72, 0x4f, 0xb0, 0x5f, 0x4c, 0x9c, 0x7f, 0x71, 0x73, 0x96,
-	0xe5, 0xb6, 0xfe, 0x3f, 0xbf, 0x4e, 0x71, 0x17, 0xd7, 0x29, 0xee, 0xea, 0x3a, 0xc5, 0xbd, 0xea,
-	0xa5, 0x42, 0x17, 0xbd, 0x54, 0xe8, 0xb2, 0x97, 0x0a, 0x3d, 0xfc, 0xcb, 0xb4, 0xbc, 0x46, 0xbb,
-	0x26, 0x1b, 0xc8, 0x56, 0xd8, 0x27, 0x81, 0x55, 0x33, 0xd6, 0x4c, 0xa4, 0x74, 0x72, 0x39, 0xc5,
-	0x46, 0xf5, 0x76, 0x13, 0x62, 0x7a, 0xdb, 0xff, 0xf6, 0xc7, 0xda, 0xe8, 0x47, 0xc7, 0xb1, 0x0b,
-	0x71, 0x2d, 0x42, 0x6e, 0xfc, 0xdf, 0x3f, 0x04, 0x00, 0x00, 0xff, 0xff, 0x98, 0xe2, 0xb1, 0x97,
-	0x98, 0x08, 0x00, 0x00,
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0xf1, 0x36, 0x09, 0xcf, 0x69, 0xbd, 0x6c, 0x69, 0x31, 0xdb, 0xc8, 0x5e, 0xa5,
+	0x48, 0x09, 0xae, 0xe2, 0x6d, 0x0c, 0x1c, 0x5a, 0x04, 0x28, 0x35, 0xae, 0x88, 0xd4, 0x24, 0xd6,
+	0xae, 0x0d, 0x02, 0x2a, 0x2c, 0x7b, 0x3c, 0xdd, 0xac, 0xe2, 0xdd, 0x59, 0x3c, 0x6b, 0x43, 0x6e,
+	0x88, 0x53, 0x95, 0x13, 0xff, 0x40, 0x24, 0x24, 0xfe, 0x81, 0x1e, 0xb8, 0xf0, 0x1f, 0x54, 0x9c,
+	0x7a, 0xac, 0x84, 0x84, 0xaa, 0xe4, 0x50, 0xfe, 0x0c, 0xb4, 0x33, 0xe3, 0x8d, 0x7f, 0xac, 0xb1,
+	0xab, 0x18, 0xd4, 0xd3, 0xee, 0xbc, 0xf9, 0xbe, 0xf7, 0xe6, 0x7d, 0xde, 0x78, 0x76, 0x0c, 0xab,
+	0x4e, 0x13, 0x19, 0x88, 0x74, 0xb0, 0x81, 0x0e, 0x1a, 0x9e, 0x87, 0xdb, 0x46, 0xaf, 0x68, 0x04,
+	0x3f, 0x14, 0xfc, 0x0e, 0x09, 0x88, 0x7a, 0xd5, 0x69, 0xa2, 0x42, 0x38, 0x5b, 0x10, 0xb3, 0x85,
+	0x5e, 0x51, 0x7b, 0xcb, 0x26, 0x36, 0x61, 0xf3, 0x46, 0xf8, 0xc6, 0xa5, 0xda, 0xdb, 0x88, 0x50,
+	0x97, 0x50, 0xc3, 0xa5, 0xb6, 0xd1, 0xdb, 0x0a, 0x1f, 0x62, 0x42, 0x8f, 0xcb, 0xe0, 0x37, 0xd0,
+	0x21, 0x0e, 0x84, 0x22, 0x77, 0xae, 0x68, 0x3b, 0xd8, 0x0b, 0x42, 0x7f, 0xfe, 0xc6, 0x05, 0x6b,
+	0x7f, 0x48, 0x70, 0x79, 0x97, 0xda, 0x16, 0xf6, 0x5a, 0x15, 0xe6, 0xa8, 0xde, 0x84, 0xcb, 0x94,
+	0x74, 0x3b, 0x08, 0xd7, 0xb9, 0x30, 0x23, 0xe9, 0xd2, 0xc6, 0x1b, 0xe6, 0x0a, 0x37, 0x96, 0x98,
+	0x4d, 0xbd, 0x05, 0x6f, 0x06, 0x8e, 0x8b, 0x49, 0x37, 0xa8, 0x87, 0x4f, 0x1a, 0x34, 0x5c, 0x3f,
+	0xb3, 0xa0, 0x4b, 0x1b, 0xb2, 0xa9, 0x88, 0x89, 0x6a, 0xdf, 0xae, 0x7e, 0x02, 0xcb, 0x7e, 0xe3,
+	0xa8, 0x4d, 0x1a, 0x2d, 0x9a, 0x49, 0xea, 0xc9, 0x8d, 0x54, 0x71, 0xb5, 0x10, 0x53, 0x7d, 0xa1,
+	0xc2, 0x45, 0xf7, 0xe4, 0xa7, 0x7f, 0xe5, 0x12, 0x66, 0xe4, 0xa3, 0x5e, 0x87, 0x45, 0xea, 0xd8,
+	0x1e, 0xee, 0x64, 0x64, 0xb6, 0x14, 0x31, 0xba, 0x9b, 0x7e, 0xfc, 0x4b, 0x2e, 0xf1, 0xd3, 0xcb,
+	0x27, 0x79, 0x61, 0x58, 0xbb, 0x03, 0xd7, 0x86, 0x6a, 0x31, 0x31, 0xf5, 0x89, 0x47, 0xb1, 0xaa,
+	0xc1, 0x32, 0xc5, 0xdf, 0x75, 0xb1, 0x87, 0x30, 0x2b, 0x47, 0x36, 0xa3, 0xf1, 0x5d, 0x39, 0x8c,
+	0xb2, 0x76, 0xc6, 0x39, 0x98, 0x18, 0xf5, 0x04, 0x87, 0x3b, 0xb0, 0xc8, 0x51, 0x32, 0x8f, 0x54,
+	0xf1, 0xc6, 0x84, 0x35, 0x87, 0x12, 0xb1, 0x64, 0xe1, 0xa0, 0xbe, 0x07, 0x8a, 0xdf, 0x21, 0xe4,
+	0x51, 0x1d, 0x11, 0xd7, 0x75, 0x02, 0x37, 0xa4, 0x18, 0xc2, 0x59, 0x31, 0xd3, 0xcc, 0x5e, 0x8a,
+	0xcc, 0x6a, 0x09, 0x56, 0xb8, 0xf4, 0x00, 0x3b, 0xf6, 0x41, 0x90, 0x49, 0xb2, 0x5c, 0xda, 0x40,
+	0x2e, 0xde, 0xad, 0xde, 0x56, 0xe1, 0x73, 0xa6, 0x10, 0xa9, 0x52, 0xcc, 0x8b, 0x9b, 0x66, 0x07,
+	0xf4, 0x2d, 0x5c, 0x1b, 0x2a, 0x32, 0x02, 0xf4, 0x29, 0x2c, 0x76, 0x30, 0xed, 0xb6, 0x79, 0xb1,
+	0x57, 0x8a, 0xeb, 0xb1, 0xc5, 0xf6, 0xe5, 0x26, 0x93, 0x56, 0x8f, 0x7c, 0x6c, 0x0a, 0x37, 0x41,
+	0xf1, 0x85, 0x04, 0xb0, 0x4b, 0xed, 0x2a, 0xdf, 0x01, 0x73, 0x41, 0xd8, 0xf5, 0x3a, 0x18, 0x61,
+	0xa7, 0x87, 0x5b, 0x43, 0x08, 0x6b, 0x91, 0x79, 0xde, 0x08, 0x2f, 0xfd, 0x3b, 0xc2, 0x6f, 0x40,
+	0x3d, 0xaf, 0x70, 0xde, 0xfc, 0x7e, 0x5b, 0x60, 0xd1, 0xb7, 0xd1, 0xa1, 0x47, 0xbe, 0x6f, 0xe3,
+	0x96, 0x8d, 0xd9, 0x26, 0xb9, 0x00, 0xc7, 0x2a, 0xa4, 0x1b, 0xc3, 0xd1, 0x18, 0xc6, 0x54, 0xf1,
+	0xdd, 0xd8, 0x18, 0x23, 0x99, 0x45, 0xb0, 0xd1, 0x10, 0x6a, 0x0e, 0x38, 0xbc, 0x7a, 0x98, 0xa4,
+	0xc5, 0x88, 0xaf, 0x98, 0xc0, 0x4c, 0xdb, 0xe8, 0x30, 0xa6, 0x27, 0xf2, 0x7f, 0xda, 0x13, 0x04,
+	0xda, 0x38, 0xb5, 0x79, 0xf7, 0xe6, 0x6f, 0x09, 0xae, 0x0c, 0xfd, 0x78, 0xa8, 0xfa, 0x11, 0x2c,
+	0x71, 0xcc, 0x34, 0x23, 0xe9, 0xc9, 0xd9, 0x1a, 0xd3, 0xf7, 0x08, 0x8f, 0xd0, 0xd1, 0x43, 0x82,
+	0x8a, 0x2d, 0xae, 0x8c, 0x9c, 0x12, 0xf4, 0x7f, 0x3e, 0x26, 0x1a, 0x70, 0x7d, 0xb8, 0xd2, 0x88,
+	0xe5, 0x36, 0x2c, 0x71, 0x28, 0xbc, 0xe2, 0x57, 0x80, 0xd9, 0xf7, 0x13, 0x34, 0x7f, 0x5f, 0x80,
+	0xab, 0xe3, 0x3d, 0xbb, 0x20, 0xd2, 0x2f, 0x40, 0x19, 0xd9, 0xa9, 0x21, 0xd1, 0xe4, 0x2b, 0xee,
+	0xf6, 0xb1, 0x18, 0xaf, 0xdb, 0x76, 0x7f, 0x04, 0x37, 0x62, 0xd0, 0xcd, 0xbd, 0x47, 0xf9, 0xe7,
+	0x12, 0xa8, 0xe3, 0x2a, 0xf5, 0x43, 0xd0, 0xcd, 0xb2, 0x55, 0xd9, 0xdf, 0xb3, 0xca, 0x75, 0xb3,
+	0x6c, 0xd5, 0x1e, 0x54, 0xeb, 0xd5, 0xaf, 0x2a, 0xe5, 0x7a, 0x6d, 0xcf, 0xaa, 0x94, 0x4b, 0x3b,
+	0xf7, 0x77, 0xca, 0x9f, 0x29, 0x09, 0x2d, 0x7d, 0x7c, 0xa2, 0xa7, 0x06, 0x4c, 0xea, 0x3a, 0xbc,
+	0x13, 0xeb, 0xb6, 0xb7, 0xbf, 0x5f, 0x51, 0x24, 0x6d, 0xf9, 0xf8, 0x44, 0x97, 0xc3, 0x77, 0x75,
+	0x13, 0x56, 0x63, 0x85, 0x56, 0xad, 0x54, 0x2a, 0x5b, 0x96, 0xb2, 0xa0, 0xa5, 0x8e, 0x4f, 0xf4,
+	0x25, 0x31, 0x9c, 0x28, 0xbf, 0xbf, 0xbd, 0xf3, 0xa0, 0x66, 0x96, 0x95, 0x24, 0x97, 0x8b, 0xa1,
+	0x26, 0x3f, 0xfe, 0x35, 0x9b, 0x28, 0xfe, 0x29, 0x43, 0x72, 0x97, 0xda, 0xea, 0x43, 0x80, 0x81,
+	0xab, 0xcf, 0x5a, 0x2c, 0xa8, 0xa1, 0x2b, 0x85, 0x96, 0x9f, 0xae, 0x89, 0x3a, 0xf1, 0x10, 0x60,
+	0xe0, 0x42, 0x31, 0x31, 0xfa, 0xb9, 0x46, 0xcb, 0x4f, 0xd7, 0x44, 0xd1, 0x2d, 0x58, 0xea, 0x7f,
+	0x68, 0x73, 0x93, 0xdc, 0x84, 0x40, 0x5b, 0x9f, 0x22, 0x88, 0x82, 0x1e, 0x42, 0x7a, 0xf4, 0xeb,
+	0x33, 0xd1, 0x77, 0x44, 0xa8, 0x19, 0x33, 0x0a, 0xa3, 0x64, 0x75, 0x48, 0x0d, 0x1e, 0xa7, 0x37,
+	0xa7, 0x17, 0x4f, 0xb5, 0x5b, 0x33, 0x88, 0xa2, 0x04, 0x1e, 0x28, 0x63, 0x27, 0xcc, 0xc6, 0x8c,
+	0xab, 0xa4, 0xda, 0xed, 0x59, 0x95, 0xfd, 0x7c, 0xda, 0xa5, 0x1f, 0x5f, 0x3e, 0xc9, 0x4b, 0xf7,
+	0xbe, 0x7c, 0x7a, 0x9a, 0x95, 0x9e, 0x9d, 0x66, 0xa5, 0x17, 0xa7, 0x59, 0xe9, 0xe7, 0xb3, 0x6c,
+	0xe2, 0xd9, 0x59, 0x36, 0xf1, 0xfc, 0x2c, 0x9b, 0xf8, 0xfa, 0x63, 0xdb, 0x09, 0x0e, 0xba, 0xcd,
+	0x02, 0x22, 0xae, 0x21, 0x6e, 0xf5, 0x4e, 0x13, 0x6d, 0xda, 0xc4, 0xe8, 0x6d, 0x6d, 0x19, 0x2e,
+	0x69, 0x75, 0xdb, 0x98, 0xf2, 0x0b, 0xfb, 0xed, 0x0f, 0x36, 0x07, 0xff, 0x37, 0x1c, 0xf9, 0x98,
+	0x36, 0x17, 0xd9, 0xa5, 0xfd, 0xfd, 0x7f, 0x06, 0x00, 0xaa, 0x4e, 0xb0, 0x66, 0x5b, 0x0c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -472,6 +647,10 @@ type MsgClient interface {
 	Timeout(ctx context.Context, in *MsgTimeout, opts ...grpc.CallOption) (*MsgTimeoutResponse, error)
 	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
 	Acknowledgement(ctx context.Context, in *MsgAcknowledgement, opts ...grpc.CallOption) (*MsgAcknowledgementResponse, error)
+	// RecvPackets defines a rpc handler method for MsgRecvPackets.
+	RecvPackets(ctx context.Context, in *MsgRecvPackets, opts ...grpc.CallOption) (*MsgRecvPacketsResponse, error)
+	// Acknowledgements defines a rpc handler method for MsgAcknowledgements.
+	Acknowledgements(ctx context.Context, in *MsgAcknowledgements, opts ...grpc.CallOption) (*MsgAcknowledgementsResponse, error)
 }
 
 type msgClient struct {
@@ -518,6 +697,24 @@ func (c *msgClient) Acknowledgement(ctx context.Context, in *MsgAcknowledgement,
 	return out, nil
 }
 
+func (c *msgClient) RecvPackets(ctx context.Context, in *MsgRecvPackets, opts ...grpc.CallOption) (*MsgRecvPacketsResponse, error) {
+	out := new(MsgRecvPacketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Msg/RecvPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Acknowledgements(ctx context.Context, in *MsgAcknowledgements, opts ...grpc.CallOption) (*MsgAcknowledgementsResponse, error) {
+	out := new(MsgAcknowledgementsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Msg/Acknowledgements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SendPacket defines a rpc handler method for MsgSendPacket.
@@ -528,6 +725,10 @@ type MsgServer interface {
 	Timeout(context.Context, *MsgTimeout) (*MsgTimeoutResponse, error)
 	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
 	Acknowledgement(context.Context, *MsgAcknowledgement) (*MsgAcknowledgementResponse, error)
+	// RecvPackets defines a rpc handler method for MsgRecvPackets.
+	RecvPackets(context.Context, *MsgRecvPackets) (*MsgRecvPacketsResponse, error)
+	// Acknowledgements defines a rpc handler method for MsgAcknowledgements.
+	Acknowledgements(context.Context, *MsgAcknowledgements) (*MsgAcknowledgementsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -546,6 +747,12 @@ func (*UnimplementedMsgServer) Timeout(ctx context.Context, req *MsgTimeout) (*M
 func (*UnimplementedMsgServer) Acknowledgement(ctx context.Context, req *MsgAcknowledgement) (*MsgAcknowledgementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Acknowledgement not implemented")
 }
+func (*UnimplementedMsgServer) RecvPackets(ctx context.Context, req *MsgRecvPackets) (*MsgRecvPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecvPackets not implemented")
+}
+func (*UnimplementedMsgServer) Acknowledgements(ctx context.Context, req *MsgAcknowledgements) (*MsgAcknowledgementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Acknowledgements not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -623,6 +830,42 @@ func _Msg_Acknowledgement_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RecvPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecvPackets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecvPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Msg/RecvPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecvPackets(ctx, req.(*MsgRecvPackets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Acknowledgements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgements)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Acknowledgements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Msg/Acknowledgements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Acknowledgements(ctx, req.(*MsgAcknowledgements))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v2.Msg",
@@ -644,6 +887,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Acknowledgement",
 			Handler:    _Msg_Acknowledgement_Handler,
 		},
+		{
+			MethodName: "RecvPackets",
+			Handler:    _Msg_RecvPackets_Handler,
+		},
+		{
+			MethodName: "Acknowledgements",
+			Handler:    _Msg_Acknowledgements_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v2/tx.proto",
@@ -998,99 +1249,317 @@ func (m *MsgAcknowledgementResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRecvPackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSendPacket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRecvPackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecvPackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SourceClient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TimeoutTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Payloads) > 0 {
-		for _, e := range m.Payloads {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProofCommitments) > 0 {
+		i -= len(m.ProofCommitments)
+		copy(dAtA[i:], m.ProofCommitments)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofCommitments)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovTx(uint64(m.Sequence))
+func (m *MsgRecvPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRecvPacket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofCommitment)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgRecvPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRecvPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRecvPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
+	if len(m.Results) > 0 {
+		dAtA10 := make([]byte, len(m.Results)*10)
+		var j9 int
+		for _, num := range m.Results {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTx(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTimeout) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAcknowledgements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofUnreceived)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ProofAcked) > 0 {
+		i -= len(m.ProofAcked)
+		copy(dAtA[i:], m.ProofAcked)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofAcked)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Acknowledgements) > 0 {
+		for iNdEx := len(m.Acknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Acknowledgements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		dAtA13 := make([]byte, len(m.Results)*10)
+		var j12 int
+		for _, num := range m.Results {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintTx(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSendPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	if len(m.Payloads) > 0 {
+		for _, e := range m.Payloads {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgRecvPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecvPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofUnreceived)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.ProofHeight.Size()
 	n += 1 + l + sovTx(uint64(l))
@@ -1140,21 +1609,596 @@ func (m *MsgAcknowledgementResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgRecvPackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ProofCommitments)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecvPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		l = 0
+		for _, e := range m.Results {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgAcknowledgements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Acknowledgements) > 0 {
+		for _, e := range m.Acknowledgements {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ProofAcked)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		l = 0
+		for _, e := range m.Results {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceClient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceClient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payloads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payloads = append(m.Payloads, Payload{})
+			if err := m.Payloads[len(m.Payloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofCommitment = append(m.ProofCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofCommitment == nil {
+				m.ProofCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
+func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1177,17 +2221,17 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendPacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTimeout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1197,29 +2241,30 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceClient = string(dAtA[iNdEx:postIndex])
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
 			}
-			m.TimeoutTimestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1229,14 +2274,29 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUnreceived == nil {
+				m.ProofUnreceived = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payloads", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1263,12 +2323,11 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payloads = append(m.Payloads, Payload{})
-			if err := m.Payloads[len(m.Payloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -1321,7 +2380,7 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1344,17 +2403,17 @@ func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTimeoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			m.Sequence = 0
+			m.Result = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1364,7 +2423,7 @@ func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.Result |= ResponseResultType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1390,7 +2449,7 @@ func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
+func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1413,10 +2472,10 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecvPacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcknowledgement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecvPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1454,7 +2513,40 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Acknowledgement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAcked", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1481,12 +2573,12 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofCommitment = append(m.ProofCommitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofCommitment == nil {
-				m.ProofCommitment = []byte{}
+			m.ProofAcked = append(m.ProofAcked[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAcked == nil {
+				m.ProofAcked = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -1519,7 +2611,7 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -1572,7 +2664,7 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1595,10 +2687,10 @@ func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecvPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcknowledgementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecvPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcknowledgementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1641,7 +2733,7 @@ func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
+func (m *MsgRecvPackets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1664,15 +2756,15 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeout: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecvPackets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecvPackets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1699,13 +2791,14 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitments", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1732,9 +2825,9 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUnreceived == nil {
-				m.ProofUnreceived = []byte{}
+			m.ProofCommitments = append(m.ProofCommitments[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofCommitments == nil {
+				m.ProofCommitments = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
@@ -1770,7 +2863,7 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -1823,7 +2916,7 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecvPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1846,30 +2939,80 @@ func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeoutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecvPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecvPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v ResponseResultType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ResponseResultType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Results = append(m.Results, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]ResponseResultType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ResponseResultType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ResponseResultType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -1892,7 +3035,7 @@ func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
+func (m *MsgAcknowledgements) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1915,15 +3058,15 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgement: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcknowledgements: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcknowledgements: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1950,13 +3093,14 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1983,7 +3127,8 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Acknowledgement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Acknowledgements = append(m.Acknowledgements, Acknowledgement{})
+			if err := m.Acknowledgements[len(m.Acknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2107,7 +3252,7 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2130,30 +3275,80 @@ func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgementResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcknowledgementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcknowledgementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v ResponseResultType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ResponseResultType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Results = append(m.Results, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]ResponseResultType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ResponseResultType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ResponseResultType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -24,6 +24,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProofFormat defines the format of the commitment proofs of a counterparty
+// and selects the verifier used to check them against its commitment roots.
+type ProofFormat int32
+
+const (
+	// Default zero value enumeration
+	UNSPECIFIED ProofFormat = 0
+	// ICS-23 chained commitment proofs, encoded as a MerkleProof
+	ICS23 ProofFormat = 1
+	// Ethereum Merkle-Patricia trie proofs with keccak256 hashed keys, encoded as
+	// an MPTProof
+	MPT ProofFormat = 2
+	// sha256 sparse Merkle tree proofs, encoded as a SparseMerkleProof
+	SMT ProofFormat = 3
+)
+
+var ProofFormat_name = map[int32]string{
+	0: "PROOF_FORMAT_UNSPECIFIED",
+	1: "PROOF_FORMAT_ICS23",
+	2: "PROOF_FORMAT_MPT",
+	3: "PROOF_FORMAT_SMT",
+}
+
+var ProofFormat_value = map[string]int32{
+	"PROOF_FORMAT_UNSPECIFIED": 0,
+	"PROOF_FORMAT_ICS23":       1,
+	"PROOF_FORMAT_MPT":         2,
+	"PROOF_FORMAT_SMT":         3,
+}
+
+func (x ProofFormat) String() string {
+	return proto.EnumName(ProofFormat_name, int32(x))
+}
+
+func (ProofFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7921d88972a41469, []int{0}
+}
+
 // MerkleRoot defines a merkle root hash.
 // In the Cosmos SDK, the AppHash of a block header becomes the root.
 type MerkleRoot struct {
@@ -159,10 +197,97 @@ func (m *MerkleProof) GetProofs() []*_go.CommitmentProof {
 	return nil
 }
 
+// MPTProof is a proof of the value stored at a key in an Ethereum
+// Merkle-Patricia trie. It holds the RLP-encoded trie nodes along the path from
+// the root to the key, in any order.
+type MPTProof struct {
+	Nodes [][]byte `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (m *MPTProof) Reset()         { *m = MPTProof{} }
+func (m *MPTProof) String() string { return proto.CompactTextString(m) }
+func (*MPTProof) ProtoMessage()    {}
+func (*MPTProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7921d88972a41469, []int{3}
+}
+func (m *MPTProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MPTProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MPTProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MPTProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MPTProof.Merge(m, src)
+}
+func (m *MPTProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MPTProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MPTProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MPTProof proto.InternalMessageInfo
+
+// SparseMerkleProof is a proof of the value stored at a key in a sha256 sparse
+// Merkle tree, in which leaves are placed at the shortest unique prefix of the
+// hashed key.
+type SparseMerkleProof struct {
+	// sibling hashes along the path, ordered from leaf-to-root
+	SideNodes [][]byte `protobuf:"bytes,1,rep,name=side_nodes,json=sideNodes,proto3" json:"side_nodes,omitempty"`
+	// for non-membership proofs, the path and value hash of the leaf placed where
+	// the key would be, empty if that position holds no leaf
+	NonMembershipLeafData []byte `protobuf:"bytes,2,opt,name=non_membership_leaf_data,json=nonMembershipLeafData,proto3" json:"non_membership_leaf_data,omitempty"`
+}
+
+func (m *SparseMerkleProof) Reset()         { *m = SparseMerkleProof{} }
+func (m *SparseMerkleProof) String() string { return proto.CompactTextString(m) }
+func (*SparseMerkleProof) ProtoMessage()    {}
+func (*SparseMerkleProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7921d88972a41469, []int{4}
+}
+func (m *SparseMerkleProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SparseMerkleProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SparseMerkleProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SparseMerkleProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SparseMerkleProof.Merge(m, src)
+}
+func (m *SparseMerkleProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *SparseMerkleProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_SparseMerkleProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SparseMerkleProof proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("ibc.core.commitment.v1.ProofFormat", ProofFormat_name, ProofFormat_value)
 	proto.RegisterType((*MerkleRoot)(nil), "ibc.core.commitment.v1.MerkleRoot")
 	proto.RegisterType((*MerklePrefix)(nil), "ibc.core.commitment.v1.MerklePrefix")
 	proto.RegisterType((*MerkleProof)(nil), "ibc.core.commitment.v1.MerkleProof")
+	proto.RegisterType((*MPTProof)(nil), "ibc.core.commitment.v1.MPTProof")
+	proto.RegisterType((*SparseMerkleProof)(nil), "ibc.core.commitment.v1.SparseMerkleProof")
 }
 
 func init() {
@@ -170,26 +295,38 @@ func init() {
 }
 
 var fileDescriptor_7921d88972a41469 = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x18, 0x86, 0x73, 0x58, 0x0a, 0x5e, 0x3b, 0x05, 0x91, 0x52, 0xf4, 0x2c, 0x1d, 0xb4, 0x4b, 0xee,
-	0x48, 0xb2, 0x88, 0xe0, 0xa2, 0x83, 0x93, 0x20, 0x59, 0x04, 0x17, 0x31, 0xe7, 0x25, 0x39, 0xd2,
-	0xf8, 0x85, 0xdc, 0x35, 0x98, 0x7f, 0xe0, 0xe8, 0x4f, 0xf0, 0xe7, 0x38, 0x76, 0x74, 0x94, 0xe4,
-	0x8f, 0x48, 0xee, 0x5a, 0xc9, 0xf6, 0x7d, 0xbc, 0xcf, 0xdd, 0xf7, 0xbe, 0x2f, 0xbe, 0x90, 0x31,
-	0x67, 0x1c, 0x2a, 0xc1, 0x38, 0x14, 0x85, 0xd4, 0x85, 0x78, 0xd3, 0xac, 0xf6, 0x07, 0x1b, 0x2d,
-	0x2b, 0xd0, 0xe0, 0x1e, 0xcb, 0x98, 0xd3, 0x1e, 0xa4, 0x03, 0xa9, 0xf6, 0xe7, 0x47, 0x29, 0xa4,
-	0x60, 0x10, 0xd6, 0x4f, 0x96, 0x9e, 0x9f, 0x70, 0x50, 0x05, 0x28, 0x26, 0xb9, 0x0a, 0xc2, 0xfe,
-	0xbf, 0xb2, 0x02, 0x48, 0x94, 0x55, 0x97, 0xe7, 0x18, 0xdf, 0x8b, 0x2a, 0x5f, 0x8b, 0x08, 0x40,
-	0xbb, 0x2e, 0x1e, 0x65, 0x2f, 0x2a, 0x9b, 0xa1, 0x05, 0x5a, 0x4d, 0x23, 0x33, 0x5f, 0x8d, 0x3e,
-	0xbe, 0xce, 0x9c, 0xa5, 0x87, 0xa7, 0x96, 0x7b, 0xa8, 0x44, 0x22, 0xdf, 0xdd, 0x53, 0x8c, 0x73,
-	0xd1, 0x3c, 0x97, 0x66, 0xdb, 0xf1, 0x87, 0xb9, 0x68, 0xac, 0xbc, 0xbc, 0xc3, 0x93, 0x3d, 0x0e,
-	0x90, 0xb8, 0x97, 0x78, 0x6c, 0xaf, 0xce, 0xd0, 0xe2, 0x60, 0x35, 0x09, 0x16, 0xd4, 0x9a, 0xa2,
-	0xc6, 0x14, 0xad, 0x7d, 0x7a, 0xfb, 0x9f, 0xc4, 0xbc, 0x88, 0x76, 0xfc, 0xcd, 0xe3, 0x77, 0x4b,
-	0xd0, 0xb6, 0x25, 0xe8, 0xb7, 0x25, 0xe8, 0xb3, 0x23, 0xce, 0xb6, 0x23, 0xce, 0x4f, 0x47, 0x9c,
-	0xa7, 0xeb, 0x54, 0xea, 0x6c, 0x13, 0xf7, 0x1d, 0xb0, 0x7d, 0xc4, 0x98, 0x7b, 0x29, 0xb0, 0xda,
-	0xf7, 0x59, 0x01, 0xaf, 0x9b, 0xb5, 0x50, 0xb6, 0xcf, 0x20, 0xf4, 0x06, 0x95, 0xea, 0xa6, 0x14,
-	0x2a, 0x1e, 0x9b, 0xfc, 0xe1, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0x84, 0x54, 0xdf, 0x76,
-	0x01, 0x00, 0x00,
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x51, 0x6b, 0xd3, 0x50,
+	0x14, 0xc7, 0x93, 0xb5, 0x9b, 0xf6, 0xb6, 0x60, 0xbc, 0x4c, 0x09, 0xc1, 0xc6, 0xd8, 0x87, 0x39,
+	0x84, 0x26, 0xa4, 0x7d, 0x50, 0x04, 0x1f, 0xb4, 0x5b, 0xa5, 0x60, 0xd6, 0x90, 0x44, 0x04, 0x5f,
+	0x42, 0x92, 0xde, 0xb4, 0xa1, 0x4d, 0x4e, 0xcc, 0xcd, 0x8a, 0xfd, 0x06, 0xa3, 0x4f, 0x7e, 0x81,
+	0x82, 0xb0, 0x2f, 0xe3, 0xe3, 0x1e, 0x7d, 0x94, 0xf6, 0x8b, 0x48, 0x72, 0xbb, 0x91, 0xb1, 0xb7,
+	0x7b, 0xce, 0xff, 0x77, 0xcf, 0xff, 0x1c, 0xf8, 0xa3, 0xd7, 0x91, 0x1f, 0x68, 0x01, 0x64, 0x44,
+	0x0b, 0x20, 0x8e, 0xa3, 0x3c, 0x26, 0x49, 0xae, 0x2d, 0xf5, 0x4a, 0xa5, 0xa6, 0x19, 0xe4, 0x80,
+	0x9f, 0x47, 0x7e, 0xa0, 0x16, 0xa0, 0x5a, 0x91, 0x96, 0xba, 0x74, 0x3c, 0x85, 0x29, 0x94, 0x88,
+	0x56, 0xbc, 0x18, 0x2d, 0xbd, 0x08, 0x80, 0xc6, 0x40, 0xb5, 0x28, 0xa0, 0xbd, 0x7e, 0x31, 0x2f,
+	0xcd, 0x00, 0x42, 0xca, 0xd4, 0xce, 0x09, 0x42, 0x06, 0xc9, 0xe6, 0x0b, 0x62, 0x01, 0xe4, 0x18,
+	0xa3, 0xfa, 0xcc, 0xa3, 0x33, 0x91, 0x57, 0xf8, 0xd3, 0x96, 0x55, 0xbe, 0xdf, 0xd7, 0xaf, 0x7e,
+	0xbf, 0xe4, 0x3a, 0x5d, 0xd4, 0x62, 0x9c, 0x99, 0x91, 0x30, 0xfa, 0x89, 0xdb, 0x08, 0xcd, 0xc9,
+	0xca, 0x4d, 0xcb, 0x6a, 0xcf, 0x37, 0xe6, 0x64, 0xc5, 0xe4, 0xce, 0x67, 0xd4, 0xbc, 0xc5, 0x01,
+	0x42, 0xfc, 0x0e, 0x1d, 0x31, 0x57, 0x91, 0x57, 0x6a, 0xa7, 0xcd, 0x9e, 0xa2, 0xb2, 0xa5, 0xd4,
+	0x72, 0x29, 0x75, 0xa9, 0xab, 0x83, 0xbb, 0x4b, 0xca, 0x1f, 0xd6, 0x9e, 0xef, 0x9c, 0xa0, 0xc7,
+	0x86, 0xe9, 0xb0, 0x29, 0xc7, 0xe8, 0x30, 0x81, 0x09, 0x61, 0x43, 0x5a, 0x16, 0x2b, 0xf6, 0xfb,
+	0xfd, 0x40, 0x4f, 0xed, 0xd4, 0xcb, 0x28, 0xa9, 0xda, 0xb6, 0x11, 0xa2, 0xd1, 0x84, 0xb8, 0xd5,
+	0x5f, 0x8d, 0xa2, 0x73, 0x51, 0x34, 0xf0, 0x5b, 0x24, 0x26, 0x90, 0xb8, 0x31, 0x89, 0x7d, 0x92,
+	0xd1, 0x59, 0x94, 0xba, 0x0b, 0xe2, 0x85, 0xee, 0xc4, 0xcb, 0x3d, 0xf1, 0xa0, 0xbc, 0xe8, 0x59,
+	0x02, 0x89, 0x71, 0x27, 0x7f, 0x21, 0x5e, 0x78, 0xe6, 0xe5, 0x1e, 0xb3, 0x7c, 0x73, 0xcd, 0xa3,
+	0x66, 0xe9, 0x33, 0x84, 0x2c, 0xf6, 0x72, 0xdc, 0x45, 0xa2, 0x69, 0x8d, 0xc7, 0x43, 0x77, 0x38,
+	0xb6, 0x8c, 0x8f, 0x8e, 0xfb, 0xf5, 0xc2, 0x36, 0xcf, 0x07, 0xa3, 0xe1, 0xe8, 0xfc, 0x4c, 0xe0,
+	0xa4, 0x27, 0xeb, 0x8d, 0xd2, 0xac, 0xb4, 0xf0, 0x2b, 0x84, 0xef, 0xe1, 0xa3, 0x81, 0xdd, 0xeb,
+	0x0b, 0xbc, 0xd4, 0x58, 0x6f, 0x94, 0xc3, 0xb2, 0xc0, 0x6d, 0x24, 0xdc, 0x43, 0x0c, 0xd3, 0x11,
+	0x0e, 0xa4, 0x47, 0xeb, 0x8d, 0x52, 0x33, 0x4c, 0xe7, 0x81, 0x6c, 0x1b, 0x8e, 0x50, 0x63, 0xb2,
+	0x6d, 0x38, 0x52, 0xfd, 0xea, 0x5a, 0xe6, 0x3e, 0x7d, 0xfb, 0xb3, 0x95, 0xf9, 0x9b, 0xad, 0xcc,
+	0xff, 0xdb, 0xca, 0xfc, 0xaf, 0x9d, 0xcc, 0xdd, 0xec, 0x64, 0xee, 0xef, 0x4e, 0xe6, 0xbe, 0x7f,
+	0x98, 0x46, 0xf9, 0xec, 0xd2, 0x2f, 0x42, 0xa4, 0xdd, 0x66, 0xc4, 0x0f, 0xba, 0x53, 0xd0, 0x96,
+	0xba, 0xae, 0xc5, 0x30, 0xb9, 0x5c, 0x10, 0xca, 0x02, 0xd9, 0xeb, 0x77, 0x2b, 0x99, 0xcc, 0x57,
+	0x29, 0xa1, 0xfe, 0x51, 0x19, 0xa0, 0xfe, 0xff, 0x01, 0x00, 0xb2, 0x6e, 0x01, 0xef, 0xb7, 0x02,
+	0x00, 0x00,
 }
 
 func (m *MerkleRoot) Marshal() (dAtA []byte, err error) {
@@ -289,6 +426,77 @@ func (m *MerkleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MPTProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MPTProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MPTProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Nodes[iNdEx])
+			copy(dAtA[i:], m.Nodes[iNdEx])
+			i = encodeVarintCommitment(dAtA, i, uint64(len(m.Nodes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SparseMerkleProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SparseMerkleProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SparseMerkleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NonMembershipLeafData) > 0 {
+		i -= len(m.NonMembershipLeafData)
+		copy(dAtA[i:], m.NonMembershipLeafData)
+		i = encodeVarintCommitment(dAtA, i, uint64(len(m.NonMembershipLeafData)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SideNodes) > 0 {
+		for iNdEx := len(m.SideNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SideNodes[iNdEx])
+			copy(dAtA[i:], m.SideNodes[iNdEx])
+			i = encodeVarintCommitment(dAtA, i, uint64(len(m.SideNodes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCommitment(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommitment(v)
 	base := offset
@@ -341,6 +549,40 @@ func (m *MerkleProof) Size() (n int) {
 	return n
 }
 
+func (m *MPTProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, b := range m.Nodes {
+			l = len(b)
+			n += 1 + l + sovCommitment(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SparseMerkleProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SideNodes) > 0 {
+		for _, b := range m.SideNodes {
+			l = len(b)
+			n += 1 + l + sovCommitment(uint64(l))
+		}
+	}
+	l = len(m.NonMembershipLeafData)
+	if l > 0 {
+		n += 1 + l + sovCommitment(uint64(l))
+	}
+	return n
+}
+
 func sovCommitment(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -599,6 +841,204 @@ func (m *MerkleProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MPTProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommitment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MPTProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MPTProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommitment
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, make([]byte, postIndex-iNdEx))
+			copy(m.Nodes[len(m.Nodes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommitment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommitment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SparseMerkleProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommitment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SparseMerkleProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SparseMerkleProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SideNodes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommitment
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SideNodes = append(m.SideNodes, make([]byte, postIndex-iNdEx))
+			copy(m.SideNodes[len(m.SideNodes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonMembershipLeafData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommitment
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonMembershipLeafData = append(m.NonMembershipLeafData[:0], dAtA[iNdEx:postIndex]...)
+			if m.NonMembershipLeafData == nil {
+				m.NonMembershipLeafData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommitment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommitment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCommitment(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@ package types
 
 import (
 	"bytes"
+	"slices"
 
 	ics23 "github.com/cosmos/ics23/go"
 
@@ -142,6 +143,65 @@ func (p MerkleProof) VerifyNonMembership(specs []*ics23.ProofSpec, root exported
 	return verifyChainedMembershipProof(root.GetHash(), specs, p.Proofs, mpath, subroot, 1)
 }
 
+// VerifyBatchMembership verifies the membership of many values against the given root with a single merkle proof.
+// The lowest proof must be an ICS-23 batch proof, optionally compressed, holding an existence proof for the key of
+// each path in the lowest subtree. The paths must only differ in their last key, so that the remaining proofs chain
+// the shared subroot up to the root.
+func (p MerkleProof) VerifyBatchMembership(specs []*ics23.ProofSpec, root exported.Root, paths []exported.Path, values [][]byte) error {
+	if len(paths) == 0 {
+		return errorsmod.Wrap(ErrInvalidProof, "batch must contain at least one path")
+	}
+
+	if len(paths) != len(values) {
+		return errorsmod.Wrapf(ErrInvalidProof, "number of paths %d not equal to number of values %d", len(paths), len(values))
+	}
+
+	mpaths := make([]v2.MerklePath, len(paths))
+	for i, path := range paths {
+		mpath, ok := path.(v2.MerklePath)
+		if !ok {
+			return errorsmod.Wrapf(ErrInvalidProof, "path %v is not of type MerklePath", path)
+		}
+
+		if err := validateVerificationArgs(p, mpath, specs, root); err != nil {
+			return err
+		}
+
+		mpaths[i] = mpath
+
+		// validateVerificationArgs ensures all paths have the same length as the specs
+		if !slices.EqualFunc(mpath.KeyPath[:len(mpath.KeyPath)-1], mpaths[0].KeyPath[:len(mpath.KeyPath)-1], bytes.Equal) {
+			return errorsmod.Wrapf(ErrInvalidProof, "path at index %d does not share the prefix of the first path", i)
+		}
+	}
+
+	// Every entry of the batch must calculate the same subroot, which is checked by verifying each entry against it
+	batchProof := ics23.Decompress(p.Proofs[0])
+	subroot, err := batchProof.Calculate()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "could not calculate root for proof index 0, merkle tree is likely empty. %v", err)
+	}
+
+	for i, mpath := range mpaths {
+		// VerifyBatchMembership specific argument validation
+		if len(values[i]) == 0 {
+			return errorsmod.Wrapf(ErrInvalidProof, "empty value in membership proof at index %d", i)
+		}
+
+		key, err := mpath.GetKey(uint64(len(mpath.KeyPath) - 1))
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidProof, "could not retrieve key bytes for key: %s", mpath.KeyPath[len(mpath.KeyPath)-1])
+		}
+
+		if !ics23.VerifyMembership(specs[0], subroot, batchProof, key, values[i]) {
+			return errorsmod.Wrapf(ErrInvalidProof, "failed to verify batch membership proof with key %s at index %d", string(key), i)
+		}
+	}
+
+	// Verify chained membership proof starting from index 1 with value = subroot
+	return verifyChainedMembershipProof(root.GetHash(), specs, p.Proofs, mpaths[0], subroot, 1)
+}
+
 // verifyChainedMembershipProof takes a list of proofs and specs and verifies each proof sequentially ensuring that the value is committed to
 // by first proof and each subsequent subroot is committed to by the next subroot and checking that the final calculated root is equal to the given roothash.
 // The proofs and specs are passed in from lowest subtree to the highest subtree, but the keys are passed in from highest subtree to lowest.
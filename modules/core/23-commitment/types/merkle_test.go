@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types/v2"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
 )
 
 func (s *MerkleTestSuite) TestVerifyMembership() {
@@ -135,6 +136,152 @@ func (s *MerkleTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (s *MerkleTestSuite) TestVerifyBatchMembership() {
+	var (
+		proof  types.MerkleProof
+		root   []byte
+		paths  []exported.Path
+		values [][]byte
+	)
+
+	keys := []string{"KEY1", "KEY2", "KEY3"}
+	for _, key := range keys {
+		s.kvStore.Set([]byte(key), []byte("VALUE"+key))
+	}
+	cid := s.store.Commit()
+
+	queryProof := func(key string) types.MerkleProof {
+		res, err := s.store.Query(&storetypes.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", s.storeKey.Name()), // required path to get key/value+proof
+			Data:  []byte(key),
+			Prove: true,
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(res.ProofOps)
+
+		proof, err := types.ConvertProofs(res.ProofOps)
+		s.Require().NoError(err)
+		return proof
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: subset of the batch",
+			func() {
+				paths = paths[1:]
+				values = values[1:]
+			},
+			nil,
+		},
+		{
+			"success: single existence proof",
+			func() {
+				proof = queryProof(keys[0])
+				paths = paths[:1]
+				values = values[:1]
+			},
+			nil,
+		},
+		{
+			"failure: wrong value",
+			func() {
+				values[1] = []byte("WRONGVALUE")
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: empty value",
+			func() {
+				values[1] = nil
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: key not in batch",
+			func() {
+				proof = queryProof(keys[0])
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: paths do not share the store key",
+			func() {
+				paths[1] = types.NewMerklePath([]byte("otherStoreKey"), []byte(keys[1]))
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: number of paths and values differ",
+			func() {
+				values = values[1:]
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: empty batch",
+			func() {
+				paths = nil
+				values = nil
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: wrong root",
+			func() {
+				root = []byte("WRONGROOT")
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: proof is wrong length",
+			func() {
+				proof = types.MerkleProof{
+					Proofs: proof.Proofs[1:],
+				}
+			},
+			types.ErrInvalidMerkleProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			proofs := make([]types.MerkleProof, len(keys))
+			paths = make([]exported.Path, len(keys))
+			values = make([][]byte, len(keys))
+			for i, key := range keys {
+				proofs[i] = queryProof(key)
+				paths[i] = types.NewMerklePath([]byte(s.storeKey.Name()), []byte(key))
+				values[i] = []byte("VALUE" + key)
+			}
+
+			var err error
+			proof, err = types.CombineMerkleProofs(proofs)
+			s.Require().NoError(err)
+			root = cid.Hash
+
+			tc.malleate()
+
+			merkleRoot := types.NewMerkleRoot(root)
+			err = proof.VerifyBatchMembership(types.GetSDKSpecs(), &merkleRoot, paths, values)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func TestApplyPrefix(t *testing.T) {
 	prefix := types.NewMerklePrefix([]byte("storePrefixKey"))
 
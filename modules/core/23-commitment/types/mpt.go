@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// branchNodeLength is the number of RLP items in a Merkle-Patricia trie branch node.
+	branchNodeLength = 17
+	// shortNodeLength is the number of RLP items in a Merkle-Patricia trie extension or leaf node.
+	shortNodeLength = 2
+)
+
+// VerifyMPTProof verifies an Ethereum Merkle-Patricia trie proof for the provided key against the trie root.
+// The proof consists of the RLP-encoded trie nodes along the path to the key. The value stored at the key
+// is returned, or nil if the proof shows that the key is absent from the trie. Values of the Ethereum state
+// and storage tries are themselves RLP-encoded and keyed by the keccak256 hash of the account or slot.
+func VerifyMPTProof(root common.Hash, key []byte, proof [][]byte) ([]byte, error) {
+	nodes := make(map[common.Hash][]byte, len(proof))
+	for _, node := range proof {
+		nodes[crypto.Keccak256Hash(node)] = node
+	}
+
+	nibbles := keyToNibbles(key)
+	node, ok := nodes[root]
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "proof node for root %s not found", root.Hex())
+	}
+
+	for {
+		items, err := splitRLPList(node)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "failed to decode trie node: %v", err)
+		}
+
+		var child []byte
+		switch len(items) {
+		case branchNodeLength:
+			if len(nibbles) == 0 {
+				return decodeMPTValue(items[branchNodeLength-1])
+			}
+
+			child = items[nibbles[0]]
+			nibbles = nibbles[1:]
+		case shortNodeLength:
+			encodedPath, _, err := rlp.SplitString(items[0])
+			if err != nil {
+				return nil, errorsmod.Wrapf(ErrInvalidProof, "failed to decode trie node path: %v", err)
+			}
+
+			path, isLeaf := decodeHexPrefix(encodedPath)
+			if isLeaf {
+				if !bytes.Equal(path, nibbles) {
+					return nil, nil
+				}
+
+				return decodeMPTValue(items[1])
+			}
+
+			if len(nibbles) < len(path) || !bytes.Equal(path, nibbles[:len(path)]) {
+				return nil, nil
+			}
+
+			child = items[1]
+			nibbles = nibbles[len(path):]
+		default:
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "invalid trie node with %d items", len(items))
+		}
+
+		kind, content, _, err := rlp.Split(child)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "failed to decode trie node reference: %v", err)
+		}
+
+		switch {
+		case kind == rlp.List:
+			// nodes shorter than 32 bytes are embedded in their parent
+			node = child
+		case len(content) == 0:
+			return nil, nil
+		case len(content) == common.HashLength:
+			node, ok = nodes[common.BytesToHash(content)]
+			if !ok {
+				return nil, errorsmod.Wrapf(ErrInvalidProof, "proof node for hash %x not found", content)
+			}
+		default:
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "invalid trie node reference of length %d", len(content))
+		}
+	}
+}
+
+// splitRLPList returns the RLP-encoded items of the provided RLP list.
+func splitRLPList(encoded []byte) ([][]byte, error) {
+	content, _, err := rlp.SplitList(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var items [][]byte
+	for len(content) > 0 {
+		_, _, rest, err := rlp.Split(content)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, content[:len(content)-len(rest)])
+		content = rest
+	}
+
+	return items, nil
+}
+
+// decodeMPTValue returns the content of the RLP string stored as a trie value, or nil if it is empty.
+func decodeMPTValue(item []byte) ([]byte, error) {
+	value, _, err := rlp.SplitString(item)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "failed to decode trie value: %v", err)
+	}
+
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	return value, nil
+}
+
+// keyToNibbles splits each byte of the key into two 4-bit nibbles.
+func keyToNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, len(key)*2)
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+
+	return nibbles
+}
+
+// decodeHexPrefix decodes the hex-prefix encoded path of an extension or leaf node into nibbles
+// and reports whether the node is a leaf.
+func decodeHexPrefix(encoded []byte) ([]byte, bool) {
+	if len(encoded) == 0 {
+		return nil, false
+	}
+
+	nibbles := keyToNibbles(encoded)
+	flag := nibbles[0]
+	isLeaf := flag&0x2 != 0
+	if flag&0x1 != 0 {
+		// odd length paths store the first nibble alongside the flag
+		return nibbles[1:], isLeaf
+	}
+
+	return nibbles[2:], isLeaf
+}
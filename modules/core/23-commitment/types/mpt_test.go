@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/trie/trienode"
+
+	"github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+)
+
+// dogsTrieRoot is the root of the "dogs" trie of the Ethereum trie tests, holding the unhashed keys
+// doe, dog and dogglesworth.
+var dogsTrieRoot = common.HexToHash("0x8aad789dff2f538bca5d8ea56e8abe10f4c7ba3a5dea95fea4cd6e7c3a1168d3")
+
+func (s *MerkleTestSuite) TestVerifyMPTProof() {
+	dogsTrie := trie.NewEmpty(nil)
+	for _, kv := range [][2]string{{"doe", "reindeer"}, {"dog", "puppy"}, {"dogglesworth", "cat"}} {
+		s.Require().NoError(dogsTrie.Update([]byte(kv[0]), []byte(kv[1])))
+	}
+	s.Require().Equal(dogsTrieRoot, dogsTrie.Hash())
+
+	var (
+		key   []byte
+		proof [][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expValue []byte
+		expErr   error
+	}{
+		{
+			"success: leaf",
+			func() {},
+			[]byte("puppy"),
+			nil,
+		},
+		{
+			"success: leaf behind extension",
+			func() {
+				key = []byte("dogglesworth")
+				proof = mptProof(s, dogsTrie, key)
+			},
+			[]byte("cat"),
+			nil,
+		},
+		{
+			"success: absent key diverging from extension",
+			func() {
+				key = []byte("cat")
+				proof = mptProof(s, dogsTrie, key)
+			},
+			nil,
+			nil,
+		},
+		{
+			"success: absent key diverging from leaf",
+			func() {
+				key = []byte("dogs")
+				proof = mptProof(s, dogsTrie, key)
+			},
+			nil,
+			nil,
+		},
+		{
+			"failure: tampered node",
+			func() {
+				last := proof[len(proof)-1]
+				tampered := append([]byte(nil), last...)
+				tampered[len(tampered)-1] ^= 0x01
+				proof[len(proof)-1] = tampered
+			},
+			nil,
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: missing root node",
+			func() {
+				proof = proof[1:]
+			},
+			nil,
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: empty proof",
+			func() {
+				proof = nil
+			},
+			nil,
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: malformed node",
+			func() {
+				proof = [][]byte{{0x01, 0x02}}
+			},
+			nil,
+			types.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			key = []byte("dog")
+			proof = mptProof(s, dogsTrie, key)
+
+			tc.malleate()
+
+			value, err := types.VerifyMPTProof(dogsTrieRoot, key, proof)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expValue, value)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// mptProof returns the nodes of the Merkle-Patricia trie proof of the key.
+func mptProof(s *MerkleTestSuite, t *trie.Trie, key []byte) [][]byte {
+	proofList := trienode.ProofList{}
+	s.Require().NoError(t.Prove(key, &proofList))
+
+	nodes := make([][]byte, len(proofList))
+	for i, node := range proofList {
+		nodes[i] = node
+	}
+
+	return nodes
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"bytes"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types/v2"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+// ProofVerifier verifies encoded commitment proofs of a single proof format against a commitment root.
+// Light clients select the verifier of a client from its configured ProofFormat with NewProofVerifier.
+type ProofVerifier interface {
+	// VerifyMembership verifies that the value is stored at the path.
+	VerifyMembership(root exported.Root, path exported.Path, proof []byte, value []byte) error
+	// VerifyNonMembership verifies that no value is stored at the path.
+	VerifyNonMembership(root exported.Root, path exported.Path, proof []byte) error
+}
+
+var (
+	_ ProofVerifier = (*ICS23ProofVerifier)(nil)
+	_ ProofVerifier = (*MPTProofVerifier)(nil)
+	_ ProofVerifier = (*SMTProofVerifier)(nil)
+)
+
+// NewProofVerifier returns the verifier of the provided proof format. ICS-23 proofs are verified with the
+// provided proof specs, which are ignored by the other proof formats.
+func NewProofVerifier(format ProofFormat, specs []*ics23.ProofSpec) (ProofVerifier, error) {
+	switch format {
+	case ICS23:
+		return ICS23ProofVerifier{Specs: specs}, nil
+	case MPT:
+		return MPTProofVerifier{}, nil
+	case SMT:
+		return SMTProofVerifier{}, nil
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidProofFormat, "unsupported proof format: %s", format)
+	}
+}
+
+// ICS23ProofVerifier verifies proofs encoded as a MerkleProof of chained ICS-23 commitment proofs.
+type ICS23ProofVerifier struct {
+	Specs []*ics23.ProofSpec
+}
+
+// VerifyMembership implements ProofVerifier.
+func (v ICS23ProofVerifier) VerifyMembership(root exported.Root, path exported.Path, proof []byte, value []byte) error {
+	var merkleProof MerkleProof
+	if err := merkleProof.Unmarshal(proof); err != nil {
+		return errorsmod.Wrap(ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+	}
+
+	return merkleProof.VerifyMembership(v.Specs, root, path, value)
+}
+
+// VerifyNonMembership implements ProofVerifier.
+func (v ICS23ProofVerifier) VerifyNonMembership(root exported.Root, path exported.Path, proof []byte) error {
+	var merkleProof MerkleProof
+	if err := merkleProof.Unmarshal(proof); err != nil {
+		return errorsmod.Wrap(ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+	}
+
+	return merkleProof.VerifyNonMembership(v.Specs, root, path)
+}
+
+// MPTProofVerifier verifies proofs encoded as an MPTProof against the root of an Ethereum Merkle-Patricia
+// trie. The trie key of a path is the keccak256 hash of the concatenation of its keys, and the value is
+// compared with the bytes stored in the trie as is.
+type MPTProofVerifier struct{}
+
+// VerifyMembership implements ProofVerifier.
+func (MPTProofVerifier) VerifyMembership(root exported.Root, path exported.Path, proof []byte, value []byte) error {
+	if len(value) == 0 {
+		return errorsmod.Wrap(ErrInvalidProof, "empty value in membership proof")
+	}
+
+	storedValue, err := verifyMPTPath(root, path, proof)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(storedValue, value) {
+		return errorsmod.Wrapf(ErrInvalidProof, "value stored in trie does not match: expected %X, got %X", value, storedValue)
+	}
+
+	return nil
+}
+
+// VerifyNonMembership implements ProofVerifier.
+func (MPTProofVerifier) VerifyNonMembership(root exported.Root, path exported.Path, proof []byte) error {
+	storedValue, err := verifyMPTPath(root, path, proof)
+	if err != nil {
+		return err
+	}
+
+	if storedValue != nil {
+		return errorsmod.Wrap(ErrInvalidProof, "key is present in trie")
+	}
+
+	return nil
+}
+
+// verifyMPTPath verifies the encoded MPTProof of the path and returns the value stored in the trie,
+// or nil if the path is absent.
+func verifyMPTPath(root exported.Root, path exported.Path, proof []byte) ([]byte, error) {
+	key, err := proofKey(root, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var mptProof MPTProof
+	if err := mptProof.Unmarshal(proof); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidProof, "failed to unmarshal proof into MPT proof")
+	}
+
+	return VerifyMPTProof(common.BytesToHash(root.GetHash()), crypto.Keccak256(key), mptProof.Nodes)
+}
+
+// SMTProofVerifier verifies proofs encoded as a SparseMerkleProof against the root of a sha256 sparse
+// Merkle tree. The tree key of a path is the concatenation of its keys.
+type SMTProofVerifier struct{}
+
+// VerifyMembership implements ProofVerifier.
+func (SMTProofVerifier) VerifyMembership(root exported.Root, path exported.Path, proof []byte, value []byte) error {
+	if len(value) == 0 {
+		return errorsmod.Wrap(ErrInvalidProof, "empty value in membership proof")
+	}
+
+	return verifySMTPath(root, path, proof, value)
+}
+
+// VerifyNonMembership implements ProofVerifier.
+func (SMTProofVerifier) VerifyNonMembership(root exported.Root, path exported.Path, proof []byte) error {
+	return verifySMTPath(root, path, proof, nil)
+}
+
+// verifySMTPath verifies the encoded SparseMerkleProof of the value at the path, or of its absence if the value is nil.
+func verifySMTPath(root exported.Root, path exported.Path, proof []byte, value []byte) error {
+	key, err := proofKey(root, path)
+	if err != nil {
+		return err
+	}
+
+	var smtProof SparseMerkleProof
+	if err := smtProof.Unmarshal(proof); err != nil {
+		return errorsmod.Wrap(ErrInvalidProof, "failed to unmarshal proof into sparse merkle proof")
+	}
+
+	return VerifySparseMerkleProof(root.GetHash(), key, value, smtProof)
+}
+
+// proofKey validates the 32-byte root and returns the concatenation of the keys of the merkle path, which is
+// the key committed to by single tree proof formats.
+func proofKey(root exported.Root, path exported.Path) ([]byte, error) {
+	if root == nil || len(root.GetHash()) != common.HashLength {
+		return nil, errorsmod.Wrapf(ErrInvalidMerkleProof, "root must be %d bytes", common.HashLength)
+	}
+
+	mpath, ok := path.(v2.MerklePath)
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "path %v is not of type MerklePath", path)
+	}
+
+	key := bytes.Join(mpath.KeyPath, nil)
+	if len(key) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidProof, "path cannot be empty")
+	}
+
+	return key, nil
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+func (s *MerkleTestSuite) TestNewProofVerifier() {
+	testCases := []struct {
+		name   string
+		format types.ProofFormat
+		expErr error
+	}{
+		{"success: ics23", types.ICS23, nil},
+		{"success: mpt", types.MPT, nil},
+		{"success: smt", types.SMT, nil},
+		{"failure: unspecified", types.UNSPECIFIED, types.ErrInvalidProofFormat},
+		{"failure: unknown", types.ProofFormat(100), types.ErrInvalidProofFormat},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			verifier, err := types.NewProofVerifier(tc.format, types.GetSDKSpecs())
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(verifier)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Nil(verifier)
+			}
+		})
+	}
+}
+
+func (s *MerkleTestSuite) TestProofVerifiers() {
+	storeKey := []byte(s.storeKey.Name())
+	existsKey, absentKey := []byte("commitments/1"), []byte("commitments/2")
+	value := []byte("commitment")
+	existsPath := types.NewMerklePath(storeKey, existsKey)
+	absentPath := types.NewMerklePath(storeKey, absentKey)
+
+	type proofs struct {
+		root       exported.Root
+		membership []byte
+		absence    []byte
+	}
+
+	ics23Proofs := func() proofs {
+		s.kvStore.Set(existsKey, value)
+		cid := s.store.Commit()
+
+		query := func(key []byte) []byte {
+			res, err := s.store.Query(&storetypes.RequestQuery{
+				Path:  fmt.Sprintf("/%s/key", s.storeKey.Name()),
+				Data:  key,
+				Prove: true,
+			})
+			s.Require().NoError(err)
+
+			proof, err := types.ConvertProofs(res.ProofOps)
+			s.Require().NoError(err)
+
+			bz, err := proof.Marshal()
+			s.Require().NoError(err)
+			return bz
+		}
+
+		return proofs{types.NewMerkleRoot(cid.Hash), query(existsKey), query(absentKey)}
+	}
+
+	mptProofs := func() proofs {
+		secureTrie := trie.NewEmpty(nil)
+		s.Require().NoError(secureTrie.Update(crypto.Keccak256(storeKey, existsKey), value))
+		s.Require().NoError(secureTrie.Update(crypto.Keccak256(storeKey, []byte("other")), []byte("other")))
+
+		prove := func(key []byte) []byte {
+			proof := types.MPTProof{Nodes: mptProof(s, secureTrie, crypto.Keccak256(storeKey, key))}
+			bz, err := proof.Marshal()
+			s.Require().NoError(err)
+			return bz
+		}
+
+		return proofs{types.NewMerkleRoot(secureTrie.Hash().Bytes()), prove(existsKey), prove(absentKey)}
+	}
+
+	smtProofs := func() proofs {
+		tree := newSMT(map[string]string{
+			string(storeKey) + string(existsKey): string(value),
+			"other":                              "other",
+		})
+
+		prove := func(key []byte) []byte {
+			proof := tree.prove(append(append([]byte(nil), storeKey...), key...))
+			bz, err := proof.Marshal()
+			s.Require().NoError(err)
+			return bz
+		}
+
+		return proofs{types.NewMerkleRoot(tree.root()), prove(existsKey), prove(absentKey)}
+	}
+
+	for _, tc := range []struct {
+		format types.ProofFormat
+		proofs func() proofs
+	}{
+		{types.ICS23, ics23Proofs},
+		{types.MPT, mptProofs},
+		{types.SMT, smtProofs},
+	} {
+		s.Run(tc.format.String(), func() {
+			p := tc.proofs()
+
+			verifier, err := types.NewProofVerifier(tc.format, types.GetSDKSpecs())
+			s.Require().NoError(err)
+
+			s.Require().NoError(verifier.VerifyMembership(p.root, existsPath, p.membership, value))
+			s.Require().NoError(verifier.VerifyNonMembership(p.root, absentPath, p.absence))
+
+			s.Require().ErrorIs(verifier.VerifyMembership(p.root, existsPath, p.membership, []byte("WRONGVALUE")), types.ErrInvalidProof)
+			s.Require().ErrorIs(verifier.VerifyMembership(p.root, existsPath, p.membership, nil), types.ErrInvalidProof)
+			s.Require().ErrorIs(verifier.VerifyMembership(p.root, existsPath, []byte("invalid"), value), types.ErrInvalidProof)
+			s.Require().Error(verifier.VerifyMembership(types.NewMerkleRoot(make([]byte, 32)), existsPath, p.membership, value))
+			s.Require().Error(verifier.VerifyMembership(p.root, absentPath, p.absence, value))
+			s.Require().Error(verifier.VerifyNonMembership(p.root, existsPath, p.membership))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// smtDepth is the depth of a sparse Merkle tree keyed by sha256 hashes.
+const smtDepth = sha256.Size * 8
+
+var (
+	// smtLeafPrefix is prepended to the path and value hash of a leaf before hashing.
+	smtLeafPrefix = []byte{0}
+	// smtNodePrefix is prepended to the child hashes of an inner node before hashing.
+	smtNodePrefix = []byte{1}
+	// smtPlaceholder is the hash of an empty subtree.
+	smtPlaceholder = make([]byte, sha256.Size)
+)
+
+// VerifySparseMerkleProof verifies a sparse Merkle tree proof for the provided key against the tree root.
+// A nil value verifies that the key is absent from the tree. The tree follows the celestiaorg/smt layout,
+// also described by the ics23 SmtSpec: the path of a key is its sha256 hash, leaves are hashed as
+// sha256(0x00 || path || sha256(value)), inner nodes as sha256(0x01 || left || right) and empty subtrees
+// are represented by 32 zero bytes. Leaves are placed at the shortest prefix of their path that is unique
+// in the tree, so the number of side nodes of a proof is the depth of the leaf.
+func VerifySparseMerkleProof(root, key, value []byte, proof SparseMerkleProof) error {
+	if len(proof.SideNodes) > smtDepth {
+		return errorsmod.Wrapf(ErrInvalidProof, "number of side nodes %d exceeds tree depth %d", len(proof.SideNodes), smtDepth)
+	}
+
+	path := sha256.Sum256(key)
+	depth := len(proof.SideNodes)
+
+	var current []byte
+	switch {
+	case value != nil:
+		if len(proof.NonMembershipLeafData) != 0 {
+			return errorsmod.Wrap(ErrInvalidProof, "membership proof cannot contain non-membership leaf data")
+		}
+
+		valueHash := sha256.Sum256(value)
+		current = smtLeafHash(path[:], valueHash[:])
+	case len(proof.NonMembershipLeafData) == 0:
+		current = smtPlaceholder
+	default:
+		leafPath, valueHash, err := parseSMTLeaf(proof.NonMembershipLeafData)
+		if err != nil {
+			return err
+		}
+
+		if bytes.Equal(leafPath, path[:]) {
+			return errorsmod.Wrap(ErrInvalidProof, "non-membership leaf data is the leaf of the key")
+		}
+
+		for i := range depth {
+			if smtBit(leafPath, i) != smtBit(path[:], i) {
+				return errorsmod.Wrapf(ErrInvalidProof, "non-membership leaf does not share the path of the key up to depth %d", depth)
+			}
+		}
+
+		current = smtLeafHash(leafPath, valueHash)
+	}
+
+	for i, sideNode := range proof.SideNodes {
+		if len(sideNode) != sha256.Size {
+			return errorsmod.Wrapf(ErrInvalidProof, "side node at index %d must be %d bytes, got %d", i, sha256.Size, len(sideNode))
+		}
+
+		if smtBit(path[:], depth-1-i) == 1 {
+			current = smtNodeHash(sideNode, current)
+		} else {
+			current = smtNodeHash(current, sideNode)
+		}
+	}
+
+	if !bytes.Equal(root, current) {
+		return errorsmod.Wrapf(ErrInvalidProof, "proof did not commit to expected root: %X, got: %X", root, current)
+	}
+
+	return nil
+}
+
+// parseSMTLeaf returns the path and value hash of the provided leaf data.
+func parseSMTLeaf(data []byte) ([]byte, []byte, error) {
+	if len(data) != len(smtLeafPrefix)+2*sha256.Size || !bytes.HasPrefix(data, smtLeafPrefix) {
+		return nil, nil, errorsmod.Wrapf(ErrInvalidProof, "invalid non-membership leaf data: %X", data)
+	}
+
+	data = data[len(smtLeafPrefix):]
+	return data[:sha256.Size], data[sha256.Size:], nil
+}
+
+// smtLeafHash returns the hash of a leaf with the provided path and value hash.
+func smtLeafHash(path, valueHash []byte) []byte {
+	hash := sha256.Sum256(bytes.Join([][]byte{smtLeafPrefix, path, valueHash}, nil))
+	return hash[:]
+}
+
+// smtNodeHash returns the hash of an inner node with the provided children.
+func smtNodeHash(left, right []byte) []byte {
+	hash := sha256.Sum256(bytes.Join([][]byte{smtNodePrefix, left, right}, nil))
+	return hash[:]
+}
+
+// smtBit returns the bit of the path at the provided depth, counting from the most significant bit.
+func smtBit(path []byte, depth int) byte {
+	return (path[depth/8] >> (7 - depth%8)) & 1
+}
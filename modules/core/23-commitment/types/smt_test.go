@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	"github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+)
+
+// smtTestRoot is the root of the sparse Merkle tree holding smtTestLeaves.
+const smtTestRoot = "5aabfd3a8ba7daa195569ab6079bc86d3396e58f63fc0bea149e7923ddf1ff9f"
+
+var smtTestLeaves = map[string]string{
+	"foo":                    "bar",
+	"client/07-tendermint-0": "clientState",
+	"commitments/ports/transfer/channels/channel-0/sequences/1": "commitment",
+	"acks/ports/transfer/channels/channel-0/sequences/1":        "ack",
+}
+
+func (s *MerkleTestSuite) TestVerifySparseMerkleProof() {
+	tree := newSMT(smtTestLeaves)
+	root := tree.root()
+
+	var (
+		key   []byte
+		value []byte
+		proof types.SparseMerkleProof
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: membership",
+			func() {},
+			nil,
+		},
+		{
+			"success: non-membership of empty subtree",
+			func() {
+				key, value = nil, nil
+				for i := 0; ; i++ {
+					key = []byte{byte(i)}
+					proof = tree.prove(key)
+					if len(proof.NonMembershipLeafData) == 0 {
+						break
+					}
+				}
+			},
+			nil,
+		},
+		{
+			"success: non-membership of unrelated leaf",
+			func() {
+				key, value = nil, nil
+				for i := 0; ; i++ {
+					key = []byte{byte(i)}
+					proof = tree.prove(key)
+					if len(proof.NonMembershipLeafData) != 0 {
+						break
+					}
+				}
+			},
+			nil,
+		},
+		{
+			"failure: wrong value",
+			func() {
+				value = []byte("WRONGVALUE")
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: non-membership of present key",
+			func() {
+				value = nil
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: non-membership with leaf of the key",
+			func() {
+				value = nil
+				path := sha256.Sum256(key)
+				valueHash := sha256.Sum256([]byte("commitment"))
+				proof.NonMembershipLeafData = append(append([]byte{0}, path[:]...), valueHash[:]...)
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: membership with non-membership leaf data",
+			func() {
+				proof.NonMembershipLeafData = make([]byte, 1+2*sha256.Size)
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: invalid side node length",
+			func() {
+				proof.SideNodes[0] = proof.SideNodes[0][1:]
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: missing side node",
+			func() {
+				proof.SideNodes = proof.SideNodes[1:]
+			},
+			types.ErrInvalidProof,
+		},
+		{
+			"failure: too many side nodes",
+			func() {
+				proof.SideNodes = make([][]byte, 257)
+			},
+			types.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			key = []byte("commitments/ports/transfer/channels/channel-0/sequences/1")
+			value = []byte("commitment")
+			proof = tree.prove(key)
+
+			tc.malleate()
+
+			err := types.VerifySparseMerkleProof(root, key, value, proof)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestSparseMerkleProofICS23Compatibility checks the tree layout and membership proofs against the
+// ics23 SmtSpec reference verifier of celestiaorg/smt trees.
+func (s *MerkleTestSuite) TestSparseMerkleProofICS23Compatibility() {
+	tree := newSMT(smtTestLeaves)
+	root := tree.root()
+	s.Require().Equal(smtTestRoot, hex.EncodeToString(root))
+
+	for key, value := range smtTestLeaves {
+		proof := tree.prove([]byte(key))
+		s.Require().NoError(types.VerifySparseMerkleProof(root, []byte(key), []byte(value), proof))
+
+		existenceProof := &ics23.ExistenceProof{
+			Key:   []byte(key),
+			Value: []byte(value),
+			Leaf:  ics23.SmtSpec.LeafSpec,
+		}
+
+		path := sha256.Sum256([]byte(key))
+		for i, sideNode := range proof.SideNodes {
+			depth := len(proof.SideNodes) - 1 - i
+			if smtTestBit(path[:], depth) == 1 {
+				existenceProof.Path = append(existenceProof.Path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: append([]byte{1}, sideNode...)})
+			} else {
+				existenceProof.Path = append(existenceProof.Path, &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: []byte{1}, Suffix: sideNode})
+			}
+		}
+
+		commitmentProof := &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: existenceProof}}
+		s.Require().True(ics23.VerifyMembership(ics23.SmtSpec, root, commitmentProof, []byte(key), []byte(value)), key)
+	}
+}
+
+// smt is a reference sparse Merkle tree placing each leaf at the shortest unique prefix of its path.
+type smt struct {
+	leaves []smtLeaf
+}
+
+type smtLeaf struct {
+	path      []byte
+	valueHash []byte
+}
+
+func newSMT(kvs map[string]string) smt {
+	var tree smt
+	for key, value := range kvs {
+		path := sha256.Sum256([]byte(key))
+		valueHash := sha256.Sum256([]byte(value))
+		tree.leaves = append(tree.leaves, smtLeaf{path: path[:], valueHash: valueHash[:]})
+	}
+
+	return tree
+}
+
+func (t smt) root() []byte {
+	return smtTestSubtreeRoot(t.leaves, 0)
+}
+
+// prove returns the proof of the key, a non-membership proof if the key is not in the tree.
+func (t smt) prove(key []byte) types.SparseMerkleProof {
+	path := sha256.Sum256(key)
+
+	var sideNodes [][]byte
+	leaves := t.leaves
+	for depth := 0; len(leaves) > 1; depth++ {
+		left, right := smtTestSplit(leaves, depth)
+		if smtTestBit(path[:], depth) == 1 {
+			sideNodes = append([][]byte{smtTestSubtreeRoot(left, depth+1)}, sideNodes...)
+			leaves = right
+		} else {
+			sideNodes = append([][]byte{smtTestSubtreeRoot(right, depth+1)}, sideNodes...)
+			leaves = left
+		}
+	}
+
+	proof := types.SparseMerkleProof{SideNodes: sideNodes}
+	if len(leaves) == 1 && !bytes.Equal(leaves[0].path, path[:]) {
+		proof.NonMembershipLeafData = append(append([]byte{0}, leaves[0].path...), leaves[0].valueHash...)
+	}
+
+	return proof
+}
+
+func smtTestSubtreeRoot(leaves []smtLeaf, depth int) []byte {
+	switch len(leaves) {
+	case 0:
+		return make([]byte, sha256.Size)
+	case 1:
+		hash := sha256.Sum256(append(append([]byte{0}, leaves[0].path...), leaves[0].valueHash...))
+		return hash[:]
+	default:
+		left, right := smtTestSplit(leaves, depth)
+		hash := sha256.Sum256(append(append([]byte{1}, smtTestSubtreeRoot(left, depth+1)...), smtTestSubtreeRoot(right, depth+1)...))
+		return hash[:]
+	}
+}
+
+func smtTestSplit(leaves []smtLeaf, depth int) ([]smtLeaf, []smtLeaf) {
+	var left, right []smtLeaf
+	for _, leaf := range leaves {
+		if smtTestBit(leaf.path, depth) == 1 {
+			right = append(right, leaf)
+		} else {
+			left = append(left, leaf)
+		}
+	}
+
+	return left, right
+}
+
+func smtTestBit(path []byte, depth int) byte {
+	return (path[depth/8] >> (7 - depth%8)) & 1
+}
@@ -3,6 +3,9 @@
 package types
 
 import (
+	"slices"
+
+	"github.com/cosmos/gogoproto/proto"
 	ics23 "github.com/cosmos/ics23/go"
 
 	errorsmod "cosmossdk.io/errors"
@@ -29,3 +32,50 @@ func ConvertProofs(tmProof *crypto.ProofOps) (MerkleProof, error) {
 		Proofs: proofs,
 	}, nil
 }
+
+// CombineMerkleProofs combines merkle proofs of keys in the same subtree, queried at the same height, into a single
+// merkle proof for VerifyBatchMembership. The lowest proofs are combined into a compressed ICS-23 batch proof and the
+// remaining proofs, which must be equal across all merkle proofs, are shared.
+func CombineMerkleProofs(proofs []MerkleProof) (MerkleProof, error) {
+	if len(proofs) == 0 {
+		return MerkleProof{}, errorsmod.Wrap(ErrInvalidMerkleProof, "proofs cannot be empty")
+	}
+
+	lowestProofs := make([]*ics23.CommitmentProof, len(proofs))
+	for i, proof := range proofs {
+		if len(proof.Proofs) == 0 {
+			return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "proof at index %d is empty", i)
+		}
+
+		if !slices.EqualFunc(proof.Proofs[1:], proofs[0].Proofs[1:], func(a, b *ics23.CommitmentProof) bool { return proto.Equal(a, b) }) {
+			return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "proof at index %d does not share the subtree proofs of the first proof", i)
+		}
+
+		lowestProofs[i] = proof.Proofs[0]
+	}
+
+	batchProof, err := ics23.CombineProofs(lowestProofs)
+	if err != nil {
+		return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "could not combine proofs: %v", err)
+	}
+
+	return MerkleProof{
+		Proofs: append([]*ics23.CommitmentProof{batchProof}, proofs[0].Proofs[1:]...),
+	}, nil
+}
+
+// ConvertBatchProofs converts the crypto.ProofOps of keys in the same subtree, queried at the same height, into a
+// single merkle proof for VerifyBatchMembership whose lowest proof is a compressed ICS-23 batch proof.
+func ConvertBatchProofs(tmProofs []*crypto.ProofOps) (MerkleProof, error) {
+	proofs := make([]MerkleProof, len(tmProofs))
+	for i, tmProof := range tmProofs {
+		proof, err := ConvertProofs(tmProof)
+		if err != nil {
+			return MerkleProof{}, errorsmod.Wrapf(err, "could not convert proof at index %d", i)
+		}
+
+		proofs[i] = proof
+	}
+
+	return CombineMerkleProofs(proofs)
+}
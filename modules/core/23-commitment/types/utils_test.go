@@ -10,6 +10,7 @@ import (
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	"github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
 )
 
 func (s *MerkleTestSuite) TestConvertProofs() {
@@ -102,3 +103,147 @@ func (s *MerkleTestSuite) TestConvertProofs() {
 		}
 	}
 }
+
+func (s *MerkleTestSuite) TestCombineMerkleProofs() {
+	queryProof := func(key string, height int64) types.MerkleProof {
+		res, err := s.store.Query(&storetypes.RequestQuery{
+			Path:   fmt.Sprintf("/%s/key", s.storeKey.Name()),
+			Data:   []byte(key),
+			Height: height,
+			Prove:  true,
+		})
+		s.Require().NoError(err)
+
+		proof, err := types.ConvertProofs(res.ProofOps)
+		s.Require().NoError(err)
+		return proof
+	}
+
+	s.kvStore.Set([]byte("KEY1"), []byte("VALUE1"))
+	staleCid := s.store.Commit()
+	staleProof := queryProof("KEY1", staleCid.Version)
+
+	s.kvStore.Set([]byte("KEY2"), []byte("VALUE2"))
+	cid := s.store.Commit()
+
+	var proofs []types.MerkleProof
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: empty proofs",
+			func() {
+				proofs = nil
+			},
+			types.ErrInvalidMerkleProof,
+		},
+		{
+			"failure: empty proof",
+			func() {
+				proofs[1] = types.MerkleProof{}
+			},
+			types.ErrInvalidMerkleProof,
+		},
+		{
+			"failure: proofs queried at different heights",
+			func() {
+				proofs[1] = staleProof
+			},
+			types.ErrInvalidMerkleProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			proofs = []types.MerkleProof{queryProof("KEY1", cid.Version), queryProof("KEY2", cid.Version)}
+
+			tc.malleate()
+
+			proof, err := types.CombineMerkleProofs(proofs)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Len(proof.Proofs, len(proofs[0].Proofs))
+				s.Require().NotNil(proof.Proofs[0].GetCompressed())
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *MerkleTestSuite) TestConvertBatchProofs() {
+	s.kvStore.Set([]byte("KEY1"), []byte("VALUE1"))
+	s.kvStore.Set([]byte("KEY2"), []byte("VALUE2"))
+	cid := s.store.Commit()
+
+	queryProofOps := func(key string) *crypto.ProofOps {
+		res, err := s.store.Query(&storetypes.RequestQuery{
+			Path:   fmt.Sprintf("/%s/key", s.storeKey.Name()),
+			Data:   []byte(key),
+			Height: cid.Version,
+			Prove:  true,
+		})
+		s.Require().NoError(err)
+		return res.ProofOps
+	}
+
+	root := types.NewMerkleRoot(cid.Hash)
+	paths := []exported.Path{
+		types.NewMerklePath([]byte(s.storeKey.Name()), []byte("KEY1")),
+		types.NewMerklePath([]byte(s.storeKey.Name()), []byte("KEY2")),
+	}
+	values := [][]byte{[]byte("VALUE1"), []byte("VALUE2")}
+
+	var proofOps []*crypto.ProofOps
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: empty proofs",
+			func() {
+				proofOps = nil
+			},
+			types.ErrInvalidMerkleProof,
+		},
+		{
+			"failure: nil proof",
+			func() {
+				proofOps[1] = nil
+			},
+			types.ErrInvalidMerkleProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			proofOps = []*crypto.ProofOps{queryProofOps("KEY1"), queryProofOps("KEY2")}
+
+			tc.malleate()
+
+			proof, err := types.ConvertBatchProofs(proofOps)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(proof.Proofs[0].GetCompressed())
+				s.Require().NoError(proof.VerifyBatchMembership(types.GetSDKSpecs(), root, paths, values))
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -124,6 +124,26 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 					redundancies++
 				}
 				packetMsgs++
+			case *channeltypesv2.MsgRecvPackets:
+				response, err := rrd.k.ChannelKeeperV2.RecvPackets(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+
+				if isRedundantBatch(response.Results) {
+					redundancies++
+				}
+				packetMsgs++
+			case *channeltypesv2.MsgAcknowledgements:
+				response, err := rrd.k.ChannelKeeperV2.Acknowledgements(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+
+				if isRedundantBatch(response.Results) {
+					redundancies++
+				}
+				packetMsgs++
 			default:
 				// if the multiMsg tx has a msg that is not a packet msg or update msg, then we will not return error
 				// regardless of if all packet messages are redundant. This ensures that non-packet messages get processed
@@ -140,6 +160,17 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	return next(ctx, tx, simulate)
 }
 
+// isRedundantBatch returns true if every packet of a batched packet message is a no-op.
+func isRedundantBatch(results []channeltypesv2.ResponseResultType) bool {
+	for _, result := range results {
+		if result != channeltypesv2.NOOP {
+			return false
+		}
+	}
+
+	return true
+}
+
 // recvPacketCheckTx runs a subset of ibc recv packet logic to be used specifically within the RedundantRelayDecorator AnteHandler.
 // It only performs core IBC receiving logic and skips any application logic.
 func (rrd RedundantRelayDecorator) recvPacketCheckTx(ctx sdk.Context, msg *channeltypes.MsgRecvPacket) (*channeltypes.MsgRecvPacketResponse, error) {
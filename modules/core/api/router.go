@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 )
 
 // Router contains all the module-defined callbacks required by IBC Protocol V2.
@@ -56,13 +58,14 @@ func (rtr *Router) AddRoute(portID string, cbs IBCModule) *Router {
 // A prefix route matches any portID that starts with the given prefix.
 //
 // Panics:
-//   - if `portIDPrefix` is not alphanumeric.
+//   - if `portIDPrefix` contains characters which are not valid in port identifiers.
 //   - if a direct route `portIDPrefix` has already been registered.
 //   - if a prefix of `portIDPrefix` is already registered as a prefix.
 //   - if `portIDPrefix` is a prefix of am already registered prefix.
 func (rtr *Router) AddPrefixRoute(portIDPrefix string, cbs IBCModule) *Router {
-	if !sdk.IsAlphaNumeric(portIDPrefix) {
-		panic(errors.New("route prefix can only contain alphanumeric characters"))
+	// prefixes may end with a separator, e.g. the "icacontroller-" prefix of interchain accounts controller ports
+	if !host.IsValidID(portIDPrefix) {
+		panic(errors.New("route prefix can only contain alphanumeric characters and the special characters of port identifiers"))
 	}
 
 	// If the prefix is a prefix of an already registered route, we panic to avoid confusing behavior.
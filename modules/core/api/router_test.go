@@ -50,6 +50,16 @@ func (s *APITestSuite) TestRouter() {
 				s.Require().True(router.HasRoute("port01"))
 			},
 		},
+		{
+			name: "success: prefix route ending with a separator",
+			malleate: func() {
+				router.AddPrefixRoute("somemodule-", &mockv2.IBCModule{})
+			},
+			assertionFn: func() {
+				s.Require().True(router.HasRoute("somemodule-port01"))
+				s.Require().False(router.HasRoute("somemoduleport01"))
+			},
+		},
 		{
 			name: "failure: panics on adding direct route after overlapping prefix route",
 			malleate: func() {
@@ -114,6 +124,15 @@ func (s *APITestSuite) TestRouter() {
 				})
 			},
 		},
+		{
+			name:     "failure: panics invalid prefix",
+			malleate: func() {},
+			assertionFn: func() {
+				s.Require().PanicsWithError("route prefix can only contain alphanumeric characters and the special characters of port identifiers", func() {
+					router.AddPrefixRoute("port/", &mockv2.IBCModule{})
+				})
+			},
+		},
 		{
 			name:     "failure: panics conflicting prefix routes registered, when shorter prefix is added",
 			malleate: func() {},
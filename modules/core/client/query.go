@@ -42,14 +42,7 @@ func QueryTendermintProof(clientCtx client.Context, key []byte) ([]byte, []byte,
 		height--
 	}
 
-	req := abci.RequestQuery{
-		Path:   fmt.Sprintf("store/%s/key", ibcexported.StoreKey),
-		Height: height,
-		Data:   key,
-		Prove:  true,
-	}
-
-	res, err := clientCtx.QueryABCI(req)
+	res, err := queryIBCStoreProof(clientCtx, key, height)
 	if err != nil {
 		return nil, nil, clienttypes.Height{}, err
 	}
@@ -69,3 +62,15 @@ func QueryTendermintProof(clientCtx client.Context, key []byte) ([]byte, []byte,
 	revision := clienttypes.ParseChainID(clientCtx.ChainID)
 	return res.Value, proofBz, clienttypes.NewHeight(revision, uint64(res.Height)+1), nil
 }
+
+// queryIBCStoreProof performs an ABCI query with proof of the key in the IBC store at the provided IAVL height.
+func queryIBCStoreProof(clientCtx client.Context, key []byte, height int64) (abci.ResponseQuery, error) {
+	req := abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", ibcexported.StoreKey),
+		Height: height,
+		Data:   key,
+		Prove:  true,
+	}
+
+	return clientCtx.QueryABCI(req)
+}
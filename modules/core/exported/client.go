@@ -27,6 +27,15 @@ const (
 	// Attestations is used to indicate that the light client is an attestor-based client.
 	Attestations string = "attestations"
 
+	// Ethereum is used to indicate that the light client follows the Ethereum beacon chain sync committee.
+	Ethereum string = "ethereum"
+
+	// Quorum is used to indicate that the light client requires a threshold of sub-clients to agree.
+	Quorum string = "quorum"
+
+	// ZK is used to indicate that the light client verifies succinct proofs of the counterparty state transitions.
+	ZK string = "zk"
+
 	// Active is a status type of a client. An active client is allowed to be used.
 	Active Status = "Active"
 
@@ -127,6 +136,24 @@ type LightClientModule interface {
 	) error
 }
 
+// BatchVerifier is an optional interface which light client modules may implement to verify the existence of many
+// values at the same height with a single combined proof, rather than one proof per CommitmentPath.
+type BatchVerifier interface {
+	// VerifyBatchMembership verifies a combined proof of the existence of each value at its CommitmentPath at the specified height.
+	// The paths and values are passed in pairs: the value at each index is expected at the path of the same index.
+	// The caller is expected to construct the full CommitmentPaths from a CommitmentPrefix and standardized paths (as defined in ICS 24).
+	VerifyBatchMembership(
+		ctx sdk.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		paths []Path,
+		values [][]byte,
+	) error
+}
+
 // ClientState defines the required common functions for light clients.
 type ClientState interface {
 	proto.Message
@@ -429,8 +429,9 @@ func (s *IBCTestSuite) TestInitGenesis() {
 
 func (s *IBCTestSuite) TestExportGenesis() {
 	testCases := []struct {
-		msg      string
-		malleate func()
+		msg              string
+		malleate         func()
+		expPrunedClients []string
 	}{
 		{
 			"success",
@@ -441,6 +442,28 @@ func (s *IBCTestSuite) TestExportGenesis() {
 				ibctesting.NewPath(s.chainA, s.chainB).SetupClients()
 				ibctesting.NewPath(s.chainA, s.chainB).SetupClients()
 			},
+			nil,
+		},
+		{
+			"success: pruned client",
+			func() {
+				path := ibctesting.NewPath(s.chainA, s.chainB)
+				path.SetupClients()
+				ibctesting.NewPath(s.chainA, s.chainB).SetupClients()
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				s.Require().True(ok)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+
+				clientKeeper := s.chainA.App.GetIBCKeeper().ClientKeeper
+				clientKeeper.TrackClientStatuses(s.chainA.GetContext(), 10)
+
+				ctx := s.chainA.GetContext()
+				err := clientKeeper.PruneClient(ctx.WithBlockTime(ctx.BlockTime().Add(clienttypes.DefaultClientPruneDelay)), path.EndpointA.ClientID)
+				s.Require().NoError(err)
+			},
+			[]string{ibctesting.FirstClientID},
 		},
 	}
 
@@ -455,6 +478,13 @@ func (s *IBCTestSuite) TestExportGenesis() {
 				gs = ibc.ExportGenesis(s.chainA.GetContext(), *s.chainA.App.GetIBCKeeper())
 			})
 
+			var prunedClients []string
+			for _, prunedClient := range gs.ClientGenesis.PrunedClients {
+				prunedClients = append(prunedClients, prunedClient.ClientId)
+			}
+			s.Require().Equal(tc.expPrunedClients, prunedClients)
+			s.Require().NoError(gs.Validate())
+
 			// init genesis based on export
 			s.Require().NotPanics(func() {
 				ibc.InitGenesis(s.chainA.GetContext(), *s.chainA.App.GetIBCKeeper(), gs)
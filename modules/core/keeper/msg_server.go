@@ -57,6 +57,10 @@ func (k *Keeper) CreateClient(goCtx context.Context, msg *clienttypes.MsgCreateC
 func (k *Keeper) RegisterCounterparty(goCtx context.Context, msg *clientv2types.MsgRegisterCounterparty) (*clientv2types.MsgRegisterCounterpartyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if k.ClientKeeper.IsClientPruned(ctx, msg.ClientId) {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientPruned, "cannot register counterparty of pruned client (%s)", msg.ClientId)
+	}
+
 	creator := k.ClientKeeper.GetClientCreator(ctx, msg.ClientId)
 	if !creator.Equals(sdk.MustAccAddressFromBech32(msg.Signer)) {
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected same signer as createClient submittor %s, got %s", creator, msg.Signer)
@@ -650,6 +654,10 @@ func (k *Keeper) UpdateConnectionParams(goCtx context.Context, msg *connectionty
 func (k *Keeper) UpdateClientConfig(goCtx context.Context, msg *clientv2types.MsgUpdateClientConfig) (*clientv2types.MsgUpdateClientConfigResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if k.ClientKeeper.IsClientPruned(ctx, msg.ClientId) {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientPruned, "cannot register counterparty of pruned client (%s)", msg.ClientId)
+	}
+
 	creator := k.ClientKeeper.GetClientCreator(ctx, msg.ClientId)
 	if err := sdk.ValidateAuthority(ctx, k.GetAuthority(), msg.Signer); err != nil {
 		if !creator.Equals(sdk.MustAccAddressFromBech32(msg.Signer)) {
@@ -667,6 +675,10 @@ func (k *Keeper) UpdateClientConfig(goCtx context.Context, msg *clientv2types.Ms
 func (k *Keeper) DeleteClientCreator(goCtx context.Context, msg *clienttypes.MsgDeleteClientCreator) (*clienttypes.MsgDeleteClientCreatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if k.ClientKeeper.IsClientPruned(ctx, msg.ClientId) {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientPruned, "cannot register counterparty of pruned client (%s)", msg.ClientId)
+	}
+
 	creator := k.ClientKeeper.GetClientCreator(ctx, msg.ClientId)
 	if creator == nil {
 		return nil, errorsmod.Wrapf(ibcerrors.ErrNotFound, "creator for client %s not found", msg.ClientId)
@@ -684,3 +696,74 @@ func (k *Keeper) DeleteClientCreator(goCtx context.Context, msg *clienttypes.Msg
 	k.ClientKeeper.DeleteClientCreator(ctx, msg.ClientId)
 	return &clienttypes.MsgDeleteClientCreatorResponse{}, nil
 }
+
+// PruneConsensusStates defines an rpc handler method for MsgPruneConsensusStates for the 02-client v1 submodule.
+func (k *Keeper) PruneConsensusStates(goCtx context.Context, msg *clienttypes.MsgPruneConsensusStates) (*clienttypes.MsgPruneConsensusStatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, k.GetAuthority(), msg.Signer); err != nil {
+		return nil, err
+	}
+
+	totalPruned, nextClientID := k.ClientKeeper.PruneTendermintConsensusStates(ctx, msg.StartClientId, msg.Limit)
+
+	ctx.Logger().Info("pruned consensus states", "total-pruned", totalPruned, "next-client-id", nextClientID)
+
+	return &clienttypes.MsgPruneConsensusStatesResponse{
+		TotalPruned:  totalPruned,
+		NextClientId: nextClientID,
+	}, nil
+}
+
+// PruneClient defines an rpc handler method for MsgPruneClient for the 02-client v1 submodule.
+func (k *Keeper) PruneClient(goCtx context.Context, msg *clienttypes.MsgPruneClient) (*clienttypes.MsgPruneClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, k.GetAuthority(), msg.Signer); err != nil {
+		return nil, err
+	}
+
+	connectionIDs, _ := k.ConnectionKeeper.GetClientConnectionPaths(ctx, msg.ClientId)
+
+	// channels which are not closed or still have packets in flight could be recovered along with the client
+	var (
+		channels   []channeltypes.IdentifiedChannel
+		channelErr error
+	)
+	for _, connectionID := range connectionIDs {
+		k.ChannelKeeper.IterateConnectionChannels(ctx, connectionID, func(channel channeltypes.IdentifiedChannel) bool {
+			if channel.State != channeltypes.CLOSED || k.ChannelKeeper.HasInflightPackets(ctx, channel.PortId, channel.ChannelId) {
+				channelErr = errorsmod.Wrapf(clienttypes.ErrClientNotPrunable, "channel %s on port %s referencing client (%s) is not closed or has packets in flight", channel.ChannelId, channel.PortId, msg.ClientId)
+				return true
+			}
+
+			channels = append(channels, channel)
+			return false
+		})
+		if channelErr != nil {
+			return nil, channelErr
+		}
+	}
+
+	// funds escrowed for packets sent over the client are only refunded once the packets are acknowledged or timed out
+	if k.ChannelKeeperV2.HasInflightPackets(ctx, msg.ClientId) {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientNotPrunable, "client (%s) has packets in flight", msg.ClientId)
+	}
+
+	if err := k.ClientKeeper.PruneClient(ctx, msg.ClientId); err != nil {
+		return nil, err
+	}
+
+	k.ChannelKeeperV2.DeletePacketStateForClient(ctx, msg.ClientId)
+
+	// the closed channels of the deleted connections can no longer be used
+	for _, channel := range channels {
+		k.ChannelKeeper.DeleteChannel(ctx, channel.PortId, channel.ChannelId)
+	}
+
+	for _, connectionID := range connectionIDs {
+		k.ConnectionKeeper.DeleteConnection(ctx, connectionID)
+	}
+
+	return &clienttypes.MsgPruneClientResponse{}, nil
+}
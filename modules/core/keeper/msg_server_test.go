@@ -66,6 +66,14 @@ func (s *KeeperTestSuite) TestRegisterCounterparty() {
 			},
 			ibcerrors.ErrInvalidRequest,
 		},
+		{
+			"client has been pruned",
+			func() {
+				path.SetupClients()
+				path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper.SetPrunedClient(s.chainA.GetContext(), path.EndpointA.ClientID, s.chainA.GetContext().BlockTime())
+			},
+			clienttypes.ErrClientPruned,
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
@@ -1443,6 +1451,169 @@ func (s *KeeperTestSuite) TestUpdateClientConfigAuthority() {
 	})
 }
 
+// TestPruneConsensusStates tests the PruneConsensusStates rpc handler
+func (s *KeeperTestSuite) TestPruneConsensusStates() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		signer   func() string
+		expError error
+	}{
+		{
+			"success: valid authority",
+			func() string {
+				return s.chainA.App.GetIBCKeeper().GetAuthority()
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() string {
+				return ibctesting.TestAccAddress
+			},
+			errors.New("unauthorized"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupClients()
+			s.Require().NoError(path.EndpointA.UpdateClient())
+
+			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			s.Require().True(ok)
+			clientState.RetentionPolicy = ibctm.NewRetentionPolicy(1, 0, 0)
+			path.EndpointA.SetClientState(clientState)
+
+			msg := clienttypes.NewMsgPruneConsensusStates(tc.signer(), "", 10)
+			res, err := s.chainA.App.GetIBCKeeper().PruneConsensusStates(s.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(uint64(1), res.TotalPruned)
+				s.Require().Empty(res.NextClientId)
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expError.Error())
+				s.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// TestPruneClient tests the PruneClient rpc handler
+func (s *KeeperTestSuite) TestPruneClient() {
+	var (
+		path *ibctesting.Path
+		msg  *clienttypes.MsgPruneClient
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel referencing the client is open",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.OPEN })
+			},
+			clienttypes.ErrClientNotPrunable,
+		},
+		{
+			"failure: channel referencing the client has packets in flight",
+			func() {
+				s.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(s.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, []byte("commitment"))
+			},
+			clienttypes.ErrClientNotPrunable,
+		},
+		{
+			"failure: client has v2 packets in flight",
+			func() {
+				s.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetPacketCommitment(s.chainA.GetContext(), path.EndpointA.ClientID, 1, []byte("commitment"))
+			},
+			clienttypes.ErrClientNotPrunable,
+		},
+		{
+			"failure: client is active",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				s.Require().True(ok)
+				clientState.FrozenHeight = clienttypes.ZeroHeight()
+				path.EndpointA.SetClientState(clientState)
+			},
+			clienttypes.ErrClientNotPrunable,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.Setup()
+
+			path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			s.Require().True(ok)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointA.SetClientState(clientState)
+
+			// packet state of IBC v2 packets received and sent over the client
+			channelKeeperV2 := s.chainA.App.GetIBCKeeper().ChannelKeeperV2
+			channelKeeperV2.SetPacketReceipt(s.chainA.GetContext(), path.EndpointA.ClientID, 1)
+			channelKeeperV2.SetPacketAcknowledgement(s.chainA.GetContext(), path.EndpointA.ClientID, 1, []byte("acknowledgement"))
+			channelKeeperV2.SetNextSequenceSend(s.chainA.GetContext(), path.EndpointA.ClientID, 2)
+
+			s.chainA.App.GetIBCKeeper().ClientKeeper.TrackClientStatuses(s.chainA.GetContext(), 10)
+			ctx := s.chainA.GetContext().WithBlockTime(s.chainA.GetContext().BlockTime().Add(clienttypes.DefaultClientPruneDelay))
+
+			msg = clienttypes.NewMsgPruneClient(s.chainA.App.GetIBCKeeper().GetAuthority(), path.EndpointA.ClientID)
+
+			tc.malleate()
+
+			res, err := s.chainA.App.GetIBCKeeper().PruneClient(ctx, msg)
+
+			_, found := s.chainA.App.GetIBCKeeper().ConnectionKeeper.GetConnection(ctx, path.EndpointA.ConnectionID)
+			foundChannel := s.chainA.App.GetIBCKeeper().ChannelKeeper.HasChannel(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			_, foundNextSequenceSend := channelKeeperV2.GetNextSequenceSend(ctx, path.EndpointA.ClientID)
+			foundPacketState := channelKeeperV2.HasPacketReceipt(ctx, path.EndpointA.ClientID, 1) || channelKeeperV2.HasPacketAcknowledgement(ctx, path.EndpointA.ClientID, 1) || foundNextSequenceSend
+			if tc.expError == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().False(found)
+				s.Require().False(foundChannel)
+				s.Require().True(s.chainA.App.GetIBCKeeper().ClientKeeper.IsClientPruned(ctx, path.EndpointA.ClientID))
+				s.Require().False(foundPacketState)
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+				s.Require().Nil(res)
+				s.Require().True(found)
+				s.Require().True(foundChannel)
+				s.Require().False(s.chainA.App.GetIBCKeeper().ClientKeeper.IsClientPruned(ctx, path.EndpointA.ClientID))
+				s.Require().True(foundPacketState)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestDeleteClientCreatorAuthority() {
 	keeperAuthority := s.chainA.App.GetIBCKeeper().GetAuthority()
 	overrideAuthority := sdk.AccAddress("override_authority___").String()
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package solomachine
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// SentinelBatchPath defines a placeholder path value used for the Merkle root of batches signed by the solo machine
+const SentinelBatchPath = "solomachine:batch"
+
+// BatchLeafBytes returns the marshaled BatchLeaf of the path and data pair, which is the leaf of the pair
+// in the Merkle tree of a batch. The data is empty for proofs of absence.
+func BatchLeafBytes(cdc codec.BinaryCodec, path, data []byte) ([]byte, error) {
+	return cdc.Marshal(&BatchLeaf{
+		Path: path,
+		Data: data,
+	})
+}
+
+// NewBatchInclusionProofs returns the Merkle root of the batch of leaves and the inclusion proof of each leaf
+// against the root. The root is signed by the solo machine in place of the path and data of a single proof.
+func NewBatchInclusionProofs(leaves [][]byte) ([]byte, []*BatchInclusionProof) {
+	root, proofs := merkle.ProofsFromByteSlices(leaves)
+
+	inclusionProofs := make([]*BatchInclusionProof, len(proofs))
+	for i, proof := range proofs {
+		inclusionProofs[i] = &BatchInclusionProof{
+			Root:  root,
+			Proof: proof.ToProto(),
+		}
+	}
+
+	return root, inclusionProofs
+}
+
+// verifyInclusion verifies that the path and data pair is a leaf of the batch with the root of the inclusion proof.
+func (bp BatchInclusionProof) verifyInclusion(cdc codec.BinaryCodec, path, data []byte) error {
+	proof, err := merkle.ProofFromProto(bp.Proof)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "invalid batch inclusion proof: %v", err)
+	}
+
+	leaf, err := BatchLeafBytes(cdc, path, data)
+	if err != nil {
+		return err
+	}
+
+	if err := proof.Verify(bp.Root, leaf); err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "failed to verify batch inclusion proof: %v", err)
+	}
+
+	return nil
+}
+
+// isVerifiedBatch returns true if the batch is the batch whose signature was verified at the previous sequence.
+func (cs ClientState) isVerifiedBatch(batch *BatchInclusionProof) bool {
+	return len(cs.BatchRoot) != 0 && bytes.Equal(cs.BatchRoot, batch.Root)
+}
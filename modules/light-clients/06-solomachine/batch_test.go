@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package solomachine_test
+
+import (
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types/v2"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	solomachine "github.com/cosmos/ibc-go/v11/modules/light-clients/06-solomachine"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func (s *SoloMachineTestSuite) TestVerifyBatch() {
+	const batchSize = 5
+
+	var (
+		paths  []commitmenttypesv2.MerklePath
+		keys   [][]byte
+		values [][]byte
+		proofs [][]byte
+	)
+
+	// the last pair of the batch proves the absence of a packet receipt
+	verifyPair := func(clientID string, i int) error {
+		lightClientModule, err := s.chainA.App.GetIBCKeeper().ClientKeeper.Route(s.chainA.GetContext(), clientID)
+		s.Require().NoError(err)
+
+		if i == batchSize-1 {
+			return lightClientModule.VerifyNonMembership(s.chainA.GetContext(), clientID, clienttypes.ZeroHeight(), 0, 0, proofs[i], paths[i])
+		}
+
+		return lightClientModule.VerifyMembership(s.chainA.GetContext(), clientID, clienttypes.ZeroHeight(), 0, 0, proofs[i], paths[i], values[i])
+	}
+
+	// test singlesig and multisig public keys
+	for _, sm := range []*ibctesting.Solomachine{s.solomachine, s.solomachineMulti} {
+		testCases := []struct {
+			name     string
+			malleate func()
+			expErr   error
+		}{
+			{
+				"success",
+				func() {},
+				nil,
+			},
+			{
+				"success: batch of one pair",
+				func() {
+					sm.Sequence--
+					proofs = sm.GenerateBatchProofs(keys[batchSize-1:], values[batchSize-1:])
+					proofs = append(make([][]byte, batchSize-1), proofs...)
+				},
+				nil,
+			},
+			{
+				"failure: value is not included in the batch",
+				func() {
+					values[0] = []byte("invalid value")
+				},
+				solomachine.ErrInvalidProof,
+			},
+			{
+				"failure: proof of another pair of the batch",
+				func() {
+					proofs[0] = proofs[1]
+				},
+				solomachine.ErrInvalidProof,
+			},
+			{
+				"failure: batch signed at the wrong sequence",
+				func() {
+					sm.Sequence++
+					proofs = sm.GenerateBatchProofs(keys, values)
+				},
+				solomachine.ErrSignatureVerificationFailed,
+			},
+		}
+
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				s.SetupTest()
+
+				clientState := sm.ClientState()
+				s.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(s.chainA.GetContext(), sm.ClientID, clientState)
+
+				paths, keys, values = nil, nil, nil
+				for i := range batchSize {
+					var path commitmenttypesv2.MerklePath
+					if i == batchSize-1 {
+						path = sm.GetPacketReceiptPath(ibctesting.MockPort, ibctesting.FirstChannelID, uint64(i))
+						values = append(values, nil)
+					} else {
+						path = sm.GetPacketCommitmentPath(ibctesting.MockPort, ibctesting.FirstChannelID, uint64(i))
+						values = append(values, fmt.Appendf(nil, "commitment %d", i))
+					}
+
+					key, err := path.GetKey(1) // in a multistore context: index 0 is the key for the IBC store in the multistore, index 1 is the key in the IBC store
+					s.Require().NoError(err)
+
+					paths = append(paths, path)
+					keys = append(keys, key)
+				}
+
+				proofs = sm.GenerateBatchProofs(keys, values)
+
+				tc.malleate()
+
+				var err error
+				for i := range batchSize {
+					if proofs[i] == nil {
+						continue
+					}
+
+					if err = verifyPair(sm.ClientID, i); err != nil {
+						break
+					}
+				}
+
+				if tc.expErr == nil {
+					s.Require().NoError(err)
+
+					cs, found := s.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(s.chainA.GetContext(), sm.ClientID)
+					s.Require().True(found)
+
+					// the sequence advances once for the batch
+					smClientState, ok := cs.(*solomachine.ClientState)
+					s.Require().True(ok)
+					s.Require().Equal(clientState.Sequence+1, smClientState.Sequence)
+					s.Require().Equal(sm.Sequence, smClientState.Sequence)
+					s.Require().NotEmpty(smClientState.BatchRoot)
+				} else {
+					s.Require().ErrorIs(err, tc.expErr)
+				}
+			})
+		}
+	}
+}
+
+func (s *SoloMachineTestSuite) TestVerifyBatchAfterSequenceConsumed() {
+	sm := s.solomachine
+
+	s.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(s.chainA.GetContext(), sm.ClientID, sm.ClientState())
+
+	lightClientModule, err := s.chainA.App.GetIBCKeeper().ClientKeeper.Route(s.chainA.GetContext(), sm.ClientID)
+	s.Require().NoError(err)
+
+	path := sm.GetPacketCommitmentPath(ibctesting.MockPort, ibctesting.FirstChannelID, 1)
+	key := host.PacketCommitmentKey(ibctesting.MockPort, ibctesting.FirstChannelID, 1)
+	value := []byte("commitment")
+
+	proofs := sm.GenerateBatchProofs([][]byte{key, key}, [][]byte{value, value})
+	s.Require().NoError(lightClientModule.VerifyMembership(s.chainA.GetContext(), sm.ClientID, clienttypes.ZeroHeight(), 0, 0, proofs[0], path, value))
+
+	// a proof of the batch is verified against its root while no other proof consumed the next sequence
+	s.Require().NoError(lightClientModule.VerifyMembership(s.chainA.GetContext(), sm.ClientID, clienttypes.ZeroHeight(), 0, 0, proofs[1], path, value))
+
+	// the root of the batch is discarded once a single proof consumes the next sequence
+	singleProof := sm.GenerateProof(&solomachine.SignBytes{
+		Sequence:    sm.Sequence,
+		Timestamp:   sm.Time,
+		Diversifier: sm.Diversifier,
+		Path:        key,
+		Data:        value,
+	})
+	s.Require().NoError(lightClientModule.VerifyMembership(s.chainA.GetContext(), sm.ClientID, clienttypes.ZeroHeight(), 0, 0, singleProof, path, value))
+
+	err = lightClientModule.VerifyMembership(s.chainA.GetContext(), sm.ClientID, clienttypes.ZeroHeight(), 0, 0, proofs[1], path, value)
+	s.Require().ErrorIs(err, solomachine.ErrSignatureVerificationFailed)
+
+	cs, found := s.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(s.chainA.GetContext(), sm.ClientID)
+	s.Require().True(found)
+
+	smClientState, ok := cs.(*solomachine.ClientState)
+	s.Require().True(ok)
+	s.Require().Empty(smClientState.BatchRoot)
+	s.Require().Equal(sm.Sequence, smClientState.Sequence)
+}
@@ -41,10 +41,19 @@ func (cs ClientState) Validate() error {
 	if cs.ConsensusState == nil {
 		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "consensus state cannot be nil")
 	}
+	if cs.PendingRotation != nil {
+		if cs.RotationDelay == 0 {
+			return errorsmod.Wrap(ErrInvalidPendingRotation, "pending rotation requires a non-zero rotation delay")
+		}
+		if err := cs.PendingRotation.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return cs.ConsensusState.ValidateBasic()
 }
 
 // verifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given CommitmentPath at the latest sequence.
+// A proof of a pair included in the batch verified at the previous sequence is verified against the root of the batch without consuming a sequence.
 // The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
 func (cs *ClientState) verifyMembership(
 	clientStore storetypes.KVStore,
@@ -53,7 +62,7 @@ func (cs *ClientState) verifyMembership(
 	path exported.Path,
 	value []byte,
 ) error {
-	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, proof)
+	publicKey, sigData, timestamp, sequence, batch, err := produceVerificationArgs(cdc, cs, proof)
 	if err != nil {
 		return err
 	}
@@ -81,6 +90,20 @@ func (cs *ClientState) verifyMembership(
 		Data:        value,
 	}
 
+	if batch != nil {
+		if err := batch.verifyInclusion(cdc, key, value); err != nil {
+			return err
+		}
+
+		// the signature over the batch has been verified by the first proof of the batch
+		if cs.isVerifiedBatch(batch) {
+			return nil
+		}
+
+		signBytes.Path = []byte(SentinelBatchPath)
+		signBytes.Data = batch.Root
+	}
+
 	signBz, err := cdc.Marshal(signBytes)
 	if err != nil {
 		return err
@@ -92,12 +115,17 @@ func (cs *ClientState) verifyMembership(
 
 	cs.Sequence++
 	cs.ConsensusState.Timestamp = timestamp
+	cs.BatchRoot = nil
+	if batch != nil {
+		cs.BatchRoot = batch.Root
+	}
 	setClientState(clientStore, cdc, cs)
 
 	return nil
 }
 
 // verifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at the latest sequence.
+// A proof of a pair included in the batch verified at the previous sequence is verified against the root of the batch without consuming a sequence.
 // The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
 func (cs *ClientState) verifyNonMembership(
 	clientStore storetypes.KVStore,
@@ -105,7 +133,7 @@ func (cs *ClientState) verifyNonMembership(
 	proof []byte,
 	path exported.Path,
 ) error {
-	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, proof)
+	publicKey, sigData, timestamp, sequence, batch, err := produceVerificationArgs(cdc, cs, proof)
 	if err != nil {
 		return err
 	}
@@ -133,6 +161,20 @@ func (cs *ClientState) verifyNonMembership(
 		Data:        nil,
 	}
 
+	if batch != nil {
+		if err := batch.verifyInclusion(cdc, key, nil); err != nil {
+			return err
+		}
+
+		// the signature over the batch has been verified by the first proof of the batch
+		if cs.isVerifiedBatch(batch) {
+			return nil
+		}
+
+		signBytes.Path = []byte(SentinelBatchPath)
+		signBytes.Data = batch.Root
+	}
+
 	signBz, err := cdc.Marshal(signBytes)
 	if err != nil {
 		return err
@@ -144,6 +186,10 @@ func (cs *ClientState) verifyNonMembership(
 
 	cs.Sequence++
 	cs.ConsensusState.Timestamp = timestamp
+	cs.BatchRoot = nil
+	if batch != nil {
+		cs.BatchRoot = batch.Root
+	}
 	setClientState(clientStore, cdc, cs)
 
 	return nil
@@ -151,42 +197,43 @@ func (cs *ClientState) verifyNonMembership(
 
 // produceVerificationArgs performs the basic checks on the arguments that are
 // shared between the verification functions and returns the public key of the
-// consensus state, the unmarshalled proof representing the signature and timestamp.
+// consensus state, the unmarshalled proof representing the signature and timestamp,
+// and the batch inclusion proof if the signature is over the Merkle root of a batch.
 func produceVerificationArgs(
 	cdc codec.BinaryCodec,
 	cs *ClientState,
 	proof []byte,
-) (cryptotypes.PubKey, signing.SignatureData, uint64, uint64, error) {
+) (cryptotypes.PubKey, signing.SignatureData, uint64, uint64, *BatchInclusionProof, error) {
 	if proof == nil {
-		return nil, nil, 0, 0, errorsmod.Wrap(ErrInvalidProof, "proof cannot be empty")
+		return nil, nil, 0, 0, nil, errorsmod.Wrap(ErrInvalidProof, "proof cannot be empty")
 	}
 
 	var timestampedSigData TimestampedSignatureData
 	if err := cdc.Unmarshal(proof, &timestampedSigData); err != nil {
-		return nil, nil, 0, 0, errorsmod.Wrapf(err, "failed to unmarshal proof into type %T", timestampedSigData)
+		return nil, nil, 0, 0, nil, errorsmod.Wrapf(err, "failed to unmarshal proof into type %T", timestampedSigData)
 	}
 
 	timestamp := timestampedSigData.Timestamp
 	if len(timestampedSigData.SignatureData) == 0 {
-		return nil, nil, 0, 0, errorsmod.Wrap(ErrInvalidProof, "signature data cannot be empty")
+		return nil, nil, 0, 0, nil, errorsmod.Wrap(ErrInvalidProof, "signature data cannot be empty")
 	}
 
 	sigData, err := UnmarshalSignatureData(cdc, timestampedSigData.SignatureData)
 	if err != nil {
-		return nil, nil, 0, 0, err
+		return nil, nil, 0, 0, nil, err
 	}
 
 	if cs.ConsensusState.GetTimestamp() > timestamp {
-		return nil, nil, 0, 0, errorsmod.Wrapf(ErrInvalidProof, "the consensus state timestamp is greater than the signature timestamp (%d >= %d)", cs.ConsensusState.GetTimestamp(), timestamp)
+		return nil, nil, 0, 0, nil, errorsmod.Wrapf(ErrInvalidProof, "the consensus state timestamp is greater than the signature timestamp (%d >= %d)", cs.ConsensusState.GetTimestamp(), timestamp)
 	}
 
 	sequence := cs.Sequence
 	publicKey, err := cs.ConsensusState.GetPubKey()
 	if err != nil {
-		return nil, nil, 0, 0, err
+		return nil, nil, 0, 0, nil, err
 	}
 
-	return publicKey, sigData, timestamp, sequence, nil
+	return publicKey, sigData, timestamp, sequence, timestampedSigData.Batch, nil
 }
 
 // sets the client state to the store
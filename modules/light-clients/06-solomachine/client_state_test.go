@@ -55,6 +55,35 @@ func (s *SoloMachineTestSuite) TestClientStateValidate() {
 				solomachine.NewClientState(1, &solomachine.ConsensusState{nil, sm.Diversifier, sm.Time}),
 				errors.New("public key cannot be empty: invalid consensus state"),
 			},
+			{
+				"valid client state with pending rotation",
+				func() *solomachine.ClientState {
+					clientState := sm.ClientState()
+					clientState.RotationDelay = 1
+					clientState.PendingRotation = &solomachine.PendingRotation{sm.ConsensusState().PublicKey, sm.Diversifier, 1}
+					return clientState
+				}(),
+				nil,
+			},
+			{
+				"pending rotation without rotation delay",
+				func() *solomachine.ClientState {
+					clientState := sm.ClientState()
+					clientState.PendingRotation = &solomachine.PendingRotation{sm.ConsensusState().PublicKey, sm.Diversifier, 1}
+					return clientState
+				}(),
+				errors.New("pending rotation requires a non-zero rotation delay: invalid pending rotation"),
+			},
+			{
+				"pending rotation activation time is zero",
+				func() *solomachine.ClientState {
+					clientState := sm.ClientState()
+					clientState.RotationDelay = 1
+					clientState.PendingRotation = &solomachine.PendingRotation{sm.ConsensusState().PublicKey, sm.Diversifier, 0}
+					return clientState
+				}(),
+				errors.New("activation time cannot be zero: invalid pending rotation"),
+			},
 		}
 
 		for _, tc := range testCases {
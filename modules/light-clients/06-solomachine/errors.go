@@ -12,4 +12,5 @@ var (
 	ErrInvalidSignatureAndData     = errorsmod.Register(ModuleName, 4, "invalid signature and data")
 	ErrSignatureVerificationFailed = errorsmod.Register(ModuleName, 5, "signature verification failed")
 	ErrInvalidProof                = errorsmod.Register(ModuleName, 6, "invalid solo machine proof")
+	ErrInvalidPendingRotation      = errorsmod.Register(ModuleName, 7, "invalid pending rotation")
 )
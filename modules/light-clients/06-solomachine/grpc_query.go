@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package solomachine
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ QueryServer = (*queryServer)(nil)
+
+// queryServer implements the solo machine Query service.
+type queryServer struct {
+	lightClientModule LightClientModule
+}
+
+// NewQueryServer creates and returns a new solo machine QueryServer.
+func NewQueryServer(lightClientModule LightClientModule) QueryServer {
+	return &queryServer{
+		lightClientModule: lightClientModule,
+	}
+}
+
+// PendingRotation implements the Query/PendingRotation gRPC method. A pending rotation whose activation time
+// has been reached is returned until the client is next used, at which point the rotation is applied.
+func (q *queryServer) PendingRotation(goCtx context.Context, req *QueryPendingRotationRequest) (*QueryPendingRotationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	clientType, _, err := clienttypes.ParseClientIdentifier(req.ClientId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if clientType != exported.Solomachine {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected: %s, got: %s", exported.Solomachine, clientType).Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	clientState, found := getClientState(q.lightClientModule.storeProvider.ClientStore(ctx, req.ClientId), q.lightClientModule.cdc)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(clienttypes.ErrClientNotFound, req.ClientId).Error())
+	}
+
+	return &QueryPendingRotationResponse{
+		PendingRotation: clientState.PendingRotation,
+		RotationDelay:   clientState.RotationDelay,
+	}, nil
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package solomachine_test
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	solomachine "github.com/cosmos/ibc-go/v11/modules/light-clients/06-solomachine"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func (s *SoloMachineTestSuite) TestQueryPendingRotation() {
+	var (
+		req             *solomachine.QueryPendingRotationRequest
+		pendingRotation *solomachine.PendingRotation
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: no pending rotation",
+			func() {
+				pendingRotation = nil
+			},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"failure: invalid client identifier",
+			func() {
+				req.ClientId = ibctesting.InvalidID
+			},
+			status.Error(codes.InvalidArgument, "invalid client identifier IDisInvalid is not in format: `{client-type}-{N}`: invalid identifier"),
+		},
+		{
+			"failure: client is not a solo machine",
+			func() {
+				req.ClientId = wasmClientID
+			},
+			status.Error(codes.InvalidArgument, "expected: 06-solomachine, got: 08-wasm: invalid client type"),
+		},
+		{
+			"failure: client not found",
+			func() {
+				req.ClientId = unusedSmClientID
+			},
+			status.Error(codes.NotFound, "06-solomachine-999: light client not found"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			sm := s.solomachineMulti
+			pendingRotation = &solomachine.PendingRotation{
+				NewPublicKey:   s.solomachine.ConsensusState().PublicKey,
+				NewDiversifier: s.solomachine.Diversifier,
+				ActivationTime: testRotationDelay,
+			}
+			req = &solomachine.QueryPendingRotationRequest{ClientId: sm.ClientID}
+
+			tc.malleate()
+
+			clientState := sm.ClientState()
+			clientState.RotationDelay = testRotationDelay
+			clientState.PendingRotation = pendingRotation
+			s.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(s.chainA.GetContext(), sm.ClientID, clientState)
+
+			storeProvider := s.chainA.App.GetIBCKeeper().ClientKeeper.GetStoreProvider()
+			queryServer := solomachine.NewQueryServer(solomachine.NewLightClientModule(s.chainA.Codec, storeProvider))
+			res, err := queryServer.PendingRotation(s.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(testRotationDelay, res.RotationDelay)
+				s.Require().Equal(pendingRotation, res.PendingRotation)
+			} else {
+				s.Require().Error(err)
+				s.Require().Equal(tc.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
@@ -71,6 +71,8 @@ func (l LightClientModule) VerifyClientMessage(ctx sdk.Context, clientID string,
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
 	}
 
+	clientState.applyPendingRotation(ctx)
+
 	return clientState.VerifyClientMessage(ctx, l.cdc, clientStore, clientMsg)
 }
 
@@ -82,6 +84,8 @@ func (l LightClientModule) CheckForMisbehaviour(ctx sdk.Context, clientID string
 		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
 	}
 
+	clientState.applyPendingRotation(ctx)
+
 	return clientState.CheckForMisbehaviour(ctx, l.cdc, clientStore, clientMsg)
 }
 
@@ -107,6 +111,8 @@ func (l LightClientModule) UpdateState(ctx sdk.Context, clientID string, clientM
 		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
 	}
 
+	clientState.applyPendingRotation(ctx)
+
 	return clientState.UpdateState(ctx, l.cdc, clientStore, clientMsg)
 }
 
@@ -127,6 +133,8 @@ func (l LightClientModule) VerifyMembership(
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
 	}
 
+	clientState.applyPendingRotation(ctx)
+
 	return clientState.verifyMembership(clientStore, l.cdc, proof, path, value)
 }
 
@@ -146,6 +154,8 @@ func (l LightClientModule) VerifyNonMembership(
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
 	}
 
+	clientState.applyPendingRotation(ctx)
+
 	return clientState.verifyNonMembership(clientStore, l.cdc, proof, path)
 }
 
@@ -979,6 +979,8 @@ func (s *SoloMachineTestSuite) TestRecoverClient() {
 			clientStore.Set(host.ClientStateKey(), bz)
 
 			subjectClientState.IsFrozen = true
+			subjectClientState.RotationDelay = 1
+			subjectClientState.PendingRotation = &solomachine.PendingRotation{subject.ConsensusState().PublicKey, subject.Diversifier, 1}
 			s.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(ctx, subjectClientID, subjectClientState)
 
 			lightClientModule, err := s.chainA.App.GetIBCKeeper().ClientKeeper.Route(s.chainA.GetContext(), subjectClientID)
@@ -999,6 +1001,7 @@ func (s *SoloMachineTestSuite) TestRecoverClient() {
 
 				s.Require().Equal(substituteClientState.ConsensusState, smClientState.ConsensusState)
 				s.Require().Equal(substituteClientState.Sequence, smClientState.Sequence)
+				s.Require().Nil(smClientState.PendingRotation)
 				s.Require().Equal(exported.Active, lightClientModule.Status(ctx, subjectClientID))
 			} else {
 				s.Require().Error(err)
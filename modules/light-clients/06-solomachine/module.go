@@ -3,6 +3,7 @@
 package solomachine
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -22,8 +23,8 @@ var (
 )
 
 // AppModuleBasic defines the basic application module used by the solo machine light client.
-// Only the RegisterInterfaces function needs to be implemented. All other function perform
-// a no-op.
+// Only the RegisterInterfaces and RegisterGRPCGatewayRoutes functions need to be implemented.
+// All other function perform a no-op.
 type AppModuleBasic struct{}
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -62,8 +63,13 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 	return nil
 }
 
-// RegisterGRPCGatewayRoutes performs a no-op.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {}
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the solo machine client module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	err := RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientCtx))
+	if err != nil {
+		panic(err)
+	}
+}
 
 // GetTxCmd performs a no-op. Please see the 02-client cli commands.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
@@ -87,3 +93,8 @@ func NewAppModule(lightClientModule LightClientModule) AppModule {
 		lightClientModule: lightClientModule,
 	}
 }
+
+// RegisterServices registers module services.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	RegisterQueryServer(cfg.QueryServer(), NewQueryServer(am.lightClientModule))
+}
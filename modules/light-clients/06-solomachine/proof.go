@@ -3,6 +3,9 @@
 package solomachine
 
 import (
+	"fmt"
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -15,6 +18,11 @@ import (
 // The signature data type must correspond to the public key type. An error is
 // returned if signature verification fails or an invalid SignatureData type is
 // provided.
+//
+// Threshold multisig public keys may combine signers of different key types,
+// including nested multisig public keys. Every provided signature must be valid
+// and the number of signatures must meet the threshold of the public key. The
+// returned error lists all signers whose signatures failed verification.
 func VerifySignature(pubKey cryptotypes.PubKey, signBytes []byte, sigData signing.SignatureData) error {
 	switch pubKey := pubKey.(type) {
 	case multisig.PubKey:
@@ -23,12 +31,13 @@ func VerifySignature(pubKey cryptotypes.PubKey, signBytes []byte, sigData signin
 			return errorsmod.Wrapf(ErrSignatureVerificationFailed, "invalid signature data type, expected %T, got %T", (*signing.MultiSignatureData)(nil), data)
 		}
 
-		// The function supplied fulfills the VerifyMultisignature interface. No special
-		// adjustments need to be made to the sign bytes based on the sign mode.
-		if err := pubKey.VerifyMultisignature(func(signing.SignMode) ([]byte, error) {
-			return signBytes, nil
-		}, data); err != nil {
-			return errorsmod.Wrapf(ErrSignatureVerificationFailed, "failed to verify multisignature: %s", err.Error())
+		failedSigners, err := verifyMultisignature(pubKey, signBytes, data, "")
+		if err != nil {
+			return err
+		}
+
+		if len(failedSigners) != 0 {
+			return errorsmod.Wrapf(ErrSignatureVerificationFailed, "failed to verify multisignature, invalid signatures from signers: %s", strings.Join(failedSigners, ", "))
 		}
 
 	default:
@@ -44,3 +53,66 @@ func VerifySignature(pubKey cryptotypes.PubKey, signBytes []byte, sigData signin
 
 	return nil
 }
+
+// verifyMultisignature verifies each signature of the multisignature against the public key of its signer, recursing
+// into nested multisig public keys. The signers whose signatures failed verification are returned, identified by their
+// index in the public keys of the multisig public key followed by their key type. Signers of nested multisig public keys
+// are identified by the path of indices, e.g. "2/0". No special adjustments are made to the sign bytes based on the sign
+// mode of the signatures. An error is returned if the multisignature is malformed or does not meet the threshold.
+func verifyMultisignature(pubKey multisig.PubKey, signBytes []byte, sigData *signing.MultiSignatureData, prefix string) ([]string, error) {
+	if sigData.BitArray == nil {
+		return nil, errorsmod.Wrap(ErrSignatureVerificationFailed, "multisignature bit array cannot be nil")
+	}
+
+	pubKeys := pubKey.GetPubKeys()
+	size := sigData.BitArray.Count()
+	if size != len(pubKeys) {
+		return nil, errorsmod.Wrapf(ErrSignatureVerificationFailed, "multisignature bit array size %d does not match the number of signers %d", size, len(pubKeys))
+	}
+
+	if numSigners := sigData.BitArray.NumTrueBitsBefore(size); numSigners != len(sigData.Signatures) {
+		return nil, errorsmod.Wrapf(ErrSignatureVerificationFailed, "number of signatures %d does not match the number of signers %d set in the bit array", len(sigData.Signatures), numSigners)
+	}
+
+	if threshold := pubKey.GetThreshold(); uint(len(sigData.Signatures)) < threshold {
+		return nil, errorsmod.Wrapf(ErrSignatureVerificationFailed, "number of signatures %d is less than the threshold %d", len(sigData.Signatures), threshold)
+	}
+
+	var (
+		failedSigners []string
+		sigIndex      int
+	)
+
+	for i, signerPubKey := range pubKeys {
+		if !sigData.BitArray.GetIndex(i) {
+			continue
+		}
+
+		signer := fmt.Sprintf("%s%d", prefix, i)
+		sig := sigData.Signatures[sigIndex]
+		sigIndex++
+
+		if nestedPubKey, ok := signerPubKey.(multisig.PubKey); ok {
+			nestedSigData, ok := sig.(*signing.MultiSignatureData)
+			if !ok {
+				failedSigners = append(failedSigners, fmt.Sprintf("%s (%s)", signer, signerPubKey.Type()))
+				continue
+			}
+
+			nestedFailedSigners, err := verifyMultisignature(nestedPubKey, signBytes, nestedSigData, signer+"/")
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "invalid multisignature of signer %s", signer)
+			}
+
+			failedSigners = append(failedSigners, nestedFailedSigners...)
+			continue
+		}
+
+		singleSigData, ok := sig.(*signing.SingleSignatureData)
+		if !ok || !signerPubKey.VerifySignature(signBytes, singleSigData.Signature) {
+			failedSigners = append(failedSigners, fmt.Sprintf("%s (%s)", signer, signerPubKey.Type()))
+		}
+	}
+
+	return failedSigners, nil
+}
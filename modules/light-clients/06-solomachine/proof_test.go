@@ -5,7 +5,12 @@ package solomachine_test
 import (
 	"errors"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	solomachine "github.com/cosmos/ibc-go/v11/modules/light-clients/06-solomachine"
@@ -68,3 +73,136 @@ func (s *SoloMachineTestSuite) TestVerifySignature() {
 		})
 	}
 }
+
+func (s *SoloMachineTestSuite) TestVerifyThresholdMultisignature() {
+	signBytes := []byte("sign bytes")
+
+	secp256r1PrivKey, err := secp256r1.GenPrivKey()
+	s.Require().NoError(err)
+
+	// mixed key types, the last signer is a nested 1-of-2 multisig
+	privKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), ed25519.GenPrivKey(), secp256r1PrivKey}
+	nestedPrivKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), ed25519.GenPrivKey()}
+
+	nestedPubKey := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{nestedPrivKeys[0].PubKey(), nestedPrivKeys[1].PubKey()})
+	pubKey := kmultisig.NewLegacyAminoPubKey(3, []cryptotypes.PubKey{privKeys[0].PubKey(), privKeys[1].PubKey(), privKeys[2].PubKey(), nestedPubKey})
+
+	sign := func(privKey cryptotypes.PrivKey, bz []byte) *signing.SingleSignatureData {
+		sig, err := privKey.Sign(bz)
+		s.Require().NoError(err)
+
+		return &signing.SingleSignatureData{Signature: sig}
+	}
+
+	var (
+		signers       map[int]signing.SignatureData
+		nestedSigners map[int]signing.SignatureData
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: all signers",
+			func() {},
+			nil,
+		},
+		{
+			"success: threshold of signers",
+			func() {
+				delete(signers, 1)
+			},
+			nil,
+		},
+		{
+			"success: threshold of nested signers",
+			func() {
+				delete(nestedSigners, 0)
+			},
+			nil,
+		},
+		{
+			"failure: below threshold",
+			func() {
+				delete(signers, 0)
+				delete(signers, 1)
+			},
+			errors.New("number of signatures 2 is less than the threshold 3"),
+		},
+		{
+			"failure: below nested threshold",
+			func() {
+				delete(nestedSigners, 0)
+				delete(nestedSigners, 1)
+			},
+			errors.New("invalid multisignature of signer 3"),
+		},
+		{
+			"failure: invalid signature names the signer",
+			func() {
+				signers[1] = sign(privKeys[1], []byte("invalid sign bytes"))
+			},
+			errors.New("invalid signatures from signers: 1 (ed25519)"),
+		},
+		{
+			"failure: invalid signatures name all signers",
+			func() {
+				signers[2] = sign(privKeys[0], signBytes)
+				nestedSigners[1] = sign(nestedPrivKeys[0], signBytes)
+			},
+			errors.New("invalid signatures from signers: 2 (secp256r1), 3/1 (ed25519)"),
+		},
+		{
+			"failure: single signature for nested multisig signer",
+			func() {
+				signers[3] = sign(nestedPrivKeys[0], signBytes)
+			},
+			errors.New("invalid signatures from signers: 3 (PubKeyMultisigThreshold)"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			signers = make(map[int]signing.SignatureData)
+			for i, privKey := range privKeys {
+				signers[i] = sign(privKey, signBytes)
+			}
+
+			nestedSigners = make(map[int]signing.SignatureData)
+			for i, privKey := range nestedPrivKeys {
+				nestedSigners[i] = sign(privKey, signBytes)
+			}
+
+			tc.malleate()
+
+			if _, ok := signers[3]; !ok {
+				nestedSigData := multisig.NewMultisig(len(nestedPrivKeys))
+				for i := range nestedPrivKeys {
+					if sig, ok := nestedSigners[i]; ok {
+						multisig.AddSignature(nestedSigData, sig, i)
+					}
+				}
+
+				signers[3] = nestedSigData
+			}
+
+			sigData := multisig.NewMultisig(len(pubKey.GetPubKeys()))
+			for i := range pubKey.GetPubKeys() {
+				if sig, ok := signers[i]; ok {
+					multisig.AddSignature(sigData, sig, i)
+				}
+			}
+
+			err := solomachine.VerifySignature(pubKey, signBytes, sigData)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, solomachine.ErrSignatureVerificationFailed)
+				s.Require().ErrorContains(err, tc.expErr.Error())
+			}
+		})
+	}
+}
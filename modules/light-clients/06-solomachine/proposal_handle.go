@@ -18,10 +18,10 @@ import (
 // CheckSubstituteAndUpdateState verifies that the subject is allowed to be updated by
 // a governance proposal and that the substitute client is a solo machine.
 // It will update the consensus state to the substitute's consensus state and
-// the sequence to the substitute's current sequence. An error is returned if
-// the client has been disallowed to be updated by a governance proposal,
-// the substitute is not a solo machine, or the current public key equals
-// the new public key.
+// the sequence to the substitute's current sequence. Any pending rotation or verified
+// batch of the subject is discarded. An error is returned if the client has been disallowed
+// to be updated by a governance proposal, the substitute is not a solo machine,
+// or the current public key equals the new public key.
 func (cs *ClientState) CheckSubstituteAndUpdateState(
 	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
 	_ storetypes.KVStore, substituteClient exported.ClientState,
@@ -49,6 +49,9 @@ func (cs *ClientState) CheckSubstituteAndUpdateState(
 	cs.Sequence = substituteClientState.Sequence
 	cs.ConsensusState = substituteClientState.ConsensusState
 	cs.IsFrozen = false
+	// a rotation scheduled or a batch signed by the replaced public key must not take effect
+	cs.PendingRotation = nil
+	cs.BatchRoot = nil
 
 	setClientState(subjectClientStore, cdc, cs)
 
@@ -0,0 +1,637 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/lightclients/solomachine/v3/query.proto
+
+package solomachine
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryPendingRotationRequest is the request type for the Query/PendingRotation RPC method.
+type QueryPendingRotationRequest struct {
+	// client identifier of the solo machine client
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryPendingRotationRequest) Reset()         { *m = QueryPendingRotationRequest{} }
+func (m *QueryPendingRotationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRotationRequest) ProtoMessage()    {}
+func (*QueryPendingRotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_def1416b66a8bd86, []int{0}
+}
+func (m *QueryPendingRotationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRotationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRotationRequest.Merge(m, src)
+}
+func (m *QueryPendingRotationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRotationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRotationRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRotationRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryPendingRotationResponse is the response type for the Query/PendingRotation RPC method.
+type QueryPendingRotationResponse struct {
+	// pending rotation of the client, empty if no rotation is scheduled
+	PendingRotation *PendingRotation `protobuf:"bytes,1,opt,name=pending_rotation,json=pendingRotation,proto3" json:"pending_rotation,omitempty"`
+	// rotation delay of the client in seconds
+	RotationDelay uint64 `protobuf:"varint,2,opt,name=rotation_delay,json=rotationDelay,proto3" json:"rotation_delay,omitempty"`
+}
+
+func (m *QueryPendingRotationResponse) Reset()         { *m = QueryPendingRotationResponse{} }
+func (m *QueryPendingRotationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRotationResponse) ProtoMessage()    {}
+func (*QueryPendingRotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_def1416b66a8bd86, []int{1}
+}
+func (m *QueryPendingRotationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRotationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRotationResponse.Merge(m, src)
+}
+func (m *QueryPendingRotationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRotationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRotationResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRotationResponse) GetPendingRotation() *PendingRotation {
+	if m != nil {
+		return m.PendingRotation
+	}
+	return nil
+}
+
+func (m *QueryPendingRotationResponse) GetRotationDelay() uint64 {
+	if m != nil {
+		return m.RotationDelay
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryPendingRotationRequest)(nil), "ibc.lightclients.solomachine.v3.QueryPendingRotationRequest")
+	proto.RegisterType((*QueryPendingRotationResponse)(nil), "ibc.lightclients.solomachine.v3.QueryPendingRotationResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/lightclients/solomachine/v3/query.proto", fileDescriptor_def1416b66a8bd86)
+}
+
+var fileDescriptor_def1416b66a8bd86 = []byte{
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcd, 0x4a, 0xeb, 0x40,
+	0x14, 0xc7, 0x3b, 0xe5, 0xde, 0xcb, 0xed, 0x88, 0x56, 0xb2, 0x2a, 0x6d, 0x89, 0xa5, 0x20, 0x14,
+	0xa4, 0x99, 0x7e, 0x80, 0x0b, 0x3f, 0x36, 0xa2, 0x88, 0x8b, 0x82, 0x66, 0xa9, 0x8b, 0x92, 0x8f,
+	0x61, 0x3a, 0x90, 0xcc, 0x49, 0x3b, 0x93, 0x42, 0x11, 0x37, 0x3e, 0x81, 0xe0, 0x1b, 0xf8, 0x34,
+	0x2e, 0x0b, 0x6e, 0xdc, 0x08, 0xd2, 0x0a, 0xbe, 0x86, 0x34, 0x69, 0x24, 0x16, 0x31, 0xe0, 0x2e,
+	0xfc, 0xcf, 0xf9, 0xff, 0xce, 0x3f, 0xe7, 0x0c, 0xde, 0xe1, 0xb6, 0x43, 0x3c, 0xce, 0x06, 0xca,
+	0xf1, 0x38, 0x15, 0x4a, 0x12, 0x09, 0x1e, 0xf8, 0x96, 0x33, 0xe0, 0x82, 0x92, 0x71, 0x97, 0x0c,
+	0x43, 0x3a, 0x9a, 0x18, 0xc1, 0x08, 0x14, 0x68, 0x5b, 0xdc, 0x76, 0x8c, 0x74, 0xb3, 0x91, 0x6a,
+	0x36, 0xc6, 0xdd, 0x72, 0x95, 0x01, 0x30, 0x8f, 0x12, 0x2b, 0xe0, 0xc4, 0x12, 0x02, 0x94, 0xa5,
+	0x38, 0x08, 0x19, 0xdb, 0xcb, 0xed, 0xac, 0x59, 0x69, 0x5a, 0x64, 0xa9, 0xef, 0xe1, 0xca, 0xc5,
+	0x22, 0xc0, 0x39, 0x15, 0x2e, 0x17, 0xcc, 0x5c, 0x12, 0x4d, 0x3a, 0x0c, 0xa9, 0x54, 0x5a, 0x05,
+	0x17, 0x62, 0x54, 0x9f, 0xbb, 0x25, 0x54, 0x43, 0x8d, 0x82, 0xf9, 0x3f, 0x16, 0xce, 0xdc, 0xfa,
+	0x03, 0xc2, 0xd5, 0xef, 0xcd, 0x32, 0x00, 0x21, 0xa9, 0x76, 0x85, 0x37, 0x83, 0xb8, 0xd4, 0x1f,
+	0x2d, 0x6b, 0x11, 0x64, 0xad, 0xd3, 0x32, 0x32, 0xfe, 0xd4, 0x58, 0x65, 0x16, 0x83, 0xaf, 0x82,
+	0xb6, 0x8d, 0x37, 0x12, 0x68, 0xdf, 0xa5, 0x9e, 0x35, 0x29, 0xe5, 0x6b, 0xa8, 0xf1, 0xc7, 0x5c,
+	0x4f, 0xd4, 0xe3, 0x85, 0xd8, 0x79, 0x47, 0xf8, 0x6f, 0x14, 0x52, 0x7b, 0x41, 0xb8, 0xb8, 0x42,
+	0xd5, 0x0e, 0x32, 0x73, 0xfc, 0xb0, 0x9d, 0xf2, 0xe1, 0x2f, 0xdd, 0xf1, 0x7a, 0xea, 0xbd, 0xdb,
+	0xa7, 0xb7, 0xfb, 0xfc, 0xa9, 0x76, 0x42, 0xb2, 0xee, 0x96, 0xc8, 0xd7, 0x9f, 0xc7, 0xb8, 0x21,
+	0xab, 0x9b, 0x3d, 0x62, 0x8f, 0x33, 0x1d, 0x4d, 0x67, 0x3a, 0x7a, 0x9d, 0xe9, 0xe8, 0x6e, 0xae,
+	0xe7, 0xa6, 0x73, 0x3d, 0xf7, 0x3c, 0xd7, 0x73, 0x97, 0x3d, 0xc6, 0xd5, 0x20, 0xb4, 0x0d, 0x07,
+	0x7c, 0xe2, 0x80, 0xf4, 0x41, 0x2e, 0x26, 0x36, 0x19, 0x90, 0x71, 0xbb, 0x4d, 0x7c, 0x70, 0x43,
+	0x8f, 0xca, 0x38, 0x40, 0x33, 0x19, 0xd5, 0xda, 0x6d, 0xa6, 0x42, 0xec, 0xa7, 0xbe, 0xed, 0x7f,
+	0xd1, 0xd3, 0xe9, 0x7e, 0x0c, 0x00, 0xe4, 0x60, 0xa2, 0xf7, 0xdb, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// PendingRotation queries the public key rotation scheduled for a solo machine client.
+	PendingRotation(ctx context.Context, in *QueryPendingRotationRequest, opts ...grpc.CallOption) (*QueryPendingRotationResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PendingRotation(ctx context.Context, in *QueryPendingRotationRequest, opts ...grpc.CallOption) (*QueryPendingRotationResponse, error) {
+	out := new(QueryPendingRotationResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.solomachine.v3.Query/PendingRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// PendingRotation queries the public key rotation scheduled for a solo machine client.
+	PendingRotation(context.Context, *QueryPendingRotationRequest) (*QueryPendingRotationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PendingRotation(ctx context.Context, req *QueryPendingRotationRequest) (*QueryPendingRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRotation not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PendingRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.solomachine.v3.Query/PendingRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRotation(ctx, req.(*QueryPendingRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.lightclients.solomachine.v3.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PendingRotation",
+			Handler:    _Query_PendingRotation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/lightclients/solomachine/v3/query.proto",
+}
+
+func (m *QueryPendingRotationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRotationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRotationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRotationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRotationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRotationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RotationDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RotationDelay))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PendingRotation != nil {
+		{
+			size, err := m.PendingRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPendingRotationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRotationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingRotation != nil {
+		l = m.PendingRotation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RotationDelay != 0 {
+		n += 1 + sovQuery(uint64(m.RotationDelay))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPendingRotationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRotationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRotationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRotationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRotationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRotationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingRotation == nil {
+				m.PendingRotation = &PendingRotation{}
+			}
+			if err := m.PendingRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationDelay", wireType)
+			}
+			m.RotationDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotationDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package solomachine
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPubKey unmarshals the new public key into a cryptotypes.PubKey type.
+// An error is returned if the new public key is nil or the cached value
+// is not a PubKey.
+func (pr PendingRotation) GetPubKey() (cryptotypes.PubKey, error) {
+	if pr.NewPublicKey == nil {
+		return nil, errorsmod.Wrap(ErrInvalidPendingRotation, "pending rotation NewPublicKey cannot be nil")
+	}
+
+	publicKey, ok := pr.NewPublicKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrInvalidPendingRotation, "pending rotation NewPublicKey is not cryptotypes.PubKey")
+	}
+
+	return publicKey, nil
+}
+
+// ValidateBasic ensures that the activation time and public key of the pending rotation have been initialized.
+func (pr PendingRotation) ValidateBasic() error {
+	if pr.ActivationTime == 0 {
+		return errorsmod.Wrap(ErrInvalidPendingRotation, "activation time cannot be zero")
+	}
+
+	if pr.NewDiversifier != "" && strings.TrimSpace(pr.NewDiversifier) == "" {
+		return errorsmod.Wrap(ErrInvalidPendingRotation, "diversifier cannot contain only spaces")
+	}
+
+	newPublicKey, err := pr.GetPubKey()
+	if err != nil || newPublicKey == nil || len(newPublicKey.Bytes()) == 0 {
+		return errorsmod.Wrap(ErrInvalidPendingRotation, "new public key cannot be empty")
+	}
+
+	return nil
+}
+
+// applyPendingRotation rotates the public key and diversifier of the consensus state to those of the pending rotation
+// once the block time of the host chain has reached the activation time of the rotation. The caller is responsible for
+// persisting the client state.
+func (cs *ClientState) applyPendingRotation(ctx sdk.Context) {
+	if cs.PendingRotation == nil || uint64(ctx.BlockTime().Unix()) < cs.PendingRotation.ActivationTime {
+		return
+	}
+
+	cs.ConsensusState.PublicKey = cs.PendingRotation.NewPublicKey
+	cs.ConsensusState.Diversifier = cs.PendingRotation.NewDiversifier
+	cs.PendingRotation = nil
+}
+
+// scheduleRotation schedules the rotation to the public key and diversifier of the header, which takes effect once the
+// rotation delay of the client has passed. A header rotating to the current public key and diversifier cancels the
+// pending rotation instead, and any other header replaces the pending rotation and restarts the rotation delay.
+func (cs *ClientState) scheduleRotation(ctx sdk.Context, header *Header) {
+	if cs.isCurrentKey(header) {
+		cs.PendingRotation = nil
+		return
+	}
+
+	cs.PendingRotation = &PendingRotation{
+		NewPublicKey:   header.NewPublicKey,
+		NewDiversifier: header.NewDiversifier,
+		ActivationTime: uint64(ctx.BlockTime().Unix()) + cs.RotationDelay,
+	}
+}
+
+// isCurrentKey returns true if the header rotates to the public key and diversifier of the current consensus state.
+func (cs ClientState) isCurrentKey(header *Header) bool {
+	if header.NewDiversifier != cs.ConsensusState.Diversifier {
+		return false
+	}
+
+	currentPublicKey, err := cs.ConsensusState.GetPubKey()
+	if err != nil {
+		return false
+	}
+
+	newPublicKey, err := header.GetPubKey()
+	if err != nil {
+		return false
+	}
+
+	return currentPublicKey.Equals(newPublicKey)
+}
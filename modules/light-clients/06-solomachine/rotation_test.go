@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package solomachine_test
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	solomachine "github.com/cosmos/ibc-go/v11/modules/light-clients/06-solomachine"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+const testRotationDelay = uint64(time.Hour / time.Second)
+
+// createRotationHeader creates a header signed by the current keys of the solo machine which rotates to the provided
+// public key and diversifier. The keys of the solo machine are left unchanged.
+func (s *SoloMachineTestSuite) createRotationHeader(sm *ibctesting.Solomachine, newPubKey cryptotypes.PubKey, newDiversifier string) *solomachine.Header {
+	publicKey, err := codectypes.NewAnyWithValue(newPubKey)
+	s.Require().NoError(err)
+
+	dataBz, err := s.chainA.Codec.Marshal(&solomachine.HeaderData{
+		NewPubKey:      publicKey,
+		NewDiversifier: newDiversifier,
+	})
+	s.Require().NoError(err)
+
+	signBz, err := s.chainA.Codec.Marshal(&solomachine.SignBytes{
+		Sequence:    sm.Sequence,
+		Timestamp:   sm.Time,
+		Diversifier: sm.Diversifier,
+		Path:        []byte(solomachine.SentinelHeaderPath),
+		Data:        dataBz,
+	})
+	s.Require().NoError(err)
+
+	header := &solomachine.Header{
+		Timestamp:      sm.Time,
+		Signature:      sm.GenerateSignature(signBz),
+		NewPublicKey:   publicKey,
+		NewDiversifier: newDiversifier,
+	}
+
+	sm.Sequence++
+	sm.Time++
+
+	return header
+}
+
+func (s *SoloMachineTestSuite) TestPendingRotationValidateBasic() {
+	var pendingRotation *solomachine.PendingRotation
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty diversifier",
+			func() {
+				pendingRotation.NewDiversifier = ""
+			},
+			nil,
+		},
+		{
+			"failure: activation time is zero",
+			func() {
+				pendingRotation.ActivationTime = 0
+			},
+			solomachine.ErrInvalidPendingRotation,
+		},
+		{
+			"failure: diversifier is blank",
+			func() {
+				pendingRotation.NewDiversifier = "  "
+			},
+			solomachine.ErrInvalidPendingRotation,
+		},
+		{
+			"failure: public key is nil",
+			func() {
+				pendingRotation.NewPublicKey = nil
+			},
+			solomachine.ErrInvalidPendingRotation,
+		},
+		{
+			"failure: public key is not a public key",
+			func() {
+				pendingRotation.NewPublicKey = &codectypes.Any{}
+			},
+			solomachine.ErrInvalidPendingRotation,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			pendingRotation = &solomachine.PendingRotation{
+				NewPublicKey:   s.solomachineMulti.ConsensusState().PublicKey,
+				NewDiversifier: "new diversifier",
+				ActivationTime: testRotationDelay,
+			}
+
+			tc.malleate()
+
+			err := pendingRotation.ValidateBasic()
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *SoloMachineTestSuite) TestKeyRotationDelay() {
+	// test singlesig and multisig public keys
+	for _, sm := range []*ibctesting.Solomachine{s.solomachine, s.solomachineMulti} {
+		s.Run(sm.ClientID, func() {
+			s.SetupTest()
+
+			clientState := sm.ClientState()
+			clientState.RotationDelay = testRotationDelay
+			s.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(s.chainA.GetContext(), sm.ClientID, clientState)
+
+			lightClientModule, err := s.chainA.App.GetIBCKeeper().ClientKeeper.Route(s.chainA.GetContext(), sm.ClientID)
+			s.Require().NoError(err)
+
+			getClientState := func() *solomachine.ClientState {
+				cs, found := s.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(s.chainA.GetContext(), sm.ClientID)
+				s.Require().True(found)
+
+				smClientState, ok := cs.(*solomachine.ClientState)
+				s.Require().True(ok)
+
+				return smClientState
+			}
+
+			// the header schedules the rotation, the consensus state keeps the current public key
+			ctx := s.chainA.GetContext()
+			header := sm.CreateHeader("new diversifier")
+			s.Require().NoError(lightClientModule.VerifyClientMessage(ctx, sm.ClientID, header))
+			lightClientModule.UpdateState(ctx, sm.ClientID, header)
+
+			clientState = getClientState()
+			s.Require().Equal(sm.Sequence, clientState.Sequence)
+			s.Require().Equal(header.Timestamp, clientState.ConsensusState.Timestamp)
+			s.Require().NotEqual(header.NewPublicKey, clientState.ConsensusState.PublicKey)
+			s.Require().Equal(&solomachine.PendingRotation{
+				NewPublicKey:   header.NewPublicKey,
+				NewDiversifier: header.NewDiversifier,
+				ActivationTime: uint64(ctx.BlockTime().Unix()) + testRotationDelay,
+			}, clientState.PendingRotation)
+
+			// the new public key cannot be used before the activation time
+			header = s.createRotationHeader(sm, sm.PublicKey, sm.Diversifier)
+			err = lightClientModule.VerifyClientMessage(s.chainA.GetContext(), sm.ClientID, header)
+			s.Require().ErrorIs(err, solomachine.ErrInvalidHeader)
+			sm.Sequence--
+			sm.Time--
+
+			// the rotation is applied once the block time reaches the activation time
+			s.coordinator.IncrementTimeBy(time.Duration(testRotationDelay) * time.Second)
+			s.coordinator.CommitBlock(s.chainA)
+
+			ctx = s.chainA.GetContext()
+			header = s.createRotationHeader(sm, sm.PublicKey, sm.Diversifier)
+			s.Require().NoError(lightClientModule.VerifyClientMessage(ctx, sm.ClientID, header))
+			lightClientModule.UpdateState(ctx, sm.ClientID, header)
+
+			clientState = getClientState()
+			s.Require().Equal(sm.ConsensusState().PublicKey, clientState.ConsensusState.PublicKey)
+			s.Require().Equal(sm.Diversifier, clientState.ConsensusState.Diversifier)
+			s.Require().Nil(clientState.PendingRotation)
+		})
+	}
+}
+
+func (s *SoloMachineTestSuite) TestKeyRotationCancel() {
+	sm := s.solomachineMulti
+
+	clientState := sm.ClientState()
+	clientState.RotationDelay = testRotationDelay
+	clientState.PendingRotation = &solomachine.PendingRotation{
+		NewPublicKey:   s.solomachine.ConsensusState().PublicKey,
+		NewDiversifier: s.solomachine.Diversifier,
+		ActivationTime: uint64(s.chainA.GetContext().BlockTime().Unix()) + testRotationDelay,
+	}
+	s.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(s.chainA.GetContext(), sm.ClientID, clientState)
+
+	lightClientModule, err := s.chainA.App.GetIBCKeeper().ClientKeeper.Route(s.chainA.GetContext(), sm.ClientID)
+	s.Require().NoError(err)
+
+	// a header rotating to the current public key and diversifier cancels the pending rotation
+	header := s.createRotationHeader(sm, sm.PublicKey, sm.Diversifier)
+	s.Require().NoError(lightClientModule.VerifyClientMessage(s.chainA.GetContext(), sm.ClientID, header))
+	lightClientModule.UpdateState(s.chainA.GetContext(), sm.ClientID, header)
+
+	cs, found := s.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(s.chainA.GetContext(), sm.ClientID)
+	s.Require().True(found)
+
+	smClientState, ok := cs.(*solomachine.ClientState)
+	s.Require().True(ok)
+	s.Require().Nil(smClientState.PendingRotation)
+	s.Require().Equal(sm.ConsensusState().PublicKey, smClientState.ConsensusState.PublicKey)
+
+	// the cancelled rotation is not applied after the activation time
+	s.coordinator.IncrementTimeBy(time.Duration(testRotationDelay) * time.Second)
+	s.coordinator.CommitBlock(s.chainA)
+
+	s.Require().Equal(clienttypes.NewHeight(0, sm.Sequence), lightClientModule.LatestHeight(s.chainA.GetContext(), sm.ClientID))
+	header = s.createRotationHeader(sm, sm.PublicKey, sm.Diversifier)
+	s.Require().NoError(lightClientModule.VerifyClientMessage(s.chainA.GetContext(), sm.ClientID, header))
+}
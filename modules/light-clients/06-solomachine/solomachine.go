@@ -8,14 +8,22 @@ import (
 )
 
 // Interface implementation checks.
-var _, _, _, _ codectypes.UnpackInterfacesMessage = (*ClientState)(nil), (*ConsensusState)(nil), (*Header)(nil), (*HeaderData)(nil)
+var _, _, _, _, _ codectypes.UnpackInterfacesMessage = (*ClientState)(nil), (*ConsensusState)(nil), (*Header)(nil), (*HeaderData)(nil), (*PendingRotation)(nil)
 
 // Data is an interface used for all the signature data bytes proto definitions.
 type Data any
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (cs ClientState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	return cs.ConsensusState.UnpackInterfaces(unpacker)
+	if err := cs.ConsensusState.UnpackInterfaces(unpacker); err != nil {
+		return err
+	}
+
+	if cs.PendingRotation != nil {
+		return cs.PendingRotation.UnpackInterfaces(unpacker)
+	}
+
+	return nil
 }
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
@@ -32,3 +40,8 @@ func (h Header) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 func (hd HeaderData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(hd.NewPubKey, new(cryptotypes.PubKey))
 }
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (pr PendingRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(pr.NewPublicKey, new(cryptotypes.PubKey))
+}
@@ -5,6 +5,7 @@ package solomachine
 
 import (
 	fmt "fmt"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -32,6 +33,15 @@ type ClientState struct {
 	// frozen sequence of the solo machine
 	IsFrozen       bool            `protobuf:"varint,2,opt,name=is_frozen,json=isFrozen,proto3" json:"is_frozen,omitempty"`
 	ConsensusState *ConsensusState `protobuf:"bytes,3,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+	// delay in seconds before a public key rotation scheduled by a header takes
+	// effect. If zero, headers rotate the public key immediately.
+	RotationDelay uint64 `protobuf:"varint,4,opt,name=rotation_delay,json=rotationDelay,proto3" json:"rotation_delay,omitempty"`
+	// public key rotation scheduled by a header, which takes effect once the
+	// rotation delay has passed unless it is cancelled by a subsequent header.
+	PendingRotation *PendingRotation `protobuf:"bytes,5,opt,name=pending_rotation,json=pendingRotation,proto3" json:"pending_rotation,omitempty"`
+	// Merkle root of the batch signed at the previous sequence. Pairs included in
+	// the batch are verified against the root without consuming a sequence.
+	BatchRoot []byte `protobuf:"bytes,6,opt,name=batch_root,json=batchRoot,proto3" json:"batch_root,omitempty"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -67,6 +77,50 @@ func (m *ClientState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClientState proto.InternalMessageInfo
 
+// PendingRotation defines a public key rotation of a solo machine which has
+// been scheduled by a header and has not yet taken effect.
+type PendingRotation struct {
+	// public key the solo machine rotates to
+	NewPublicKey *types.Any `protobuf:"bytes,1,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty"`
+	// diversifier the solo machine rotates to
+	NewDiversifier string `protobuf:"bytes,2,opt,name=new_diversifier,json=newDiversifier,proto3" json:"new_diversifier,omitempty"`
+	// host chain unix time in seconds at which the rotation takes effect
+	ActivationTime uint64 `protobuf:"varint,3,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"`
+}
+
+func (m *PendingRotation) Reset()         { *m = PendingRotation{} }
+func (m *PendingRotation) String() string { return proto.CompactTextString(m) }
+func (*PendingRotation) ProtoMessage()    {}
+func (*PendingRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_264187157b9220a4, []int{1}
+}
+func (m *PendingRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRotation.Merge(m, src)
+}
+func (m *PendingRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRotation proto.InternalMessageInfo
+
 // ConsensusState defines a solo machine consensus state. The sequence of a
 // consensus state is contained in the "height" key used in storing the
 // consensus state.
@@ -84,7 +138,7 @@ func (m *ConsensusState) Reset()         { *m = ConsensusState{} }
 func (m *ConsensusState) String() string { return proto.CompactTextString(m) }
 func (*ConsensusState) ProtoMessage()    {}
 func (*ConsensusState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{1}
+	return fileDescriptor_264187157b9220a4, []int{2}
 }
 func (m *ConsensusState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -125,7 +179,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{2}
+	return fileDescriptor_264187157b9220a4, []int{3}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,7 +220,7 @@ func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
 func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
 func (*Misbehaviour) ProtoMessage()    {}
 func (*Misbehaviour) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{3}
+	return fileDescriptor_264187157b9220a4, []int{4}
 }
 func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +262,7 @@ func (m *SignatureAndData) Reset()         { *m = SignatureAndData{} }
 func (m *SignatureAndData) String() string { return proto.CompactTextString(m) }
 func (*SignatureAndData) ProtoMessage()    {}
 func (*SignatureAndData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{4}
+	return fileDescriptor_264187157b9220a4, []int{5}
 }
 func (m *SignatureAndData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,13 +296,16 @@ var xxx_messageInfo_SignatureAndData proto.InternalMessageInfo
 type TimestampedSignatureData struct {
 	SignatureData []byte `protobuf:"bytes,1,opt,name=signature_data,json=signatureData,proto3" json:"signature_data,omitempty"`
 	Timestamp     uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// inclusion proof of the proven path and data in a batch, set if the
+	// signature is over the Merkle root of the batch.
+	Batch *BatchInclusionProof `protobuf:"bytes,3,opt,name=batch,proto3" json:"batch,omitempty"`
 }
 
 func (m *TimestampedSignatureData) Reset()         { *m = TimestampedSignatureData{} }
 func (m *TimestampedSignatureData) String() string { return proto.CompactTextString(m) }
 func (*TimestampedSignatureData) ProtoMessage()    {}
 func (*TimestampedSignatureData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{5}
+	return fileDescriptor_264187157b9220a4, []int{6}
 }
 func (m *TimestampedSignatureData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,6 +334,90 @@ func (m *TimestampedSignatureData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TimestampedSignatureData proto.InternalMessageInfo
 
+// BatchInclusionProof proves that a path and data pair is included in a batch
+// of pairs whose Merkle root is signed by the solo machine.
+type BatchInclusionProof struct {
+	// Merkle root of the batch
+	Root []byte `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// Merkle proof of the BatchLeaf of the pair against the root
+	Proof *crypto.Proof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *BatchInclusionProof) Reset()         { *m = BatchInclusionProof{} }
+func (m *BatchInclusionProof) String() string { return proto.CompactTextString(m) }
+func (*BatchInclusionProof) ProtoMessage()    {}
+func (*BatchInclusionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_264187157b9220a4, []int{7}
+}
+func (m *BatchInclusionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchInclusionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchInclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchInclusionProof.Merge(m, src)
+}
+func (m *BatchInclusionProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchInclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchInclusionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchInclusionProof proto.InternalMessageInfo
+
+// BatchLeaf defines the leaf of a path and data pair in the Merkle tree of a
+// batch.
+type BatchLeaf struct {
+	// the standardised path bytes
+	Path []byte `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// the marshaled data bytes, empty for proofs of absence
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *BatchLeaf) Reset()         { *m = BatchLeaf{} }
+func (m *BatchLeaf) String() string { return proto.CompactTextString(m) }
+func (*BatchLeaf) ProtoMessage()    {}
+func (*BatchLeaf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_264187157b9220a4, []int{8}
+}
+func (m *BatchLeaf) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchLeaf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchLeaf.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchLeaf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchLeaf.Merge(m, src)
+}
+func (m *BatchLeaf) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchLeaf) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchLeaf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchLeaf proto.InternalMessageInfo
+
 // SignBytes defines the signed bytes used for signature verification.
 type SignBytes struct {
 	// the sequence number
@@ -295,7 +436,7 @@ func (m *SignBytes) Reset()         { *m = SignBytes{} }
 func (m *SignBytes) String() string { return proto.CompactTextString(m) }
 func (*SignBytes) ProtoMessage()    {}
 func (*SignBytes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{6}
+	return fileDescriptor_264187157b9220a4, []int{9}
 }
 func (m *SignBytes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +477,7 @@ func (m *HeaderData) Reset()         { *m = HeaderData{} }
 func (m *HeaderData) String() string { return proto.CompactTextString(m) }
 func (*HeaderData) ProtoMessage()    {}
 func (*HeaderData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{7}
+	return fileDescriptor_264187157b9220a4, []int{10}
 }
 func (m *HeaderData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,11 +508,14 @@ var xxx_messageInfo_HeaderData proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.solomachine.v3.ClientState")
+	proto.RegisterType((*PendingRotation)(nil), "ibc.lightclients.solomachine.v3.PendingRotation")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.solomachine.v3.ConsensusState")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.solomachine.v3.Header")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.solomachine.v3.Misbehaviour")
 	proto.RegisterType((*SignatureAndData)(nil), "ibc.lightclients.solomachine.v3.SignatureAndData")
 	proto.RegisterType((*TimestampedSignatureData)(nil), "ibc.lightclients.solomachine.v3.TimestampedSignatureData")
+	proto.RegisterType((*BatchInclusionProof)(nil), "ibc.lightclients.solomachine.v3.BatchInclusionProof")
+	proto.RegisterType((*BatchLeaf)(nil), "ibc.lightclients.solomachine.v3.BatchLeaf")
 	proto.RegisterType((*SignBytes)(nil), "ibc.lightclients.solomachine.v3.SignBytes")
 	proto.RegisterType((*HeaderData)(nil), "ibc.lightclients.solomachine.v3.HeaderData")
 }
@@ -381,46 +525,59 @@ func init() {
 }
 
 var fileDescriptor_264187157b9220a4 = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0xb5, 0xa6, 0x6a, 0xce, 0x21, 0x45, 0x56, 0x87, 0x50, 0x90, 0x1b, 0x55, 0x42, 0x74,
-	0xa9, 0x4d, 0x12, 0xc4, 0x50, 0xa6, 0xb6, 0x11, 0x42, 0x42, 0x15, 0xc8, 0xad, 0x10, 0x62, 0x89,
-	0xce, 0xf6, 0xc5, 0x39, 0x61, 0xdf, 0xa5, 0xbe, 0x73, 0xa2, 0x20, 0x3e, 0x00, 0x12, 0x0b, 0x0b,
-	0x3b, 0x13, 0x2b, 0x5f, 0x83, 0xb1, 0x23, 0x63, 0x95, 0x7c, 0x11, 0xe4, 0xb3, 0x1d, 0x3b, 0x6e,
-	0x9a, 0x0c, 0x6c, 0xef, 0x3d, 0xbf, 0xf7, 0xbb, 0xdf, 0xef, 0xfd, 0x31, 0x6c, 0x11, 0xdb, 0x31,
-	0x7d, 0xe2, 0x0d, 0x84, 0xe3, 0x13, 0x4c, 0x05, 0x37, 0x39, 0xf3, 0x59, 0x80, 0x9c, 0x01, 0xa1,
-	0xd8, 0x1c, 0x75, 0x8a, 0xae, 0x31, 0x0c, 0x99, 0x60, 0xda, 0x3e, 0xb1, 0x1d, 0xa3, 0x58, 0x62,
-	0x14, 0x73, 0x46, 0x9d, 0xbd, 0x5d, 0x8f, 0x79, 0x4c, 0xe6, 0x9a, 0xb1, 0x95, 0x94, 0xed, 0x3d,
-	0xf4, 0x18, 0xf3, 0x7c, 0x6c, 0x4a, 0xcf, 0x8e, 0xfa, 0x26, 0xa2, 0x93, 0xe4, 0xd3, 0xc1, 0x2f,
-	0x00, 0xd5, 0x33, 0x89, 0x75, 0x21, 0x90, 0xc0, 0xda, 0x1e, 0xdc, 0xe6, 0xf8, 0x2a, 0xc2, 0xd4,
-	0xc1, 0x0d, 0xd0, 0x04, 0x87, 0x8a, 0x35, 0xf7, 0xb5, 0x47, 0xb0, 0x4a, 0x78, 0xaf, 0x1f, 0xb2,
-	0xcf, 0x98, 0x36, 0x36, 0x9a, 0xe0, 0x70, 0xdb, 0xda, 0x26, 0xfc, 0x95, 0xf4, 0xb5, 0x0f, 0x70,
-	0xc7, 0x61, 0x94, 0x63, 0xca, 0x23, 0xde, 0xe3, 0x31, 0x56, 0x63, 0xb3, 0x09, 0x0e, 0xd5, 0xb6,
-	0x69, 0xac, 0x21, 0x6d, 0x9c, 0x65, 0x75, 0x92, 0x82, 0x55, 0x77, 0x16, 0xfc, 0x63, 0xe5, 0xeb,
-	0xcf, 0xfd, 0xca, 0xc1, 0x37, 0x00, 0xeb, 0x8b, 0x89, 0x5a, 0x07, 0xc2, 0x61, 0x64, 0xfb, 0xc4,
-	0xe9, 0x7d, 0xc2, 0x13, 0xc9, 0x56, 0x6d, 0xef, 0x1a, 0x89, 0x56, 0x23, 0xd3, 0x6a, 0x9c, 0xd0,
-	0x89, 0x55, 0x4d, 0xf2, 0xde, 0xe0, 0x89, 0xd6, 0x84, 0xaa, 0x4b, 0x46, 0x38, 0xe4, 0xa4, 0x4f,
-	0x70, 0x28, 0x65, 0x54, 0xad, 0x62, 0x48, 0x7b, 0x0c, 0xab, 0x82, 0x04, 0x98, 0x0b, 0x14, 0x0c,
-	0xa5, 0x06, 0xc5, 0xca, 0x03, 0x29, 0x9b, 0xdf, 0x00, 0x6e, 0xbd, 0xc6, 0xc8, 0x2d, 0xa7, 0x83,
-	0x52, 0x7a, 0xfc, 0x95, 0x13, 0x8f, 0x22, 0x11, 0x85, 0x58, 0x3e, 0x56, 0xb3, 0xf2, 0x80, 0x76,
-	0x0c, 0xeb, 0x14, 0x8f, 0x7b, 0x05, 0x15, 0x9b, 0x2b, 0x54, 0xd4, 0x28, 0x1e, 0xbf, 0x9b, 0x0b,
-	0x79, 0x0a, 0x77, 0xe2, 0xda, 0xa2, 0x18, 0x45, 0x8a, 0x89, 0x21, 0xbb, 0x79, 0x34, 0x65, 0x7c,
-	0x03, 0x60, 0xed, 0x9c, 0x70, 0x1b, 0x0f, 0xd0, 0x88, 0xb0, 0x28, 0x5c, 0x39, 0xe9, 0xf7, 0xf0,
-	0xfe, 0x9c, 0x64, 0x8f, 0xd1, 0x84, 0xb9, 0xda, 0x6e, 0xad, 0x1d, 0xe5, 0x45, 0x56, 0x75, 0x42,
-	0xdd, 0x2e, 0x12, 0xc8, 0xaa, 0xcd, 0x71, 0xde, 0xd2, 0x12, 0xae, 0x18, 0xb3, 0x54, 0xee, 0x7f,
-	0xe1, 0x5e, 0x8e, 0x59, 0x2a, 0xf1, 0x0b, 0x7c, 0x50, 0xce, 0x5b, 0xec, 0x3f, 0x28, 0xf7, 0x5f,
-	0x83, 0xca, 0x10, 0x89, 0x41, 0x3a, 0x18, 0x69, 0xc7, 0x31, 0x17, 0x09, 0x24, 0xa9, 0xd5, 0x2c,
-	0x69, 0x2f, 0xce, 0x58, 0x59, 0xbe, 0x12, 0x18, 0x36, 0x2e, 0xb3, 0x10, 0x76, 0xe7, 0x44, 0x24,
-	0x8b, 0x27, 0xb0, 0x9e, 0xeb, 0x96, 0xe8, 0x09, 0x95, 0xbc, 0x1b, 0xdd, 0x5b, 0xcf, 0x6c, 0x2c,
-	0x7f, 0xe6, 0x07, 0x80, 0xd5, 0x18, 0xfc, 0x74, 0x22, 0x30, 0x5f, 0x39, 0xc4, 0x95, 0x68, 0xe5,
-	0x3b, 0xd8, 0xbc, 0x7d, 0x07, 0x59, 0x73, 0x94, 0x25, 0xcd, 0xb9, 0x97, 0x37, 0x27, 0xe5, 0x75,
-	0x05, 0x61, 0x72, 0x10, 0x52, 0xc9, 0x73, 0xa8, 0xa6, 0x8b, 0xbd, 0xfe, 0x36, 0x93, 0xad, 0xbe,
-	0x63, 0xa5, 0x37, 0xee, 0x5e, 0xe9, 0x53, 0xef, 0xcf, 0x54, 0x07, 0xd7, 0x53, 0x1d, 0xdc, 0x4c,
-	0x75, 0xf0, 0x7d, 0xa6, 0x57, 0xae, 0x67, 0x7a, 0xe5, 0xef, 0x4c, 0xaf, 0x7c, 0x3c, 0xf7, 0x88,
-	0x18, 0x44, 0xb6, 0xe1, 0xb0, 0xc0, 0x74, 0x18, 0x0f, 0x18, 0x37, 0x89, 0xed, 0x1c, 0x79, 0xcc,
-	0x1c, 0xb5, 0x5a, 0x66, 0xc0, 0xdc, 0xc8, 0xc7, 0x3c, 0xf9, 0xf7, 0x1e, 0x65, 0x3f, 0xdf, 0x67,
-	0x2f, 0x8e, 0x0a, 0x4b, 0xf7, 0xb2, 0x60, 0xdb, 0x5b, 0x92, 0x70, 0xe7, 0x5f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x1c, 0xa4, 0x3f, 0xc8, 0xb2, 0x05, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x3b, 0xad, 0x5b, 0x35, 0x2f, 0x69, 0xb2, 0x32, 0x7b, 0x30, 0x85, 0xcd, 0x46, 0x2b,
+	0xad, 0xc8, 0xa5, 0xf6, 0xa6, 0x59, 0x21, 0xb1, 0x9c, 0xb6, 0x5b, 0x21, 0x7e, 0xad, 0xa8, 0xbc,
+	0x15, 0x42, 0x70, 0xb0, 0xc6, 0xf6, 0xc4, 0x19, 0x61, 0xcf, 0x78, 0x3d, 0xe3, 0x44, 0x41, 0xfc,
+	0x01, 0x48, 0x5c, 0xb8, 0x70, 0xe7, 0xc2, 0x19, 0xf1, 0x5f, 0xc0, 0x6d, 0x8f, 0x1c, 0x57, 0xed,
+	0x3f, 0x82, 0x3c, 0x1e, 0xc7, 0x89, 0x1b, 0x35, 0xa0, 0xde, 0x66, 0xde, 0xbc, 0x1f, 0x1f, 0x7f,
+	0x67, 0xde, 0x33, 0x8c, 0xa8, 0x1f, 0x38, 0x31, 0x8d, 0xa6, 0x32, 0x88, 0x29, 0x61, 0x52, 0x38,
+	0x82, 0xc7, 0x3c, 0xc1, 0xc1, 0x94, 0x32, 0xe2, 0xcc, 0xc6, 0xab, 0x5b, 0x3b, 0xcd, 0xb8, 0xe4,
+	0xe6, 0x43, 0xea, 0x07, 0xf6, 0x6a, 0x88, 0xbd, 0xea, 0x33, 0x1b, 0x1f, 0xdf, 0x8f, 0x78, 0xc4,
+	0x95, 0xaf, 0x53, 0xac, 0xca, 0xb0, 0xe3, 0x77, 0x23, 0xce, 0xa3, 0x98, 0x38, 0x6a, 0xe7, 0xe7,
+	0x13, 0x07, 0xb3, 0x85, 0x3e, 0x7a, 0x20, 0x09, 0x0b, 0x49, 0x96, 0x50, 0x26, 0x9d, 0x20, 0x5b,
+	0xa4, 0x92, 0x17, 0x5e, 0x7c, 0x52, 0x1e, 0x3f, 0xfa, 0x7b, 0x17, 0xda, 0x2f, 0x54, 0xa9, 0x57,
+	0x12, 0x4b, 0x62, 0x1e, 0xc3, 0xa1, 0x20, 0xaf, 0x73, 0xc2, 0x02, 0x62, 0xa1, 0x01, 0x1a, 0x1a,
+	0xee, 0x72, 0x6f, 0xbe, 0x07, 0x2d, 0x2a, 0xbc, 0x49, 0xc6, 0x7f, 0x20, 0xcc, 0xda, 0x1d, 0xa0,
+	0xe1, 0xa1, 0x7b, 0x48, 0xc5, 0x27, 0x6a, 0x6f, 0x7e, 0x03, 0xbd, 0x80, 0x33, 0x41, 0x98, 0xc8,
+	0x85, 0x27, 0x8a, 0x5c, 0xd6, 0xde, 0x00, 0x0d, 0xdb, 0xa7, 0x8e, 0xbd, 0xe5, 0x9b, 0xec, 0x17,
+	0x55, 0x9c, 0x42, 0x70, 0xbb, 0xc1, 0xda, 0xde, 0x7c, 0x0c, 0xdd, 0x8c, 0x4b, 0x2c, 0x29, 0x67,
+	0x5e, 0x48, 0x62, 0xbc, 0xb0, 0x0c, 0x05, 0x76, 0x54, 0x59, 0xcf, 0x0b, 0xa3, 0xf9, 0x1d, 0xdc,
+	0x4b, 0x09, 0x0b, 0x29, 0x8b, 0xbc, 0xea, 0xc0, 0xda, 0x57, 0x04, 0x4f, 0xb6, 0x12, 0x5c, 0x94,
+	0x81, 0xae, 0x8e, 0x73, 0x7b, 0xe9, 0xba, 0xc1, 0x7c, 0x00, 0xe0, 0x63, 0x19, 0x4c, 0xbd, 0x8c,
+	0x73, 0x69, 0x1d, 0x0c, 0xd0, 0xb0, 0xe3, 0xb6, 0x94, 0xc5, 0xe5, 0x5c, 0x3e, 0x33, 0x7e, 0xfa,
+	0xed, 0xe1, 0xce, 0xa3, 0xdf, 0x11, 0xf4, 0x1a, 0x99, 0xcc, 0x67, 0xd0, 0x65, 0x64, 0xee, 0xa5,
+	0xb9, 0x1f, 0xd3, 0xc0, 0xfb, 0x9e, 0x2c, 0x94, 0xaa, 0xed, 0xd3, 0xfb, 0x76, 0x79, 0x65, 0x76,
+	0x75, 0x65, 0xf6, 0x73, 0xb6, 0x70, 0x3b, 0x8c, 0xcc, 0x2f, 0x94, 0xeb, 0x17, 0x64, 0x61, 0x7e,
+	0x00, 0xbd, 0x22, 0x36, 0xa4, 0x33, 0x92, 0x09, 0x3a, 0xa1, 0x24, 0x53, 0xaa, 0xb7, 0xdc, 0x22,
+	0xe5, 0x79, 0x6d, 0x2d, 0x1c, 0x71, 0x20, 0xe9, 0xac, 0xd4, 0x48, 0xd2, 0xa4, 0xd4, 0xde, 0x70,
+	0xbb, 0xb5, 0xf9, 0x92, 0x26, 0x44, 0x73, 0xfe, 0x8c, 0xa0, 0xbb, 0xae, 0xb9, 0x39, 0x06, 0xf8,
+	0x8f, 0x88, 0xad, 0x74, 0xc9, 0x37, 0x80, 0xf6, 0x4d, 0xb6, 0x55, 0x93, 0xf9, 0x3e, 0xb4, 0x0a,
+	0x1a, 0x21, 0x71, 0x92, 0x6a, 0xa4, 0xda, 0xa0, 0x69, 0xfe, 0x40, 0x70, 0xf0, 0x29, 0xc1, 0x61,
+	0xd3, 0x1d, 0x35, 0xdc, 0x8b, 0x53, 0x41, 0x23, 0x86, 0x65, 0x9e, 0x11, 0x55, 0xac, 0xe3, 0xd6,
+	0x86, 0x0d, 0x42, 0xef, 0xdd, 0x45, 0x68, 0x63, 0x93, 0xd0, 0x9a, 0xf8, 0x2d, 0x82, 0xce, 0x4b,
+	0x2a, 0x7c, 0x32, 0xc5, 0x33, 0xca, 0xf3, 0xec, 0xd6, 0xa6, 0xf9, 0x1a, 0x8e, 0x96, 0x90, 0x1e,
+	0x67, 0x25, 0x79, 0xfb, 0x74, 0xb4, 0xf5, 0x4d, 0xbe, 0xaa, 0xa2, 0x9e, 0xb3, 0xf0, 0x1c, 0x4b,
+	0xec, 0x76, 0x96, 0x79, 0xbe, 0x62, 0x8d, 0xbc, 0x72, 0xce, 0xad, 0xbd, 0xbb, 0xe7, 0xbd, 0x9c,
+	0x73, 0xfd, 0x89, 0x3f, 0xc2, 0xbd, 0xa6, 0xdf, 0xba, 0xfe, 0xa8, 0xa9, 0xbf, 0x09, 0x46, 0x8a,
+	0xe5, 0x54, 0x5f, 0x8c, 0x5a, 0x17, 0xb6, 0x10, 0x4b, 0xac, 0xd0, 0x3a, 0xae, 0x11, 0xea, 0x2c,
+	0xf5, 0x1d, 0x1b, 0x9b, 0x9f, 0xc4, 0x9f, 0x08, 0xac, 0xcb, 0xca, 0x46, 0xc2, 0x25, 0x89, 0xc2,
+	0x78, 0x0c, 0xdd, 0xfa, 0xc3, 0x55, 0xfa, 0x92, 0xe5, 0x48, 0xac, 0xb9, 0xad, 0xd5, 0xd9, 0x6d,
+	0xbe, 0xa5, 0xcf, 0x61, 0x5f, 0x75, 0xaf, 0x56, 0xed, 0xe9, 0x56, 0xd5, 0xce, 0x0a, 0xef, 0xcf,
+	0x58, 0x10, 0xe7, 0x82, 0x72, 0x76, 0x51, 0x4c, 0x50, 0xb7, 0x4c, 0xa1, 0x99, 0x3d, 0x78, 0x67,
+	0x83, 0x4f, 0x21, 0x81, 0x1a, 0x19, 0x25, 0xa3, 0x5a, 0x9b, 0x36, 0xec, 0xab, 0x11, 0xac, 0x9f,
+	0x82, 0x65, 0xd7, 0x23, 0xda, 0x2e, 0x47, 0xb4, 0xad, 0x0b, 0x28, 0x37, 0x5d, 0xe0, 0x23, 0x68,
+	0xa9, 0x02, 0x5f, 0x12, 0x3c, 0x59, 0xaa, 0x8d, 0x36, 0xa8, 0xbd, 0x5b, 0xab, 0xad, 0x43, 0x7f,
+	0x45, 0xd0, 0x2a, 0x44, 0x3c, 0x5b, 0x48, 0x22, 0x6e, 0x7d, 0xad, 0xb7, 0xab, 0xd6, 0x68, 0xf8,
+	0xbd, 0x9b, 0x0d, 0x5f, 0x71, 0x19, 0x1b, 0xb8, 0xf6, 0x6f, 0x70, 0xbd, 0x06, 0x28, 0x3b, 0x5f,
+	0xdd, 0xd8, 0x53, 0x68, 0xeb, 0x0e, 0xde, 0x3e, 0x84, 0xca, 0xf6, 0xfd, 0x3f, 0x43, 0xb2, 0x2c,
+	0x79, 0x16, 0xfd, 0x75, 0xd5, 0x47, 0x6f, 0xae, 0xfa, 0xe8, 0xed, 0x55, 0x1f, 0xfd, 0x72, 0xdd,
+	0xdf, 0x79, 0x73, 0xdd, 0xdf, 0xf9, 0xe7, 0xba, 0xbf, 0xf3, 0xed, 0xcb, 0x88, 0xca, 0x69, 0xee,
+	0xdb, 0x01, 0x4f, 0x9c, 0x80, 0x8b, 0x84, 0x0b, 0x87, 0xfa, 0xc1, 0x49, 0xc4, 0x9d, 0xd9, 0x68,
+	0xe4, 0x24, 0x3c, 0xcc, 0x63, 0x22, 0xca, 0xdf, 0xf9, 0x49, 0xf5, 0x3f, 0x7f, 0xf2, 0xe1, 0xc9,
+	0xca, 0x3b, 0xf9, 0x78, 0x65, 0xed, 0x1f, 0x28, 0xe0, 0xf1, 0xbf, 0x03, 0x00, 0x8c, 0xd1, 0x8c,
+	0xef, 0x05, 0x08, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -443,6 +600,30 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BatchRoot) > 0 {
+		i -= len(m.BatchRoot)
+		copy(dAtA[i:], m.BatchRoot)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.BatchRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PendingRotation != nil {
+		{
+			size, err := m.PendingRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RotationDelay != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.RotationDelay))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.ConsensusState != nil {
 		{
 			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
@@ -473,6 +654,53 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationTime != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.ActivationTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewDiversifier) > 0 {
+		i -= len(m.NewDiversifier)
+		copy(dAtA[i:], m.NewDiversifier)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.NewDiversifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NewPublicKey != nil {
+		{
+			size, err := m.NewPublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConsensusState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -695,6 +923,18 @@ func (m *TimestampedSignatureData) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintSolomachine(dAtA, i, uint64(m.Timestamp))
 		i--
@@ -710,6 +950,85 @@ func (m *TimestampedSignatureData) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *BatchInclusionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchInclusionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchInclusionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchLeaf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchLeaf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchLeaf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SignBytes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -833,6 +1152,37 @@ func (m *ClientState) Size() (n int) {
 		l = m.ConsensusState.Size()
 		n += 1 + l + sovSolomachine(uint64(l))
 	}
+	if m.RotationDelay != 0 {
+		n += 1 + sovSolomachine(uint64(m.RotationDelay))
+	}
+	if m.PendingRotation != nil {
+		l = m.PendingRotation.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	l = len(m.BatchRoot)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewPublicKey != nil {
+		l = m.NewPublicKey.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	l = len(m.NewDiversifier)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.ActivationTime != 0 {
+		n += 1 + sovSolomachine(uint64(m.ActivationTime))
+	}
 	return n
 }
 
@@ -937,22 +1287,60 @@ func (m *TimestampedSignatureData) Size() (n int) {
 	if m.Timestamp != 0 {
 		n += 1 + sovSolomachine(uint64(m.Timestamp))
 	}
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
 	return n
 }
 
-func (m *SignBytes) Size() (n int) {
+func (m *BatchInclusionProof) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovSolomachine(uint64(m.Sequence))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovSolomachine(uint64(m.Timestamp))
-	}
-	l = len(m.Diversifier)
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchLeaf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	return n
+}
+
+func (m *SignBytes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovSolomachine(uint64(m.Sequence))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovSolomachine(uint64(m.Timestamp))
+	}
+	l = len(m.Diversifier)
 	if l > 0 {
 		n += 1 + l + sovSolomachine(uint64(l))
 	}
@@ -1094,6 +1482,232 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationDelay", wireType)
+			}
+			m.RotationDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotationDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingRotation == nil {
+				m.PendingRotation = &PendingRotation{}
+			}
+			if err := m.PendingRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchRoot = append(m.BatchRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BatchRoot == nil {
+				m.BatchRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPublicKey == nil {
+				m.NewPublicKey = &types.Any{}
+			}
+			if err := m.NewPublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDiversifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDiversifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			m.ActivationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSolomachine(dAtA[iNdEx:])
@@ -1817,6 +2431,280 @@ func (m *TimestampedSignatureData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &BatchInclusionProof{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchInclusionProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchInclusionProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchLeaf) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchLeaf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchLeaf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path[:0], dAtA[iNdEx:postIndex]...)
+			if m.Path == nil {
+				m.Path = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSolomachine(dAtA[iNdEx:])
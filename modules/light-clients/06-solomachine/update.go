@@ -78,6 +78,9 @@ func (cs *ClientState) verifyHeader(cdc codec.BinaryCodec, header *Header) error
 }
 
 // UpdateState updates the consensus state to the new public key and an incremented sequence.
+// If the client has a rotation delay, the consensus state keeps its current public key and the header
+// schedules a rotation to the new public key instead, or cancels the pending rotation if the header
+// rotates to the current public key and diversifier.
 // A list containing the updated consensus height is returned.
 // If the provided clientMsg is not of type Header, the handler will no-op and return an empty slice.
 func (cs *ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
@@ -87,15 +90,20 @@ func (cs *ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clien
 		return []exported.Height{}
 	}
 
-	// create new solomachine ConsensusState
-	consensusState := &ConsensusState{
-		PublicKey:   smHeader.NewPublicKey,
-		Diversifier: smHeader.NewDiversifier,
-		Timestamp:   smHeader.Timestamp,
+	if cs.RotationDelay != 0 {
+		cs.scheduleRotation(ctx, smHeader)
+		cs.ConsensusState.Timestamp = smHeader.Timestamp
+	} else {
+		// create new solomachine ConsensusState
+		cs.ConsensusState = &ConsensusState{
+			PublicKey:   smHeader.NewPublicKey,
+			Diversifier: smHeader.NewDiversifier,
+			Timestamp:   smHeader.Timestamp,
+		}
 	}
 
 	cs.Sequence++
-	cs.ConsensusState = consensusState
+	cs.BatchRoot = nil
 
 	setClientState(clientStore, cdc, cs)
 
@@ -167,6 +167,12 @@ func (cs ClientState) Validate() error {
 		}
 	}
 
+	if cs.RetentionPolicy != nil {
+		if err := cs.RetentionPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -245,6 +251,50 @@ func (cs ClientState) verifyMembership(
 	return merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath, value)
 }
 
+// verifyBatchMembership verifies a combined ICS-23 proof of the existence of many values at their CommitmentPaths at the specified height.
+// The proof must be a merkle proof whose lowest proof is a batch proof of the keys of all paths, as created by commitmenttypes.CombineMerkleProofs.
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) verifyBatchMembership(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	if cs.LatestHeight.LT(height) {
+		return errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.LatestHeight, height,
+		)
+	}
+
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+	}
+
+	for _, path := range paths {
+		if _, ok := path.(commitmenttypesv2.MerklePath); !ok {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+		}
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return merkleProof.VerifyBatchMembership(cs.ProofSpecs, consensusState.GetRoot(), paths, values)
+}
+
 // verifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a specified height.
 // The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
 // If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
@@ -23,8 +23,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*exported.ClientMessage)(nil),
 		&Header{},
 	)
+	registry.RegisterImplementations(
+		(*exported.ClientMessage)(nil),
+		&HeaderBatch{},
+	)
 	registry.RegisterImplementations(
 		(*exported.ClientMessage)(nil),
 		&Misbehaviour{},
 	)
+	registry.RegisterImplementations(
+		(*exported.ClientMessage)(nil),
+		&LightClientAttackEvidence{},
+	)
 }
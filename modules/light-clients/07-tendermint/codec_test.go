@@ -35,11 +35,21 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&tendermint.Header{}),
 			nil,
 		},
+		{
+			"success: HeaderBatch",
+			sdk.MsgTypeURL(&tendermint.HeaderBatch{}),
+			nil,
+		},
 		{
 			"success: Misbehaviour",
 			sdk.MsgTypeURL(&tendermint.Misbehaviour{}),
 			nil,
 		},
+		{
+			"success: LightClientAttackEvidence",
+			sdk.MsgTypeURL(&tendermint.LightClientAttackEvidence{}),
+			nil,
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
@@ -2,7 +2,7 @@
 
 /*
 Package tendermint implements a concrete LightClientModule, ClientState, ConsensusState,
-Header, Misbehaviour and types for the Tendermint consensus light client.
+Header, HeaderBatch, Misbehaviour, LightClientAttackEvidence and types for the Tendermint consensus light client.
 This implementation is based off the ICS 07 specification
 (https://github.com/cosmos/ibc/tree/main/spec/client/ics-007-tendermint-client)
 
@@ -22,4 +22,5 @@ var (
 	ErrInvalidProofSpecs       = errorsmod.Register(ModuleName, 13, "invalid proof specs")
 	ErrInvalidValidatorSet     = errorsmod.Register(ModuleName, 14, "invalid validator set")
 	ErrInvalidTrustLevel       = errorsmod.Register(ModuleName, 15, "invalid trust level")
+	ErrInvalidRetentionPolicy  = errorsmod.Register(ModuleName, 16, "invalid retention policy")
 )
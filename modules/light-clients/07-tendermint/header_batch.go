@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package tendermint
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*HeaderBatch)(nil)
+
+// NewHeaderBatch creates a new HeaderBatch instance.
+func NewHeaderBatch(headers []*Header, checkpoints []clienttypes.Height) *HeaderBatch {
+	return &HeaderBatch{
+		Headers:     headers,
+		Checkpoints: checkpoints,
+	}
+}
+
+// ClientType defines that the HeaderBatch is a Tendermint consensus algorithm
+func (HeaderBatch) ClientType() string {
+	return exported.Tendermint
+}
+
+// GetHeight returns the height of the final header in the batch.
+// NOTE: the batch is checked to contain at least one header in ValidateBasic.
+func (hb HeaderBatch) GetHeight() exported.Height {
+	return hb.Headers[len(hb.Headers)-1].GetHeight()
+}
+
+// ValidateBasic performs basic validation of every header in the batch and checks that
+// the headers form a chain, where each header is trusted at the height of the preceding header.
+// Checkpoints must be unique and reference the heights of headers preceding the final header.
+func (hb HeaderBatch) ValidateBasic() error {
+	if len(hb.Headers) == 0 {
+		return errorsmod.Wrap(ErrInvalidHeader, "header batch cannot be empty")
+	}
+
+	heights := make(map[clienttypes.Height]bool, len(hb.Headers))
+	for i, header := range hb.Headers {
+		if header == nil {
+			return errorsmod.Wrapf(ErrInvalidHeader, "header %d cannot be nil", i)
+		}
+
+		if err := header.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "header %d failed validation", i)
+		}
+
+		if header.TrustedValidators == nil {
+			return errorsmod.Wrapf(ErrInvalidValidatorSet, "trusted validator set in header %d cannot be empty", i)
+		}
+
+		if i > 0 {
+			previous := hb.Headers[i-1]
+			if header.Header.ChainID != previous.Header.ChainID {
+				return errorsmod.Wrapf(ErrInvalidHeader, "header %d chain ID %s does not match chain ID %s of preceding header", i, header.Header.ChainID, previous.Header.ChainID)
+			}
+
+			if !header.TrustedHeight.EQ(previous.GetHeight()) {
+				return errorsmod.Wrapf(ErrInvalidHeaderHeight, "header %d trusted height %s must equal preceding header height %s", i, header.TrustedHeight, previous.GetHeight())
+			}
+		}
+
+		if i < len(hb.Headers)-1 {
+			heights[header.GetHeight().(clienttypes.Height)] = true
+		}
+	}
+
+	seen := make(map[clienttypes.Height]bool, len(hb.Checkpoints))
+	for _, checkpoint := range hb.Checkpoints {
+		if seen[checkpoint] {
+			return errorsmod.Wrapf(ErrInvalidHeaderHeight, "duplicate checkpoint height %s", checkpoint)
+		}
+		seen[checkpoint] = true
+
+		if !heights[checkpoint] {
+			return errorsmod.Wrapf(ErrInvalidHeaderHeight, "checkpoint height %s does not match the height of an intermediate header", checkpoint)
+		}
+	}
+
+	return nil
+}
+
+// checkpointHeaders returns the headers whose consensus states are stored when the batch is applied:
+// the headers at the checkpoint heights followed by the final header.
+func (hb HeaderBatch) checkpointHeaders() []*Header {
+	checkpoints := make(map[clienttypes.Height]bool, len(hb.Checkpoints))
+	for _, checkpoint := range hb.Checkpoints {
+		checkpoints[checkpoint] = true
+	}
+
+	var headers []*Header
+	for _, header := range hb.Headers[:len(hb.Headers)-1] {
+		if checkpoints[header.GetHeight().(clienttypes.Height)] {
+			headers = append(headers, header)
+		}
+	}
+
+	return append(headers, hb.Headers[len(hb.Headers)-1])
+}
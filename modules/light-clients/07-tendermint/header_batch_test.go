@@ -0,0 +1,291 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package tendermint_test
+
+import (
+	"errors"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+// createHeaderBatchHeaders commits n blocks on chainB and returns a chain of headers for them,
+// where the first header is trusted at the latest height of the client on chainA.
+func (s *TendermintTestSuite) createHeaderBatchHeaders(path *ibctesting.Path, n int) []*ibctm.Header {
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	s.Require().True(ok)
+
+	headers := make([]*ibctm.Header, 0, n)
+	for range n {
+		s.coordinator.CommitBlock(s.chainB)
+
+		header, err := s.chainB.IBCClientHeader(s.chainB.LatestCommittedHeader, trustedHeight)
+		s.Require().NoError(err)
+		headers = append(headers, header)
+
+		trustedHeight, ok = header.GetHeight().(clienttypes.Height)
+		s.Require().True(ok)
+	}
+
+	return headers
+}
+
+func (s *TendermintTestSuite) TestHeaderBatchValidateBasic() {
+	var (
+		path        *ibctesting.Path
+		headerBatch *ibctm.HeaderBatch
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: single header", func() {
+				headerBatch.Headers = headerBatch.Headers[:1]
+				headerBatch.Checkpoints = nil
+			}, nil,
+		},
+		{
+			"failure: empty batch", func() {
+				headerBatch.Headers = nil
+			}, ibctm.ErrInvalidHeader,
+		},
+		{
+			"failure: nil header", func() {
+				headerBatch.Headers[1] = nil
+			}, ibctm.ErrInvalidHeader,
+		},
+		{
+			"failure: header fails basic validation", func() {
+				headerBatch.Headers[1].ValidatorSet = nil
+			}, clienttypes.ErrInvalidHeader,
+		},
+		{
+			"failure: trusted validators are nil", func() {
+				headerBatch.Headers[1].TrustedValidators = nil
+			}, ibctm.ErrInvalidValidatorSet,
+		},
+		{
+			"failure: chain ID does not match preceding header", func() {
+				otherHeader := s.chainA.LatestCommittedHeader
+				otherHeader.TrustedValidators = headerBatch.Headers[1].TrustedValidators
+				s.Require().NotEqual(headerBatch.Headers[1].Header.ChainID, otherHeader.Header.ChainID)
+				headerBatch.Headers[2] = otherHeader
+			}, ibctm.ErrInvalidHeader,
+		},
+		{
+			"failure: trusted height does not match preceding header height", func() {
+				headerBatch.Headers[2].TrustedHeight = headerBatch.Headers[0].TrustedHeight
+			}, ibctm.ErrInvalidHeaderHeight,
+		},
+		{
+			"failure: duplicate checkpoint", func() {
+				headerBatch.Checkpoints = append(headerBatch.Checkpoints, headerBatch.Checkpoints[0])
+			}, ibctm.ErrInvalidHeaderHeight,
+		},
+		{
+			"failure: checkpoint at final header height", func() {
+				headerBatch.Checkpoints = []clienttypes.Height{headerBatch.GetHeight().(clienttypes.Height)}
+			}, ibctm.ErrInvalidHeaderHeight,
+		},
+		{
+			"failure: checkpoint does not match a header height", func() {
+				headerBatch.Checkpoints = []clienttypes.Height{headerBatch.Headers[0].TrustedHeight}
+			}, ibctm.ErrInvalidHeaderHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupClients()
+
+			headers := s.createHeaderBatchHeaders(path, 3)
+			headerBatch = ibctm.NewHeaderBatch(headers, []clienttypes.Height{headers[1].GetHeight().(clienttypes.Height)})
+
+			s.Require().Equal(exported.Tendermint, headerBatch.ClientType())
+
+			tc.malleate()
+
+			err := headerBatch.ValidateBasic()
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *TendermintTestSuite) TestVerifyHeaderBatch() {
+	var (
+		path        *ibctesting.Path
+		headerBatch *ibctm.HeaderBatch
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: headers skip heights", func() {
+				// only keep every second header and re-link the chain of trusted heights
+				skippingHeaders := []*ibctm.Header{headerBatch.Headers[0]}
+				for i := 2; i < len(headerBatch.Headers); i += 2 {
+					header, err := s.chainB.IBCClientHeader(headerBatch.Headers[i], skippingHeaders[len(skippingHeaders)-1].GetHeight().(clienttypes.Height))
+					s.Require().NoError(err)
+					skippingHeaders = append(skippingHeaders, header)
+				}
+
+				headerBatch = ibctm.NewHeaderBatch(skippingHeaders, nil)
+			}, nil,
+		},
+		{
+			"failure: consensus state for first trusted height not found", func() {
+				headerBatch.Headers[0].TrustedHeight = headerBatch.Headers[0].TrustedHeight.Increment().(clienttypes.Height)
+			}, clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"failure: trusted validators of intermediate header do not match preceding header", func() {
+				headerBatch.Headers[2].TrustedValidators = s.chainA.LatestCommittedHeader.ValidatorSet
+			}, ibctm.ErrInvalidValidatorSet,
+		},
+		{
+			"failure: intermediate header is not signed by the validators of the preceding header", func() {
+				altPrivVal := cmttypes.NewMockPV()
+				altPubKey, err := altPrivVal.GetPubKey()
+				s.Require().NoError(err)
+
+				altVal := cmttypes.NewValidator(altPubKey, 100)
+				altValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{altVal})
+
+				header := headerBatch.Headers[2]
+				trustedVals, err := cmttypes.ValidatorSetFromProto(header.TrustedValidators)
+				s.Require().NoError(err)
+
+				headerBatch.Headers[2] = s.chainB.CreateTMClientHeader(s.chainB.ChainID, header.Header.Height, header.TrustedHeight, header.Header.Time, altValSet, altValSet, trustedVals, getAltSigners(altVal, altPrivVal))
+			}, errors.New("failed to verify header 2"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupClients()
+
+			headerBatch = ibctm.NewHeaderBatch(s.createHeaderBatchHeaders(path, 5), nil)
+
+			tc.malleate()
+
+			lightClientModule, err := s.chainA.App.GetIBCKeeper().ClientKeeper.Route(s.chainA.GetContext(), path.EndpointA.ClientID)
+			s.Require().NoError(err)
+
+			err = lightClientModule.VerifyClientMessage(s.chainA.GetContext(), path.EndpointA.ClientID, headerBatch)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorContains(err, tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func (s *TendermintTestSuite) TestUpdateStateHeaderBatch() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupClients()
+
+	headers := s.createHeaderBatchHeaders(path, 4)
+	checkpoint := headers[1].GetHeight()
+	headerBatch := ibctm.NewHeaderBatch(headers, []clienttypes.Height{checkpoint.(clienttypes.Height)})
+
+	lightClientModule, err := s.chainA.App.GetIBCKeeper().ClientKeeper.Route(s.chainA.GetContext(), path.EndpointA.ClientID)
+	s.Require().NoError(err)
+
+	s.Require().NoError(lightClientModule.VerifyClientMessage(s.chainA.GetContext(), path.EndpointA.ClientID, headerBatch))
+	s.Require().False(lightClientModule.CheckForMisbehaviour(s.chainA.GetContext(), path.EndpointA.ClientID, headerBatch))
+
+	consensusHeights := lightClientModule.UpdateState(s.chainA.GetContext(), path.EndpointA.ClientID, headerBatch)
+	s.Require().Equal([]exported.Height{checkpoint, headerBatch.GetHeight()}, consensusHeights)
+
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	s.Require().True(ok)
+	s.Require().Equal(headerBatch.GetHeight(), clientState.LatestHeight)
+
+	// only the checkpoint and the final header consensus states are stored
+	for _, header := range headers {
+		consensusState, found := s.chainA.GetConsensusState(path.EndpointA.ClientID, header.GetHeight())
+		if header.GetHeight().EQ(checkpoint) || header.GetHeight().EQ(headerBatch.GetHeight()) {
+			s.Require().True(found)
+			s.Require().Equal(header.ConsensusState(), consensusState)
+		} else {
+			s.Require().False(found)
+		}
+	}
+
+	// applying the same batch again is a no-op
+	consensusHeights = lightClientModule.UpdateState(s.chainA.GetContext(), path.EndpointA.ClientID, headerBatch)
+	s.Require().Equal([]exported.Height{checkpoint, headerBatch.GetHeight()}, consensusHeights)
+	s.Require().Equal(clientState, path.EndpointA.GetClientState())
+}
+
+func (s *TendermintTestSuite) TestCheckForMisbehaviourHeaderBatch() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupClients()
+
+	headerBatch := ibctm.NewHeaderBatch(s.createHeaderBatchHeaders(path, 3), nil)
+
+	lightClientModule, err := s.chainA.App.GetIBCKeeper().ClientKeeper.Route(s.chainA.GetContext(), path.EndpointA.ClientID)
+	s.Require().NoError(err)
+
+	s.Require().False(lightClientModule.CheckForMisbehaviour(s.chainA.GetContext(), path.EndpointA.ClientID, headerBatch))
+
+	// store a conflicting consensus state at the height of an intermediate header
+	intermediateHeader := headerBatch.Headers[1]
+	conflictingConsensusState := &ibctm.ConsensusState{
+		Timestamp:          intermediateHeader.GetTime(),
+		Root:               commitmenttypes.NewMerkleRoot([]byte("conflicting app hash")),
+		NextValidatorsHash: intermediateHeader.Header.NextValidatorsHash,
+	}
+	s.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(s.chainA.GetContext(), path.EndpointA.ClientID, intermediateHeader.GetHeight(), conflictingConsensusState)
+
+	s.Require().True(lightClientModule.CheckForMisbehaviour(s.chainA.GetContext(), path.EndpointA.ClientID, headerBatch))
+}
+
+func (s *TendermintTestSuite) TestMsgUpdateClientHeaderBatch() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupClients()
+
+	// let the client fall behind by several blocks
+	s.coordinator.CommitNBlocks(s.chainB, 5)
+
+	headerBatch := ibctm.NewHeaderBatch(s.createHeaderBatchHeaders(path, 3), nil)
+
+	msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, headerBatch, s.chainA.SenderAccount.GetAddress().String())
+	s.Require().NoError(err)
+
+	_, err = s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(headerBatch.GetHeight(), path.EndpointA.GetClientLatestHeight())
+	s.Require().Equal(exported.Active, s.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(s.chainA.GetContext(), path.EndpointA.ClientID))
+}
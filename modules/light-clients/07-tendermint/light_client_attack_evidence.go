@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package tendermint
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*LightClientAttackEvidence)(nil)
+
+// NewLightClientAttackEvidence creates a new LightClientAttackEvidence instance.
+func NewLightClientAttackEvidence(
+	evidence *cmtproto.LightClientAttackEvidence, trustedHeader *Header, commonValidators *cmtproto.ValidatorSet,
+) *LightClientAttackEvidence {
+	return &LightClientAttackEvidence{
+		Evidence:         evidence,
+		TrustedHeader:    trustedHeader,
+		CommonValidators: commonValidators,
+	}
+}
+
+// ClientType defines that the LightClientAttackEvidence is a Tendermint consensus algorithm
+func (LightClientAttackEvidence) ClientType() string {
+	return exported.Tendermint
+}
+
+// ValidateBasic performs basic validation of the CometBFT evidence and of the Misbehaviour it converts into.
+func (e LightClientAttackEvidence) ValidateBasic() error {
+	misbehaviour, err := e.Misbehaviour()
+	if err != nil {
+		return err
+	}
+
+	return misbehaviour.validateHeaders()
+}
+
+// Misbehaviour converts the light client attack evidence into a Misbehaviour. Header1 of the Misbehaviour is the
+// trusted header and Header2 is the conflicting block of the evidence. The conflicting block of a lunatic attack is
+// trusted at the common height of the evidence using the common validators. The conflicting block of an equivocation
+// or amnesia attack, whose common height is the height of the conflicting block, shares the trusted height and trusted
+// validators of the trusted header.
+func (e LightClientAttackEvidence) Misbehaviour() (*Misbehaviour, error) {
+	if e.Evidence == nil {
+		return nil, errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, "light client attack evidence cannot be nil")
+	}
+
+	if e.TrustedHeader == nil || e.TrustedHeader.SignedHeader == nil || e.TrustedHeader.Header == nil {
+		return nil, errorsmod.Wrap(ErrInvalidHeader, "trusted header cannot be nil")
+	}
+
+	// the evidence is validated by CometBFT when it is decoded
+	evidence, err := cmttypes.LightClientAttackEvidenceFromProto(e.Evidence)
+	if err != nil {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidMisbehaviour, "invalid light client attack evidence: %v", err)
+	}
+
+	if e.TrustedHeader.Header.Height != evidence.ConflictingBlock.Height {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidMisbehaviour, "trusted header height %d must equal conflicting block height %d", e.TrustedHeader.Header.Height, evidence.ConflictingBlock.Height)
+	}
+
+	conflictingBlock := e.Evidence.ConflictingBlock
+	conflictingHeader := &Header{
+		SignedHeader: conflictingBlock.SignedHeader,
+		ValidatorSet: conflictingBlock.ValidatorSet,
+	}
+
+	if evidence.CommonHeight < evidence.ConflictingBlock.Height {
+		if e.CommonValidators == nil {
+			return nil, errorsmod.Wrapf(ErrInvalidValidatorSet, "common validators cannot be empty for common height %d below conflicting block height %d", evidence.CommonHeight, evidence.ConflictingBlock.Height)
+		}
+
+		revision := clienttypes.ParseChainID(conflictingBlock.SignedHeader.Header.ChainID)
+		conflictingHeader.TrustedHeight = clienttypes.NewHeight(revision, uint64(evidence.CommonHeight))
+		conflictingHeader.TrustedValidators = e.CommonValidators
+	} else {
+		if e.CommonValidators != nil {
+			return nil, errorsmod.Wrap(ErrInvalidValidatorSet, "common validators must be empty if the common height is the conflicting block height")
+		}
+
+		conflictingHeader.TrustedHeight = e.TrustedHeader.TrustedHeight
+		conflictingHeader.TrustedValidators = e.TrustedHeader.TrustedValidators
+	}
+
+	return &Misbehaviour{
+		Header1: e.TrustedHeader,
+		Header2: conflictingHeader,
+	}, nil
+}
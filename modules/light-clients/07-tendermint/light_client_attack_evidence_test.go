@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package tendermint_test
+
+import (
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+// newLightClientAttackEvidence returns the CometBFT light client attack evidence for the conflicting header at the provided common height.
+func (s *TendermintTestSuite) newLightClientAttackEvidence(conflictingHeader *ibctm.Header, commonHeight int64) *cmtproto.LightClientAttackEvidence {
+	valSet, err := cmttypes.ValidatorSetFromProto(conflictingHeader.ValidatorSet)
+	s.Require().NoError(err)
+
+	return &cmtproto.LightClientAttackEvidence{
+		ConflictingBlock: &cmtproto.LightBlock{
+			SignedHeader: conflictingHeader.SignedHeader,
+			ValidatorSet: conflictingHeader.ValidatorSet,
+		},
+		CommonHeight:        commonHeight,
+		ByzantineValidators: conflictingHeader.ValidatorSet.Validators,
+		TotalVotingPower:    valSet.TotalVotingPower(),
+		Timestamp:           conflictingHeader.GetTime(),
+	}
+}
+
+func (s *TendermintTestSuite) TestLightClientAttackEvidence() {
+	altPrivVal := cmttypes.NewMockPV()
+	altPubKey, err := altPrivVal.GetPubKey()
+	s.Require().NoError(err)
+
+	altVal := cmttypes.NewValidator(altPubKey, 100)
+	altValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{altVal})
+
+	var (
+		path          *ibctesting.Path
+		trustedHeight clienttypes.Height
+		trustedVals   *cmttypes.ValidatorSet
+		height        int64
+		evidence      *ibctm.LightClientAttackEvidence
+	)
+
+	testCases := []struct {
+		name            string
+		malleate        func()
+		expMisbehaviour bool
+		expErr          error
+	}{
+		{
+			"success: equivocation attack", func() {}, true, nil,
+		},
+		{
+			"success: lunatic attack", func() {
+				commonValidators, err := trustedVals.ToProto()
+				s.Require().NoError(err)
+
+				evidence.Evidence.CommonHeight = int64(trustedHeight.RevisionHeight)
+				evidence.CommonValidators = commonValidators
+			}, true, nil,
+		},
+		{
+			"conflicting block is the trusted header", func() {
+				evidence.Evidence = s.newLightClientAttackEvidence(evidence.TrustedHeader, height)
+			}, false, nil,
+		},
+		{
+			"failure: nil evidence", func() {
+				evidence.Evidence = nil
+			}, false, clienttypes.ErrInvalidMisbehaviour,
+		},
+		{
+			"failure: nil trusted header", func() {
+				evidence.TrustedHeader = nil
+			}, false, ibctm.ErrInvalidHeader,
+		},
+		{
+			"failure: invalid evidence", func() {
+				evidence.Evidence.TotalVotingPower = 0
+			}, false, clienttypes.ErrInvalidMisbehaviour,
+		},
+		{
+			"failure: trusted header height does not match conflicting block height", func() {
+				evidence.TrustedHeader = s.chainB.CreateTMClientHeader(s.chainB.ChainID, height+1, trustedHeight, s.chainB.ProposedHeader.Time, s.chainB.Vals, s.chainB.NextVals, trustedVals, s.chainB.Signers)
+			}, false, clienttypes.ErrInvalidMisbehaviour,
+		},
+		{
+			"failure: lunatic attack without common validators", func() {
+				evidence.Evidence.CommonHeight = int64(trustedHeight.RevisionHeight)
+			}, false, ibctm.ErrInvalidValidatorSet,
+		},
+		{
+			"failure: equivocation attack with common validators", func() {
+				commonValidators, err := trustedVals.ToProto()
+				s.Require().NoError(err)
+
+				evidence.CommonValidators = commonValidators
+			}, false, ibctm.ErrInvalidValidatorSet,
+		},
+		{
+			"failure: consensus state not found at common height", func() {
+				commonValidators, err := trustedVals.ToProto()
+				s.Require().NoError(err)
+
+				evidence.Evidence.CommonHeight = int64(trustedHeight.RevisionHeight) + 1
+				evidence.CommonValidators = commonValidators
+			}, false, clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"failure: conflicting block is not signed by the common validators", func() {
+				commonValidators, err := trustedVals.ToProto()
+				s.Require().NoError(err)
+
+				conflictingHeader := s.chainB.CreateTMClientHeader(s.chainB.ChainID, height, trustedHeight, s.chainB.ProposedHeader.Time.Add(time.Minute), altValSet, altValSet, trustedVals, getAltSigners(altVal, altPrivVal))
+				evidence.Evidence = s.newLightClientAttackEvidence(conflictingHeader, int64(trustedHeight.RevisionHeight))
+				evidence.CommonValidators = commonValidators
+			}, false, clienttypes.ErrInvalidMisbehaviour,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupClients()
+
+			var ok bool
+			trustedHeight, ok = path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			s.Require().True(ok)
+
+			trustedVals, ok = s.chainB.TrustedValidators[trustedHeight.RevisionHeight]
+			s.Require().True(ok)
+
+			height = s.chainB.ProposedHeader.Height + 2
+			trustedHeader := s.chainB.CreateTMClientHeader(s.chainB.ChainID, height, trustedHeight, s.chainB.ProposedHeader.Time, s.chainB.Vals, s.chainB.NextVals, trustedVals, s.chainB.Signers)
+			conflictingHeader := s.chainB.CreateTMClientHeader(s.chainB.ChainID, height, trustedHeight, s.chainB.ProposedHeader.Time.Add(time.Minute), s.chainB.Vals, s.chainB.NextVals, trustedVals, s.chainB.Signers)
+
+			evidence = ibctm.NewLightClientAttackEvidence(s.newLightClientAttackEvidence(conflictingHeader, height), trustedHeader, nil)
+			s.Require().Equal(exported.Tendermint, evidence.ClientType())
+
+			tc.malleate()
+
+			lightClientModule, err := s.chainA.App.GetIBCKeeper().ClientKeeper.Route(s.chainA.GetContext(), path.EndpointA.ClientID)
+			s.Require().NoError(err)
+
+			err = evidence.ValidateBasic()
+			if err == nil {
+				err = lightClientModule.VerifyClientMessage(s.chainA.GetContext(), path.EndpointA.ClientID, evidence)
+			}
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expMisbehaviour, lightClientModule.CheckForMisbehaviour(s.chainA.GetContext(), path.EndpointA.ClientID, evidence))
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *TendermintTestSuite) TestMsgUpdateClientLightClientAttackEvidence() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupClients()
+
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	s.Require().True(ok)
+
+	trustedVals, ok := s.chainB.TrustedValidators[trustedHeight.RevisionHeight]
+	s.Require().True(ok)
+
+	commonValidators, err := trustedVals.ToProto()
+	s.Require().NoError(err)
+
+	// the client is deceived into accepting a conflicting header at a height it has not been updated to
+	height := s.chainB.ProposedHeader.Height
+	trustedHeader := s.chainB.CreateTMClientHeader(s.chainB.ChainID, height, trustedHeight, s.chainB.ProposedHeader.Time, s.chainB.Vals, s.chainB.NextVals, trustedVals, s.chainB.Signers)
+	conflictingHeader := s.chainB.CreateTMClientHeader(s.chainB.ChainID, height, trustedHeight, s.chainB.ProposedHeader.Time.Add(time.Minute), s.chainB.Vals, s.chainB.NextVals, trustedVals, s.chainB.Signers)
+
+	evidence := ibctm.NewLightClientAttackEvidence(s.newLightClientAttackEvidence(conflictingHeader, int64(trustedHeight.RevisionHeight)), trustedHeader, commonValidators)
+
+	msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, evidence, s.chainA.SenderAccount.GetAddress().String())
+	s.Require().NoError(err)
+
+	_, err = s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(exported.Frozen, s.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(s.chainA.GetContext(), path.EndpointA.ClientID))
+}
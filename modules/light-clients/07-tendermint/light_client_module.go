@@ -15,7 +15,10 @@ import (
 	"github.com/cosmos/ibc-go/v11/modules/core/exported"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule = (*LightClientModule)(nil)
+	_ exported.BatchVerifier     = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -121,6 +124,26 @@ func (l LightClientModule) VerifyMembership(
 	return clientState.verifyMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
 }
 
+// VerifyBatchMembership obtains the client state associated with the client identifier and calls into the clientState.verifyBatchMembership method.
+func (l LightClientModule) VerifyBatchMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.verifyBatchMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, paths, values)
+}
+
 // VerifyNonMembership obtains the client state associated with the client identifier and calls into the clientState.verifyNonMembership method.
 func (l LightClientModule) VerifyNonMembership(
 	ctx sdk.Context,
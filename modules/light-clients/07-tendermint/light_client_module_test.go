@@ -520,6 +520,153 @@ func (s *TendermintTestSuite) TestVerifyMembership() {
 	}
 }
 
+func (s *TendermintTestSuite) TestVerifyBatchMembership() {
+	var (
+		testingpath      *ibctesting.Path
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		proof            []byte
+		paths            []exported.Path
+		values           [][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: batch of a single path with an existence proof", func() {
+				proof, proofHeight = s.chainB.QueryProof(host.FullClientStateKey(testingpath.EndpointB.ClientID))
+				paths = paths[:1]
+				values = values[:1]
+			},
+			nil,
+		},
+		{
+			"delay time period has passed", func() {
+				delayTimePeriod = uint64(time.Second.Nanoseconds())
+			},
+			nil,
+		},
+		{
+			"delay time period has not passed", func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			ibctm.ErrDelayPeriodNotPassed,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = testingpath.EndpointA.GetClientLatestHeight().Increment()
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"invalid path type",
+			func() {
+				paths[1] = ibcmock.KeyPath{}
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failed to unmarshal merkle proof", func() {
+				proof = invalidProof
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"consensus state not found", func() {
+				proofHeight = clienttypes.ZeroHeight()
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"proof verification failed", func() {
+				values[1] = []byte("invalid value")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"path not covered by the batch proof", func() {
+				key := host.ChannelKey(testingpath.EndpointB.ChannelConfig.PortID, testingpath.EndpointB.ChannelID)
+				path, err := commitmenttypes.ApplyPrefix(s.chainB.GetPrefix(), commitmenttypes.NewMerklePath(key))
+				s.Require().NoError(err)
+				paths[1] = path
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"client state not found",
+			func() {
+				store := s.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(s.chainA.GetContext(), testingpath.EndpointA.ClientID)
+				store.Delete(host.ClientStateKey())
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			testingpath = ibctesting.NewPath(s.chainA, s.chainB)
+			testingpath.Setup()
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+
+			// create default batch proof of the client state and connection of chainB, which passes
+			// may be overwritten by malleate()
+			clientStateKey := host.FullClientStateKey(testingpath.EndpointB.ClientID)
+			connectionKey := host.ConnectionKey(testingpath.EndpointB.ConnectionID)
+
+			paths = nil
+			for _, key := range [][]byte{clientStateKey, connectionKey} {
+				path, err := commitmenttypes.ApplyPrefix(s.chainB.GetPrefix(), commitmenttypes.NewMerklePath(key))
+				s.Require().NoError(err)
+				paths = append(paths, path)
+			}
+
+			proof, proofHeight = s.chainB.QueryBatchProof([][]byte{clientStateKey, connectionKey})
+
+			clientState, ok := testingpath.EndpointB.GetClientState().(*ibctm.ClientState)
+			s.Require().True(ok)
+			clientStateBz, err := s.chainB.Codec.MarshalInterface(clientState)
+			s.Require().NoError(err)
+
+			connection := testingpath.EndpointB.GetConnection()
+			connectionBz, err := s.chainB.Codec.Marshal(&connection)
+			s.Require().NoError(err)
+
+			values = [][]byte{clientStateBz, connectionBz}
+
+			tc.malleate() // make changes as necessary
+
+			lightClientModule, err := s.chainA.App.GetIBCKeeper().ClientKeeper.Route(s.chainA.GetContext(), testingpath.EndpointA.ClientID)
+			s.Require().NoError(err)
+
+			batchVerifier, ok := lightClientModule.(exported.BatchVerifier)
+			s.Require().True(ok)
+
+			err = batchVerifier.VerifyBatchMembership(
+				s.chainA.GetContext(), testingpath.EndpointA.ClientID, proofHeight, delayTimePeriod, delayBlockPeriod,
+				proof, paths, values,
+			)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (s *TendermintTestSuite) TestVerifyNonMembership() {
 	var (
 		testingpath         *ibctesting.Path
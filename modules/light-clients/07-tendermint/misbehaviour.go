@@ -47,6 +47,16 @@ func (m Misbehaviour) GetTime() time.Time {
 
 // ValidateBasic implements Misbehaviour interface
 func (m Misbehaviour) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(m.ClientId); err != nil {
+		return errorsmod.Wrap(err, "misbehaviour client ID is invalid")
+	}
+
+	return m.validateHeaders()
+}
+
+// validateHeaders performs basic validation of the headers of the misbehaviour and checks that
+// each header is committed to by its validator set.
+func (m Misbehaviour) validateHeaders() error {
 	if m.Header1 == nil {
 		return errorsmod.Wrap(ErrInvalidHeader, "misbehaviour Header1 cannot be nil")
 	}
@@ -69,10 +79,6 @@ func (m Misbehaviour) ValidateBasic() error {
 		return errorsmod.Wrap(clienttypes.ErrInvalidMisbehaviour, "headers must have identical chainIDs")
 	}
 
-	if err := host.ClientIdentifierValidator(m.ClientId); err != nil {
-		return errorsmod.Wrap(err, "misbehaviour client ID is invalid")
-	}
-
 	// ValidateBasic on both validators
 	if err := m.Header1.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(
@@ -20,40 +20,19 @@ import (
 )
 
 // CheckForMisbehaviour detects duplicate height misbehaviour and BFT time violation misbehaviour
-// in a submitted Header message and verifies the correctness of a submitted Misbehaviour ClientMessage
-func (ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
+// in a submitted Header or HeaderBatch message and verifies the correctness of a submitted Misbehaviour or
+// LightClientAttackEvidence ClientMessage
+func (cs ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
 	switch msg := msg.(type) {
 	case *Header:
-		tmHeader := msg
-		consState := tmHeader.ConsensusState()
-
-		// Check if the Client store already has a consensus state for the header's height
-		// If the consensus state exists, and it matches the header then we return early
-		// since header has already been submitted in a previous UpdateClient.
-		if existingConsState, found := GetConsensusState(clientStore, cdc, tmHeader.GetHeight()); found {
-			// This header has already been submitted and the necessary state is already stored
-			// in client store, thus we can return early without further validation.
-			if reflect.DeepEqual(existingConsState, tmHeader.ConsensusState()) {
-				return false
+		return checkHeaderForMisbehaviour(cdc, clientStore, msg)
+	case *HeaderBatch:
+		// every header in the batch has been verified, thus any header conflicting with
+		// the stored consensus states is evidence of misbehaviour
+		for _, header := range msg.Headers {
+			if checkHeaderForMisbehaviour(cdc, clientStore, header) {
+				return true
 			}
-
-			// A consensus state already exists for this height, but it does not match the provided header.
-			// The assumption is that Header has already been validated. Thus we can return true as misbehaviour is present
-			return true
-		}
-
-		// Check that consensus state timestamps are monotonic
-		prevCons, prevOk := GetPreviousConsensusState(clientStore, cdc, tmHeader.GetHeight())
-		nextCons, nextOk := GetNextConsensusState(clientStore, cdc, tmHeader.GetHeight())
-		// if previous consensus state exists, check consensus state time is greater than previous consensus state time
-		// if previous consensus state is not before current consensus state return true
-		if prevOk && !prevCons.Timestamp.Before(consState.Timestamp) {
-			return true
-		}
-		// if next consensus state exists, check consensus state time is less than next consensus state time
-		// if next consensus state is not after current consensus state return true
-		if nextOk && !nextCons.Timestamp.After(consState.Timestamp) {
-			return true
 		}
 	case *Misbehaviour:
 		// if heights are equal check that this is valid misbehaviour of a fork
@@ -78,6 +57,13 @@ func (ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec,
 			// Header2 time in order to be valid misbehaviour (violation of monotonic time).
 			return true
 		}
+	case *LightClientAttackEvidence:
+		misbehaviour, err := msg.Misbehaviour()
+		if err != nil {
+			return false
+		}
+
+		return cs.CheckForMisbehaviour(ctx, cdc, clientStore, misbehaviour)
 	default:
 		return false
 	}
@@ -85,6 +71,43 @@ func (ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec,
 	return false
 }
 
+// checkHeaderForMisbehaviour returns true if the consensus state of the header conflicts with the consensus state
+// stored at the same height, or if its timestamp is not monotonic with respect to the neighbouring consensus states.
+func checkHeaderForMisbehaviour(cdc codec.BinaryCodec, clientStore storetypes.KVStore, tmHeader *Header) bool {
+	consState := tmHeader.ConsensusState()
+
+	// Check if the Client store already has a consensus state for the header's height
+	// If the consensus state exists, and it matches the header then we return early
+	// since header has already been submitted in a previous UpdateClient.
+	if existingConsState, found := GetConsensusState(clientStore, cdc, tmHeader.GetHeight()); found {
+		// This header has already been submitted and the necessary state is already stored
+		// in client store, thus we can return early without further validation.
+		if reflect.DeepEqual(existingConsState, tmHeader.ConsensusState()) {
+			return false
+		}
+
+		// A consensus state already exists for this height, but it does not match the provided header.
+		// The assumption is that Header has already been validated. Thus we can return true as misbehaviour is present
+		return true
+	}
+
+	// Check that consensus state timestamps are monotonic
+	prevCons, prevOk := GetPreviousConsensusState(clientStore, cdc, tmHeader.GetHeight())
+	nextCons, nextOk := GetNextConsensusState(clientStore, cdc, tmHeader.GetHeight())
+	// if previous consensus state exists, check consensus state time is greater than previous consensus state time
+	// if previous consensus state is not before current consensus state return true
+	if prevOk && !prevCons.Timestamp.Before(consState.Timestamp) {
+		return true
+	}
+	// if next consensus state exists, check consensus state time is less than next consensus state time
+	// if next consensus state is not after current consensus state return true
+	if nextOk && !nextCons.Timestamp.After(consState.Timestamp) {
+		return true
+	}
+
+	return false
+}
+
 // verifyMisbehaviour determines whether or not two conflicting
 // headers at the same height would have convinced the light client.
 //
@@ -95,6 +95,8 @@ func IsMatchingClientState(subject, substitute ClientState) bool {
 	substitute.TrustingPeriod = time.Duration(0)
 	subject.ChainId = ""
 	substitute.ChainId = ""
+	subject.RetentionPolicy = nil
+	substitute.RetentionPolicy = nil
 	// sets both sets of flags to true as these flags have been DEPRECATED, see ADR-026 for more information
 	subject.AllowUpdateAfterExpiry = true
 	substitute.AllowUpdateAfterExpiry = true
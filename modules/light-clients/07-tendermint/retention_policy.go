@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package tendermint
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+// MaxRetentionPolicyPrunesPerUpdate is the maximum number of consensus states pruned by the retention
+// policy of a client in a single client update. Consensus states exceeding this limit are pruned in
+// subsequent updates, or all at once by pruning the consensus states of the client.
+const MaxRetentionPolicyPrunesPerUpdate = 10
+
+// NewRetentionPolicy creates a new RetentionPolicy instance.
+func NewRetentionPolicy(maxConsensusStates, heightInterval, minRetentionBlocks uint64) *RetentionPolicy {
+	return &RetentionPolicy{
+		MaxConsensusStates: maxConsensusStates,
+		HeightInterval:     heightInterval,
+		MinRetentionBlocks: minRetentionBlocks,
+	}
+}
+
+// Validate performs basic validation of the retention policy. A retention policy must limit
+// either the number of consensus states or the heights at which consensus states are retained.
+func (rp RetentionPolicy) Validate() error {
+	if rp.MaxConsensusStates == 0 && rp.HeightInterval <= 1 {
+		return errorsmod.Wrap(ErrInvalidRetentionPolicy, "retention policy must set max consensus states or a height interval greater than 1")
+	}
+
+	return nil
+}
+
+// pruneConsensusStatesByRetentionPolicy prunes the consensus states which are not retained by the retention policy of
+// the client, oldest first. The consensus state at the latest height and consensus states processed within the minimum
+// retention blocks are never pruned. Pruning stops at the first consensus state still inside the retention window, so
+// that consensus states which cannot be pruned yet are not iterated on every update. At most limit consensus states are
+// pruned, unless limit is zero. The number of consensus states pruned is returned.
+func (cs ClientState) pruneConsensusStatesByRetentionPolicy(ctx sdk.Context, clientStore storetypes.KVStore, limit int) int {
+	policy := cs.RetentionPolicy
+	if policy == nil {
+		return 0
+	}
+
+	// consensus states below the cutoff height exceed the maximum number of consensus states
+	var cutoffHeight exported.Height
+	if policy.MaxConsensusStates != 0 {
+		cutoffHeight = cs.retentionCutoffHeight(ctx, clientStore)
+	}
+
+	var pruneHeights []exported.Height
+	IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+		if limit != 0 && len(pruneHeights) == limit {
+			return true
+		}
+
+		if cs.isProtectedConsensusState(ctx, clientStore, height) {
+			return true
+		}
+
+		if cs.isIntervalConsensusState(height) && (cutoffHeight == nil || height.GTE(cutoffHeight)) {
+			return false
+		}
+
+		pruneHeights = append(pruneHeights, height)
+		return false
+	})
+
+	for _, height := range pruneHeights {
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+	}
+
+	return len(pruneHeights)
+}
+
+// retentionCutoffHeight returns the height of the oldest of the newest MaxConsensusStates consensus states retained by
+// the retention policy, iterating the consensus states in descending order. Protected consensus states count towards
+// the maximum. Nil is returned if the client does not store more consensus states than the maximum.
+func (cs ClientState) retentionCutoffHeight(ctx sdk.Context, clientStore storetypes.KVStore) exported.Height {
+	iterator := storetypes.KVStoreReversePrefixIterator(clientStore, []byte(KeyIterateConsensusStatePrefix))
+	defer iterator.Close()
+
+	var numRetained uint64
+	for ; iterator.Valid(); iterator.Next() {
+		height := GetHeightFromIterationKey(iterator.Key())
+		if !cs.isIntervalConsensusState(height) && !cs.isProtectedConsensusState(ctx, clientStore, height) {
+			continue
+		}
+
+		numRetained++
+		if numRetained == cs.RetentionPolicy.MaxConsensusStates {
+			return height
+		}
+	}
+
+	return nil
+}
+
+// isIntervalConsensusState returns true if the provided height is retained by the height interval of the retention
+// policy. All heights are retained if no height interval is set.
+func (cs ClientState) isIntervalConsensusState(height exported.Height) bool {
+	return cs.RetentionPolicy.HeightInterval <= 1 || height.GetRevisionHeight()%cs.RetentionPolicy.HeightInterval == 0
+}
+
+// isProtectedConsensusState returns true if the consensus state at the provided height must be retained regardless
+// of the retention policy, because it is the consensus state at the latest height or was processed within the minimum
+// retention blocks and may therefore still be referenced by in-flight proofs.
+func (cs ClientState) isProtectedConsensusState(ctx sdk.Context, clientStore storetypes.KVStore, height exported.Height) bool {
+	if height.EQ(cs.LatestHeight) {
+		return true
+	}
+
+	processedHeight, found := GetProcessedHeight(clientStore, height)
+	if !found {
+		return false
+	}
+
+	return processedHeight.GetRevisionHeight()+cs.RetentionPolicy.MinRetentionBlocks > uint64(ctx.BlockHeight())
+}
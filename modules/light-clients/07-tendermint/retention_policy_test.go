@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package tendermint_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+// consensusStateHeights returns the heights of all consensus states stored for the client on chainA in ascending order.
+func (s *TendermintTestSuite) consensusStateHeights(clientID string) []exported.Height {
+	clientStore := s.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(s.chainA.GetContext(), clientID)
+
+	var heights []exported.Height
+	ibctm.IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+		heights = append(heights, height)
+		return false
+	})
+
+	return heights
+}
+
+// setRetentionPolicy sets the provided retention policy on the client of the path on chainA.
+func (s *TendermintTestSuite) setRetentionPolicy(path *ibctesting.Path, policy *ibctm.RetentionPolicy) {
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	s.Require().True(ok)
+
+	clientState.RetentionPolicy = policy
+	path.EndpointA.SetClientState(clientState)
+}
+
+func (s *TendermintTestSuite) TestRetentionPolicyValidate() {
+	testCases := []struct {
+		name   string
+		policy *ibctm.RetentionPolicy
+		expErr error
+	}{
+		{
+			"success: max consensus states", ibctm.NewRetentionPolicy(10, 0, 0), nil,
+		},
+		{
+			"success: height interval", ibctm.NewRetentionPolicy(0, 100, 0), nil,
+		},
+		{
+			"success: all fields set", ibctm.NewRetentionPolicy(10, 100, 1000), nil,
+		},
+		{
+			"failure: empty policy", ibctm.NewRetentionPolicy(0, 0, 0), ibctm.ErrInvalidRetentionPolicy,
+		},
+		{
+			"failure: height interval of one retains every consensus state", ibctm.NewRetentionPolicy(0, 1, 1000), ibctm.ErrInvalidRetentionPolicy,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := tc.policy.Validate()
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+
+			clientState := ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath)
+			clientState.RetentionPolicy = tc.policy
+
+			err = clientState.Validate()
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *TendermintTestSuite) TestUpdateStateRetentionPolicy() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name             string
+		policy           *ibctm.RetentionPolicy
+		expNumConsStates int
+		assertHeights    func(heights []exported.Height)
+	}{
+		{
+			"max consensus states", ibctm.NewRetentionPolicy(3, 0, 0), 3, func(heights []exported.Height) {},
+		},
+		{
+			"height interval", ibctm.NewRetentionPolicy(0, 2, 0), -1, func(heights []exported.Height) {
+				latestHeight := path.EndpointA.GetClientLatestHeight()
+				for _, height := range heights {
+					if !height.EQ(latestHeight) {
+						s.Require().Zero(height.GetRevisionHeight() % 2)
+					}
+				}
+			},
+		},
+		{
+			"minimum retention blocks protect recently processed consensus states", ibctm.NewRetentionPolicy(1, 0, 100), 6, func(heights []exported.Height) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path = ibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupClients()
+
+			s.setRetentionPolicy(path, tc.policy)
+
+			for range 5 {
+				s.Require().NoError(path.EndpointA.UpdateClient())
+			}
+
+			heights := s.consensusStateHeights(path.EndpointA.ClientID)
+			if tc.expNumConsStates >= 0 {
+				s.Require().Len(heights, tc.expNumConsStates)
+			}
+
+			// the consensus state at the latest height is always retained
+			s.Require().True(heights[len(heights)-1].EQ(path.EndpointA.GetClientLatestHeight()))
+			tc.assertHeights(heights)
+		})
+	}
+}
+
+func (s *TendermintTestSuite) TestPruneConsensusStatesRetentionPolicy() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupClients()
+
+	for range 4 {
+		s.Require().NoError(path.EndpointA.UpdateClient())
+	}
+	initialHeights := s.consensusStateHeights(path.EndpointA.ClientID)
+	s.Require().Len(initialHeights, 5)
+
+	// consensus states are only pruned by the retention policy once it is set
+	s.setRetentionPolicy(path, ibctm.NewRetentionPolicy(2, 0, 0))
+
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	s.Require().True(ok)
+
+	ctx := s.chainA.GetContext()
+	clientStore := s.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+	pruned := ibctm.PruneConsensusStates(ctx, clientStore, s.chainA.App.AppCodec(), clientState, 0)
+	s.Require().Equal(3, pruned)
+
+	heights := s.consensusStateHeights(path.EndpointA.ClientID)
+	s.Require().Len(heights, 2)
+	s.Require().True(heights[1].EQ(clientState.LatestHeight))
+
+	// the metadata of pruned consensus states is removed
+	_, found := ibctm.GetProcessedHeight(clientStore, initialHeights[0])
+	s.Require().False(found)
+
+	// pruning again is a no-op
+	s.Require().Zero(ibctm.PruneConsensusStates(ctx, clientStore, s.chainA.App.AppCodec(), clientState, 0))
+}
+
+func (s *TendermintTestSuite) TestPruneConsensusStatesLimit() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupClients()
+
+	for range 4 {
+		s.Require().NoError(path.EndpointA.UpdateClient())
+	}
+	initialHeights := s.consensusStateHeights(path.EndpointA.ClientID)
+	s.Require().Len(initialHeights, 5)
+
+	s.setRetentionPolicy(path, ibctm.NewRetentionPolicy(1, 0, 0))
+
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	s.Require().True(ok)
+
+	clientStore := s.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(s.chainA.GetContext(), path.EndpointA.ClientID)
+
+	// the two oldest consensus states have expired
+	consensusState, found := ibctm.GetConsensusState(clientStore, s.chainA.App.AppCodec(), initialHeights[1])
+	s.Require().True(found)
+	expiredCtx := s.chainA.GetContext().WithBlockTime(consensusState.Timestamp.Add(clientState.TrustingPeriod).Add(time.Nanosecond))
+
+	// the expired consensus states are pruned first, within the limit
+	pruned := ibctm.PruneConsensusStates(expiredCtx, clientStore, s.chainA.App.AppCodec(), clientState, 1)
+	s.Require().Equal(1, pruned)
+	s.Require().Equal(initialHeights[1:], s.consensusStateHeights(path.EndpointA.ClientID))
+
+	// the remaining expired consensus state and the consensus states not retained by the retention policy are pruned
+	pruned = ibctm.PruneConsensusStates(expiredCtx, clientStore, s.chainA.App.AppCodec(), clientState, 2)
+	s.Require().Equal(2, pruned)
+	s.Require().Equal(initialHeights[3:], s.consensusStateHeights(path.EndpointA.ClientID))
+
+	pruned = ibctm.PruneConsensusStates(expiredCtx, clientStore, s.chainA.App.AppCodec(), clientState, 0)
+	s.Require().Equal(1, pruned)
+	s.Require().Equal(initialHeights[4:], s.consensusStateHeights(path.EndpointA.ClientID))
+}
+
+func (s *TendermintTestSuite) TestPruneConsensusStatesRetentionPolicyStopsAtRetentionWindow() {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupClients()
+
+	for range 4 {
+		s.Require().NoError(path.EndpointA.UpdateClient())
+	}
+	initialHeights := s.consensusStateHeights(path.EndpointA.ClientID)
+	s.Require().Len(initialHeights, 5)
+
+	s.setRetentionPolicy(path, ibctm.NewRetentionPolicy(1, 0, 5))
+
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	s.Require().True(ok)
+
+	ctx := s.chainA.GetContext()
+	clientStore := s.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+	// only the two oldest consensus states are outside the retention window
+	s.Require().GreaterOrEqual(ctx.BlockHeight(), int64(5))
+	retentionHeight := clienttypes.NewHeight(0, uint64(ctx.BlockHeight())-5)
+	for _, height := range initialHeights {
+		ibctm.SetProcessedHeight(clientStore, height, clienttypes.NewHeight(0, uint64(ctx.BlockHeight())))
+	}
+	ibctm.SetProcessedHeight(clientStore, initialHeights[0], retentionHeight)
+	ibctm.SetProcessedHeight(clientStore, initialHeights[1], retentionHeight)
+	ibctm.SetProcessedHeight(clientStore, initialHeights[3], retentionHeight)
+
+	// pruning stops at the first consensus state inside the retention window
+	pruned := ibctm.PruneConsensusStates(ctx, clientStore, s.chainA.App.AppCodec(), clientState, 0)
+	s.Require().Equal(2, pruned)
+	s.Require().Equal(initialHeights[2:], s.consensusStateHeights(path.EndpointA.ClientID))
+}
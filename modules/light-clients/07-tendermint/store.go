@@ -292,6 +292,47 @@ func PruneAllExpiredConsensusStates(
 	return len(heights)
 }
 
+// PruneConsensusStates prunes the expired consensus states of the client and the consensus states which are not
+// retained by the retention policy of the client, if set, oldest first. At most limit consensus states are pruned,
+// unless limit is zero. Expired consensus states are iterated in ascending order up to the first consensus state
+// which has not expired, as consensus states of later heights have later timestamps. The number of consensus states
+// pruned is returned.
+func PruneConsensusStates(
+	ctx sdk.Context, clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec, clientState *ClientState, limit int,
+) int {
+	var heights []exported.Height
+	IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+		if limit != 0 && len(heights) == limit {
+			return true
+		}
+
+		consState, found := GetConsensusState(clientStore, cdc, height)
+		if !found || !clientState.IsExpired(consState.Timestamp, ctx.BlockTime()) {
+			return true
+		}
+
+		heights = append(heights, height)
+		return false
+	})
+
+	for _, height := range heights {
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+	}
+
+	totalPruned := len(heights)
+	if limit != 0 {
+		if totalPruned == limit {
+			return totalPruned
+		}
+
+		limit -= totalPruned
+	}
+
+	return totalPruned + clientState.pruneConsensusStatesByRetentionPolicy(ctx, clientStore, limit)
+}
+
 // Helper function for GetNextConsensusState and GetPreviousConsensusState
 func getTmConsensusState(clientStore storetypes.KVStore, cdc codec.BinaryCodec, key []byte) (*ConsensusState, bool) {
 	bz := clientStore.Get(key)
@@ -63,6 +63,10 @@ type ClientState struct {
 	AllowUpdateAfterExpiry bool `protobuf:"varint,10,opt,name=allow_update_after_expiry,json=allowUpdateAfterExpiry,proto3" json:"allow_update_after_expiry,omitempty"` // Deprecated: Do not use.
 	// allow_update_after_misbehaviour is deprecated
 	AllowUpdateAfterMisbehaviour bool `protobuf:"varint,11,opt,name=allow_update_after_misbehaviour,json=allowUpdateAfterMisbehaviour,proto3" json:"allow_update_after_misbehaviour,omitempty"` // Deprecated: Do not use.
+	// retention policy for the consensus states of the client, enforced in
+	// addition to the pruning of expired consensus states. If unset, only
+	// expired consensus states are pruned.
+	RetentionPolicy *RetentionPolicy `protobuf:"bytes,12,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -98,6 +102,55 @@ func (m *ClientState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClientState proto.InternalMessageInfo
 
+// RetentionPolicy defines which consensus states are retained by a client.
+// The consensus state at the latest height of the client, and consensus states
+// which were processed within the last min_retention_blocks blocks, are always
+// retained so that they remain available for in-flight proofs.
+type RetentionPolicy struct {
+	// maximum number of consensus states retained by the client, zero means
+	// there is no limit
+	MaxConsensusStates uint64 `protobuf:"varint,1,opt,name=max_consensus_states,json=maxConsensusStates,proto3" json:"max_consensus_states,omitempty"`
+	// only consensus states at revision heights which are a multiple of the
+	// height interval are retained, zero or one retains every height
+	HeightInterval uint64 `protobuf:"varint,2,opt,name=height_interval,json=heightInterval,proto3" json:"height_interval,omitempty"`
+	// number of blocks after a consensus state was processed during which it
+	// is always retained
+	MinRetentionBlocks uint64 `protobuf:"varint,3,opt,name=min_retention_blocks,json=minRetentionBlocks,proto3" json:"min_retention_blocks,omitempty"`
+}
+
+func (m *RetentionPolicy) Reset()         { *m = RetentionPolicy{} }
+func (m *RetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetentionPolicy) ProtoMessage()    {}
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d6cf2b288949be, []int{1}
+}
+func (m *RetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetentionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetentionPolicy.Merge(m, src)
+}
+func (m *RetentionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetentionPolicy proto.InternalMessageInfo
+
 // ConsensusState defines the consensus state from Tendermint.
 type ConsensusState struct {
 	// timestamp that corresponds to the block height in which the ConsensusState
@@ -112,7 +165,7 @@ func (m *ConsensusState) Reset()         { *m = ConsensusState{} }
 func (m *ConsensusState) String() string { return proto.CompactTextString(m) }
 func (*ConsensusState) ProtoMessage()    {}
 func (*ConsensusState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{1}
+	return fileDescriptor_c6d6cf2b288949be, []int{2}
 }
 func (m *ConsensusState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,7 +207,7 @@ func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
 func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
 func (*Misbehaviour) ProtoMessage()    {}
 func (*Misbehaviour) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{2}
+	return fileDescriptor_c6d6cf2b288949be, []int{3}
 }
 func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,6 +236,55 @@ func (m *Misbehaviour) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Misbehaviour proto.InternalMessageInfo
 
+// LightClientAttackEvidence defines a misbehaviour client message carrying the light client attack
+// evidence produced by a CometBFT node which detected a fork of the counterparty chain. The evidence
+// is converted into a Misbehaviour consisting of the trusted header and the conflicting block of the
+// evidence, which is then verified and checked as any other submitted Misbehaviour.
+type LightClientAttackEvidence struct {
+	// the evidence as produced by CometBFT, including the conflicting block and the common height
+	Evidence *types2.LightClientAttackEvidence `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// header of the trusted chain at the height of the conflicting block
+	TrustedHeader *Header `protobuf:"bytes,2,opt,name=trusted_header,json=trustedHeader,proto3" json:"trusted_header,omitempty"`
+	// validator set of the counterparty at the common height of the evidence, used to verify the
+	// conflicting block of a lunatic attack. It must be empty if the common height of the evidence
+	// is the height of the conflicting block, in which case the conflicting block is verified against
+	// the trusted height and trusted validators of the trusted header.
+	CommonValidators *types2.ValidatorSet `protobuf:"bytes,3,opt,name=common_validators,json=commonValidators,proto3" json:"common_validators,omitempty"`
+}
+
+func (m *LightClientAttackEvidence) Reset()         { *m = LightClientAttackEvidence{} }
+func (m *LightClientAttackEvidence) String() string { return proto.CompactTextString(m) }
+func (*LightClientAttackEvidence) ProtoMessage()    {}
+func (*LightClientAttackEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d6cf2b288949be, []int{4}
+}
+func (m *LightClientAttackEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttackEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttackEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttackEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttackEvidence.Merge(m, src)
+}
+func (m *LightClientAttackEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttackEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttackEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttackEvidence proto.InternalMessageInfo
+
 // Header defines the Tendermint client consensus Header.
 // It encapsulates all the information necessary to update from a trusted
 // Tendermint ConsensusState. The inclusion of TrustedHeight and
@@ -206,7 +308,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{3}
+	return fileDescriptor_c6d6cf2b288949be, []int{5}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,6 +358,65 @@ func (m *Header) GetTrustedValidators() *types2.ValidatorSet {
 	return nil
 }
 
+// HeaderBatch defines a chain of Tendermint headers which are verified in a
+// single client update. The first header is verified against a consensus state
+// stored on the client, and every following header is verified against the
+// preceding header in the batch using skipping verification. The TrustedHeight
+// of each following header must therefore be the height of the preceding header.
+// Only the consensus state of the final header is stored, along with the
+// consensus states of the headers at the optional checkpoint heights.
+type HeaderBatch struct {
+	Headers     []*Header      `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	Checkpoints []types.Height `protobuf:"bytes,2,rep,name=checkpoints,proto3" json:"checkpoints"`
+}
+
+func (m *HeaderBatch) Reset()         { *m = HeaderBatch{} }
+func (m *HeaderBatch) String() string { return proto.CompactTextString(m) }
+func (*HeaderBatch) ProtoMessage()    {}
+func (*HeaderBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d6cf2b288949be, []int{6}
+}
+func (m *HeaderBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeaderBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeaderBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeaderBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderBatch.Merge(m, src)
+}
+func (m *HeaderBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeaderBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderBatch proto.InternalMessageInfo
+
+func (m *HeaderBatch) GetHeaders() []*Header {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *HeaderBatch) GetCheckpoints() []types.Height {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
 // Fraction defines the protobuf message type for tmmath.Fraction that only
 // supports positive values.
 type Fraction struct {
@@ -267,7 +428,7 @@ func (m *Fraction) Reset()         { *m = Fraction{} }
 func (m *Fraction) String() string { return proto.CompactTextString(m) }
 func (*Fraction) ProtoMessage()    {}
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{4}
+	return fileDescriptor_c6d6cf2b288949be, []int{7}
 }
 func (m *Fraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -312,9 +473,12 @@ func (m *Fraction) GetDenominator() uint64 {
 
 func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.tendermint.v1.ClientState")
+	proto.RegisterType((*RetentionPolicy)(nil), "ibc.lightclients.tendermint.v1.RetentionPolicy")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.tendermint.v1.ConsensusState")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.tendermint.v1.Misbehaviour")
+	proto.RegisterType((*LightClientAttackEvidence)(nil), "ibc.lightclients.tendermint.v1.LightClientAttackEvidence")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.tendermint.v1.Header")
+	proto.RegisterType((*HeaderBatch)(nil), "ibc.lightclients.tendermint.v1.HeaderBatch")
 	proto.RegisterType((*Fraction)(nil), "ibc.lightclients.tendermint.v1.Fraction")
 }
 
@@ -323,66 +487,80 @@ func init() {
 }
 
 var fileDescriptor_c6d6cf2b288949be = []byte{
-	// 942 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xc7, 0xe3, 0x34, 0xdb, 0x26, 0x93, 0x64, 0x0b, 0xa3, 0x15, 0x72, 0xab, 0x2a, 0x09, 0x3d,
-	0x40, 0x2e, 0xb5, 0x37, 0x59, 0x24, 0x24, 0x16, 0x0e, 0xa4, 0xbb, 0xd0, 0xb2, 0x5b, 0xa8, 0x5c,
-	0xe0, 0xc0, 0xc5, 0x1a, 0xdb, 0x13, 0x7b, 0xb4, 0xb6, 0xc7, 0x9a, 0x19, 0x87, 0x94, 0x13, 0x47,
-	0x8e, 0x7b, 0xe4, 0xc8, 0x47, 0xe0, 0x63, 0xec, 0xb1, 0x17, 0x24, 0x4e, 0x05, 0xa5, 0xdf, 0x82,
-	0x13, 0x9a, 0x17, 0x27, 0xa6, 0xac, 0xd8, 0x8a, 0x4b, 0xf4, 0xcc, 0x3c, 0xff, 0xe7, 0x97, 0x99,
-	0xe7, 0x65, 0x0c, 0x5c, 0x12, 0x84, 0x6e, 0x4a, 0xe2, 0x44, 0x84, 0x29, 0xc1, 0xb9, 0xe0, 0xae,
-	0xc0, 0x79, 0x84, 0x59, 0x46, 0x72, 0xe1, 0x2e, 0x26, 0xb5, 0x95, 0x53, 0x30, 0x2a, 0x28, 0x1c,
-	0x90, 0x20, 0x74, 0xea, 0x01, 0x4e, 0x4d, 0xb2, 0x98, 0xec, 0x8f, 0x6a, 0xf1, 0xe2, 0xb2, 0xc0,
-	0xdc, 0x5d, 0xa0, 0x94, 0x44, 0x48, 0x50, 0xa6, 0x09, 0xfb, 0x07, 0xff, 0x52, 0xa8, 0xdf, 0xca,
-	0x1b, 0x52, 0x9e, 0x51, 0xee, 0x92, 0x90, 0x4f, 0x1f, 0xc9, 0x13, 0x14, 0x8c, 0xd2, 0x79, 0xe5,
-	0x1d, 0xc4, 0x94, 0xc6, 0x29, 0x76, 0xd5, 0x2a, 0x28, 0xe7, 0x6e, 0x54, 0x32, 0x24, 0x08, 0xcd,
-	0x8d, 0x7f, 0x78, 0xdb, 0x2f, 0x48, 0x86, 0xb9, 0x40, 0x59, 0x51, 0x09, 0xe4, 0x7d, 0x43, 0xca,
-	0xb0, 0xab, 0x8f, 0x2f, 0xff, 0x41, 0x5b, 0x46, 0xf0, 0xfe, 0x46, 0x40, 0xb3, 0x8c, 0x88, 0xac,
-	0x12, 0xad, 0x57, 0x46, 0xf8, 0x20, 0xa6, 0x31, 0x55, 0xa6, 0x2b, 0x2d, 0xbd, 0x7b, 0xb8, 0xba,
-	0x07, 0xba, 0xc7, 0x8a, 0x77, 0x21, 0x90, 0xc0, 0x70, 0x0f, 0xb4, 0xc3, 0x04, 0x91, 0xdc, 0x27,
-	0x91, 0x6d, 0x8d, 0xac, 0x71, 0xc7, 0xdb, 0x51, 0xeb, 0xd3, 0x08, 0x7e, 0x05, 0xba, 0x82, 0x95,
-	0x5c, 0xf8, 0x29, 0x5e, 0xe0, 0xd4, 0x6e, 0x8e, 0xac, 0x71, 0x77, 0x3a, 0x76, 0xfe, 0x3b, 0xbf,
-	0xce, 0x67, 0x0c, 0x85, 0xf2, 0xc2, 0xb3, 0xd6, 0xab, 0xeb, 0x61, 0xc3, 0x03, 0x0a, 0xf1, 0x5c,
-	0x12, 0xe0, 0x73, 0xb0, 0xab, 0x56, 0x24, 0x8f, 0xfd, 0x02, 0x33, 0x42, 0x23, 0x7b, 0x4b, 0x41,
-	0xf7, 0x1c, 0x9d, 0x16, 0xa7, 0x4a, 0x8b, 0xf3, 0xc4, 0xa4, 0x6d, 0xd6, 0x96, 0x94, 0x9f, 0xff,
-	0x18, 0x5a, 0xde, 0xfd, 0x2a, 0xf6, 0x5c, 0x85, 0xc2, 0x2f, 0xc1, 0x5b, 0x65, 0x1e, 0xd0, 0x3c,
-	0xaa, 0xe1, 0x5a, 0x77, 0xc7, 0xed, 0xae, 0x83, 0x0d, 0xef, 0x19, 0xd8, 0xcd, 0xd0, 0xd2, 0x0f,
-	0x53, 0x1a, 0xbe, 0xf0, 0x23, 0x46, 0xe6, 0xc2, 0xbe, 0x77, 0x77, 0x5c, 0x3f, 0x43, 0xcb, 0x63,
-	0x19, 0xfa, 0x44, 0x46, 0xc2, 0xa7, 0xa0, 0x3f, 0x67, 0xf4, 0x07, 0x9c, 0xfb, 0x09, 0x96, 0xb9,
-	0xb2, 0xb7, 0x15, 0x6a, 0x5f, 0x65, 0x4f, 0x56, 0xcf, 0x31, 0x45, 0x5d, 0x4c, 0x9c, 0x13, 0xa5,
-	0x30, 0xf9, 0xea, 0xe9, 0x30, 0xbd, 0x27, 0x31, 0x29, 0x12, 0x98, 0x8b, 0x0a, 0xb3, 0x73, 0x57,
-	0x8c, 0x0e, 0x33, 0x98, 0xc7, 0xa0, 0xab, 0xba, 0xd4, 0xe7, 0x05, 0x0e, 0xb9, 0xdd, 0x1e, 0x6d,
-	0x29, 0x88, 0xee, 0x64, 0x47, 0x75, 0xb2, 0x24, 0x9c, 0x4b, 0xcd, 0x45, 0x81, 0x43, 0x0f, 0x14,
-	0x95, 0xc9, 0xe1, 0xbb, 0xa0, 0x57, 0x16, 0x31, 0x43, 0x11, 0xf6, 0x0b, 0x24, 0x12, 0xbb, 0x33,
-	0xda, 0x1a, 0x77, 0xbc, 0xae, 0xd9, 0x3b, 0x47, 0x22, 0x81, 0x9f, 0x80, 0x3d, 0x94, 0xa6, 0xf4,
-	0x7b, 0xbf, 0x2c, 0x22, 0x24, 0xb0, 0x8f, 0xe6, 0x02, 0x33, 0x1f, 0x2f, 0x0b, 0xc2, 0x2e, 0x6d,
-	0x30, 0xb2, 0xc6, 0xed, 0x59, 0xd3, 0xb6, 0xbc, 0x77, 0x94, 0xe8, 0x1b, 0xa5, 0xf9, 0x54, 0x4a,
-	0x9e, 0x2a, 0x05, 0x3c, 0x05, 0xc3, 0xd7, 0x84, 0x67, 0x84, 0x07, 0x38, 0x41, 0x0b, 0x42, 0x4b,
-	0x66, 0x77, 0xd7, 0x90, 0x83, 0xdb, 0x90, 0xb3, 0x9a, 0xee, 0xa3, 0xd6, 0x4f, 0xbf, 0x0c, 0x1b,
-	0x87, 0x3f, 0x36, 0xc1, 0xfd, 0x63, 0x9a, 0x73, 0x9c, 0xf3, 0x92, 0xeb, 0x3e, 0x9f, 0x81, 0xce,
-	0x7a, 0xd4, 0x54, 0xa3, 0xcb, 0x04, 0xdc, 0xae, 0xeb, 0xd7, 0x95, 0x42, 0x17, 0xf6, 0xa5, 0x2c,
-	0xec, 0x26, 0x0c, 0x7e, 0x0c, 0x5a, 0x8c, 0x52, 0x61, 0x26, 0xe1, 0xb0, 0x56, 0x84, 0xcd, 0xec,
-	0x2d, 0x26, 0xce, 0x19, 0x66, 0x2f, 0x52, 0xec, 0x51, 0x5a, 0x15, 0x43, 0x45, 0xc1, 0x39, 0x78,
-	0x90, 0xe3, 0xa5, 0xf0, 0xd7, 0xcf, 0x0d, 0xf7, 0x13, 0xc4, 0x13, 0x35, 0x02, 0xbd, 0xd9, 0x07,
-	0x7f, 0x5d, 0x0f, 0x1f, 0xc6, 0x44, 0x24, 0x65, 0x20, 0x71, 0x72, 0x9c, 0xb1, 0x08, 0xe6, 0x62,
-	0x63, 0xa4, 0x24, 0xe0, 0x6e, 0x70, 0x29, 0x30, 0x77, 0x4e, 0xf0, 0x72, 0x26, 0x0d, 0x0f, 0x4a,
-	0xe2, 0xb7, 0x6b, 0xe0, 0x09, 0xe2, 0x89, 0x49, 0xc1, 0x6f, 0x16, 0xe8, 0xd5, 0x33, 0x03, 0x87,
-	0xa0, 0xa3, 0x7b, 0x65, 0x3d, 0xe9, 0x2a, 0x9d, 0x6d, 0xbd, 0x79, 0x2a, 0xe7, 0xa9, 0x9d, 0x60,
-	0x14, 0x61, 0xe6, 0x4f, 0xcc, 0x0d, 0xdf, 0x7b, 0xd3, 0xac, 0x9f, 0x28, 0xfd, 0xac, 0xbb, 0xba,
-	0x1e, 0xee, 0x68, 0x7b, 0xe2, 0xed, 0x68, 0xc8, 0xa4, 0xc6, 0x9b, 0x9a, 0x31, 0xff, 0x1f, 0xbc,
-	0x69, 0xc5, 0x9b, 0x9a, 0x7b, 0xfd, 0xda, 0x04, 0xdb, 0xda, 0x05, 0x4f, 0x41, 0x9f, 0x93, 0x38,
-	0xc7, 0x91, 0xaf, 0x25, 0xa6, 0xac, 0x83, 0x3a, 0x54, 0xbf, 0xdc, 0x17, 0x4a, 0x66, 0xe8, 0xad,
-	0xab, 0xeb, 0xa1, 0xe5, 0xf5, 0x78, 0x6d, 0x0f, 0x1e, 0x83, 0xfe, 0xba, 0x2c, 0x3e, 0xc7, 0x55,
-	0x89, 0x5f, 0x83, 0x5a, 0x27, 0xfb, 0x02, 0x0b, 0xaf, 0xb7, 0xa8, 0xad, 0xe0, 0xe7, 0x40, 0x3f,
-	0x51, 0xea, 0x40, 0x6a, 0x5a, 0xb7, 0xee, 0x38, 0xad, 0x7d, 0x13, 0x67, 0xc6, 0xf5, 0x0c, 0xc0,
-	0x0a, 0xb4, 0x69, 0x16, 0xf3, 0xb6, 0xbd, 0xe9, 0x48, 0x6f, 0x9b, 0xc8, 0x4d, 0x53, 0x1c, 0x7e,
-	0x01, 0xda, 0xd5, 0xa3, 0x0c, 0x0f, 0x40, 0x27, 0x2f, 0x33, 0xcc, 0xa4, 0x47, 0xe5, 0xab, 0xe5,
-	0x6d, 0x36, 0xe0, 0x08, 0x74, 0x23, 0x9c, 0xd3, 0x8c, 0xe4, 0xca, 0xdf, 0x54, 0xfe, 0xfa, 0xd6,
-	0x0c, 0xbf, 0x5a, 0x0d, 0xac, 0xab, 0xd5, 0xc0, 0xfa, 0x73, 0x35, 0xb0, 0x5e, 0xde, 0x0c, 0x1a,
-	0x57, 0x37, 0x83, 0xc6, 0xef, 0x37, 0x83, 0xc6, 0x77, 0xcf, 0xfe, 0xd1, 0xbc, 0xfa, 0x13, 0x19,
-	0x84, 0x47, 0x31, 0x75, 0x17, 0x93, 0x89, 0x9b, 0xd1, 0xa8, 0x4c, 0x31, 0xd7, 0x5f, 0xf2, 0xa3,
-	0xea, 0x53, 0xfe, 0xf0, 0xc3, 0xa3, 0xcd, 0x6d, 0x1e, 0x6f, 0xcc, 0x60, 0x5b, 0x8d, 0xe4, 0xa3,
-	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xef, 0x7b, 0xd9, 0x79, 0xfe, 0x07, 0x00, 0x00,
+	// 1159 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x93, 0xd0, 0x26, 0x93, 0x74, 0xb3, 0x3b, 0x5a, 0x21, 0x77, 0xb5, 0x4a, 0x42, 0x0f,
+	0x6c, 0x25, 0xb4, 0xf6, 0xa6, 0x8b, 0x84, 0xc4, 0x82, 0xc4, 0xa6, 0x5b, 0xb6, 0xa5, 0x2d, 0x54,
+	0x2e, 0x70, 0xd8, 0x8b, 0x35, 0xb6, 0x27, 0xf1, 0xa8, 0xf6, 0x8c, 0xe5, 0x99, 0x84, 0x96, 0x13,
+	0x27, 0xc4, 0x71, 0x25, 0x2e, 0x1c, 0x39, 0xf0, 0x07, 0xf0, 0x17, 0x70, 0xde, 0x63, 0x2f, 0x48,
+	0x9c, 0x0a, 0x6a, 0xff, 0x0b, 0x4e, 0x68, 0x7e, 0x38, 0x76, 0x5b, 0x96, 0x76, 0xb9, 0x44, 0x33,
+	0x6f, 0xbe, 0xef, 0xcb, 0x9b, 0xf7, 0x3e, 0x3f, 0x1b, 0xb8, 0x24, 0x08, 0xdd, 0x84, 0x4c, 0x62,
+	0x11, 0x26, 0x04, 0x53, 0xc1, 0x5d, 0x81, 0x69, 0x84, 0xf3, 0x94, 0x50, 0xe1, 0xce, 0x86, 0x95,
+	0x9d, 0x93, 0xe5, 0x4c, 0x30, 0xd8, 0x23, 0x41, 0xe8, 0x54, 0x09, 0x4e, 0x05, 0x32, 0x1b, 0xde,
+	0x1b, 0x54, 0xf8, 0xe2, 0x38, 0xc3, 0xdc, 0x9d, 0xa1, 0x84, 0x44, 0x48, 0xb0, 0x5c, 0x2b, 0xdc,
+	0xbb, 0x7f, 0x05, 0xa1, 0x7e, 0xcd, 0x69, 0xff, 0xca, 0x29, 0x9e, 0x91, 0x08, 0xd3, 0x10, 0x17,
+	0xf4, 0x90, 0xf1, 0x94, 0x71, 0x97, 0x84, 0x7c, 0xfd, 0xb1, 0x4c, 0x31, 0xcb, 0x19, 0x1b, 0x17,
+	0xf4, 0xde, 0x84, 0xb1, 0x49, 0x82, 0x5d, 0xb5, 0x0b, 0xa6, 0x63, 0x37, 0x9a, 0xe6, 0x48, 0x10,
+	0x46, 0x0b, 0xf9, 0xcb, 0xe7, 0x82, 0xa4, 0x98, 0x0b, 0x94, 0x66, 0x05, 0x40, 0x16, 0x24, 0x64,
+	0x39, 0x76, 0xf5, 0xfd, 0xe4, 0x3f, 0xe8, 0x95, 0x01, 0x3c, 0x28, 0x01, 0x2c, 0x4d, 0x89, 0x48,
+	0x0b, 0xd0, 0x7c, 0x67, 0x80, 0x77, 0x27, 0x6c, 0xc2, 0xd4, 0xd2, 0x95, 0x2b, 0x1d, 0x5d, 0xfd,
+	0x6d, 0x11, 0xb4, 0x37, 0x94, 0xde, 0x81, 0x40, 0x02, 0xc3, 0x15, 0xd0, 0x0c, 0x63, 0x44, 0xa8,
+	0x4f, 0x22, 0xdb, 0x1a, 0x58, 0x6b, 0x2d, 0x6f, 0x49, 0xed, 0xb7, 0x23, 0xf8, 0x05, 0x68, 0x8b,
+	0x7c, 0xca, 0x85, 0x9f, 0xe0, 0x19, 0x4e, 0xec, 0xda, 0xc0, 0x5a, 0x6b, 0xaf, 0xaf, 0x39, 0xff,
+	0xdd, 0x00, 0xe7, 0xd3, 0x1c, 0x85, 0xf2, 0xc2, 0xa3, 0xc6, 0xab, 0xd3, 0xfe, 0x82, 0x07, 0x94,
+	0xc4, 0xae, 0x54, 0x80, 0xbb, 0xa0, 0xab, 0x76, 0x84, 0x4e, 0xfc, 0x0c, 0xe7, 0x84, 0x45, 0x76,
+	0x5d, 0x89, 0xae, 0x38, 0xba, 0x2c, 0x4e, 0x51, 0x16, 0xe7, 0x99, 0x29, 0xdb, 0xa8, 0x29, 0x55,
+	0x7e, 0xfa, 0xb3, 0x6f, 0x79, 0xb7, 0x0a, 0xee, 0xbe, 0xa2, 0xc2, 0xcf, 0xc1, 0xed, 0x29, 0x0d,
+	0x18, 0x8d, 0x2a, 0x72, 0x8d, 0x9b, 0xcb, 0x75, 0xe7, 0x64, 0xa3, 0xb7, 0x03, 0xba, 0x29, 0x3a,
+	0xf2, 0xc3, 0x84, 0x85, 0x87, 0x7e, 0x94, 0x93, 0xb1, 0xb0, 0xdf, 0xba, 0xb9, 0xdc, 0x72, 0x8a,
+	0x8e, 0x36, 0x24, 0xf5, 0x99, 0x64, 0xc2, 0x4d, 0xb0, 0x3c, 0xce, 0xd9, 0xb7, 0x98, 0xfa, 0x31,
+	0x96, 0xb5, 0xb2, 0x17, 0x95, 0xd4, 0x3d, 0x55, 0x3d, 0xd9, 0x3d, 0xc7, 0x34, 0x75, 0x36, 0x74,
+	0xb6, 0x14, 0xc2, 0xd4, 0xab, 0xa3, 0x69, 0x3a, 0x26, 0x65, 0x12, 0x24, 0x30, 0x17, 0x85, 0xcc,
+	0xd2, 0x4d, 0x65, 0x34, 0xcd, 0xc8, 0x3c, 0x01, 0x6d, 0xe5, 0x52, 0x9f, 0x67, 0x38, 0xe4, 0x76,
+	0x73, 0x50, 0x57, 0x22, 0xda, 0xc9, 0x8e, 0x72, 0xb2, 0x54, 0xd8, 0x97, 0x98, 0x83, 0x0c, 0x87,
+	0x1e, 0xc8, 0x8a, 0x25, 0x87, 0xef, 0x80, 0xce, 0x34, 0x9b, 0xe4, 0x28, 0xc2, 0x7e, 0x86, 0x44,
+	0x6c, 0xb7, 0x06, 0xf5, 0xb5, 0x96, 0xd7, 0x36, 0xb1, 0x7d, 0x24, 0x62, 0xf8, 0x31, 0x58, 0x41,
+	0x49, 0xc2, 0xbe, 0xf1, 0xa7, 0x59, 0x84, 0x04, 0xf6, 0xd1, 0x58, 0xe0, 0xdc, 0xc7, 0x47, 0x19,
+	0xc9, 0x8f, 0x6d, 0x30, 0xb0, 0xd6, 0x9a, 0xa3, 0x9a, 0x6d, 0x79, 0x6f, 0x2b, 0xd0, 0x57, 0x0a,
+	0xf3, 0x54, 0x42, 0x36, 0x15, 0x02, 0x6e, 0x83, 0xfe, 0xbf, 0xd0, 0x53, 0xc2, 0x03, 0x1c, 0xa3,
+	0x19, 0x61, 0xd3, 0xdc, 0x6e, 0xcf, 0x45, 0xee, 0x5f, 0x16, 0xd9, 0xab, 0xe0, 0xe0, 0x0b, 0x70,
+	0x3b, 0xc7, 0x02, 0x53, 0xd9, 0x1d, 0x3f, 0x63, 0x09, 0x09, 0x8f, 0xed, 0x8e, 0xaa, 0x99, 0x7b,
+	0x9d, 0x71, 0xbd, 0x82, 0xb7, 0xaf, 0x68, 0x5e, 0x37, 0xbf, 0x18, 0xf8, 0xb0, 0xf1, 0xc3, 0xcf,
+	0xfd, 0x85, 0xd5, 0x5f, 0x2c, 0xd0, 0xbd, 0x04, 0x85, 0x8f, 0xc0, 0x5d, 0x65, 0x1d, 0x46, 0x39,
+	0xa6, 0x7c, 0xca, 0x7d, 0x2e, 0x9f, 0x2d, 0xae, 0x1e, 0xa8, 0x86, 0x07, 0xa5, 0x35, 0x8a, 0x23,
+	0xf5, 0xd4, 0x71, 0xf8, 0x00, 0x74, 0x75, 0x47, 0x7d, 0x42, 0x05, 0xce, 0x67, 0x48, 0x3f, 0x5f,
+	0x0d, 0xef, 0x96, 0x0e, 0x6f, 0x9b, 0xa8, 0x92, 0x26, 0xd4, 0x2f, 0x2f, 0x15, 0x48, 0x93, 0x71,
+	0xbb, 0x6e, 0xa4, 0x09, 0x9d, 0x27, 0x33, 0x52, 0x27, 0x26, 0xcd, 0xef, 0x6a, 0xe0, 0xd6, 0xc5,
+	0x3f, 0x85, 0x23, 0xd0, 0x9a, 0x4f, 0x1b, 0x95, 0x9a, 0xf4, 0xc0, 0x65, 0x6b, 0x7f, 0x59, 0x20,
+	0xb4, 0xb7, 0x5f, 0x4a, 0x6f, 0x97, 0x34, 0xf8, 0x11, 0x68, 0xe4, 0x8c, 0x09, 0x33, 0x0c, 0x56,
+	0x2b, 0x3e, 0x2c, 0xc7, 0xcf, 0x6c, 0xe8, 0xec, 0xe1, 0xfc, 0x30, 0xc1, 0x1e, 0x63, 0x85, 0x1f,
+	0x15, 0x0b, 0x8e, 0xc1, 0x5d, 0x8a, 0x8f, 0x84, 0x3f, 0x1f, 0xc9, 0xdc, 0x8f, 0x11, 0x8f, 0xd5,
+	0x65, 0x3a, 0xa3, 0xf7, 0xff, 0x3e, 0xed, 0x3f, 0x9a, 0x10, 0x11, 0x4f, 0x03, 0x29, 0x27, 0x27,
+	0x1a, 0x16, 0xc1, 0x58, 0x94, 0x8b, 0x84, 0x04, 0xdc, 0x0d, 0x8e, 0x05, 0xe6, 0xce, 0x16, 0x3e,
+	0x1a, 0xc9, 0x85, 0x07, 0xa5, 0xe2, 0xd7, 0x73, 0xc1, 0x2d, 0xc4, 0x63, 0x53, 0x82, 0xdf, 0x2d,
+	0xd0, 0xb9, 0x60, 0x8e, 0x3e, 0x68, 0xe9, 0xd6, 0xcf, 0x87, 0x9d, 0x72, 0x54, 0x53, 0x07, 0xb7,
+	0xe5, 0x48, 0x69, 0xc6, 0x18, 0x45, 0x38, 0xf7, 0x87, 0xe6, 0x86, 0xef, 0x5e, 0xe7, 0x9a, 0x2d,
+	0x85, 0x1f, 0xb5, 0xcf, 0x4e, 0xfb, 0x4b, 0x7a, 0x3d, 0xf4, 0x96, 0xb4, 0xc8, 0xb0, 0xa2, 0xb7,
+	0x6e, 0xd7, 0xff, 0xaf, 0xde, 0x7a, 0xa1, 0xb7, 0x6e, 0xee, 0xf5, 0x7d, 0x0d, 0xac, 0xec, 0x4a,
+	0x05, 0x3d, 0xc7, 0x9f, 0x0a, 0x81, 0xc2, 0xc3, 0x4d, 0xf3, 0x96, 0x82, 0xcf, 0x41, 0xb3, 0x78,
+	0x63, 0x99, 0x26, 0xbf, 0x57, 0xfd, 0x0b, 0xfd, 0xae, 0x7b, 0x2d, 0xdd, 0x9b, 0x93, 0xe1, 0x1e,
+	0xd0, 0x13, 0x17, 0x47, 0xbe, 0xfe, 0xff, 0x37, 0x2b, 0x89, 0xb7, 0x6c, 0xd8, 0x7a, 0x0b, 0x77,
+	0xc0, 0x1d, 0xe9, 0x11, 0x46, 0x2b, 0xdd, 0x37, 0x45, 0xe9, 0x5d, 0x4d, 0x70, 0xde, 0xd0, 0x03,
+	0x2c, 0xbc, 0xdb, 0x9a, 0x58, 0x36, 0xd9, 0x14, 0xe2, 0xd7, 0x1a, 0x58, 0x34, 0xea, 0xdb, 0x60,
+	0x99, 0x93, 0x09, 0x2d, 0x73, 0xb5, 0x5e, 0xa7, 0x7c, 0xa0, 0x60, 0xa6, 0xcc, 0x8d, 0x93, 0xd3,
+	0xbe, 0xe5, 0x75, 0x78, 0x25, 0x06, 0x37, 0xc0, 0xf2, 0x3c, 0x43, 0x9f, 0xe3, 0xc2, 0xeb, 0xd7,
+	0x25, 0xd9, 0x99, 0x55, 0x76, 0xf0, 0x79, 0xb5, 0x78, 0x6a, 0x72, 0xd7, 0x6f, 0x38, 0xb9, 0xcb,
+	0xb2, 0xc9, 0x20, 0xdc, 0x03, 0xb0, 0x10, 0xaa, 0xd4, 0xad, 0x71, 0xa3, 0x94, 0xee, 0x18, 0x66,
+	0x59, 0xb8, 0xd5, 0x1f, 0x2d, 0xd0, 0x36, 0x77, 0x47, 0x22, 0x8c, 0xe1, 0x27, 0xc0, 0x98, 0x4b,
+	0x0e, 0xab, 0xfa, 0x1b, 0x74, 0xb7, 0xa0, 0xc1, 0x11, 0x68, 0x87, 0x31, 0x0e, 0x0f, 0x33, 0x46,
+	0xa8, 0xe0, 0x76, 0xcd, 0xbc, 0x5b, 0xae, 0xbb, 0x66, 0x95, 0xb4, 0xfa, 0x19, 0x68, 0x16, 0x9f,
+	0x0d, 0xf0, 0x3e, 0x68, 0xd1, 0x69, 0x8a, 0x73, 0x99, 0xaf, 0x19, 0xa0, 0x65, 0x00, 0x0e, 0x40,
+	0x3b, 0xc2, 0x94, 0xa5, 0x84, 0xaa, 0x73, 0x3d, 0x33, 0xab, 0xa1, 0x11, 0x7e, 0x75, 0xd6, 0xb3,
+	0x4e, 0xce, 0x7a, 0xd6, 0x5f, 0x67, 0x3d, 0xeb, 0xe5, 0x79, 0x6f, 0xe1, 0xe4, 0xbc, 0xb7, 0xf0,
+	0xc7, 0x79, 0x6f, 0xe1, 0xc5, 0xce, 0x85, 0xd9, 0xa2, 0x3f, 0xe2, 0x82, 0xf0, 0xe1, 0x84, 0xb9,
+	0xb3, 0xe1, 0xd0, 0x4d, 0x59, 0x34, 0x4d, 0x30, 0xd7, 0x1f, 0xa3, 0x0f, 0x8b, 0xaf, 0xd1, 0x47,
+	0x1f, 0x3c, 0x2c, 0xaf, 0xff, 0xa4, 0x5c, 0x06, 0x8b, 0x6a, 0x62, 0x3e, 0xfe, 0x67, 0x00, 0xc4,
+	0x33, 0xbd, 0x40, 0xc1, 0x0a, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -405,6 +583,18 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetentionPolicy != nil {
+		{
+			size, err := m.RetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTendermint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.AllowUpdateAfterMisbehaviour {
 		i--
 		if m.AllowUpdateAfterMisbehaviour {
@@ -468,29 +658,29 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x32
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxClockDrift, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxClockDrift):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintTendermint(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x2a
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxClockDrift, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxClockDrift):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintTendermint(dAtA, i, uint64(n4))
 	i--
-	dAtA[i] = 0x22
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod):])
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintTendermint(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x22
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTendermint(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0x1a
 	{
 		size, err := m.TrustLevel.MarshalToSizedBuffer(dAtA[:i])
@@ -512,6 +702,44 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetentionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinRetentionBlocks != 0 {
+		i = encodeVarintTendermint(dAtA, i, uint64(m.MinRetentionBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HeightInterval != 0 {
+		i = encodeVarintTendermint(dAtA, i, uint64(m.HeightInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxConsensusStates != 0 {
+		i = encodeVarintTendermint(dAtA, i, uint64(m.MaxConsensusStates))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConsensusState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -549,12 +777,12 @@ func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintTendermint(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintTendermint(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -614,6 +842,65 @@ func (m *Misbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttackEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttackEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommonValidators != nil {
+		{
+			size, err := m.CommonValidators.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTendermint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TrustedHeader != nil {
+		{
+			size, err := m.TrustedHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTendermint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTendermint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -683,6 +970,57 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HeaderBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTendermint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTendermint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Fraction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -767,6 +1105,28 @@ func (m *ClientState) Size() (n int) {
 	if m.AllowUpdateAfterMisbehaviour {
 		n += 2
 	}
+	if m.RetentionPolicy != nil {
+		l = m.RetentionPolicy.Size()
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	return n
+}
+
+func (m *RetentionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxConsensusStates != 0 {
+		n += 1 + sovTendermint(uint64(m.MaxConsensusStates))
+	}
+	if m.HeightInterval != 0 {
+		n += 1 + sovTendermint(uint64(m.HeightInterval))
+	}
+	if m.MinRetentionBlocks != 0 {
+		n += 1 + sovTendermint(uint64(m.MinRetentionBlocks))
+	}
 	return n
 }
 
@@ -808,6 +1168,27 @@ func (m *Misbehaviour) Size() (n int) {
 	return n
 }
 
+func (m *LightClientAttackEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	if m.TrustedHeader != nil {
+		l = m.TrustedHeader.Size()
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	if m.CommonValidators != nil {
+		l = m.CommonValidators.Size()
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	return n
+}
+
 func (m *Header) Size() (n int) {
 	if m == nil {
 		return 0
@@ -831,23 +1212,44 @@ func (m *Header) Size() (n int) {
 	return n
 }
 
-func (m *Fraction) Size() (n int) {
+func (m *HeaderBatch) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Numerator != 0 {
-		n += 1 + sovTendermint(uint64(m.Numerator))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovTendermint(uint64(l))
+		}
 	}
-	if m.Denominator != 0 {
-		n += 1 + sovTendermint(uint64(m.Denominator))
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovTendermint(uint64(l))
+		}
 	}
 	return n
 }
 
-func sovTendermint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Fraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Numerator != 0 {
+		n += 1 + sovTendermint(uint64(m.Numerator))
+	}
+	if m.Denominator != 0 {
+		n += 1 + sovTendermint(uint64(m.Denominator))
+	}
+	return n
+}
+
+func sovTendermint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTendermint(x uint64) (n int) {
 	return sovTendermint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -1217,6 +1619,149 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUpdateAfterMisbehaviour = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionPolicy == nil {
+				m.RetentionPolicy = &RetentionPolicy{}
+			}
+			if err := m.RetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTendermint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetentionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTendermint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetentionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsensusStates", wireType)
+			}
+			m.MaxConsensusStates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsensusStates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightInterval", wireType)
+			}
+			m.HeightInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeightInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRetentionBlocks", wireType)
+			}
+			m.MinRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTendermint(dAtA[iNdEx:])
@@ -1542,6 +2087,164 @@ func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTendermint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttackEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttackEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &types2.LightClientAttackEvidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrustedHeader == nil {
+				m.TrustedHeader = &Header{}
+			}
+			if err := m.TrustedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommonValidators == nil {
+				m.CommonValidators = &types2.ValidatorSet{}
+			}
+			if err := m.CommonValidators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTendermint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Header) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1733,6 +2436,124 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HeaderBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTendermint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, types.Height{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTendermint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Fraction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
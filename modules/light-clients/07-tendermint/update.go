@@ -21,7 +21,8 @@ import (
 	"github.com/cosmos/ibc-go/v11/modules/core/exported"
 )
 
-// VerifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message
+// VerifyClientMessage checks if the clientMessage is of type Header, HeaderBatch, Misbehaviour or LightClientAttackEvidence
+// and verifies the message. LightClientAttackEvidence is verified as the Misbehaviour it converts into.
 func (cs *ClientState) VerifyClientMessage(
 	ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore,
 	clientMsg exported.ClientMessage,
@@ -29,8 +30,17 @@ func (cs *ClientState) VerifyClientMessage(
 	switch msg := clientMsg.(type) {
 	case *Header:
 		return cs.verifyHeader(ctx, clientStore, cdc, msg)
+	case *HeaderBatch:
+		return cs.verifyHeaderBatch(ctx, clientStore, cdc, msg)
 	case *Misbehaviour:
 		return cs.verifyMisbehaviour(ctx, clientStore, cdc, msg)
+	case *LightClientAttackEvidence:
+		misbehaviour, err := msg.Misbehaviour()
+		if err != nil {
+			return err
+		}
+
+		return cs.verifyMisbehaviour(ctx, clientStore, cdc, misbehaviour)
 	default:
 		return clienttypes.ErrInvalidClientType
 	}
@@ -48,14 +58,45 @@ func (cs *ClientState) verifyHeader(
 	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
 	header *Header,
 ) error {
-	currentTimestamp := ctx.BlockTime()
-
 	// Retrieve trusted consensus states for each Header in misbehaviour
 	consState, found := GetConsensusState(clientStore, cdc, header.TrustedHeight)
 	if !found {
 		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "could not get trusted consensus state from clientStore for Header at TrustedHeight: %s", header.TrustedHeight)
 	}
 
+	return cs.verifyHeaderWithConsensusState(ctx, header, consState)
+}
+
+// verifyHeaderBatch returns an error if any header in the batch fails verification. The first header is
+// verified against the consensus state stored at its trusted height, and every following header is verified
+// against the consensus state of the preceding header using skipping verification. The checks performed for
+// each header are the same as in verifyHeader.
+func (cs *ClientState) verifyHeaderBatch(
+	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
+	headerBatch *HeaderBatch,
+) error {
+	for i, header := range headerBatch.Headers {
+		var err error
+		if i == 0 {
+			err = cs.verifyHeader(ctx, clientStore, cdc, header)
+		} else {
+			// the preceding header has been verified, thus its consensus state is trusted
+			err = cs.verifyHeaderWithConsensusState(ctx, header, headerBatch.Headers[i-1].ConsensusState())
+		}
+
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to verify header %d at height %s in header batch", i, header.GetHeight())
+		}
+	}
+
+	return nil
+}
+
+// verifyHeaderWithConsensusState verifies the header against the provided trusted consensus state,
+// see verifyHeader for the conditions under which an error is returned.
+func (cs *ClientState) verifyHeaderWithConsensusState(ctx sdk.Context, header *Header, consState *ConsensusState) error {
+	currentTimestamp := ctx.BlockTime()
+
 	if err := checkTrustedHeader(header, consState); err != nil {
 		return err
 	}
@@ -132,11 +173,18 @@ func (cs *ClientState) verifyHeader(
 // A list containing the updated consensus height is returned.
 // UpdateState must only be used to update within a single revision, thus header revision number and trusted height's revision
 // number must be the same. To update to a new revision, use a separate upgrade path
-// UpdateState will prune the oldest consensus state if it is expired.
-// If the provided clientMsg is not of type of Header then the handler will noop and empty slice is returned.
+// UpdateState will prune the oldest consensus state if it is expired, and enforce the retention policy of the client if set.
+// A HeaderBatch creates consensus states for its final header and for the headers at its checkpoint heights,
+// in ascending order of height. A list containing all updated consensus heights is returned.
+// If the provided clientMsg is not of type of Header or HeaderBatch then the handler will noop and empty slice is returned.
 func (cs *ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
-	header, ok := clientMsg.(*Header)
-	if !ok {
+	var headers []*Header
+	switch msg := clientMsg.(type) {
+	case *Header:
+		headers = []*Header{msg}
+	case *HeaderBatch:
+		headers = msg.checkpointHeaders()
+	default:
 		// clientMsg is invalid Misbehaviour, no update necessary
 		return []exported.Height{}
 	}
@@ -147,10 +195,26 @@ func (cs *ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clien
 		cs.pruneOldestConsensusState(ctx, cdc, clientStore)
 	}
 
+	heights := make([]exported.Height, 0, len(headers))
+	for _, header := range headers {
+		heights = append(heights, cs.updateConsensusState(ctx, cdc, clientStore, header))
+	}
+
+	// the retention policy is enforced after the update so that the new consensus states count towards it
+	if (!ctx.IsCheckTx() && !ctx.IsReCheckTx()) || ctx.ExecMode() == sdk.ExecModeSimulate {
+		cs.pruneConsensusStatesByRetentionPolicy(ctx, clientStore, MaxRetentionPolicyPrunesPerUpdate)
+	}
+
+	return heights
+}
+
+// updateConsensusState creates the consensus state for the provided header and updates the
+// latest height of the client state if necessary. The height of the header is returned.
+func (cs *ClientState) updateConsensusState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, header *Header) exported.Height {
 	// check for duplicate update
 	if _, found := GetConsensusState(clientStore, cdc, header.GetHeight()); found {
 		// perform no-op
-		return []exported.Height{header.GetHeight()}
+		return header.GetHeight()
 	}
 
 	height, ok := header.GetHeight().(clienttypes.Height)
@@ -172,7 +236,7 @@ func (cs *ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clien
 	setConsensusState(clientStore, cdc, consensusState, header.GetHeight())
 	setConsensusMetadata(ctx, clientStore, header.GetHeight())
 
-	return []exported.Height{height}
+	return height
 }
 
 // pruneOldestConsensusState will retrieve the earliest consensus state for this clientID and check if it is expired. If it is,
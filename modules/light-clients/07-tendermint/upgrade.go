@@ -113,6 +113,9 @@ func (cs ClientState) VerifyUpgradeAndUpdateState(
 		cs.MaxClockDrift, tmUpgradeClient.LatestHeight, tmUpgradeClient.ProofSpecs, tmUpgradeClient.UpgradePath,
 	)
 
+	// the retention policy is a custom field and is retained across upgrades
+	newClientState.RetentionPolicy = cs.RetentionPolicy
+
 	if err := newClientState.Validate(); err != nil {
 		return errorsmod.Wrap(err, "updated client state failed basic validation")
 	}
@@ -21,6 +21,7 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		getCmdCode(),
 		getCmdChecksums(),
+		getCmdChecksumClients(),
 	)
 
 	return queryCmd
@@ -39,6 +40,9 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		newSubmitStoreCodeProposalCmd(),
 		newMigrateContractCmd(),
+		newMigrateAllClientsCmd(),
+		newPinChecksumCmd(),
+		newUnpinChecksumCmd(),
 	)
 
 	return txCmd
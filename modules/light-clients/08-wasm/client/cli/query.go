@@ -93,3 +93,36 @@ func getCmdChecksums() *cobra.Command {
 
 	return cmd
 }
+
+// getCmdChecksumClients defines the command to query the clients using a wasm checksum.
+func getCmdChecksumClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "checksum-clients [checksum]",
+		Short:   "Query the clients using a checksum",
+		Long:    "Query the identifiers of the light clients using the wasm contract with a given checksum and whether the contract is pinned",
+		Example: fmt.Sprintf("%s query %s wasm checksum-clients [checksum]", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryChecksumClientsRequest{
+				Checksum: args[0],
+			}
+
+			res, err := queryClient.ChecksumClients(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
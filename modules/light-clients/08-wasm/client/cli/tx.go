@@ -128,3 +128,101 @@ func newMigrateContractCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func newMigrateAllClientsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "migrate-all-clients [checksum] [new-checksum] [migrate-msg]",
+		Short:   "Migrates the contracts of all clients using a checksum to a new byte code",
+		Long:    "Migrates the contracts of all clients using the byte code corresponding to checksum to the byte code corresponding to new checksum, passing the JSON-encoded migrate message to each contract",
+		Example: fmt.Sprintf("%s tx %s-wasm migrate-all-clients 36cfc8b5c3aeaaa1ba5d87f6ac5ec6e2a2a8cd0e1ab8c9db8fd7fc4e1fa4a3b3 b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab {}", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			checksum, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid checksum format: %w", err)
+			}
+
+			newChecksum, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid new checksum format: %w", err)
+			}
+
+			msg := types.NewMsgMigrateAllClients(clientCtx.GetFromAddress().String(), checksum, newChecksum, []byte(args[2]))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func newPinChecksumCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pin-checksum [checksum]",
+		Short:   "Pins the byte code of a checksum to the vm in-memory cache",
+		Long:    "Pins the byte code corresponding to checksum to the vm in-memory cache",
+		Example: fmt.Sprintf("%s tx %s-wasm pin-checksum b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			checksum, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid checksum format: %w", err)
+			}
+
+			msg := types.NewMsgPinChecksum(clientCtx.GetFromAddress().String(), checksum)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func newUnpinChecksumCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unpin-checksum [checksum]",
+		Short:   "Unpins the byte code of a checksum from the vm in-memory cache",
+		Long:    "Unpins the byte code corresponding to checksum from the vm in-memory cache",
+		Example: fmt.Sprintf("%s tx %s-wasm unpin-checksum b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			checksum, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid checksum format: %w", err)
+			}
+
+			msg := types.NewMsgUnpinChecksum(clientCtx.GetFromAddress().String(), checksum)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -48,7 +48,7 @@ func (k *Keeper) instantiateContract(ctx sdk.Context, clientID string, clientSto
 		Funds:  nil,
 	}
 
-	ctx.GasMeter().ConsumeGas(types.VMGasRegister.SetupContractCost(true, len(msg)), "Loading CosmWasm module: instantiate")
+	ctx.GasMeter().ConsumeGas(types.VMGasRegister.SetupContractCost(k.IsPinned(ctx, checksum), len(msg)), "Loading CosmWasm module: instantiate")
 	resp, gasUsed, err := k.GetVM().Instantiate(checksum, env, msgInfo, msg, internaltypes.NewStoreAdapter(clientStore), wasmvmAPI, k.newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, types.CostJSONDeserialization)
 	types.VMGasRegister.ConsumeRuntimeGas(ctx, gasUsed)
 	return resp, err
@@ -62,7 +62,7 @@ func (k *Keeper) callContract(ctx sdk.Context, clientID string, clientStore stor
 
 	env := getEnv(ctx, clientID)
 
-	ctx.GasMeter().ConsumeGas(VMGasRegister.SetupContractCost(true, len(msg)), "Loading CosmWasm module: sudo")
+	ctx.GasMeter().ConsumeGas(VMGasRegister.SetupContractCost(k.IsPinned(ctx, checksum), len(msg)), "Loading CosmWasm module: sudo")
 	resp, gasUsed, err := k.GetVM().Sudo(checksum, env, msg, internaltypes.NewStoreAdapter(clientStore), wasmvmAPI, k.newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, types.CostJSONDeserialization)
 	VMGasRegister.ConsumeRuntimeGas(ctx, gasUsed)
 	return resp, err
@@ -76,7 +76,7 @@ func (k *Keeper) queryContract(ctx sdk.Context, clientID string, clientStore sto
 
 	env := getEnv(ctx, clientID)
 
-	ctx.GasMeter().ConsumeGas(VMGasRegister.SetupContractCost(true, len(msg)), "Loading CosmWasm module: query")
+	ctx.GasMeter().ConsumeGas(VMGasRegister.SetupContractCost(k.IsPinned(ctx, checksum), len(msg)), "Loading CosmWasm module: query")
 	resp, gasUsed, err := k.GetVM().Query(checksum, env, msg, internaltypes.NewStoreAdapter(clientStore), wasmvmAPI, k.newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, types.CostJSONDeserialization)
 	VMGasRegister.ConsumeRuntimeGas(ctx, gasUsed)
 
@@ -91,7 +91,7 @@ func (k *Keeper) migrateContract(ctx sdk.Context, clientID string, clientStore s
 
 	env := getEnv(ctx, clientID)
 
-	ctx.GasMeter().ConsumeGas(VMGasRegister.SetupContractCost(true, len(msg)), "Loading CosmWasm module: migrate")
+	ctx.GasMeter().ConsumeGas(VMGasRegister.SetupContractCost(k.IsPinned(ctx, checksum), len(msg)), "Loading CosmWasm module: migrate")
 	resp, gasUsed, err := k.GetVM().Migrate(checksum, env, msg, internaltypes.NewStoreAdapter(clientStore), wasmvmAPI, k.newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, types.CostJSONDeserialization)
 	VMGasRegister.ConsumeRuntimeGas(ctx, gasUsed)
 
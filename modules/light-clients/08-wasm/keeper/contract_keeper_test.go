@@ -556,3 +556,49 @@ func (s *KeeperTestSuite) TestWasmSudo() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestWasmQueryUnpinnedChecksumGas() {
+	s.SetupWasmWithMockVM()
+	checksum := s.storeWasmCode(wasmtesting.Code)
+
+	endpoint := wasmtesting.NewWasmEndpoint(s.chainA)
+	err := endpoint.CreateClient()
+	s.Require().NoError(err)
+
+	wasmClientState, ok := endpoint.GetClientState().(*types.ClientState)
+	s.Require().True(ok)
+
+	s.mockVM.RegisterQueryCallback(types.StatusMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+		resp, err := json.Marshal(types.StatusResult{Status: exported.Active.String()})
+		s.Require().NoError(err)
+
+		return &wasmvmtypes.QueryResult{Ok: resp}, wasmtesting.DefaultGasUsed, nil
+	})
+
+	wasmClientKeeper := GetSimApp(s.chainA).WasmClientKeeper
+	payload := types.QueryMsg{Status: &types.StatusMsg{}}
+	queryGas := func() uint64 {
+		ctx := s.chainA.GetContext()
+		clientStore := s.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, endpoint.ClientID)
+		gasBefore := ctx.GasMeter().GasConsumed()
+
+		_, err := wasmClientKeeper.WasmQuery(ctx, endpoint.ClientID, clientStore, wasmClientState, payload)
+		s.Require().NoError(err)
+
+		return ctx.GasMeter().GasConsumed() - gasBefore
+	}
+
+	pinnedGas := queryGas()
+
+	signer := GetSimApp(s.chainA).WasmClientKeeper.GetAuthority()
+	_, err = wasmClientKeeper.UnpinChecksum(s.chainA.GetContext(), types.NewMsgUnpinChecksum(signer, checksum))
+	s.Require().NoError(err)
+
+	// the contract setup of an unpinned checksum is not discounted
+	bz, err := json.Marshal(payload)
+	s.Require().NoError(err)
+
+	msgLen := len(bz)
+	expGasDiff := types.VMGasRegister.SetupContractCost(false, msgLen) - types.VMGasRegister.SetupContractCost(true, msgLen)
+	s.Require().Equal(pinnedGas+expGasDiff, queryGas())
+}
@@ -39,3 +39,31 @@ func emitMigrateContractEvent(ctx sdk.Context, clientID string, checksum, newChe
 		),
 	})
 }
+
+// emitPinChecksumEvent emits a pin checksum event
+func emitPinChecksumEvent(ctx sdk.Context, checksum types.Checksum) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePinChecksum,
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitUnpinChecksumEvent emits an unpin checksum event
+func emitUnpinChecksumEvent(ctx sdk.Context, checksum types.Checksum) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnpinChecksum,
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
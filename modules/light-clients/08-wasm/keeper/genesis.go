@@ -19,16 +19,22 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
 	}
 
 	for _, contract := range gs.Contracts {
-		_, err := k.storeWasmCode(ctx, contract.CodeBytes, storeFn)
+		checksum, err := k.storeWasmCode(ctx, contract.CodeBytes, storeFn)
 		if err != nil {
 			return err
 		}
+
+		if contract.Unpinned {
+			if err := k.unpinChecksum(ctx, checksum); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
 // ExportGenesis returns the 08-wasm module's exported genesis. This includes the code
-// for all contracts previously stored.
+// for all contracts previously stored and whether they have been unpinned.
 func (k *Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 	checksums, err := k.GetAllChecksums(ctx)
 	if err != nil {
@@ -44,6 +50,7 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 		}
 		genesisState.Contracts = append(genesisState.Contracts, types.Contract{
 			CodeBytes: code,
+			Unpinned:  !k.IsPinned(ctx, checksum),
 		})
 	}
 
@@ -38,6 +38,23 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 				expChecksums = []string{checksum}
 			},
 		},
+		{
+			"success with unpinned contract",
+			func() {
+				checksum := "b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab" //nolint:gosec // these are not hard-coded credentials
+
+				genesisState = *types.NewGenesisState(
+					[]types.Contract{
+						{
+							CodeBytes: wasmtesting.Code,
+							Unpinned:  true,
+						},
+					},
+				)
+
+				expChecksums = []string{checksum}
+			},
+		},
 		{
 			"success with empty genesis contract",
 			func() {
@@ -67,6 +84,10 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 
 			s.Require().Len(storedHashes, len(expChecksums))
 			s.Require().ElementsMatch(expChecksums, storedHashes)
+
+			for i, contract := range genesisState.Contracts {
+				s.Require().Equal(!contract.Unpinned, GetSimApp(s.chainA).WasmClientKeeper.IsPinned(ctx, checksums[i]))
+			}
 		})
 	}
 }
@@ -88,4 +109,12 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	genesisState := GetSimApp(s.chainA).WasmClientKeeper.ExportGenesis(ctx)
 	s.Require().Len(genesisState.Contracts, 1)
 	s.Require().NotEmpty(genesisState.Contracts[0].CodeBytes)
+	s.Require().False(genesisState.Contracts[0].Unpinned)
+
+	_, err = GetSimApp(s.chainA).WasmClientKeeper.UnpinChecksum(ctx, types.NewMsgUnpinChecksum(signer, res.Checksum))
+	s.Require().NoError(err)
+
+	genesisState = GetSimApp(s.chainA).WasmClientKeeper.ExportGenesis(ctx)
+	s.Require().Len(genesisState.Contracts, 1)
+	s.Require().True(genesisState.Contracts[0].Unpinned)
 }
@@ -64,3 +64,26 @@ func (k *Keeper) Checksums(goCtx context.Context, req *types.QueryChecksumsReque
 		Pagination: pageRes,
 	}, nil
 }
+
+// ChecksumClients implements the Query/ChecksumClients gRPC method. It returns the identifiers of the
+// light clients using the checksum and whether the contract code is pinned to the vm in-memory cache.
+func (k *Keeper) ChecksumClients(goCtx context.Context, req *types.QueryChecksumClientsRequest) (*types.QueryChecksumClientsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid checksum")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasChecksum(ctx, checksum) {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrWasmChecksumNotFound, req.Checksum).Error())
+	}
+
+	return &types.QueryChecksumClientsResponse{
+		ClientIds: k.GetChecksumClients(ctx, checksum),
+		Pinned:    k.IsPinned(ctx, checksum),
+	}, nil
+}
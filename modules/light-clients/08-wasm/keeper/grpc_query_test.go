@@ -130,3 +130,100 @@ func (s *KeeperTestSuite) TestQueryChecksums() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryChecksumClients() {
+	var (
+		req          *types.QueryChecksumClientsRequest
+		expClientIDs []string
+		expPinned    bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success with no clients",
+			func() {
+				expClientIDs = []string{}
+				expPinned = true
+			},
+			nil,
+		},
+		{
+			"success with one client",
+			func() {
+				endpoint := wasmtesting.NewWasmEndpoint(s.chainA)
+				err := endpoint.CreateClient()
+				s.Require().NoError(err)
+
+				expClientIDs = []string{endpoint.ClientID}
+				expPinned = true
+			},
+			nil,
+		},
+		{
+			"success with unpinned checksum",
+			func() {
+				signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+				checksum, err := hex.DecodeString(req.Checksum)
+				s.Require().NoError(err)
+
+				_, err = GetSimApp(s.chainA).WasmClientKeeper.UnpinChecksum(s.chainA.GetContext(), types.NewMsgUnpinChecksum(signer, checksum))
+				s.Require().NoError(err)
+
+				expClientIDs = []string{}
+				expPinned = false
+			},
+			nil,
+		},
+		{
+			"fails with empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"fails with invalid checksum",
+			func() {
+				req = &types.QueryChecksumClientsRequest{Checksum: "test"}
+			},
+			status.Error(codes.InvalidArgument, "invalid checksum"),
+		},
+		{
+			"fails with non-existent checksum",
+			func() {
+				req = &types.QueryChecksumClientsRequest{Checksum: hex.EncodeToString([]byte{1})}
+			},
+			status.Error(
+				codes.NotFound,
+				errorsmod.Wrap(types.ErrWasmChecksumNotFound, hex.EncodeToString([]byte{1})).Error(),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupWasmWithMockVM()
+
+			checksum := s.storeWasmCode(wasmtesting.Code)
+			req = &types.QueryChecksumClientsRequest{Checksum: hex.EncodeToString(checksum)}
+
+			tc.malleate()
+
+			res, err := GetSimApp(s.chainA).WasmClientKeeper.ChecksumClients(s.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().ElementsMatch(expClientIDs, res.ClientIds)
+				s.Require().Equal(expPinned, res.Pinned)
+			} else {
+				s.Require().Error(err)
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -31,8 +31,9 @@ type Keeper struct {
 
 	vm types.WasmEngine
 
-	checksums    collections.KeySet[[]byte]
-	storeService store.KVStoreService
+	checksums         collections.KeySet[[]byte]
+	unpinnedChecksums collections.KeySet[[]byte]
+	storeService      store.KVStoreService
 
 	queryPlugins QueryPlugins
 
@@ -185,6 +186,51 @@ func (k *Keeper) migrateContractCode(ctx sdk.Context, clientID string, newChecks
 	return nil
 }
 
+// migrateAllClients migrates the contracts of all light clients using the given checksum to the one denoted by the given
+// new checksum, passing the same migrate message to each contract. The identifiers of the migrated clients are returned.
+// The migration of all clients fails if the migration of any client fails.
+func (k *Keeper) migrateAllClients(ctx sdk.Context, checksum, newChecksum, migrateMsg []byte) ([]string, error) {
+	if !k.HasChecksum(ctx, checksum) {
+		return nil, types.ErrWasmChecksumNotFound
+	}
+
+	clientIDs := k.GetChecksumClients(ctx, checksum)
+	for _, clientID := range clientIDs {
+		if err := k.migrateContractCode(ctx, clientID, newChecksum, migrateMsg); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to migrate contract of client %s", clientID)
+		}
+	}
+
+	return clientIDs, nil
+}
+
+// pinChecksum pins the code for the given checksum to the vm in-memory cache.
+func (k *Keeper) pinChecksum(ctx sdk.Context, checksum types.Checksum) error {
+	if !k.HasChecksum(ctx, checksum) {
+		return types.ErrWasmChecksumNotFound
+	}
+
+	if err := k.GetVM().Pin(checksum); err != nil {
+		return errorsmod.Wrapf(err, "failed to pin contract with checksum (%s) to vm cache", hex.EncodeToString(checksum))
+	}
+
+	return k.unpinnedChecksums.Remove(ctx, checksum)
+}
+
+// unpinChecksum unpins the code for the given checksum from the vm in-memory cache. The checksum remains unpinned
+// when the pinned codes are initialized on node restart.
+func (k *Keeper) unpinChecksum(ctx sdk.Context, checksum types.Checksum) error {
+	if !k.HasChecksum(ctx, checksum) {
+		return types.ErrWasmChecksumNotFound
+	}
+
+	if err := k.GetVM().Unpin(checksum); err != nil {
+		return errorsmod.Wrapf(err, "failed to unpin contract with checksum (%s) from vm cache", hex.EncodeToString(checksum))
+	}
+
+	return k.unpinnedChecksums.Set(ctx, checksum)
+}
+
 // GetWasmClientState returns the 08-wasm client state for the given client identifier.
 func (k *Keeper) GetWasmClientState(ctx sdk.Context, clientID string) (*types.ClientState, error) {
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
@@ -232,7 +278,39 @@ func (k *Keeper) HasChecksum(ctx sdk.Context, checksum types.Checksum) bool {
 	return found
 }
 
-// InitializePinnedCodes updates wasmvm to pin to cache all contracts marked as pinned
+// IsPinned returns true if the code for the given checksum is stored and has not been unpinned
+// from the vm in-memory cache, and false otherwise.
+func (k *Keeper) IsPinned(ctx sdk.Context, checksum types.Checksum) bool {
+	if !k.HasChecksum(ctx, checksum) {
+		return false
+	}
+
+	unpinned, err := k.unpinnedChecksums.Has(ctx, checksum)
+	if err != nil {
+		return false
+	}
+
+	return !unpinned
+}
+
+// GetChecksumClients returns the identifiers of the light clients whose client state uses the given checksum.
+// It returns an empty slice if no clients use the checksum.
+func (k *Keeper) GetChecksumClients(ctx sdk.Context, checksum types.Checksum) []string {
+	clientIDs := []string{}
+	k.clientKeeper.IterateClientStates(ctx, []byte(types.Wasm), func(clientID string, clientState exported.ClientState) bool {
+		wasmClientState, ok := clientState.(*types.ClientState)
+		if ok && bytes.Equal(wasmClientState.Checksum, checksum) {
+			clientIDs = append(clientIDs, clientID)
+		}
+
+		return false
+	})
+
+	return clientIDs
+}
+
+// InitializePinnedCodes updates wasmvm to pin to cache all contracts marked as pinned.
+// Contracts are pinned when they are stored, unless they have been unpinned since.
 func (k *Keeper) InitializePinnedCodes(ctx sdk.Context) error {
 	checksums, err := k.GetAllChecksums(ctx)
 	if err != nil {
@@ -240,6 +318,10 @@ func (k *Keeper) InitializePinnedCodes(ctx sdk.Context) error {
 	}
 
 	for _, checksum := range checksums {
+		if !k.IsPinned(ctx, checksum) {
+			continue
+		}
+
 		if err := k.GetVM().Pin(checksum); err != nil {
 			return err
 		}
@@ -54,12 +54,13 @@ func NewKeeperWithVM(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	keeper := &Keeper{
-		cdc:          cdc,
-		vm:           vm,
-		checksums:    collections.NewKeySet(sb, types.ChecksumsKey, "checksums", collections.BytesKey),
-		storeService: storeService,
-		clientKeeper: clientKeeper,
-		authority:    authority,
+		cdc:               cdc,
+		vm:                vm,
+		checksums:         collections.NewKeySet(sb, types.ChecksumsKey, "checksums", collections.BytesKey),
+		unpinnedChecksums: collections.NewKeySet(sb, types.UnpinnedChecksumsKey, "unpinned_checksums", collections.BytesKey),
+		storeService:      storeService,
+		clientKeeper:      clientKeeper,
+		authority:         authority,
 	}
 
 	_, err := sb.Build()
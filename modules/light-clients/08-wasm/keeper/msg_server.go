@@ -48,11 +48,20 @@ func (k *Keeper) RemoveChecksum(goCtx context.Context, msg *types.MsgRemoveCheck
 		return nil, types.ErrWasmChecksumNotFound
 	}
 
+	// the contract code must not be removed while light clients still depend on it
+	if clientIDs := k.GetChecksumClients(ctx, msg.Checksum); len(clientIDs) > 0 {
+		return nil, errorsmod.Wrapf(types.ErrWasmChecksumInUse, "checksum (%s) is used by clients %v", hex.EncodeToString(msg.Checksum), clientIDs)
+	}
+
 	err := k.GetChecksums().Remove(goCtx, msg.Checksum)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to remove checksum")
 	}
 
+	if err := k.unpinnedChecksums.Remove(goCtx, msg.Checksum); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to remove unpinned checksum")
+	}
+
 	// unpin the code from the vm in-memory cache
 	if err := k.GetVM().Unpin(msg.Checksum); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to unpin contract with checksum (%s) from vm cache", hex.EncodeToString(msg.Checksum))
@@ -78,3 +87,57 @@ func (k *Keeper) MigrateContract(goCtx context.Context, msg *types.MsgMigrateCon
 
 	return &types.MsgMigrateContractResponse{}, nil
 }
+
+// MigrateAllClients defines a rpc handler method for MsgMigrateAllClients
+func (k *Keeper) MigrateAllClients(goCtx context.Context, msg *types.MsgMigrateAllClients) (*types.MsgMigrateAllClientsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, k.GetAuthority(), msg.Signer); err != nil {
+		return nil, err
+	}
+
+	clientIDs, err := k.migrateAllClients(ctx, msg.Checksum, msg.NewChecksum, msg.Msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to migrate clients")
+	}
+
+	// event emission is handled in migrateContractCode
+
+	return &types.MsgMigrateAllClientsResponse{
+		ClientIds: clientIDs,
+	}, nil
+}
+
+// PinChecksum defines a rpc handler method for MsgPinChecksum
+func (k *Keeper) PinChecksum(goCtx context.Context, msg *types.MsgPinChecksum) (*types.MsgPinChecksumResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, k.GetAuthority(), msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.pinChecksum(ctx, msg.Checksum); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to pin checksum")
+	}
+
+	emitPinChecksumEvent(ctx, msg.Checksum)
+
+	return &types.MsgPinChecksumResponse{}, nil
+}
+
+// UnpinChecksum defines a rpc handler method for MsgUnpinChecksum
+func (k *Keeper) UnpinChecksum(goCtx context.Context, msg *types.MsgUnpinChecksum) (*types.MsgUnpinChecksumResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, k.GetAuthority(), msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.unpinChecksum(ctx, msg.Checksum); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unpin checksum")
+	}
+
+	emitUnpinChecksumEvent(ctx, msg.Checksum)
+
+	return &types.MsgUnpinChecksumResponse{}, nil
+}
@@ -395,6 +395,17 @@ func (s *KeeperTestSuite) TestMsgRemoveChecksum() {
 			},
 			wasmtesting.ErrMockVM,
 		},
+		{
+			"failure: checksum is in use by a client",
+			func() {
+				msg = types.NewMsgRemoveChecksum(govAcc, checksum)
+
+				endpoint := wasmtesting.NewWasmEndpoint(s.chainA)
+				err := endpoint.CreateClient()
+				s.Require().NoError(err)
+			},
+			types.ErrWasmChecksumInUse,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -403,10 +414,6 @@ func (s *KeeperTestSuite) TestMsgRemoveChecksum() {
 
 			_ = s.storeWasmCode(wasmtesting.Code)
 
-			endpoint := wasmtesting.NewWasmEndpoint(s.chainA)
-			err := endpoint.CreateClient()
-			s.Require().NoError(err)
-
 			tc.malleate()
 
 			ctx := s.chainA.GetContext()
@@ -433,6 +440,222 @@ func (s *KeeperTestSuite) TestMsgRemoveChecksum() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgMigrateAllClients() {
+	oldChecksum, err := types.CreateChecksum(wasmtesting.Code)
+	s.Require().NoError(err)
+
+	newByteCode := wasmtesting.CreateMockContract([]byte("MockByteCode-TestMsgMigrateAllClients"))
+
+	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	var (
+		newChecksum  []byte
+		msg          *types.MsgMigrateAllClients
+		expClientIDs []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				msg = types.NewMsgMigrateAllClients(govAcc, oldChecksum, newChecksum, []byte("{}"))
+			},
+			nil,
+		},
+		{
+			"success: no clients use the checksum",
+			func() {
+				msg = types.NewMsgMigrateAllClients(govAcc, newChecksum, oldChecksum, []byte("{}"))
+				expClientIDs = []string{}
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg = types.NewMsgMigrateAllClients(s.chainA.SenderAccount.GetAddress().String(), oldChecksum, newChecksum, []byte("{}"))
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: checksum is missing",
+			func() {
+				msg = types.NewMsgMigrateAllClients(govAcc, []byte{1}, newChecksum, []byte("{}"))
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: new checksum is missing",
+			func() {
+				msg = types.NewMsgMigrateAllClients(govAcc, oldChecksum, []byte{1}, []byte("{}"))
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: contract returns error",
+			func() {
+				msg = types.NewMsgMigrateAllClients(govAcc, oldChecksum, newChecksum, []byte("{}"))
+
+				s.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: wasmtesting.ErrMockContract.Error()}, wasmtesting.DefaultGasUsed, nil
+				}
+			},
+			types.ErrWasmContractCallFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupWasmWithMockVM()
+
+			_ = s.storeWasmCode(wasmtesting.Code)
+			newChecksum = s.storeWasmCode(newByteCode)
+
+			expClientIDs = nil
+			for range 2 {
+				endpoint := wasmtesting.NewWasmEndpoint(s.chainA)
+				err := endpoint.CreateClient()
+				s.Require().NoError(err)
+
+				expClientIDs = append(expClientIDs, endpoint.ClientID)
+			}
+
+			s.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+				data, err := json.Marshal(types.EmptyResult{})
+				s.Require().NoError(err)
+
+				return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: data}}, wasmtesting.DefaultGasUsed, nil
+			}
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			res, err := GetSimApp(s.chainA).WasmClientKeeper.MigrateAllClients(ctx, msg)
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+				s.Require().NotNil(res)
+				s.Require().ElementsMatch(expClientIDs, res.ClientIds)
+
+				for _, clientID := range res.ClientIds {
+					clientState, err := GetSimApp(s.chainA).WasmClientKeeper.GetWasmClientState(ctx, clientID)
+					s.Require().NoError(err)
+					s.Require().Equal(msg.NewChecksum, clientState.Checksum)
+				}
+
+				s.Require().Empty(GetSimApp(s.chainA).WasmClientKeeper.GetChecksumClients(ctx, msg.Checksum))
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+				s.Require().Nil(res)
+
+				// no client has been migrated
+				s.Require().Len(GetSimApp(s.chainA).WasmClientKeeper.GetChecksumClients(s.chainA.GetContext(), oldChecksum), 2)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgPinUnpinChecksum() {
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	s.Require().NoError(err)
+
+	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		signer   string
+		checksum []byte
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			govAcc,
+			checksum,
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {},
+			sdk.AccAddress("unauthorized________").String(),
+			checksum,
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: checksum is missing",
+			func() {},
+			govAcc,
+			[]byte{1},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: vm returns error",
+			func() {
+				s.mockVM.PinFn = func(_ wasmvm.Checksum) error { return wasmtesting.ErrMockVM }
+				s.mockVM.UnpinFn = func(_ wasmvm.Checksum) error { return wasmtesting.ErrMockVM }
+			},
+			govAcc,
+			checksum,
+			wasmtesting.ErrMockVM,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupWasmWithMockVM()
+
+			_ = s.storeWasmCode(wasmtesting.Code)
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			wasmClientKeeper := GetSimApp(s.chainA).WasmClientKeeper
+
+			unpinRes, err := wasmClientKeeper.UnpinChecksum(ctx, types.NewMsgUnpinChecksum(tc.signer, tc.checksum))
+			if tc.expError != nil {
+				s.Require().ErrorIs(err, tc.expError)
+				s.Require().Nil(unpinRes)
+
+				pinRes, err := wasmClientKeeper.PinChecksum(ctx, types.NewMsgPinChecksum(tc.signer, tc.checksum))
+				s.Require().ErrorIs(err, tc.expError)
+				s.Require().Nil(pinRes)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(unpinRes)
+			s.Require().False(wasmClientKeeper.IsPinned(ctx, tc.checksum))
+			s.Require().Contains(ctx.EventManager().Events().ToABCIEvents(), sdk.Events{sdk.NewEvent(
+				types.EventTypeUnpinChecksum,
+				sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(tc.checksum)),
+			)}.ToABCIEvents()[0])
+
+			// an unpinned checksum is not pinned when the pinned codes are initialized
+			var pinnedChecksums []wasmvm.Checksum
+			s.mockVM.PinFn = func(checksum wasmvm.Checksum) error {
+				pinnedChecksums = append(pinnedChecksums, checksum)
+				return nil
+			}
+			s.Require().NoError(wasmClientKeeper.InitializePinnedCodes(ctx))
+			s.Require().Empty(pinnedChecksums)
+
+			pinRes, err := wasmClientKeeper.PinChecksum(ctx, types.NewMsgPinChecksum(tc.signer, tc.checksum))
+			s.Require().NoError(err)
+			s.Require().NotNil(pinRes)
+			s.Require().True(wasmClientKeeper.IsPinned(ctx, tc.checksum))
+			s.Require().Contains(ctx.EventManager().Events().ToABCIEvents(), sdk.Events{sdk.NewEvent(
+				types.EventTypePinChecksum,
+				sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(tc.checksum)),
+			)}.ToABCIEvents()[0])
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestStoreCodeAuthority() {
 	keeperAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 	overrideAuthority := sdk.AccAddress("override_authority___").String()
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package precompiles
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/blsverifier"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/types"
+)
+
+var (
+	bls12381Aggregate       = newPrecompile(bls12381AggregateGas, runBls12381Aggregate)
+	bls12381AggregateVerify = newPrecompile(bls12381AggregateVerifyGas, runBls12381AggregateVerify)
+)
+
+// Bls12381AggregateRequest is the input of the BLS12-381 public key aggregation precompile.
+// The result is the compressed aggregated public key.
+type Bls12381AggregateRequest = blsverifier.QueryAggregate
+
+// Bls12381AggregateVerifyRequest is the input of the BLS12-381 aggregate signature verification precompile.
+// The message must be a 32 byte hash. The result is true if the signature is valid.
+type Bls12381AggregateVerifyRequest = blsverifier.QueryAggregateVerify
+
+func bls12381AggregateGas(req Bls12381AggregateRequest) uint64 {
+	return safeMul(uint64(len(req.PublicKeys)), Bls12381AggregateGasPerKey)
+}
+
+func runBls12381Aggregate(req Bls12381AggregateRequest) (any, error) {
+	if err := requireCount("public keys", len(req.PublicKeys), MaxBatchSize); err != nil {
+		return nil, err
+	}
+
+	aggregatedPublicKey, err := blsverifier.AggregatePublicKeys(req.PublicKeys)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "failed to aggregate public keys: %v", err)
+	}
+
+	return aggregatedPublicKey.Marshal(), nil
+}
+
+func bls12381AggregateVerifyGas(req Bls12381AggregateVerifyRequest) uint64 {
+	return safeAdd(Bls12381VerifyGas, safeMul(uint64(len(req.PublicKeys)), Bls12381AggregateGasPerKey))
+}
+
+func runBls12381AggregateVerify(req Bls12381AggregateVerifyRequest) (any, error) {
+	if err := requireCount("public keys", len(req.PublicKeys), MaxBatchSize); err != nil {
+		return nil, err
+	}
+
+	if len(req.Message) != blsverifier.MessageSize {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "invalid message length (%d), must be a %d bytes hash", len(req.Message), blsverifier.MessageSize)
+	}
+
+	valid, err := blsverifier.VerifySignature(req.Signature, [blsverifier.MessageSize]byte(req.Message), req.PublicKeys)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "failed to verify signature: %v", err)
+	}
+
+	return valid, nil
+}
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package precompiles
+
+import (
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// FlagEnabledPrecompiles is the app.toml key of the names of the precompiles enabled on the node.
+// All registered precompiles are enabled if the key is not set. It must be set to the same value
+// on all nodes of a network, since it determines which custom queries contracts can execute.
+const FlagEnabledPrecompiles = "ibc-wasm.enabled-precompiles"
+
+// EnabledPrecompilesFromAppOptions returns the names of the enabled precompiles read from the app options.
+func EnabledPrecompilesFromAppOptions(appOpts servertypes.AppOptions) []string {
+	return cast.ToStringSlice(appOpts.Get(FlagEnabledPrecompiles))
+}
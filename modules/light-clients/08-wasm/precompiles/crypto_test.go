@@ -0,0 +1,480 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package precompiles_test
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/OffchainLabs/prysm/v6/crypto/bls"
+	"github.com/OffchainLabs/prysm/v6/crypto/bls/common"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/precompiles"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/types"
+)
+
+// runPrecompile runs the precompile with the given name on the request through the custom querier.
+func runPrecompile(t *testing.T, name string, req any) ([]byte, error) {
+	t.Helper()
+
+	querier, err := precompiles.DefaultRegistry().CustomQuerier(name)
+	require.NoError(t, err)
+
+	request, err := json.Marshal(map[string]any{name: req})
+	require.NoError(t, err)
+
+	return querier(sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter()), request)
+}
+
+func TestHash(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		precompile string
+		messages   [][]byte
+		expDigests []string
+		expErr     error
+	}{
+		{
+			"success: keccak256",
+			precompiles.Keccak256Name,
+			[][]byte{{}, []byte("abc")},
+			[]string{
+				"c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+				"4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45",
+			},
+			nil,
+		},
+		{
+			"success: sha256",
+			precompiles.Sha256Name,
+			[][]byte{{}, []byte("abc")},
+			[]string{
+				"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+				"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+			},
+			nil,
+		},
+		{
+			"failure: no messages",
+			precompiles.Sha256Name,
+			nil,
+			nil,
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: too many messages",
+			precompiles.Keccak256Name,
+			make([][]byte, precompiles.MaxBatchSize+1),
+			nil,
+			types.ErrInvalidPrecompileInput,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := runPrecompile(t, tc.precompile, precompiles.HashRequest{Messages: tc.messages})
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+
+				var digests [][]byte
+				require.NoError(t, json.Unmarshal(res, &digests))
+				require.Len(t, digests, len(tc.expDigests))
+				for i, digest := range digests {
+					require.Equal(t, tc.expDigests[i], hex.EncodeToString(digest))
+				}
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestSecp256k1Recover(t *testing.T) {
+	privKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	hash := sha256.Sum256([]byte("message"))
+
+	// convert the compact signature (recovery code || r || s) to r || s || v
+	compactSig := ecdsa.SignCompact(privKey, hash[:], false)
+	signature := append(compactSig[1:], compactSig[0]-27)
+
+	var req precompiles.Secp256k1RecoverRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid hash length",
+			func() {
+				req.Hash = req.Hash[:31]
+			},
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: invalid signature length",
+			func() {
+				req.Signature = req.Signature[:64]
+			},
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: invalid recovery id",
+			func() {
+				req.Signature[64] = 27
+			},
+			types.ErrInvalidPrecompileInput,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req = precompiles.Secp256k1RecoverRequest{
+				Hash:      hash[:],
+				Signature: append([]byte(nil), signature...),
+			}
+
+			tc.malleate()
+
+			res, err := runPrecompile(t, precompiles.Secp256k1RecoverName, req)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+
+				var publicKey []byte
+				require.NoError(t, json.Unmarshal(res, &publicKey))
+				require.Equal(t, privKey.PubKey().SerializeUncompressed(), publicKey)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestEd25519BatchVerify(t *testing.T) {
+	var req precompiles.Ed25519BatchVerifyRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expValid bool
+		expErr   error
+	}{
+		{
+			"success: valid batch",
+			func() {},
+			true,
+			nil,
+		},
+		{
+			"success: invalid signature",
+			func() {
+				req.Messages[1] = []byte("another message")
+			},
+			false,
+			nil,
+		},
+		{
+			"failure: no signatures",
+			func() {
+				req = precompiles.Ed25519BatchVerifyRequest{}
+			},
+			false,
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: mismatched lengths",
+			func() {
+				req.PublicKeys = req.PublicKeys[1:]
+			},
+			false,
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: invalid public key length",
+			func() {
+				req.PublicKeys[0] = req.PublicKeys[0][1:]
+			},
+			false,
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: invalid signature length",
+			func() {
+				req.Signatures[0] = req.Signatures[0][1:]
+			},
+			false,
+			types.ErrInvalidPrecompileInput,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req = precompiles.Ed25519BatchVerifyRequest{}
+			for i := range 3 {
+				publicKey, privKey, err := ed25519.GenerateKey(nil)
+				require.NoError(t, err)
+
+				message := []byte{byte(i)}
+				req.Messages = append(req.Messages, message)
+				req.Signatures = append(req.Signatures, ed25519.Sign(privKey, message))
+				req.PublicKeys = append(req.PublicKeys, publicKey)
+			}
+
+			tc.malleate()
+
+			res, err := runPrecompile(t, precompiles.Ed25519BatchVerifyName, req)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+
+				var valid bool
+				require.NoError(t, json.Unmarshal(res, &valid))
+				require.Equal(t, tc.expValid, valid)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestBls12381AggregateVerify(t *testing.T) {
+	message := sha256.Sum256([]byte("message"))
+
+	var (
+		publicKeys [][]byte
+		signatures []common.Signature
+	)
+	for range 3 {
+		secretKey, err := bls.RandKey()
+		require.NoError(t, err)
+
+		publicKeys = append(publicKeys, secretKey.PublicKey().Marshal())
+		signatures = append(signatures, secretKey.Sign(message[:]))
+	}
+
+	aggregatedSignature := bls.AggregateSignatures(signatures).Marshal()
+
+	var req precompiles.Bls12381AggregateVerifyRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expValid bool
+		expErr   error
+	}{
+		{
+			"success: valid signature",
+			func() {},
+			true,
+			nil,
+		},
+		{
+			"success: signature of a subset of the public keys",
+			func() {
+				req.PublicKeys = req.PublicKeys[1:]
+			},
+			false,
+			nil,
+		},
+		{
+			"failure: invalid message length",
+			func() {
+				req.Message = req.Message[1:]
+			},
+			false,
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: no public keys",
+			func() {
+				req.PublicKeys = nil
+			},
+			false,
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: invalid signature",
+			func() {
+				req.Signature = []byte("invalid signature")
+			},
+			false,
+			types.ErrInvalidPrecompileInput,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req = precompiles.Bls12381AggregateVerifyRequest{
+				PublicKeys: publicKeys,
+				Signature:  aggregatedSignature,
+				Message:    message[:],
+			}
+
+			tc.malleate()
+
+			res, err := runPrecompile(t, precompiles.Bls12381AggregateVerifyName, req)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+
+				var valid bool
+				require.NoError(t, json.Unmarshal(res, &valid))
+				require.Equal(t, tc.expValid, valid)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+
+	res, err := runPrecompile(t, precompiles.Bls12381AggregateName, precompiles.Bls12381AggregateRequest{PublicKeys: publicKeys})
+	require.NoError(t, err)
+
+	var aggregatedPublicKey []byte
+	require.NoError(t, json.Unmarshal(res, &aggregatedPublicKey))
+	require.Len(t, aggregatedPublicKey, 48)
+}
+
+func TestGroth16Verify(t *testing.T) {
+	_, _, g1, g2 := bn254.Generators()
+
+	g1Mul := func(s *big.Int) []byte {
+		var p bn254.G1Affine
+		p.ScalarMultiplication(&g1, s)
+		bz := p.RawBytes()
+		return bz[:]
+	}
+	g2Mul := func(s *big.Int) []byte {
+		var p bn254.G2Affine
+		p.ScalarMultiplication(&g2, s)
+		bz := p.Bytes()
+		return bz[:]
+	}
+
+	// build a verifying key and a proof satisfying the verification equation for the public input x:
+	// a * b = alpha * beta + (ic0 + x * ic1) * gamma + c * delta
+	modulus := fr.Modulus()
+	alpha, beta, gamma, delta, ic0, ic1, c, x := big.NewInt(3), big.NewInt(5), big.NewInt(7), big.NewInt(11), big.NewInt(13), big.NewInt(17), big.NewInt(19), big.NewInt(23)
+
+	vkX := new(big.Int).Add(ic0, new(big.Int).Mul(x, ic1))
+	b := new(big.Int).Mul(alpha, beta)
+	b.Add(b, new(big.Int).Mul(vkX, gamma))
+	b.Add(b, new(big.Int).Mul(c, delta))
+	b.Mod(b, modulus)
+
+	var req precompiles.Groth16VerifyRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expValid bool
+		expErr   error
+	}{
+		{
+			"success: valid proof",
+			func() {},
+			true,
+			nil,
+		},
+		{
+			"success: invalid public input",
+			func() {
+				req.PublicInputs[0] = big.NewInt(24).Bytes()
+			},
+			false,
+			nil,
+		},
+		{
+			"success: invalid proof",
+			func() {
+				req.Proof.C = g1Mul(big.NewInt(20))
+			},
+			false,
+			nil,
+		},
+		{
+			"failure: public input is not a field element",
+			func() {
+				req.PublicInputs[0] = modulus.Bytes()
+			},
+			false,
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: missing ic point",
+			func() {
+				req.VerifyingKey.IC = req.VerifyingKey.IC[:1]
+			},
+			false,
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: invalid point",
+			func() {
+				req.Proof.A = []byte("invalid point")
+			},
+			false,
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: point with trailing bytes",
+			func() {
+				req.Proof.B = append(req.Proof.B, 0)
+			},
+			false,
+			types.ErrInvalidPrecompileInput,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req = precompiles.Groth16VerifyRequest{
+				VerifyingKey: precompiles.Groth16VerifyingKey{
+					Alpha: g1Mul(alpha),
+					Beta:  g2Mul(beta),
+					Gamma: g2Mul(gamma),
+					Delta: g2Mul(delta),
+					IC:    [][]byte{g1Mul(ic0), g1Mul(ic1)},
+				},
+				Proof: precompiles.Groth16Proof{
+					A: g1Mul(big.NewInt(1)),
+					B: g2Mul(b),
+					C: g1Mul(c),
+				},
+				PublicInputs: [][]byte{x.Bytes()},
+			}
+
+			tc.malleate()
+
+			res, err := runPrecompile(t, precompiles.Groth16VerifyName, req)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+
+				var valid bool
+				require.NoError(t, json.Unmarshal(res, &valid))
+				require.Equal(t, tc.expValid, valid)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
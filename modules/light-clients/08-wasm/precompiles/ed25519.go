@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package precompiles
+
+import (
+	"crypto/ed25519"
+
+	"github.com/hdevalence/ed25519consensus"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/types"
+)
+
+var ed25519BatchVerify = newPrecompile(ed25519BatchVerifyGas, runEd25519BatchVerify)
+
+// Ed25519BatchVerifyRequest is the input of the ed25519 batch signature verification precompile. The i-th signature
+// is verified against the i-th message and public key. The result is true if all signatures are valid.
+// Signatures are verified following the ZIP-215 rules, so that batch and single verification agree.
+type Ed25519BatchVerifyRequest struct {
+	Messages   [][]byte `json:"messages"`
+	Signatures [][]byte `json:"signatures"`
+	PublicKeys [][]byte `json:"public_keys"`
+}
+
+func ed25519BatchVerifyGas(req Ed25519BatchVerifyRequest) uint64 {
+	return safeAdd(Ed25519VerifyGasBase, safeMul(uint64(len(req.Signatures)), Ed25519VerifyGasPerSignature))
+}
+
+func runEd25519BatchVerify(req Ed25519BatchVerifyRequest) (any, error) {
+	if err := requireCount("signatures", len(req.Signatures), MaxBatchSize); err != nil {
+		return nil, err
+	}
+
+	if len(req.Messages) != len(req.Signatures) || len(req.PublicKeys) != len(req.Signatures) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "number of messages (%d), signatures (%d) and public keys (%d) must be equal", len(req.Messages), len(req.Signatures), len(req.PublicKeys))
+	}
+
+	verifier := ed25519consensus.NewPreallocatedBatchVerifier(len(req.Signatures))
+	for i, signature := range req.Signatures {
+		if len(req.PublicKeys[i]) != ed25519.PublicKeySize {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "invalid public key length (%d) at index %d, must be %d bytes", len(req.PublicKeys[i]), i, ed25519.PublicKeySize)
+		}
+
+		if len(signature) != ed25519.SignatureSize {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "invalid signature length (%d) at index %d, must be %d bytes", len(signature), i, ed25519.SignatureSize)
+		}
+
+		verifier.Add(req.PublicKeys[i], req.Messages[i], signature)
+	}
+
+	return verifier.Verify(), nil
+}
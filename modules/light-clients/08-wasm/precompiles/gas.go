@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package precompiles
+
+import "math"
+
+// The gas costs of the precompiles are denominated in sdk gas and are loosely based on the costs
+// of the equivalent Ethereum precompiles (EIP-196, EIP-197, EIP-2537 and the ecrecover, sha256
+// and keccak256 opcode and precompile costs).
+const (
+	// GasPerInputByte is charged for every byte of the JSON-encoded input of a precompile.
+	GasPerInputByte uint64 = 1
+
+	// Bls12381AggregateGasPerKey is charged for every public key aggregated.
+	Bls12381AggregateGasPerKey uint64 = 1_500
+	// Bls12381VerifyGas is charged for every aggregate signature verification.
+	Bls12381VerifyGas uint64 = 45_000
+
+	// Secp256k1RecoverGas is charged for every public key recovery.
+	Secp256k1RecoverGas uint64 = 3_000
+
+	// Ed25519VerifyGasBase is charged for every batch verification.
+	Ed25519VerifyGasBase uint64 = 1_000
+	// Ed25519VerifyGasPerSignature is charged for every signature of a batch.
+	Ed25519VerifyGasPerSignature uint64 = 2_000
+
+	// Keccak256GasPerMessage is charged for every message hashed with keccak256.
+	Keccak256GasPerMessage uint64 = 30
+	// Keccak256GasPerWord is charged for every 32 byte word hashed with keccak256.
+	Keccak256GasPerWord uint64 = 6
+	// Sha256GasPerMessage is charged for every message hashed with sha256.
+	Sha256GasPerMessage uint64 = 60
+	// Sha256GasPerWord is charged for every 32 byte word hashed with sha256.
+	Sha256GasPerWord uint64 = 12
+
+	// Groth16VerifyGasBase is charged for every proof verification, covering the four pairings.
+	Groth16VerifyGasBase uint64 = 181_000
+	// Groth16VerifyGasPerPublicInput is charged for every public input of a proof.
+	Groth16VerifyGasPerPublicInput uint64 = 6_150
+
+	// MaxBatchSize is the maximum number of items (keys, signatures, messages or public inputs) of a single call.
+	MaxBatchSize = 1024
+)
+
+// words returns the number of 32 byte words required to hold the given number of bytes.
+func words(size int) uint64 {
+	return (uint64(size) + 31) / 32
+}
+
+// safeAdd returns the sum of a and b, saturating at the maximum uint64 value.
+func safeAdd(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+
+	return a + b
+}
+
+// safeMul returns the product of a and b, saturating at the maximum uint64 value.
+func safeMul(a, b uint64) uint64 {
+	if a != 0 && b > math.MaxUint64/a {
+		return math.MaxUint64
+	}
+
+	return a * b
+}
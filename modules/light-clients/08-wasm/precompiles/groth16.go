@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package precompiles
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/types"
+)
+
+var groth16Verify = newPrecompile(groth16VerifyGas, runGroth16Verify)
+
+// Groth16VerifyingKey is the verifying key of a Groth16 circuit over BN254. The G1 and G2 points are encoded
+// in the compressed or uncompressed gnark-crypto format.
+type Groth16VerifyingKey struct {
+	Alpha []byte `json:"alpha"`
+	Beta  []byte `json:"beta"`
+	Gamma []byte `json:"gamma"`
+	Delta []byte `json:"delta"`
+	// IC holds the G1 points of the linear combination of the public inputs, the first
+	// point being the constant term.
+	IC [][]byte `json:"ic"`
+}
+
+// Groth16Proof is a Groth16 proof over BN254. The G1 and G2 points are encoded in the compressed
+// or uncompressed gnark-crypto format.
+type Groth16Proof struct {
+	A []byte `json:"a"`
+	B []byte `json:"b"`
+	C []byte `json:"c"`
+}
+
+// Groth16VerifyRequest is the input of the Groth16 proof verification precompile. The public inputs are
+// big-endian encoded elements of the BN254 scalar field. The result is true if the proof is valid.
+type Groth16VerifyRequest struct {
+	VerifyingKey Groth16VerifyingKey `json:"verifying_key"`
+	Proof        Groth16Proof        `json:"proof"`
+	PublicInputs [][]byte            `json:"public_inputs"`
+}
+
+func groth16VerifyGas(req Groth16VerifyRequest) uint64 {
+	return safeAdd(Groth16VerifyGasBase, safeMul(uint64(len(req.PublicInputs)), Groth16VerifyGasPerPublicInput))
+}
+
+func runGroth16Verify(req Groth16VerifyRequest) (any, error) {
+	if len(req.PublicInputs) > MaxBatchSize {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "number of public inputs must not exceed %d, got %d", MaxBatchSize, len(req.PublicInputs))
+	}
+
+	if len(req.VerifyingKey.IC) != len(req.PublicInputs)+1 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "verifying key must have %d IC points for %d public inputs, got %d", len(req.PublicInputs)+1, len(req.PublicInputs), len(req.VerifyingKey.IC))
+	}
+
+	var (
+		alpha, a, c, vkX      bn254.G1Affine
+		beta, gamma, delta, b bn254.G2Affine
+	)
+
+	for _, point := range []struct {
+		name string
+		bz   []byte
+		p    interface{ SetBytes([]byte) (int, error) }
+	}{
+		{"alpha", req.VerifyingKey.Alpha, &alpha},
+		{"beta", req.VerifyingKey.Beta, &beta},
+		{"gamma", req.VerifyingKey.Gamma, &gamma},
+		{"delta", req.VerifyingKey.Delta, &delta},
+		{"proof a", req.Proof.A, &a},
+		{"proof b", req.Proof.B, &b},
+		{"proof c", req.Proof.C, &c},
+	} {
+		if err := setPoint(point.name, point.bz, point.p); err != nil {
+			return nil, err
+		}
+	}
+
+	// vk_x = IC[0] + sum(input_i * IC[i+1])
+	if err := setPoint("ic 0", req.VerifyingKey.IC[0], &vkX); err != nil {
+		return nil, err
+	}
+
+	modulus := fr.Modulus()
+	for i, input := range req.PublicInputs {
+		if len(input) > fr.Bytes {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "public input %d exceeds %d bytes", i, fr.Bytes)
+		}
+
+		scalar := new(big.Int).SetBytes(input)
+		if scalar.Cmp(modulus) >= 0 {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "public input %d is not a scalar field element", i)
+		}
+
+		var ic bn254.G1Affine
+		if err := setPoint("ic", req.VerifyingKey.IC[i+1], &ic); err != nil {
+			return nil, err
+		}
+
+		ic.ScalarMultiplication(&ic, scalar)
+		vkX.Add(&vkX, &ic)
+	}
+
+	// e(A, B) = e(alpha, beta) * e(vk_x, gamma) * e(C, delta)
+	var negA bn254.G1Affine
+	negA.Neg(&a)
+
+	valid, err := bn254.PairingCheck(
+		[]bn254.G1Affine{negA, alpha, vkX, c},
+		[]bn254.G2Affine{b, beta, gamma, delta},
+	)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "failed to check pairing: %v", err)
+	}
+
+	return valid, nil
+}
+
+// setPoint decodes the encoded curve point into p. The decoding checks that the point lies in the correct subgroup.
+func setPoint(name string, bz []byte, p interface{ SetBytes([]byte) (int, error) }) error {
+	n, err := p.SetBytes(bz)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "invalid %s point: %v", name, err)
+	}
+
+	if n != len(bz) {
+		return errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "invalid %s point: %d trailing bytes", name, len(bz)-n)
+	}
+
+	return nil
+}
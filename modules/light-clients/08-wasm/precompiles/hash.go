@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package precompiles
+
+import (
+	"crypto/sha256"
+	"hash"
+
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	keccak256Hash = newPrecompile(hashGas(Keccak256GasPerMessage, Keccak256GasPerWord), runHash(sha3.NewLegacyKeccak256))
+	sha256Hash    = newPrecompile(hashGas(Sha256GasPerMessage, Sha256GasPerWord), runHash(sha256.New))
+)
+
+// HashRequest is the input of the bulk hashing precompiles. The result is the list of the
+// digests of the messages, in the same order.
+type HashRequest struct {
+	Messages [][]byte `json:"messages"`
+}
+
+// hashGas returns the gas function of a bulk hashing precompile with the given costs per message and per word.
+func hashGas(gasPerMessage, gasPerWord uint64) func(req HashRequest) uint64 {
+	return func(req HashRequest) uint64 {
+		gas := safeMul(uint64(len(req.Messages)), gasPerMessage)
+		for _, message := range req.Messages {
+			gas = safeAdd(gas, safeMul(words(len(message)), gasPerWord))
+		}
+
+		return gas
+	}
+}
+
+// runHash returns the run function of a bulk hashing precompile using the given hash function.
+func runHash(newHash func() hash.Hash) func(req HashRequest) (any, error) {
+	return func(req HashRequest) (any, error) {
+		if err := requireCount("messages", len(req.Messages), MaxBatchSize); err != nil {
+			return nil, err
+		}
+
+		digests := make([][]byte, len(req.Messages))
+		for i, message := range req.Messages {
+			h := newHash()
+			h.Write(message)
+			digests[i] = h.Sum(nil)
+		}
+
+		return digests, nil
+	}
+}
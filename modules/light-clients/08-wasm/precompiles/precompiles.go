@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package precompiles defines named cryptographic functions that wasm light client contracts can call
+// through custom queries. A custom query selects a precompile by its name, which is the single key of
+// the JSON-encoded query, e.g. {"sha256": {"messages": [...]}}. The gas cost of each call is computed
+// deterministically from its input and consumed before the precompile is run.
+package precompiles
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/types"
+)
+
+const (
+	// Bls12381AggregateName is the name of the BLS12-381 public key aggregation precompile.
+	// The name is kept compatible with the custom queries served by the blsverifier package.
+	Bls12381AggregateName = "aggregate"
+	// Bls12381AggregateVerifyName is the name of the BLS12-381 aggregate signature verification precompile.
+	// The name is kept compatible with the custom queries served by the blsverifier package.
+	Bls12381AggregateVerifyName = "aggregate_verify"
+	// Secp256k1RecoverName is the name of the secp256k1 public key recovery precompile.
+	Secp256k1RecoverName = "secp256k1_recover"
+	// Ed25519BatchVerifyName is the name of the ed25519 batch signature verification precompile.
+	Ed25519BatchVerifyName = "ed25519_batch_verify"
+	// Keccak256Name is the name of the keccak256 bulk hashing precompile.
+	Keccak256Name = "keccak256"
+	// Sha256Name is the name of the sha256 bulk hashing precompile.
+	Sha256Name = "sha256"
+	// Groth16VerifyName is the name of the Groth16 (BN254) proof verification precompile.
+	Groth16VerifyName = "groth16_verify"
+)
+
+// Precompile defines a cryptographic function that can be called by wasm contracts through custom queries.
+type Precompile interface {
+	// Decode decodes and validates the JSON-encoded input, returning the call of the precompile on it.
+	Decode(input json.RawMessage) (Call, error)
+}
+
+// Call is a call of a precompile on a decoded and validated input.
+type Call interface {
+	// RequiredGas returns the deterministic gas cost of the call.
+	RequiredGas() uint64
+	// Run runs the call and returns the JSON-encoded result.
+	Run() ([]byte, error)
+}
+
+// Registry is a set of precompiles identified by their names.
+type Registry struct {
+	precompiles map[string]Precompile
+}
+
+// NewRegistry returns an empty precompile registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		precompiles: make(map[string]Precompile),
+	}
+}
+
+// DefaultRegistry returns a precompile registry with all the precompiles defined in this package registered.
+func DefaultRegistry() *Registry {
+	registry := NewRegistry()
+	registry.Register(Bls12381AggregateName, bls12381Aggregate)
+	registry.Register(Bls12381AggregateVerifyName, bls12381AggregateVerify)
+	registry.Register(Secp256k1RecoverName, secp256k1Recover)
+	registry.Register(Ed25519BatchVerifyName, ed25519BatchVerify)
+	registry.Register(Keccak256Name, keccak256Hash)
+	registry.Register(Sha256Name, sha256Hash)
+	registry.Register(Groth16VerifyName, groth16Verify)
+
+	return registry
+}
+
+// Register registers the precompile under the given name. It panics if the name is empty or a
+// precompile is already registered under the name.
+func (r *Registry) Register(name string, precompile Precompile) {
+	if name == "" {
+		panic("precompile name cannot be empty")
+	}
+
+	if _, found := r.precompiles[name]; found {
+		panic(fmt.Errorf("precompile %s already registered", name))
+	}
+
+	r.precompiles[name] = precompile
+}
+
+// Names returns the sorted names of the registered precompiles.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.precompiles))
+	for name := range r.precompiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// CustomQuerier returns a custom querier serving the enabled precompiles, which can be set as the custom
+// query plugin of the 08-wasm keeper. All registered precompiles are enabled if no names are provided.
+// An error is returned if any enabled name does not refer to a registered precompile.
+func (r *Registry) CustomQuerier(enabled ...string) (func(sdk.Context, json.RawMessage) ([]byte, error), error) {
+	if len(enabled) == 0 {
+		enabled = r.Names()
+	}
+
+	precompiles := make(map[string]Precompile, len(enabled))
+	for _, name := range enabled {
+		precompile, found := r.precompiles[name]
+		if !found {
+			return nil, fmt.Errorf("precompile %s is not registered, registered precompiles: %v", name, r.Names())
+		}
+
+		precompiles[name] = precompile
+	}
+
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query map[string]json.RawMessage
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "failed to parse custom query: %v", err)
+		}
+
+		if len(query) != 1 {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "custom query must select exactly one precompile, got %d", len(query))
+		}
+
+		for name, input := range query {
+			precompile, found := precompiles[name]
+			if !found {
+				return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("precompile '%s' is not enabled", name)}
+			}
+
+			call, err := precompile.Decode(input)
+			if err != nil {
+				return nil, err
+			}
+
+			ctx.GasMeter().ConsumeGas(call.RequiredGas(), fmt.Sprintf("08-wasm precompile %s", name))
+
+			return call.Run()
+		}
+
+		panic("unreachable")
+	}, nil
+}
+
+// precompile is a Precompile whose JSON-encoded input is decoded into a request of type T.
+type precompile[T any] struct {
+	gas func(req T) uint64
+	run func(req T) (any, error)
+}
+
+var _ Precompile = (*precompile[struct{}])(nil)
+
+// newPrecompile returns a Precompile from the gas and run functions of its decoded request.
+func newPrecompile[T any](gas func(req T) uint64, run func(req T) (any, error)) Precompile {
+	return &precompile[T]{gas: gas, run: run}
+}
+
+// Decode implements the Precompile interface. The input is decoded once and the cost of decoding it is
+// charged on top of the cost of running the precompile.
+func (p *precompile[T]) Decode(input json.RawMessage) (Call, error) {
+	req, err := decodeInput[T](input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &call[T]{
+		precompile: p,
+		req:        req,
+		gas:        safeAdd(uint64(len(input))*GasPerInputByte, p.gas(req)),
+	}, nil
+}
+
+// call is a Call of a precompile on its decoded request.
+type call[T any] struct {
+	precompile *precompile[T]
+	req        T
+	gas        uint64
+}
+
+var _ Call = (*call[struct{}])(nil)
+
+// RequiredGas implements the Call interface.
+func (c *call[T]) RequiredGas() uint64 {
+	return c.gas
+}
+
+// Run implements the Call interface.
+func (c *call[T]) Run() ([]byte, error) {
+	res, err := c.precompile.run(c.req)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(res)
+}
+
+// decodeInput decodes the JSON-encoded input of a precompile.
+func decodeInput[T any](input json.RawMessage) (T, error) {
+	var req T
+	if err := json.Unmarshal(input, &req); err != nil {
+		return req, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "failed to decode input into %T: %v", req, err)
+	}
+
+	return req, nil
+}
+
+// requireCount returns an error if the number of elements of an input does not lie within [1, maxCount].
+func requireCount(field string, count, maxCount int) error {
+	if count == 0 || count > maxCount {
+		return errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "number of %s must be between 1 and %d, got %d", field, maxCount, count)
+	}
+
+	return nil
+}
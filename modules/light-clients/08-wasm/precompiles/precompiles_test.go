@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package precompiles_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/precompiles"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/types"
+)
+
+func TestRegistry(t *testing.T) {
+	registry := precompiles.DefaultRegistry()
+
+	require.Equal(t, []string{
+		precompiles.Bls12381AggregateName,
+		precompiles.Bls12381AggregateVerifyName,
+		precompiles.Ed25519BatchVerifyName,
+		precompiles.Groth16VerifyName,
+		precompiles.Keccak256Name,
+		precompiles.Secp256k1RecoverName,
+		precompiles.Sha256Name,
+	}, registry.Names())
+
+	require.Panics(t, func() {
+		registry.Register(precompiles.Sha256Name, nil)
+	})
+
+	require.Panics(t, func() {
+		registry.Register("", nil)
+	})
+
+	_, err := registry.CustomQuerier(precompiles.Sha256Name, "unknown")
+	require.ErrorContains(t, err, "precompile unknown is not registered")
+
+	require.Empty(t, precompiles.NewRegistry().Names())
+}
+
+func TestCustomQuerier(t *testing.T) {
+	var (
+		enabled []string
+		request json.RawMessage
+		ctx     sdk.Context
+	)
+
+	hashRequest := []byte(`{"sha256":{"messages":["YWJj"]}}`)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: all precompiles enabled",
+			func() {},
+			nil,
+		},
+		{
+			"success: precompile enabled",
+			func() {
+				enabled = []string{precompiles.Sha256Name}
+			},
+			nil,
+		},
+		{
+			"failure: precompile not enabled",
+			func() {
+				enabled = []string{precompiles.Keccak256Name}
+			},
+			wasmvmtypes.UnsupportedRequest{Kind: "precompile 'sha256' is not enabled"},
+		},
+		{
+			"failure: invalid query",
+			func() {
+				request = []byte(`[]`)
+			},
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: no precompile selected",
+			func() {
+				request = []byte(`{}`)
+			},
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: more than one precompile selected",
+			func() {
+				request = []byte(`{"sha256":{"messages":["YWJj"]},"keccak256":{"messages":["YWJj"]}}`)
+			},
+			types.ErrInvalidPrecompileInput,
+		},
+		{
+			"failure: invalid input",
+			func() {
+				request = []byte(`{"sha256":{"messages":"YWJj"}}`)
+			},
+			types.ErrInvalidPrecompileInput,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			enabled = nil
+			request = hashRequest
+			ctx = sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+			tc.malleate()
+
+			querier, err := precompiles.DefaultRegistry().CustomQuerier(enabled...)
+			require.NoError(t, err)
+
+			res, err := querier(ctx, request)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.NotEmpty(t, res)
+
+				// the gas consumed is the cost of decoding the input and hashing a message of a single word
+				expGas := uint64(len(`{"messages":["YWJj"]}`))*precompiles.GasPerInputByte + precompiles.Sha256GasPerMessage + precompiles.Sha256GasPerWord
+				require.Equal(t, expGas, ctx.GasMeter().GasConsumed())
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Nil(t, res)
+			}
+		})
+	}
+}
+
+func TestCustomQuerierOutOfGas(t *testing.T) {
+	querier, err := precompiles.DefaultRegistry().CustomQuerier()
+	require.NoError(t, err)
+
+	ctx := sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(precompiles.Groth16VerifyGasBase - 1))
+
+	// the gas is consumed before the precompile is run
+	require.PanicsWithValue(t, storetypes.ErrorOutOfGas{Descriptor: "08-wasm precompile groth16_verify"}, func() {
+		_, _ = querier(ctx, []byte(`{"groth16_verify":{}}`))
+	})
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package precompiles
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/types"
+)
+
+const (
+	// secp256k1HashSize is the size of the message hash signed with secp256k1.
+	secp256k1HashSize = 32
+	// secp256k1SignatureSize is the size of a recoverable secp256k1 signature (r || s || v).
+	secp256k1SignatureSize = 65
+	// secp256k1CompactRecoveryCode is the recovery code offset of the compact signature format
+	// of an uncompressed public key.
+	secp256k1CompactRecoveryCode = 27
+)
+
+var secp256k1Recover = newPrecompile(secp256k1RecoverGas, runSecp256k1Recover)
+
+// Secp256k1RecoverRequest is the input of the secp256k1 public key recovery precompile. The signature is encoded
+// as r || s || v, where the recovery id v is 0 or 1. The result is the 65 byte uncompressed public key.
+type Secp256k1RecoverRequest struct {
+	Hash      []byte `json:"hash"`
+	Signature []byte `json:"signature"`
+}
+
+func secp256k1RecoverGas(_ Secp256k1RecoverRequest) uint64 {
+	return Secp256k1RecoverGas
+}
+
+func runSecp256k1Recover(req Secp256k1RecoverRequest) (any, error) {
+	if len(req.Hash) != secp256k1HashSize {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "invalid hash length (%d), must be %d bytes", len(req.Hash), secp256k1HashSize)
+	}
+
+	if len(req.Signature) != secp256k1SignatureSize {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "invalid signature length (%d), must be %d bytes", len(req.Signature), secp256k1SignatureSize)
+	}
+
+	recoveryID := req.Signature[secp256k1SignatureSize-1]
+	if recoveryID > 1 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "invalid recovery id (%d), must be 0 or 1", recoveryID)
+	}
+
+	// convert the signature to the compact format: recovery code || r || s
+	compactSig := make([]byte, 0, secp256k1SignatureSize)
+	compactSig = append(compactSig, secp256k1CompactRecoveryCode+recoveryID)
+	compactSig = append(compactSig, req.Signature[:secp256k1SignatureSize-1]...)
+
+	publicKey, _, err := ecdsa.RecoverCompact(compactSig, req.Hash)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInput, "failed to recover public key: %v", err)
+	}
+
+	return publicKey.SerializeUncompressed(), nil
+}
@@ -96,8 +96,8 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	ibcwasm "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11"
-	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/blsverifier"
 	ibcwasmkeeper "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/keeper"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/precompiles"
 	ibcwasmtypes "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v11/types"
 	gmp "github.com/cosmos/ibc-go/v11/modules/apps/27-gmp"
 	gmpkeeper "github.com/cosmos/ibc-go/v11/modules/apps/27-gmp/keeper"
@@ -122,6 +122,9 @@ import (
 	solomachine "github.com/cosmos/ibc-go/v11/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
 	"github.com/cosmos/ibc-go/v11/modules/light-clients/attestations"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/ethereum"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/quorum"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/zk"
 	ibcmock "github.com/cosmos/ibc-go/v11/testing/mock"
 )
 
@@ -426,8 +429,14 @@ func newSimApp(
 			authtypes.NewModuleAddress(govtypes.ModuleName).String(), mockVM, app.GRPCQueryRouter(),
 		)
 	} else {
+		// the custom queries of the contracts are served by the crypto precompiles enabled in app.toml
+		customQuerier, err := precompiles.DefaultRegistry().CustomQuerier(precompiles.EnabledPrecompilesFromAppOptions(appOpts)...)
+		if err != nil {
+			panic(err)
+		}
+
 		querierOption := ibcwasmkeeper.WithQueryPlugins(&ibcwasmkeeper.QueryPlugins{
-			Custom: blsverifier.CustomQuerier(),
+			Custom: customQuerier,
 			Stargate: ibcwasmkeeper.AcceptListStargateQuerier(
 				[]string{"/cosmos.base.tendermint.v1beta1.Service/ABCIQuery"},
 				app.GRPCQueryRouter(),
@@ -465,6 +474,9 @@ func newSimApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// Enable the migration of interchain accounts to 27-gmp accounts
+	app.ICAHostKeeper.WithGMPKeeper(app.GMPKeeper)
+
 	// Create IBC Router
 	ibcRouter := porttypes.NewRouter()
 	ibcRouterV2 := ibcapi.NewRouter()
@@ -556,6 +568,15 @@ func newSimApp(
 	attestationsLightClientModule := attestations.NewLightClientModule(appCodec, storeProvider)
 	clientKeeper.AddRoute(attestations.ModuleName, &attestationsLightClientModule)
 
+	ethereumLightClientModule := ethereum.NewLightClientModule(appCodec, storeProvider)
+	clientKeeper.AddRoute(ethereum.ModuleName, &ethereumLightClientModule)
+
+	quorumLightClientModule := quorum.NewLightClientModule(appCodec, storeProvider, clientKeeper)
+	clientKeeper.AddRoute(quorum.ModuleName, &quorumLightClientModule)
+
+	zkLightClientModule := zk.NewLightClientModule(appCodec, storeProvider, authtypes.NewModuleAddress(govtypes.ModuleName).String(), zk.DefaultVerifiers())
+	clientKeeper.AddRoute(zk.ModuleName, &zkLightClientModule)
+
 	wasmLightClientModule := ibcwasm.NewLightClientModule(app.WasmClientKeeper, storeProvider)
 	clientKeeper.AddRoute(ibcwasmtypes.ModuleName, &wasmLightClientModule)
 
@@ -601,6 +622,9 @@ func newSimApp(
 		ibctm.NewAppModule(tmLightClientModule),
 		solomachine.NewAppModule(smLightClientModule),
 		attestations.NewAppModule(attestationsLightClientModule),
+		ethereum.NewAppModule(ethereumLightClientModule),
+		quorum.NewAppModule(quorumLightClientModule),
+		zk.NewAppModule(zkLightClientModule),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
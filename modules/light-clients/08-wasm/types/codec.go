@@ -30,6 +30,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgStoreCode{},
 		&MsgMigrateContract{},
 		&MsgRemoveChecksum{},
+		&MsgMigrateAllClients{},
+		&MsgPinChecksum{},
+		&MsgUnpinChecksum{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
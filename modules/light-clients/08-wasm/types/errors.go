@@ -22,4 +22,6 @@ var (
 	ErrWasmInvalidResponseData         = errorsmod.Register(ModuleName, 15, "wasm contract returned invalid response data")
 	ErrWasmInvalidContractModification = errorsmod.Register(ModuleName, 16, "wasm contract made invalid state modifications")
 	ErrVMError                         = errorsmod.Register(ModuleName, 17, "wasm VM error")
+	ErrWasmChecksumInUse               = errorsmod.Register(ModuleName, 18, "wasm checksum in use by clients")
+	ErrInvalidPrecompileInput          = errorsmod.Register(ModuleName, 19, "invalid precompile input")
 )
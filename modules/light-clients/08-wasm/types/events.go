@@ -8,6 +8,10 @@ const (
 	EventTypeStoreWasmCode = "store_wasm_code"
 	// EventTypeMigrateContract defines the event type for a contract migration
 	EventTypeMigrateContract = "migrate_contract"
+	// EventTypePinChecksum defines the event type for pinning a checksum to the vm in-memory cache
+	EventTypePinChecksum = "pin_checksum"
+	// EventTypeUnpinChecksum defines the event type for unpinning a checksum from the vm in-memory cache
+	EventTypeUnpinChecksum = "unpin_checksum"
 
 	// AttributeKeyWasmChecksum denotes the checksum of the wasm code that was stored or migrated
 	AttributeKeyWasmChecksum = "wasm_checksum"
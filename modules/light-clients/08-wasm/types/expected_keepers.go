@@ -14,4 +14,5 @@ type ClientKeeper interface {
 	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
 	SetClientState(ctx sdk.Context, clientID string, clientState exported.ClientState)
+	IterateClientStates(ctx sdk.Context, storePrefix []byte, cb func(clientID string, cs exported.ClientState) bool)
 }
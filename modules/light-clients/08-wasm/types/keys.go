@@ -20,5 +20,9 @@ const (
 	KeyChecksums = "checksums"
 )
 
-// ChecksumsKey is the key under which all checksums are stored
-var ChecksumsKey = collections.NewPrefix(0)
+var (
+	// ChecksumsKey is the key under which all checksums are stored
+	ChecksumsKey = collections.NewPrefix(0)
+	// UnpinnedChecksumsKey is the key under which the checksums unpinned from the vm in-memory cache are stored
+	UnpinnedChecksumsKey = collections.NewPrefix(1)
+)
@@ -3,6 +3,8 @@
 package types
 
 import (
+	"bytes"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,9 +16,15 @@ var (
 	_ sdk.Msg              = (*MsgStoreCode)(nil)
 	_ sdk.Msg              = (*MsgMigrateContract)(nil)
 	_ sdk.Msg              = (*MsgRemoveChecksum)(nil)
+	_ sdk.Msg              = (*MsgMigrateAllClients)(nil)
+	_ sdk.Msg              = (*MsgPinChecksum)(nil)
+	_ sdk.Msg              = (*MsgUnpinChecksum)(nil)
 	_ sdk.HasValidateBasic = (*MsgStoreCode)(nil)
 	_ sdk.HasValidateBasic = (*MsgMigrateContract)(nil)
 	_ sdk.HasValidateBasic = (*MsgRemoveChecksum)(nil)
+	_ sdk.HasValidateBasic = (*MsgMigrateAllClients)(nil)
+	_ sdk.HasValidateBasic = (*MsgPinChecksum)(nil)
+	_ sdk.HasValidateBasic = (*MsgUnpinChecksum)(nil)
 )
 
 // NewMsgStoreCode creates a new MsgStoreCode instance
@@ -90,3 +98,75 @@ func (m MsgMigrateContract) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgMigrateAllClients creates a new MsgMigrateAllClients instance
+func NewMsgMigrateAllClients(signer string, checksum, newChecksum, migrateMsg []byte) *MsgMigrateAllClients {
+	return &MsgMigrateAllClients{
+		Signer:      signer,
+		Checksum:    checksum,
+		NewChecksum: newChecksum,
+		Msg:         migrateMsg,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgMigrateAllClients) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := ValidateWasmChecksum(m.Checksum); err != nil {
+		return err
+	}
+
+	if err := ValidateWasmChecksum(m.NewChecksum); err != nil {
+		return err
+	}
+
+	if bytes.Equal(m.Checksum, m.NewChecksum) {
+		return errorsmod.Wrap(ErrWasmCodeExists, "new checksum cannot be the same as the current checksum")
+	}
+
+	if len(m.Msg) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "migrate message cannot be empty")
+	}
+
+	return nil
+}
+
+// NewMsgPinChecksum creates a new MsgPinChecksum instance
+func NewMsgPinChecksum(signer string, checksum []byte) *MsgPinChecksum {
+	return &MsgPinChecksum{
+		Signer:   signer,
+		Checksum: checksum,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgPinChecksum) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateWasmChecksum(m.Checksum)
+}
+
+// NewMsgUnpinChecksum creates a new MsgUnpinChecksum instance
+func NewMsgUnpinChecksum(signer string, checksum []byte) *MsgUnpinChecksum {
+	return &MsgUnpinChecksum{
+		Signer:   signer,
+		Checksum: checksum,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgUnpinChecksum) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateWasmChecksum(m.Checksum)
+}
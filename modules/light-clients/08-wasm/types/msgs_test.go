@@ -261,3 +261,105 @@ func (s *TypesTestSuite) TestMsgRemoveChecksumGetSigners() {
 		})
 	}
 }
+
+func TestMsgMigrateAllClientsValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err, t.Name())
+	newChecksum, err := types.CreateChecksum(wasmtesting.CreateMockContract([]byte("new contract")))
+	require.NoError(t, err, t.Name())
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgMigrateAllClients
+		expErr error
+	}{
+		{
+			"success: valid signer address, valid checksums, valid migrate msg",
+			types.NewMsgMigrateAllClients(signer, checksum, newChecksum, []byte("{}")),
+			nil,
+		},
+		{
+			"failure: signer is invalid",
+			types.NewMsgMigrateAllClients(ibctesting.InvalidID, checksum, newChecksum, []byte("{}")),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: checksum is nil",
+			types.NewMsgMigrateAllClients(signer, nil, newChecksum, []byte("{}")),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: new checksum is nil",
+			types.NewMsgMigrateAllClients(signer, checksum, nil, []byte("{}")),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: checksums are equal",
+			types.NewMsgMigrateAllClients(signer, checksum, checksum, []byte("{}")),
+			types.ErrWasmCodeExists,
+		},
+		{
+			"failure: migrate msg is empty",
+			types.NewMsgMigrateAllClients(signer, checksum, newChecksum, []byte("")),
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMsgPinUnpinChecksumValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err, t.Name())
+
+	testCases := []struct {
+		name     string
+		signer   string
+		checksum []byte
+		expErr   error
+	}{
+		{
+			"success: valid signer address, valid length checksum",
+			signer,
+			checksum,
+			nil,
+		},
+		{
+			"failure: checksum is empty",
+			signer,
+			[]byte(""),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: signer is invalid",
+			ibctesting.InvalidID,
+			checksum,
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, msg := range []sdk.HasValidateBasic{
+			types.NewMsgPinChecksum(tc.signer, tc.checksum),
+			types.NewMsgUnpinChecksum(tc.signer, tc.checksum),
+		} {
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err, tc.name)
+			} else {
+				require.ErrorIs(t, err, tc.expErr, tc.name)
+			}
+		}
+	}
+}
@@ -221,11 +221,114 @@ func (m *QueryCodeResponse) GetData() []byte {
 	return nil
 }
 
+// QueryChecksumClientsRequest is the request type for the Query/ChecksumClients RPC method.
+type QueryChecksumClientsRequest struct {
+	// checksum is a hex encoded string of the code stored.
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *QueryChecksumClientsRequest) Reset()         { *m = QueryChecksumClientsRequest{} }
+func (m *QueryChecksumClientsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChecksumClientsRequest) ProtoMessage()    {}
+func (*QueryChecksumClientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{4}
+}
+func (m *QueryChecksumClientsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChecksumClientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChecksumClientsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChecksumClientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChecksumClientsRequest.Merge(m, src)
+}
+func (m *QueryChecksumClientsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChecksumClientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChecksumClientsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChecksumClientsRequest proto.InternalMessageInfo
+
+func (m *QueryChecksumClientsRequest) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+// QueryChecksumClientsResponse is the response type for the Query/ChecksumClients RPC method.
+type QueryChecksumClientsResponse struct {
+	// client_ids are the identifiers of the clients using the code.
+	ClientIds []string `protobuf:"bytes,1,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	// pinned is true if the code is pinned to the vm in-memory cache.
+	Pinned bool `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+}
+
+func (m *QueryChecksumClientsResponse) Reset()         { *m = QueryChecksumClientsResponse{} }
+func (m *QueryChecksumClientsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChecksumClientsResponse) ProtoMessage()    {}
+func (*QueryChecksumClientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{5}
+}
+func (m *QueryChecksumClientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChecksumClientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChecksumClientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChecksumClientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChecksumClientsResponse.Merge(m, src)
+}
+func (m *QueryChecksumClientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChecksumClientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChecksumClientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChecksumClientsResponse proto.InternalMessageInfo
+
+func (m *QueryChecksumClientsResponse) GetClientIds() []string {
+	if m != nil {
+		return m.ClientIds
+	}
+	return nil
+}
+
+func (m *QueryChecksumClientsResponse) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryChecksumsRequest)(nil), "ibc.lightclients.wasm.v1.QueryChecksumsRequest")
 	proto.RegisterType((*QueryChecksumsResponse)(nil), "ibc.lightclients.wasm.v1.QueryChecksumsResponse")
 	proto.RegisterType((*QueryCodeRequest)(nil), "ibc.lightclients.wasm.v1.QueryCodeRequest")
 	proto.RegisterType((*QueryCodeResponse)(nil), "ibc.lightclients.wasm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryChecksumClientsRequest)(nil), "ibc.lightclients.wasm.v1.QueryChecksumClientsRequest")
+	proto.RegisterType((*QueryChecksumClientsResponse)(nil), "ibc.lightclients.wasm.v1.QueryChecksumClientsResponse")
 }
 
 func init() {
@@ -233,35 +336,39 @@ func init() {
 }
 
 var fileDescriptor_9e3718a8cb915777 = []byte{
-	// 434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x77, 0xd6, 0x2a, 0x66, 0xf4, 0xa0, 0x03, 0xca, 0x12, 0x4a, 0x28, 0xf1, 0x47, 0x4b,
-	0xcb, 0xce, 0x6b, 0x5a, 0x04, 0xc1, 0x8b, 0x28, 0xe8, 0x55, 0x73, 0xec, 0x45, 0x26, 0x93, 0x21,
-	0x3b, 0x98, 0x64, 0xd2, 0xce, 0x64, 0xa5, 0x88, 0x08, 0xfe, 0x05, 0x82, 0x47, 0xfd, 0x73, 0x3c,
-	0x78, 0x2c, 0x78, 0xf1, 0x28, 0xbb, 0xfe, 0x21, 0x92, 0x99, 0xa4, 0xd9, 0x15, 0x97, 0xdd, 0xdb,
-	0xe4, 0xf1, 0x79, 0xdf, 0xef, 0xf7, 0xbd, 0x3c, 0x7c, 0x5f, 0x26, 0x1c, 0x72, 0x99, 0x4d, 0x0c,
-	0xcf, 0xa5, 0x28, 0x8d, 0x86, 0x77, 0x4c, 0x17, 0x30, 0x8d, 0xe0, 0xb4, 0x16, 0x67, 0xe7, 0xb4,
-	0x3a, 0x53, 0x46, 0x91, 0x91, 0x4c, 0x38, 0x5d, 0xa4, 0x68, 0x43, 0xd1, 0x69, 0xe4, 0x6f, 0x67,
-	0x4a, 0x65, 0xb9, 0x00, 0x56, 0x49, 0x60, 0x65, 0xa9, 0x0c, 0x33, 0x52, 0x95, 0xda, 0xf5, 0xf9,
-	0xfb, 0x5c, 0xe9, 0x42, 0x69, 0x48, 0x98, 0x16, 0x4e, 0x10, 0xa6, 0x51, 0x22, 0x0c, 0x8b, 0xa0,
-	0x62, 0x99, 0x2c, 0x2d, 0xec, 0xd8, 0xf0, 0x0d, 0xbe, 0xf3, 0xba, 0x21, 0x9e, 0x4f, 0x04, 0x7f,
-	0xab, 0xeb, 0x42, 0xc7, 0xe2, 0xb4, 0x16, 0xda, 0x90, 0x17, 0x18, 0xf7, 0xf0, 0x08, 0xed, 0xa0,
-	0xbd, 0x1b, 0x47, 0x0f, 0xa9, 0x53, 0xa6, 0x8d, 0x32, 0x75, 0x51, 0x5b, 0x65, 0xfa, 0x8a, 0x65,
-	0xa2, 0xed, 0x8d, 0x17, 0x3a, 0xc3, 0x8f, 0xf8, 0xee, 0xbf, 0x06, 0xba, 0x52, 0xa5, 0x16, 0x64,
-	0x1b, 0x7b, 0xbc, 0x2b, 0x8e, 0xd0, 0xce, 0x95, 0x3d, 0x2f, 0xee, 0x0b, 0xe4, 0xe5, 0x92, 0xff,
-	0xd0, 0xfa, 0xef, 0xae, 0xf5, 0x77, 0xd2, 0x4b, 0x01, 0x28, 0xbe, 0xe5, 0x02, 0xa8, 0xb4, 0x0b,
-	0x48, 0x7c, 0x7c, 0xbd, 0x73, 0xb2, 0xa3, 0x79, 0xf1, 0xe5, 0x77, 0xb8, 0x8b, 0x6f, 0x2f, 0xf0,
-	0x6d, 0x56, 0x82, 0xb7, 0x52, 0x66, 0x98, 0x85, 0x6f, 0xc6, 0xf6, 0x7d, 0xf4, 0x7d, 0x88, 0xaf,
-	0x5a, 0x92, 0x7c, 0x45, 0xd8, 0xbb, 0x9c, 0x8f, 0x00, 0x5d, 0xf5, 0xdf, 0xe8, 0x7f, 0x57, 0xed,
-	0x1f, 0x6e, 0xde, 0xe0, 0xe2, 0x84, 0x07, 0x9f, 0x7e, 0xfe, 0xf9, 0x32, 0x7c, 0x40, 0xee, 0xc1,
-	0xca, 0x43, 0xea, 0x37, 0xf9, 0x0d, 0xe1, 0xad, 0x66, 0x18, 0xb2, 0xbf, 0xce, 0xa7, 0xdf, 0x90,
-	0x7f, 0xb0, 0x11, 0xdb, 0xc6, 0x79, 0x62, 0xe3, 0x3c, 0x22, 0xc7, 0x1b, 0xc4, 0x81, 0xf7, 0xdd,
-	0xf3, 0x03, 0x70, 0x95, 0x8a, 0x67, 0x27, 0x3f, 0x66, 0x01, 0xba, 0x98, 0x05, 0xe8, 0xf7, 0x2c,
-	0x40, 0x9f, 0xe7, 0xc1, 0xe0, 0x62, 0x1e, 0x0c, 0x7e, 0xcd, 0x83, 0xc1, 0xc9, 0xd3, 0x4c, 0x9a,
-	0x49, 0x9d, 0x50, 0xae, 0x0a, 0x68, 0x4f, 0x5a, 0x26, 0x7c, 0x9c, 0x29, 0x28, 0x54, 0x5a, 0xe7,
-	0x42, 0x3b, 0xab, 0x71, 0xe7, 0x75, 0xf8, 0x78, 0xdc, 0xda, 0x45, 0x60, 0xce, 0x2b, 0xa1, 0x93,
-	0x6b, 0xf6, 0xc8, 0x8f, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x17, 0xe5, 0x2a, 0x65, 0x70, 0x03,
-	0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x37, 0x75, 0x2d, 0xdd, 0x57, 0x41, 0x1d, 0xb0, 0x2c, 0x71, 0x0d, 0x25, 0xfe, 0x69,
+	0x69, 0xd9, 0x99, 0xa6, 0xa5, 0xa5, 0x22, 0x88, 0x58, 0x50, 0xbc, 0x69, 0xc0, 0x4b, 0x2f, 0x65,
+	0x92, 0x0c, 0xd9, 0xc1, 0x4d, 0x26, 0xed, 0x4c, 0x56, 0x8a, 0x88, 0xe0, 0xc5, 0xab, 0xe0, 0x51,
+	0x3f, 0x8c, 0x47, 0x8f, 0x05, 0x2f, 0x1e, 0x65, 0xd7, 0x0f, 0x52, 0x32, 0x93, 0x34, 0xbb, 0xa5,
+	0xa5, 0xe9, 0x6d, 0x66, 0x78, 0xde, 0xf7, 0xf9, 0xcd, 0xfb, 0x0c, 0x03, 0x0f, 0x79, 0x10, 0x92,
+	0x21, 0x8f, 0x07, 0x2a, 0x1c, 0x72, 0x96, 0x2a, 0x49, 0x3e, 0x50, 0x99, 0x90, 0x91, 0x47, 0x0e,
+	0x72, 0x76, 0x78, 0x84, 0xb3, 0x43, 0xa1, 0x04, 0xea, 0xf2, 0x20, 0xc4, 0xd3, 0x2a, 0x5c, 0xa8,
+	0xf0, 0xc8, 0xb3, 0x7b, 0xb1, 0x10, 0xf1, 0x90, 0x11, 0x9a, 0x71, 0x42, 0xd3, 0x54, 0x28, 0xaa,
+	0xb8, 0x48, 0xa5, 0xa9, 0xb3, 0x57, 0x43, 0x21, 0x13, 0x21, 0x49, 0x40, 0x25, 0x33, 0x0d, 0xc9,
+	0xc8, 0x0b, 0x98, 0xa2, 0x1e, 0xc9, 0x68, 0xcc, 0x53, 0x2d, 0x36, 0x5a, 0x77, 0x1f, 0xee, 0xbe,
+	0x2d, 0x14, 0xbb, 0x03, 0x16, 0xbe, 0x97, 0x79, 0x22, 0x7d, 0x76, 0x90, 0x33, 0xa9, 0xd0, 0x4b,
+	0x80, 0x5a, 0xdc, 0xb5, 0x96, 0xac, 0x95, 0x1b, 0x1b, 0x8f, 0xb1, 0xe9, 0x8c, 0x8b, 0xce, 0xd8,
+	0xa0, 0x96, 0x9d, 0xf1, 0x1b, 0x1a, 0xb3, 0xb2, 0xd6, 0x9f, 0xaa, 0x74, 0x3f, 0xc3, 0xe2, 0x59,
+	0x03, 0x99, 0x89, 0x54, 0x32, 0xd4, 0x83, 0x4e, 0x58, 0x1d, 0x76, 0xad, 0xa5, 0x6b, 0x2b, 0x1d,
+	0xbf, 0x3e, 0x40, 0xaf, 0x66, 0xfc, 0xe7, 0xb4, 0xff, 0xf2, 0xa5, 0xfe, 0xa6, 0xf5, 0x0c, 0x00,
+	0x86, 0xdb, 0x06, 0x40, 0x44, 0x15, 0x20, 0xb2, 0x61, 0xa1, 0x72, 0xd2, 0x57, 0xeb, 0xf8, 0xa7,
+	0x7b, 0x77, 0x19, 0xee, 0x4c, 0xe9, 0x4b, 0x56, 0x04, 0xed, 0x88, 0x2a, 0xaa, 0xc5, 0x37, 0x7d,
+	0xbd, 0x76, 0x9f, 0xc0, 0xbd, 0x99, 0x9b, 0xed, 0x9a, 0x90, 0x9a, 0x78, 0xbc, 0x83, 0xde, 0xf9,
+	0xa5, 0xa5, 0xdd, 0x7d, 0x00, 0x13, 0xf9, 0x3e, 0x8f, 0xea, 0xd9, 0xe8, 0x93, 0xd7, 0x91, 0x44,
+	0x8b, 0x30, 0x9f, 0xf1, 0x34, 0x65, 0x91, 0x9e, 0xcb, 0x82, 0x5f, 0xee, 0x36, 0xbe, 0xb6, 0xe1,
+	0xba, 0xee, 0x8b, 0x7e, 0x58, 0xd0, 0x39, 0x9d, 0x38, 0x22, 0xf8, 0xa2, 0x97, 0x84, 0xcf, 0x0d,
+	0xdf, 0x5e, 0x6f, 0x5e, 0x60, 0x88, 0xdd, 0xb5, 0x2f, 0x7f, 0xfe, 0x7f, 0x9f, 0x7b, 0x84, 0x1e,
+	0x90, 0x0b, 0x9f, 0x76, 0x9d, 0xed, 0x4f, 0x0b, 0xda, 0xc5, 0x78, 0xd1, 0xea, 0x65, 0x3e, 0x75,
+	0x66, 0xf6, 0x5a, 0x23, 0x6d, 0x89, 0xf3, 0x54, 0xe3, 0x6c, 0xa1, 0xcd, 0x06, 0x38, 0xe4, 0x63,
+	0xb5, 0xfc, 0x44, 0xc2, 0x82, 0xea, 0x97, 0x05, 0xb7, 0xce, 0x24, 0x83, 0xb6, 0x1a, 0x4e, 0x64,
+	0xf6, 0x11, 0xd8, 0xdb, 0x57, 0x2d, 0x2b, 0xf9, 0x9f, 0x69, 0xfe, 0x1d, 0xb4, 0x7d, 0x55, 0x7e,
+	0x23, 0x7a, 0xb1, 0xf7, 0x7b, 0xec, 0x58, 0xc7, 0x63, 0xc7, 0xfa, 0x37, 0x76, 0xac, 0x6f, 0x13,
+	0xa7, 0x75, 0x3c, 0x71, 0x5a, 0x7f, 0x27, 0x4e, 0x6b, 0xef, 0x79, 0xcc, 0xd5, 0x20, 0x0f, 0x70,
+	0x28, 0x12, 0x52, 0xfe, 0x13, 0x3c, 0x08, 0xfb, 0xb1, 0x20, 0x89, 0x88, 0xf2, 0x21, 0x93, 0xc6,
+	0xad, 0x5f, 0xd9, 0xad, 0xef, 0xf4, 0x4b, 0x47, 0x8f, 0xa8, 0xa3, 0x8c, 0xc9, 0x60, 0x5e, 0xff,
+	0x1c, 0x9b, 0x27, 0x03, 0x00, 0x47, 0x5e, 0x4f, 0xe1, 0xc5, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -280,6 +387,8 @@ type QueryClient interface {
 	Checksums(ctx context.Context, in *QueryChecksumsRequest, opts ...grpc.CallOption) (*QueryChecksumsResponse, error)
 	// Get Wasm code for given checksum
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// Get the identifiers of the clients using the Wasm code for given checksum
+	ChecksumClients(ctx context.Context, in *QueryChecksumClientsRequest, opts ...grpc.CallOption) (*QueryChecksumClientsResponse, error)
 }
 
 type queryClient struct {
@@ -308,12 +417,23 @@ func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) ChecksumClients(ctx context.Context, in *QueryChecksumClientsRequest, opts ...grpc.CallOption) (*QueryChecksumClientsResponse, error) {
+	out := new(QueryChecksumClientsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/ChecksumClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Get all Wasm checksums
 	Checksums(context.Context, *QueryChecksumsRequest) (*QueryChecksumsResponse, error)
 	// Get Wasm code for given checksum
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	// Get the identifiers of the clients using the Wasm code for given checksum
+	ChecksumClients(context.Context, *QueryChecksumClientsRequest) (*QueryChecksumClientsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -326,6 +446,9 @@ func (*UnimplementedQueryServer) Checksums(ctx context.Context, req *QueryChecks
 func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
+func (*UnimplementedQueryServer) ChecksumClients(ctx context.Context, req *QueryChecksumClientsRequest) (*QueryChecksumClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChecksumClients not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -367,6 +490,24 @@ func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ChecksumClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChecksumClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChecksumClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/ChecksumClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChecksumClients(ctx, req.(*QueryChecksumClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.lightclients.wasm.v1.Query",
@@ -380,6 +521,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
 		},
+		{
+			MethodName: "ChecksumClients",
+			Handler:    _Query_ChecksumClients_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/lightclients/wasm/v1/query.proto",
@@ -524,6 +669,78 @@ func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryChecksumClientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChecksumClientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChecksumClientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChecksumClientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChecksumClientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChecksumClientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pinned {
+		i--
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientIds) > 0 {
+		for iNdEx := len(m.ClientIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientIds[iNdEx])
+			copy(dAtA[i:], m.ClientIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -593,6 +810,37 @@ func (m *QueryCodeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryChecksumClientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChecksumClientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClientIds) > 0 {
+		for _, s := range m.ClientIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pinned {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -969,6 +1217,190 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryChecksumClientsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChecksumClientsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChecksumClientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChecksumClientsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChecksumClientsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChecksumClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientIds = append(m.ClientIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
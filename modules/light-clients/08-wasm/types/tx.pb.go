@@ -331,6 +331,309 @@ func (m *MsgMigrateContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMigrateContractResponse proto.InternalMessageInfo
 
+// MsgMigrateAllClients defines the request type for the MigrateAllClients rpc.
+type MsgMigrateAllClients struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// checksum is the sha256 hash of the wasm byte code the clients are migrated from
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// new_checksum is the sha256 hash of the wasm byte code the clients are migrated to
+	NewChecksum []byte `protobuf:"bytes,3,opt,name=new_checksum,json=newChecksum,proto3" json:"new_checksum,omitempty"`
+	// the json encoded message to be passed to the contract of each client on migration
+	Msg []byte `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *MsgMigrateAllClients) Reset()         { *m = MsgMigrateAllClients{} }
+func (m *MsgMigrateAllClients) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAllClients) ProtoMessage()    {}
+func (*MsgMigrateAllClients) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d9737363bf1e38d, []int{6}
+}
+func (m *MsgMigrateAllClients) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateAllClients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateAllClients.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateAllClients) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAllClients.Merge(m, src)
+}
+func (m *MsgMigrateAllClients) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateAllClients) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAllClients.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateAllClients proto.InternalMessageInfo
+
+func (m *MsgMigrateAllClients) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgMigrateAllClients) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *MsgMigrateAllClients) GetNewChecksum() []byte {
+	if m != nil {
+		return m.NewChecksum
+	}
+	return nil
+}
+
+func (m *MsgMigrateAllClients) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+// MsgMigrateAllClientsResponse defines the response type for the MigrateAllClients rpc
+type MsgMigrateAllClientsResponse struct {
+	// client_ids are the identifiers of the migrated clients
+	ClientIds []string `protobuf:"bytes,1,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+}
+
+func (m *MsgMigrateAllClientsResponse) Reset()         { *m = MsgMigrateAllClientsResponse{} }
+func (m *MsgMigrateAllClientsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAllClientsResponse) ProtoMessage()    {}
+func (*MsgMigrateAllClientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d9737363bf1e38d, []int{7}
+}
+func (m *MsgMigrateAllClientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateAllClientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateAllClientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateAllClientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAllClientsResponse.Merge(m, src)
+}
+func (m *MsgMigrateAllClientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateAllClientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAllClientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateAllClientsResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateAllClientsResponse) GetClientIds() []string {
+	if m != nil {
+		return m.ClientIds
+	}
+	return nil
+}
+
+// MsgPinChecksum defines the request type for the PinChecksum rpc.
+type MsgPinChecksum struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// checksum is the sha256 hash of the wasm byte code to be pinned to the vm in-memory cache
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *MsgPinChecksum) Reset()         { *m = MsgPinChecksum{} }
+func (m *MsgPinChecksum) String() string { return proto.CompactTextString(m) }
+func (*MsgPinChecksum) ProtoMessage()    {}
+func (*MsgPinChecksum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d9737363bf1e38d, []int{8}
+}
+func (m *MsgPinChecksum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPinChecksum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPinChecksum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPinChecksum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPinChecksum.Merge(m, src)
+}
+func (m *MsgPinChecksum) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPinChecksum) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPinChecksum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPinChecksum proto.InternalMessageInfo
+
+func (m *MsgPinChecksum) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgPinChecksum) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+// MsgPinChecksumResponse defines the response type for the PinChecksum rpc
+type MsgPinChecksumResponse struct {
+}
+
+func (m *MsgPinChecksumResponse) Reset()         { *m = MsgPinChecksumResponse{} }
+func (m *MsgPinChecksumResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPinChecksumResponse) ProtoMessage()    {}
+func (*MsgPinChecksumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d9737363bf1e38d, []int{9}
+}
+func (m *MsgPinChecksumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPinChecksumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPinChecksumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPinChecksumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPinChecksumResponse.Merge(m, src)
+}
+func (m *MsgPinChecksumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPinChecksumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPinChecksumResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPinChecksumResponse proto.InternalMessageInfo
+
+// MsgUnpinChecksum defines the request type for the UnpinChecksum rpc.
+type MsgUnpinChecksum struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// checksum is the sha256 hash of the wasm byte code to be unpinned from the vm in-memory cache
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *MsgUnpinChecksum) Reset()         { *m = MsgUnpinChecksum{} }
+func (m *MsgUnpinChecksum) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpinChecksum) ProtoMessage()    {}
+func (*MsgUnpinChecksum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d9737363bf1e38d, []int{10}
+}
+func (m *MsgUnpinChecksum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpinChecksum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpinChecksum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpinChecksum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpinChecksum.Merge(m, src)
+}
+func (m *MsgUnpinChecksum) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpinChecksum) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpinChecksum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpinChecksum proto.InternalMessageInfo
+
+func (m *MsgUnpinChecksum) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgUnpinChecksum) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+// MsgUnpinChecksumResponse defines the response type for the UnpinChecksum rpc
+type MsgUnpinChecksumResponse struct {
+}
+
+func (m *MsgUnpinChecksumResponse) Reset()         { *m = MsgUnpinChecksumResponse{} }
+func (m *MsgUnpinChecksumResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpinChecksumResponse) ProtoMessage()    {}
+func (*MsgUnpinChecksumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d9737363bf1e38d, []int{11}
+}
+func (m *MsgUnpinChecksumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpinChecksumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpinChecksumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpinChecksumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpinChecksumResponse.Merge(m, src)
+}
+func (m *MsgUnpinChecksumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpinChecksumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpinChecksumResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpinChecksumResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgStoreCode)(nil), "ibc.lightclients.wasm.v1.MsgStoreCode")
 	proto.RegisterType((*MsgStoreCodeResponse)(nil), "ibc.lightclients.wasm.v1.MsgStoreCodeResponse")
@@ -338,40 +641,55 @@ func init() {
 	proto.RegisterType((*MsgRemoveChecksumResponse)(nil), "ibc.lightclients.wasm.v1.MsgRemoveChecksumResponse")
 	proto.RegisterType((*MsgMigrateContract)(nil), "ibc.lightclients.wasm.v1.MsgMigrateContract")
 	proto.RegisterType((*MsgMigrateContractResponse)(nil), "ibc.lightclients.wasm.v1.MsgMigrateContractResponse")
+	proto.RegisterType((*MsgMigrateAllClients)(nil), "ibc.lightclients.wasm.v1.MsgMigrateAllClients")
+	proto.RegisterType((*MsgMigrateAllClientsResponse)(nil), "ibc.lightclients.wasm.v1.MsgMigrateAllClientsResponse")
+	proto.RegisterType((*MsgPinChecksum)(nil), "ibc.lightclients.wasm.v1.MsgPinChecksum")
+	proto.RegisterType((*MsgPinChecksumResponse)(nil), "ibc.lightclients.wasm.v1.MsgPinChecksumResponse")
+	proto.RegisterType((*MsgUnpinChecksum)(nil), "ibc.lightclients.wasm.v1.MsgUnpinChecksum")
+	proto.RegisterType((*MsgUnpinChecksumResponse)(nil), "ibc.lightclients.wasm.v1.MsgUnpinChecksumResponse")
 }
 
 func init() { proto.RegisterFile("ibc/lightclients/wasm/v1/tx.proto", fileDescriptor_1d9737363bf1e38d) }
 
 var fileDescriptor_1d9737363bf1e38d = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3d, 0x6f, 0x13, 0x41,
-	0x14, 0xf4, 0xda, 0x10, 0xc5, 0x0f, 0x2b, 0xc0, 0x09, 0x81, 0xb9, 0xa0, 0x53, 0xb0, 0x10, 0x8a,
-	0x02, 0xde, 0xc5, 0x09, 0x05, 0xa2, 0x42, 0x49, 0x45, 0x71, 0xcd, 0x41, 0x43, 0x1a, 0xcb, 0xb7,
-	0xb7, 0x5a, 0xaf, 0xf0, 0x7a, 0xad, 0x7b, 0x6b, 0x83, 0x3b, 0x84, 0xf8, 0x01, 0xfc, 0x94, 0xfc,
-	0x0c, 0xca, 0x94, 0x14, 0x14, 0xc8, 0x2e, 0xf2, 0x37, 0xd0, 0x7d, 0x99, 0x3b, 0x47, 0x8e, 0x92,
-	0xee, 0xf6, 0x69, 0xde, 0xcc, 0xdc, 0x1b, 0x0d, 0x3c, 0x55, 0x21, 0x67, 0x23, 0x25, 0x87, 0x96,
-	0x8f, 0x94, 0x18, 0x5b, 0x64, 0x5f, 0x06, 0xa8, 0xd9, 0xac, 0xc7, 0xec, 0x57, 0x3a, 0x89, 0x8d,
-	0x35, 0x4e, 0x5b, 0x85, 0x9c, 0x96, 0x21, 0x34, 0x81, 0xd0, 0x59, 0xcf, 0x7d, 0xc4, 0x0d, 0x6a,
-	0x83, 0x4c, 0xa3, 0x4c, 0x36, 0x34, 0xca, 0x6c, 0xa5, 0xf3, 0x09, 0x5a, 0x3e, 0xca, 0x0f, 0xd6,
-	0xc4, 0xe2, 0xc4, 0x44, 0xc2, 0x79, 0x08, 0x5b, 0xa8, 0xe4, 0x58, 0xc4, 0x6d, 0xb2, 0x47, 0xf6,
-	0x9b, 0x41, 0xfe, 0x72, 0x9e, 0xc1, 0x4e, 0xc2, 0xd5, 0x0f, 0xe7, 0x56, 0xf4, 0xb9, 0x89, 0x44,
-	0xbb, 0xbe, 0x47, 0xf6, 0x5b, 0x41, 0x2b, 0x99, 0x1e, 0xcf, 0x6d, 0xba, 0xfd, 0xf6, 0xce, 0xf7,
-	0x8b, 0xb3, 0x83, 0x7c, 0xa5, 0x73, 0x08, 0x0f, 0xca, 0xd4, 0x81, 0xc0, 0x89, 0x19, 0xa3, 0x70,
-	0x5c, 0xd8, 0xe6, 0x43, 0xc1, 0x3f, 0xe3, 0x54, 0xa7, 0x22, 0xad, 0x60, 0xf5, 0xee, 0x7c, 0x84,
-	0xfb, 0x3e, 0xca, 0x40, 0x68, 0x33, 0x13, 0x27, 0xf9, 0x70, 0xa3, 0xa7, 0x32, 0x51, 0xbd, 0x4a,
-	0x54, 0x75, 0xb2, 0x0b, 0x8f, 0x2f, 0xb1, 0x16, 0x76, 0x3a, 0x3f, 0x08, 0x38, 0x3e, 0x4a, 0x5f,
-	0xc9, 0x78, 0x90, 0xfc, 0xc6, 0xd8, 0xc6, 0x03, 0x6e, 0x37, 0x8a, 0xee, 0x42, 0x33, 0x3b, 0x6e,
-	0x5f, 0x45, 0xa9, 0x6a, 0x33, 0xd8, 0xce, 0x06, 0xef, 0xa3, 0x8a, 0xa3, 0x46, 0xd5, 0x91, 0x73,
-	0x0f, 0x1a, 0x1a, 0x65, 0xfb, 0x56, 0x3a, 0x4e, 0x3e, 0xab, 0x1e, 0x9f, 0x80, 0x7b, 0xd9, 0x45,
-	0x61, 0xf2, 0xf0, 0x4f, 0x1d, 0x1a, 0x3e, 0x4a, 0x87, 0x43, 0xf3, 0x7f, 0x56, 0xcf, 0xe9, 0xa6,
-	0xbc, 0x69, 0xf9, 0xf0, 0x2e, 0xbd, 0x1e, 0x6e, 0x15, 0x50, 0x0c, 0x3b, 0x6b, 0x09, 0xbc, 0xb8,
-	0x92, 0xa1, 0x0a, 0x76, 0x8f, 0x6e, 0x00, 0x5e, 0x69, 0x4e, 0xe1, 0xee, 0x7a, 0x02, 0x2f, 0xaf,
-	0xe4, 0x59, 0x43, 0xbb, 0xaf, 0x6f, 0x82, 0x2e, 0x64, 0xdd, 0xdb, 0xdf, 0x2e, 0xce, 0x0e, 0xc8,
-	0xf1, 0xe9, 0xaf, 0x85, 0x47, 0xce, 0x17, 0x1e, 0xf9, 0xbb, 0xf0, 0xc8, 0xcf, 0xa5, 0x57, 0x3b,
-	0x5f, 0x7a, 0xb5, 0xdf, 0x4b, 0xaf, 0x76, 0xfa, 0x4e, 0x2a, 0x3b, 0x9c, 0x86, 0x94, 0x1b, 0xcd,
-	0xf2, 0x0e, 0xa9, 0x90, 0x77, 0xa5, 0x61, 0xda, 0x44, 0xd3, 0x91, 0xc0, 0xac, 0x92, 0xdd, 0xa2,
-	0x93, 0xaf, 0xde, 0x74, 0xf3, 0x5a, 0xf6, 0x98, 0x9d, 0x4f, 0x04, 0x86, 0x5b, 0x69, 0xd1, 0x8e,
-	0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x04, 0x5b, 0x71, 0x35, 0xc0, 0x03, 0x00, 0x00,
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x12, 0x41,
+	0x14, 0xee, 0x16, 0xdb, 0x74, 0x5f, 0xb1, 0xb6, 0x9b, 0xa6, 0xae, 0xd3, 0xba, 0xa1, 0xc4, 0x18,
+	0x82, 0xb2, 0x5b, 0xa8, 0x31, 0xc6, 0xc4, 0x44, 0xcb, 0xc9, 0xc3, 0x26, 0xba, 0xd5, 0x83, 0xbd,
+	0x10, 0x76, 0x98, 0x0c, 0x13, 0xd9, 0x1d, 0xb2, 0x33, 0x80, 0xc4, 0x8b, 0x31, 0x1e, 0x3d, 0xf8,
+	0xa7, 0xf4, 0xe2, 0xff, 0xe0, 0xb1, 0x47, 0x8f, 0x06, 0x0e, 0xfd, 0x37, 0xcc, 0xf2, 0x63, 0xd9,
+	0x05, 0x21, 0x60, 0x7a, 0x63, 0x1e, 0xdf, 0xfb, 0xbe, 0x6f, 0xbf, 0x99, 0x97, 0x07, 0xc7, 0xcc,
+	0xc5, 0x56, 0x83, 0xd1, 0xba, 0xc4, 0x0d, 0x46, 0x7c, 0x29, 0xac, 0x4e, 0x55, 0x78, 0x56, 0xbb,
+	0x68, 0xc9, 0x4f, 0x66, 0x33, 0xe0, 0x92, 0x6b, 0x3a, 0x73, 0xb1, 0x19, 0x87, 0x98, 0x21, 0xc4,
+	0x6c, 0x17, 0xd1, 0x5d, 0xcc, 0x85, 0xc7, 0x85, 0xe5, 0x09, 0x1a, 0x76, 0x78, 0x82, 0x0e, 0x5b,
+	0xb2, 0x1f, 0x20, 0x6d, 0x0b, 0x7a, 0x2e, 0x79, 0x40, 0xca, 0xbc, 0x46, 0xb4, 0x03, 0xd8, 0x14,
+	0x8c, 0xfa, 0x24, 0xd0, 0x95, 0x8c, 0x92, 0x53, 0x9d, 0xd1, 0x49, 0x7b, 0x00, 0x3b, 0x21, 0x57,
+	0xc5, 0xed, 0x4a, 0x52, 0xc1, 0xbc, 0x46, 0xf4, 0xf5, 0x8c, 0x92, 0x4b, 0x3b, 0xe9, 0xb0, 0x7a,
+	0xd6, 0x95, 0x83, 0xee, 0xe7, 0xdb, 0x5f, 0xaf, 0x2f, 0xf3, 0xa3, 0x96, 0x6c, 0x09, 0xf6, 0xe3,
+	0xd4, 0x0e, 0x11, 0x4d, 0xee, 0x0b, 0xa2, 0x21, 0xd8, 0xc2, 0x75, 0x82, 0x3f, 0x8a, 0x96, 0x37,
+	0x10, 0x49, 0x3b, 0xd1, 0x39, 0xfb, 0x0e, 0xf6, 0x6c, 0x41, 0x1d, 0xe2, 0xf1, 0x36, 0x29, 0x8f,
+	0x8a, 0x73, 0x3d, 0xc5, 0x89, 0xd6, 0x93, 0x44, 0x49, 0x27, 0x87, 0x70, 0x6f, 0x86, 0x75, 0x6c,
+	0x27, 0xfb, 0x4d, 0x01, 0xcd, 0x16, 0xd4, 0x66, 0x34, 0xa8, 0x86, 0x9f, 0xe1, 0xcb, 0xa0, 0x8a,
+	0xe5, 0x5c, 0xd1, 0x43, 0x50, 0x87, 0xe1, 0x56, 0x58, 0x6d, 0xa0, 0xaa, 0x3a, 0x5b, 0xc3, 0xc2,
+	0xeb, 0x5a, 0xc2, 0x51, 0x2a, 0xe9, 0x48, 0xdb, 0x85, 0x94, 0x27, 0xa8, 0x7e, 0x6b, 0x50, 0x0e,
+	0x7f, 0x26, 0x3d, 0x1e, 0x01, 0x9a, 0x75, 0x11, 0x99, 0xfc, 0xae, 0xc0, 0xfe, 0xe4, 0xef, 0x57,
+	0x8d, 0x46, 0x79, 0x78, 0xc1, 0xff, 0x93, 0x8d, 0x76, 0x0c, 0x69, 0x9f, 0x74, 0x2a, 0x53, 0x4e,
+	0xb7, 0x7d, 0xd2, 0x29, 0x2f, 0x69, 0xf6, 0x05, 0x1c, 0xfd, 0xcb, 0x4d, 0x74, 0xc5, 0xf7, 0x01,
+	0xa2, 0x90, 0x84, 0xae, 0x64, 0x52, 0x39, 0xd5, 0x51, 0xc7, 0x29, 0x89, 0xec, 0x5b, 0xd8, 0xb1,
+	0x05, 0x7d, 0xc3, 0xfc, 0x9b, 0xbb, 0x62, 0x1d, 0x0e, 0x92, 0x94, 0x51, 0x74, 0xe7, 0xb0, 0x6b,
+	0x0b, 0xfa, 0xde, 0x6f, 0xde, 0xa4, 0x1c, 0x02, 0x7d, 0x9a, 0x74, 0x2c, 0x58, 0xfa, 0xb9, 0x01,
+	0x29, 0x5b, 0x50, 0x0d, 0x83, 0x3a, 0x99, 0xab, 0x87, 0xe6, 0xbc, 0xd9, 0x34, 0xe3, 0x43, 0x82,
+	0xcc, 0xe5, 0x70, 0x51, 0xd2, 0x01, 0xec, 0x4c, 0x4d, 0xcb, 0xa3, 0x85, 0x0c, 0x49, 0x30, 0x3a,
+	0x5d, 0x01, 0x1c, 0x69, 0xb6, 0xe0, 0xce, 0xf4, 0xb4, 0x3c, 0x5e, 0xc8, 0x33, 0x85, 0x46, 0x4f,
+	0x56, 0x41, 0x47, 0xb2, 0x9f, 0x61, 0x6f, 0xf6, 0xfd, 0x9b, 0xcb, 0x50, 0x4d, 0xf0, 0xe8, 0xe9,
+	0x6a, 0xf8, 0x48, 0x9c, 0xc1, 0x76, 0xfc, 0xbd, 0xe6, 0x16, 0xd2, 0xc4, 0x90, 0xe8, 0x64, 0x59,
+	0x64, 0x24, 0xc5, 0xe1, 0x76, 0xf2, 0xb5, 0xe6, 0x17, 0x52, 0x24, 0xb0, 0xa8, 0xb4, 0x3c, 0x76,
+	0x2c, 0x88, 0x36, 0xbe, 0x5c, 0x5f, 0xe6, 0x95, 0xb3, 0x8b, 0x5f, 0x3d, 0x43, 0xb9, 0xea, 0x19,
+	0xca, 0x9f, 0x9e, 0xa1, 0xfc, 0xe8, 0x1b, 0x6b, 0x57, 0x7d, 0x63, 0xed, 0x77, 0xdf, 0x58, 0xbb,
+	0x78, 0x49, 0x99, 0xac, 0xb7, 0x5c, 0x13, 0x73, 0xcf, 0x1a, 0x2d, 0x12, 0xe6, 0xe2, 0x02, 0xe5,
+	0x96, 0xc7, 0x6b, 0xad, 0x06, 0x11, 0xc3, 0xbd, 0x54, 0x18, 0x2f, 0xa6, 0x93, 0x67, 0x85, 0xd1,
+	0x6e, 0x2a, 0x5a, 0xb2, 0xdb, 0x24, 0xc2, 0xdd, 0x1c, 0x6c, 0x9b, 0xd3, 0xbf, 0x03, 0x00, 0x1a,
+	0x1b, 0x61, 0x4e, 0xc5, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -392,6 +710,12 @@ type MsgClient interface {
 	RemoveChecksum(ctx context.Context, in *MsgRemoveChecksum, opts ...grpc.CallOption) (*MsgRemoveChecksumResponse, error)
 	// MigrateContract defines a rpc handler method for MsgMigrateContract.
 	MigrateContract(ctx context.Context, in *MsgMigrateContract, opts ...grpc.CallOption) (*MsgMigrateContractResponse, error)
+	// MigrateAllClients defines a rpc handler method for MsgMigrateAllClients.
+	MigrateAllClients(ctx context.Context, in *MsgMigrateAllClients, opts ...grpc.CallOption) (*MsgMigrateAllClientsResponse, error)
+	// PinChecksum defines a rpc handler method for MsgPinChecksum.
+	PinChecksum(ctx context.Context, in *MsgPinChecksum, opts ...grpc.CallOption) (*MsgPinChecksumResponse, error)
+	// UnpinChecksum defines a rpc handler method for MsgUnpinChecksum.
+	UnpinChecksum(ctx context.Context, in *MsgUnpinChecksum, opts ...grpc.CallOption) (*MsgUnpinChecksumResponse, error)
 }
 
 type msgClient struct {
@@ -429,6 +753,33 @@ func (c *msgClient) MigrateContract(ctx context.Context, in *MsgMigrateContract,
 	return out, nil
 }
 
+func (c *msgClient) MigrateAllClients(ctx context.Context, in *MsgMigrateAllClients, opts ...grpc.CallOption) (*MsgMigrateAllClientsResponse, error) {
+	out := new(MsgMigrateAllClientsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Msg/MigrateAllClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PinChecksum(ctx context.Context, in *MsgPinChecksum, opts ...grpc.CallOption) (*MsgPinChecksumResponse, error) {
+	out := new(MsgPinChecksumResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Msg/PinChecksum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpinChecksum(ctx context.Context, in *MsgUnpinChecksum, opts ...grpc.CallOption) (*MsgUnpinChecksumResponse, error) {
+	out := new(MsgUnpinChecksumResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Msg/UnpinChecksum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// StoreCode defines a rpc handler method for MsgStoreCode.
@@ -437,6 +788,12 @@ type MsgServer interface {
 	RemoveChecksum(context.Context, *MsgRemoveChecksum) (*MsgRemoveChecksumResponse, error)
 	// MigrateContract defines a rpc handler method for MsgMigrateContract.
 	MigrateContract(context.Context, *MsgMigrateContract) (*MsgMigrateContractResponse, error)
+	// MigrateAllClients defines a rpc handler method for MsgMigrateAllClients.
+	MigrateAllClients(context.Context, *MsgMigrateAllClients) (*MsgMigrateAllClientsResponse, error)
+	// PinChecksum defines a rpc handler method for MsgPinChecksum.
+	PinChecksum(context.Context, *MsgPinChecksum) (*MsgPinChecksumResponse, error)
+	// UnpinChecksum defines a rpc handler method for MsgUnpinChecksum.
+	UnpinChecksum(context.Context, *MsgUnpinChecksum) (*MsgUnpinChecksumResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -452,6 +809,15 @@ func (*UnimplementedMsgServer) RemoveChecksum(ctx context.Context, req *MsgRemov
 func (*UnimplementedMsgServer) MigrateContract(ctx context.Context, req *MsgMigrateContract) (*MsgMigrateContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MigrateContract not implemented")
 }
+func (*UnimplementedMsgServer) MigrateAllClients(ctx context.Context, req *MsgMigrateAllClients) (*MsgMigrateAllClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAllClients not implemented")
+}
+func (*UnimplementedMsgServer) PinChecksum(ctx context.Context, req *MsgPinChecksum) (*MsgPinChecksumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinChecksum not implemented")
+}
+func (*UnimplementedMsgServer) UnpinChecksum(ctx context.Context, req *MsgUnpinChecksum) (*MsgUnpinChecksumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinChecksum not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -511,6 +877,60 @@ func _Msg_MigrateContract_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateAllClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateAllClients)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateAllClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Msg/MigrateAllClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateAllClients(ctx, req.(*MsgMigrateAllClients))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PinChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPinChecksum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PinChecksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Msg/PinChecksum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PinChecksum(ctx, req.(*MsgPinChecksum))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpinChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpinChecksum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpinChecksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Msg/UnpinChecksum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpinChecksum(ctx, req.(*MsgUnpinChecksum))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.lightclients.wasm.v1.Msg",
@@ -528,6 +948,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MigrateContract",
 			Handler:    _Msg_MigrateContract_Handler,
 		},
+		{
+			MethodName: "MigrateAllClients",
+			Handler:    _Msg_MigrateAllClients_Handler,
+		},
+		{
+			MethodName: "PinChecksum",
+			Handler:    _Msg_PinChecksum_Handler,
+		},
+		{
+			MethodName: "UnpinChecksum",
+			Handler:    _Msg_UnpinChecksum_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/lightclients/wasm/v1/tx.proto",
@@ -734,8 +1166,211 @@ func (m *MsgMigrateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
+func (m *MsgMigrateAllClients) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateAllClients) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAllClients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewChecksum) > 0 {
+		i -= len(m.NewChecksum)
+		copy(dAtA[i:], m.NewChecksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewChecksum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateAllClientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateAllClientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAllClientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientIds) > 0 {
+		for iNdEx := len(m.ClientIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientIds[iNdEx])
+			copy(dAtA[i:], m.ClientIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ClientIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPinChecksum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPinChecksum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPinChecksum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPinChecksumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPinChecksumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPinChecksumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpinChecksum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpinChecksum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpinChecksum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpinChecksumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpinChecksumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpinChecksumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -823,25 +1458,715 @@ func (m *MsgMigrateContract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgMigrateContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateAllClients) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewChecksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateAllClientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClientIds) > 0 {
+		for _, s := range m.ClientIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPinChecksum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPinChecksumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpinChecksum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpinChecksumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WasmByteCode = append(m.WasmByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WasmByteCode == nil {
+				m.WasmByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChecksum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChecksum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChecksum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChecksumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChecksumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChecksumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgMigrateContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAllClients) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -864,10 +2189,10 @@ func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreCode: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateAllClients: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreCode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateAllClients: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -904,7 +2229,7 @@ func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WasmByteCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -931,9 +2256,77 @@ func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WasmByteCode = append(m.WasmByteCode[:0], dAtA[iNdEx:postIndex]...)
-			if m.WasmByteCode == nil {
-				m.WasmByteCode = []byte{}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChecksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewChecksum = append(m.NewChecksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewChecksum == nil {
+				m.NewChecksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -957,7 +2350,7 @@ func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAllClientsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -980,17 +2373,17 @@ func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateAllClientsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateAllClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1000,25 +2393,23 @@ func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
-			if m.Checksum == nil {
-				m.Checksum = []byte{}
-			}
+			m.ClientIds = append(m.ClientIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1041,7 +2432,7 @@ func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveChecksum) Unmarshal(dAtA []byte) error {
+func (m *MsgPinChecksum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1064,10 +2455,10 @@ func (m *MsgRemoveChecksum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveChecksum: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPinChecksum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveChecksum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPinChecksum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1157,7 +2548,7 @@ func (m *MsgRemoveChecksum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveChecksumResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPinChecksumResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1180,10 +2571,10 @@ func (m *MsgRemoveChecksumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveChecksumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPinChecksumResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveChecksumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPinChecksumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1207,7 +2598,7 @@ func (m *MsgRemoveChecksumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpinChecksum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1230,10 +2621,10 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpinChecksum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpinChecksum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1269,38 +2660,6 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
@@ -1334,40 +2693,6 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 				m.Checksum = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1389,7 +2714,7 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpinChecksumResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1412,10 +2737,10 @@ func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpinChecksumResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpinChecksumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3,16 +3,33 @@
 package attestations
 
 import (
+	"bytes"
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	errorsmod "cosmossdk.io/errors"
 )
 
 const (
 	nanosPerSecond = 1_000_000_000
+
+	// abiWordLength is the length of a single ABI-encoded word.
+	abiWordLength = 32
 )
 
 var (
+	// packetAttestationOffsetWord is the offset of the PacketAttestation tuple in the encoding
+	// without a revision number, abi.encode(attestation).
+	packetAttestationOffsetWord = common.LeftPadBytes([]byte{abiWordLength}, abiWordLength)
+
+	// StateAttestationRevisionTag is the leading word of the StateAttestation encoding with a revision number.
+	StateAttestationRevisionTag = crypto.Keccak256Hash([]byte("ibc-go/attestations/StateAttestation/revision"))
+	// StateRootAttestationRevisionTag is the leading word of the StateRootAttestation encoding with a revision number.
+	StateRootAttestationRevisionTag = crypto.Keccak256Hash([]byte("ibc-go/attestations/StateRootAttestation/revision"))
+
 	uint64Type, _  = abi.NewType("uint64", "", nil)
 	bytes32Type, _ = abi.NewType("bytes32", "", nil)
 	tupleArrayType = abi.Arguments{
@@ -25,6 +42,27 @@ var (
 		{Name: "timestamp", Type: uint64Type},
 	}
 
+	revisionStateAttestationArgs = abi.Arguments{
+		{Name: "tag", Type: bytes32Type},
+		{Name: "height", Type: uint64Type},
+		{Name: "timestamp", Type: uint64Type},
+		{Name: "revisionNumber", Type: uint64Type},
+	}
+
+	stateRootAttestationArgs = abi.Arguments{
+		{Name: "height", Type: uint64Type},
+		{Name: "timestamp", Type: uint64Type},
+		{Name: "root", Type: bytes32Type},
+	}
+
+	revisionStateRootAttestationArgs = abi.Arguments{
+		{Name: "tag", Type: bytes32Type},
+		{Name: "height", Type: uint64Type},
+		{Name: "timestamp", Type: uint64Type},
+		{Name: "root", Type: bytes32Type},
+		{Name: "revisionNumber", Type: uint64Type},
+	}
+
 	packetAttestationType, _ = abi.NewType("tuple", "PacketAttestation", []abi.ArgumentMarshaling{
 		{Name: "height", Type: "uint64"},
 		{Name: "packets", Type: "tuple[]", Components: []abi.ArgumentMarshaling{
@@ -36,6 +74,11 @@ var (
 	packetAttestationArgs = abi.Arguments{
 		{Name: "attestation", Type: packetAttestationType},
 	}
+
+	revisionPacketAttestationArgs = abi.Arguments{
+		{Name: "attestation", Type: packetAttestationType},
+		{Name: "revisionNumber", Type: uint64Type},
+	}
 )
 
 // ABIPacketCompact is the ABI-compatible representation with fixed-size arrays.
@@ -46,16 +89,33 @@ type ABIPacketCompact struct {
 
 // StateAttestation is used by client updates.
 // This type uses ABI encoding (not Protobuf) for cross-platform compatibility.
+// A non-zero revision number is encoded as abi.encode(StateAttestationRevisionTag, height, timestamp, revisionNumber),
+// so that attestations for revision 0 keep the encoding abi.encode(height, timestamp).
 type StateAttestation struct {
-	Height    uint64
-	Timestamp uint64
+	Height         uint64
+	Timestamp      uint64
+	RevisionNumber uint64
+}
+
+// StateRootAttestation is used by client updates of clients configured with a StateRootConfig.
+// This type uses ABI encoding (not Protobuf) for cross-platform compatibility.
+// A non-zero revision number is encoded as abi.encode(StateRootAttestationRevisionTag, height, timestamp, root,
+// revisionNumber), so that attestations for revision 0 keep the encoding abi.encode(height, timestamp, root).
+type StateRootAttestation struct {
+	Height         uint64
+	Timestamp      uint64
+	Root           []byte
+	RevisionNumber uint64
 }
 
 // PacketAttestation is used by membership queries.
 // This type uses ABI encoding (not Protobuf) for cross-platform compatibility.
+// A non-zero revision number is encoded as abi.encode(attestation, revisionNumber),
+// so that attestations for revision 0 keep the encoding abi.encode(attestation).
 type PacketAttestation struct {
-	Height  uint64
-	Packets []PacketCompact
+	Height         uint64
+	Packets        []PacketCompact
+	RevisionNumber uint64
 }
 
 // PacketCompact represents a packet commitment.
@@ -67,9 +127,20 @@ type PacketCompact struct {
 
 func (sa *StateAttestation) ABIEncode() ([]byte, error) {
 	timestampSeconds := sa.Timestamp / nanosPerSecond
+	if sa.RevisionNumber != 0 {
+		return revisionStateAttestationArgs.Pack(StateAttestationRevisionTag, sa.Height, timestampSeconds, sa.RevisionNumber)
+	}
 	return stateAttestationArgs.Pack(sa.Height, timestampSeconds)
 }
 
+func (sra *StateRootAttestation) ABIEncode() ([]byte, error) {
+	timestampSeconds := sra.Timestamp / nanosPerSecond
+	if sra.RevisionNumber != 0 {
+		return revisionStateRootAttestationArgs.Pack(StateRootAttestationRevisionTag, sra.Height, timestampSeconds, bytesToBytes32(sra.Root), sra.RevisionNumber)
+	}
+	return stateRootAttestationArgs.Pack(sra.Height, timestampSeconds, bytesToBytes32(sra.Root))
+}
+
 // ABIPacketAttestation is the ABI-compatible representation for tuple-wrapped encoding.
 type ABIPacketAttestation struct {
 	Height  uint64
@@ -89,18 +160,37 @@ func (pa *PacketAttestation) ABIEncode() ([]byte, error) {
 		Height:  pa.Height,
 		Packets: packets,
 	}
+	if pa.RevisionNumber != 0 {
+		return revisionPacketAttestationArgs.Pack(abiAttestation, pa.RevisionNumber)
+	}
 	return packetAttestationArgs.Pack(abiAttestation)
 }
 
 func ABIDecodePacketAttestation(data []byte) (*PacketAttestation, error) {
-	unpacked, err := packetAttestationArgs.Unpack(data)
+	// The encoding without a revision number always places the tuple directly after its offset word,
+	// any other offset indicates the trailing revision number word.
+	args, expFields := packetAttestationArgs, 1
+	if len(data) >= abiWordLength && !bytes.Equal(data[:abiWordLength], packetAttestationOffsetWord) {
+		args, expFields = revisionPacketAttestationArgs, 2
+	}
+
+	unpacked, err := args.Unpack(data)
 	if err != nil {
 		return nil, errorsmod.Wrapf(ErrInvalidAttestationData, "failed to ABI decode packet attestation: %v", err)
 	}
 
-	// Tuple-wrapped format: single element containing the struct
-	if len(unpacked) != 1 {
-		return nil, errorsmod.Wrap(ErrInvalidAttestationData, "invalid packet attestation: expected 1 tuple element")
+	// Tuple-wrapped format: the struct followed by the optional revision number
+	if len(unpacked) != expFields {
+		return nil, errorsmod.Wrapf(ErrInvalidAttestationData, "invalid packet attestation: expected %d elements", expFields)
+	}
+
+	var revisionNumber uint64
+	if expFields == 2 {
+		var ok bool
+		revisionNumber, ok = unpacked[1].(uint64)
+		if !ok {
+			return nil, errorsmod.Wrap(ErrInvalidAttestationData, "invalid revision number type")
+		}
 	}
 
 	//nolint:revive // go-ethereum returns anonymous struct, cannot use named type
@@ -124,8 +214,9 @@ func ABIDecodePacketAttestation(data []byte) (*PacketAttestation, error) {
 	}
 
 	return &PacketAttestation{
-		Height:  abiAttestation.Height,
-		Packets: packets,
+		Height:         abiAttestation.Height,
+		Packets:        packets,
+		RevisionNumber: revisionNumber,
 	}, nil
 }
 
@@ -134,13 +225,37 @@ func (pc *PacketCompact) ABIEncode() ([]byte, error) {
 }
 
 func ABIDecodeStateAttestation(data []byte) (*StateAttestation, error) {
-	unpacked, err := stateAttestationArgs.Unpack(data)
+	unpacked, err := unpackRevisionTagged(data, stateAttestationArgs, revisionStateAttestationArgs, StateAttestationRevisionTag)
 	if err != nil {
 		return nil, errorsmod.Wrapf(ErrInvalidAttestationData, "failed to ABI decode state attestation: %v", err)
 	}
 
-	if len(unpacked) != 2 {
-		return nil, errorsmod.Wrap(ErrInvalidAttestationData, "invalid state attestation: expected 2 fields")
+	height, ok := unpacked[0].(uint64)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrInvalidAttestationData, "invalid height type")
+	}
+
+	timestampSeconds, ok := unpacked[1].(uint64)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrInvalidAttestationData, "invalid timestamp type")
+	}
+
+	revisionNumber, err := decodeRevisionNumber(unpacked, len(stateAttestationArgs))
+	if err != nil {
+		return nil, err
+	}
+
+	return &StateAttestation{
+		Height:         height,
+		Timestamp:      timestampSeconds * nanosPerSecond,
+		RevisionNumber: revisionNumber,
+	}, nil
+}
+
+func ABIDecodeStateRootAttestation(data []byte) (*StateRootAttestation, error) {
+	unpacked, err := unpackRevisionTagged(data, stateRootAttestationArgs, revisionStateRootAttestationArgs, StateRootAttestationRevisionTag)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidAttestationData, "failed to ABI decode state root attestation: %v", err)
 	}
 
 	height, ok := unpacked[0].(uint64)
@@ -153,12 +268,69 @@ func ABIDecodeStateAttestation(data []byte) (*StateAttestation, error) {
 		return nil, errorsmod.Wrap(ErrInvalidAttestationData, "invalid timestamp type")
 	}
 
-	return &StateAttestation{
-		Height:    height,
-		Timestamp: timestampSeconds * nanosPerSecond,
+	root, ok := unpacked[2].([32]byte)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrInvalidAttestationData, "invalid root type")
+	}
+
+	revisionNumber, err := decodeRevisionNumber(unpacked, len(stateRootAttestationArgs))
+	if err != nil {
+		return nil, err
+	}
+
+	return &StateRootAttestation{
+		Height:         height,
+		Timestamp:      timestampSeconds * nanosPerSecond,
+		Root:           root[:],
+		RevisionNumber: revisionNumber,
 	}, nil
 }
 
+// unpackRevisionTagged unpacks the static ABI encoding of an attestation with the legacy arguments, or with the revision
+// arguments if the encoding starts with the provided revision tag. The revision tag is dropped from the returned values.
+// The encoding must have the exact length of the selected arguments, such that attestations of different types cannot
+// be decoded as one another.
+func unpackRevisionTagged(data []byte, legacyArgs, revisionArgs abi.Arguments, revisionTag common.Hash) ([]any, error) {
+	args := legacyArgs
+	if len(data) >= abiWordLength && bytes.Equal(data[:abiWordLength], revisionTag.Bytes()) {
+		args = revisionArgs
+	}
+
+	if len(data) != len(args)*abiWordLength {
+		return nil, fmt.Errorf("expected %d bytes, got %d", len(args)*abiWordLength, len(data))
+	}
+
+	unpacked, err := args.Unpack(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(unpacked) != len(args) {
+		return nil, fmt.Errorf("expected %d fields, got %d", len(args), len(unpacked))
+	}
+
+	if len(args) == len(revisionArgs) {
+		return unpacked[1:], nil
+	}
+
+	return unpacked, nil
+}
+
+// decodeRevisionNumber returns the trailing revision number of the unpacked values, or 0 if the
+// attestation was encoded without a revision number.
+func decodeRevisionNumber(unpacked []any, legacyFields int) (uint64, error) {
+	if len(unpacked) == legacyFields {
+		return 0, nil
+	}
+
+	revisionNumber, ok := unpacked[legacyFields].(uint64)
+	if !ok {
+		return 0, errorsmod.Wrap(ErrInvalidAttestationData, "invalid revision number type")
+	}
+
+	return revisionNumber, nil
+}
+
 func bytesToBytes32(b []byte) [32]byte {
 	var result [32]byte
 	copy(result[:], b)
@@ -46,6 +46,29 @@ func TestABIEncodeDecodeStateAttestation(t *testing.T) {
 	}
 }
 
+func TestABIEncodeDecodeStateRootAttestation(t *testing.T) {
+	root := bytes.Repeat([]byte{0xab}, 32)
+
+	original := &attestations.StateRootAttestation{
+		Height:    100,
+		Timestamp: 1234567890 * nanosPerSecond,
+		Root:      root,
+	}
+
+	encoded, err := original.ABIEncode()
+	require.NoError(t, err)
+	require.Len(t, encoded, 96, "ABI encoding should produce 96 bytes (3x32-byte words)")
+
+	decoded, err := attestations.ABIDecodeStateRootAttestation(encoded)
+	require.NoError(t, err)
+	require.Equal(t, original.Height, decoded.Height)
+	require.Equal(t, original.Timestamp, decoded.Timestamp)
+	require.Equal(t, root, decoded.Root)
+
+	_, err = attestations.ABIDecodeStateRootAttestation(encoded[:64])
+	require.Error(t, err)
+}
+
 func TestABIEncodeDecodePacketAttestation(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -105,6 +128,87 @@ func TestABIEncodeDecodePacketAttestation(t *testing.T) {
 	}
 }
 
+func TestABIEncodeDecodeRevisionNumber(t *testing.T) {
+	root := bytes.Repeat([]byte{0xab}, 32)
+	packets := []attestations.PacketCompact{
+		{Path: bytes.Repeat([]byte{0x01}, 32), Commitment: bytes.Repeat([]byte{0x02}, 32)},
+	}
+
+	testCases := []struct {
+		name           string
+		revisionNumber uint64
+		expStateLen    int
+		expStateRoot   int
+	}{
+		{name: "revision 0 keeps the legacy encoding", revisionNumber: 0, expStateLen: 64, expStateRoot: 96},
+		{name: "non-zero revision is tagged", revisionNumber: 3, expStateLen: 128, expStateRoot: 160},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stateAttestation := &attestations.StateAttestation{Height: 100, Timestamp: 10 * nanosPerSecond, RevisionNumber: tc.revisionNumber}
+			encoded, err := stateAttestation.ABIEncode()
+			require.NoError(t, err)
+			require.Len(t, encoded, tc.expStateLen)
+
+			decodedState, err := attestations.ABIDecodeStateAttestation(encoded)
+			require.NoError(t, err)
+			require.Equal(t, stateAttestation, decodedState)
+
+			stateRootAttestation := &attestations.StateRootAttestation{Height: 100, Timestamp: 10 * nanosPerSecond, Root: root, RevisionNumber: tc.revisionNumber}
+			encoded, err = stateRootAttestation.ABIEncode()
+			require.NoError(t, err)
+			require.Len(t, encoded, tc.expStateRoot)
+
+			decodedStateRoot, err := attestations.ABIDecodeStateRootAttestation(encoded)
+			require.NoError(t, err)
+			require.Equal(t, stateRootAttestation, decodedStateRoot)
+
+			packetAttestation := &attestations.PacketAttestation{Height: 100, Packets: packets, RevisionNumber: tc.revisionNumber}
+			encoded, err = packetAttestation.ABIEncode()
+			require.NoError(t, err)
+
+			decodedPacket, err := attestations.ABIDecodePacketAttestation(encoded)
+			require.NoError(t, err)
+			require.Equal(t, packetAttestation, decodedPacket)
+		})
+	}
+}
+
+func TestABIDecodeStateAttestationTypes(t *testing.T) {
+	root := bytes.Repeat([]byte{0xab}, 32)
+
+	// a revision 0 state root attestation has the length of a state attestation of a non-zero revision
+	stateRootAttestation := &attestations.StateRootAttestation{Height: 100, Timestamp: 10 * nanosPerSecond, Root: root}
+	encodedStateRoot, err := stateRootAttestation.ABIEncode()
+	require.NoError(t, err)
+
+	_, err = attestations.ABIDecodeStateAttestation(encodedStateRoot)
+	require.ErrorIs(t, err, attestations.ErrInvalidAttestationData)
+
+	stateRootAttestation.RevisionNumber = 3
+	encodedStateRoot, err = stateRootAttestation.ABIEncode()
+	require.NoError(t, err)
+
+	_, err = attestations.ABIDecodeStateAttestation(encodedStateRoot)
+	require.ErrorIs(t, err, attestations.ErrInvalidAttestationData)
+
+	stateAttestation := &attestations.StateAttestation{Height: 100, Timestamp: 10 * nanosPerSecond, RevisionNumber: 3}
+	encodedState, err := stateAttestation.ABIEncode()
+	require.NoError(t, err)
+
+	_, err = attestations.ABIDecodeStateRootAttestation(encodedState)
+	require.ErrorIs(t, err, attestations.ErrInvalidAttestationData)
+
+	// trailing data is rejected
+	stateAttestation.RevisionNumber = 0
+	encodedState, err = stateAttestation.ABIEncode()
+	require.NoError(t, err)
+
+	_, err = attestations.ABIDecodeStateAttestation(append(encodedState, make([]byte, 32)...))
+	require.ErrorIs(t, err, attestations.ErrInvalidAttestationData)
+}
+
 func TestABISolidityCompatibility(t *testing.T) {
 	// Test round-trip encoding compatibility
 	t.Run("StateAttestation round-trip", func(t *testing.T) {
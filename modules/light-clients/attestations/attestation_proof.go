@@ -17,8 +17,8 @@ func (AttestationProof) ClientType() string {
 }
 
 // ValidateBasic ensures that the attestation data and signatures are initialized.
-// Attestation data can be either a StateAttestation (for client updates) or
-// a PacketAttestation (for packet membership/non-membership proofs).
+// Attestation data can be either a StateAttestation or StateRootAttestation (for client updates)
+// or a PacketAttestation (for packet membership/non-membership proofs).
 func (ap AttestationProof) ValidateBasic() error {
 	if len(ap.AttestationData) == 0 {
 		return errorsmod.Wrap(clienttypes.ErrInvalidHeader, "attestation data cannot be empty")
@@ -31,8 +31,10 @@ func (ap AttestationProof) ValidateBasic() error {
 			return errorsmod.Wrap(clienttypes.ErrInvalidHeader, "packets cannot be empty")
 		}
 	} else {
-		// If that fails, try to decode as StateAttestation (used for client updates)
-		if _, stateErr := ABIDecodeStateAttestation(ap.AttestationData); stateErr != nil {
+		// If that fails, try to decode as StateAttestation or StateRootAttestation (used for client updates)
+		_, stateErr := ABIDecodeStateAttestation(ap.AttestationData)
+		_, stateRootErr := ABIDecodeStateRootAttestation(ap.AttestationData)
+		if stateErr != nil && stateRootErr != nil {
 			return errorsmod.Wrap(clienttypes.ErrInvalidHeader, "attestation data must be a valid StateAttestation or PacketAttestation")
 		}
 	}
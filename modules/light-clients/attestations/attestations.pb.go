@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	_go "github.com/cosmos/ics23/go"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -23,6 +25,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProofType defines the proof format used to verify membership against an
+// attested state root.
+type ProofType int32
+
+const (
+	// Default zero value enumeration
+	UNSPECIFIED ProofType = 0
+	// ICS-23 chained commitment proofs (e.g. IAVL/tendermint)
+	ICS23 ProofType = 1
+	// Ethereum account and storage Merkle-Patricia trie proofs
+	EVM_STORAGE ProofType = 2
+)
+
+var ProofType_name = map[int32]string{
+	0: "PROOF_TYPE_UNSPECIFIED",
+	1: "PROOF_TYPE_ICS23",
+	2: "PROOF_TYPE_EVM_STORAGE",
+}
+
+var ProofType_value = map[string]int32{
+	"PROOF_TYPE_UNSPECIFIED": 0,
+	"PROOF_TYPE_ICS23":       1,
+	"PROOF_TYPE_EVM_STORAGE": 2,
+}
+
+func (x ProofType) String() string {
+	return proto.EnumName(ProofType_name, int32(x))
+}
+
+func (ProofType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4c60154e5a577f40, []int{0}
+}
+
 // ClientState defines an attestor-based light client that tracks the current
 // consensus state and if the client is frozen.
 type ClientState struct {
@@ -30,10 +65,18 @@ type ClientState struct {
 	AttestorAddresses []string `protobuf:"bytes,1,rep,name=attestor_addresses,json=attestorAddresses,proto3" json:"attestor_addresses,omitempty"`
 	// quorum threshold (minimum number of unique attestor signatures required)
 	MinRequiredSigs uint32 `protobuf:"varint,2,opt,name=min_required_sigs,json=minRequiredSigs,proto3" json:"min_required_sigs,omitempty"`
-	// highest height that has been trusted
-	LatestHeight uint64 `protobuf:"varint,3,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height,omitempty"`
+	// highest revision height that has been trusted by clients created before
+	// revision number support. Deprecated: superseded by latest_height, client
+	// states are migrated by clearing this field.
+	LegacyLatestHeight uint64 `protobuf:"varint,3,opt,name=legacy_latest_height,json=legacyLatestHeight,proto3" json:"legacy_latest_height,omitempty"` // Deprecated: Do not use.
 	// when true, all verification and updates MUST fail
 	IsFrozen bool `protobuf:"varint,4,opt,name=is_frozen,json=isFrozen,proto3" json:"is_frozen,omitempty"`
+	// optional state root configuration. When set, attestors sign a counterparty
+	// state root per height and membership proofs are verified against that root
+	// instead of against packet attestations.
+	StateRootConfig *StateRootConfig `protobuf:"bytes,5,opt,name=state_root_config,json=stateRootConfig,proto3" json:"state_root_config,omitempty"`
+	// highest height that has been trusted
+	LatestHeight types.Height `protobuf:"bytes,6,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -69,18 +112,69 @@ func (m *ClientState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClientState proto.InternalMessageInfo
 
+// StateRootConfig defines how membership proofs are verified against attested
+// state roots.
+type StateRootConfig struct {
+	// proof format used against the attested state root
+	ProofType ProofType `protobuf:"varint,1,opt,name=proof_type,json=proofType,proto3,enum=ibc.lightclients.attestations.v1.ProofType" json:"proof_type,omitempty"`
+	// proof specifications used in verifying ICS-23 proofs
+	ProofSpecs []*_go.ProofSpec `protobuf:"bytes,2,rep,name=proof_specs,json=proofSpecs,proto3" json:"proof_specs,omitempty"`
+	// address of the counterparty contract holding the IBC commitments, used for
+	// EVM storage proofs
+	IbcContractAddress string `protobuf:"bytes,3,opt,name=ibc_contract_address,json=ibcContractAddress,proto3" json:"ibc_contract_address,omitempty"`
+	// 32-byte storage slot of the commitments mapping in the IBC contract, used
+	// for EVM storage proofs
+	CommitmentsSlot []byte `protobuf:"bytes,4,opt,name=commitments_slot,json=commitmentsSlot,proto3" json:"commitments_slot,omitempty"`
+}
+
+func (m *StateRootConfig) Reset()         { *m = StateRootConfig{} }
+func (m *StateRootConfig) String() string { return proto.CompactTextString(m) }
+func (*StateRootConfig) ProtoMessage()    {}
+func (*StateRootConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c60154e5a577f40, []int{1}
+}
+func (m *StateRootConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateRootConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateRootConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateRootConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateRootConfig.Merge(m, src)
+}
+func (m *StateRootConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateRootConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateRootConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateRootConfig proto.InternalMessageInfo
+
 // ConsensusState defines an attestor consensus state. The timestamp of a
 // consensus state is stored per height.
 type ConsensusState struct {
 	// trusted UNIX timestamp (nanoseconds) for the height
 	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// attested counterparty state root for the height, only set when the client
+	// is configured with a StateRootConfig
+	Root []byte `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
 }
 
 func (m *ConsensusState) Reset()         { *m = ConsensusState{} }
 func (m *ConsensusState) String() string { return proto.CompactTextString(m) }
 func (*ConsensusState) ProtoMessage()    {}
 func (*ConsensusState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c60154e5a577f40, []int{1}
+	return fileDescriptor_4c60154e5a577f40, []int{2}
 }
 func (m *ConsensusState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -122,7 +216,7 @@ func (m *AttestationProof) Reset()         { *m = AttestationProof{} }
 func (m *AttestationProof) String() string { return proto.CompactTextString(m) }
 func (*AttestationProof) ProtoMessage()    {}
 func (*AttestationProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c60154e5a577f40, []int{2}
+	return fileDescriptor_4c60154e5a577f40, []int{3}
 }
 func (m *AttestationProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,10 +245,56 @@ func (m *AttestationProof) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AttestationProof proto.InternalMessageInfo
 
+// EvmStorageProof is the proof used for membership verification against an
+// attested Ethereum state root.
+type EvmStorageProof struct {
+	// RLP-encoded trie nodes proving the IBC contract account in the state trie
+	AccountProof [][]byte `protobuf:"bytes,1,rep,name=account_proof,json=accountProof,proto3" json:"account_proof,omitempty"`
+	// RLP-encoded trie nodes proving the commitment slot in the account storage
+	// trie
+	StorageProof [][]byte `protobuf:"bytes,2,rep,name=storage_proof,json=storageProof,proto3" json:"storage_proof,omitempty"`
+}
+
+func (m *EvmStorageProof) Reset()         { *m = EvmStorageProof{} }
+func (m *EvmStorageProof) String() string { return proto.CompactTextString(m) }
+func (*EvmStorageProof) ProtoMessage()    {}
+func (*EvmStorageProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c60154e5a577f40, []int{4}
+}
+func (m *EvmStorageProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmStorageProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmStorageProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmStorageProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmStorageProof.Merge(m, src)
+}
+func (m *EvmStorageProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmStorageProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmStorageProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmStorageProof proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("ibc.lightclients.attestations.v1.ProofType", ProofType_name, ProofType_value)
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.attestations.v1.ClientState")
+	proto.RegisterType((*StateRootConfig)(nil), "ibc.lightclients.attestations.v1.StateRootConfig")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.attestations.v1.ConsensusState")
 	proto.RegisterType((*AttestationProof)(nil), "ibc.lightclients.attestations.v1.AttestationProof")
+	proto.RegisterType((*EvmStorageProof)(nil), "ibc.lightclients.attestations.v1.EvmStorageProof")
 }
 
 func init() {
@@ -162,32 +302,54 @@ func init() {
 }
 
 var fileDescriptor_4c60154e5a577f40 = []byte{
-	// 386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x8e, 0xd3, 0x40,
-	0x10, 0x86, 0xbd, 0x24, 0x42, 0x97, 0x25, 0xc7, 0xdd, 0xad, 0x28, 0x2c, 0x40, 0xc6, 0x3a, 0x1a,
-	0x83, 0x14, 0xaf, 0xac, 0xa3, 0x82, 0xea, 0x38, 0x84, 0x28, 0x28, 0x90, 0xd3, 0xd1, 0x58, 0x6b,
-	0x7b, 0xb3, 0x19, 0xc9, 0xde, 0x0d, 0x3b, 0xeb, 0x14, 0x3c, 0x01, 0x25, 0x8f, 0xc0, 0x23, 0xf0,
-	0x18, 0x94, 0x29, 0x29, 0x51, 0xf2, 0x22, 0xc8, 0x76, 0xa2, 0x38, 0xd2, 0x75, 0x3b, 0xff, 0xcc,
-	0x37, 0x3b, 0x33, 0x3f, 0xbd, 0x81, 0xbc, 0xe0, 0x15, 0xa8, 0xa5, 0x2b, 0x2a, 0x90, 0xda, 0x21,
-	0x17, 0xce, 0x49, 0x74, 0xc2, 0x81, 0xd1, 0xc8, 0xd7, 0xc9, 0x49, 0x1c, 0xaf, 0xac, 0x71, 0x86,
-	0x85, 0x90, 0x17, 0xf1, 0x10, 0x8a, 0x4f, 0x8a, 0xd6, 0xc9, 0xd3, 0x27, 0xca, 0x28, 0xd3, 0x15,
-	0xf3, 0xf6, 0xd5, 0x73, 0xd7, 0xbf, 0x09, 0x7d, 0x74, 0xd7, 0x11, 0x73, 0x27, 0x9c, 0x64, 0x33,
-	0xca, 0x7a, 0xd0, 0xd8, 0x4c, 0x94, 0xa5, 0x95, 0x88, 0x12, 0x7d, 0x12, 0x8e, 0xa2, 0x49, 0x7a,
-	0x75, 0xc8, 0xdc, 0x1e, 0x12, 0xec, 0x35, 0xbd, 0xaa, 0x41, 0x67, 0x56, 0x7e, 0x6b, 0xc0, 0xca,
-	0x32, 0x43, 0x50, 0xe8, 0x3f, 0x08, 0x49, 0x74, 0x9e, 0x5e, 0xd4, 0xa0, 0xd3, 0xbd, 0x3e, 0x07,
-	0x85, 0xec, 0x25, 0x3d, 0xaf, 0x44, 0xdb, 0x20, 0x5b, 0xca, 0x76, 0x50, 0x7f, 0x14, 0x92, 0x68,
-	0x9c, 0x4e, 0x7b, 0xf1, 0x53, 0xa7, 0xb1, 0x67, 0x74, 0x02, 0x98, 0x2d, 0xac, 0xf9, 0x2e, 0xb5,
-	0x3f, 0x0e, 0x49, 0x74, 0x96, 0x9e, 0x01, 0x7e, 0xec, 0xe2, 0xb7, 0xe3, 0x1f, 0xbf, 0x5e, 0x78,
-	0xd7, 0x6f, 0xe8, 0xe3, 0x3b, 0xa3, 0x51, 0x6a, 0x6c, 0xb0, 0x1f, 0xfa, 0x39, 0x9d, 0x38, 0xa8,
-	0xdb, 0x6d, 0xeb, 0x95, 0x4f, 0xba, 0xae, 0x47, 0x61, 0x4f, 0x15, 0xf4, 0xf2, 0xf6, 0x78, 0x91,
-	0x2f, 0xd6, 0x98, 0x05, 0x7b, 0x45, 0x2f, 0x07, 0x57, 0xca, 0x4a, 0xe1, 0x44, 0x87, 0x4f, 0xd3,
-	0x8b, 0x81, 0xfe, 0x41, 0x38, 0xc1, 0x02, 0x4a, 0x11, 0x94, 0x16, 0xae, 0xb1, 0xb2, 0xdd, 0x70,
-	0x14, 0x4d, 0xd3, 0x81, 0xd2, 0x7f, 0xf2, 0x7e, 0xf1, 0x67, 0x1b, 0x90, 0xcd, 0x36, 0x20, 0xff,
-	0xb6, 0x01, 0xf9, 0xb9, 0x0b, 0xbc, 0xcd, 0x2e, 0xf0, 0xfe, 0xee, 0x02, 0xef, 0xeb, 0x67, 0x05,
-	0x6e, 0xd9, 0xe4, 0x71, 0x61, 0x6a, 0x5e, 0x18, 0xac, 0x0d, 0x72, 0xc8, 0x8b, 0x99, 0x32, 0x7c,
-	0x9d, 0x24, 0xbc, 0x36, 0x65, 0x53, 0x49, 0xec, 0x5d, 0x9f, 0xdd, 0x67, 0xfb, 0xbb, 0x61, 0x90,
-	0x3f, 0xec, 0xcc, 0xbb, 0xf9, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xef, 0xaa, 0x6d, 0xb2, 0x2b, 0x02,
-	0x00, 0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0x8e, 0x43, 0x40, 0x64, 0x62, 0x48, 0x18, 0xa1, 0x55, 0x94, 0x45, 0x8e, 0xc5, 0x5e, 0xb2,
+	0x20, 0xec, 0x4d, 0xb2, 0xa7, 0xe5, 0x04, 0x21, 0x2c, 0xac, 0xd8, 0x12, 0x8d, 0x69, 0xa5, 0x56,
+	0xaa, 0x2c, 0x67, 0x32, 0x31, 0x23, 0xd9, 0x1e, 0xd7, 0x33, 0x89, 0x44, 0x7f, 0x01, 0x42, 0x3d,
+	0xf4, 0x0f, 0x20, 0x55, 0xea, 0x9f, 0xe1, 0xc8, 0xb1, 0xa7, 0xaa, 0x82, 0x43, 0x7f, 0x45, 0xa5,
+	0xca, 0x33, 0x0e, 0x38, 0xa8, 0x12, 0xb7, 0x37, 0xdf, 0x7b, 0xef, 0x7b, 0xef, 0x7d, 0x6f, 0x66,
+	0x40, 0x97, 0x0e, 0xb1, 0x1d, 0x50, 0xff, 0x5c, 0xe0, 0x80, 0x92, 0x48, 0x70, 0xdb, 0x13, 0x82,
+	0x70, 0xe1, 0x09, 0xca, 0x22, 0x6e, 0x4f, 0xdb, 0x73, 0x67, 0x2b, 0x4e, 0x98, 0x60, 0xd0, 0xa4,
+	0x43, 0x6c, 0xe5, 0x93, 0xac, 0xb9, 0xa0, 0x69, 0xbb, 0xb1, 0xee, 0x33, 0x9f, 0xc9, 0x60, 0x3b,
+	0xb5, 0x54, 0x5e, 0x63, 0x03, 0x33, 0x1e, 0x32, 0x6e, 0x53, 0xcc, 0x3b, 0xdd, 0x94, 0x3b, 0x4e,
+	0x18, 0x1b, 0x67, 0xac, 0x8d, 0x66, 0xda, 0x0a, 0x66, 0x09, 0xb1, 0x15, 0x6b, 0x1a, 0xa0, 0x2c,
+	0x15, 0xb0, 0xf9, 0xbd, 0x08, 0x2a, 0x3d, 0x09, 0x38, 0xc2, 0x13, 0x04, 0xee, 0x00, 0xa8, 0xea,
+	0xb2, 0xc4, 0xf5, 0x46, 0xa3, 0x84, 0x70, 0x4e, 0x78, 0x5d, 0x33, 0x17, 0x5a, 0x65, 0xb4, 0x36,
+	0xf3, 0xec, 0xcd, 0x1c, 0x70, 0x0b, 0xac, 0x85, 0x34, 0x72, 0x13, 0xf2, 0x6e, 0x42, 0x13, 0x32,
+	0x72, 0x39, 0xf5, 0x79, 0xbd, 0x68, 0x6a, 0xad, 0x15, 0x54, 0x0d, 0x69, 0x84, 0x32, 0xdc, 0xa1,
+	0x3e, 0x87, 0x7f, 0x83, 0xf5, 0x80, 0xf8, 0x1e, 0xbe, 0x70, 0x03, 0x2f, 0xe5, 0x71, 0xcf, 0x49,
+	0x3a, 0x6e, 0x7d, 0xc1, 0xd4, 0x5a, 0xa5, 0xfd, 0x62, 0x5d, 0x43, 0x50, 0xf9, 0x4f, 0xa4, 0xfb,
+	0x48, 0x7a, 0xe1, 0xef, 0xa0, 0x4c, 0xb9, 0x3b, 0x4e, 0xd8, 0x7b, 0x12, 0xd5, 0x4b, 0xa6, 0xd6,
+	0x5a, 0x46, 0xcb, 0x94, 0x1f, 0xca, 0x33, 0x7c, 0x0b, 0xd6, 0x52, 0x85, 0x88, 0x9b, 0x30, 0x26,
+	0x5c, 0xcc, 0xa2, 0x31, 0xf5, 0xeb, 0x8b, 0xa6, 0xd6, 0xaa, 0x74, 0xda, 0xd6, 0x73, 0x82, 0x5a,
+	0x72, 0x62, 0xc4, 0x98, 0xe8, 0xc9, 0x44, 0x54, 0xe5, 0xf3, 0x00, 0xec, 0x83, 0x95, 0xf9, 0x56,
+	0x97, 0x24, 0x75, 0x43, 0x52, 0xa7, 0xaa, 0x5a, 0x99, 0x96, 0xd3, 0xb6, 0xa5, 0xda, 0xdd, 0x2f,
+	0xdd, 0x7c, 0x6d, 0x16, 0x90, 0x1e, 0xe4, 0x46, 0xf8, 0xa7, 0x74, 0xf9, 0xa9, 0x59, 0xd8, 0xfc,
+	0xa1, 0x81, 0xea, 0x93, 0x8a, 0xf0, 0x3f, 0x00, 0xe4, 0xba, 0x5c, 0x71, 0x11, 0x93, 0xba, 0x66,
+	0x6a, 0xad, 0xd5, 0xce, 0xf6, 0xf3, 0x8d, 0x0f, 0xd2, 0x9c, 0xb3, 0x8b, 0x98, 0xa0, 0x72, 0x3c,
+	0x33, 0xe1, 0x2e, 0xa8, 0x28, 0x2e, 0x1e, 0x13, 0x9c, 0x2e, 0x61, 0x41, 0xb6, 0xaa, 0xae, 0x87,
+	0x25, 0xaf, 0xc7, 0x43, 0xae, 0x13, 0x13, 0x8c, 0x40, 0x3c, 0x33, 0x39, 0xfc, 0x0b, 0xac, 0xd3,
+	0x21, 0x4e, 0x15, 0x14, 0x89, 0x87, 0xc5, 0x6c, 0xf5, 0x72, 0x37, 0x65, 0x04, 0xe9, 0x10, 0xf7,
+	0x32, 0x57, 0xb6, 0x7b, 0xf8, 0x27, 0xa8, 0x61, 0x16, 0x86, 0x54, 0x84, 0x69, 0x8b, 0x2e, 0x0f,
+	0x98, 0x90, 0xeb, 0xd1, 0x51, 0x35, 0x87, 0x3b, 0x01, 0x9b, 0xcd, 0x7f, 0x04, 0x56, 0x7b, 0x2c,
+	0xe2, 0x24, 0xe2, 0x13, 0xae, 0xee, 0xda, 0x06, 0x28, 0x0b, 0x1a, 0xa6, 0x93, 0x85, 0xb1, 0x1c,
+	0xbe, 0x84, 0x1e, 0x01, 0x08, 0x41, 0x29, 0xdd, 0xaa, 0xbc, 0x4d, 0x3a, 0x92, 0x76, 0xc6, 0x84,
+	0x41, 0x6d, 0xef, 0x51, 0x11, 0x39, 0x50, 0xda, 0x4e, 0x4e, 0x25, 0x77, 0xe4, 0x09, 0x4f, 0x52,
+	0xea, 0xa8, 0x9a, 0xc3, 0x0f, 0x3c, 0xe1, 0x41, 0x03, 0x00, 0x4e, 0xfd, 0xc8, 0x13, 0x93, 0x84,
+	0x28, 0x9d, 0x74, 0x94, 0x43, 0xb2, 0x22, 0x1e, 0xa8, 0xf6, 0xa7, 0xa1, 0x23, 0x58, 0xe2, 0xf9,
+	0x44, 0xd5, 0xf8, 0x03, 0xac, 0x78, 0x18, 0xb3, 0x49, 0x24, 0x5c, 0x29, 0x9d, 0x7c, 0x16, 0x3a,
+	0xd2, 0x33, 0xf0, 0x21, 0x88, 0xab, 0xa4, 0x2c, 0x48, 0x15, 0xd0, 0x79, 0x8e, 0x49, 0x95, 0xd8,
+	0xfa, 0xa0, 0x81, 0xf2, 0xc3, 0x2a, 0xe1, 0x36, 0xf8, 0x6d, 0x80, 0x4e, 0x4f, 0x0f, 0xdd, 0xb3,
+	0xd7, 0x83, 0xbe, 0xfb, 0xf2, 0x85, 0x33, 0xe8, 0xf7, 0x8e, 0x0f, 0x8f, 0xfb, 0x07, 0xb5, 0x42,
+	0xa3, 0x7a, 0x75, 0x6d, 0x56, 0x72, 0x10, 0x6c, 0x82, 0x5a, 0x2e, 0xf8, 0xb8, 0xe7, 0x74, 0xba,
+	0x35, 0xad, 0x51, 0xbe, 0xba, 0x36, 0x17, 0xe5, 0xe1, 0x09, 0x5b, 0xff, 0xd5, 0xff, 0xae, 0x73,
+	0x76, 0x8a, 0xf6, 0xfe, 0xed, 0xd7, 0x8a, 0x8a, 0x2d, 0x07, 0x35, 0x4a, 0x97, 0x9f, 0x8d, 0xc2,
+	0xfe, 0xf8, 0xe6, 0xce, 0xd0, 0x6e, 0xef, 0x0c, 0xed, 0xdb, 0x9d, 0xa1, 0x7d, 0xbc, 0x37, 0x0a,
+	0xb7, 0xf7, 0x46, 0xe1, 0xcb, 0xbd, 0x51, 0x78, 0x73, 0xe2, 0x53, 0x71, 0x3e, 0x19, 0x5a, 0x98,
+	0x85, 0xf6, 0xec, 0xbb, 0x19, 0xe2, 0x1d, 0x9f, 0xd9, 0xd3, 0x76, 0xdb, 0x0e, 0xd9, 0x68, 0x12,
+	0x10, 0xae, 0x7e, 0xbc, 0x9d, 0x5f, 0x7d, 0x79, 0xbb, 0xf9, 0xc3, 0x70, 0x49, 0xfe, 0x3c, 0xdd,
+	0x9f, 0x03, 0x00, 0xdd, 0x7c, 0x97, 0x60, 0x27, 0x05, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -210,6 +372,28 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.LatestHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttestations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.StateRootConfig != nil {
+		{
+			size, err := m.StateRootConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAttestations(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.IsFrozen {
 		i--
 		if m.IsFrozen {
@@ -220,8 +404,8 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.LatestHeight != 0 {
-		i = encodeVarintAttestations(dAtA, i, uint64(m.LatestHeight))
+	if m.LegacyLatestHeight != 0 {
+		i = encodeVarintAttestations(dAtA, i, uint64(m.LegacyLatestHeight))
 		i--
 		dAtA[i] = 0x18
 	}
@@ -242,6 +426,62 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StateRootConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateRootConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateRootConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommitmentsSlot) > 0 {
+		i -= len(m.CommitmentsSlot)
+		copy(dAtA[i:], m.CommitmentsSlot)
+		i = encodeVarintAttestations(dAtA, i, uint64(len(m.CommitmentsSlot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IbcContractAddress) > 0 {
+		i -= len(m.IbcContractAddress)
+		copy(dAtA[i:], m.IbcContractAddress)
+		i = encodeVarintAttestations(dAtA, i, uint64(len(m.IbcContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProofSpecs) > 0 {
+		for iNdEx := len(m.ProofSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttestations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ProofType != 0 {
+		i = encodeVarintAttestations(dAtA, i, uint64(m.ProofType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConsensusState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -262,6 +502,13 @@ func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintAttestations(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintAttestations(dAtA, i, uint64(m.Timestamp))
 		i--
@@ -309,6 +556,47 @@ func (m *AttestationProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EvmStorageProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmStorageProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmStorageProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageProof) > 0 {
+		for iNdEx := len(m.StorageProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StorageProof[iNdEx])
+			copy(dAtA[i:], m.StorageProof[iNdEx])
+			i = encodeVarintAttestations(dAtA, i, uint64(len(m.StorageProof[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AccountProof) > 0 {
+		for iNdEx := len(m.AccountProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AccountProof[iNdEx])
+			copy(dAtA[i:], m.AccountProof[iNdEx])
+			i = encodeVarintAttestations(dAtA, i, uint64(len(m.AccountProof[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAttestations(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAttestations(v)
 	base := offset
@@ -335,12 +623,44 @@ func (m *ClientState) Size() (n int) {
 	if m.MinRequiredSigs != 0 {
 		n += 1 + sovAttestations(uint64(m.MinRequiredSigs))
 	}
-	if m.LatestHeight != 0 {
-		n += 1 + sovAttestations(uint64(m.LatestHeight))
+	if m.LegacyLatestHeight != 0 {
+		n += 1 + sovAttestations(uint64(m.LegacyLatestHeight))
 	}
 	if m.IsFrozen {
 		n += 2
 	}
+	if m.StateRootConfig != nil {
+		l = m.StateRootConfig.Size()
+		n += 1 + l + sovAttestations(uint64(l))
+	}
+	l = m.LatestHeight.Size()
+	n += 1 + l + sovAttestations(uint64(l))
+	return n
+}
+
+func (m *StateRootConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProofType != 0 {
+		n += 1 + sovAttestations(uint64(m.ProofType))
+	}
+	if len(m.ProofSpecs) > 0 {
+		for _, e := range m.ProofSpecs {
+			l = e.Size()
+			n += 1 + l + sovAttestations(uint64(l))
+		}
+	}
+	l = len(m.IbcContractAddress)
+	if l > 0 {
+		n += 1 + l + sovAttestations(uint64(l))
+	}
+	l = len(m.CommitmentsSlot)
+	if l > 0 {
+		n += 1 + l + sovAttestations(uint64(l))
+	}
 	return n
 }
 
@@ -353,6 +673,10 @@ func (m *ConsensusState) Size() (n int) {
 	if m.Timestamp != 0 {
 		n += 1 + sovAttestations(uint64(m.Timestamp))
 	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovAttestations(uint64(l))
+	}
 	return n
 }
 
@@ -375,6 +699,27 @@ func (m *AttestationProof) Size() (n int) {
 	return n
 }
 
+func (m *EvmStorageProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountProof) > 0 {
+		for _, b := range m.AccountProof {
+			l = len(b)
+			n += 1 + l + sovAttestations(uint64(l))
+		}
+	}
+	if len(m.StorageProof) > 0 {
+		for _, b := range m.StorageProof {
+			l = len(b)
+			n += 1 + l + sovAttestations(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovAttestations(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -463,9 +808,9 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyLatestHeight", wireType)
 			}
-			m.LatestHeight = 0
+			m.LegacyLatestHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttestations
@@ -475,7 +820,7 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestHeight |= uint64(b&0x7F) << shift
+				m.LegacyLatestHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -500,6 +845,244 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsFrozen = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRootConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StateRootConfig == nil {
+				m.StateRootConfig = &StateRootConfig{}
+			}
+			if err := m.StateRootConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateRootConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateRootConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateRootConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofType", wireType)
+			}
+			m.ProofType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofType |= ProofType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSpecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofSpecs = append(m.ProofSpecs, &_go.ProofSpec{})
+			if err := m.ProofSpecs[len(m.ProofSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentsSlot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitmentsSlot = append(m.CommitmentsSlot[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommitmentsSlot == nil {
+				m.CommitmentsSlot = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestations(dAtA[iNdEx:])
@@ -569,6 +1152,40 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestations(dAtA[iNdEx:])
@@ -706,6 +1323,120 @@ func (m *AttestationProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EvmStorageProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmStorageProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmStorageProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountProof = append(m.AccountProof, make([]byte, postIndex-iNdEx))
+			copy(m.AccountProof[len(m.AccountProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageProof = append(m.StorageProof, make([]byte, postIndex-iNdEx))
+			copy(m.StorageProof[len(m.StorageProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAttestations(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
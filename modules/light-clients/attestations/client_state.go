@@ -18,14 +18,13 @@ import (
 	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
 	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/internal/evm"
 )
 
 var _ exported.ClientState = (*ClientState)(nil)
 
-var nonMembershipCommitment = make([]byte, 32)
-
 // NewClientState creates a new ClientState instance.
-func NewClientState(attestorAddresses []string, minRequiredSigs uint32, latestHeight uint64) *ClientState {
+func NewClientState(attestorAddresses []string, minRequiredSigs uint32, latestHeight clienttypes.Height) *ClientState {
 	return &ClientState{
 		AttestorAddresses: attestorAddresses,
 		MinRequiredSigs:   minRequiredSigs,
@@ -50,6 +49,9 @@ func (cs ClientState) Validate() error {
 	if cs.MinRequiredSigs > uint32(len(cs.AttestorAddresses)) {
 		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "min required sigs cannot exceed number of attestors")
 	}
+	if cs.LegacyLatestHeight != 0 { //nolint:staticcheck // legacy field must be migrated into latest height
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "legacy latest height must not be set, use latest height instead")
+	}
 
 	seen := make(map[common.Address]bool)
 	for _, addr := range cs.AttestorAddresses {
@@ -66,10 +68,27 @@ func (cs ClientState) Validate() error {
 		seen[normalizedAddr] = true
 	}
 
+	if cs.StateRootConfig != nil {
+		if err := cs.StateRootConfig.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// attestationTypeState returns the attestation type signed by attestors for client updates.
+// Clients configured with a StateRootConfig expect state root attestations.
+func (cs ClientState) attestationTypeState() AttestationType {
+	if cs.StateRootConfig != nil {
+		return AttestationTypeStateRoot
+	}
+
+	return AttestationTypeState
+}
+
 // verifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given CommitmentPath at the specified height.
+// If the client is configured with a StateRootConfig the proof is verified against the attested state root, otherwise it must be a packet attestation.
 func (cs *ClientState) verifyMembership(
 	clientStore storetypes.KVStore,
 	cdc codec.BinaryCodec,
@@ -90,61 +109,81 @@ func (cs *ClientState) verifyMembership(
 		return errorsmod.Wrap(ErrInvalidAttestationData, "value cannot be empty")
 	}
 
-	if _, found := getConsensusState(clientStore, cdc, height); !found {
+	consensusState, found := getConsensusState(clientStore, cdc, height)
+	if !found {
 		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "consensus state not found for height %s", height)
 	}
 
-	var attestationProof AttestationProof
-	if err := cdc.Unmarshal(proof, &attestationProof); err != nil {
-		return errorsmod.Wrapf(ErrInvalidAttestationProof, "failed to unmarshal proof: %v", err)
+	if cs.StateRootConfig != nil {
+		return cs.StateRootConfig.verifyMembership(cdc, consensusState.Root, proof, path, value)
 	}
 
-	if err := cs.verifySignatures(&attestationProof, AttestationTypePacket); err != nil {
+	packetAttestation, err := cs.verifyPacketAttestation(cdc, height, proof)
+	if err != nil {
 		return err
 	}
 
-	packetAttestation, err := ABIDecodePacketAttestation(attestationProof.AttestationData)
-	if err != nil {
-		return errorsmod.Wrapf(ErrInvalidAttestationData, "failed to ABI decode attestation data: %v", err)
-	}
+	return verifyAttestedCommitment(packetAttestation, path, value)
+}
 
-	if packetAttestation.Height != height.GetRevisionHeight() {
-		return errorsmod.Wrapf(ErrInvalidHeight, "height mismatch: expected %d, got %d", height.GetRevisionHeight(), packetAttestation.Height)
+// verifyBatchMembership verifies a single proof of the existence of many values at their CommitmentPaths at the specified height.
+// If the client is configured with a StateRootConfig the proof must be an ICS-23 batch proof against the attested state root,
+// otherwise it must be a packet attestation attesting to all of the commitments, so that its signatures are only verified once.
+func (cs *ClientState) verifyBatchMembership(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	if cs.IsFrozen {
+		return ErrClientFrozen
 	}
 
-	if len(packetAttestation.Packets) == 0 {
-		return errorsmod.Wrap(ErrInvalidAttestationData, "packets cannot be empty")
+	if len(paths) == 0 {
+		return errorsmod.Wrap(ErrInvalidPath, "paths cannot be empty")
 	}
 
-	merklePath, ok := path.(commitmenttypesv2.MerklePath)
-	if !ok {
-		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+	if len(paths) != len(values) {
+		return errorsmod.Wrapf(ErrInvalidValue, "number of paths %d not equal to number of values %d", len(paths), len(values))
 	}
 
-	if len(merklePath.KeyPath) != 1 {
-		return errorsmod.Wrapf(ErrInvalidPath, "key path must have exactly 1 element, got %d", len(merklePath.KeyPath))
+	for i := range paths {
+		if paths[i] == nil || paths[i].Empty() {
+			return errorsmod.Wrapf(ErrInvalidPath, "path at index %d cannot be empty", i)
+		}
+
+		if len(values[i]) == 0 {
+			return errorsmod.Wrapf(ErrInvalidAttestationData, "value at index %d cannot be empty", i)
+		}
 	}
 
-	if len(merklePath.KeyPath[0]) == 0 {
-		return errorsmod.Wrap(ErrInvalidPath, "path cannot be empty")
+	consensusState, found := getConsensusState(clientStore, cdc, height)
+	if !found {
+		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "consensus state not found for height %s", height)
 	}
 
-	commitmentPath := crypto.Keccak256(merklePath.KeyPath[0])
+	if cs.StateRootConfig != nil {
+		return cs.StateRootConfig.verifyBatchMembership(cdc, consensusState.Root, proof, paths, values)
+	}
 
-	if len(value) != 32 {
-		return errorsmod.Wrapf(ErrInvalidValue, "value must be 32 bytes, got %d", len(value))
+	packetAttestation, err := cs.verifyPacketAttestation(cdc, height, proof)
+	if err != nil {
+		return err
 	}
 
-	for _, packet := range packetAttestation.Packets {
-		if len(packet.Commitment) == 32 && len(packet.Path) == 32 && bytes.Equal(packet.Commitment, value) && bytes.Equal(packet.Path, commitmentPath) {
-			return nil
+	for i := range paths {
+		if err := verifyAttestedCommitment(packetAttestation, paths[i], values[i]); err != nil {
+			return errorsmod.Wrapf(err, "path at index %d", i)
 		}
 	}
 
-	return ErrNotMember
+	return nil
 }
 
 // verifyNonMembership verifies a proof of the absence of a value at a given CommitmentPath at the specified height.
+// If the client is configured with a StateRootConfig the proof is verified against the attested state root, otherwise it must be a packet attestation.
 func (cs *ClientState) verifyNonMembership(
 	clientStore storetypes.KVStore,
 	cdc codec.BinaryCodec,
@@ -160,30 +199,18 @@ func (cs *ClientState) verifyNonMembership(
 		return errorsmod.Wrap(ErrInvalidPath, "path cannot be empty")
 	}
 
-	if _, found := getConsensusState(clientStore, cdc, height); !found {
+	consensusState, found := getConsensusState(clientStore, cdc, height)
+	if !found {
 		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "consensus state not found for height %s", height)
 	}
 
-	var attestationProof AttestationProof
-	if err := cdc.Unmarshal(proof, &attestationProof); err != nil {
-		return errorsmod.Wrapf(ErrInvalidAttestationProof, "failed to unmarshal proof: %v", err)
-	}
-
-	if err := cs.verifySignatures(&attestationProof, AttestationTypePacket); err != nil {
-		return err
+	if cs.StateRootConfig != nil {
+		return cs.StateRootConfig.verifyNonMembership(cdc, consensusState.Root, proof, path)
 	}
 
-	packetAttestation, err := ABIDecodePacketAttestation(attestationProof.AttestationData)
+	packetAttestation, err := cs.verifyPacketAttestation(cdc, height, proof)
 	if err != nil {
-		return errorsmod.Wrapf(ErrInvalidAttestationData, "failed to ABI decode attestation data: %v", err)
-	}
-
-	if packetAttestation.Height != height.GetRevisionHeight() {
-		return errorsmod.Wrapf(ErrInvalidHeight, "height mismatch: expected %d, got %d", height.GetRevisionHeight(), packetAttestation.Height)
-	}
-
-	if len(packetAttestation.Packets) == 0 {
-		return errorsmod.Wrap(ErrInvalidAttestationData, "packets cannot be empty")
+		return err
 	}
 
 	merklePath, ok := path.(commitmenttypesv2.MerklePath)
@@ -206,7 +233,7 @@ func (cs *ClientState) verifyNonMembership(
 	for _, packet := range packetAttestation.Packets {
 		if bytes.Equal(packet.Path, commitmentPath) {
 			foundMatchingPath = true
-			if len(packet.Commitment) != 32 || !bytes.Equal(packet.Commitment, nonMembershipCommitment) {
+			if len(packet.Commitment) != 32 || !bytes.Equal(packet.Commitment, evm.NonMembershipCommitment) {
 				allZeroCommitments = false
 			}
 		}
@@ -223,6 +250,65 @@ func (cs *ClientState) verifyNonMembership(
 	return nil
 }
 
+// verifyPacketAttestation unmarshals the proof into an attestation proof, verifies its signatures and returns the decoded
+// packet attestation, which must attest to at least one packet at the specified height.
+func (cs *ClientState) verifyPacketAttestation(cdc codec.BinaryCodec, height exported.Height, proof []byte) (*PacketAttestation, error) {
+	var attestationProof AttestationProof
+	if err := cdc.Unmarshal(proof, &attestationProof); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidAttestationProof, "failed to unmarshal proof: %v", err)
+	}
+
+	if err := cs.verifySignatures(&attestationProof, AttestationTypePacket); err != nil {
+		return nil, err
+	}
+
+	packetAttestation, err := ABIDecodePacketAttestation(attestationProof.AttestationData)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidAttestationData, "failed to ABI decode attestation data: %v", err)
+	}
+
+	attestedHeight := clienttypes.NewHeight(packetAttestation.RevisionNumber, packetAttestation.Height)
+	if !attestedHeight.EQ(height) {
+		return nil, errorsmod.Wrapf(ErrInvalidHeight, "height mismatch: expected %s, got %s", height, attestedHeight)
+	}
+
+	if len(packetAttestation.Packets) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidAttestationData, "packets cannot be empty")
+	}
+
+	return packetAttestation, nil
+}
+
+// verifyAttestedCommitment returns an error unless the packet attestation attests to the 32-byte value as the commitment of the path.
+func verifyAttestedCommitment(packetAttestation *PacketAttestation, path exported.Path, value []byte) error {
+	merklePath, ok := path.(commitmenttypesv2.MerklePath)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+	}
+
+	if len(merklePath.KeyPath) != 1 {
+		return errorsmod.Wrapf(ErrInvalidPath, "key path must have exactly 1 element, got %d", len(merklePath.KeyPath))
+	}
+
+	if len(merklePath.KeyPath[0]) == 0 {
+		return errorsmod.Wrap(ErrInvalidPath, "path cannot be empty")
+	}
+
+	commitmentPath := crypto.Keccak256(merklePath.KeyPath[0])
+
+	if len(value) != 32 {
+		return errorsmod.Wrapf(ErrInvalidValue, "value must be 32 bytes, got %d", len(value))
+	}
+
+	for _, packet := range packetAttestation.Packets {
+		if len(packet.Commitment) == 32 && len(packet.Path) == 32 && bytes.Equal(packet.Commitment, value) && bytes.Equal(packet.Path, commitmentPath) {
+			return nil
+		}
+	}
+
+	return ErrNotMember
+}
+
 // sets the client state to the store
 func setClientState(store storetypes.KVStore, cdc codec.BinaryCodec, clientState exported.ClientState) {
 	bz := clienttypes.MustMarshalClientState(cdc, clientState)
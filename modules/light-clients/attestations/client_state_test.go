@@ -11,6 +11,7 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
 	commitmenttypesv2 "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types/v2"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
 	"github.com/cosmos/ibc-go/v11/modules/light-clients/attestations"
 )
 
@@ -27,29 +28,49 @@ func (s *AttestationsTestSuite) TestClientStateValidate() {
 		},
 		{
 			name:        "empty attestor addresses",
-			clientState: attestations.NewClientState([]string{}, 1, 1),
+			clientState: attestations.NewClientState([]string{}, 1, clienttypes.NewHeight(0, 1)),
 			expErr:      true,
 		},
 		{
 			name:        "zero min required sigs",
-			clientState: attestations.NewClientState(s.attestorAddrs, 0, 1),
+			clientState: attestations.NewClientState(s.attestorAddrs, 0, clienttypes.NewHeight(0, 1)),
 			expErr:      true,
 		},
 		{
 			name:        "min required sigs exceeds attestor count",
-			clientState: attestations.NewClientState(s.attestorAddrs, 10, 1),
+			clientState: attestations.NewClientState(s.attestorAddrs, 10, clienttypes.NewHeight(0, 1)),
 			expErr:      true,
 		},
 		{
 			name:        "duplicate attestor address",
-			clientState: attestations.NewClientState([]string{s.attestorAddrs[0], s.attestorAddrs[0]}, 1, 1),
+			clientState: attestations.NewClientState([]string{s.attestorAddrs[0], s.attestorAddrs[0]}, 1, clienttypes.NewHeight(0, 1)),
 			expErr:      true,
 		},
 		{
 			name:        "empty attestor address",
-			clientState: attestations.NewClientState([]string{""}, 1, 1),
+			clientState: attestations.NewClientState([]string{""}, 1, clienttypes.NewHeight(0, 1)),
 			expErr:      true,
 		},
+		{
+			name:        "valid client state with non-zero revision number",
+			clientState: attestations.NewClientState(s.attestorAddrs, 1, clienttypes.NewHeight(2, 1)),
+			expErr:      false,
+		},
+		{
+			name:        "zero latest height",
+			clientState: attestations.NewClientState(s.attestorAddrs, 1, clienttypes.ZeroHeight()),
+			expErr:      false,
+		},
+		{
+			name: "legacy latest height set",
+			clientState: &attestations.ClientState{
+				AttestorAddresses:  s.attestorAddrs,
+				MinRequiredSigs:    1,
+				LatestHeight:       clienttypes.NewHeight(0, 1),
+				LegacyLatestHeight: 1,
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -72,27 +93,27 @@ func (s *AttestationsTestSuite) TestClientStateValidateInvalidAddressFormat() {
 	}{
 		{
 			name:        "invalid address format - not hex",
-			clientState: attestations.NewClientState([]string{"not-a-valid-address"}, 1, 1),
+			clientState: attestations.NewClientState([]string{"not-a-valid-address"}, 1, clienttypes.NewHeight(0, 1)),
 			expErr:      "invalid attestor address format",
 		},
 		{
 			name:        "invalid address format - too short",
-			clientState: attestations.NewClientState([]string{"0x1234"}, 1, 1),
+			clientState: attestations.NewClientState([]string{"0x1234"}, 1, clienttypes.NewHeight(0, 1)),
 			expErr:      "invalid attestor address format",
 		},
 		{
 			name:        "valid checksummed address",
-			clientState: attestations.NewClientState([]string{s.attestorAddrs[0]}, 1, 1),
+			clientState: attestations.NewClientState([]string{s.attestorAddrs[0]}, 1, clienttypes.NewHeight(0, 1)),
 			expErr:      "",
 		},
 		{
 			name:        "valid lowercase address",
-			clientState: attestations.NewClientState([]string{strings.ToLower(s.attestorAddrs[0])}, 1, 1),
+			clientState: attestations.NewClientState([]string{strings.ToLower(s.attestorAddrs[0])}, 1, clienttypes.NewHeight(0, 1)),
 			expErr:      "",
 		},
 		{
 			name:        "duplicate addresses with different case",
-			clientState: attestations.NewClientState([]string{s.attestorAddrs[0], strings.ToLower(s.attestorAddrs[0])}, 1, 1),
+			clientState: attestations.NewClientState([]string{s.attestorAddrs[0], strings.ToLower(s.attestorAddrs[0])}, 1, clienttypes.NewHeight(0, 1)),
 			expErr:      "duplicate attestor address",
 		},
 	}
@@ -189,6 +210,124 @@ func (s *AttestationsTestSuite) TestVerifyMembership() {
 	}
 }
 
+func (s *AttestationsTestSuite) TestVerifyBatchMembership() {
+	var (
+		paths   []exported.Path
+		values  [][]byte
+		signers []int
+	)
+
+	ibcPaths := [][]byte{bytes.Repeat([]byte{0x01}, 32), bytes.Repeat([]byte{0x02}, 32), bytes.Repeat([]byte{0x03}, 32)}
+	commitments := [][]byte{bytes.Repeat([]byte{0xAB}, 32), bytes.Repeat([]byte{0xCD}, 32), bytes.Repeat([]byte{0xEF}, 32)}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: all attested packets",
+			func() {},
+			nil,
+		},
+		{
+			"success: subset of the attested packets",
+			func() {
+				paths = paths[1:]
+				values = values[1:]
+			},
+			nil,
+		},
+		{
+			"failure: path is not attested",
+			func() {
+				paths[1] = commitmenttypesv2.NewMerklePath(bytes.Repeat([]byte{0x04}, 32))
+			},
+			attestations.ErrNotMember,
+		},
+		{
+			"failure: commitment does not match",
+			func() {
+				values[2] = bytes.Repeat([]byte{0xAB}, 32)
+			},
+			attestations.ErrNotMember,
+		},
+		{
+			"failure: empty batch",
+			func() {
+				paths = nil
+				values = nil
+			},
+			attestations.ErrInvalidPath,
+		},
+		{
+			"failure: number of paths and values differ",
+			func() {
+				values = values[1:]
+			},
+			attestations.ErrInvalidValue,
+		},
+		{
+			"failure: empty value",
+			func() {
+				values[0] = nil
+			},
+			attestations.ErrInvalidAttestationData,
+		},
+		{
+			"failure: quorum not met",
+			func() {
+				signers = []int{0}
+			},
+			attestations.ErrInvalidQuorum,
+		},
+		{
+			"failure: frozen client",
+			func() {
+				s.freezeClient(s.chainA.GetContext(), testClientID)
+			},
+			attestations.ErrClientFrozen,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.chainA.GetContext()
+			s.initializeClient(ctx, testClientID, 100, uint64(time.Second.Nanoseconds()))
+
+			newHeight := uint64(200)
+			s.updateClientState(ctx, testClientID, newHeight, uint64(2*time.Second.Nanoseconds()))
+
+			paths = nil
+			values = nil
+			var packets []attestations.PacketCompact
+			for i := range ibcPaths {
+				packets = append(packets, attestations.PacketCompact{Path: crypto.Keccak256(ibcPaths[i]), Commitment: commitments[i]})
+				paths = append(paths, commitmenttypesv2.NewMerklePath(ibcPaths[i]))
+				values = append(values, commitments[i])
+			}
+			signers = []int{0, 1, 2}
+
+			tc.malleate()
+
+			// a single attestation covers all packets of the batch
+			packetAttestation := s.createPacketAttestation(newHeight, packets)
+			proof := s.marshalProof(s.createAttestationProof(packetAttestation, signers, attestations.AttestationTypePacket))
+
+			proofHeight := clienttypes.NewHeight(0, newHeight)
+			err := s.lightClientModule.VerifyBatchMembership(ctx, testClientID, proofHeight, 0, 0, proof, paths, values)
+
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (s *AttestationsTestSuite) TestVerifyMembershipMalformedProof() {
 	initialHeight := uint64(100)
 	initialTimestamp := uint64(time.Second.Nanoseconds())
@@ -26,5 +26,8 @@ func (cs ConsensusState) ValidateBasic() error {
 	if cs.Timestamp == 0 {
 		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "timestamp cannot be 0")
 	}
+	if len(cs.Root) != 0 && len(cs.Root) != StateRootLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "root must be empty or %d bytes, got %d", StateRootLength, len(cs.Root))
+	}
 	return nil
 }
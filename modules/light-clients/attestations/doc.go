@@ -24,14 +24,26 @@
 //   - minRequiredSigs: quorum threshold
 //   - latestHeight: highest trusted height
 //   - isFrozen: whether operations are halted
+//   - stateRootConfig: optional proof format used against attested state roots
 //
 // Consensus states are stored per height and contain a trusted timestamp. Proof
 // verification relies on quorum-signed attestations over ABI-encoded packet data
 // (paths and commitments hashed with keccak256).
 //
+// Clients may optionally be configured with a StateRootConfig. Attestors then sign
+// a counterparty state root per height, which is stored in the consensus state,
+// and membership proofs are ordinary ICS-23 proofs or Ethereum storage proofs
+// verified against that root. This requires a single attestation per height
+// rather than one per packet batch.
+//
+// Attested heights include the revision number of the counterparty chain. Attestations
+// for a non-zero revision carry the revision number as an additional ABI word, and state
+// attestations are prefixed with a type tag, while revision 0 attestations keep the original
+// encoding. Client states created before revision numbers were supported are migrated by the
+// IBC core module migration to consensus version 9.
+//
 // Limitations:
 //   - No client recovery or upgrades
 //   - No attestor rotation
 //   - No misbehaviour handling
-//   - Revision number is always 0 (only revision height is used).
 package attestations
@@ -23,4 +23,7 @@ var (
 	ErrProcessedHeightNotFound = errorsmod.Register(ModuleName, 15, "processed height not found")
 	ErrDelayPeriodNotPassed    = errorsmod.Register(ModuleName, 16, "delay period has not been reached")
 	ErrNonMembershipFailed     = errorsmod.Register(ModuleName, 17, "non-membership verification failed: commitment is not zero")
+	ErrInvalidStateRootConfig  = errorsmod.Register(ModuleName, 18, "invalid state root config")
+	ErrInvalidStateRoot        = errorsmod.Register(ModuleName, 19, "invalid state root")
+	ErrInvalidStorageProof     = errorsmod.Register(ModuleName, 20, "invalid storage proof")
 )
@@ -3,6 +3,7 @@
 package attestations
 
 import (
+	"bytes"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -15,7 +16,10 @@ import (
 	"github.com/cosmos/ibc-go/v11/modules/core/exported"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule = (*LightClientModule)(nil)
+	_ exported.BatchVerifier     = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -51,10 +55,13 @@ func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientSt
 		return err
 	}
 
+	if clientState.StateRootConfig != nil && len(consensusState.Root) == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "root cannot be empty for clients configured with a state root config")
+	}
+
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
 
-	initialHeight := clienttypes.NewHeight(0, clientState.LatestHeight)
-	setConsensusState(clientStore, l.cdc, &consensusState, initialHeight)
+	setConsensusState(clientStore, l.cdc, &consensusState, clientState.LatestHeight)
 	setClientState(clientStore, l.cdc, &clientState)
 
 	return nil
@@ -71,24 +78,30 @@ func (l LightClientModule) VerifyClientMessage(ctx sdk.Context, clientID string,
 	return clientState.VerifyClientMessage(ctx, l.cdc, clientStore, clientMsg)
 }
 
-// CheckForMisbehaviour returns true if the provided client message contains conflicting timestamps
+// CheckForMisbehaviour returns true if the provided client message contains conflicting timestamps or state roots
 func (l LightClientModule) CheckForMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) bool {
 	attestationProof, ok := clientMsg.(*AttestationProof)
 	if !ok {
 		panic(fmt.Sprintf("expected type %T, got type %T", (*AttestationProof)(nil), clientMsg))
 	}
 
-	stateAttestation, err := ABIDecodeStateAttestation(attestationProof.AttestationData)
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	attestedHeight, attestedConsensusState, err := clientState.decodeStateAttestation(attestationProof.AttestationData)
 	if err != nil {
 		panic(fmt.Sprintf("failed to ABI decode attestation data: %v", err))
 	}
 
-	consensusState, found := getConsensusState(l.storeProvider.ClientStore(ctx, clientID), l.cdc, clienttypes.NewHeight(0, stateAttestation.Height))
+	consensusState, found := getConsensusState(clientStore, l.cdc, attestedHeight)
 	if !found {
 		return false
 	}
 
-	return consensusState.Timestamp != stateAttestation.Timestamp
+	return consensusState.Timestamp != attestedConsensusState.Timestamp || !bytes.Equal(consensusState.Root, attestedConsensusState.Root)
 }
 
 // UpdateStateOnMisbehaviour freezes the client
@@ -134,6 +147,26 @@ func (l LightClientModule) VerifyMembership(
 	return clientState.verifyMembership(clientStore, l.cdc, height, proof, path, value)
 }
 
+// VerifyBatchMembership obtains the client state associated with the client identifier and calls into the clientState.verifyBatchMembership method.
+func (l LightClientModule) VerifyBatchMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.verifyBatchMembership(clientStore, l.cdc, height, proof, paths, values)
+}
+
 // VerifyNonMembership obtains the client state associated with the client identifier and calls into the clientState.verifyNonMembership method.
 func (l LightClientModule) VerifyNonMembership(
 	ctx sdk.Context,
@@ -174,7 +207,6 @@ func (l LightClientModule) Status(ctx sdk.Context, clientID string) exported.Sta
 
 // LatestHeight returns the latest height for the client state for the given client identifier.
 // If no client is present for the provided client identifier a zero value height is returned.
-// NOTE: RevisionNumber is always 0 for attestations client heights.
 func (l LightClientModule) LatestHeight(ctx sdk.Context, clientID string) exported.Height {
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
 
@@ -183,7 +215,7 @@ func (l LightClientModule) LatestHeight(ctx sdk.Context, clientID string) export
 		return clienttypes.ZeroHeight()
 	}
 
-	return clienttypes.NewHeight(0, clientState.LatestHeight)
+	return clientState.LatestHeight
 }
 
 // TimestampAtHeight obtains the client state associated with the client identifier and returns the timestamp in nanoseconds of the consensus state at the given height.
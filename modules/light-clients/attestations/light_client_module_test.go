@@ -115,7 +115,7 @@ func (s *AttestationsTestSuite) createClientState(initialHeight uint64) *attesta
 	return attestations.NewClientState(
 		s.attestorAddrs,
 		s.minRequiredSigs,
-		initialHeight,
+		clienttypes.NewHeight(0, initialHeight),
 	)
 }
 
@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"cosmossdk.io/log/v2"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+// ClientKeeper expected account IBC client keeper
+type ClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
+	IterateClientStates(ctx sdk.Context, prefix []byte, cb func(string, exported.ClientState) bool)
+	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
+	Logger(ctx sdk.Context) log.Logger
+}
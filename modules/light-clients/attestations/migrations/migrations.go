@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/attestations"
+)
+
+// MigrateLatestHeights migrates all attestations client states created before revision number support.
+// The deprecated revision height stored in the legacy latest height field is moved into the latest height
+// with revision number 0, which matches the heights their consensus states are stored under. This function
+// should be called during in-place store migrations.
+func MigrateLatestHeights(ctx sdk.Context, cdc codec.BinaryCodec, clientKeeper ClientKeeper) (int, error) {
+	var clientIDs []string
+	clientKeeper.IterateClientStates(ctx, []byte(exported.Attestations), func(clientID string, _ exported.ClientState) bool {
+		clientIDs = append(clientIDs, clientID)
+		return false
+	})
+
+	var totalMigrated int
+	for _, clientID := range clientIDs {
+		clientState, ok := clientKeeper.GetClientState(ctx, clientID)
+		if !ok {
+			return 0, errorsmod.Wrapf(clienttypes.ErrClientNotFound, "clientID %s", clientID)
+		}
+
+		attestationsClientState, ok := clientState.(*attestations.ClientState)
+		if !ok {
+			return 0, errorsmod.Wrap(clienttypes.ErrInvalidClient, "client state is not attestations even though client id contains attestations")
+		}
+
+		legacyLatestHeight := attestationsClientState.LegacyLatestHeight //nolint:staticcheck // migration of the deprecated field
+		if legacyLatestHeight == 0 {
+			continue
+		}
+
+		attestationsClientState.LatestHeight = clienttypes.NewHeight(0, legacyLatestHeight)
+		attestationsClientState.LegacyLatestHeight = 0 //nolint:staticcheck // migration of the deprecated field
+
+		clientStore := clientKeeper.ClientStore(ctx, clientID)
+		clientStore.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(cdc, attestationsClientState))
+		totalMigrated++
+	}
+
+	clientKeeper.Logger(ctx).Info("migrated attestations client latest heights", "total", totalMigrated)
+
+	return totalMigrated, nil
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/attestations"
+	attestationsmigrations "github.com/cosmos/ibc-go/v11/modules/light-clients/attestations/migrations"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+type MigrationsTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chain used for convenience and readability
+	chainA *ibctesting.TestChain
+}
+
+func (s *MigrationsTestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 1)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+func TestAttestationsMigrationsTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(MigrationsTestSuite))
+}
+
+// test migration of attestations client states created before revision number support
+func (s *MigrationsTestSuite) TestMigrateLatestHeights() {
+	privKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	attestorAddrs := []string{crypto.PubkeyToAddress(privKey.PublicKey).Hex()}
+
+	ctx := s.chainA.GetContext()
+	cdc := s.chainA.App.AppCodec()
+	clientKeeper := s.chainA.GetSimApp().IBCKeeper.ClientKeeper
+
+	legacyClientID := clienttypes.FormatClientIdentifier(attestations.ModuleName, 0)
+	legacyClientState := &attestations.ClientState{
+		AttestorAddresses:  attestorAddrs,
+		MinRequiredSigs:    1,
+		LegacyLatestHeight: 100,
+	}
+	clientKeeper.ClientStore(ctx, legacyClientID).Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(cdc, legacyClientState))
+
+	consensusState := &attestations.ConsensusState{Timestamp: uint64(time.Second.Nanoseconds())}
+	clientKeeper.ClientStore(ctx, legacyClientID).Set(host.ConsensusStateKey(clienttypes.NewHeight(0, 100)), clienttypes.MustMarshalConsensusState(cdc, consensusState))
+
+	migratedClientID := clienttypes.FormatClientIdentifier(attestations.ModuleName, 1)
+	migratedClientState := attestations.NewClientState(attestorAddrs, 1, clienttypes.NewHeight(1, 50))
+	clientKeeper.ClientStore(ctx, migratedClientID).Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(cdc, migratedClientState))
+
+	totalMigrated, err := attestationsmigrations.MigrateLatestHeights(ctx, cdc, clientKeeper)
+	s.Require().NoError(err)
+	s.Require().Equal(1, totalMigrated)
+
+	clientState, found := clientKeeper.GetClientState(ctx, legacyClientID)
+	s.Require().True(found)
+	s.Require().NoError(clientState.(*attestations.ClientState).Validate())
+	s.Require().Equal(clienttypes.NewHeight(0, 100), clientState.(*attestations.ClientState).LatestHeight)
+
+	// the latest height of the migrated client references the existing consensus state
+	_, found = clientKeeper.GetClientConsensusState(ctx, legacyClientID, clientState.(*attestations.ClientState).LatestHeight)
+	s.Require().True(found)
+
+	clientState, found = clientKeeper.GetClientState(ctx, migratedClientID)
+	s.Require().True(found)
+	s.Require().Equal(migratedClientState, clientState)
+}
@@ -19,6 +19,8 @@ const (
 	AttestationTypeState AttestationType = 0x01
 	// AttestationTypePacket is used for packet membership/non-membership attestations.
 	AttestationTypePacket AttestationType = 0x02
+	// AttestationTypeStateRoot is used for client update attestations over a counterparty state root.
+	AttestationTypeStateRoot AttestationType = 0x03
 )
 
 const (
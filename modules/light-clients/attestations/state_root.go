@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestations
+
+import (
+	"bytes"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types/v2"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/internal/evm"
+)
+
+// StateRootLength is the expected length of an attested state root.
+const StateRootLength = 32
+
+// NewICS23StateRootConfig creates a new StateRootConfig which verifies ICS-23 proofs
+// against the attested state roots.
+func NewICS23StateRootConfig(proofSpecs []*ics23.ProofSpec) *StateRootConfig {
+	return &StateRootConfig{
+		ProofType:  ICS23,
+		ProofSpecs: proofSpecs,
+	}
+}
+
+// NewEVMStorageStateRootConfig creates a new StateRootConfig which verifies Ethereum storage proofs
+// of the commitments mapping stored in the provided contract against the attested state roots.
+func NewEVMStorageStateRootConfig(ibcContractAddress string, commitmentsSlot []byte) *StateRootConfig {
+	return &StateRootConfig{
+		ProofType:          EVM_STORAGE,
+		IbcContractAddress: ibcContractAddress,
+		CommitmentsSlot:    commitmentsSlot,
+	}
+}
+
+// Validate performs basic validation of the state root configuration.
+func (src StateRootConfig) Validate() error {
+	switch src.ProofType {
+	case ICS23:
+		if len(src.ProofSpecs) == 0 {
+			return errorsmod.Wrap(ErrInvalidStateRootConfig, "proof specs cannot be empty for ICS-23 proofs")
+		}
+		for i, spec := range src.ProofSpecs {
+			if spec == nil {
+				return errorsmod.Wrapf(ErrInvalidStateRootConfig, "proof spec cannot be nil at index: %d", i)
+			}
+		}
+	case EVM_STORAGE:
+		if !common.IsHexAddress(src.IbcContractAddress) {
+			return errorsmod.Wrapf(ErrInvalidStateRootConfig, "invalid IBC contract address format: %s", src.IbcContractAddress)
+		}
+		if len(src.CommitmentsSlot) != 32 {
+			return errorsmod.Wrapf(ErrInvalidStateRootConfig, "commitments slot must be 32 bytes, got %d", len(src.CommitmentsSlot))
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidStateRootConfig, "unsupported proof type: %s", src.ProofType)
+	}
+
+	return nil
+}
+
+// verifyMembership verifies a proof of the existence of the value at the given path against the attested state root.
+func (src StateRootConfig) verifyMembership(cdc codec.BinaryCodec, root []byte, proof []byte, path exported.Path, value []byte) error {
+	if len(root) != StateRootLength {
+		return errorsmod.Wrapf(ErrInvalidStateRoot, "consensus state root must be %d bytes, got %d", StateRootLength, len(root))
+	}
+
+	merklePath, ok := path.(commitmenttypesv2.MerklePath)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+	}
+
+	switch src.ProofType {
+	case ICS23:
+		var merkleProof commitmenttypes.MerkleProof
+		if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+			return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+		}
+
+		return merkleProof.VerifyMembership(src.ProofSpecs, commitmenttypes.NewMerkleRoot(root), merklePath, value)
+	case EVM_STORAGE:
+		if len(value) != 32 {
+			return errorsmod.Wrapf(ErrInvalidValue, "value must be 32 bytes, got %d", len(value))
+		}
+
+		storedValue, err := src.verifyEVMStorageProof(cdc, root, proof, merklePath)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(storedValue, value) {
+			return ErrNotMember
+		}
+
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidStateRootConfig, "unsupported proof type: %s", src.ProofType)
+	}
+}
+
+// verifyBatchMembership verifies a single proof of the existence of each value at its path against the attested state root.
+// Only ICS-23 proofs can be combined, EVM storage proofs must be verified per path.
+func (src StateRootConfig) verifyBatchMembership(cdc codec.BinaryCodec, root []byte, proof []byte, paths []exported.Path, values [][]byte) error {
+	if len(root) != StateRootLength {
+		return errorsmod.Wrapf(ErrInvalidStateRoot, "consensus state root must be %d bytes, got %d", StateRootLength, len(root))
+	}
+
+	switch src.ProofType {
+	case ICS23:
+		var merkleProof commitmenttypes.MerkleProof
+		if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+			return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+		}
+
+		return merkleProof.VerifyBatchMembership(src.ProofSpecs, commitmenttypes.NewMerkleRoot(root), paths, values)
+	case EVM_STORAGE:
+		if len(paths) != 1 {
+			return errorsmod.Wrapf(clienttypes.ErrBatchVerificationNotSupported, "EVM storage proofs cannot be combined, got %d paths", len(paths))
+		}
+
+		return src.verifyMembership(cdc, root, proof, paths[0], values[0])
+	default:
+		return errorsmod.Wrapf(ErrInvalidStateRootConfig, "unsupported proof type: %s", src.ProofType)
+	}
+}
+
+// verifyNonMembership verifies a proof of the absence of a value at the given path against the attested state root.
+func (src StateRootConfig) verifyNonMembership(cdc codec.BinaryCodec, root []byte, proof []byte, path exported.Path) error {
+	if len(root) != StateRootLength {
+		return errorsmod.Wrapf(ErrInvalidStateRoot, "consensus state root must be %d bytes, got %d", StateRootLength, len(root))
+	}
+
+	merklePath, ok := path.(commitmenttypesv2.MerklePath)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+	}
+
+	switch src.ProofType {
+	case ICS23:
+		var merkleProof commitmenttypes.MerkleProof
+		if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+			return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+		}
+
+		return merkleProof.VerifyNonMembership(src.ProofSpecs, commitmenttypes.NewMerkleRoot(root), merklePath)
+	case EVM_STORAGE:
+		storedValue, err := src.verifyEVMStorageProof(cdc, root, proof, merklePath)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(storedValue, evm.NonMembershipCommitment) {
+			return ErrNonMembershipFailed
+		}
+
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidStateRootConfig, "unsupported proof type: %s", src.ProofType)
+	}
+}
+
+// verifyEVMStorageProof verifies the account proof of the IBC contract against the state root and the storage proof
+// of the commitment slot for the provided path against the account storage root. The 32-byte value stored in the slot
+// is returned, absent slots are returned as 32 zero bytes.
+func (src StateRootConfig) verifyEVMStorageProof(cdc codec.BinaryCodec, root []byte, proof []byte, merklePath commitmenttypesv2.MerklePath) ([]byte, error) {
+	if len(merklePath.KeyPath) != 1 {
+		return nil, errorsmod.Wrapf(ErrInvalidPath, "key path must have exactly 1 element, got %d", len(merklePath.KeyPath))
+	}
+
+	if len(merklePath.KeyPath[0]) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidPath, "path cannot be empty")
+	}
+
+	var storageProof EvmStorageProof
+	if err := cdc.Unmarshal(proof, &storageProof); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidStorageProof, "failed to unmarshal proof: %v", err)
+	}
+
+	storageRoot, err := evm.VerifyAccountStorageRoot(root, common.HexToAddress(src.IbcContractAddress), storageProof.AccountProof)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidStorageProof, err.Error())
+	}
+
+	slot := evm.CommitmentSlot(merklePath.KeyPath[0], src.CommitmentsSlot)
+	value, err := evm.VerifyStorageValue(storageRoot, slot, storageProof.StorageProof)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidStorageProof, err.Error())
+	}
+
+	return value, nil
+}
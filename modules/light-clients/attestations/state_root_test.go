@@ -0,0 +1,461 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestations_test
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types/v2"
+	hostv2 "github.com/cosmos/ibc-go/v11/modules/core/24-host/v2"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/attestations"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/internal/evm"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+var (
+	testIBCContractAddress = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	testCommitmentsSlot    = common.LeftPadBytes([]byte{0x01}, 32)
+)
+
+func (s *AttestationsTestSuite) TestStateRootConfigValidate() {
+	testCases := []struct {
+		name   string
+		config *attestations.StateRootConfig
+		expErr error
+	}{
+		{
+			name:   "valid ICS-23 config",
+			config: attestations.NewICS23StateRootConfig(commitmenttypes.GetSDKSpecs()),
+		},
+		{
+			name:   "valid EVM storage config",
+			config: attestations.NewEVMStorageStateRootConfig(testIBCContractAddress.Hex(), testCommitmentsSlot),
+		},
+		{
+			name:   "unspecified proof type",
+			config: &attestations.StateRootConfig{},
+			expErr: attestations.ErrInvalidStateRootConfig,
+		},
+		{
+			name:   "empty proof specs",
+			config: attestations.NewICS23StateRootConfig(nil),
+			expErr: attestations.ErrInvalidStateRootConfig,
+		},
+		{
+			name:   "invalid IBC contract address",
+			config: attestations.NewEVMStorageStateRootConfig("invalid", testCommitmentsSlot),
+			expErr: attestations.ErrInvalidStateRootConfig,
+		},
+		{
+			name:   "invalid commitments slot length",
+			config: attestations.NewEVMStorageStateRootConfig(testIBCContractAddress.Hex(), []byte{0x01}),
+			expErr: attestations.ErrInvalidStateRootConfig,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			clientState := s.createClientState(100)
+			clientState.StateRootConfig = tc.config
+
+			err := clientState.Validate()
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *AttestationsTestSuite) TestStateRootInitialize() {
+	clientState := s.createClientState(100)
+	clientState.StateRootConfig = attestations.NewICS23StateRootConfig(commitmenttypes.GetSDKSpecs())
+
+	clientStateBz, err := s.chainA.App.AppCodec().Marshal(clientState)
+	s.Require().NoError(err)
+
+	consensusStateBz, err := s.chainA.App.AppCodec().Marshal(s.createConsensusState(uint64(time.Second.Nanoseconds())))
+	s.Require().NoError(err)
+
+	err = s.lightClientModule.Initialize(s.chainA.GetContext(), testClientID, clientStateBz, consensusStateBz)
+	s.Require().ErrorIs(err, clienttypes.ErrInvalidConsensus)
+}
+
+func (s *AttestationsTestSuite) TestStateRootUpdateState() {
+	root := bytes.Repeat([]byte{0x01}, 32)
+
+	testCases := []struct {
+		name            string
+		attestationData func() []byte
+		attestationType attestations.AttestationType
+		expErr          error
+	}{
+		{
+			name: "success",
+			attestationData: func() []byte {
+				return s.createStateRootAttestation(200, uint64(2*time.Second.Nanoseconds()), root)
+			},
+			attestationType: attestations.AttestationTypeStateRoot,
+		},
+		{
+			name: "failure: signed as state attestation",
+			attestationData: func() []byte {
+				return s.createStateRootAttestation(200, uint64(2*time.Second.Nanoseconds()), root)
+			},
+			attestationType: attestations.AttestationTypeState,
+			expErr:          attestations.ErrUnknownSigner,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.chainA.GetContext()
+			s.initializeStateRootClient(ctx, attestations.NewICS23StateRootConfig(commitmenttypes.GetSDKSpecs()), 100, bytes.Repeat([]byte{0x02}, 32))
+
+			proof := s.createAttestationProof(tc.attestationData(), []int{0, 1, 2}, tc.attestationType)
+
+			err := s.lightClientModule.VerifyClientMessage(ctx, testClientID, proof)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().False(s.lightClientModule.CheckForMisbehaviour(ctx, testClientID, proof))
+
+			heights := s.lightClientModule.UpdateState(ctx, testClientID, proof)
+			s.Require().Len(heights, 1)
+
+			consensusState, found := s.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(ctx, testClientID, heights[0])
+			s.Require().True(found)
+			s.Require().Equal(root, consensusState.(*attestations.ConsensusState).Root)
+
+			// a conflicting root for the same height is misbehaviour
+			conflictingData := s.createStateRootAttestation(200, uint64(2*time.Second.Nanoseconds()), bytes.Repeat([]byte{0x03}, 32))
+			conflictingProof := s.createAttestationProof(conflictingData, []int{0, 1, 2}, attestations.AttestationTypeStateRoot)
+			s.Require().True(s.lightClientModule.CheckForMisbehaviour(ctx, testClientID, conflictingProof))
+		})
+	}
+}
+
+func (s *AttestationsTestSuite) TestStateRootVerifyMembershipICS23() {
+	var (
+		path  exported.Path
+		value []byte
+		proof []byte
+	)
+
+	commitment := bytes.Repeat([]byte{0x0c}, 32)
+	commitmentKey := hostv2.PacketCommitmentKey(ibctesting.FirstClientID, 1)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: wrong value",
+			malleate: func() {
+				value = bytes.Repeat([]byte{0x0d}, 32)
+			},
+			expErr: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: malformed proof",
+			malleate: func() {
+				proof = []byte("invalid")
+			},
+			expErr: commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketCommitment(s.chainB.GetContext(), ibctesting.FirstClientID, 1, commitment)
+			s.coordinator.CommitNBlocks(s.chainB, 2)
+
+			var proofHeight clienttypes.Height
+			proof, proofHeight = s.chainB.QueryProof(commitmentKey)
+			root := s.chainB.LatestCommittedHeader.Header.AppHash
+
+			ctx := s.chainA.GetContext()
+			s.initializeStateRootClient(ctx, attestations.NewICS23StateRootConfig(commitmenttypes.GetSDKSpecs()), proofHeight.RevisionHeight, root)
+
+			path = commitmenttypesv2.NewMerklePath([]byte(exported.StoreKey), commitmentKey)
+			value = commitment
+
+			tc.malleate()
+
+			height := clienttypes.NewHeight(0, proofHeight.RevisionHeight)
+			err := s.lightClientModule.VerifyMembership(ctx, testClientID, height, 0, 0, proof, path, value)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			// the same path is absent at the attested root of a chain state without the commitment
+			absentPath := commitmenttypesv2.NewMerklePath([]byte(exported.StoreKey), hostv2.PacketCommitmentKey(ibctesting.FirstClientID, 2))
+			absenceProof, _ := s.chainB.QueryProof(hostv2.PacketCommitmentKey(ibctesting.FirstClientID, 2))
+			err = s.lightClientModule.VerifyNonMembership(ctx, testClientID, height, 0, 0, absenceProof, absentPath)
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *AttestationsTestSuite) TestStateRootVerifyBatchMembershipICS23() {
+	var (
+		paths  []exported.Path
+		values [][]byte
+		proof  []byte
+	)
+
+	commitment := bytes.Repeat([]byte{0x0c}, 32)
+	commitmentKeys := [][]byte{
+		hostv2.PacketCommitmentKey(ibctesting.FirstClientID, 1),
+		hostv2.PacketCommitmentKey(ibctesting.FirstClientID, 2),
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: wrong value",
+			malleate: func() {
+				values[1] = bytes.Repeat([]byte{0x0d}, 32)
+			},
+			expErr: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: malformed proof",
+			malleate: func() {
+				proof = []byte("invalid")
+			},
+			expErr: commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			for sequence := uint64(1); sequence <= 2; sequence++ {
+				s.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketCommitment(s.chainB.GetContext(), ibctesting.FirstClientID, sequence, commitment)
+			}
+			s.coordinator.CommitNBlocks(s.chainB, 2)
+
+			var proofHeight clienttypes.Height
+			proof, proofHeight = s.chainB.QueryBatchProof(commitmentKeys)
+			root := s.chainB.LatestCommittedHeader.Header.AppHash
+
+			ctx := s.chainA.GetContext()
+			s.initializeStateRootClient(ctx, attestations.NewICS23StateRootConfig(commitmenttypes.GetSDKSpecs()), proofHeight.RevisionHeight, root)
+
+			paths = nil
+			values = nil
+			for _, key := range commitmentKeys {
+				paths = append(paths, commitmenttypesv2.NewMerklePath([]byte(exported.StoreKey), key))
+				values = append(values, commitment)
+			}
+
+			tc.malleate()
+
+			height := clienttypes.NewHeight(0, proofHeight.RevisionHeight)
+			err := s.lightClientModule.VerifyBatchMembership(ctx, testClientID, height, 0, 0, proof, paths, values)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *AttestationsTestSuite) TestStateRootVerifyBatchMembershipEVMStorage() {
+	ibcPath := []byte("07-tendermint-0\x01\x00\x00\x00\x00\x00\x00\x00\x01")
+	commitment := bytes.Repeat([]byte{0x0c}, 32)
+
+	slot := evm.CommitmentSlot(ibcPath, testCommitmentsSlot)
+	root, proof := s.createEVMStorageProof(slot, commitment)
+
+	ctx := s.chainA.GetContext()
+	s.initializeStateRootClient(ctx, attestations.NewEVMStorageStateRootConfig(testIBCContractAddress.Hex(), testCommitmentsSlot), 100, root)
+
+	height := clienttypes.NewHeight(0, 100)
+	path := commitmenttypesv2.NewMerklePath(ibcPath)
+
+	// a batch of a single path is verified with its storage proof
+	err := s.lightClientModule.VerifyBatchMembership(ctx, testClientID, height, 0, 0, proof, []exported.Path{path}, [][]byte{commitment})
+	s.Require().NoError(err)
+
+	// storage proofs of many paths cannot be combined
+	err = s.lightClientModule.VerifyBatchMembership(ctx, testClientID, height, 0, 0, proof, []exported.Path{path, path}, [][]byte{commitment, commitment})
+	s.Require().ErrorIs(err, clienttypes.ErrBatchVerificationNotSupported)
+}
+
+func (s *AttestationsTestSuite) TestStateRootVerifyMembershipEVMStorage() {
+	var (
+		path  exported.Path
+		value []byte
+		root  []byte
+	)
+
+	ibcPath := []byte("07-tendermint-0\x01\x00\x00\x00\x00\x00\x00\x00\x01")
+	commitment := bytes.Repeat([]byte{0x0c}, 32)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: wrong value",
+			malleate: func() {
+				value = bytes.Repeat([]byte{0x0d}, 32)
+			},
+			expErr: attestations.ErrNotMember,
+		},
+		{
+			name: "failure: path not committed",
+			malleate: func() {
+				path = commitmenttypesv2.NewMerklePath([]byte("other-path"))
+			},
+			expErr: attestations.ErrNotMember,
+		},
+		{
+			name: "failure: proof does not match root",
+			malleate: func() {
+				root = bytes.Repeat([]byte{0x01}, 32)
+			},
+			expErr: attestations.ErrInvalidStorageProof,
+		},
+		{
+			name: "failure: key path with multiple elements",
+			malleate: func() {
+				path = commitmenttypesv2.NewMerklePath([]byte("ibc"), ibcPath)
+			},
+			expErr: attestations.ErrInvalidPath,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			slot := evm.CommitmentSlot(ibcPath, testCommitmentsSlot)
+			var proof []byte
+			root, proof = s.createEVMStorageProof(slot, commitment)
+			path = commitmenttypesv2.NewMerklePath(ibcPath)
+			value = commitment
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			s.initializeStateRootClient(ctx, attestations.NewEVMStorageStateRootConfig(testIBCContractAddress.Hex(), testCommitmentsSlot), 100, root)
+
+			height := clienttypes.NewHeight(0, 100)
+			err := s.lightClientModule.VerifyMembership(ctx, testClientID, height, 0, 0, proof, path, value)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			err = s.lightClientModule.VerifyNonMembership(ctx, testClientID, height, 0, 0, proof, path)
+			s.Require().ErrorIs(err, attestations.ErrNonMembershipFailed)
+
+			err = s.lightClientModule.VerifyNonMembership(ctx, testClientID, height, 0, 0, proof, commitmenttypesv2.NewMerklePath([]byte("other-path")))
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *AttestationsTestSuite) createStateRootAttestation(height, timestamp uint64, root []byte) []byte {
+	stateRootAttestation := attestations.StateRootAttestation{
+		Height:    height,
+		Timestamp: timestamp,
+		Root:      root,
+	}
+	data, err := stateRootAttestation.ABIEncode()
+	s.Require().NoError(err)
+	return data
+}
+
+func (s *AttestationsTestSuite) initializeStateRootClient(ctx sdk.Context, config *attestations.StateRootConfig, initialHeight uint64, root []byte) {
+	clientState := s.createClientState(initialHeight)
+	clientState.StateRootConfig = config
+	consensusState := &attestations.ConsensusState{
+		Timestamp: uint64(time.Second.Nanoseconds()),
+		Root:      root,
+	}
+
+	clientStateBz, err := s.chainA.App.AppCodec().Marshal(clientState)
+	s.Require().NoError(err)
+
+	consensusStateBz, err := s.chainA.App.AppCodec().Marshal(consensusState)
+	s.Require().NoError(err)
+
+	err = s.lightClientModule.Initialize(ctx, testClientID, clientStateBz, consensusStateBz)
+	s.Require().NoError(err)
+}
+
+// createEVMStorageProof creates a state trie holding only the IBC contract account, whose storage trie holds
+// only the provided slot. It returns the state root and the marshaled EvmStorageProof for the slot.
+func (s *AttestationsTestSuite) createEVMStorageProof(slot, value []byte) ([]byte, []byte) {
+	storageValue, err := rlp.EncodeToBytes(bytes.TrimLeft(value, "\x00"))
+	s.Require().NoError(err)
+	storageRoot, storageNode := s.singleLeafTrie(crypto.Keccak256(slot), storageValue)
+
+	account, err := rlp.EncodeToBytes([]any{uint64(1), uint64(0), storageRoot, crypto.Keccak256(nil)})
+	s.Require().NoError(err)
+	stateRoot, accountNode := s.singleLeafTrie(crypto.Keccak256(testIBCContractAddress.Bytes()), account)
+
+	proof, err := s.chainA.App.AppCodec().Marshal(&attestations.EvmStorageProof{
+		AccountProof: [][]byte{accountNode},
+		StorageProof: [][]byte{storageNode},
+	})
+	s.Require().NoError(err)
+
+	return stateRoot, proof
+}
+
+// singleLeafTrie returns the root hash and the RLP-encoded root node of a Merkle-Patricia trie with a single leaf.
+func (s *AttestationsTestSuite) singleLeafTrie(key, value []byte) ([]byte, []byte) {
+	// hex-prefix encoding of an even length leaf path
+	encodedPath := append([]byte{0x20}, key...)
+	node, err := rlp.EncodeToBytes([][]byte{encodedPath, value})
+	s.Require().NoError(err)
+
+	return crypto.Keccak256(node), node
+}
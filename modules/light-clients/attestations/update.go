@@ -17,6 +17,7 @@ import (
 
 // VerifyClientMessage introspects the provided ClientMessage and checks its validity.
 // An AttestationProof is considered valid if it has valid signatures from unique attestors meeting quorum.
+// Clients configured with a StateRootConfig require the signatures to cover a state root attestation.
 func (cs *ClientState) VerifyClientMessage(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) error {
 	if cs.IsFrozen {
 		return ErrClientFrozen
@@ -27,7 +28,7 @@ func (cs *ClientState) VerifyClientMessage(ctx sdk.Context, cdc codec.BinaryCode
 		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got type %T", (*AttestationProof)(nil), clientMsg)
 	}
 
-	return cs.verifySignatures(attestationProof, AttestationTypeState)
+	return cs.verifySignatures(attestationProof, cs.attestationTypeState())
 }
 
 // UpdateState updates the consensus state to a new height and timestamp.
@@ -39,23 +40,44 @@ func (cs *ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clien
 		panic(fmt.Sprintf("expected type %T, got type %T", (*AttestationProof)(nil), clientMsg))
 	}
 
-	stateAttestation, err := ABIDecodeStateAttestation(attestationProof.AttestationData)
+	attestedHeight, consensusState, err := cs.decodeStateAttestation(attestationProof.AttestationData)
 	if err != nil {
 		panic(fmt.Sprintf("failed to ABI decode attestation data: %v", err))
 	}
 
-	height := clienttypes.NewHeight(0, stateAttestation.Height)
-	consensusState := &ConsensusState{
-		Timestamp: stateAttestation.Timestamp,
+	setConsensusState(clientStore, cdc, consensusState, attestedHeight)
+
+	if attestedHeight.GT(cs.LatestHeight) {
+		cs.LatestHeight = attestedHeight
 	}
 
-	setConsensusState(clientStore, cdc, consensusState, height)
+	setClientState(clientStore, cdc, cs)
+
+	return []exported.Height{attestedHeight}
+}
+
+// decodeStateAttestation decodes the attestation data used for client updates into the attested height
+// and the resulting consensus state. Clients configured with a StateRootConfig expect a StateRootAttestation,
+// all other clients expect a StateAttestation.
+func (cs ClientState) decodeStateAttestation(attestationData []byte) (clienttypes.Height, *ConsensusState, error) {
+	if cs.StateRootConfig != nil {
+		stateRootAttestation, err := ABIDecodeStateRootAttestation(attestationData)
+		if err != nil {
+			return clienttypes.Height{}, nil, err
+		}
 
-	if stateAttestation.Height > cs.LatestHeight {
-		cs.LatestHeight = stateAttestation.Height
+		return clienttypes.NewHeight(stateRootAttestation.RevisionNumber, stateRootAttestation.Height), &ConsensusState{
+			Timestamp: stateRootAttestation.Timestamp,
+			Root:      stateRootAttestation.Root,
+		}, nil
 	}
 
-	setClientState(clientStore, cdc, cs)
+	stateAttestation, err := ABIDecodeStateAttestation(attestationData)
+	if err != nil {
+		return clienttypes.Height{}, nil, err
+	}
 
-	return []exported.Height{height}
+	return clienttypes.NewHeight(stateAttestation.RevisionNumber, stateAttestation.Height), &ConsensusState{
+		Timestamp: stateAttestation.Timestamp,
+	}, nil
 }
@@ -3,11 +3,15 @@
 package attestations_test
 
 import (
+	"bytes"
 	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types/v2"
 	"github.com/cosmos/ibc-go/v11/modules/core/exported"
 	"github.com/cosmos/ibc-go/v11/modules/light-clients/attestations"
 )
@@ -97,6 +101,60 @@ func (s *AttestationsTestSuite) TestUpdateStateIdempotency() {
 	s.Require().Equal(exported.Active, status)
 }
 
+func (s *AttestationsTestSuite) TestUpdateStateRevisionNumber() {
+	ctx := s.chainA.GetContext()
+	s.initializeClient(ctx, testClientID, 100, uint64(time.Second.Nanoseconds()))
+
+	// the attested chain resets its heights under a new revision number
+	stateAttestation := attestations.StateAttestation{
+		Height:         5,
+		Timestamp:      uint64(2 * time.Second.Nanoseconds()),
+		RevisionNumber: 1,
+	}
+	attestationData, err := stateAttestation.ABIEncode()
+	s.Require().NoError(err)
+	proof := s.createAttestationProof(attestationData, []int{0, 1, 2}, attestations.AttestationTypeState)
+
+	err = s.lightClientModule.VerifyClientMessage(ctx, testClientID, proof)
+	s.Require().NoError(err)
+
+	expHeight := clienttypes.NewHeight(1, 5)
+	heights := s.lightClientModule.UpdateState(ctx, testClientID, proof)
+	s.Require().Equal([]exported.Height{expHeight}, heights)
+	s.Require().Equal(expHeight, s.lightClientModule.LatestHeight(ctx, testClientID))
+
+	timestamp, err := s.lightClientModule.TimestampAtHeight(ctx, testClientID, expHeight)
+	s.Require().NoError(err)
+	s.Require().Equal(stateAttestation.Timestamp, timestamp)
+
+	// the consensus state at the previous revision is kept under its own key
+	_, err = s.lightClientModule.TimestampAtHeight(ctx, testClientID, clienttypes.NewHeight(0, 100))
+	s.Require().NoError(err)
+
+	// packet attestations must match the revision number of the proof height
+	path := []byte("test/path")
+	value := bytes.Repeat([]byte{0x01}, 32)
+	packetAttestation := attestations.PacketAttestation{
+		Height:         5,
+		Packets:        []attestations.PacketCompact{{Path: crypto.Keccak256(path), Commitment: value}},
+		RevisionNumber: 1,
+	}
+	packetData, err := packetAttestation.ABIEncode()
+	s.Require().NoError(err)
+	packetProof := s.marshalProof(s.createAttestationProof(packetData, []int{0, 1, 2}, attestations.AttestationTypePacket))
+
+	err = s.lightClientModule.VerifyMembership(ctx, testClientID, expHeight, 0, 0, packetProof, commitmenttypesv2.NewMerklePath(path), value)
+	s.Require().NoError(err)
+
+	packetAttestation.RevisionNumber = 0
+	packetData, err = packetAttestation.ABIEncode()
+	s.Require().NoError(err)
+	packetProof = s.marshalProof(s.createAttestationProof(packetData, []int{0, 1, 2}, attestations.AttestationTypePacket))
+
+	err = s.lightClientModule.VerifyMembership(ctx, testClientID, expHeight, 0, 0, packetProof, commitmenttypesv2.NewMerklePath(path), value)
+	s.Require().ErrorIs(err, attestations.ErrInvalidHeight)
+}
+
 func (s *AttestationsTestSuite) TestVerifyClientMessageFrozenClient() {
 	initialHeight := uint64(100)
 	initialTimestamp := uint64(time.Second.Nanoseconds())
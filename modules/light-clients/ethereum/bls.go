@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum
+
+import (
+	"errors"
+	"fmt"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+)
+
+const (
+	// PubkeyLength is the length of a compressed BLS12-381 public key.
+	PubkeyLength = 48
+	// SignatureLength is the length of a compressed BLS12-381 signature.
+	SignatureLength = 96
+)
+
+// signatureDST is the domain separation tag of the proof of possession BLS signature scheme used by the beacon chain.
+var signatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// fastAggregateVerify verifies the aggregate signature of the message by all the provided public keys,
+// following the FastAggregateVerify algorithm of the IETF BLS signature specification.
+func fastAggregateVerify(pubkeys [][]byte, message []byte, signature []byte) error {
+	if len(pubkeys) == 0 {
+		return errors.New("public keys cannot be empty")
+	}
+
+	var aggregate bls12381.G1Jac
+	for i, pubkey := range pubkeys {
+		var point bls12381.G1Affine
+		if len(pubkey) != PubkeyLength {
+			return fmt.Errorf("public key %d must be %d bytes, got %d", i, PubkeyLength, len(pubkey))
+		}
+
+		if _, err := point.SetBytes(pubkey); err != nil {
+			return fmt.Errorf("invalid public key %d: %w", i, err)
+		}
+
+		if point.IsInfinity() {
+			return fmt.Errorf("public key %d cannot be the identity", i)
+		}
+
+		aggregate.AddMixed(&point)
+	}
+
+	if len(signature) != SignatureLength {
+		return fmt.Errorf("signature must be %d bytes, got %d", SignatureLength, len(signature))
+	}
+
+	var sig bls12381.G2Affine
+	if _, err := sig.SetBytes(signature); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	hash, err := bls12381.HashToG2(message, signatureDST)
+	if err != nil {
+		return fmt.Errorf("failed to hash message: %w", err)
+	}
+
+	var aggregatePubkey bls12381.G1Affine
+	aggregatePubkey.FromJacobian(&aggregate)
+
+	// e(aggregatePubkey, H(m)) == e(g1, sig) is checked as e(-g1, sig) * e(aggregatePubkey, H(m)) == 1
+	_, _, g1, _ := bls12381.Generators()
+	var negG1 bls12381.G1Affine
+	negG1.Neg(&g1)
+
+	valid, err := bls12381.PairingCheck([]bls12381.G1Affine{negG1, aggregatePubkey}, []bls12381.G2Affine{sig, hash})
+	if err != nil {
+		return fmt.Errorf("failed to compute pairing: %w", err)
+	}
+
+	if !valid {
+		return errors.New("signature does not match the message and public keys")
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types/v2"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/internal/evm"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+const (
+	// RootLength is the length of SSZ hash tree roots and execution layer trie roots.
+	RootLength = 32
+	// ForkVersionLength is the length of a beacon chain fork version.
+	ForkVersionLength = 4
+)
+
+// ClientType is Ethereum.
+func (ClientState) ClientType() string {
+	return exported.Ethereum
+}
+
+// Validate performs basic validation of the client state fields.
+func (cs ClientState) Validate() error {
+	if len(cs.GenesisValidatorsRoot) != RootLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "genesis validators root must be %d bytes, got %d", RootLength, len(cs.GenesisValidatorsRoot))
+	}
+	if cs.SecondsPerSlot == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "seconds per slot cannot be 0")
+	}
+	if cs.SlotsPerEpoch == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "slots per epoch cannot be 0")
+	}
+	if cs.EpochsPerSyncCommitteePeriod == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "epochs per sync committee period cannot be 0")
+	}
+	if cs.SyncCommitteeSize == 0 || cs.SyncCommitteeSize%8 != 0 {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "sync committee size must be a non-zero multiple of 8, got %d", cs.SyncCommitteeSize)
+	}
+	if !common.IsHexAddress(cs.IbcContractAddress) {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "invalid IBC contract address format: %s", cs.IbcContractAddress)
+	}
+	if len(cs.IbcCommitmentSlot) != RootLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "IBC commitment slot must be %d bytes, got %d", RootLength, len(cs.IbcCommitmentSlot))
+	}
+	if cs.LatestSlot < cs.GenesisSlot {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "latest slot %d cannot be before genesis slot %d", cs.LatestSlot, cs.GenesisSlot)
+	}
+
+	return cs.ForkParameters.Validate()
+}
+
+// Validate performs basic validation of the fork parameters. Forks must be activated in order.
+func (fp ForkParameters) Validate() error {
+	if len(fp.GenesisForkVersion) != ForkVersionLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "genesis fork version must be %d bytes, got %d", ForkVersionLength, len(fp.GenesisForkVersion))
+	}
+
+	var previousEpoch uint64
+	for _, fork := range []struct {
+		name string
+		fork Fork
+	}{
+		{"altair", fp.Altair},
+		{"bellatrix", fp.Bellatrix},
+		{"capella", fp.Capella},
+		{"deneb", fp.Deneb},
+		{"electra", fp.Electra},
+		{"fulu", fp.Fulu},
+	} {
+		if len(fork.fork.Version) != ForkVersionLength {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "%s fork version must be %d bytes, got %d", fork.name, ForkVersionLength, len(fork.fork.Version))
+		}
+		if fork.fork.Epoch < previousEpoch {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "%s fork epoch %d cannot be before the previous fork epoch %d", fork.name, fork.fork.Epoch, previousEpoch)
+		}
+
+		previousEpoch = fork.fork.Epoch
+	}
+
+	return nil
+}
+
+// computeEpoch returns the epoch of the slot.
+func (cs ClientState) computeEpoch(slot uint64) uint64 {
+	return slot / cs.SlotsPerEpoch
+}
+
+// computeSyncCommitteePeriod returns the sync committee period of the slot.
+func (cs ClientState) computeSyncCommitteePeriod(slot uint64) uint64 {
+	return cs.computeEpoch(slot) / cs.EpochsPerSyncCommitteePeriod
+}
+
+// computeSlotAtTimestamp returns the beacon chain slot at the provided time.
+func (cs ClientState) computeSlotAtTimestamp(t time.Time) uint64 {
+	timestamp := uint64(t.Unix())
+	if timestamp < cs.GenesisTime {
+		return cs.GenesisSlot
+	}
+
+	return cs.GenesisSlot + (timestamp-cs.GenesisTime)/cs.SecondsPerSlot
+}
+
+// forkVersionAtEpoch returns the fork version active at the epoch.
+func (cs ClientState) forkVersionAtEpoch(epoch uint64) []byte {
+	fp := cs.ForkParameters
+	switch {
+	case epoch >= fp.Fulu.Epoch:
+		return fp.Fulu.Version
+	case epoch >= fp.Electra.Epoch:
+		return fp.Electra.Version
+	case epoch >= fp.Deneb.Epoch:
+		return fp.Deneb.Version
+	case epoch >= fp.Capella.Epoch:
+		return fp.Capella.Version
+	case epoch >= fp.Bellatrix.Epoch:
+		return fp.Bellatrix.Version
+	case epoch >= fp.Altair.Epoch:
+		return fp.Altair.Version
+	default:
+		return fp.GenesisForkVersion
+	}
+}
+
+// verifyMembership verifies a storage proof of the commitment stored for the path in the IBC contract at the specified height.
+func (cs *ClientState) verifyMembership(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	if cs.IsFrozen {
+		return ErrClientFrozen
+	}
+
+	if len(value) != 32 {
+		return errorsmod.Wrapf(ErrInvalidValue, "value must be 32 bytes, got %d", len(value))
+	}
+
+	storedValue, err := cs.verifyStorageProof(clientStore, cdc, height, proof, path)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(storedValue, value) {
+		return ErrNotMember
+	}
+
+	return nil
+}
+
+// verifyNonMembership verifies a storage proof of the absence of a commitment for the path in the IBC contract at the specified height.
+func (cs *ClientState) verifyNonMembership(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	proof []byte,
+	path exported.Path,
+) error {
+	if cs.IsFrozen {
+		return ErrClientFrozen
+	}
+
+	storedValue, err := cs.verifyStorageProof(clientStore, cdc, height, proof, path)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(storedValue, evm.NonMembershipCommitment) {
+		return ErrNonMembershipFailed
+	}
+
+	return nil
+}
+
+// verifyStorageProof verifies the storage proof of the commitment slot for the path against the storage root of the IBC contract
+// stored in the consensus state at the specified height, and returns the 32-byte value stored in the slot.
+func (cs *ClientState) verifyStorageProof(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	proof []byte,
+	path exported.Path,
+) ([]byte, error) {
+	if path == nil || path.Empty() {
+		return nil, errorsmod.Wrap(ErrInvalidPath, "path cannot be empty")
+	}
+
+	merklePath, ok := path.(commitmenttypesv2.MerklePath)
+	if !ok {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+	}
+
+	if len(merklePath.KeyPath) != 1 {
+		return nil, errorsmod.Wrapf(ErrInvalidPath, "key path must have exactly 1 element, got %d", len(merklePath.KeyPath))
+	}
+
+	if len(merklePath.KeyPath[0]) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidPath, "path cannot be empty")
+	}
+
+	consensusState, found := getConsensusState(clientStore, cdc, height)
+	if !found {
+		return nil, errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "consensus state not found for height %s", height)
+	}
+
+	var storageProof StorageProof
+	if err := cdc.Unmarshal(proof, &storageProof); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidStorageProof, "failed to unmarshal proof: %v", err)
+	}
+
+	slot := evm.CommitmentSlot(merklePath.KeyPath[0], cs.IbcCommitmentSlot)
+	return verifyStorageValue(consensusState.StorageRoot, slot, storageProof.Proof)
+}
+
+// sets the client state to the store
+func setClientState(store storetypes.KVStore, cdc codec.BinaryCodec, clientState exported.ClientState) {
+	bz := clienttypes.MustMarshalClientState(cdc, clientState)
+	store.Set(host.ClientStateKey(), bz)
+}
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/ethereum"
+)
+
+func (s *EthereumTestSuite) TestClientStateValidate() {
+	var clientState *ethereum.ClientState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid genesis validators root",
+			func() {
+				clientState.GenesisValidatorsRoot = clientState.GenesisValidatorsRoot[1:]
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: zero seconds per slot",
+			func() {
+				clientState.SecondsPerSlot = 0
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: zero epochs per sync committee period",
+			func() {
+				clientState.EpochsPerSyncCommitteePeriod = 0
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: sync committee size is not a multiple of 8",
+			func() {
+				clientState.SyncCommitteeSize = 31
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: invalid IBC contract address",
+			func() {
+				clientState.IbcContractAddress = "invalid"
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: invalid IBC commitment slot",
+			func() {
+				clientState.IbcCommitmentSlot = []byte{0x01}
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: latest slot before genesis slot",
+			func() {
+				clientState.GenesisSlot = clientState.LatestSlot + 1
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: invalid genesis fork version",
+			func() {
+				clientState.ForkParameters.GenesisForkVersion = nil
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: invalid fork version",
+			func() {
+				clientState.ForkParameters.Deneb.Version = []byte{0x04}
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: forks out of order",
+			func() {
+				clientState.ForkParameters.Capella.Epoch = clientState.ForkParameters.Electra.Epoch + 1
+			},
+			clienttypes.ErrInvalidClient,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			clientState = s.clientState()
+
+			tc.malleate()
+
+			err := clientState.Validate()
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *EthereumTestSuite) TestHeaderValidateBasic() {
+	var header *ethereum.Header
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid active sync committee public key",
+			func() {
+				header.ActiveSyncCommittee.Pubkeys[0] = header.ActiveSyncCommittee.Pubkeys[0][1:]
+			},
+			ethereum.ErrInvalidSyncCommittee,
+		},
+		{
+			"failure: invalid next sync committee aggregate public key",
+			func() {
+				header.ConsensusUpdate.NextSyncCommittee.AggregatePubkey = nil
+			},
+			ethereum.ErrInvalidSyncCommittee,
+		},
+		{
+			"failure: next sync committee branch without next sync committee",
+			func() {
+				header.ConsensusUpdate.NextSyncCommittee = nil
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: invalid attested beacon state root",
+			func() {
+				header.ConsensusUpdate.AttestedHeader.Beacon.StateRoot = nil
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: invalid finalized execution fee recipient",
+			func() {
+				header.ConsensusUpdate.FinalizedHeader.Execution.FeeRecipient = make([]byte, 32)
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: invalid finalized execution logs bloom",
+			func() {
+				header.ConsensusUpdate.FinalizedHeader.Execution.LogsBloom = nil
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: execution extra data too long",
+			func() {
+				header.ConsensusUpdate.FinalizedHeader.Execution.ExtraData = make([]byte, 33)
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: invalid sync committee signature",
+			func() {
+				header.ConsensusUpdate.SyncAggregate.SyncCommitteeSignature = nil
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: signature slot is not after the attested slot",
+			func() {
+				header.ConsensusUpdate.SignatureSlot = header.ConsensusUpdate.AttestedHeader.Beacon.Slot
+			},
+			ethereum.ErrInvalidSlot,
+		},
+		{
+			"failure: attested slot is before the finalized slot",
+			func() {
+				header.ConsensusUpdate.FinalizedHeader.Beacon.Slot = header.ConsensusUpdate.AttestedHeader.Beacon.Slot + 1
+			},
+			ethereum.ErrInvalidSlot,
+		},
+		{
+			"failure: empty account proof",
+			func() {
+				header.AccountProof = nil
+			},
+			ethereum.ErrInvalidHeader,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			header = s.header(0)
+
+			tc.malleate()
+
+			err := header.ValidateBasic()
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ exported.ConsensusState = (*ConsensusState)(nil)
+
+// ClientType returns Ethereum type.
+func (ConsensusState) ClientType() string {
+	return exported.Ethereum
+}
+
+// GetTimestamp is deprecated and will panic if called.
+func (ConsensusState) GetTimestamp() uint64 {
+	panic("GetTimestamp is deprecated")
+}
+
+// getTimestampNanos returns the timestamp of the consensus state in nanoseconds.
+func (cs ConsensusState) getTimestampNanos() uint64 {
+	return cs.Timestamp * uint64(time.Second)
+}
+
+// ValidateBasic defines basic validation for the Ethereum consensus state.
+func (cs ConsensusState) ValidateBasic() error {
+	if cs.Timestamp == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "timestamp cannot be 0")
+	}
+	if len(cs.StateRoot) != RootLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "state root must be %d bytes, got %d", RootLength, len(cs.StateRoot))
+	}
+	if len(cs.StorageRoot) != RootLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "storage root must be %d bytes, got %d", RootLength, len(cs.StorageRoot))
+	}
+	if len(cs.CurrentSyncCommittee) != RootLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "current sync committee must be %d bytes, got %d", RootLength, len(cs.CurrentSyncCommittee))
+	}
+	if len(cs.NextSyncCommittee) != 0 && len(cs.NextSyncCommittee) != RootLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "next sync committee must be empty or %d bytes, got %d", RootLength, len(cs.NextSyncCommittee))
+	}
+	return nil
+}
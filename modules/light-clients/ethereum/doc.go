@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ethereum implements a native IBC light client for Ethereum which follows
+// the beacon chain using the Altair sync committee light client protocol and
+// verifies IBC commitments with execution layer storage proofs.
+//
+// # Experimental
+//
+// This package is EXPERIMENTAL and is not yet stable. It may change
+// in backwards-incompatible ways without notice.
+//
+// Client updates carry a light client update as served by the beacon node light
+// client API together with the sync committee which signed it. An update is
+// accepted if:
+//   - at least two thirds of the sync committee signed the attested header with
+//     a BLS12-381 aggregate signature over the sync committee signing domain
+//   - the signing sync committee is the current or the next sync committee of
+//     the trusted consensus state, so that the client can only move forward one
+//     sync committee period at a time
+//   - the finalized header and, if present, the next sync committee are proven
+//     in the attested beacon state, and the execution payload headers are proven
+//     in their beacon block bodies
+//   - the account of the ICS26 router contract is proven in the finalized
+//     execution state
+//
+// Consensus states are stored per finalized slot, using revision number 0 and the
+// slot as revision height. They hold the execution state root, the storage root
+// of the ICS26 router contract, the execution timestamp and the hash tree roots
+// of the current and next sync committees. When the finalized slot enters the
+// next sync committee period the committees are rotated.
+//
+// Membership proofs are Ethereum storage proofs of the commitments mapping of the
+// solidity-ibc-eureka ICS26 router, verified against the stored storage root. The
+// commitment for a path is stored at keccak256(keccak256(path) || slot), where
+// slot is the configured storage slot of the mapping. Absent commitments are
+// proven with a zero value.
+//
+// Light client headers are supported from the Deneb fork onwards, including the
+// beacon state layout changes of the Electra fork.
+//
+// Limitations:
+//   - No trusting period: the client does not expire
+//   - No client recovery or upgrades
+//   - Misbehaviour is only detected for conflicting finalized execution states
+//     submitted as client updates
+package ethereum
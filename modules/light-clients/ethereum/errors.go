@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidHeader             = errorsmod.Register(ModuleName, 2, "invalid header")
+	ErrInvalidSignature          = errorsmod.Register(ModuleName, 3, "invalid sync committee signature")
+	ErrInsufficientParticipation = errorsmod.Register(ModuleName, 4, "insufficient sync committee participation")
+	ErrInvalidSlot               = errorsmod.Register(ModuleName, 5, "invalid slot")
+	ErrInvalidSyncCommittee      = errorsmod.Register(ModuleName, 6, "invalid sync committee")
+	ErrInvalidMerkleBranch       = errorsmod.Register(ModuleName, 7, "invalid merkle branch")
+	ErrUnsupportedFork           = errorsmod.Register(ModuleName, 8, "unsupported fork")
+	ErrClientFrozen              = errorsmod.Register(ModuleName, 9, "client is frozen")
+	ErrInvalidPath               = errorsmod.Register(ModuleName, 10, "invalid path")
+	ErrInvalidValue              = errorsmod.Register(ModuleName, 11, "invalid value")
+	ErrInvalidAccountProof       = errorsmod.Register(ModuleName, 12, "invalid account proof")
+	ErrInvalidStorageProof       = errorsmod.Register(ModuleName, 13, "invalid storage proof")
+	ErrNotMember                 = errorsmod.Register(ModuleName, 14, "membership verification failed: value does not match the stored commitment")
+	ErrNonMembershipFailed       = errorsmod.Register(ModuleName, 15, "non-membership verification failed: commitment is not zero")
+)
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ComputeForkDataRoot is a wrapper around computeForkDataRoot to allow the function to be directly called in tests.
+func ComputeForkDataRoot(forkVersion, genesisValidatorsRoot []byte) [32]byte {
+	return computeForkDataRoot(forkVersion, genesisValidatorsRoot)
+}
+
+// ForkVersionAtEpoch is a wrapper around cs.forkVersionAtEpoch to allow the method to be directly called in tests.
+func (cs ClientState) ForkVersionAtEpoch(epoch uint64) []byte {
+	return cs.forkVersionAtEpoch(epoch)
+}
+
+// VerifyLightClientUpdate is a wrapper around cs.verifyLightClientUpdate to allow the method to be directly called in
+// tests, bypassing the validation of the header.
+func (cs ClientState) VerifyLightClientUpdate(ctx sdk.Context, header *Header, trustedConsensusState *ConsensusState) error {
+	return cs.verifyLightClientUpdate(ctx, header, trustedConsensusState)
+}
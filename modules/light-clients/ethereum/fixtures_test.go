@@ -0,0 +1,621 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/trie/trienode"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/ethereum"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/internal/evm"
+)
+
+// fixturesPath is the path of the light client fixtures.
+const fixturesPath = "testdata/fixtures.json"
+
+// generateFixturesEnv is the environment variable which enables the regeneration of the fixtures.
+const generateFixturesEnv = "ETHEREUM_GENERATE_FIXTURES"
+
+// fixtures is the client state, initial consensus state and sequence of client updates of a
+// beacon chain, together with storage proofs of the IBC contract at the finalized slots.
+type fixtures struct {
+	ClientState    json.RawMessage     `json:"client_state"`
+	ConsensusState json.RawMessage     `json:"consensus_state"`
+	Updates        []fixtureUpdate     `json:"updates"`
+	Commitments    []fixtureCommitment `json:"commitments"`
+}
+
+// fixtureUpdate is a client update and the UNIX block time (seconds) at which it is submitted.
+type fixtureUpdate struct {
+	BlockTime int64           `json:"block_time"`
+	Header    json.RawMessage `json:"header"`
+}
+
+// fixtureCommitment is a storage proof of the IBC commitment for a path at a finalized slot.
+// An empty value denotes a proof of absence.
+type fixtureCommitment struct {
+	Slot  uint64 `json:"slot"`
+	Path  []byte `json:"path"`
+	Value []byte `json:"value"`
+	Proof []byte `json:"proof"`
+}
+
+// loadFixtures reads the fixtures.
+func loadFixtures(t *testing.T) fixtures {
+	t.Helper()
+
+	bz, err := os.ReadFile(fixturesPath)
+	require.NoError(t, err)
+
+	var f fixtures
+	require.NoError(t, json.Unmarshal(bz, &f))
+
+	return f
+}
+
+// The fixtures follow a synthetic beacon chain using the minimal preset (8 slots per epoch,
+// 8 epochs per sync committee period and sync committees of 32 validators) with deterministic BLS keys.
+// The Electra fork is activated at epoch 16. The chain is built with the same SSZ containers, merkle
+// branches and signing domains as the beacon chain light client API, and the execution state of each
+// finalized block is a Merkle-Patricia trie holding the IBC contract account and its commitments. The
+// hash tree roots of the SSZ containers are computed by the reference merkleization below, written from
+// the consensus specs, rather than by the client, so that the fixtures do not inherit its SSZ encoding.
+//
+// The updates are, in order:
+//   - finalizing slot 48 from the initial slot 40 within period 0, revealing the next sync committee
+//   - finalizing slot 64 of period 1, signed by the next sync committee
+//   - finalizing slot 136 of period 2 after the Electra fork, signed by the next sync committee
+//
+// Run the test with ETHEREUM_GENERATE_FIXTURES=true to regenerate the fixtures.
+func TestGenerateFixtures(t *testing.T) {
+	if os.Getenv(generateFixturesEnv) == "" {
+		t.Skipf("set %s to regenerate the fixtures", generateFixturesEnv)
+	}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	chain := newSyntheticChain()
+
+	clientState := chain.clientState(40)
+	initial := chain.finalize(40, nil)
+	consensusState := &ethereum.ConsensusState{
+		Slot:                 40,
+		StateRoot:            initial.execution.StateRoot,
+		StorageRoot:          initial.storageRoot,
+		Timestamp:            initial.execution.Timestamp,
+		CurrentSyncCommittee: hashTreeRoot(syncCommitteeRoot(chain.committee(0))),
+	}
+
+	var f fixtures
+	f.ClientState = mustMarshalJSON(t, cdc, clientState)
+	f.ConsensusState = mustMarshalJSON(t, cdc, consensusState)
+
+	commitmentPath := []byte("07-tendermint-0\x01\x00\x00\x00\x00\x00\x00\x00\x01")
+	commitment := crypto.Keccak256([]byte("packet commitment"))
+	absentPath := []byte("07-tendermint-0\x01\x00\x00\x00\x00\x00\x00\x00\x02")
+
+	for _, u := range []struct {
+		trusted, finalized, attested, signature uint64
+		commitments                             map[string][]byte
+	}{
+		{40, 48, 56, 57, nil},
+		{48, 64, 72, 73, nil},
+		{64, 136, 144, 145, map[string][]byte{string(commitmentPath): commitment}},
+	} {
+		finalized := chain.finalize(u.finalized, u.commitments)
+		header := chain.update(u.trusted, finalized, u.attested, u.signature)
+		f.Updates = append(f.Updates, fixtureUpdate{
+			BlockTime: int64(chain.genesisTime + (u.signature+1)*chain.secondsPerSlot),
+			Header:    mustMarshalJSON(t, cdc, header),
+		})
+
+		for _, path := range [][]byte{commitmentPath, absentPath} {
+			value, proof := finalized.storageProof(chain.commitmentSlot, path)
+			proofBz, err := cdc.Marshal(&ethereum.StorageProof{Proof: proof})
+			require.NoError(t, err)
+
+			f.Commitments = append(f.Commitments, fixtureCommitment{
+				Slot:  u.finalized,
+				Path:  path,
+				Value: value,
+				Proof: proofBz,
+			})
+		}
+	}
+
+	bz, err := json.MarshalIndent(f, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(fixturesPath, append(bz, '\n'), 0o600))
+}
+
+// syntheticChain generates beacon chain light client updates signed by deterministic sync committees.
+type syntheticChain struct {
+	genesisTime           uint64
+	secondsPerSlot        uint64
+	slotsPerEpoch         uint64
+	epochsPerPeriod       uint64
+	syncCommitteeSize     int
+	genesisValidatorsRoot []byte
+	forkParameters        ethereum.ForkParameters
+	contractAddress       common.Address
+	commitmentSlot        []byte
+
+	// commitments holds the IBC commitments stored in the contract, accumulated over finalized blocks
+	commitments map[string][]byte
+}
+
+// finalizedBlock is a finalized beacon block together with its execution state.
+type finalizedBlock struct {
+	header      ethereum.LightClientHeader
+	execution   ethereum.ExecutionPayloadHeader
+	storageRoot []byte
+	stateTrie   *trie.Trie
+	storageTrie *trie.Trie
+	accountKey  []byte
+}
+
+func newSyntheticChain() *syntheticChain {
+	return &syntheticChain{
+		genesisTime:           1_700_000_000,
+		secondsPerSlot:        6,
+		slotsPerEpoch:         8,
+		epochsPerPeriod:       8,
+		syncCommitteeSize:     32,
+		genesisValidatorsRoot: filler("genesis validators root", 0),
+		forkParameters: ethereum.ForkParameters{
+			GenesisForkVersion: []byte{0x00, 0x00, 0x00, 0x01},
+			Altair:             ethereum.Fork{Version: []byte{0x01, 0x00, 0x00, 0x01}, Epoch: 0},
+			Bellatrix:          ethereum.Fork{Version: []byte{0x02, 0x00, 0x00, 0x01}, Epoch: 0},
+			Capella:            ethereum.Fork{Version: []byte{0x03, 0x00, 0x00, 0x01}, Epoch: 0},
+			Deneb:              ethereum.Fork{Version: []byte{0x04, 0x00, 0x00, 0x01}, Epoch: 0},
+			Electra:            ethereum.Fork{Version: []byte{0x05, 0x00, 0x00, 0x01}, Epoch: 16},
+			Fulu:               ethereum.Fork{Version: []byte{0x06, 0x00, 0x00, 0x01}, Epoch: math.MaxUint64},
+		},
+		contractAddress: common.HexToAddress("0x3aa5ebb10dc797cac828524e59a333d0a371443c"),
+		commitmentSlot:  filler("ics26 commitment slot", 0),
+		commitments:     make(map[string][]byte),
+	}
+}
+
+func (c *syntheticChain) clientState(latestSlot uint64) *ethereum.ClientState {
+	return &ethereum.ClientState{
+		ChainID:                      11155111,
+		GenesisValidatorsRoot:        c.genesisValidatorsRoot,
+		GenesisTime:                  c.genesisTime,
+		GenesisSlot:                  0,
+		ForkParameters:               c.forkParameters,
+		SecondsPerSlot:               c.secondsPerSlot,
+		SlotsPerEpoch:                c.slotsPerEpoch,
+		EpochsPerSyncCommitteePeriod: c.epochsPerPeriod,
+		SyncCommitteeSize:            uint64(c.syncCommitteeSize),
+		IbcContractAddress:           c.contractAddress.Hex(),
+		IbcCommitmentSlot:            c.commitmentSlot,
+		LatestSlot:                   latestSlot,
+	}
+}
+
+func (c *syntheticChain) period(slot uint64) uint64 {
+	return slot / c.slotsPerEpoch / c.epochsPerPeriod
+}
+
+func (c *syntheticChain) isElectra(slot uint64) bool {
+	return slot/c.slotsPerEpoch >= c.forkParameters.Electra.Epoch
+}
+
+// secretKeys returns the deterministic secret keys of the sync committee of the period.
+func (c *syntheticChain) secretKeys(period uint64) []*big.Int {
+	keys := make([]*big.Int, c.syncCommitteeSize)
+	for i := range keys {
+		seed := sha256.Sum256(fmt.Appendf(nil, "sync committee %d member %d", period, i))
+		keys[i] = new(big.Int).Mod(new(big.Int).SetBytes(seed[:]), fr.Modulus())
+	}
+
+	return keys
+}
+
+// committee returns the sync committee of the period.
+func (c *syntheticChain) committee(period uint64) ethereum.SyncCommittee {
+	_, _, g1, _ := bls12381.Generators()
+
+	var (
+		committee ethereum.SyncCommittee
+		aggregate bls12381.G1Jac
+	)
+	for _, sk := range c.secretKeys(period) {
+		var pubkey bls12381.G1Affine
+		pubkey.ScalarMultiplication(&g1, sk)
+		aggregate.AddMixed(&pubkey)
+
+		bz := pubkey.Bytes()
+		committee.Pubkeys = append(committee.Pubkeys, bz[:])
+	}
+
+	var aggregatePubkey bls12381.G1Affine
+	aggregatePubkey.FromJacobian(&aggregate)
+	bz := aggregatePubkey.Bytes()
+	committee.AggregatePubkey = bz[:]
+
+	return committee
+}
+
+// finalize builds the beacon block at the slot, after storing the commitments in the IBC contract.
+func (c *syntheticChain) finalize(slot uint64, commitments map[string][]byte) *finalizedBlock {
+	for path, commitment := range commitments {
+		c.commitments[path] = commitment
+	}
+
+	block := &finalizedBlock{
+		stateTrie:   trie.NewEmpty(nil),
+		storageTrie: trie.NewEmpty(nil),
+		accountKey:  crypto.Keccak256(c.contractAddress.Bytes()),
+	}
+
+	// the contract always holds an unrelated storage slot, so that its storage trie is never empty
+	mustUpdateTrie(block.storageTrie, crypto.Keccak256(filler("ics26 storage", 0)), rlpBytes(filler("ics26 value", 0)))
+	for path, commitment := range c.commitments {
+		mustUpdateTrie(block.storageTrie, crypto.Keccak256(evm.CommitmentSlot([]byte(path), c.commitmentSlot)), rlpBytes(commitment))
+	}
+
+	storageRoot := block.storageTrie.Hash()
+	block.storageRoot = storageRoot.Bytes()
+
+	account, err := rlp.EncodeToBytes([]any{slot, big.NewInt(0), storageRoot, crypto.Keccak256(filler("ics26 code", 0))})
+	if err != nil {
+		panic(err)
+	}
+
+	mustUpdateTrie(block.stateTrie, block.accountKey, account)
+	for i := range uint64(8) {
+		other, err := rlp.EncodeToBytes([]any{slot + i, big.NewInt(int64(i)), common.Hash(trie.NewEmpty(nil).Hash()), crypto.Keccak256(nil)})
+		if err != nil {
+			panic(err)
+		}
+
+		mustUpdateTrie(block.stateTrie, crypto.Keccak256(filler("account", slot*8+i)[:common.AddressLength]), other)
+	}
+
+	block.execution = c.executionPayload(slot, block.stateTrie.Hash().Bytes())
+	block.header = c.lightClientHeader(slot, block.execution, filler("beacon state root", slot))
+
+	return block
+}
+
+// update returns the header finalizing the block, attested at the attested slot and signed at the signature slot.
+// The update includes the next sync committee of the period of the attested slot.
+func (c *syntheticChain) update(trustedSlot uint64, finalized *finalizedBlock, attestedSlot, signatureSlot uint64) *ethereum.Header {
+	attestedPeriod := c.period(attestedSlot)
+	nextSyncCommittee := c.committee(attestedPeriod + 1)
+
+	finalizedRootGindex, currentGindex, nextGindex := uint64(105), uint64(54), uint64(55)
+	if c.isElectra(attestedSlot) {
+		finalizedRootGindex, currentGindex, nextGindex = 169, 86, 87
+	}
+
+	beaconState := newSparseTree(fmt.Sprintf("beacon state %d", attestedSlot), map[uint64][32]byte{
+		finalizedRootGindex: beaconBlockHeaderRoot(finalized.header.Beacon),
+		currentGindex:       syncCommitteeRoot(c.committee(attestedPeriod)),
+		nextGindex:          syncCommitteeRoot(nextSyncCommittee),
+	})
+	stateRoot := beaconState.root()
+
+	attested := c.lightClientHeader(attestedSlot, c.executionPayload(attestedSlot, filler("execution state root", attestedSlot)), stateRoot[:])
+
+	signingPeriod := c.period(signatureSlot)
+	forkVersion := c.forkParameters.Deneb.Version
+	if c.isElectra(signatureSlot - 1) {
+		forkVersion = c.forkParameters.Electra.Version
+	}
+
+	forkDataRoot := sha256.Sum256(append(chunk(forkVersion), c.genesisValidatorsRoot...))
+	domain := append([]byte{0x07, 0x00, 0x00, 0x00}, forkDataRoot[:28]...)
+	attestedRoot := beaconBlockHeaderRoot(attested.Beacon)
+	signingRoot := sha256.Sum256(append(attestedRoot[:], domain...))
+
+	// all members but two participate
+	bits := make([]byte, c.syncCommitteeSize/8)
+	participants := new(big.Int)
+	for i, sk := range c.secretKeys(signingPeriod) {
+		if i == 3 || i == 17 {
+			continue
+		}
+
+		bits[i/8] |= 1 << (i % 8)
+		participants.Add(participants, sk)
+	}
+
+	hash, err := bls12381.HashToG2(signingRoot[:], []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"))
+	if err != nil {
+		panic(err)
+	}
+
+	var signature bls12381.G2Affine
+	signature.ScalarMultiplication(&hash, participants.Mod(participants, fr.Modulus()))
+	signatureBz := signature.Bytes()
+
+	accountProof := trienode.ProofList{}
+	if err := finalized.stateTrie.Prove(finalized.accountKey, &accountProof); err != nil {
+		panic(err)
+	}
+
+	return &ethereum.Header{
+		ActiveSyncCommittee: c.committee(signingPeriod),
+		ConsensusUpdate: ethereum.LightClientUpdate{
+			AttestedHeader:          attested,
+			NextSyncCommittee:       &nextSyncCommittee,
+			NextSyncCommitteeBranch: beaconState.branch(nextGindex),
+			FinalizedHeader:         finalized.header,
+			FinalityBranch:          beaconState.branch(finalizedRootGindex),
+			SyncAggregate: ethereum.SyncAggregate{
+				SyncCommitteeBits:      bits,
+				SyncCommitteeSignature: signatureBz[:],
+			},
+			SignatureSlot: signatureSlot,
+		},
+		TrustedSlot:  trustedSlot,
+		AccountProof: proofBytes(accountProof),
+	}
+}
+
+// storageProof returns the value stored for the path in the IBC contract, or nil if absent, and the storage proof of the slot.
+func (b *finalizedBlock) storageProof(commitmentSlot, path []byte) ([]byte, [][]byte) {
+	key := crypto.Keccak256(evm.CommitmentSlot(path, commitmentSlot))
+
+	valueRLP, err := b.storageTrie.Get(key)
+	if err != nil {
+		panic(err)
+	}
+
+	var value []byte
+	if len(valueRLP) != 0 {
+		if err := rlp.DecodeBytes(valueRLP, &value); err != nil {
+			panic(err)
+		}
+		value = common.LeftPadBytes(value, 32)
+	}
+
+	proof := trienode.ProofList{}
+	if err := b.storageTrie.Prove(key, &proof); err != nil {
+		panic(err)
+	}
+
+	return value, proofBytes(proof)
+}
+
+func (c *syntheticChain) executionPayload(slot uint64, stateRoot []byte) ethereum.ExecutionPayloadHeader {
+	baseFee := make([]byte, 32)
+	binary.LittleEndian.PutUint64(baseFee, 7_000_000_000)
+
+	return ethereum.ExecutionPayloadHeader{
+		ParentHash:       filler("parent hash", slot),
+		FeeRecipient:     filler("fee recipient", slot)[:common.AddressLength],
+		StateRoot:        stateRoot,
+		ReceiptsRoot:     filler("receipts root", slot),
+		LogsBloom:        make([]byte, 256),
+		PrevRandao:       filler("prev randao", slot),
+		BlockNumber:      1000 + slot,
+		GasLimit:         30_000_000,
+		GasUsed:          21_000 * slot,
+		Timestamp:        c.genesisTime + slot*c.secondsPerSlot,
+		ExtraData:        []byte("ibc-go"),
+		BaseFeePerGas:    baseFee,
+		BlockHash:        filler("block hash", slot),
+		TransactionsRoot: filler("transactions root", slot),
+		WithdrawalsRoot:  filler("withdrawals root", slot),
+		BlobGasUsed:      131072,
+		ExcessBlobGas:    0,
+	}
+}
+
+func (*syntheticChain) lightClientHeader(slot uint64, execution ethereum.ExecutionPayloadHeader, stateRoot []byte) ethereum.LightClientHeader {
+	body := newSparseTree(fmt.Sprintf("beacon block body %d", slot), map[uint64][32]byte{
+		25: executionPayloadHeaderRoot(execution),
+	})
+	bodyRoot := body.root()
+
+	return ethereum.LightClientHeader{
+		Beacon: ethereum.BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: slot % 7,
+			ParentRoot:    filler("parent root", slot),
+			StateRoot:     stateRoot,
+			BodyRoot:      bodyRoot[:],
+		},
+		Execution:       execution,
+		ExecutionBranch: body.branch(25),
+	}
+}
+
+// beaconBlockHeaderRoot returns the hash tree root of the BeaconBlockHeader container of the phase0 specs.
+func beaconBlockHeaderRoot(h ethereum.BeaconBlockHeader) [32]byte {
+	return containerRoot(
+		uint64Leaf(h.Slot),
+		uint64Leaf(h.ProposerIndex),
+		[32]byte(h.ParentRoot),
+		[32]byte(h.StateRoot),
+		[32]byte(h.BodyRoot),
+	)
+}
+
+// executionPayloadHeaderRoot returns the hash tree root of the ExecutionPayloadHeader container of the deneb
+// specs, which is unchanged in electra.
+func executionPayloadHeaderRoot(h ethereum.ExecutionPayloadHeader) [32]byte {
+	// logs_bloom is a ByteVector[256] and extra_data a ByteList[32], whose root mixes in its length
+	var extraDataLength [32]byte
+	binary.LittleEndian.PutUint64(extraDataLength[:], uint64(len(h.ExtraData)))
+
+	return containerRoot(
+		[32]byte(h.ParentHash),
+		[32]byte(chunk(h.FeeRecipient)),
+		[32]byte(h.StateRoot),
+		[32]byte(h.ReceiptsRoot),
+		containerRoot(chunks(h.LogsBloom)...),
+		[32]byte(h.PrevRandao),
+		uint64Leaf(h.BlockNumber),
+		uint64Leaf(h.GasLimit),
+		uint64Leaf(h.GasUsed),
+		uint64Leaf(h.Timestamp),
+		sha256.Sum256(append(chunk(h.ExtraData), extraDataLength[:]...)),
+		[32]byte(h.BaseFeePerGas),
+		[32]byte(h.BlockHash),
+		[32]byte(h.TransactionsRoot),
+		[32]byte(h.WithdrawalsRoot),
+		uint64Leaf(h.BlobGasUsed),
+		uint64Leaf(h.ExcessBlobGas),
+	)
+}
+
+// syncCommitteeRoot returns the hash tree root of the SyncCommittee container of the altair specs. Each
+// BLSPubkey is a ByteVector[48] spanning two chunks.
+func syncCommitteeRoot(sc ethereum.SyncCommittee) [32]byte {
+	pubkeyRoots := make([][32]byte, len(sc.Pubkeys))
+	for i, pubkey := range sc.Pubkeys {
+		pubkeyRoots[i] = containerRoot(chunks(pubkey)...)
+	}
+
+	return containerRoot(containerRoot(pubkeyRoots...), containerRoot(chunks(sc.AggregatePubkey)...))
+}
+
+// containerRoot merkleizes the leaves, padded with zero leaves to the next power of two.
+func containerRoot(leaves ...[32]byte) [32]byte {
+	width := 1
+	for width < len(leaves) {
+		width *= 2
+	}
+
+	layer := make([][32]byte, width)
+	copy(layer, leaves)
+	for len(layer) > 1 {
+		parents := make([][32]byte, len(layer)/2)
+		for i := range parents {
+			parents[i] = sha256.Sum256(append(layer[2*i][:], layer[2*i+1][:]...))
+		}
+		layer = parents
+	}
+
+	return layer[0]
+}
+
+// uint64Leaf returns the little-endian SSZ leaf of the uint64.
+func uint64Leaf(value uint64) [32]byte {
+	var leaf [32]byte
+	binary.LittleEndian.PutUint64(leaf[:], value)
+
+	return leaf
+}
+
+// chunks splits the bytes into 32-byte chunks, right padding the last chunk.
+func chunks(bz []byte) [][32]byte {
+	var chunks [][32]byte
+	for i := 0; i < len(bz); i += 32 {
+		chunks = append(chunks, [32]byte(chunk(bz[i:min(i+32, len(bz))])))
+	}
+
+	return chunks
+}
+
+// sparseTree is a binary merkle tree in which only the provided leaves are known. All other subtrees are
+// deterministic filler hashes.
+type sparseTree struct {
+	seed   string
+	leaves map[uint64][32]byte
+}
+
+func newSparseTree(seed string, leaves map[uint64][32]byte) sparseTree {
+	return sparseTree{seed: seed, leaves: leaves}
+}
+
+func (t sparseTree) root() [32]byte {
+	return t.node(1)
+}
+
+func (t sparseTree) branch(gindex uint64) [][]byte {
+	var branch [][]byte
+	for ; gindex > 1; gindex >>= 1 {
+		sibling := t.node(gindex ^ 1)
+		branch = append(branch, sibling[:])
+	}
+
+	return branch
+}
+
+func (t sparseTree) node(gindex uint64) [32]byte {
+	if leaf, ok := t.leaves[gindex]; ok {
+		return leaf
+	}
+
+	for leaf := range t.leaves {
+		for ancestor := leaf >> 1; ancestor > 0; ancestor >>= 1 {
+			if ancestor == gindex {
+				left, right := t.node(2*gindex), t.node(2*gindex+1)
+				return sha256.Sum256(append(left[:], right[:]...))
+			}
+		}
+	}
+
+	return [32]byte(filler(t.seed, gindex))
+}
+
+// filler returns a deterministic 32-byte value for the label and index.
+func filler(label string, index uint64) []byte {
+	hash := sha256.Sum256(fmt.Appendf(nil, "%s %d", label, index))
+	return hash[:]
+}
+
+// chunk right pads the bytes to 32 bytes.
+func chunk(bz []byte) []byte {
+	return common.RightPadBytes(bz, 32)
+}
+
+func hashTreeRoot(root [32]byte) []byte {
+	return root[:]
+}
+
+func rlpBytes(value []byte) []byte {
+	bz, err := rlp.EncodeToBytes(common.TrimLeftZeroes(value))
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func mustUpdateTrie(t *trie.Trie, key, value []byte) {
+	if err := t.Update(key, value); err != nil {
+		panic(err)
+	}
+}
+
+func proofBytes(proof trienode.ProofList) [][]byte {
+	nodes := make([][]byte, len(proof))
+	for i, node := range proof {
+		nodes[i] = node
+	}
+
+	return nodes
+}
+
+func mustMarshalJSON(t *testing.T, cdc codec.JSONCodec, msg codec.ProtoMarshaler) json.RawMessage {
+	t.Helper()
+
+	bz, err := cdc.MarshalJSON(msg)
+	require.NoError(t, err)
+
+	return bz
+}
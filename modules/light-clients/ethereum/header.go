@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*Header)(nil)
+
+const (
+	// addressLength is the length of an execution layer address.
+	addressLength = 20
+	// logsBloomLength is the length of the logs bloom filter of an execution payload.
+	logsBloomLength = 256
+	// maxExtraDataLength is the maximum length of the extra data of an execution payload.
+	maxExtraDataLength = 32
+)
+
+// ClientType defines that the Header is an Ethereum light client message.
+func (Header) ClientType() string {
+	return exported.Ethereum
+}
+
+// ValidateBasic performs basic validation of the header fields.
+func (h Header) ValidateBasic() error {
+	if err := h.ActiveSyncCommittee.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid active sync committee")
+	}
+
+	if err := h.ConsensusUpdate.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(h.AccountProof) == 0 {
+		return errorsmod.Wrap(ErrInvalidHeader, "account proof cannot be empty")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the light client update fields.
+func (u LightClientUpdate) ValidateBasic() error {
+	if err := u.AttestedHeader.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid attested header")
+	}
+
+	if err := u.FinalizedHeader.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid finalized header")
+	}
+
+	if u.NextSyncCommittee != nil {
+		if err := u.NextSyncCommittee.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "invalid next sync committee")
+		}
+	} else if len(u.NextSyncCommitteeBranch) != 0 {
+		return errorsmod.Wrap(ErrInvalidHeader, "next sync committee branch must be empty without a next sync committee")
+	}
+
+	if len(u.SyncAggregate.SyncCommitteeBits) == 0 {
+		return errorsmod.Wrap(ErrInvalidHeader, "sync committee bits cannot be empty")
+	}
+
+	if len(u.SyncAggregate.SyncCommitteeSignature) != SignatureLength {
+		return errorsmod.Wrapf(ErrInvalidHeader, "sync committee signature must be %d bytes, got %d", SignatureLength, len(u.SyncAggregate.SyncCommitteeSignature))
+	}
+
+	if u.SignatureSlot <= u.AttestedHeader.Beacon.Slot {
+		return errorsmod.Wrapf(ErrInvalidSlot, "signature slot %d must be after attested slot %d", u.SignatureSlot, u.AttestedHeader.Beacon.Slot)
+	}
+
+	if u.AttestedHeader.Beacon.Slot < u.FinalizedHeader.Beacon.Slot {
+		return errorsmod.Wrapf(ErrInvalidSlot, "attested slot %d cannot be before finalized slot %d", u.AttestedHeader.Beacon.Slot, u.FinalizedHeader.Beacon.Slot)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the light client header fields.
+func (h LightClientHeader) ValidateBasic() error {
+	for _, root := range []struct {
+		name  string
+		value []byte
+	}{
+		{"parent root", h.Beacon.ParentRoot},
+		{"state root", h.Beacon.StateRoot},
+		{"body root", h.Beacon.BodyRoot},
+		{"execution parent hash", h.Execution.ParentHash},
+		{"execution state root", h.Execution.StateRoot},
+		{"execution receipts root", h.Execution.ReceiptsRoot},
+		{"execution prev randao", h.Execution.PrevRandao},
+		{"execution base fee per gas", h.Execution.BaseFeePerGas},
+		{"execution block hash", h.Execution.BlockHash},
+		{"execution transactions root", h.Execution.TransactionsRoot},
+		{"execution withdrawals root", h.Execution.WithdrawalsRoot},
+	} {
+		if len(root.value) != RootLength {
+			return errorsmod.Wrapf(ErrInvalidHeader, "%s must be %d bytes, got %d", root.name, RootLength, len(root.value))
+		}
+	}
+
+	if len(h.Execution.FeeRecipient) != addressLength {
+		return errorsmod.Wrapf(ErrInvalidHeader, "execution fee recipient must be %d bytes, got %d", addressLength, len(h.Execution.FeeRecipient))
+	}
+
+	if len(h.Execution.LogsBloom) != logsBloomLength {
+		return errorsmod.Wrapf(ErrInvalidHeader, "execution logs bloom must be %d bytes, got %d", logsBloomLength, len(h.Execution.LogsBloom))
+	}
+
+	if len(h.Execution.ExtraData) > maxExtraDataLength {
+		return errorsmod.Wrapf(ErrInvalidHeader, "execution extra data cannot exceed %d bytes, got %d", maxExtraDataLength, len(h.Execution.ExtraData))
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the sync committee public keys.
+func (sc SyncCommittee) ValidateBasic() error {
+	if len(sc.Pubkeys) == 0 {
+		return errorsmod.Wrap(ErrInvalidSyncCommittee, "public keys cannot be empty")
+	}
+
+	for i, pubkey := range sc.Pubkeys {
+		if len(pubkey) != PubkeyLength {
+			return errorsmod.Wrapf(ErrInvalidSyncCommittee, "public key %d must be %d bytes, got %d", i, PubkeyLength, len(pubkey))
+		}
+	}
+
+	if len(sc.AggregatePubkey) != PubkeyLength {
+		return errorsmod.Wrapf(ErrInvalidSyncCommittee, "aggregate public key must be %d bytes, got %d", PubkeyLength, len(sc.AggregatePubkey))
+	}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum
+
+const (
+	ModuleName = "ethereum"
+)
@@ -0,0 +1,427 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum_test
+
+import (
+	"testing"
+	"time"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types/v2"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/ethereum"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+const testClientID = "ethereum-0"
+
+type EthereumTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+
+	fixtures          fixtures
+	lightClientModule ethereum.LightClientModule
+}
+
+func TestEthereumTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(EthereumTestSuite))
+}
+
+func (s *EthereumTestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 1)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	ethereum.RegisterInterfaces(s.chainA.GetSimApp().InterfaceRegistry())
+
+	s.fixtures = loadFixtures(s.T())
+
+	cdc := s.chainA.App.AppCodec()
+	storeKey := s.chainA.GetSimApp().GetKey(exported.StoreKey)
+	storeProvider := clienttypes.NewStoreProvider(runtime.NewKVStoreService(storeKey))
+	s.lightClientModule = ethereum.NewLightClientModule(cdc, storeProvider)
+}
+
+// clientState returns the recorded initial client state.
+func (s *EthereumTestSuite) clientState() *ethereum.ClientState {
+	var clientState ethereum.ClientState
+	s.Require().NoError(s.chainA.App.AppCodec().UnmarshalJSON(s.fixtures.ClientState, &clientState))
+	return &clientState
+}
+
+// consensusState returns the recorded initial consensus state.
+func (s *EthereumTestSuite) consensusState() *ethereum.ConsensusState {
+	var consensusState ethereum.ConsensusState
+	s.Require().NoError(s.chainA.App.AppCodec().UnmarshalJSON(s.fixtures.ConsensusState, &consensusState))
+	return &consensusState
+}
+
+// header returns the recorded header of the update at the index.
+func (s *EthereumTestSuite) header(index int) *ethereum.Header {
+	var header ethereum.Header
+	s.Require().NoError(s.chainA.App.AppCodec().UnmarshalJSON(s.fixtures.Updates[index].Header, &header))
+	return &header
+}
+
+// updateContext returns a context with the block time at which the update at the index is submitted.
+func (s *EthereumTestSuite) updateContext(index int) sdk.Context {
+	return s.chainA.GetContext().WithBlockTime(time.Unix(s.fixtures.Updates[index].BlockTime, 0))
+}
+
+// initializeClient initializes the client with the provided client and consensus states.
+func (s *EthereumTestSuite) initializeClient(ctx sdk.Context, clientState *ethereum.ClientState, consensusState *ethereum.ConsensusState) error {
+	clientStateBz, err := s.chainA.App.AppCodec().Marshal(clientState)
+	s.Require().NoError(err)
+
+	consensusStateBz, err := s.chainA.App.AppCodec().Marshal(consensusState)
+	s.Require().NoError(err)
+
+	return s.lightClientModule.Initialize(ctx, testClientID, clientStateBz, consensusStateBz)
+}
+
+// applyUpdates initializes the client with the recorded states and applies the given number of recorded updates.
+func (s *EthereumTestSuite) applyUpdates(count int) {
+	s.Require().NoError(s.initializeClient(s.chainA.GetContext(), s.clientState(), s.consensusState()))
+
+	for i := range count {
+		ctx := s.updateContext(i)
+		header := s.header(i)
+
+		s.Require().NoError(s.lightClientModule.VerifyClientMessage(ctx, testClientID, header))
+		s.Require().False(s.lightClientModule.CheckForMisbehaviour(ctx, testClientID, header))
+		s.lightClientModule.UpdateState(ctx, testClientID, header)
+	}
+}
+
+// storedConsensusState returns the consensus state stored for the slot.
+func (s *EthereumTestSuite) storedConsensusState(slot uint64) *ethereum.ConsensusState {
+	clientStore := s.chainA.GetSimApp().GetIBCKeeper().ClientKeeper.ClientStore(s.chainA.GetContext(), testClientID)
+	bz := clientStore.Get(host.ConsensusStateKey(clienttypes.NewHeight(0, slot)))
+	s.Require().NotEmpty(bz)
+
+	consensusState, ok := clienttypes.MustUnmarshalConsensusState(s.chainA.App.AppCodec(), bz).(*ethereum.ConsensusState)
+	s.Require().True(ok)
+	return consensusState
+}
+
+func (s *EthereumTestSuite) freezeClient() {
+	ctx := s.chainA.GetContext()
+	s.lightClientModule.UpdateStateOnMisbehaviour(ctx, testClientID, nil)
+	s.Require().Equal(exported.Frozen, s.lightClientModule.Status(ctx, testClientID))
+}
+
+func (s *EthereumTestSuite) TestInitialize() {
+	var (
+		clientState    *ethereum.ClientState
+		consensusState *ethereum.ConsensusState
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid client state",
+			func() {
+				clientState.SlotsPerEpoch = 0
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: invalid consensus state",
+			func() {
+				consensusState.StorageRoot = nil
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+		{
+			"failure: consensus state slot does not match the latest slot",
+			func() {
+				consensusState.Slot++
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			clientState = s.clientState()
+			consensusState = s.consensusState()
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			err := s.initializeClient(ctx, clientState, consensusState)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(exported.Active, s.lightClientModule.Status(ctx, testClientID))
+				s.Require().Equal(clienttypes.NewHeight(0, clientState.LatestSlot), s.lightClientModule.LatestHeight(ctx, testClientID))
+
+				timestamp, err := s.lightClientModule.TimestampAtHeight(ctx, testClientID, clienttypes.NewHeight(0, consensusState.Slot))
+				s.Require().NoError(err)
+				s.Require().Equal(consensusState.Timestamp*uint64(time.Second), timestamp)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *EthereumTestSuite) TestStatus() {
+	ctx := s.chainA.GetContext()
+	s.Require().Equal(exported.Unknown, s.lightClientModule.Status(ctx, testClientID))
+
+	s.applyUpdates(0)
+	s.Require().Equal(exported.Active, s.lightClientModule.Status(ctx, testClientID))
+
+	s.freezeClient()
+}
+
+func (s *EthereumTestSuite) TestVerifyMembership() {
+	var (
+		commitment fixtureCommitment
+		path       exported.Path
+		proof      []byte
+		value      []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: client is frozen",
+			func() {
+				s.freezeClient()
+			},
+			ethereum.ErrClientFrozen,
+		},
+		{
+			"failure: value does not match the stored commitment",
+			func() {
+				value[0] ^= 0xff
+			},
+			ethereum.ErrNotMember,
+		},
+		{
+			"failure: value is not 32 bytes",
+			func() {
+				value = value[1:]
+			},
+			ethereum.ErrInvalidValue,
+		},
+		{
+			"failure: path with multiple keys",
+			func() {
+				path = commitmenttypesv2.NewMerklePath([]byte("ibc"), commitment.Path)
+			},
+			ethereum.ErrInvalidPath,
+		},
+		{
+			"failure: path is not a merkle path",
+			func() {
+				path = mockPath{}
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: proof of a different path",
+			func() {
+				path = commitmenttypesv2.NewMerklePath([]byte("07-tendermint-1\x01"))
+			},
+			ethereum.ErrNotMember,
+		},
+		{
+			"failure: proof at a different slot",
+			func() {
+				proof = s.fixtures.Commitments[0].Proof
+			},
+			ethereum.ErrInvalidStorageProof,
+		},
+		{
+			"failure: invalid proof bytes",
+			func() {
+				proof = []byte("invalid")
+			},
+			ethereum.ErrInvalidStorageProof,
+		},
+		{
+			"failure: consensus state not found",
+			func() {
+				commitment.Slot++
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.applyUpdates(len(s.fixtures.Updates))
+
+			commitment = s.membershipCommitment()
+			path = commitmenttypesv2.NewMerklePath(commitment.Path)
+			proof = commitment.Proof
+			value = append([]byte(nil), commitment.Value...)
+
+			tc.malleate()
+
+			err := s.lightClientModule.VerifyMembership(s.chainA.GetContext(), testClientID, clienttypes.NewHeight(0, commitment.Slot), 0, 0, proof, path, value)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *EthereumTestSuite) TestVerifyNonMembership() {
+	var (
+		commitment fixtureCommitment
+		path       exported.Path
+		proof      []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: commitment is absent at an earlier slot",
+			func() {
+				commitment = s.fixtures.Commitments[0]
+				path = commitmenttypesv2.NewMerklePath(commitment.Path)
+				proof = commitment.Proof
+			},
+			nil,
+		},
+		{
+			"failure: client is frozen",
+			func() {
+				s.freezeClient()
+			},
+			ethereum.ErrClientFrozen,
+		},
+		{
+			"failure: commitment is stored",
+			func() {
+				membership := s.membershipCommitment()
+				path = commitmenttypesv2.NewMerklePath(membership.Path)
+				proof = membership.Proof
+			},
+			ethereum.ErrNonMembershipFailed,
+		},
+		{
+			"failure: empty path",
+			func() {
+				path = commitmenttypesv2.NewMerklePath()
+			},
+			ethereum.ErrInvalidPath,
+		},
+		{
+			"failure: invalid proof bytes",
+			func() {
+				proof = []byte("invalid")
+			},
+			ethereum.ErrInvalidStorageProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.applyUpdates(len(s.fixtures.Updates))
+
+			commitment = s.nonMembershipCommitment()
+			path = commitmenttypesv2.NewMerklePath(commitment.Path)
+			proof = commitment.Proof
+
+			tc.malleate()
+
+			err := s.lightClientModule.VerifyNonMembership(s.chainA.GetContext(), testClientID, clienttypes.NewHeight(0, commitment.Slot), 0, 0, proof, path)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *EthereumTestSuite) TestRecoverClientNotSupported() {
+	err := s.lightClientModule.RecoverClient(s.chainA.GetContext(), testClientID, "ethereum-1")
+	s.Require().ErrorIs(err, ibcerrors.ErrInvalidRequest)
+}
+
+func (s *EthereumTestSuite) TestVerifyUpgradeAndUpdateStateNotSupported() {
+	err := s.lightClientModule.VerifyUpgradeAndUpdateState(s.chainA.GetContext(), testClientID, nil, nil, nil, nil)
+	s.Require().ErrorIs(err, clienttypes.ErrInvalidUpgradeClient)
+}
+
+// membershipCommitment returns the recorded proof of a stored commitment at the latest finalized slot.
+func (s *EthereumTestSuite) membershipCommitment() fixtureCommitment {
+	for _, commitment := range s.fixtures.Commitments {
+		if len(commitment.Value) != 0 {
+			return commitment
+		}
+	}
+
+	s.FailNow("no membership commitment recorded")
+	return fixtureCommitment{}
+}
+
+// nonMembershipCommitment returns the recorded proof of an absent commitment at the latest finalized slot.
+func (s *EthereumTestSuite) nonMembershipCommitment() fixtureCommitment {
+	commitments := s.fixtures.Commitments
+	commitment := commitments[len(commitments)-1]
+	s.Require().Empty(commitment.Value)
+	return commitment
+}
+
+type mockPath struct{}
+
+func (mockPath) Empty() bool { return false }
+
+type mockClientMessage struct{}
+
+func (mockClientMessage) Reset() {}
+
+func (mockClientMessage) String() string { return "mock" }
+
+func (mockClientMessage) ProtoMessage() {}
+
+func (mockClientMessage) ClientType() string { return "mock" }
+
+func (mockClientMessage) ValidateBasic() error { return nil }
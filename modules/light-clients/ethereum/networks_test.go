@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum_test
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/ethereum"
+)
+
+// mainnetClientState returns a client state with the genesis and fork schedule of Ethereum mainnet.
+func mainnetClientState() ethereum.ClientState {
+	return ethereum.ClientState{
+		ChainID:                      1,
+		GenesisValidatorsRoot:        common.FromHex("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"),
+		GenesisTime:                  1606824023,
+		SecondsPerSlot:               12,
+		SlotsPerEpoch:                32,
+		EpochsPerSyncCommitteePeriod: 256,
+		ForkParameters: ethereum.ForkParameters{
+			GenesisForkVersion: common.FromHex("0x00000000"),
+			Altair:             ethereum.Fork{Version: common.FromHex("0x01000000"), Epoch: 74240},
+			Bellatrix:          ethereum.Fork{Version: common.FromHex("0x02000000"), Epoch: 144896},
+			Capella:            ethereum.Fork{Version: common.FromHex("0x03000000"), Epoch: 194048},
+			Deneb:              ethereum.Fork{Version: common.FromHex("0x04000000"), Epoch: 269568},
+			Electra:            ethereum.Fork{Version: common.FromHex("0x05000000"), Epoch: 364032},
+			Fulu:               ethereum.Fork{Version: common.FromHex("0x06000000"), Epoch: 411392},
+		},
+	}
+}
+
+// TestMainnetForkDigests checks the fork data roots, from which the sync committee signing domains are
+// computed, against the fork digests advertised by Ethereum mainnet nodes in their gossip topics. The fork
+// digest of Fulu onwards also commits to the blob parameters and is therefore not covered.
+func (s *EthereumTestSuite) TestMainnetForkDigests() {
+	clientState := mainnetClientState()
+
+	testCases := []struct {
+		name      string
+		epoch     uint64
+		expDigest string
+	}{
+		{"phase0", 0, "b5303f2a"},
+		{"altair", 74240, "afcaaba0"},
+		{"bellatrix", 144896, "4a26c58b"},
+		{"capella", 194048, "bba4da96"},
+		{"deneb", 269568, "6a95a1a9"},
+		{"electra", 364032, "ad532ceb"},
+		{"last epoch of electra", 411391, "ad532ceb"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			forkVersion := clientState.ForkVersionAtEpoch(tc.epoch)
+			forkDataRoot := ethereum.ComputeForkDataRoot(forkVersion, clientState.GenesisValidatorsRoot)
+			s.Require().Equal(tc.expDigest, hex.EncodeToString(forkDataRoot[:4]))
+		})
+	}
+}
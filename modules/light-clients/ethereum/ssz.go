@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+)
+
+// chunkSize is the size of an SSZ merkleization chunk.
+const chunkSize = 32
+
+// maxZeroHashDepth is the depth of the largest zero subtree used when merkleizing SSZ objects.
+const maxZeroHashDepth = 16
+
+// zeroHashes holds the roots of zero subtrees of increasing depth.
+var zeroHashes = func() [maxZeroHashDepth + 1][chunkSize]byte {
+	var hashes [maxZeroHashDepth + 1][chunkSize]byte
+	for i := 1; i <= maxZeroHashDepth; i++ {
+		hashes[i] = hashPair(hashes[i-1], hashes[i-1])
+	}
+
+	return hashes
+}()
+
+// HashTreeRoot returns the SSZ hash tree root of the beacon block header.
+func (h BeaconBlockHeader) HashTreeRoot() [chunkSize]byte {
+	return merkleize([][chunkSize]byte{
+		uint64Chunk(h.Slot),
+		uint64Chunk(h.ProposerIndex),
+		bytesChunk(h.ParentRoot),
+		bytesChunk(h.StateRoot),
+		bytesChunk(h.BodyRoot),
+	}, 8)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the execution payload header.
+func (h ExecutionPayloadHeader) HashTreeRoot() [chunkSize]byte {
+	return merkleize([][chunkSize]byte{
+		bytesChunk(h.ParentHash),
+		bytesChunk(h.FeeRecipient),
+		bytesChunk(h.StateRoot),
+		bytesChunk(h.ReceiptsRoot),
+		merkleize(packBytes(h.LogsBloom), logsBloomLength/chunkSize),
+		bytesChunk(h.PrevRandao),
+		uint64Chunk(h.BlockNumber),
+		uint64Chunk(h.GasLimit),
+		uint64Chunk(h.GasUsed),
+		uint64Chunk(h.Timestamp),
+		mixInLength(merkleize(packBytes(h.ExtraData), 1), uint64(len(h.ExtraData))),
+		bytesChunk(h.BaseFeePerGas),
+		bytesChunk(h.BlockHash),
+		bytesChunk(h.TransactionsRoot),
+		bytesChunk(h.WithdrawalsRoot),
+		uint64Chunk(h.BlobGasUsed),
+		uint64Chunk(h.ExcessBlobGas),
+	}, 32)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the sync committee.
+func (sc SyncCommittee) HashTreeRoot() [chunkSize]byte {
+	pubkeyRoots := make([][chunkSize]byte, len(sc.Pubkeys))
+	for i, pubkey := range sc.Pubkeys {
+		pubkeyRoots[i] = merkleize(packBytes(pubkey), 2)
+	}
+
+	return hashPair(merkleize(pubkeyRoots, len(pubkeyRoots)), merkleize(packBytes(sc.AggregatePubkey), 2))
+}
+
+// computeForkDataRoot returns the hash tree root of the ForkData container of the provided fork version
+// and genesis validators root.
+func computeForkDataRoot(forkVersion, genesisValidatorsRoot []byte) [chunkSize]byte {
+	return hashPair(bytesChunk(forkVersion), bytesChunk(genesisValidatorsRoot))
+}
+
+// computeDomain returns the signature domain of the provided domain type for the provided fork version
+// and genesis validators root.
+func computeDomain(domainType [4]byte, forkVersion, genesisValidatorsRoot []byte) [chunkSize]byte {
+	var domain [chunkSize]byte
+	forkDataRoot := computeForkDataRoot(forkVersion, genesisValidatorsRoot)
+	copy(domain[:], domainType[:])
+	copy(domain[len(domainType):], forkDataRoot[:chunkSize-len(domainType)])
+
+	return domain
+}
+
+// computeSigningRoot returns the hash tree root of the SigningData container of the provided object root and domain.
+func computeSigningRoot(objectRoot, domain [chunkSize]byte) [chunkSize]byte {
+	return hashPair(objectRoot, domain)
+}
+
+// isValidMerkleBranch reports whether the branch proves the leaf at the generalized index in the tree with the provided root.
+func isValidMerkleBranch(leaf [chunkSize]byte, branch [][]byte, gindex uint64, root []byte) bool {
+	depth := bits.Len64(gindex) - 1
+	if depth < 0 || len(branch) != depth || len(root) != chunkSize {
+		return false
+	}
+
+	value := leaf
+	for i, sibling := range branch {
+		if len(sibling) != chunkSize {
+			return false
+		}
+
+		if (gindex>>i)&1 == 1 {
+			value = hashPair([chunkSize]byte(sibling), value)
+		} else {
+			value = hashPair(value, [chunkSize]byte(sibling))
+		}
+	}
+
+	return [chunkSize]byte(root) == value
+}
+
+// merkleize returns the root of the binary Merkle tree of the chunks, padded with zero chunks up to the next
+// power of two of the provided limit.
+func merkleize(chunks [][chunkSize]byte, limit int) [chunkSize]byte {
+	depth := 0
+	for (1 << depth) < limit {
+		depth++
+	}
+
+	layer := chunks
+	for i := range depth {
+		if len(layer)%2 == 1 {
+			layer = append(layer, zeroHashes[i])
+		}
+
+		next := make([][chunkSize]byte, len(layer)/2)
+		for j := range next {
+			next[j] = hashPair(layer[2*j], layer[2*j+1])
+		}
+
+		layer = next
+	}
+
+	if len(layer) == 0 {
+		return zeroHashes[depth]
+	}
+
+	return layer[0]
+}
+
+// mixInLength returns the root of a list with the provided length.
+func mixInLength(root [chunkSize]byte, length uint64) [chunkSize]byte {
+	return hashPair(root, uint64Chunk(length))
+}
+
+// packBytes splits the bytes into right padded chunks.
+func packBytes(bz []byte) [][chunkSize]byte {
+	chunks := make([][chunkSize]byte, (len(bz)+chunkSize-1)/chunkSize)
+	for i := range chunks {
+		copy(chunks[i][:], bz[i*chunkSize:])
+	}
+
+	return chunks
+}
+
+// bytesChunk returns the bytes right padded into a single chunk. The bytes must not exceed the chunk size.
+func bytesChunk(bz []byte) [chunkSize]byte {
+	var chunk [chunkSize]byte
+	copy(chunk[:], bz)
+
+	return chunk
+}
+
+// uint64Chunk returns the little-endian encoding of the value right padded into a single chunk.
+func uint64Chunk(value uint64) [chunkSize]byte {
+	var chunk [chunkSize]byte
+	binary.LittleEndian.PutUint64(chunk[:], value)
+
+	return chunk
+}
+
+// hashPair returns the sha256 hash of the concatenation of both chunks.
+func hashPair(left, right [chunkSize]byte) [chunkSize]byte {
+	return sha256.Sum256(append(left[:], right[:]...))
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/internal/evm"
+)
+
+// verifyAccountStorageRoot verifies the account proof of the IBC contract against the execution state root
+// and returns the storage root of the contract.
+func (cs ClientState) verifyAccountStorageRoot(stateRoot []byte, accountProof [][]byte) ([]byte, error) {
+	storageRoot, err := evm.VerifyAccountStorageRoot(stateRoot, common.HexToAddress(cs.IbcContractAddress), accountProof)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidAccountProof, err.Error())
+	}
+
+	return storageRoot, nil
+}
+
+// verifyStorageValue verifies the storage proof of the slot against the storage root and returns the 32-byte value
+// stored in the slot. Absent slots are returned as 32 zero bytes.
+func verifyStorageValue(storageRoot []byte, slot []byte, proof [][]byte) ([]byte, error) {
+	value, err := evm.VerifyStorageValue(storageRoot, slot, proof)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidStorageProof, err.Error())
+	}
+
+	return value, nil
+}
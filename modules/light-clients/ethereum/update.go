@@ -0,0 +1,290 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+// domainSyncCommittee is the signature domain type of sync committee messages.
+var domainSyncCommittee = [4]byte{0x07, 0x00, 0x00, 0x00}
+
+const (
+	// executionPayloadGindex is the generalized index of the execution payload in the beacon block body.
+	executionPayloadGindex = 25
+
+	// denebFinalizedRootGindex is the generalized index of the finalized checkpoint root in the Deneb beacon state.
+	denebFinalizedRootGindex = 105
+	// denebNextSyncCommitteeGindex is the generalized index of the next sync committee in the Deneb beacon state.
+	denebNextSyncCommitteeGindex = 55
+
+	// electraFinalizedRootGindex is the generalized index of the finalized checkpoint root in the Electra beacon state.
+	electraFinalizedRootGindex = 169
+	// electraNextSyncCommitteeGindex is the generalized index of the next sync committee in the Electra beacon state.
+	electraNextSyncCommitteeGindex = 87
+)
+
+// VerifyClientMessage introspects the provided ClientMessage and checks its validity.
+// A Header is considered valid if the light client update it contains is signed by a supermajority of the
+// sync committee of the trusted consensus state, the finalized header is proven in the attested beacon state,
+// and the IBC contract account is proven in the finalized execution state.
+func (cs *ClientState) VerifyClientMessage(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) error {
+	if cs.IsFrozen {
+		return ErrClientFrozen
+	}
+
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got type %T", (*Header)(nil), clientMsg)
+	}
+
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+
+	trustedConsensusState, found := getConsensusState(clientStore, cdc, clienttypes.NewHeight(0, header.TrustedSlot))
+	if !found {
+		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "trusted consensus state not found for slot %d", header.TrustedSlot)
+	}
+
+	if err := cs.verifyLightClientUpdate(ctx, header, trustedConsensusState); err != nil {
+		return err
+	}
+
+	_, err := cs.verifyAccountStorageRoot(header.ConsensusUpdate.FinalizedHeader.Execution.StateRoot, header.AccountProof)
+	return err
+}
+
+// verifyLightClientUpdate verifies the light client update of the header against the trusted consensus state
+// following the validate_light_client_update algorithm of the Altair light client specification.
+func (cs ClientState) verifyLightClientUpdate(ctx sdk.Context, header *Header, trustedConsensusState *ConsensusState) error {
+	update := header.ConsensusUpdate
+	attestedSlot := update.AttestedHeader.Beacon.Slot
+	finalizedSlot := update.FinalizedHeader.Beacon.Slot
+
+	// verify the sync committee participation before any expensive checks
+	participants, err := cs.participatingPubkeys(header.ActiveSyncCommittee, update.SyncAggregate)
+	if err != nil {
+		return err
+	}
+
+	if 3*uint64(len(participants)) < 2*cs.SyncCommitteeSize {
+		return errorsmod.Wrapf(ErrInsufficientParticipation, "%d of %d sync committee members participated, a supermajority is required", len(participants), cs.SyncCommitteeSize)
+	}
+
+	currentSlot := cs.computeSlotAtTimestamp(ctx.BlockTime())
+	if update.SignatureSlot > currentSlot {
+		return errorsmod.Wrapf(ErrInvalidSlot, "signature slot %d cannot be after the current slot %d", update.SignatureSlot, currentSlot)
+	}
+
+	// the slots must be ordered as signature_slot > attested_slot >= finalized_slot, such that the finalized period is
+	// at most the signature period
+	if update.SignatureSlot <= attestedSlot {
+		return errorsmod.Wrapf(ErrInvalidSlot, "signature slot %d must be after attested slot %d", update.SignatureSlot, attestedSlot)
+	}
+
+	if attestedSlot < finalizedSlot {
+		return errorsmod.Wrapf(ErrInvalidSlot, "attested slot %d cannot be before finalized slot %d", attestedSlot, finalizedSlot)
+	}
+
+	if finalizedSlot < trustedConsensusState.Slot {
+		return errorsmod.Wrapf(ErrInvalidSlot, "finalized slot %d cannot be before the trusted slot %d", finalizedSlot, trustedConsensusState.Slot)
+	}
+
+	// light client headers are only supported from the deneb fork onwards, as the attested slot is never before the
+	// finalized slot the attested header is from the deneb fork onwards as well
+	if cs.computeEpoch(finalizedSlot) < cs.ForkParameters.Deneb.Epoch {
+		return errorsmod.Wrapf(ErrUnsupportedFork, "finalized slot %d is before the deneb fork", finalizedSlot)
+	}
+
+	storePeriod := cs.computeSyncCommitteePeriod(trustedConsensusState.Slot)
+	signaturePeriod := cs.computeSyncCommitteePeriod(update.SignatureSlot)
+	attestedPeriod := cs.computeSyncCommitteePeriod(attestedSlot)
+	hasNextSyncCommittee := len(trustedConsensusState.NextSyncCommittee) != 0
+
+	// the update must be signed by the current or, once known, the next sync committee of the trusted consensus state
+	activeSyncCommittee := header.ActiveSyncCommittee.HashTreeRoot()
+	switch {
+	case signaturePeriod == storePeriod:
+		if !bytes.Equal(activeSyncCommittee[:], trustedConsensusState.CurrentSyncCommittee) {
+			return errorsmod.Wrap(ErrInvalidSyncCommittee, "active sync committee does not match the current sync committee of the trusted consensus state")
+		}
+	case signaturePeriod == storePeriod+1 && hasNextSyncCommittee:
+		if !bytes.Equal(activeSyncCommittee[:], trustedConsensusState.NextSyncCommittee) {
+			return errorsmod.Wrap(ErrInvalidSyncCommittee, "active sync committee does not match the next sync committee of the trusted consensus state")
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidSlot, "signature period %d is not covered by the sync committees known at period %d", signaturePeriod, storePeriod)
+	}
+
+	// the update must advance the client, either by finalizing a new slot or by revealing the next sync committee
+	learnsNextSyncCommittee := update.NextSyncCommittee != nil && !hasNextSyncCommittee && attestedPeriod == storePeriod
+	if finalizedSlot == trustedConsensusState.Slot && !learnsNextSyncCommittee {
+		return errorsmod.Wrapf(ErrInvalidHeader, "update for finalized slot %d does not advance the trusted consensus state", finalizedSlot)
+	}
+
+	if err := cs.verifyExecutionBranch(update.AttestedHeader); err != nil {
+		return errorsmod.Wrap(err, "invalid attested header")
+	}
+
+	if err := cs.verifyExecutionBranch(update.FinalizedHeader); err != nil {
+		return errorsmod.Wrap(err, "invalid finalized header")
+	}
+
+	finalizedRootGindex, nextSyncCommitteeGindex := cs.beaconStateGindices(attestedSlot)
+	finalizedRoot := update.FinalizedHeader.Beacon.HashTreeRoot()
+	if !isValidMerkleBranch(finalizedRoot, update.FinalityBranch, finalizedRootGindex, update.AttestedHeader.Beacon.StateRoot) {
+		return errorsmod.Wrap(ErrInvalidMerkleBranch, "finalized header is not included in the attested beacon state")
+	}
+
+	if update.NextSyncCommittee != nil {
+		nextSyncCommittee := update.NextSyncCommittee.HashTreeRoot()
+		if attestedPeriod == storePeriod && hasNextSyncCommittee && !bytes.Equal(nextSyncCommittee[:], trustedConsensusState.NextSyncCommittee) {
+			return errorsmod.Wrap(ErrInvalidSyncCommittee, "next sync committee does not match the next sync committee of the trusted consensus state")
+		}
+
+		if !isValidMerkleBranch(nextSyncCommittee, update.NextSyncCommitteeBranch, nextSyncCommitteeGindex, update.AttestedHeader.Beacon.StateRoot) {
+			return errorsmod.Wrap(ErrInvalidMerkleBranch, "next sync committee is not included in the attested beacon state")
+		}
+	}
+
+	forkVersion := cs.forkVersionAtEpoch(cs.computeEpoch(max(update.SignatureSlot, 1) - 1))
+	domain := computeDomain(domainSyncCommittee, forkVersion, cs.GenesisValidatorsRoot)
+	signingRoot := computeSigningRoot(update.AttestedHeader.Beacon.HashTreeRoot(), domain)
+	if err := fastAggregateVerify(participants, signingRoot[:], update.SyncAggregate.SyncCommitteeSignature); err != nil {
+		return errorsmod.Wrap(ErrInvalidSignature, err.Error())
+	}
+
+	return nil
+}
+
+// participatingPubkeys returns the public keys of the sync committee members which participated in the sync aggregate.
+func (cs ClientState) participatingPubkeys(syncCommittee SyncCommittee, syncAggregate SyncAggregate) ([][]byte, error) {
+	if uint64(len(syncCommittee.Pubkeys)) != cs.SyncCommitteeSize {
+		return nil, errorsmod.Wrapf(ErrInvalidSyncCommittee, "expected %d public keys, got %d", cs.SyncCommitteeSize, len(syncCommittee.Pubkeys))
+	}
+
+	if uint64(len(syncAggregate.SyncCommitteeBits)) != cs.SyncCommitteeSize/8 {
+		return nil, errorsmod.Wrapf(ErrInvalidHeader, "sync committee bits must be %d bytes, got %d", cs.SyncCommitteeSize/8, len(syncAggregate.SyncCommitteeBits))
+	}
+
+	var participants [][]byte
+	for i, pubkey := range syncCommittee.Pubkeys {
+		if syncAggregate.SyncCommitteeBits[i/8]&(1<<(i%8)) != 0 {
+			participants = append(participants, pubkey)
+		}
+	}
+
+	return participants, nil
+}
+
+// verifyExecutionBranch verifies the inclusion of the execution payload header in the body of the beacon block header.
+func (ClientState) verifyExecutionBranch(header LightClientHeader) error {
+	if !isValidMerkleBranch(header.Execution.HashTreeRoot(), header.ExecutionBranch, executionPayloadGindex, header.Beacon.BodyRoot) {
+		return errorsmod.Wrap(ErrInvalidMerkleBranch, "execution payload is not included in the beacon block body")
+	}
+
+	return nil
+}
+
+// beaconStateGindices returns the generalized indices of the finalized checkpoint root and the next sync committee
+// in the beacon state at the slot.
+func (cs ClientState) beaconStateGindices(slot uint64) (uint64, uint64) {
+	if cs.computeEpoch(slot) >= cs.ForkParameters.Electra.Epoch {
+		return electraFinalizedRootGindex, electraNextSyncCommitteeGindex
+	}
+
+	return denebFinalizedRootGindex, denebNextSyncCommitteeGindex
+}
+
+// CheckForMisbehaviour returns true if a consensus state is already stored for the finalized slot of the header
+// and it conflicts with the execution state finalized by the header.
+func (cs ClientState) CheckForMisbehaviour(cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) bool {
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		panic(fmt.Sprintf("expected type %T, got type %T", (*Header)(nil), clientMsg))
+	}
+
+	finalizedHeader := header.ConsensusUpdate.FinalizedHeader
+	consensusState, found := getConsensusState(clientStore, cdc, clienttypes.NewHeight(0, finalizedHeader.Beacon.Slot))
+	if !found {
+		return false
+	}
+
+	return consensusState.Timestamp != finalizedHeader.Execution.Timestamp || !bytes.Equal(consensusState.StateRoot, finalizedHeader.Execution.StateRoot)
+}
+
+// UpdateState stores the consensus state of the finalized slot of the header and rotates the sync committees when
+// the finalized slot enters the next sync committee period.
+// A list containing the updated consensus height is returned.
+// Since client message is validated in VerifyClientMessage, we don't validate much here, and panics on anything unexpected.
+func (cs *ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		panic(fmt.Sprintf("expected type %T, got type %T", (*Header)(nil), clientMsg))
+	}
+
+	trustedConsensusState, found := getConsensusState(clientStore, cdc, clienttypes.NewHeight(0, header.TrustedSlot))
+	if !found {
+		panic(errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "trusted consensus state not found for slot %d", header.TrustedSlot))
+	}
+
+	update := header.ConsensusUpdate
+	finalizedHeader := update.FinalizedHeader
+	storageRoot, err := cs.verifyAccountStorageRoot(finalizedHeader.Execution.StateRoot, header.AccountProof)
+	if err != nil {
+		panic(err)
+	}
+
+	storePeriod := cs.computeSyncCommitteePeriod(trustedConsensusState.Slot)
+	finalizedPeriod := cs.computeSyncCommitteePeriod(finalizedHeader.Beacon.Slot)
+
+	// the next sync committee of the update is only relative to the finalized slot if both are in the same period
+	var nextSyncCommittee []byte
+	if update.NextSyncCommittee != nil && cs.computeSyncCommitteePeriod(update.AttestedHeader.Beacon.Slot) == finalizedPeriod {
+		root := update.NextSyncCommittee.HashTreeRoot()
+		nextSyncCommittee = root[:]
+	}
+
+	consensusState := &ConsensusState{
+		Slot:        finalizedHeader.Beacon.Slot,
+		StateRoot:   finalizedHeader.Execution.StateRoot,
+		StorageRoot: storageRoot,
+		Timestamp:   finalizedHeader.Execution.Timestamp,
+	}
+
+	switch finalizedPeriod {
+	case storePeriod:
+		consensusState.CurrentSyncCommittee = trustedConsensusState.CurrentSyncCommittee
+		consensusState.NextSyncCommittee = trustedConsensusState.NextSyncCommittee
+		if len(consensusState.NextSyncCommittee) == 0 {
+			consensusState.NextSyncCommittee = nextSyncCommittee
+		}
+	case storePeriod + 1:
+		consensusState.CurrentSyncCommittee = trustedConsensusState.NextSyncCommittee
+		consensusState.NextSyncCommittee = nextSyncCommittee
+	default:
+		panic(fmt.Sprintf("finalized period %d cannot be more than one period after the trusted period %d", finalizedPeriod, storePeriod))
+	}
+
+	height := clienttypes.NewHeight(0, consensusState.Slot)
+	setConsensusState(clientStore, cdc, consensusState, height)
+
+	if consensusState.Slot > cs.LatestSlot {
+		cs.LatestSlot = consensusState.Slot
+	}
+
+	setClientState(clientStore, cdc, cs)
+
+	return []exported.Height{height}
+}
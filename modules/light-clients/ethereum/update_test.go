@@ -0,0 +1,436 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package ethereum_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/ethereum"
+)
+
+func (s *EthereumTestSuite) TestVerifyClientMessage() {
+	var (
+		ctx       sdk.Context
+		clientMsg exported.ClientMessage
+	)
+
+	header := func() *ethereum.Header {
+		h, ok := clientMsg.(*ethereum.Header)
+		s.Require().True(ok)
+		return h
+	}
+
+	testCases := []struct {
+		name        string
+		updateIndex int
+		malleate    func()
+		expErr      error
+	}{
+		{
+			"success: update within the sync committee period",
+			0,
+			func() {},
+			nil,
+		},
+		{
+			"success: update signed by the next sync committee",
+			1,
+			func() {},
+			nil,
+		},
+		{
+			"success: update after the electra fork",
+			2,
+			func() {},
+			nil,
+		},
+		{
+			"failure: client is frozen",
+			0,
+			func() {
+				s.freezeClient()
+			},
+			ethereum.ErrClientFrozen,
+		},
+		{
+			"failure: invalid client message type",
+			0,
+			func() {
+				clientMsg = mockClientMessage{}
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: trusted consensus state not found",
+			0,
+			func() {
+				header().TrustedSlot++
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"failure: invalid header",
+			0,
+			func() {
+				header().AccountProof = nil
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: insufficient sync committee participation",
+			0,
+			func() {
+				bits := header().ConsensusUpdate.SyncAggregate.SyncCommitteeBits
+				bits[0], bits[1] = 0, 0
+			},
+			ethereum.ErrInsufficientParticipation,
+		},
+		{
+			"failure: sync committee bits of the wrong size",
+			0,
+			func() {
+				header().ConsensusUpdate.SyncAggregate.SyncCommitteeBits = []byte{0xff}
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: signature slot is in the future",
+			0,
+			func() {
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(-time.Minute))
+			},
+			ethereum.ErrInvalidSlot,
+		},
+		{
+			"failure: finalized slot is before the trusted slot",
+			2,
+			func() {
+				trustedSlot := s.header(1).ConsensusUpdate.FinalizedHeader.Beacon.Slot
+
+				clientMsg = s.header(0)
+				header().TrustedSlot = trustedSlot
+			},
+			ethereum.ErrInvalidSlot,
+		},
+		{
+			"failure: update does not advance the client",
+			1,
+			func() {
+				trustedSlot := s.header(0).ConsensusUpdate.FinalizedHeader.Beacon.Slot
+
+				clientMsg = s.header(0)
+				header().TrustedSlot = trustedSlot
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: update skips a sync committee period",
+			2,
+			func() {
+				header().TrustedSlot = s.consensusState().Slot
+			},
+			ethereum.ErrInvalidSlot,
+		},
+		{
+			"failure: active sync committee is not the trusted sync committee",
+			0,
+			func() {
+				pubkeys := header().ActiveSyncCommittee.Pubkeys
+				pubkeys[0], pubkeys[1] = pubkeys[1], pubkeys[0]
+			},
+			ethereum.ErrInvalidSyncCommittee,
+		},
+		{
+			"failure: active sync committee is not the trusted next sync committee",
+			1,
+			func() {
+				pubkeys := header().ActiveSyncCommittee.Pubkeys
+				pubkeys[0], pubkeys[1] = pubkeys[1], pubkeys[0]
+			},
+			ethereum.ErrInvalidSyncCommittee,
+		},
+		{
+			"failure: invalid signature",
+			0,
+			func() {
+				// the signature of the second update is made by a different sync committee
+				header().ConsensusUpdate.SyncAggregate.SyncCommitteeSignature = s.header(1).ConsensusUpdate.SyncAggregate.SyncCommitteeSignature
+			},
+			ethereum.ErrInvalidSignature,
+		},
+		{
+			"failure: signature over a different attested header",
+			0,
+			func() {
+				header().ConsensusUpdate.AttestedHeader.Beacon.ProposerIndex++
+			},
+			ethereum.ErrInvalidSignature,
+		},
+		{
+			"failure: finalized header is not included in the attested state",
+			0,
+			func() {
+				header().ConsensusUpdate.FinalizedHeader.Beacon.ProposerIndex++
+			},
+			ethereum.ErrInvalidMerkleBranch,
+		},
+		{
+			"failure: invalid finality branch",
+			0,
+			func() {
+				branch := header().ConsensusUpdate.FinalityBranch
+				header().ConsensusUpdate.FinalityBranch = branch[:len(branch)-1]
+			},
+			ethereum.ErrInvalidMerkleBranch,
+		},
+		{
+			"failure: finalized execution payload is not included in the block body",
+			0,
+			func() {
+				header().ConsensusUpdate.FinalizedHeader.Execution.BlockNumber++
+			},
+			ethereum.ErrInvalidMerkleBranch,
+		},
+		{
+			"failure: attested execution payload is not included in the block body",
+			0,
+			func() {
+				header().ConsensusUpdate.AttestedHeader.Execution.Timestamp++
+			},
+			ethereum.ErrInvalidMerkleBranch,
+		},
+		{
+			"failure: next sync committee is not included in the attested state",
+			0,
+			func() {
+				header().ConsensusUpdate.NextSyncCommittee = &header().ActiveSyncCommittee
+			},
+			ethereum.ErrInvalidMerkleBranch,
+		},
+		{
+			"failure: finalized slot is before the deneb fork",
+			0,
+			func() {
+				clientState := s.clientState()
+				clientState.ForkParameters.Deneb.Epoch = 100
+				clientState.ForkParameters.Electra.Epoch = 100
+				clientState.ForkParameters.Fulu.Epoch = 100
+
+				clientStore := s.chainA.GetSimApp().GetIBCKeeper().ClientKeeper.ClientStore(ctx, testClientID)
+				clientStore.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(s.chainA.App.AppCodec(), clientState))
+			},
+			ethereum.ErrUnsupportedFork,
+		},
+		{
+			"failure: IBC contract account is not proven in the finalized execution state",
+			0,
+			func() {
+				header().AccountProof = s.header(1).AccountProof
+			},
+			ethereum.ErrInvalidAccountProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			s.applyUpdates(tc.updateIndex)
+
+			ctx = s.updateContext(tc.updateIndex)
+			clientMsg = s.header(tc.updateIndex)
+
+			tc.malleate()
+
+			err := s.lightClientModule.VerifyClientMessage(ctx, testClientID, clientMsg)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *EthereumTestSuite) TestVerifyLightClientUpdateSlotOrder() {
+	var header *ethereum.Header
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: signature slot is not after the attested slot",
+			func() {
+				header.ConsensusUpdate.SignatureSlot = header.ConsensusUpdate.AttestedHeader.Beacon.Slot
+			},
+			ethereum.ErrInvalidSlot,
+		},
+		{
+			"failure: attested slot is before the finalized slot",
+			func() {
+				header.ConsensusUpdate.AttestedHeader.Beacon.Slot = header.ConsensusUpdate.FinalizedHeader.Beacon.Slot - 1
+			},
+			ethereum.ErrInvalidSlot,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.updateContext(0)
+			header = s.header(0)
+
+			tc.malleate()
+
+			// the slot order is verified even if the header is not validated beforehand
+			err := s.clientState().VerifyLightClientUpdate(ctx, header, s.consensusState())
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *EthereumTestSuite) TestUpdateState() {
+	initialConsensusState := s.consensusState()
+	s.applyUpdates(0)
+
+	var consensusStates []*ethereum.ConsensusState
+	for i := range s.fixtures.Updates {
+		ctx := s.updateContext(i)
+		header := s.header(i)
+		s.Require().NoError(s.lightClientModule.VerifyClientMessage(ctx, testClientID, header))
+
+		heights := s.lightClientModule.UpdateState(ctx, testClientID, header)
+
+		finalizedHeader := header.ConsensusUpdate.FinalizedHeader
+		expHeight := clienttypes.NewHeight(0, finalizedHeader.Beacon.Slot)
+		s.Require().Equal([]exported.Height{expHeight}, heights)
+		s.Require().Equal(expHeight, s.lightClientModule.LatestHeight(ctx, testClientID))
+
+		timestamp, err := s.lightClientModule.TimestampAtHeight(ctx, testClientID, expHeight)
+		s.Require().NoError(err)
+		s.Require().Equal(finalizedHeader.Execution.Timestamp*uint64(time.Second), timestamp)
+
+		consensusState := s.storedConsensusState(finalizedHeader.Beacon.Slot)
+		s.Require().Equal(finalizedHeader.Execution.StateRoot, consensusState.StateRoot)
+		s.Require().Len(consensusState.StorageRoot, ethereum.RootLength)
+		consensusStates = append(consensusStates, consensusState)
+	}
+
+	// the first update stays within the period of the initial consensus state and reveals the next sync committee
+	nextSyncCommittee := s.header(0).ConsensusUpdate.NextSyncCommittee.HashTreeRoot()
+	s.Require().Equal(initialConsensusState.CurrentSyncCommittee, consensusStates[0].CurrentSyncCommittee)
+	s.Require().Equal(nextSyncCommittee[:], consensusStates[0].NextSyncCommittee)
+
+	// the following updates enter the next period, rotating the sync committees
+	for i := 1; i < len(consensusStates); i++ {
+		nextSyncCommittee := s.header(i).ConsensusUpdate.NextSyncCommittee.HashTreeRoot()
+		s.Require().Equal(consensusStates[i-1].NextSyncCommittee, consensusStates[i].CurrentSyncCommittee)
+		s.Require().Equal(nextSyncCommittee[:], consensusStates[i].NextSyncCommittee)
+	}
+}
+
+func (s *EthereumTestSuite) TestCheckForMisbehaviour() {
+	var clientMsg exported.ClientMessage
+
+	testCases := []struct {
+		name            string
+		malleate        func()
+		expMisbehaviour bool
+		expPanic        string
+	}{
+		{
+			"no consensus state stored for the finalized slot",
+			func() {
+				clientMsg = s.header(1)
+			},
+			false,
+			"",
+		},
+		{
+			"matching consensus state for the finalized slot",
+			func() {},
+			false,
+			"",
+		},
+		{
+			"conflicting execution state root for the finalized slot",
+			func() {
+				header, ok := clientMsg.(*ethereum.Header)
+				s.Require().True(ok)
+				header.ConsensusUpdate.FinalizedHeader.Execution.StateRoot = header.ConsensusUpdate.AttestedHeader.Execution.StateRoot
+			},
+			true,
+			"",
+		},
+		{
+			"conflicting execution timestamp for the finalized slot",
+			func() {
+				header, ok := clientMsg.(*ethereum.Header)
+				s.Require().True(ok)
+				header.ConsensusUpdate.FinalizedHeader.Execution.Timestamp++
+			},
+			true,
+			"",
+		},
+		{
+			"panic on invalid client message type",
+			func() {
+				clientMsg = mockClientMessage{}
+			},
+			false,
+			fmt.Sprintf("expected type %T, got type %T", (*ethereum.Header)(nil), mockClientMessage{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.applyUpdates(1)
+
+			ctx := s.updateContext(0)
+			clientMsg = s.header(0)
+
+			tc.malleate()
+
+			if tc.expPanic != "" {
+				s.Require().PanicsWithValue(tc.expPanic, func() {
+					s.lightClientModule.CheckForMisbehaviour(ctx, testClientID, clientMsg)
+				})
+				return
+			}
+
+			s.Require().Equal(tc.expMisbehaviour, s.lightClientModule.CheckForMisbehaviour(ctx, testClientID, clientMsg))
+		})
+	}
+}
+
+func (s *EthereumTestSuite) TestUpdateStateOnMisbehaviour() {
+	s.applyUpdates(1)
+
+	ctx := s.updateContext(1)
+	s.lightClientModule.UpdateStateOnMisbehaviour(ctx, testClientID, s.header(1))
+	s.Require().Equal(exported.Frozen, s.lightClientModule.Status(ctx, testClientID))
+
+	err := s.lightClientModule.VerifyClientMessage(ctx, testClientID, s.header(1))
+	s.Require().ErrorIs(err, ethereum.ErrClientFrozen)
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package evm implements the verification of Ethereum account and storage proofs shared by the light clients
+// verifying the commitments stored by the ICS26 router contract on EVM chains.
+package evm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+)
+
+// NonMembershipCommitment is the 32-byte value of an absent commitment.
+var NonMembershipCommitment = make([]byte, 32)
+
+// account is the RLP-encoded account stored in the Ethereum state trie.
+type account struct {
+	Nonce    uint64
+	Balance  *big.Int
+	Root     common.Hash
+	CodeHash []byte
+}
+
+// VerifyAccountStorageRoot verifies the account proof of the contract against the state root and returns the storage
+// root of the contract.
+func VerifyAccountStorageRoot(stateRoot []byte, contractAddress common.Address, accountProof [][]byte) ([]byte, error) {
+	accountRLP, err := commitmenttypes.VerifyMPTProof(common.BytesToHash(stateRoot), crypto.Keccak256(contractAddress.Bytes()), accountProof)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify account proof: %w", err)
+	}
+
+	if len(accountRLP) == 0 {
+		return nil, fmt.Errorf("contract account %s does not exist", contractAddress.Hex())
+	}
+
+	var acc account
+	if err := rlp.DecodeBytes(accountRLP, &acc); err != nil {
+		return nil, fmt.Errorf("failed to decode account: %w", err)
+	}
+
+	return acc.Root.Bytes(), nil
+}
+
+// VerifyStorageValue verifies the storage proof of the slot against the storage root and returns the 32-byte value
+// stored in the slot. Absent slots are returned as the NonMembershipCommitment.
+func VerifyStorageValue(storageRoot []byte, slot []byte, proof [][]byte) ([]byte, error) {
+	valueRLP, err := commitmenttypes.VerifyMPTProof(common.BytesToHash(storageRoot), crypto.Keccak256(slot), proof)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify storage proof: %w", err)
+	}
+
+	if len(valueRLP) == 0 {
+		return NonMembershipCommitment, nil
+	}
+
+	var value []byte
+	if err := rlp.DecodeBytes(valueRLP, &value); err != nil {
+		return nil, fmt.Errorf("failed to decode storage value: %w", err)
+	}
+
+	if len(value) > 32 {
+		return nil, fmt.Errorf("storage value exceeds 32 bytes: %d", len(value))
+	}
+
+	return common.LeftPadBytes(value, 32), nil
+}
+
+// CommitmentSlot returns the storage slot of the commitment for the provided path in the commitments
+// mapping(bytes32 => bytes32) of the ICS26 router located at the provided base slot: keccak256(keccak256(path) || slot).
+func CommitmentSlot(path []byte, commitmentsSlot []byte) []byte {
+	return crypto.Keccak256(crypto.Keccak256(path), common.LeftPadBytes(commitmentsSlot, 32))
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package quorum
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+// NewClientState creates a new quorum ClientState instance.
+func NewClientState(clientIDs []string, threshold uint32) *ClientState {
+	return &ClientState{
+		ClientIDs: clientIDs,
+		Threshold: threshold,
+	}
+}
+
+// ClientType is quorum.
+func (ClientState) ClientType() string {
+	return exported.Quorum
+}
+
+// Validate performs basic validation of the client state fields. The sub-client identifiers must be
+// unique and cannot identify quorum clients, and the threshold must be between 1 and the number of
+// sub-clients.
+func (cs ClientState) Validate() error {
+	if len(cs.ClientIDs) == 0 {
+		return errorsmod.Wrap(ErrInvalidClientIDs, "sub-client identifiers cannot be empty")
+	}
+
+	seen := make(map[string]struct{}, len(cs.ClientIDs))
+	for _, clientID := range cs.ClientIDs {
+		clientType, _, err := clienttypes.ParseClientIdentifier(clientID)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidClientIDs, "invalid sub-client identifier %s: %v", clientID, err)
+		}
+
+		if clientType == exported.Quorum {
+			return errorsmod.Wrapf(ErrInvalidClientIDs, "sub-client %s cannot be a quorum client", clientID)
+		}
+
+		if _, found := seen[clientID]; found {
+			return errorsmod.Wrapf(ErrInvalidClientIDs, "duplicate sub-client identifier %s", clientID)
+		}
+		seen[clientID] = struct{}{}
+	}
+
+	if cs.Threshold == 0 || int(cs.Threshold) > len(cs.ClientIDs) {
+		return errorsmod.Wrapf(ErrInvalidThreshold, "threshold must be between 1 and %d, got %d", len(cs.ClientIDs), cs.Threshold)
+	}
+
+	return nil
+}
+
+// sets the client state to the store
+func setClientState(store storetypes.KVStore, cdc codec.BinaryCodec, clientState exported.ClientState) {
+	bz := clienttypes.MustMarshalClientState(cdc, clientState)
+	store.Set(host.ClientStateKey(), bz)
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package quorum_test
+
+import (
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/quorum"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func (s *QuorumTestSuite) TestClientStateValidate() {
+	testCases := []struct {
+		name        string
+		clientState *quorum.ClientState
+		expErr      error
+	}{
+		{
+			"valid client state",
+			quorum.NewClientState([]string{"07-tendermint-0", "attestations-0", "08-wasm-0"}, 2),
+			nil,
+		},
+		{
+			"valid client state with a threshold of all sub-clients",
+			quorum.NewClientState([]string{"07-tendermint-0", "attestations-0"}, 2),
+			nil,
+		},
+		{
+			"empty sub-client identifiers",
+			quorum.NewClientState(nil, 1),
+			quorum.ErrInvalidClientIDs,
+		},
+		{
+			"invalid sub-client identifier",
+			quorum.NewClientState([]string{"07-tendermint-0", ibctesting.InvalidID}, 1),
+			quorum.ErrInvalidClientIDs,
+		},
+		{
+			"quorum sub-client",
+			quorum.NewClientState([]string{"07-tendermint-0", "quorum-0"}, 1),
+			quorum.ErrInvalidClientIDs,
+		},
+		{
+			"duplicate sub-client identifier",
+			quorum.NewClientState([]string{"07-tendermint-0", "07-tendermint-0"}, 1),
+			quorum.ErrInvalidClientIDs,
+		},
+		{
+			"zero threshold",
+			quorum.NewClientState([]string{"07-tendermint-0", "attestations-0"}, 0),
+			quorum.ErrInvalidThreshold,
+		},
+		{
+			"threshold exceeds the number of sub-clients",
+			quorum.NewClientState([]string{"07-tendermint-0", "attestations-0"}, 3),
+			quorum.ErrInvalidThreshold,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(exported.Quorum, tc.clientState.ClientType())
+
+			err := tc.clientState.Validate()
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package quorum
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+// RegisterInterfaces register the ibc quorum light client submodule interfaces to protobuf Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*exported.ClientState)(nil),
+		&ClientState{},
+	)
+	registry.RegisterImplementations(
+		(*exported.ConsensusState)(nil),
+		&ConsensusState{},
+	)
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package quorum
+
+import (
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ exported.ConsensusState = (*ConsensusState)(nil)
+
+// ClientType returns quorum type.
+func (ConsensusState) ClientType() string {
+	return exported.Quorum
+}
+
+// GetTimestamp is deprecated and will panic if called.
+func (ConsensusState) GetTimestamp() uint64 {
+	panic("GetTimestamp is deprecated")
+}
+
+// ValidateBasic performs a no-op. The quorum consensus state holds no fields, as consensus states
+// are kept by the sub-clients.
+func (ConsensusState) ValidateBasic() error {
+	return nil
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package quorum implements a meta IBC light client which trusts the counterparty only if a
+// threshold of independent sub-clients agree, for example a 07-tendermint client, an attestations
+// client and an 08-wasm client tracking the same counterparty chain.
+//
+// # Experimental
+//
+// This package is EXPERIMENTAL and is not yet stable. It may change
+// in backwards-incompatible ways without notice.
+//
+// The quorum client holds no consensus states of its own. The sub-clients are updated
+// independently, and every call is routed to them through the 02-client keeper:
+//   - Membership and non-membership proofs are MultiProofs holding a proof for each sub-client,
+//     verified at the same height by each sub-client. At least the threshold of active
+//     sub-clients must verify their proof.
+//   - The status is Active if at least the threshold of sub-clients is active.
+//   - The latest height is the highest height reached by at least the threshold of active
+//     sub-clients, and the timestamp at a height is the highest timestamp such that at least
+//     the threshold of sub-clients report a timestamp no earlier than it.
+//
+// As heights are passed to the sub-clients unchanged, all sub-clients must track the same
+// counterparty chain with the same height semantics.
+//
+// Limitations:
+//   - No client updates, misbehaviour, recovery or upgrades: these apply to the sub-clients
+//   - Sub-clients cannot be quorum clients
+package quorum
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package quorum
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidClientIDs   = errorsmod.Register(ModuleName, 2, "invalid sub-client identifiers")
+	ErrInvalidThreshold   = errorsmod.Register(ModuleName, 3, "invalid threshold")
+	ErrInvalidProof       = errorsmod.Register(ModuleName, 4, "invalid quorum proof")
+	ErrThresholdNotMet    = errorsmod.Register(ModuleName, 5, "threshold of sub-clients not met")
+	ErrSubClientNotActive = errorsmod.Register(ModuleName, 6, "sub-client is not active")
+)
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package quorum
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+// ClientKeeper defines the expected 02-client keeper, used to route calls to the sub-clients.
+type ClientKeeper interface {
+	Route(ctx sdk.Context, clientID string) (exported.LightClientModule, error)
+}
@@ -0,0 +1,7 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package quorum
+
+const (
+	ModuleName = "quorum"
+)
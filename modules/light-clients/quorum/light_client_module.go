@@ -0,0 +1,302 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package quorum
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ exported.LightClientModule = (*LightClientModule)(nil)
+
+// LightClientModule implements the core IBC api.LightClientModule interface.
+type LightClientModule struct {
+	cdc           codec.BinaryCodec
+	storeProvider clienttypes.StoreProvider
+	clientKeeper  ClientKeeper
+}
+
+// NewLightClientModule creates and returns a new quorum LightClientModule. The client keeper is used
+// to route calls to the sub-clients.
+func NewLightClientModule(cdc codec.BinaryCodec, storeProvider clienttypes.StoreProvider, clientKeeper ClientKeeper) LightClientModule {
+	return LightClientModule{
+		cdc:           cdc,
+		storeProvider: storeProvider,
+		clientKeeper:  clientKeeper,
+	}
+}
+
+// Initialize unmarshals the provided client and consensus states and performs basic validation.
+// Every sub-client must exist. The consensus state is not stored.
+func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientStateBz, consensusStateBz []byte) error {
+	var clientState ClientState
+	if err := l.cdc.Unmarshal(clientStateBz, &clientState); err != nil {
+		return errorsmod.Wrapf(err, "failed to unmarshal client state bytes into client state")
+	}
+
+	if err := clientState.Validate(); err != nil {
+		return err
+	}
+
+	var consensusState ConsensusState
+	if err := l.cdc.Unmarshal(consensusStateBz, &consensusState); err != nil {
+		return errorsmod.Wrapf(err, "failed to unmarshal consensus state bytes into consensus state")
+	}
+
+	if err := consensusState.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, subClientID := range clientState.ClientIDs {
+		clientModule, err := l.clientKeeper.Route(ctx, subClientID)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to route sub-client %s", subClientID)
+		}
+
+		if clientModule.Status(ctx, subClientID) == exported.Unknown {
+			return errorsmod.Wrapf(clienttypes.ErrClientNotFound, "sub-client %s", subClientID)
+		}
+	}
+
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	setClientState(clientStore, l.cdc, &clientState)
+
+	return nil
+}
+
+// VerifyClientMessage is unsupported by the quorum client type and returns an error. The sub-clients
+// must be updated instead.
+func (LightClientModule) VerifyClientMessage(_ sdk.Context, _ string, _ exported.ClientMessage) error {
+	return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "client message verification is unsupported by the quorum client, update its sub-clients instead")
+}
+
+// CheckForMisbehaviour is unsupported by the quorum client type and performs a no-op, returning false.
+func (LightClientModule) CheckForMisbehaviour(_ sdk.Context, _ string, _ exported.ClientMessage) bool {
+	return false
+}
+
+// UpdateStateOnMisbehaviour is unsupported by the quorum client type and performs a no-op.
+func (LightClientModule) UpdateStateOnMisbehaviour(_ sdk.Context, _ string, _ exported.ClientMessage) {
+}
+
+// UpdateState is unsupported by the quorum client type and performs a no-op, returning no heights.
+func (LightClientModule) UpdateState(_ sdk.Context, _ string, _ exported.ClientMessage) []exported.Height {
+	return nil
+}
+
+// VerifyMembership delegates the verification of the membership proof to the sub-clients. The proof must be a
+// MultiProof and at least the threshold of active sub-clients must verify their proof at the given height.
+func (l LightClientModule) VerifyMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	return l.verifyQuorum(ctx, clientID, proof, func(clientModule exported.LightClientModule, subClientID string, subProof []byte) error {
+		return clientModule.VerifyMembership(ctx, subClientID, height, delayTimePeriod, delayBlockPeriod, subProof, path, value)
+	})
+}
+
+// VerifyNonMembership delegates the verification of the non-membership proof to the sub-clients. The proof must be a
+// MultiProof and at least the threshold of active sub-clients must verify their proof at the given height.
+func (l LightClientModule) VerifyNonMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	return l.verifyQuorum(ctx, clientID, proof, func(clientModule exported.LightClientModule, subClientID string, subProof []byte) error {
+		return clientModule.VerifyNonMembership(ctx, subClientID, height, delayTimePeriod, delayBlockPeriod, subProof, path)
+	})
+}
+
+// verifyQuorum unmarshals the MultiProof and calls verify for the proof of each sub-client until the threshold
+// of sub-clients is met. Proofs of sub-clients which are not active are counted as failures.
+func (l LightClientModule) verifyQuorum(
+	ctx sdk.Context,
+	clientID string,
+	proof []byte,
+	verify func(clientModule exported.LightClientModule, subClientID string, subProof []byte) error,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	var multiProof MultiProof
+	if err := l.cdc.Unmarshal(proof, &multiProof); err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "failed to unmarshal proof: %v", err)
+	}
+
+	if err := multiProof.ValidateBasic(clientState); err != nil {
+		return err
+	}
+
+	var (
+		verified uint32
+		failures []string
+	)
+	for _, clientProof := range multiProof.Proofs {
+		clientModule, status := l.subClientStatus(ctx, clientProof.ClientID)
+		if status != exported.Active {
+			err := errorsmod.Wrapf(ErrSubClientNotActive, "status %s", status)
+			failures = append(failures, fmt.Sprintf("%s: %s", clientProof.ClientID, err))
+			continue
+		}
+
+		if err := verify(clientModule, clientProof.ClientID, clientProof.Proof); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", clientProof.ClientID, err))
+			continue
+		}
+
+		verified++
+		if verified == clientState.Threshold {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(ErrThresholdNotMet, "%d of %d required sub-clients verified the proof: [%s]", verified, clientState.Threshold, strings.Join(failures, "; "))
+}
+
+// Status returns the status of the quorum client, derived from the status of its sub-clients.
+// The client may be:
+// - Active: if at least the threshold of sub-clients is active.
+// - Frozen: if frozen sub-clients prevent the threshold from being met.
+// - Expired: if frozen and expired sub-clients prevent the threshold from being met.
+// - Unauthorized: if frozen, expired and unauthorized sub-clients prevent the threshold from being met.
+// - Unknown: if the client state associated with the provided client identifier is not found, or if
+// sub-clients with an unknown status prevent the threshold from being met.
+func (l LightClientModule) Status(ctx sdk.Context, clientID string) exported.Status {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return exported.Unknown
+	}
+
+	statuses := make(map[exported.Status]int)
+	for _, subClientID := range clientState.ClientIDs {
+		_, status := l.subClientStatus(ctx, subClientID)
+		statuses[status]++
+	}
+
+	if statuses[exported.Active] >= int(clientState.Threshold) {
+		return exported.Active
+	}
+
+	// the threshold cannot be met once more than len(ClientIDs) - Threshold sub-clients are inactive,
+	// the status returned is the most severe one which accounts for the missing sub-clients
+	maxInactive := len(clientState.ClientIDs) - int(clientState.Threshold)
+	var inactive int
+	for _, status := range []exported.Status{exported.Frozen, exported.Expired, exported.Unauthorized} {
+		inactive += statuses[status]
+		if inactive > maxInactive {
+			return status
+		}
+	}
+
+	return exported.Unknown
+}
+
+// LatestHeight returns the highest height reached by at least the threshold of active sub-clients.
+// If no client is present for the provided client identifier, or fewer than the threshold of sub-clients
+// are active, a zero value height is returned.
+func (l LightClientModule) LatestHeight(ctx sdk.Context, clientID string) exported.Height {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return clienttypes.ZeroHeight()
+	}
+
+	var heights []clienttypes.Height
+	for _, subClientID := range clientState.ClientIDs {
+		clientModule, status := l.subClientStatus(ctx, subClientID)
+		if status != exported.Active {
+			continue
+		}
+
+		latestHeight := clientModule.LatestHeight(ctx, subClientID)
+		heights = append(heights, clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()))
+	}
+
+	if len(heights) < int(clientState.Threshold) {
+		return clienttypes.ZeroHeight()
+	}
+
+	slices.SortFunc(heights, func(a, b clienttypes.Height) int {
+		return int(b.Compare(a))
+	})
+
+	return heights[clientState.Threshold-1]
+}
+
+// TimestampAtHeight returns the highest timestamp in nanoseconds such that at least the threshold of active
+// sub-clients hold a consensus state at the given height with a timestamp no earlier than it.
+func (l LightClientModule) TimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	var timestamps []uint64
+	for _, subClientID := range clientState.ClientIDs {
+		clientModule, status := l.subClientStatus(ctx, subClientID)
+		if status != exported.Active {
+			continue
+		}
+
+		timestamp, err := clientModule.TimestampAtHeight(ctx, subClientID, height)
+		if err != nil {
+			continue
+		}
+
+		timestamps = append(timestamps, timestamp)
+	}
+
+	if len(timestamps) < int(clientState.Threshold) {
+		return 0, errorsmod.Wrapf(ErrThresholdNotMet, "%d of %d required sub-clients have a consensus state at height %s", len(timestamps), clientState.Threshold, height)
+	}
+
+	slices.Sort(timestamps)
+	slices.Reverse(timestamps)
+
+	return timestamps[clientState.Threshold-1], nil
+}
+
+// RecoverClient returns an error. The status of the quorum client is derived from its sub-clients, which must be recovered instead.
+func (LightClientModule) RecoverClient(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "cannot recover quorum client, recover its sub-clients instead")
+}
+
+// VerifyUpgradeAndUpdateState returns an error since the quorum client does not support upgrades.
+func (LightClientModule) VerifyUpgradeAndUpdateState(_ sdk.Context, _ string, _, _, _, _ []byte) error {
+	return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade quorum client")
+}
+
+// subClientStatus routes the sub-client through the client keeper and returns its light client module and status.
+// Unauthorized is returned if the sub-client cannot be routed.
+func (l LightClientModule) subClientStatus(ctx sdk.Context, subClientID string) (exported.LightClientModule, exported.Status) {
+	clientModule, err := l.clientKeeper.Route(ctx, subClientID)
+	if err != nil {
+		return nil, exported.Unauthorized
+	}
+
+	return clientModule, clientModule.Status(ctx, subClientID)
+}
@@ -0,0 +1,739 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package quorum_test
+
+import (
+	"testing"
+	"time"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/quorum"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+const subClientCount = 3
+
+type QuorumTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+
+	// paths hold the 07-tendermint sub-clients of chainB on chainA
+	paths []*ibctesting.Path
+
+	lightClientModule quorum.LightClientModule
+}
+
+func TestQuorumTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(QuorumTestSuite))
+}
+
+func (s *QuorumTestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.paths = make([]*ibctesting.Path, subClientCount)
+	for i := range s.paths {
+		s.paths[i] = ibctesting.NewPath(s.chainA, s.chainB)
+		s.paths[i].SetupClients()
+	}
+
+	s.updateSubClients(s.paths...)
+
+	cdc := s.chainA.App.AppCodec()
+	storeKey := s.chainA.GetSimApp().GetKey(exported.StoreKey)
+	storeProvider := clienttypes.NewStoreProvider(runtime.NewKVStoreService(storeKey))
+	s.lightClientModule = quorum.NewLightClientModule(cdc, storeProvider, s.chainA.App.GetIBCKeeper().ClientKeeper)
+}
+
+// subClientIDs returns the identifiers of the sub-clients on chainA.
+func (s *QuorumTestSuite) subClientIDs() []string {
+	clientIDs := make([]string, len(s.paths))
+	for i, path := range s.paths {
+		clientIDs[i] = path.EndpointA.ClientID
+	}
+
+	return clientIDs
+}
+
+// updateSubClients commits a block on chainB and updates the sub-clients of the provided paths to it,
+// so that they all track the same height.
+func (s *QuorumTestSuite) updateSubClients(paths ...*ibctesting.Path) {
+	s.coordinator.CommitBlock(s.chainB)
+
+	for _, path := range paths {
+		trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+		s.Require().True(ok)
+
+		header, err := s.chainB.IBCClientHeader(s.chainB.LatestCommittedHeader, trustedHeight)
+		s.Require().NoError(err)
+
+		msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, header, s.chainA.SenderAccount.GetAddress().String())
+		s.Require().NoError(err)
+
+		_, err = s.chainA.SendMsgs(msg)
+		s.Require().NoError(err)
+	}
+}
+
+// createClient creates a quorum client on chainA over all sub-clients with the given threshold.
+func (s *QuorumTestSuite) createClient(threshold uint32) string {
+	clientStateBz, consensusStateBz := s.marshalStates(quorum.NewClientState(s.subClientIDs(), threshold))
+
+	clientID, err := s.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(s.chainA.GetContext(), exported.Quorum, clientStateBz, consensusStateBz)
+	s.Require().NoError(err)
+
+	return clientID
+}
+
+func (s *QuorumTestSuite) marshalStates(clientState *quorum.ClientState) ([]byte, []byte) {
+	clientStateBz, err := s.chainA.App.AppCodec().Marshal(clientState)
+	s.Require().NoError(err)
+
+	consensusStateBz, err := s.chainA.App.AppCodec().Marshal(&quorum.ConsensusState{})
+	s.Require().NoError(err)
+
+	return clientStateBz, consensusStateBz
+}
+
+// multiProof returns a marshaled MultiProof holding the same proof for each of the provided sub-clients.
+func (s *QuorumTestSuite) multiProof(proof []byte, clientIDs ...string) []byte {
+	var multiProof quorum.MultiProof
+	for _, clientID := range clientIDs {
+		multiProof.Proofs = append(multiProof.Proofs, quorum.ClientProof{ClientID: clientID, Proof: proof})
+	}
+
+	bz, err := s.chainA.App.AppCodec().Marshal(&multiProof)
+	s.Require().NoError(err)
+
+	return bz
+}
+
+// expireClient sets a trusting period on the sub-client which has elapsed since its latest consensus state.
+func (s *QuorumTestSuite) expireClient(path *ibctesting.Path) {
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	s.Require().True(ok)
+
+	clientState.TrustingPeriod = time.Nanosecond
+	path.EndpointA.SetClientState(clientState)
+}
+
+// removeClient deletes the client state of the sub-client so that its status is unknown.
+func (s *QuorumTestSuite) removeClient(path *ibctesting.Path) {
+	clientStore := s.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(s.chainA.GetContext(), path.EndpointA.ClientID)
+	clientStore.Delete(host.ClientStateKey())
+}
+
+func (s *QuorumTestSuite) TestInitialize() {
+	var clientState *quorum.ClientState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid client state",
+			func() {
+				clientState.Threshold = 0
+			},
+			quorum.ErrInvalidThreshold,
+		},
+		{
+			"failure: sub-client not found",
+			func() {
+				clientState.ClientIDs[0] = clienttypes.FormatClientIdentifier(exported.Tendermint, 100)
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: sub-client type is not allowed",
+			func() {
+				params := clienttypes.NewParams(exported.Quorum)
+				s.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(s.chainA.GetContext(), params)
+			},
+			clienttypes.ErrInvalidClientType,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			clientState = quorum.NewClientState(s.subClientIDs(), 2)
+
+			tc.malleate()
+
+			clientStateBz, consensusStateBz := s.marshalStates(clientState)
+			clientID := clienttypes.FormatClientIdentifier(exported.Quorum, 0)
+
+			err := s.lightClientModule.Initialize(s.chainA.GetContext(), clientID, clientStateBz, consensusStateBz)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(exported.Active, s.lightClientModule.Status(s.chainA.GetContext(), clientID))
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *QuorumTestSuite) TestInitializeInvalidBytes() {
+	clientStateBz, consensusStateBz := s.marshalStates(quorum.NewClientState(s.subClientIDs(), 2))
+	clientID := clienttypes.FormatClientIdentifier(exported.Quorum, 0)
+
+	err := s.lightClientModule.Initialize(s.chainA.GetContext(), clientID, []byte("invalid"), consensusStateBz)
+	s.Require().Error(err)
+
+	err = s.lightClientModule.Initialize(s.chainA.GetContext(), clientID, clientStateBz, []byte("invalid"))
+	s.Require().Error(err)
+}
+
+func (s *QuorumTestSuite) TestStatus() {
+	var clientID string
+
+	testCases := []struct {
+		name      string
+		threshold uint32
+		malleate  func()
+		expStatus exported.Status
+	}{
+		{
+			"all sub-clients are active",
+			2,
+			func() {},
+			exported.Active,
+		},
+		{
+			"threshold of sub-clients is active",
+			2,
+			func() {
+				s.paths[0].EndpointA.FreezeClient()
+			},
+			exported.Active,
+		},
+		{
+			"frozen sub-clients prevent the threshold",
+			2,
+			func() {
+				s.paths[0].EndpointA.FreezeClient()
+				s.paths[1].EndpointA.FreezeClient()
+			},
+			exported.Frozen,
+		},
+		{
+			"frozen and expired sub-clients prevent the threshold",
+			2,
+			func() {
+				s.paths[0].EndpointA.FreezeClient()
+				s.expireClient(s.paths[1])
+			},
+			exported.Expired,
+		},
+		{
+			"expired sub-client prevents the threshold",
+			3,
+			func() {
+				s.expireClient(s.paths[2])
+			},
+			exported.Expired,
+		},
+		{
+			"unauthorized sub-clients prevent the threshold",
+			2,
+			func() {
+				params := clienttypes.NewParams(exported.Quorum)
+				s.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(s.chainA.GetContext(), params)
+			},
+			exported.Unauthorized,
+		},
+		{
+			"sub-clients with an unknown status prevent the threshold",
+			2,
+			func() {
+				s.removeClient(s.paths[0])
+				s.removeClient(s.paths[1])
+			},
+			exported.Unknown,
+		},
+		{
+			"client state not found",
+			2,
+			func() {
+				clientID = ibctesting.InvalidID
+			},
+			exported.Unknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			clientID = s.createClient(tc.threshold)
+
+			tc.malleate()
+
+			s.Require().Equal(tc.expStatus, s.lightClientModule.Status(s.chainA.GetContext(), clientID))
+		})
+	}
+}
+
+func (s *QuorumTestSuite) TestLatestHeight() {
+	var (
+		clientID        string
+		expLatestHeight exported.Height
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"all sub-clients at the same height",
+			func() {},
+		},
+		{
+			"a single sub-client is ahead",
+			func() {
+				s.updateSubClients(s.paths[0])
+			},
+		},
+		{
+			"the threshold of sub-clients is ahead",
+			func() {
+				s.updateSubClients(s.paths[0], s.paths[2])
+				expLatestHeight = s.paths[2].EndpointA.GetClientLatestHeight()
+			},
+		},
+		{
+			"the threshold of sub-clients is ahead at different heights",
+			func() {
+				s.updateSubClients(s.paths[0])
+				s.updateSubClients(s.paths[1])
+				expLatestHeight = s.paths[0].EndpointA.GetClientLatestHeight()
+			},
+		},
+		{
+			"inactive sub-clients are not counted",
+			func() {
+				s.updateSubClients(s.paths[0], s.paths[1])
+				s.paths[1].EndpointA.FreezeClient()
+			},
+		},
+		{
+			"fewer than the threshold of sub-clients is active",
+			func() {
+				s.paths[0].EndpointA.FreezeClient()
+				s.paths[1].EndpointA.FreezeClient()
+				expLatestHeight = clienttypes.ZeroHeight()
+			},
+		},
+		{
+			"client state not found",
+			func() {
+				clientID = ibctesting.InvalidID
+				expLatestHeight = clienttypes.ZeroHeight()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			clientID = s.createClient(2)
+			expLatestHeight = s.paths[0].EndpointA.GetClientLatestHeight()
+
+			tc.malleate()
+
+			s.Require().Equal(expLatestHeight, s.lightClientModule.LatestHeight(s.chainA.GetContext(), clientID))
+		})
+	}
+}
+
+func (s *QuorumTestSuite) TestTimestampAtHeight() {
+	var (
+		clientID     string
+		height       exported.Height
+		expTimestamp uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: all sub-clients agree",
+			func() {},
+			nil,
+		},
+		{
+			"success: a single sub-client reports a later timestamp",
+			func() {
+				consensusState, ok := s.paths[0].EndpointA.GetConsensusState(height).(*ibctm.ConsensusState)
+				s.Require().True(ok)
+
+				consensusState.Timestamp = consensusState.Timestamp.Add(time.Hour)
+				s.paths[0].EndpointA.SetConsensusState(consensusState, height)
+			},
+			nil,
+		},
+		{
+			"success: the threshold of sub-clients reports a later timestamp",
+			func() {
+				for _, path := range s.paths[:2] {
+					consensusState, ok := path.EndpointA.GetConsensusState(height).(*ibctm.ConsensusState)
+					s.Require().True(ok)
+
+					consensusState.Timestamp = consensusState.Timestamp.Add(time.Hour)
+					path.EndpointA.SetConsensusState(consensusState, height)
+				}
+
+				expTimestamp += uint64(time.Hour)
+			},
+			nil,
+		},
+		{
+			"failure: fewer than the threshold of sub-clients has a consensus state at the height",
+			func() {
+				s.updateSubClients(s.paths[0])
+				height = s.paths[0].EndpointA.GetClientLatestHeight()
+			},
+			quorum.ErrThresholdNotMet,
+		},
+		{
+			"failure: fewer than the threshold of sub-clients is active",
+			func() {
+				s.paths[0].EndpointA.FreezeClient()
+				s.paths[1].EndpointA.FreezeClient()
+			},
+			quorum.ErrThresholdNotMet,
+		},
+		{
+			"failure: client state not found",
+			func() {
+				clientID = ibctesting.InvalidID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			clientID = s.createClient(2)
+			height = s.paths[0].EndpointA.GetClientLatestHeight()
+
+			var err error
+			expTimestamp, err = s.chainA.App.GetIBCKeeper().ClientKeeper.GetClientTimestampAtHeight(s.chainA.GetContext(), s.paths[0].EndpointA.ClientID, height)
+			s.Require().NoError(err)
+
+			tc.malleate()
+
+			timestamp, err := s.lightClientModule.TimestampAtHeight(s.chainA.GetContext(), clientID, height)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(expTimestamp, timestamp)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *QuorumTestSuite) TestVerifyMembership() {
+	var (
+		clientID    string
+		proofHeight exported.Height
+		proof       []byte
+		subProof    []byte
+		path        exported.Path
+		value       []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: proofs for all sub-clients",
+			func() {},
+			nil,
+		},
+		{
+			"success: proofs for the threshold of sub-clients",
+			func() {
+				proof = s.multiProof(subProof, s.paths[0].EndpointA.ClientID, s.paths[2].EndpointA.ClientID)
+			},
+			nil,
+		},
+		{
+			"success: a single sub-client fails verification",
+			func() {
+				var multiProof quorum.MultiProof
+				s.Require().NoError(s.chainA.App.AppCodec().Unmarshal(proof, &multiProof))
+
+				multiProof.Proofs[0].Proof = []byte("invalid proof")
+				proof = s.chainA.App.AppCodec().MustMarshal(&multiProof)
+			},
+			nil,
+		},
+		{
+			"success: a single sub-client is frozen",
+			func() {
+				s.paths[1].EndpointA.FreezeClient()
+			},
+			nil,
+		},
+		{
+			"failure: proofs for fewer than the threshold of sub-clients",
+			func() {
+				proof = s.multiProof(subProof, s.paths[1].EndpointA.ClientID)
+			},
+			quorum.ErrThresholdNotMet,
+		},
+		{
+			"failure: fewer than the threshold of sub-clients verifies the value",
+			func() {
+				value = []byte("invalid value")
+			},
+			quorum.ErrThresholdNotMet,
+		},
+		{
+			"failure: fewer than the threshold of sub-clients is active",
+			func() {
+				s.paths[0].EndpointA.FreezeClient()
+				s.expireClient(s.paths[1])
+			},
+			quorum.ErrThresholdNotMet,
+		},
+		{
+			"failure: fewer than the threshold of sub-clients has a consensus state at the proof height",
+			func() {
+				proofHeight = proofHeight.Increment()
+			},
+			quorum.ErrThresholdNotMet,
+		},
+		{
+			"failure: proof cannot be unmarshaled",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			quorum.ErrInvalidProof,
+		},
+		{
+			"failure: empty proofs",
+			func() {
+				proof = s.multiProof(subProof)
+			},
+			quorum.ErrInvalidProof,
+		},
+		{
+			"failure: proof for a client which is not a sub-client",
+			func() {
+				proof = s.multiProof(subProof, s.paths[0].EndpointA.ClientID, s.paths[0].EndpointB.ClientID)
+			},
+			quorum.ErrInvalidProof,
+		},
+		{
+			"failure: duplicate proofs for a sub-client",
+			func() {
+				proof = s.multiProof(subProof, s.paths[0].EndpointA.ClientID, s.paths[0].EndpointA.ClientID)
+			},
+			quorum.ErrInvalidProof,
+		},
+		{
+			"failure: empty proof for a sub-client",
+			func() {
+				proof = s.multiProof(nil, s.paths[0].EndpointA.ClientID, s.paths[1].EndpointA.ClientID)
+			},
+			quorum.ErrInvalidProof,
+		},
+		{
+			"failure: client state not found",
+			func() {
+				clientID = ibctesting.InvalidID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			clientID = s.createClient(2)
+
+			// prove the client state stored on chainB for its client of chainA
+			key := host.FullClientStateKey(s.paths[0].EndpointB.ClientID)
+			merklePath := commitmenttypes.NewMerklePath(key)
+			var err error
+			path, err = commitmenttypes.ApplyPrefix(s.chainB.GetPrefix(), merklePath)
+			s.Require().NoError(err)
+
+			subProof, proofHeight = s.chainB.QueryProof(key)
+			s.Require().Equal(s.paths[0].EndpointA.GetClientLatestHeight(), proofHeight)
+
+			value, err = s.chainB.Codec.MarshalInterface(s.paths[0].EndpointB.GetClientState())
+			s.Require().NoError(err)
+
+			proof = s.multiProof(subProof, s.subClientIDs()...)
+
+			tc.malleate()
+
+			err = s.lightClientModule.VerifyMembership(s.chainA.GetContext(), clientID, proofHeight, 0, 0, proof, path, value)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *QuorumTestSuite) TestVerifyNonMembership() {
+	var (
+		clientID    string
+		proofHeight exported.Height
+		proof       []byte
+		subProof    []byte
+		path        exported.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: proofs for all sub-clients",
+			func() {},
+			nil,
+		},
+		{
+			"success: proofs for the threshold of sub-clients",
+			func() {
+				proof = s.multiProof(subProof, s.paths[1].EndpointA.ClientID, s.paths[2].EndpointA.ClientID)
+			},
+			nil,
+		},
+		{
+			"failure: proofs for fewer than the threshold of sub-clients",
+			func() {
+				proof = s.multiProof(subProof, s.paths[1].EndpointA.ClientID)
+			},
+			quorum.ErrThresholdNotMet,
+		},
+		{
+			"failure: the path exists",
+			func() {
+				key := host.FullClientStateKey(s.paths[0].EndpointB.ClientID)
+				merklePath := commitmenttypes.NewMerklePath(key)
+				var err error
+				path, err = commitmenttypes.ApplyPrefix(s.chainB.GetPrefix(), merklePath)
+				s.Require().NoError(err)
+
+				subProof, _ = s.chainB.QueryProof(key)
+				proof = s.multiProof(subProof, s.subClientIDs()...)
+			},
+			quorum.ErrThresholdNotMet,
+		},
+		{
+			"failure: fewer than the threshold of sub-clients is active",
+			func() {
+				s.paths[0].EndpointA.FreezeClient()
+				s.paths[2].EndpointA.FreezeClient()
+			},
+			quorum.ErrThresholdNotMet,
+		},
+		{
+			"failure: proof cannot be unmarshaled",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			quorum.ErrInvalidProof,
+		},
+		{
+			"failure: client state not found",
+			func() {
+				clientID = ibctesting.InvalidID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			clientID = s.createClient(2)
+
+			key := host.FullClientStateKey(ibctesting.InvalidID)
+			merklePath := commitmenttypes.NewMerklePath(key)
+			var err error
+			path, err = commitmenttypes.ApplyPrefix(s.chainB.GetPrefix(), merklePath)
+			s.Require().NoError(err)
+
+			subProof, proofHeight = s.chainB.QueryProof(key)
+			proof = s.multiProof(subProof, s.subClientIDs()...)
+
+			tc.malleate()
+
+			err = s.lightClientModule.VerifyNonMembership(s.chainA.GetContext(), clientID, proofHeight, 0, 0, proof, path)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *QuorumTestSuite) TestUpdateClientNotSupported() {
+	clientID := s.createClient(2)
+
+	header, err := s.chainB.IBCClientHeader(s.chainB.LatestCommittedHeader, s.paths[0].EndpointA.GetClientLatestHeight().(clienttypes.Height))
+	s.Require().NoError(err)
+
+	err = s.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(s.chainA.GetContext(), clientID, header)
+	s.Require().ErrorIs(err, clienttypes.ErrUpdateClientFailed)
+}
+
+func (s *QuorumTestSuite) TestRecoverClientNotSupported() {
+	clientID := s.createClient(2)
+	substituteClientID := s.createClient(1)
+
+	err := s.lightClientModule.RecoverClient(s.chainA.GetContext(), clientID, substituteClientID)
+	s.Require().ErrorIs(err, clienttypes.ErrUpdateClientFailed)
+}
+
+func (s *QuorumTestSuite) TestVerifyUpgradeAndUpdateStateNotSupported() {
+	clientID := s.createClient(2)
+
+	err := s.lightClientModule.VerifyUpgradeAndUpdateState(s.chainA.GetContext(), clientID, nil, nil, nil, nil)
+	s.Require().ErrorIs(err, clienttypes.ErrInvalidUpgradeClient)
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package quorum
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateBasic checks that every proof is non-empty and is provided by a distinct sub-client of the client state.
+func (mp MultiProof) ValidateBasic(clientState *ClientState) error {
+	if len(mp.Proofs) == 0 {
+		return errorsmod.Wrap(ErrInvalidProof, "proofs cannot be empty")
+	}
+
+	seen := make(map[string]struct{}, len(mp.Proofs))
+	for _, clientProof := range mp.Proofs {
+		if !slices.Contains(clientState.ClientIDs, clientProof.ClientID) {
+			return errorsmod.Wrapf(ErrInvalidProof, "client %s is not a sub-client", clientProof.ClientID)
+		}
+
+		if _, found := seen[clientProof.ClientID]; found {
+			return errorsmod.Wrapf(ErrInvalidProof, "duplicate proof for sub-client %s", clientProof.ClientID)
+		}
+		seen[clientProof.ClientID] = struct{}{}
+
+		if len(clientProof.Proof) == 0 {
+			return errorsmod.Wrapf(ErrInvalidProof, "proof for sub-client %s cannot be empty", clientProof.ClientID)
+		}
+	}
+
+	return nil
+}
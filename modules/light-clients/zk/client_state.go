@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+import (
+	"strings"
+	"time"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types/v2"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+// NewClientState creates a new ClientState instance.
+// The proof specs are only used by ICS-23 proofs and may be empty for other proof formats.
+func NewClientState(
+	chainID string, latestHeight clienttypes.Height, maxClockDrift time.Duration,
+	proofFormat commitmenttypes.ProofFormat, proofSpecs []*ics23.ProofSpec, verifier Verifier,
+) *ClientState {
+	return &ClientState{
+		ChainId:       chainID,
+		LatestHeight:  latestHeight,
+		MaxClockDrift: maxClockDrift,
+		ProofFormat:   proofFormat,
+		ProofSpecs:    proofSpecs,
+		Verifier:      verifier,
+	}
+}
+
+// ClientType is ZK.
+func (ClientState) ClientType() string {
+	return exported.ZK
+}
+
+// Validate performs basic validation of the client state fields.
+func (cs ClientState) Validate() error {
+	if strings.TrimSpace(cs.ChainId) == "" {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "chain id cannot be empty string")
+	}
+	if cs.LatestHeight.IsZero() {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "latest height cannot be zero")
+	}
+	if cs.MaxClockDrift <= 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "max clock drift must be greater than zero")
+	}
+	if _, err := commitmenttypes.NewProofVerifier(cs.ProofFormat, cs.ProofSpecs); err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, err.Error())
+	}
+	if cs.ProofFormat == commitmenttypes.ICS23 && len(cs.ProofSpecs) == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "proof specs cannot be empty for ICS-23 proofs")
+	}
+	for i, spec := range cs.ProofSpecs {
+		if spec == nil {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "proof spec cannot be nil at index: %d", i)
+		}
+	}
+
+	return cs.Verifier.Validate()
+}
+
+// Validate performs basic validation of the verifier fields. The verifying key is validated against the
+// registered verifier of the proof system by the light client module.
+func (v Verifier) Validate() error {
+	if strings.TrimSpace(v.ProofSystem) == "" {
+		return errorsmod.Wrap(ErrInvalidVerifier, "proof system cannot be empty")
+	}
+	if len(v.VerifyingKey) == 0 {
+		return errorsmod.Wrap(ErrInvalidVerifier, "verifying key cannot be empty")
+	}
+	return nil
+}
+
+// verifyMembership verifies a membership proof of the value at the path in the proof format of the client against
+// the root of the consensus state at the specified height.
+func (cs ClientState) verifyMembership(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	if err := cs.verifyProofHeight(height); err != nil {
+		return err
+	}
+
+	proofVerifier, err := commitmenttypes.NewProofVerifier(cs.ProofFormat, cs.ProofSpecs)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := path.(commitmenttypesv2.MerklePath); !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+	}
+
+	consensusState, found := getConsensusState(clientStore, cdc, height)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return proofVerifier.VerifyMembership(consensusState.getRoot(), path, proof, value)
+}
+
+// verifyNonMembership verifies a non-membership proof of the path in the proof format of the client against the root
+// of the consensus state at the specified height.
+func (cs ClientState) verifyNonMembership(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	proof []byte,
+	path exported.Path,
+) error {
+	if err := cs.verifyProofHeight(height); err != nil {
+		return err
+	}
+
+	proofVerifier, err := commitmenttypes.NewProofVerifier(cs.ProofFormat, cs.ProofSpecs)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := path.(commitmenttypesv2.MerklePath); !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+	}
+
+	consensusState, found := getConsensusState(clientStore, cdc, height)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return proofVerifier.VerifyNonMembership(consensusState.getRoot(), path, proof)
+}
+
+// verifyProofHeight returns an error if the client is frozen or has not been updated to the proof height.
+func (cs ClientState) verifyProofHeight(height exported.Height) error {
+	if cs.IsFrozen {
+		return ErrClientFrozen
+	}
+
+	if cs.LatestHeight.LT(height) {
+		return errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.LatestHeight, height,
+		)
+	}
+
+	return nil
+}
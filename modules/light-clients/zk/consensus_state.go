@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ exported.ConsensusState = (*ConsensusState)(nil)
+
+// ClientType returns ZK type.
+func (ConsensusState) ClientType() string {
+	return exported.ZK
+}
+
+// GetTimestamp returns the timestamp (in nanoseconds) of the consensus state.
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return cs.Timestamp
+}
+
+// getRoot returns the commitment root of the consensus state.
+func (cs ConsensusState) getRoot() commitmenttypes.MerkleRoot {
+	return commitmenttypes.NewMerkleRoot(cs.Root)
+}
+
+// ValidateBasic defines basic validation for the ZK consensus state.
+func (cs ConsensusState) ValidateBasic() error {
+	if cs.Timestamp == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "timestamp cannot be 0")
+	}
+	if len(cs.Root) != RootLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "root must be %d bytes, got %d", RootLength, len(cs.Root))
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package zk implements a native IBC light client which is updated with succinct
+// proofs that a new counterparty state root follows from a trusted one, and
+// verifies IBC commitments against the proven state roots in the commitment
+// proof format of the client: ICS-23, Merkle-Patricia trie or sparse Merkle
+// tree proofs.
+//
+// # Experimental
+//
+// This package is EXPERIMENTAL and is not yet stable. It may change
+// in backwards-incompatible ways without notice.
+//
+// The client is agnostic of the proven program: the counterparty consensus is
+// verified off-chain by a circuit, identified by the verifying key stored in the
+// client state. Client updates carry the new height, state root and timestamp
+// together with a proof over two public inputs, the halves of the sha256 hash of
+// the chain identifier, the trusted height, root and timestamp and the new
+// height, root and timestamp. See PublicInputs for the exact encoding.
+//
+// Proof systems are registered on the light client module by name. DefaultVerifiers
+// registers:
+//   - groth16-bn254: Groth16 proofs over BN254
+//   - plonk-bn254: gnark PLONK proofs over BN254 with KZG commitments, such as
+//     the PLONK proofs wrapping SP1 programs
+//
+// The verifier of a client can be replaced by the module authority with
+// MsgUpdateVerifier, for example to upgrade the proven program.
+//
+// Limitations:
+//   - No trusting period: the client does not expire
+//   - No client recovery or upgrades
+//   - Delay periods are ignored by membership verification
+//   - Misbehaviour is only detected for conflicting consensus states submitted
+//     as client updates
+package zk
@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidHeader           = errorsmod.Register(ModuleName, 2, "invalid header")
+	ErrInvalidVerifier         = errorsmod.Register(ModuleName, 3, "invalid verifier")
+	ErrUnknownProofSystem      = errorsmod.Register(ModuleName, 4, "unknown proof system")
+	ErrInvalidVerifyingKey     = errorsmod.Register(ModuleName, 5, "invalid verifying key")
+	ErrInvalidProof            = errorsmod.Register(ModuleName, 6, "invalid proof")
+	ErrInvalidPublicInputs     = errorsmod.Register(ModuleName, 7, "invalid public inputs")
+	ErrProofVerificationFailed = errorsmod.Register(ModuleName, 8, "proof verification failed")
+	ErrClientFrozen            = errorsmod.Register(ModuleName, 9, "client is frozen")
+)
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/zk"
+)
+
+// gnarkVectors are the reference vectors recorded from the gnark provers by testdata/gnark, such that the
+// verifiers are checked against proofs they did not produce themselves.
+type gnarkVectors struct {
+	Plonk []struct {
+		Name         string   `json:"name"`
+		VerifyingKey string   `json:"verifying_key"`
+		Proof        string   `json:"proof"`
+		PublicInputs []string `json:"public_inputs"`
+	} `json:"plonk"`
+	Groth16 []struct {
+		Name         string   `json:"name"`
+		Alpha        string   `json:"alpha"`
+		Beta         string   `json:"beta"`
+		Gamma        string   `json:"gamma"`
+		Delta        string   `json:"delta"`
+		IC           []string `json:"ic"`
+		A            string   `json:"a"`
+		B            string   `json:"b"`
+		C            string   `json:"c"`
+		PublicInputs []string `json:"public_inputs"`
+	} `json:"groth16"`
+}
+
+func loadGnarkVectors(t *testing.T) gnarkVectors {
+	t.Helper()
+
+	bz, err := os.ReadFile(filepath.Join("testdata", "gnark_vectors.json"))
+	require.NoError(t, err)
+
+	var vectors gnarkVectors
+	require.NoError(t, json.Unmarshal(bz, &vectors))
+	require.NotEmpty(t, vectors.Plonk)
+	require.NotEmpty(t, vectors.Groth16)
+	return vectors
+}
+
+func mustDecodeHex(t *testing.T, values ...string) [][]byte {
+	t.Helper()
+
+	decoded := make([][]byte, len(values))
+	for i, value := range values {
+		bz, err := hex.DecodeString(value)
+		require.NoError(t, err)
+		decoded[i] = bz
+	}
+
+	return decoded
+}
+
+func TestPlonkVerifyGnarkVectors(t *testing.T) {
+	for _, vector := range loadGnarkVectors(t).Plonk {
+		t.Run(vector.Name, func(t *testing.T) {
+			encoded := mustDecodeHex(t, vector.VerifyingKey, vector.Proof)
+			vkBz, proofBz := encoded[0], encoded[1]
+			publicInputs := mustDecodeHex(t, vector.PublicInputs...)
+
+			verifier := zk.PlonkVerifier{}
+			require.NoError(t, verifier.ValidateVerifyingKey(vkBz, len(publicInputs)))
+			require.NoError(t, verifier.Verify(vkBz, proofBz, publicInputs))
+
+			// a public input which differs from the proven witness is rejected
+			tampered := [][]byte{publicInputs[0], {1}}
+			require.ErrorIs(t, verifier.Verify(vkBz, proofBz, tampered), zk.ErrProofVerificationFailed)
+		})
+	}
+}
+
+func TestGroth16VerifyGnarkVectors(t *testing.T) {
+	for _, vector := range loadGnarkVectors(t).Groth16 {
+		t.Run(vector.Name, func(t *testing.T) {
+			points := mustDecodeHex(t, vector.Alpha, vector.Beta, vector.Gamma, vector.Delta, vector.A, vector.B, vector.C)
+			verifyingKey := zk.Groth16VerifyingKey{Alpha: points[0], Beta: points[1], Gamma: points[2], Delta: points[3], IC: mustDecodeHex(t, vector.IC...)}
+			vkBz, err := verifyingKey.Marshal()
+			require.NoError(t, err)
+
+			proof := zk.Groth16Proof{A: points[4], B: points[5], C: points[6]}
+			proofBz, err := proof.Marshal()
+			require.NoError(t, err)
+
+			publicInputs := mustDecodeHex(t, vector.PublicInputs...)
+
+			verifier := zk.Groth16Verifier{}
+			require.NoError(t, verifier.ValidateVerifyingKey(vkBz, len(publicInputs)))
+			require.NoError(t, verifier.Verify(vkBz, proofBz, publicInputs))
+
+			// a public input which differs from the proven witness is rejected
+			tampered := [][]byte{publicInputs[0], {1}}
+			require.ErrorIs(t, verifier.Verify(vkBz, proofBz, tampered), zk.ErrProofVerificationFailed)
+		})
+	}
+}
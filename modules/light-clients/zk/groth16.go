@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+import (
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+var _ ProofVerifier = (*Groth16Verifier)(nil)
+
+// Groth16Verifier verifies Groth16 proofs over BN254. Verifying keys are encoded as Groth16VerifyingKey
+// and proofs as Groth16Proof.
+type Groth16Verifier struct{}
+
+// groth16VerifyingKey is a decoded Groth16VerifyingKey.
+type groth16VerifyingKey struct {
+	alpha              bn254.G1Affine
+	beta, gamma, delta bn254.G2Affine
+	ic                 []bn254.G1Affine
+}
+
+// ValidateVerifyingKey decodes the verifying key and checks it has a point for each public input.
+func (Groth16Verifier) ValidateVerifyingKey(verifyingKey []byte, nbPublicInputs int) error {
+	vk, err := decodeGroth16VerifyingKey(verifyingKey)
+	if err != nil {
+		return err
+	}
+
+	if len(vk.ic) != nbPublicInputs+1 {
+		return errorsmod.Wrapf(ErrInvalidVerifyingKey, "verifying key must have %d IC points for %d public inputs, got %d", nbPublicInputs+1, nbPublicInputs, len(vk.ic))
+	}
+
+	return nil
+}
+
+// Verify checks the Groth16 verification equation e(A, B) = e(alpha, beta) * e(vk_x, gamma) * e(C, delta),
+// where vk_x is the linear combination of the IC points with the public inputs.
+func (Groth16Verifier) Verify(verifyingKey, proof []byte, publicInputs [][]byte) error {
+	vk, err := decodeGroth16VerifyingKey(verifyingKey)
+	if err != nil {
+		return err
+	}
+
+	if len(vk.ic) != len(publicInputs)+1 {
+		return errorsmod.Wrapf(ErrInvalidPublicInputs, "verifying key has %d IC points for %d public inputs", len(vk.ic), len(publicInputs))
+	}
+
+	inputs, err := publicInputScalars(publicInputs)
+	if err != nil {
+		return err
+	}
+
+	var groth16Proof Groth16Proof
+	if err := groth16Proof.Unmarshal(proof); err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "failed to unmarshal Groth16 proof: %v", err)
+	}
+
+	var (
+		a, c bn254.G1Affine
+		b    bn254.G2Affine
+	)
+	for _, point := range []struct {
+		name string
+		bz   []byte
+		p    interface{ SetBytes([]byte) (int, error) }
+	}{
+		{"a", groth16Proof.A, &a},
+		{"b", groth16Proof.B, &b},
+		{"c", groth16Proof.C, &c},
+	} {
+		if err := setPoint(ErrInvalidProof, point.name, point.bz, point.p); err != nil {
+			return err
+		}
+	}
+
+	// vk_x = IC[0] + sum(input_i * IC[i+1])
+	var vkX bn254.G1Affine
+	if _, err := vkX.MultiExp(vk.ic[1:], inputs, ecc.MultiExpConfig{}); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPublicInputs, "failed to compute public input commitment: %v", err)
+	}
+	vkX.Add(&vkX, &vk.ic[0])
+
+	var negA bn254.G1Affine
+	negA.Neg(&a)
+
+	valid, err := bn254.PairingCheck(
+		[]bn254.G1Affine{negA, vk.alpha, vkX, c},
+		[]bn254.G2Affine{b, vk.beta, vk.gamma, vk.delta},
+	)
+	if err != nil {
+		return errorsmod.Wrapf(ErrProofVerificationFailed, "failed to check pairing: %v", err)
+	}
+
+	if !valid {
+		return errorsmod.Wrap(ErrProofVerificationFailed, "Groth16 pairing check failed")
+	}
+
+	return nil
+}
+
+// decodeGroth16VerifyingKey unmarshals the verifying key and decodes its points.
+func decodeGroth16VerifyingKey(bz []byte) (*groth16VerifyingKey, error) {
+	var verifyingKey Groth16VerifyingKey
+	if err := verifyingKey.Unmarshal(bz); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidVerifyingKey, "failed to unmarshal Groth16 verifying key: %v", err)
+	}
+
+	if len(verifyingKey.IC) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidVerifyingKey, "IC points cannot be empty")
+	}
+
+	vk := &groth16VerifyingKey{
+		ic: make([]bn254.G1Affine, len(verifyingKey.IC)),
+	}
+	for _, point := range []struct {
+		name string
+		bz   []byte
+		p    interface{ SetBytes([]byte) (int, error) }
+	}{
+		{"alpha", verifyingKey.Alpha, &vk.alpha},
+		{"beta", verifyingKey.Beta, &vk.beta},
+		{"gamma", verifyingKey.Gamma, &vk.gamma},
+		{"delta", verifyingKey.Delta, &vk.delta},
+	} {
+		if err := setPoint(ErrInvalidVerifyingKey, point.name, point.bz, point.p); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, bz := range verifyingKey.IC {
+		if err := setPoint(ErrInvalidVerifyingKey, "IC", bz, &vk.ic[i]); err != nil {
+			return nil, errorsmod.Wrapf(err, "IC point %d", i)
+		}
+	}
+
+	return vk, nil
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/zk"
+)
+
+func TestGroth16Verify(t *testing.T) {
+	prover := newGroth16Prover(zk.NbPublicInputs)
+	verifyingKey := prover.verifyingKey()
+	publicInputs := [][]byte{[]byte("public input one"), []byte("public input two")}
+	proof := prover.prove(publicInputs)
+
+	var (
+		vkBz    []byte
+		proofBz []byte
+		inputs  [][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: public inputs do not match the proof",
+			func() {
+				inputs = [][]byte{[]byte("public input one"), []byte("public input 2")}
+			},
+			zk.ErrProofVerificationFailed,
+		},
+		{
+			"failure: proof is for another verifying key",
+			func() {
+				vkBz = newGroth16Prover(zk.NbPublicInputs + 1).verifyingKey()
+				inputs = append(inputs, []byte{1})
+			},
+			zk.ErrProofVerificationFailed,
+		},
+		{
+			"failure: invalid point in proof",
+			func() {
+				var groth16Proof zk.Groth16Proof
+				require.NoError(t, groth16Proof.Unmarshal(proofBz))
+				groth16Proof.B = groth16Proof.A
+				var err error
+				proofBz, err = groth16Proof.Marshal()
+				require.NoError(t, err)
+			},
+			zk.ErrInvalidProof,
+		},
+		{
+			"failure: proof cannot be unmarshaled",
+			func() {
+				proofBz = []byte("invalid")
+			},
+			zk.ErrInvalidProof,
+		},
+		{
+			"failure: wrong number of public inputs",
+			func() {
+				inputs = inputs[:1]
+			},
+			zk.ErrInvalidPublicInputs,
+		},
+		{
+			"failure: public input is not a scalar field element",
+			func() {
+				inputs = [][]byte{bytes32(0xff), []byte("public input two")}
+			},
+			zk.ErrInvalidPublicInputs,
+		},
+		{
+			"failure: public input exceeds 32 bytes",
+			func() {
+				inputs = [][]byte{make([]byte, 33), []byte("public input two")}
+			},
+			zk.ErrInvalidPublicInputs,
+		},
+		{
+			"failure: trailing bytes after verifying key point",
+			func() {
+				var vk zk.Groth16VerifyingKey
+				require.NoError(t, vk.Unmarshal(vkBz))
+				vk.Alpha = append(vk.Alpha, 0x00)
+				var err error
+				vkBz, err = vk.Marshal()
+				require.NoError(t, err)
+			},
+			zk.ErrInvalidVerifyingKey,
+		},
+		{
+			"failure: verifying key has no IC points",
+			func() {
+				var vk zk.Groth16VerifyingKey
+				require.NoError(t, vk.Unmarshal(vkBz))
+				vk.IC = nil
+				var err error
+				vkBz, err = vk.Marshal()
+				require.NoError(t, err)
+			},
+			zk.ErrInvalidVerifyingKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vkBz = verifyingKey
+			proofBz = proof
+			inputs = publicInputs
+
+			tc.malleate()
+
+			err := zk.Groth16Verifier{}.Verify(vkBz, proofBz, inputs)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestGroth16ValidateVerifyingKey(t *testing.T) {
+	verifyingKey := newGroth16Prover(zk.NbPublicInputs).verifyingKey()
+
+	require.NoError(t, zk.Groth16Verifier{}.ValidateVerifyingKey(verifyingKey, zk.NbPublicInputs))
+	require.ErrorIs(t, zk.Groth16Verifier{}.ValidateVerifyingKey(verifyingKey, zk.NbPublicInputs+1), zk.ErrInvalidVerifyingKey)
+	require.ErrorIs(t, zk.Groth16Verifier{}.ValidateVerifyingKey([]byte("invalid"), zk.NbPublicInputs), zk.ErrInvalidVerifyingKey)
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*Header)(nil)
+
+// ClientType defines that the Header is a ZK light client message.
+func (Header) ClientType() string {
+	return exported.ZK
+}
+
+// ValidateBasic performs basic validation of the header fields.
+func (h Header) ValidateBasic() error {
+	if h.TrustedHeight.IsZero() {
+		return errorsmod.Wrap(ErrInvalidHeader, "trusted height cannot be zero")
+	}
+
+	if !h.Height.GT(h.TrustedHeight) {
+		return errorsmod.Wrapf(ErrInvalidHeader, "height %s must be greater than trusted height %s", h.Height, h.TrustedHeight)
+	}
+
+	if len(h.Root) != RootLength {
+		return errorsmod.Wrapf(ErrInvalidHeader, "root must be %d bytes, got %d", RootLength, len(h.Root))
+	}
+
+	if h.Timestamp == 0 {
+		return errorsmod.Wrap(ErrInvalidHeader, "timestamp cannot be 0")
+	}
+
+	if len(h.Proof) == 0 {
+		return errorsmod.Wrap(ErrInvalidHeader, "proof cannot be empty")
+	}
+
+	return nil
+}
+
+// consensusState returns the consensus state proven by the header.
+func (h Header) consensusState() *ConsensusState {
+	return &ConsensusState{
+		Timestamp: h.Timestamp,
+		Root:      h.Root,
+	}
+}
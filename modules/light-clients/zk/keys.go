@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+const (
+	ModuleName = "zk"
+
+	// RootLength is the length of the state roots proven by the client.
+	RootLength = 32
+)
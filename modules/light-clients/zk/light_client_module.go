@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ exported.LightClientModule = (*LightClientModule)(nil)
+
+// LightClientModule implements the core IBC api.LightClientModule interface.
+type LightClientModule struct {
+	cdc           codec.BinaryCodec
+	storeProvider clienttypes.StoreProvider
+	authority     string
+	verifiers     map[string]ProofVerifier
+}
+
+// NewLightClientModule creates and returns a new ZK LightClientModule. The authority is allowed to update the
+// verifier of a client and the verifiers are the proof systems supported by the module, keyed by proof system name.
+func NewLightClientModule(cdc codec.BinaryCodec, storeProvider clienttypes.StoreProvider, authority string, verifiers map[string]ProofVerifier) LightClientModule {
+	if len(verifiers) == 0 {
+		panic("ZK light client module requires at least one proof verifier")
+	}
+
+	return LightClientModule{
+		cdc:           cdc,
+		storeProvider: storeProvider,
+		authority:     authority,
+		verifiers:     verifiers,
+	}
+}
+
+// GetAuthority returns the authority allowed to update the verifier of a client.
+func (l LightClientModule) GetAuthority() string {
+	return l.authority
+}
+
+// Initialize unmarshals the provided client and consensus states and performs basic validation.
+// The verifying key of the client must be valid for a registered proof system.
+func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientStateBz, consensusStateBz []byte) error {
+	var clientState ClientState
+	if err := l.cdc.Unmarshal(clientStateBz, &clientState); err != nil {
+		return errorsmod.Wrapf(err, "failed to unmarshal client state bytes into client state")
+	}
+
+	if err := clientState.Validate(); err != nil {
+		return err
+	}
+
+	if err := l.validateVerifier(clientState.Verifier); err != nil {
+		return err
+	}
+
+	var consensusState ConsensusState
+	if err := l.cdc.Unmarshal(consensusStateBz, &consensusState); err != nil {
+		return errorsmod.Wrapf(err, "failed to unmarshal consensus state bytes into consensus state")
+	}
+
+	if err := consensusState.ValidateBasic(); err != nil {
+		return err
+	}
+
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+
+	setConsensusState(clientStore, l.cdc, &consensusState, clientState.LatestHeight)
+	setClientState(clientStore, l.cdc, &clientState)
+
+	return nil
+}
+
+// VerifyClientMessage obtains the client state associated with the client identifier and calls into the clientState.VerifyClientMessage
+// method with the verifier registered for the proof system of the client.
+func (l LightClientModule) VerifyClientMessage(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	verifier, err := l.proofVerifier(clientState.Verifier.ProofSystem)
+	if err != nil {
+		return err
+	}
+
+	return clientState.VerifyClientMessage(ctx, l.cdc, clientStore, verifier, clientMsg)
+}
+
+// CheckForMisbehaviour obtains the client state associated with the client identifier and calls into the clientState.CheckForMisbehaviour method.
+func (l LightClientModule) CheckForMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) bool {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.CheckForMisbehaviour(l.cdc, clientStore, clientMsg)
+}
+
+// UpdateStateOnMisbehaviour freezes the client
+func (l LightClientModule) UpdateStateOnMisbehaviour(ctx sdk.Context, clientID string, _ exported.ClientMessage) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	clientState.IsFrozen = true
+	setClientState(clientStore, l.cdc, clientState)
+}
+
+// UpdateState obtains the client state associated with the client identifier and calls into the clientState.UpdateState method.
+func (l LightClientModule) UpdateState(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) []exported.Height {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.UpdateState(l.cdc, clientStore, clientMsg)
+}
+
+// VerifyMembership obtains the client state associated with the client identifier and calls into the clientState.verifyMembership method.
+// Delay periods are not supported and are ignored.
+func (l LightClientModule) VerifyMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.verifyMembership(clientStore, l.cdc, height, proof, path, value)
+}
+
+// VerifyNonMembership obtains the client state associated with the client identifier and calls into the clientState.verifyNonMembership method.
+// Delay periods are not supported and are ignored.
+func (l LightClientModule) VerifyNonMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.verifyNonMembership(clientStore, l.cdc, height, proof, path)
+}
+
+// Status returns the status of the ZK client.
+// The client may be:
+// - Active: if `IsFrozen` is false.
+// - Frozen: if `IsFrozen` is true.
+// - Unknown: if the client state associated with the provided client identifier is not found.
+func (l LightClientModule) Status(ctx sdk.Context, clientID string) exported.Status {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return exported.Unknown
+	}
+
+	if clientState.IsFrozen {
+		return exported.Frozen
+	}
+
+	return exported.Active
+}
+
+// LatestHeight returns the latest height for the client state for the given client identifier.
+// If no client is present for the provided client identifier a zero value height is returned.
+func (l LightClientModule) LatestHeight(ctx sdk.Context, clientID string) exported.Height {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return clienttypes.ZeroHeight()
+	}
+
+	return clientState.LatestHeight
+}
+
+// TimestampAtHeight obtains the client state associated with the client identifier and returns the timestamp in nanoseconds of the consensus state at the given height.
+func (l LightClientModule) TimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	consensusState, found := getConsensusState(clientStore, l.cdc, height)
+	if !found {
+		return 0, errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "consensus state not found for height %s", height)
+	}
+
+	return consensusState.Timestamp, nil
+}
+
+// RecoverClient returns an error. The verifier of a ZK client can be replaced through governance with MsgUpdateVerifier instead.
+func (LightClientModule) RecoverClient(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "cannot recover zk client, update its verifier instead")
+}
+
+// VerifyUpgradeAndUpdateState returns an error since the ZK client does not support upgrades.
+func (LightClientModule) VerifyUpgradeAndUpdateState(_ sdk.Context, _ string, _, _, _, _ []byte) error {
+	return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade zk client")
+}
+
+// proofVerifier returns the verifier registered for the proof system.
+func (l LightClientModule) proofVerifier(proofSystem string) (ProofVerifier, error) {
+	verifier, found := l.verifiers[proofSystem]
+	if !found {
+		return nil, errorsmod.Wrapf(ErrUnknownProofSystem, "no verifier registered for proof system %s", proofSystem)
+	}
+
+	return verifier, nil
+}
+
+// validateVerifier returns an error if the proof system of the verifier is not registered or the verifying key
+// is not valid for it.
+func (l LightClientModule) validateVerifier(verifier Verifier) error {
+	proofVerifier, err := l.proofVerifier(verifier.ProofSystem)
+	if err != nil {
+		return err
+	}
+
+	return proofVerifier.ValidateVerifyingKey(verifier.VerifyingKey, NbPublicInputs)
+}
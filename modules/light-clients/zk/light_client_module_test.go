@@ -0,0 +1,648 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/trie/trienode"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/zk"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+const testChainID = "counterparty-1"
+
+var (
+	testClientID  = clienttypes.FormatClientIdentifier(exported.ZK, 0)
+	trustedHeight = clienttypes.NewHeight(1, 10)
+)
+
+type ZKTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+
+	lightClientModule zk.LightClientModule
+	groth16Prover     groth16Prover
+	plonkProver       *plonkProver
+}
+
+func TestZKTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(ZKTestSuite))
+}
+
+func (s *ZKTestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	cdc := s.chainA.App.AppCodec()
+	storeKey := s.chainA.GetSimApp().GetKey(exported.StoreKey)
+	storeProvider := clienttypes.NewStoreProvider(runtime.NewKVStoreService(storeKey))
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.lightClientModule = zk.NewLightClientModule(cdc, storeProvider, authority, zk.DefaultVerifiers())
+
+	s.groth16Prover = newGroth16Prover(zk.NbPublicInputs)
+	if s.plonkProver == nil {
+		s.plonkProver = newPlonkProver()
+	}
+}
+
+// clientState returns a client state at the trusted height with a Groth16 verifier.
+func (s *ZKTestSuite) clientState() *zk.ClientState {
+	verifier := zk.Verifier{
+		ProofSystem:  zk.ProofSystemGroth16,
+		VerifyingKey: s.groth16Prover.verifyingKey(),
+	}
+
+	return zk.NewClientState(testChainID, trustedHeight, 10*time.Minute, commitmenttypes.ICS23, commitmenttypes.GetSDKSpecs(), verifier)
+}
+
+// consensusState returns the trusted consensus state, one hour before the block time of chainA.
+func (s *ZKTestSuite) consensusState() *zk.ConsensusState {
+	return &zk.ConsensusState{
+		Timestamp: uint64(s.chainA.GetContext().BlockTime().Add(-time.Hour).UnixNano()),
+		Root:      root("trusted"),
+	}
+}
+
+// header returns a header proving a new state at the height, thirty minutes before the block time of chainA,
+// with a Groth16 proof from the trusted consensus state.
+func (s *ZKTestSuite) header(height clienttypes.Height) *zk.Header {
+	header := &zk.Header{
+		TrustedHeight: trustedHeight,
+		Height:        height,
+		Root:          root(height.String()),
+		Timestamp:     uint64(s.chainA.GetContext().BlockTime().Add(-30 * time.Minute).UnixNano()),
+	}
+	s.prove(header, s.consensusState())
+
+	return header
+}
+
+// prove sets the Groth16 proof of the header from the trusted consensus state.
+func (s *ZKTestSuite) prove(header *zk.Header, trustedConsensusState *zk.ConsensusState) {
+	header.Proof = s.groth16Prover.prove(zk.PublicInputs(testChainID, header.TrustedHeight, trustedConsensusState, header))
+}
+
+// initializeClient initializes the client with the provided client and consensus states.
+func (s *ZKTestSuite) initializeClient(clientState *zk.ClientState, consensusState *zk.ConsensusState) error {
+	clientStateBz, err := s.chainA.App.AppCodec().Marshal(clientState)
+	s.Require().NoError(err)
+
+	consensusStateBz, err := s.chainA.App.AppCodec().Marshal(consensusState)
+	s.Require().NoError(err)
+
+	return s.lightClientModule.Initialize(s.chainA.GetContext(), testClientID, clientStateBz, consensusStateBz)
+}
+
+// storedClientState returns the stored client state.
+func (s *ZKTestSuite) storedClientState() *zk.ClientState {
+	clientStore := s.chainA.GetSimApp().GetIBCKeeper().ClientKeeper.ClientStore(s.chainA.GetContext(), testClientID)
+	bz := clientStore.Get(host.ClientStateKey())
+	s.Require().NotEmpty(bz)
+
+	clientState, ok := clienttypes.MustUnmarshalClientState(s.chainA.App.AppCodec(), bz).(*zk.ClientState)
+	s.Require().True(ok)
+	return clientState
+}
+
+func (s *ZKTestSuite) TestInitialize() {
+	var (
+		clientState    *zk.ClientState
+		consensusState *zk.ConsensusState
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: PLONK verifier",
+			func() {
+				clientState.Verifier = zk.Verifier{ProofSystem: zk.ProofSystemPlonk, VerifyingKey: s.plonkProver.verifyingKey()}
+			},
+			nil,
+		},
+		{
+			"success: MPT proof format without proof specs",
+			func() {
+				clientState.ProofFormat = commitmenttypes.MPT
+				clientState.ProofSpecs = nil
+			},
+			nil,
+		},
+		{
+			"failure: invalid client state",
+			func() {
+				clientState.ChainId = ""
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: unspecified proof format",
+			func() {
+				clientState.ProofFormat = commitmenttypes.UNSPECIFIED
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: ICS-23 proof format without proof specs",
+			func() {
+				clientState.ProofSpecs = nil
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: unknown proof system",
+			func() {
+				clientState.Verifier.ProofSystem = "stark"
+			},
+			zk.ErrUnknownProofSystem,
+		},
+		{
+			"failure: verifying key does not accept the public inputs",
+			func() {
+				clientState.Verifier.VerifyingKey = newGroth16Prover(zk.NbPublicInputs + 1).verifyingKey()
+			},
+			zk.ErrInvalidVerifyingKey,
+		},
+		{
+			"failure: invalid consensus state",
+			func() {
+				consensusState.Root = nil
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			clientState = s.clientState()
+			consensusState = s.consensusState()
+
+			tc.malleate()
+
+			err := s.initializeClient(clientState, consensusState)
+
+			ctx := s.chainA.GetContext()
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(exported.Active, s.lightClientModule.Status(ctx, testClientID))
+				s.Require().Equal(trustedHeight, s.lightClientModule.LatestHeight(ctx, testClientID))
+
+				timestamp, err := s.lightClientModule.TimestampAtHeight(ctx, testClientID, trustedHeight)
+				s.Require().NoError(err)
+				s.Require().Equal(consensusState.Timestamp, timestamp)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Equal(exported.Unknown, s.lightClientModule.Status(ctx, testClientID))
+			}
+		})
+	}
+}
+
+func (s *ZKTestSuite) TestVerifyClientMessage() {
+	var (
+		clientMsg         exported.ClientMessage
+		lightClientModule zk.LightClientModule
+		ctx               sdk.Context
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: PLONK verifier",
+			func() {
+				verifier := zk.Verifier{ProofSystem: zk.ProofSystemPlonk, VerifyingKey: s.plonkProver.verifyingKey()}
+				_, err := zk.NewMsgServerImpl(s.lightClientModule).UpdateVerifier(ctx, zk.NewMsgUpdateVerifier(s.lightClientModule.GetAuthority(), testClientID, verifier))
+				s.Require().NoError(err)
+
+				header, ok := clientMsg.(*zk.Header)
+				s.Require().True(ok)
+				header.Proof = s.plonkProver.prove(zk.PublicInputs(testChainID, trustedHeight, s.consensusState(), header))
+			},
+			nil,
+		},
+		{
+			"failure: client is frozen",
+			func() {
+				lightClientModule.UpdateStateOnMisbehaviour(ctx, testClientID, nil)
+			},
+			zk.ErrClientFrozen,
+		},
+		{
+			"failure: invalid client message type",
+			func() {
+				clientMsg = &ibctm.Header{}
+			},
+			clienttypes.ErrInvalidClientType,
+		},
+		{
+			"failure: height is not after the trusted height",
+			func() {
+				header, ok := clientMsg.(*zk.Header)
+				s.Require().True(ok)
+				header.Height = trustedHeight
+			},
+			zk.ErrInvalidHeader,
+		},
+		{
+			"failure: trusted consensus state not found",
+			func() {
+				header, ok := clientMsg.(*zk.Header)
+				s.Require().True(ok)
+				header.TrustedHeight = clienttypes.NewHeight(1, 5)
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"failure: timestamp is not after the trusted timestamp",
+			func() {
+				header, ok := clientMsg.(*zk.Header)
+				s.Require().True(ok)
+				header.Timestamp = s.consensusState().Timestamp
+				s.prove(header, s.consensusState())
+			},
+			zk.ErrInvalidHeader,
+		},
+		{
+			"failure: timestamp exceeds the max clock drift",
+			func() {
+				header, ok := clientMsg.(*zk.Header)
+				s.Require().True(ok)
+				header.Timestamp = uint64(ctx.BlockTime().Add(time.Hour).UnixNano())
+				s.prove(header, s.consensusState())
+			},
+			zk.ErrInvalidHeader,
+		},
+		{
+			"failure: proof does not commit to the header",
+			func() {
+				header, ok := clientMsg.(*zk.Header)
+				s.Require().True(ok)
+				header.Root = root("forged")
+			},
+			zk.ErrProofVerificationFailed,
+		},
+		{
+			"failure: proof system of the client is not registered",
+			func() {
+				lightClientModule = zk.NewLightClientModule(
+					s.chainA.App.AppCodec(),
+					clienttypes.NewStoreProvider(runtime.NewKVStoreService(s.chainA.GetSimApp().GetKey(exported.StoreKey))),
+					s.lightClientModule.GetAuthority(),
+					map[string]zk.ProofVerifier{zk.ProofSystemPlonk: zk.PlonkVerifier{}},
+				)
+			},
+			zk.ErrUnknownProofSystem,
+		},
+		{
+			"failure: client not found",
+			func() {
+				s.chainA.GetSimApp().GetIBCKeeper().ClientKeeper.ClientStore(ctx, testClientID).Delete(host.ClientStateKey())
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Require().NoError(s.initializeClient(s.clientState(), s.consensusState()))
+
+			ctx = s.chainA.GetContext()
+			lightClientModule = s.lightClientModule
+			clientMsg = s.header(clienttypes.NewHeight(1, 20))
+
+			tc.malleate()
+
+			err := lightClientModule.VerifyClientMessage(ctx, testClientID, clientMsg)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *ZKTestSuite) TestUpdateState() {
+	s.Require().NoError(s.initializeClient(s.clientState(), s.consensusState()))
+	ctx := s.chainA.GetContext()
+
+	height := clienttypes.NewHeight(1, 20)
+	header := s.header(height)
+	s.Require().NoError(s.lightClientModule.VerifyClientMessage(ctx, testClientID, header))
+	s.Require().False(s.lightClientModule.CheckForMisbehaviour(ctx, testClientID, header))
+
+	heights := s.lightClientModule.UpdateState(ctx, testClientID, header)
+	s.Require().Equal([]exported.Height{height}, heights)
+	s.Require().Equal(height, s.lightClientModule.LatestHeight(ctx, testClientID))
+
+	timestamp, err := s.lightClientModule.TimestampAtHeight(ctx, testClientID, height)
+	s.Require().NoError(err)
+	s.Require().Equal(header.Timestamp, timestamp)
+
+	// an update to a height below the latest height does not lower the latest height
+	lowerHeight := clienttypes.NewHeight(1, 15)
+	header = s.header(lowerHeight)
+	s.Require().NoError(s.lightClientModule.VerifyClientMessage(ctx, testClientID, header))
+
+	heights = s.lightClientModule.UpdateState(ctx, testClientID, header)
+	s.Require().Equal([]exported.Height{lowerHeight}, heights)
+	s.Require().Equal(height, s.lightClientModule.LatestHeight(ctx, testClientID))
+}
+
+func (s *ZKTestSuite) TestCheckForMisbehaviour() {
+	s.Require().NoError(s.initializeClient(s.clientState(), s.consensusState()))
+	ctx := s.chainA.GetContext()
+
+	height := clienttypes.NewHeight(1, 20)
+	header := s.header(height)
+	s.lightClientModule.UpdateState(ctx, testClientID, header)
+
+	// resubmitting the same header is not misbehaviour
+	s.Require().False(s.lightClientModule.CheckForMisbehaviour(ctx, testClientID, header))
+
+	// a valid proof of a different root at the same height is misbehaviour
+	conflictingHeader := s.header(height)
+	conflictingHeader.Root = root("conflicting")
+	s.prove(conflictingHeader, s.consensusState())
+	s.Require().NoError(s.lightClientModule.VerifyClientMessage(ctx, testClientID, conflictingHeader))
+	s.Require().True(s.lightClientModule.CheckForMisbehaviour(ctx, testClientID, conflictingHeader))
+
+	s.lightClientModule.UpdateStateOnMisbehaviour(ctx, testClientID, conflictingHeader)
+	s.Require().Equal(exported.Frozen, s.lightClientModule.Status(ctx, testClientID))
+}
+
+func (s *ZKTestSuite) TestVerifyMembership() {
+	var (
+		path        exported.Path
+		proof       []byte
+		proofHeight exported.Height
+		value       []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: value does not match",
+			func() {
+				value = []byte("invalid value")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: proof height is after the latest height",
+			func() {
+				proofHeight = proofHeight.Increment()
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: consensus state not found at the proof height",
+			func() {
+				proofHeight = clienttypes.NewHeight(proofHeight.GetRevisionNumber(), proofHeight.GetRevisionHeight()-1)
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"failure: proof cannot be unmarshaled",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: client is frozen",
+			func() {
+				s.lightClientModule.UpdateStateOnMisbehaviour(s.chainA.GetContext(), testClientID, nil)
+			},
+			zk.ErrClientFrozen,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			testingpath := ibctesting.NewPath(s.chainA, s.chainB)
+			testingpath.SetupClients()
+			s.Require().NoError(testingpath.EndpointA.UpdateClient())
+
+			key := host.FullClientStateKey(testingpath.EndpointB.ClientID)
+			merklePath := commitmenttypes.NewMerklePath(key)
+			var err error
+			path, err = commitmenttypes.ApplyPrefix(s.chainB.GetPrefix(), merklePath)
+			s.Require().NoError(err)
+
+			var height clienttypes.Height
+			proof, height = s.chainB.QueryProof(key)
+			proofHeight = height
+			s.initializeCounterpartyClient(testingpath, height)
+
+			value, err = s.chainB.Codec.MarshalInterface(testingpath.EndpointB.GetClientState())
+			s.Require().NoError(err)
+
+			tc.malleate()
+
+			err = s.lightClientModule.VerifyMembership(s.chainA.GetContext(), testClientID, proofHeight, 0, 0, proof, path, value)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *ZKTestSuite) TestVerifyNonMembership() {
+	var (
+		path        exported.Path
+		proof       []byte
+		proofHeight exported.Height
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: path is a member",
+			func() {
+				key := host.FullClientStateKey(ibctesting.FirstClientID)
+				var err error
+				path, err = commitmenttypes.ApplyPrefix(s.chainB.GetPrefix(), commitmenttypes.NewMerklePath(key))
+				s.Require().NoError(err)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: proof height is after the latest height",
+			func() {
+				proofHeight = proofHeight.Increment()
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: client is frozen",
+			func() {
+				s.lightClientModule.UpdateStateOnMisbehaviour(s.chainA.GetContext(), testClientID, nil)
+			},
+			zk.ErrClientFrozen,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			testingpath := ibctesting.NewPath(s.chainA, s.chainB)
+			testingpath.SetupClients()
+			s.Require().NoError(testingpath.EndpointA.UpdateClient())
+
+			key := host.FullClientStateKey(ibctesting.InvalidID)
+			var err error
+			path, err = commitmenttypes.ApplyPrefix(s.chainB.GetPrefix(), commitmenttypes.NewMerklePath(key))
+			s.Require().NoError(err)
+
+			var height clienttypes.Height
+			proof, height = s.chainB.QueryProof(key)
+			proofHeight = height
+			s.initializeCounterpartyClient(testingpath, height)
+
+			tc.malleate()
+
+			err = s.lightClientModule.VerifyNonMembership(s.chainA.GetContext(), testClientID, proofHeight, 0, 0, proof, path)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *ZKTestSuite) TestVerifyMembershipMPTProofFormat() {
+	path := commitmenttypes.NewMerklePath([]byte("commitments/ports/transfer/channels/channel-0/sequences/1"))
+	value := root("commitment")
+
+	stateTrie := trie.NewEmpty(nil)
+	s.Require().NoError(stateTrie.Update(crypto.Keccak256(path.KeyPath[0]), value))
+
+	clientState := s.clientState()
+	clientState.ProofFormat = commitmenttypes.MPT
+	clientState.ProofSpecs = nil
+
+	consensusState := s.consensusState()
+	consensusState.Root = stateTrie.Hash().Bytes()
+	s.Require().NoError(s.initializeClient(clientState, consensusState))
+
+	prove := func(key []byte) []byte {
+		proofList := trienode.ProofList{}
+		s.Require().NoError(stateTrie.Prove(crypto.Keccak256(key), &proofList))
+
+		proof := commitmenttypes.MPTProof{}
+		for _, node := range proofList {
+			proof.Nodes = append(proof.Nodes, node)
+		}
+
+		bz, err := proof.Marshal()
+		s.Require().NoError(err)
+		return bz
+	}
+
+	ctx := s.chainA.GetContext()
+	proof := prove(path.KeyPath[0])
+	s.Require().NoError(s.lightClientModule.VerifyMembership(ctx, testClientID, trustedHeight, 0, 0, proof, path, value))
+	s.Require().ErrorIs(s.lightClientModule.VerifyMembership(ctx, testClientID, trustedHeight, 0, 0, proof, path, root("other")), commitmenttypes.ErrInvalidProof)
+	s.Require().ErrorIs(s.lightClientModule.VerifyNonMembership(ctx, testClientID, trustedHeight, 0, 0, proof, path), commitmenttypes.ErrInvalidProof)
+
+	absentPath := commitmenttypes.NewMerklePath([]byte("commitments/ports/transfer/channels/channel-0/sequences/2"))
+	s.Require().NoError(s.lightClientModule.VerifyNonMembership(ctx, testClientID, trustedHeight, 0, 0, prove(absentPath.KeyPath[0]), absentPath))
+}
+
+func (s *ZKTestSuite) TestRecoverClientNotSupported() {
+	err := s.lightClientModule.RecoverClient(s.chainA.GetContext(), testClientID, testClientID)
+	s.Require().ErrorIs(err, clienttypes.ErrUpdateClientFailed)
+}
+
+func (s *ZKTestSuite) TestVerifyUpgradeAndUpdateStateNotSupported() {
+	err := s.lightClientModule.VerifyUpgradeAndUpdateState(s.chainA.GetContext(), testClientID, nil, nil, nil, nil)
+	s.Require().ErrorIs(err, clienttypes.ErrInvalidUpgradeClient)
+}
+
+// initializeCounterpartyClient initializes the client with the root of chainB at the height, as tracked by the
+// tendermint client of the path on chainA.
+func (s *ZKTestSuite) initializeCounterpartyClient(testingpath *ibctesting.Path, height clienttypes.Height) {
+	tmConsensusState, ok := testingpath.EndpointA.GetConsensusState(height).(*ibctm.ConsensusState)
+	s.Require().True(ok)
+
+	clientState := s.clientState()
+	clientState.ChainId = s.chainB.ChainID
+	clientState.LatestHeight = height
+
+	consensusState := &zk.ConsensusState{
+		Timestamp: tmConsensusState.GetTimestamp(),
+		Root:      tmConsensusState.Root.GetHash(),
+	}
+
+	s.Require().NoError(s.initializeClient(clientState, consensusState))
+}
+
+// root returns a 32-byte root derived from the seed.
+func root(seed string) []byte {
+	hash := sha256.Sum256([]byte(seed))
+	return hash[:]
+}
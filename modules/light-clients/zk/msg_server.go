@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+var _ MsgServer = (*msgServer)(nil)
+
+// msgServer implements the ZK client MsgServer interface.
+type msgServer struct {
+	lightClientModule LightClientModule
+}
+
+// NewMsgServerImpl returns an implementation of the ZK client MsgServer interface for the provided light client module.
+func NewMsgServerImpl(lightClientModule LightClientModule) MsgServer {
+	return &msgServer{lightClientModule: lightClientModule}
+}
+
+// UpdateVerifier defines a rpc handler method for MsgUpdateVerifier. It replaces the verifier of a ZK client,
+// for example to upgrade the verifying key of the proven program.
+func (m msgServer) UpdateVerifier(goCtx context.Context, msg *MsgUpdateVerifier) (*MsgUpdateVerifierResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	l := m.lightClientModule
+
+	if err := sdk.ValidateAuthority(ctx, l.GetAuthority(), msg.Signer); err != nil {
+		return nil, err
+	}
+
+	clientType, _, err := clienttypes.ParseClientIdentifier(msg.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	if clientType != exported.ZK {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected client type %s, got %s", exported.ZK, clientType)
+	}
+
+	clientStore := l.storeProvider.ClientStore(ctx, msg.ClientId)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return nil, errorsmod.Wrap(clienttypes.ErrClientNotFound, msg.ClientId)
+	}
+
+	if err := l.validateVerifier(msg.Verifier); err != nil {
+		return nil, err
+	}
+
+	clientState.Verifier = msg.Verifier
+	setClientState(clientStore, l.cdc, clientState)
+
+	return &MsgUpdateVerifierResponse{}, nil
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk_test
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/zk"
+	ibctesting "github.com/cosmos/ibc-go/v11/testing"
+)
+
+func (s *ZKTestSuite) TestUpdateVerifier() {
+	var msg *zk.MsgUpdateVerifier
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: switch to a PLONK verifier",
+			func() {},
+			nil,
+		},
+		{
+			"failure: signer is not the authority",
+			func() {
+				msg.Signer = s.chainA.SenderAccount.GetAddress().String()
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"failure: client is not a zk client",
+			func() {
+				msg.ClientId = ibctesting.FirstClientID
+			},
+			clienttypes.ErrInvalidClientType,
+		},
+		{
+			"failure: invalid client identifier",
+			func() {
+				msg.ClientId = ibctesting.InvalidID
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: client not found",
+			func() {
+				msg.ClientId = clienttypes.FormatClientIdentifier(zk.ModuleName, 1)
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: unknown proof system",
+			func() {
+				msg.Verifier.ProofSystem = "stark"
+			},
+			zk.ErrUnknownProofSystem,
+		},
+		{
+			"failure: verifying key does not match the proof system",
+			func() {
+				msg.Verifier.VerifyingKey = s.groth16Prover.verifyingKey()
+			},
+			zk.ErrInvalidVerifyingKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Require().NoError(s.initializeClient(s.clientState(), s.consensusState()))
+
+			verifier := zk.Verifier{ProofSystem: zk.ProofSystemPlonk, VerifyingKey: s.plonkProver.verifyingKey()}
+			msg = zk.NewMsgUpdateVerifier(s.lightClientModule.GetAuthority(), testClientID, verifier)
+
+			tc.malleate()
+
+			_, err := zk.NewMsgServerImpl(s.lightClientModule).UpdateVerifier(s.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(verifier, s.storedClientState().Verifier)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Equal(zk.ProofSystemGroth16, s.storedClientState().Verifier.ProofSystem)
+			}
+		})
+	}
+}
+
+func (s *ZKTestSuite) TestMsgUpdateVerifierValidateBasic() {
+	verifier := zk.Verifier{ProofSystem: zk.ProofSystemGroth16, VerifyingKey: s.groth16Prover.verifyingKey()}
+
+	testCases := []struct {
+		name   string
+		msg    *zk.MsgUpdateVerifier
+		expErr error
+	}{
+		{
+			"success",
+			zk.NewMsgUpdateVerifier(s.lightClientModule.GetAuthority(), testClientID, verifier),
+			nil,
+		},
+		{
+			"failure: invalid signer",
+			zk.NewMsgUpdateVerifier(ibctesting.InvalidID, testClientID, verifier),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid client identifier",
+			zk.NewMsgUpdateVerifier(s.lightClientModule.GetAuthority(), "", verifier),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: empty proof system",
+			zk.NewMsgUpdateVerifier(s.lightClientModule.GetAuthority(), testClientID, zk.Verifier{VerifyingKey: verifier.VerifyingKey}),
+			zk.ErrInvalidVerifier,
+		},
+		{
+			"failure: empty verifying key",
+			zk.NewMsgUpdateVerifier(s.lightClientModule.GetAuthority(), testClientID, zk.Verifier{ProofSystem: zk.ProofSystemGroth16}),
+			zk.ErrInvalidVerifier,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v11/modules/core/errors"
+)
+
+var _ sdk.HasValidateBasic = (*MsgUpdateVerifier)(nil)
+
+// NewMsgUpdateVerifier creates a new MsgUpdateVerifier instance
+func NewMsgUpdateVerifier(signer, clientID string, verifier Verifier) *MsgUpdateVerifier {
+	return &MsgUpdateVerifier{
+		Signer:   signer,
+		ClientId: clientID,
+		Verifier: verifier,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgUpdateVerifier) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ClientIdentifierValidator(m.ClientId); err != nil {
+		return err
+	}
+
+	return m.Verifier.Validate()
+}
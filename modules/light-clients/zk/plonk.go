@@ -0,0 +1,490 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/hash_to_field"
+	"github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// plonkBsb22DomainSeparator is the domain separator with which gnark hashes the BSB22 commitments of a proof
+// into the public inputs of the circuit.
+const plonkBsb22DomainSeparator = "BSB22-Plonk"
+
+var _ ProofVerifier = (*PlonkVerifier)(nil)
+
+// PlonkVerifier verifies gnark PLONK proofs over BN254 with KZG polynomial commitments, such as the PLONK
+// proofs wrapping SP1 programs. Verification follows the gnark verifier, including its sha256 Fiat-Shamir
+// transcript, its KZG folding and the BSB22 commitments of custom gates, so that proofs produced by gnark
+// provers verify unchanged. Verifying keys and proofs are the binary encodings written by the WriteTo
+// (compressed points) or WriteRawTo (uncompressed points) methods of the gnark verifying key and proof, where the
+// verifying key includes the pairing lines precomputed from the G2 points of its KZG setup.
+type PlonkVerifier struct{}
+
+// plonkVerifyingKey is a decoded gnark PLONK verifying key.
+type plonkVerifyingKey struct {
+	size              uint64
+	sizeInv           fr.Element
+	generator         fr.Element
+	nbPublicVariables uint64
+	cosetShift        fr.Element
+
+	s                  [3]bn254.G1Affine
+	ql, qr, qm, qo, qk bn254.G1Affine
+	qcp                []bn254.G1Affine
+	kzg                kzg.VerifyingKey
+
+	commitmentConstraintIndexes []uint64
+}
+
+// plonkProof is a decoded gnark PLONK proof.
+type plonkProof struct {
+	lro              [3]bn254.G1Affine
+	z                bn254.G1Affine
+	h                [3]bn254.G1Affine
+	batchedProof     kzg.BatchOpeningProof
+	zShiftedOpening  kzg.OpeningProof
+	bsb22Commitments []bn254.G1Affine
+}
+
+// ValidateVerifyingKey decodes the verifying key and checks it accepts the provided number of public inputs.
+func (PlonkVerifier) ValidateVerifyingKey(verifyingKey []byte, nbPublicInputs int) error {
+	vk, err := decodePlonkVerifyingKey(verifyingKey)
+	if err != nil {
+		return err
+	}
+
+	if vk.nbPublicVariables != uint64(nbPublicInputs) {
+		return errorsmod.Wrapf(ErrInvalidVerifyingKey, "verifying key expects %d public inputs, got %d", vk.nbPublicVariables, nbPublicInputs)
+	}
+
+	return nil
+}
+
+// Verify checks the PLONK proof. The opening of the linearised polynomial is checked against the algebraic
+// relation of the circuit, and the batched KZG openings at zeta and at the shifted zeta are checked with the
+// verifying key of the KZG setup.
+func (PlonkVerifier) Verify(verifyingKey, proof []byte, publicInputs [][]byte) error {
+	vk, err := decodePlonkVerifyingKey(verifyingKey)
+	if err != nil {
+		return err
+	}
+
+	if uint64(len(publicInputs)) != vk.nbPublicVariables {
+		return errorsmod.Wrapf(ErrInvalidPublicInputs, "verifying key expects %d public inputs, got %d", vk.nbPublicVariables, len(publicInputs))
+	}
+
+	inputs, err := publicInputScalars(publicInputs)
+	if err != nil {
+		return err
+	}
+
+	p, err := decodePlonkProof(proof)
+	if err != nil {
+		return err
+	}
+
+	if len(p.bsb22Commitments) != len(vk.qcp) {
+		return errorsmod.Wrapf(ErrInvalidProof, "expected %d BSB22 commitments, got %d", len(vk.qcp), len(p.bsb22Commitments))
+	}
+
+	// the claimed values are the openings of the linearised polynomial, l, r, o, s1, s2 and of each qcp
+	if len(p.batchedProof.ClaimedValues) != len(vk.qcp)+6 {
+		return errorsmod.Wrapf(ErrInvalidProof, "expected %d claimed values, got %d", len(vk.qcp)+6, len(p.batchedProof.ClaimedValues))
+	}
+
+	if err := p.verify(vk, inputs); err != nil {
+		return errorsmod.Wrap(ErrProofVerificationFailed, err.Error())
+	}
+
+	return nil
+}
+
+// verify runs the gnark PLONK verifier on the decoded verifying key, proof and public inputs.
+func (p *plonkProof) verify(vk *plonkVerifyingKey, publicInputs []fr.Element) error {
+	fs := fiatshamir.NewTranscript(sha256.New(), "gamma", "beta", "alpha", "zeta")
+
+	// gamma is bound to the verifying key, the public inputs and the wire commitments
+	if err := bindPlonkPublicData(fs, "gamma", vk, publicInputs); err != nil {
+		return err
+	}
+
+	gamma, err := deriveRandomness(fs, "gamma", &p.lro[0], &p.lro[1], &p.lro[2])
+	if err != nil {
+		return err
+	}
+
+	beta, err := deriveRandomness(fs, "beta")
+	if err != nil {
+		return err
+	}
+
+	alphaDeps := make([]*bn254.G1Affine, 0, len(p.bsb22Commitments)+1)
+	for i := range p.bsb22Commitments {
+		alphaDeps = append(alphaDeps, &p.bsb22Commitments[i])
+	}
+	alphaDeps = append(alphaDeps, &p.z)
+	alpha, err := deriveRandomness(fs, "alpha", alphaDeps...)
+	if err != nil {
+		return err
+	}
+
+	zeta, err := deriveRandomness(fs, "zeta", &p.h[0], &p.h[1], &p.h[2])
+	if err != nil {
+		return err
+	}
+
+	// Z_H(zeta) = zeta^n - 1 and L_1(zeta) = (zeta^n - 1) / (n * (zeta - 1))
+	var zetaPowerN, zhZeta, lagrangeOne, one fr.Element
+	one.SetOne()
+	zetaPowerN.Exp(zeta, new(big.Int).SetUint64(vk.size))
+	zhZeta.Sub(&zetaPowerN, &one)
+	lagrangeOne.Sub(&zeta, &one)
+	if lagrangeOne.IsZero() || zhZeta.IsZero() {
+		return fmt.Errorf("evaluation challenge lies in the evaluation domain")
+	}
+	lagrangeOne.Inverse(&lagrangeOne).Mul(&lagrangeOne, &zhZeta).Mul(&lagrangeOne, &vk.sizeInv)
+
+	// PI(zeta) = sum(w_i * L_i(zeta)) over the public inputs and the hashed BSB22 commitments, where
+	// L_i(zeta) = omega^i * (zeta^n - 1) / (n * (zeta - omega^i))
+	var pi fr.Element
+	dens := make([]fr.Element, len(publicInputs))
+	var omegaI fr.Element
+	omegaI.SetOne()
+	for i := range publicInputs {
+		dens[i].Sub(&zeta, &omegaI)
+		omegaI.Mul(&omegaI, &vk.generator)
+	}
+	invDens := fr.BatchInvert(dens)
+
+	omegaI.SetOne()
+	for i := range publicInputs {
+		var term fr.Element
+		term.Mul(&zhZeta, &invDens[i]).Mul(&term, &vk.sizeInv).Mul(&term, &omegaI).Mul(&term, &publicInputs[i])
+		pi.Add(&pi, &term)
+		omegaI.Mul(&omegaI, &vk.generator)
+	}
+
+	hashToField := hash_to_field.New([]byte(plonkBsb22DomainSeparator))
+	for i := range vk.commitmentConstraintIndexes {
+		hashToField.Write(p.bsb22Commitments[i].Marshal())
+		var hashedCommitment fr.Element
+		hashedCommitment.SetBytes(hashToField.Sum(nil))
+		hashToField.Reset()
+
+		var omegaK, den, lagrange fr.Element
+		omegaK.Exp(vk.generator, new(big.Int).SetUint64(vk.nbPublicVariables+vk.commitmentConstraintIndexes[i]))
+		den.Sub(&zeta, &omegaK)
+		if den.IsZero() {
+			return fmt.Errorf("evaluation challenge lies in the evaluation domain")
+		}
+		lagrange.Sub(&zeta, &one).Mul(&lagrange, &omegaK).Div(&lagrange, &den).Mul(&lagrange, &lagrangeOne)
+
+		lagrange.Mul(&lagrange, &hashedCommitment)
+		pi.Add(&pi, &lagrange)
+	}
+
+	claimedValues := p.batchedProof.ClaimedValues
+	linearisedPolynomialEval := claimedValues[0]
+	l, r, o, s1, s2 := claimedValues[1], claimedValues[2], claimedValues[3], claimedValues[4], claimedValues[5]
+	zu := p.zShiftedOpening.ClaimedValue
+
+	var alphaSquareLagrangeOne, tmp fr.Element
+	alphaSquareLagrangeOne.Mul(&lagrangeOne, &alpha).Mul(&alphaSquareLagrangeOne, &alpha)
+
+	// the opening of the linearised polynomial must equal
+	// -[PI(zeta) - alpha^2 * L_1(zeta) + alpha * (l + beta*s1 + gamma) * (r + beta*s2 + gamma) * (o + gamma) * z(omega*zeta)]
+	var constLin fr.Element
+	constLin.Mul(&beta, &s1).Add(&constLin, &gamma).Add(&constLin, &l)
+	tmp.Mul(&beta, &s2).Add(&tmp, &gamma).Add(&tmp, &r)
+	constLin.Mul(&constLin, &tmp)
+	tmp.Add(&o, &gamma)
+	constLin.Mul(&constLin, &tmp).Mul(&constLin, &alpha).Mul(&constLin, &zu)
+	constLin.Sub(&constLin, &alphaSquareLagrangeOne).Add(&constLin, &pi).Neg(&constLin)
+	if !constLin.Equal(&linearisedPolynomialEval) {
+		return fmt.Errorf("algebraic relation does not hold")
+	}
+
+	// _s1 = alpha * (l + beta*s1 + gamma) * (r + beta*s2 + gamma) * beta * z(omega*zeta)
+	var s1Coeff fr.Element
+	s1Coeff.Mul(&beta, &s1).Add(&s1Coeff, &l).Add(&s1Coeff, &gamma)
+	tmp.Mul(&beta, &s2).Add(&tmp, &r).Add(&tmp, &gamma)
+	s1Coeff.Mul(&s1Coeff, &tmp).Mul(&s1Coeff, &beta).Mul(&s1Coeff, &alpha).Mul(&s1Coeff, &zu)
+
+	// coefficient of [Z]: alpha^2 * L_1(zeta) - alpha * (l + beta*zeta + gamma) * (r + beta*u*zeta + gamma) * (o + beta*u^2*zeta + gamma)
+	var zCoeff fr.Element
+	zCoeff.Mul(&beta, &zeta).Add(&zCoeff, &gamma).Add(&zCoeff, &l)
+	tmp.Mul(&beta, &vk.cosetShift).Mul(&tmp, &zeta).Add(&tmp, &gamma).Add(&tmp, &r)
+	zCoeff.Mul(&zCoeff, &tmp)
+	tmp.Mul(&beta, &vk.cosetShift).Mul(&tmp, &vk.cosetShift).Mul(&tmp, &zeta).Add(&tmp, &gamma).Add(&tmp, &o)
+	zCoeff.Mul(&zCoeff, &tmp).Mul(&zCoeff, &alpha).Neg(&zCoeff)
+	zCoeff.Add(&zCoeff, &alphaSquareLagrangeOne)
+
+	var rl fr.Element
+	rl.Mul(&l, &r)
+
+	// the quotient is split in chunks of n+2 coefficients: -Z_H(zeta) * ([H0] + zeta^(n+2)*[H1] + zeta^(2(n+2))*[H2])
+	var zetaNPlusTwoZh, zetaNPlusTwoSquareZh, zh fr.Element
+	zetaNPlusTwoZh.Exp(zeta, new(big.Int).SetUint64(vk.size+2))
+	zetaNPlusTwoSquareZh.Square(&zetaNPlusTwoZh)
+	zetaNPlusTwoZh.Mul(&zetaNPlusTwoZh, &zhZeta).Neg(&zetaNPlusTwoZh)
+	zetaNPlusTwoSquareZh.Mul(&zetaNPlusTwoSquareZh, &zhZeta).Neg(&zetaNPlusTwoSquareZh)
+	zh.Neg(&zhZeta)
+
+	points := append(append([]bn254.G1Affine{}, p.bsb22Commitments...),
+		vk.ql, vk.qr, vk.qm, vk.qo, vk.qk,
+		vk.s[2], p.z,
+		p.h[0], p.h[1], p.h[2],
+	)
+	scalars := append(append([]fr.Element{}, claimedValues[6:]...),
+		l, r, rl, o, one,
+		s1Coeff, zCoeff,
+		zh, zetaNPlusTwoZh, zetaNPlusTwoSquareZh,
+	)
+
+	var linearisedPolynomialDigest bn254.G1Affine
+	if _, err := linearisedPolynomialDigest.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	digestsToFold := append([]kzg.Digest{
+		linearisedPolynomialDigest, p.lro[0], p.lro[1], p.lro[2], vk.s[0], vk.s[1],
+	}, vk.qcp...)
+
+	zuBytes := zu.Marshal()
+	foldedProof, foldedDigest, err := kzg.FoldProof(digestsToFold, &p.batchedProof, zeta, sha256.New(), zuBytes)
+	if err != nil {
+		return err
+	}
+
+	// the openings are checked one at a time rather than batched with random coefficients, so that
+	// verification is deterministic
+	if err := kzg.Verify(&foldedDigest, &foldedProof, zeta, vk.kzg); err != nil {
+		return err
+	}
+
+	var shiftedZeta fr.Element
+	shiftedZeta.Mul(&zeta, &vk.generator)
+
+	return kzg.Verify(&p.z, &p.zShiftedOpening, shiftedZeta, vk.kzg)
+}
+
+// bindPlonkPublicData binds the permutation and selector commitments of the verifying key and the public
+// inputs to the challenge.
+func bindPlonkPublicData(fs *fiatshamir.Transcript, challenge string, vk *plonkVerifyingKey, publicInputs []fr.Element) error {
+	commitments := []*bn254.G1Affine{&vk.s[0], &vk.s[1], &vk.s[2], &vk.ql, &vk.qr, &vk.qm, &vk.qo, &vk.qk}
+	for i := range vk.qcp {
+		commitments = append(commitments, &vk.qcp[i])
+	}
+
+	for _, commitment := range commitments {
+		if err := fs.Bind(challenge, commitment.Marshal()); err != nil {
+			return err
+		}
+	}
+
+	for i := range publicInputs {
+		if err := fs.Bind(challenge, publicInputs[i].Marshal()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deriveRandomness binds the points to the challenge and computes it.
+func deriveRandomness(fs *fiatshamir.Transcript, challenge string, points ...*bn254.G1Affine) (fr.Element, error) {
+	var r fr.Element
+	for _, p := range points {
+		bz := p.RawBytes()
+		if err := fs.Bind(challenge, bz[:]); err != nil {
+			return r, err
+		}
+	}
+
+	bz, err := fs.ComputeChallenge(challenge)
+	if err != nil {
+		return r, err
+	}
+
+	r.SetBytes(bz)
+	return r, nil
+}
+
+// decodePlonkVerifyingKey decodes the gnark encoding of a PLONK verifying key and checks its consistency.
+func decodePlonkVerifyingKey(bz []byte) (*plonkVerifyingKey, error) {
+	vk := &plonkVerifyingKey{}
+
+	dec := newGnarkDecoder(bz)
+	dec.decode(&vk.size)
+	dec.decode(&vk.sizeInv)
+	dec.decode(&vk.generator)
+	dec.decode(&vk.nbPublicVariables)
+	dec.decode(&vk.cosetShift)
+	for i := range vk.s {
+		dec.decode(&vk.s[i])
+	}
+	dec.decode(&vk.ql)
+	dec.decode(&vk.qr)
+	dec.decode(&vk.qm)
+	dec.decode(&vk.qo)
+	dec.decode(&vk.qk)
+	vk.qcp = decodeSlice[bn254.G1Affine](dec, bn254.SizeOfG1AffineCompressed)
+	dec.decode(&vk.kzg.G1)
+	dec.decode(&vk.kzg.G2[0])
+	dec.decode(&vk.kzg.G2[1])
+	dec.decode(&vk.kzg.Lines)
+	vk.commitmentConstraintIndexes = decodeSlice[uint64](dec, 8)
+	if err := dec.finish(); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidVerifyingKey, "failed to decode PLONK verifying key: %v", err)
+	}
+
+	if vk.size == 0 || bits.OnesCount64(vk.size) != 1 {
+		return nil, errorsmod.Wrapf(ErrInvalidVerifyingKey, "domain size must be a power of two, got %d", vk.size)
+	}
+
+	var size, sizeTimesInv fr.Element
+	size.SetUint64(vk.size)
+	if !sizeTimesInv.Mul(&size, &vk.sizeInv).IsOne() {
+		return nil, errorsmod.Wrap(ErrInvalidVerifyingKey, "inverse of the domain size is invalid")
+	}
+
+	// the generator must be a primitive root of unity of order the domain size
+	var generatorPower fr.Element
+	generatorPower.Exp(vk.generator, new(big.Int).SetUint64(vk.size))
+	if !generatorPower.IsOne() {
+		return nil, errorsmod.Wrap(ErrInvalidVerifyingKey, "generator is not a root of unity of the domain size")
+	}
+	if vk.size > 1 {
+		generatorPower.Exp(vk.generator, new(big.Int).SetUint64(vk.size/2))
+		if generatorPower.IsOne() {
+			return nil, errorsmod.Wrap(ErrInvalidVerifyingKey, "generator is not a primitive root of unity of the domain size")
+		}
+	}
+
+	if len(vk.commitmentConstraintIndexes) != len(vk.qcp) {
+		return nil, errorsmod.Wrapf(ErrInvalidVerifyingKey, "expected %d commitment constraint indexes, got %d", len(vk.qcp), len(vk.commitmentConstraintIndexes))
+	}
+
+	if vk.nbPublicVariables >= vk.size {
+		return nil, errorsmod.Wrapf(ErrInvalidVerifyingKey, "number of public inputs %d exceeds domain size %d", vk.nbPublicVariables, vk.size)
+	}
+
+	for _, index := range vk.commitmentConstraintIndexes {
+		if index >= vk.size-vk.nbPublicVariables {
+			return nil, errorsmod.Wrapf(ErrInvalidVerifyingKey, "commitment constraint index %d exceeds domain size %d", index, vk.size)
+		}
+	}
+
+	// the pairing lines precomputed by gnark are only accepted if they match the G2 points of the KZG setup
+	if vk.kzg.Lines[0] != bn254.PrecomputeLines(vk.kzg.G2[0]) || vk.kzg.Lines[1] != bn254.PrecomputeLines(vk.kzg.G2[1]) {
+		return nil, errorsmod.Wrap(ErrInvalidVerifyingKey, "precomputed pairing lines do not match the KZG verifying key")
+	}
+
+	return vk, nil
+}
+
+// decodePlonkProof decodes the gnark encoding of a PLONK proof.
+func decodePlonkProof(bz []byte) (*plonkProof, error) {
+	p := &plonkProof{}
+
+	dec := newGnarkDecoder(bz)
+	for i := range p.lro {
+		dec.decode(&p.lro[i])
+	}
+	dec.decode(&p.z)
+	for i := range p.h {
+		dec.decode(&p.h[i])
+	}
+	dec.decode(&p.batchedProof.H)
+	p.batchedProof.ClaimedValues = decodeSlice[fr.Element](dec, fr.Bytes)
+	dec.decode(&p.zShiftedOpening.H)
+	dec.decode(&p.zShiftedOpening.ClaimedValue)
+	p.bsb22Commitments = decodeSlice[bn254.G1Affine](dec, bn254.SizeOfG1AffineCompressed)
+	if err := dec.finish(); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "failed to decode PLONK proof: %v", err)
+	}
+
+	return p, nil
+}
+
+// gnarkDecoder decodes the binary encoding of gnark objects. Slice lengths are bounded by the remaining input,
+// so that malformed inputs cannot cause large allocations. The first error is retained and returned by finish.
+type gnarkDecoder struct {
+	r   *bytes.Reader
+	dec *bn254.Decoder
+	err error
+}
+
+// newGnarkDecoder returns a decoder of the encoded bytes.
+func newGnarkDecoder(bz []byte) *gnarkDecoder {
+	r := bytes.NewReader(bz)
+	return &gnarkDecoder{r: r, dec: bn254.NewDecoder(r)}
+}
+
+// decode decodes the next value into v, which must be supported by the bn254 decoder.
+func (d *gnarkDecoder) decode(v any) {
+	if d.err == nil {
+		d.err = d.dec.Decode(v)
+	}
+}
+
+// length decodes the length prefix of a slice whose elements are encoded on at least minSize bytes.
+func (d *gnarkDecoder) length(minSize int) int {
+	if d.err != nil {
+		return 0
+	}
+
+	var n uint32
+	if d.err = binary.Read(d.r, binary.BigEndian, &n); d.err != nil {
+		return 0
+	}
+
+	if uint64(n)*uint64(minSize) > uint64(d.r.Len()) {
+		d.err = fmt.Errorf("slice length %d exceeds the remaining %d bytes", n, d.r.Len())
+		return 0
+	}
+
+	return int(n)
+}
+
+// finish returns the first decoding error, or an error if the input has not been fully decoded.
+func (d *gnarkDecoder) finish() error {
+	if d.err != nil {
+		return d.err
+	}
+
+	if d.r.Len() != 0 {
+		return fmt.Errorf("%d trailing bytes", d.r.Len())
+	}
+
+	return nil
+}
+
+// decodeSlice decodes a length prefixed slice whose elements are encoded on at least minSize bytes.
+func decodeSlice[T any](d *gnarkDecoder, minSize int) []T {
+	n := d.length(minSize)
+	if d.err != nil {
+		return nil
+	}
+
+	values := make([]T, n)
+	for i := range values {
+		d.decode(&values[i])
+	}
+
+	return values
+}
@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk_test
+
+import (
+	"encoding/binary"
+	"slices"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/zk"
+)
+
+func TestPlonkVerify(t *testing.T) {
+	prover := newPlonkProver()
+	publicInputs := [][]byte{[]byte("public input one"), []byte("public input two")}
+	baseProof := prover.proof(publicInputs)
+
+	_, _, g1, _ := bn254.Generators()
+
+	var (
+		vk      plonkTestVerifyingKey
+		proof   plonkTestProof
+		vkBz    []byte
+		proofBz []byte
+		inputs  [][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: uncompressed encodings",
+			func() {
+				vkBz = vk.encode(bn254.RawEncoding())
+				proofBz = proof.encode(bn254.RawEncoding())
+			},
+			nil,
+		},
+		{
+			"failure: public inputs do not match the proof",
+			func() {
+				inputs = [][]byte{[]byte("public input one"), []byte("public input 2")}
+			},
+			zk.ErrProofVerificationFailed,
+		},
+		{
+			"failure: opening of the linearised polynomial is tampered with",
+			func() {
+				proof.batchedProof.ClaimedValues[0].SetOne()
+			},
+			zk.ErrProofVerificationFailed,
+		},
+		{
+			"failure: opening of a wire polynomial is tampered with",
+			func() {
+				proof.batchedProof.ClaimedValues[1].SetOne()
+			},
+			zk.ErrProofVerificationFailed,
+		},
+		{
+			"failure: opening of the commitment selector is tampered with",
+			func() {
+				proof.batchedProof.ClaimedValues[6].SetOne()
+			},
+			zk.ErrProofVerificationFailed,
+		},
+		{
+			"failure: shifted opening of the permutation accumulator is tampered with",
+			func() {
+				proof.zShiftedOpening.ClaimedValue.SetOne()
+			},
+			zk.ErrProofVerificationFailed,
+		},
+		{
+			"failure: quotient commitment is tampered with",
+			func() {
+				proof.h[0] = g1
+			},
+			zk.ErrProofVerificationFailed,
+		},
+		{
+			"failure: BSB22 commitment is tampered with",
+			func() {
+				proof.bsb22Commitments[0] = g1
+			},
+			zk.ErrProofVerificationFailed,
+		},
+		{
+			"failure: proof is for another verifying key",
+			func() {
+				vk.qk = g1
+			},
+			zk.ErrProofVerificationFailed,
+		},
+		{
+			"failure: proof is verified with another KZG setup",
+			func() {
+				vk.kzg = newPlonkProver().srs.Vk
+			},
+			zk.ErrProofVerificationFailed,
+		},
+		{
+			"failure: missing BSB22 commitment",
+			func() {
+				proof.bsb22Commitments = nil
+			},
+			zk.ErrInvalidProof,
+		},
+		{
+			"failure: missing claimed value",
+			func() {
+				proof.batchedProof.ClaimedValues = proof.batchedProof.ClaimedValues[:6]
+			},
+			zk.ErrInvalidProof,
+		},
+		{
+			"failure: proof has trailing bytes",
+			func() {
+				proofBz = append(proof.encode(), 0)
+			},
+			zk.ErrInvalidProof,
+		},
+		{
+			"failure: proof is truncated",
+			func() {
+				bz := proof.encode()
+				proofBz = bz[:len(bz)-1]
+			},
+			zk.ErrInvalidProof,
+		},
+		{
+			"failure: invalid point in proof",
+			func() {
+				proofBz = proof.encode()
+				// the x coordinate of the first wire commitment, following the compression flag, exceeds the base field
+				// modulus, such that it is never a point of the curve regardless of the randomness of the proof
+				copy(proofBz, bytes32(0xff))
+				proofBz[0] = 0xbf
+			},
+			zk.ErrInvalidProof,
+		},
+		{
+			"failure: claimed value is not a scalar field element",
+			func() {
+				proofBz = proof.encode()
+				// claimed values follow the three wire, the permutation, the three quotient and the batched opening commitments and the length prefix
+				offset := 8*bn254.SizeOfG1AffineCompressed + 4
+				copy(proofBz[offset:], bytes32(0xff))
+			},
+			zk.ErrInvalidProof,
+		},
+		{
+			"failure: wrong number of public inputs",
+			func() {
+				inputs = inputs[:1]
+			},
+			zk.ErrInvalidPublicInputs,
+		},
+		{
+			"failure: public input is not a scalar field element",
+			func() {
+				inputs = [][]byte{bytes32(0xff), []byte("public input two")}
+			},
+			zk.ErrInvalidPublicInputs,
+		},
+		{
+			"failure: domain size is not a power of two",
+			func() {
+				vk.size = 12
+			},
+			zk.ErrInvalidVerifyingKey,
+		},
+		{
+			"failure: inverse of the domain size is invalid",
+			func() {
+				vk.sizeInv.SetOne()
+			},
+			zk.ErrInvalidVerifyingKey,
+		},
+		{
+			"failure: generator is not a primitive root of unity",
+			func() {
+				vk.generator.Square(&vk.generator)
+			},
+			zk.ErrInvalidVerifyingKey,
+		},
+		{
+			"failure: missing commitment constraint index",
+			func() {
+				vk.commitmentConstraintIndexes = nil
+			},
+			zk.ErrInvalidVerifyingKey,
+		},
+		{
+			"failure: commitment constraint index exceeds the domain",
+			func() {
+				vk.commitmentConstraintIndexes = []uint64{vk.size}
+			},
+			zk.ErrInvalidVerifyingKey,
+		},
+		{
+			"failure: precomputed pairing lines do not match the KZG verifying key",
+			func() {
+				vk.kzg.Lines[0] = vk.kzg.Lines[1]
+			},
+			zk.ErrInvalidVerifyingKey,
+		},
+		{
+			"failure: slice length exceeds the verifying key",
+			func() {
+				vkBz = vk.encode()
+				// the selector commitments follow the domain size and its inverse, the generator, the number of
+				// public inputs, the coset shift and the eight permutation and selector commitments
+				offset := 8 + fr.Bytes + fr.Bytes + 8 + fr.Bytes + 8*bn254.SizeOfG1AffineCompressed
+				binary.BigEndian.PutUint32(vkBz[offset:], 1<<31)
+			},
+			zk.ErrInvalidVerifyingKey,
+		},
+		{
+			"failure: verifying key cannot be decoded",
+			func() {
+				vkBz = []byte("invalid")
+			},
+			zk.ErrInvalidVerifyingKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vk = prover.vk()
+			proof = baseProof
+			proof.batchedProof.ClaimedValues = slices.Clone(baseProof.batchedProof.ClaimedValues)
+			proof.bsb22Commitments = slices.Clone(baseProof.bsb22Commitments)
+			vkBz, proofBz = nil, nil
+			inputs = publicInputs
+
+			tc.malleate()
+
+			if vkBz == nil {
+				vkBz = vk.encode()
+			}
+			if proofBz == nil {
+				proofBz = proof.encode()
+			}
+
+			err := zk.PlonkVerifier{}.Verify(vkBz, proofBz, inputs)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestPlonkValidateVerifyingKey(t *testing.T) {
+	verifyingKey := newPlonkProver().verifyingKey()
+
+	require.NoError(t, zk.PlonkVerifier{}.ValidateVerifyingKey(verifyingKey, zk.NbPublicInputs))
+	require.ErrorIs(t, zk.PlonkVerifier{}.ValidateVerifyingKey(verifyingKey, zk.NbPublicInputs+1), zk.ErrInvalidVerifyingKey)
+}
+
+// bytes32 returns 32 bytes set to b.
+func bytes32(b byte) []byte {
+	bz := make([]byte, 32)
+	for i := range bz {
+		bz[i] = b
+	}
+	return bz
+}
@@ -0,0 +1,578 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/hash_to_field"
+	"github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/zk"
+)
+
+// groth16Prover produces Groth16 proofs from known setup scalars. Knowing the scalars of the verifying key,
+// a proof for any public inputs is found by solving the verification equation for B with A set to the generator.
+type groth16Prover struct {
+	alpha, beta, gamma, delta *big.Int
+	ic                        []*big.Int
+}
+
+func newGroth16Prover(nbPublicInputs int) groth16Prover {
+	ic := make([]*big.Int, nbPublicInputs+1)
+	for i := range ic {
+		ic[i] = big.NewInt(int64(13 + 4*i))
+	}
+
+	return groth16Prover{
+		alpha: big.NewInt(3),
+		beta:  big.NewInt(5),
+		gamma: big.NewInt(7),
+		delta: big.NewInt(11),
+		ic:    ic,
+	}
+}
+
+func (groth16Prover) g1(s *big.Int) []byte {
+	_, _, g1, _ := bn254.Generators()
+	var p bn254.G1Affine
+	p.ScalarMultiplication(&g1, s)
+	bz := p.Bytes()
+	return bz[:]
+}
+
+func (groth16Prover) g2(s *big.Int) []byte {
+	_, _, _, g2 := bn254.Generators()
+	var p bn254.G2Affine
+	p.ScalarMultiplication(&g2, s)
+	bz := p.Bytes()
+	return bz[:]
+}
+
+func (p groth16Prover) verifyingKey() []byte {
+	vk := zk.Groth16VerifyingKey{
+		Alpha: p.g1(p.alpha),
+		Beta:  p.g2(p.beta),
+		Gamma: p.g2(p.gamma),
+		Delta: p.g2(p.delta),
+	}
+	for _, s := range p.ic {
+		vk.IC = append(vk.IC, p.g1(s))
+	}
+
+	bz, err := vk.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// prove returns a proof with a = 1, c = 19 and b = alpha*beta + (ic0 + sum(x_i*ic_i+1))*gamma + c*delta.
+func (p groth16Prover) prove(publicInputs [][]byte) []byte {
+	c := big.NewInt(19)
+
+	vkX := new(big.Int).Set(p.ic[0])
+	for i, input := range publicInputs {
+		term := new(big.Int).Mul(new(big.Int).SetBytes(input), p.ic[i+1])
+		vkX.Add(vkX, term)
+	}
+
+	b := new(big.Int).Mul(p.alpha, p.beta)
+	b.Add(b, vkX.Mul(vkX, p.gamma))
+	b.Add(b, new(big.Int).Mul(c, p.delta))
+	b.Mod(b, fr.Modulus())
+
+	proof := zk.Groth16Proof{
+		A: p.g1(big.NewInt(1)),
+		B: p.g2(b),
+		C: p.g1(c),
+	}
+
+	bz, err := proof.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// plonkProver produces gnark PLONK proofs for a circuit over a domain of size 8. The circuit exposes its two
+// public inputs in the first two rows, commits to the first public input with a BSB22 commitment whose hash is
+// exposed in the third row, and multiplies the public inputs, with copy constraints wiring the public inputs
+// into the commitment and multiplication gates. The prover follows the gnark protocol, including the blinding
+// of the wire and permutation polynomials, and the KZG setup is generated from a random secret.
+type plonkProver struct {
+	n          uint64
+	omega      fr.Element
+	cosetShift fr.Element
+	srs        *kzg.SRS
+
+	// selector and permutation polynomials in coefficient form
+	ql, qr, qm, qo, qk, qcp []fr.Element
+	s                       [3][]fr.Element
+
+	// identity and permutation values of the wire positions
+	ids, sigmas [3][]fr.Element
+}
+
+// plonkTestVerifyingKey mirrors the gnark PLONK verifying key, so that tests can tamper with its elements.
+type plonkTestVerifyingKey struct {
+	size              uint64
+	sizeInv           fr.Element
+	generator         fr.Element
+	nbPublicVariables uint64
+	cosetShift        fr.Element
+
+	s                  [3]bn254.G1Affine
+	ql, qr, qm, qo, qk bn254.G1Affine
+	qcp                []bn254.G1Affine
+	kzg                kzg.VerifyingKey
+
+	commitmentConstraintIndexes []uint64
+}
+
+// plonkTestProof mirrors the gnark PLONK proof, so that tests can tamper with its elements.
+type plonkTestProof struct {
+	lro              [3]bn254.G1Affine
+	z                bn254.G1Affine
+	h                [3]bn254.G1Affine
+	batchedProof     kzg.BatchOpeningProof
+	zShiftedOpening  kzg.OpeningProof
+	bsb22Commitments []bn254.G1Affine
+}
+
+const (
+	// plonkHashRow is the row exposing the hash of the BSB22 commitment, following the public inputs
+	plonkHashRow = 2
+	// plonkCommitmentRow is the row committing to the first public input
+	plonkCommitmentRow = 3
+	// plonkMulRow is the row multiplying the public inputs
+	plonkMulRow = 4
+)
+
+func newPlonkProver() *plonkProver {
+	const n = 8
+
+	omega, err := fr.Generator(n)
+	if err != nil {
+		panic(err)
+	}
+
+	// the quotient is split in chunks of n+2 coefficients and the blinded permutation accumulator has n+3
+	var secret fr.Element
+	if _, err := secret.SetRandom(); err != nil {
+		panic(err)
+	}
+	srs, err := kzg.NewSRS(n+3, secret.BigInt(new(big.Int)))
+	if err != nil {
+		panic(err)
+	}
+
+	p := &plonkProver{n: n, omega: omega, srs: srs}
+	p.cosetShift.SetUint64(5)
+
+	var one, minusOne fr.Element
+	one.SetOne()
+	minusOne.Neg(&one)
+
+	ql, qr, qm, qo, qk, qcp := make([]fr.Element, n), make([]fr.Element, n), make([]fr.Element, n), make([]fr.Element, n), make([]fr.Element, n), make([]fr.Element, n)
+	for _, row := range []int{0, 1, plonkHashRow, plonkCommitmentRow} {
+		ql[row] = minusOne
+	}
+	qcp[plonkCommitmentRow] = one
+	qm[plonkMulRow], qo[plonkMulRow] = one, minusOne
+
+	shift := one
+	for col := range p.ids {
+		p.ids[col] = make([]fr.Element, n)
+		omegaI := one
+		for i := range p.ids[col] {
+			p.ids[col][i].Mul(&shift, &omegaI)
+			omegaI.Mul(&omegaI, &omega)
+		}
+		p.sigmas[col] = append([]fr.Element(nil), p.ids[col]...)
+		shift.Mul(&shift, &p.cosetShift)
+	}
+
+	// copy constraints: l[0] = l[3] = l[4] and l[1] = r[4]
+	p.sigmas[0][0], p.sigmas[0][plonkCommitmentRow], p.sigmas[0][plonkMulRow] = p.ids[0][plonkCommitmentRow], p.ids[0][plonkMulRow], p.ids[0][0]
+	p.sigmas[0][1], p.sigmas[1][plonkMulRow] = p.ids[1][plonkMulRow], p.ids[0][1]
+
+	p.ql, p.qr, p.qm, p.qo, p.qk, p.qcp = p.interpolate(ql), p.interpolate(qr), p.interpolate(qm), p.interpolate(qo), p.interpolate(qk), p.interpolate(qcp)
+	for col := range p.s {
+		p.s[col] = p.interpolate(p.sigmas[col])
+	}
+
+	return p
+}
+
+// vk returns the verifying key of the circuit.
+func (p *plonkProver) vk() plonkTestVerifyingKey {
+	vk := plonkTestVerifyingKey{
+		size:                        p.n,
+		generator:                   p.omega,
+		nbPublicVariables:           zk.NbPublicInputs,
+		cosetShift:                  p.cosetShift,
+		ql:                          p.commit(p.ql),
+		qr:                          p.commit(p.qr),
+		qm:                          p.commit(p.qm),
+		qo:                          p.commit(p.qo),
+		qk:                          p.commit(p.qk),
+		qcp:                         []bn254.G1Affine{p.commit(p.qcp)},
+		kzg:                         p.srs.Vk,
+		commitmentConstraintIndexes: []uint64{plonkHashRow - zk.NbPublicInputs},
+	}
+	vk.sizeInv.SetUint64(p.n).Inverse(&vk.sizeInv)
+	for col := range vk.s {
+		vk.s[col] = p.commit(p.s[col])
+	}
+
+	return vk
+}
+
+// verifyingKey returns the gnark encoding of the verifying key of the circuit.
+func (p *plonkProver) verifyingKey() []byte {
+	return p.vk().encode()
+}
+
+// proof runs the gnark PLONK prover for the public inputs.
+func (p *plonkProver) proof(publicInputs [][]byte) plonkTestProof {
+	n := int(p.n)
+
+	var w0, w1, one, minusOne fr.Element
+	w0.SetBytes(publicInputs[0])
+	w1.SetBytes(publicInputs[1])
+	one.SetOne()
+	minusOne.Neg(&one)
+
+	// BSB22 commitment to the first public input, hashed into the public inputs of the circuit
+	pi2Evals := make([]fr.Element, n)
+	pi2Evals[plonkCommitmentRow] = w0
+	pi2 := p.interpolate(pi2Evals)
+	bsb22Commitment := p.commit(pi2)
+
+	hashToField := hash_to_field.New([]byte("BSB22-Plonk"))
+	hashToField.Write(bsb22Commitment.Marshal())
+	var hashedCommitment fr.Element
+	hashedCommitment.SetBytes(hashToField.Sum(nil))
+
+	// round 1: blinded wire polynomials
+	wires := [3][]fr.Element{make([]fr.Element, n), make([]fr.Element, n), make([]fr.Element, n)}
+	wires[0][0], wires[0][1], wires[0][plonkHashRow], wires[0][plonkCommitmentRow], wires[0][plonkMulRow] = w0, w1, hashedCommitment, w0, w0
+	wires[1][plonkMulRow] = w1
+	wires[2][plonkMulRow].Mul(&w0, &w1)
+
+	var lro [3][]fr.Element
+	var proof plonkTestProof
+	for col := range lro {
+		lro[col] = p.blind(p.interpolate(wires[col]), 2)
+		proof.lro[col] = p.commit(lro[col])
+	}
+	proof.bsb22Commitments = []bn254.G1Affine{bsb22Commitment}
+
+	vk := p.vk()
+	fs := fiatshamir.NewTranscript(sha256.New(), "gamma", "beta", "alpha", "zeta")
+	for _, commitment := range append([]bn254.G1Affine{vk.s[0], vk.s[1], vk.s[2], vk.ql, vk.qr, vk.qm, vk.qo, vk.qk}, vk.qcp...) {
+		bindTranscript(fs, "gamma", commitment.Marshal())
+	}
+	bindTranscript(fs, "gamma", w0.Marshal(), w1.Marshal())
+	gamma := transcriptChallenge(fs, "gamma", proof.lro[:]...)
+	beta := transcriptChallenge(fs, "beta")
+
+	// round 2: blinded permutation accumulator
+	zEvals := make([]fr.Element, n)
+	zEvals[0] = one
+	for i := 0; i < n-1; i++ {
+		num, den := one, one
+		for col := range wires {
+			var term fr.Element
+			term.Mul(&beta, &p.ids[col][i]).Add(&term, &wires[col][i]).Add(&term, &gamma)
+			num.Mul(&num, &term)
+			term.Mul(&beta, &p.sigmas[col][i]).Add(&term, &wires[col][i]).Add(&term, &gamma)
+			den.Mul(&den, &term)
+		}
+		den.Inverse(&den)
+		zEvals[i+1].Mul(&zEvals[i], &num).Mul(&zEvals[i+1], &den)
+	}
+
+	z := p.blind(p.interpolate(zEvals), 3)
+	proof.z = p.commit(z)
+	alpha := transcriptChallenge(fs, "alpha", proof.bsb22Commitments[0], proof.z)
+
+	// round 3: quotient polynomial of
+	// gate + alpha * (z(omega*X) * den(X) - z(X) * num(X)) + alpha^2 * L_1 * (z - 1)
+	piEvals := make([]fr.Element, n)
+	piEvals[0], piEvals[1], piEvals[plonkHashRow] = w0, w1, hashedCommitment
+	pi := p.interpolate(piEvals)
+
+	l1Evals := make([]fr.Element, n)
+	l1Evals[0] = one
+	l1 := p.interpolate(l1Evals)
+
+	zShifted := make([]fr.Element, len(z))
+	omegaI := one
+	for i := range z {
+		zShifted[i].Mul(&z[i], &omegaI)
+		omegaI.Mul(&omegaI, &p.omega)
+	}
+
+	var betaShift, betaShiftSquare fr.Element
+	betaShift.Mul(&beta, &p.cosetShift)
+	betaShiftSquare.Mul(&betaShift, &p.cosetShift)
+
+	l, r, o := lro[0], lro[1], lro[2]
+	gate := polyAdd(polyMul(l, p.ql), polyMul(r, p.qr), polyMul(polyMul(l, r), p.qm), polyMul(o, p.qo), p.qk, polyMul(pi2, p.qcp), pi)
+	num := polyMul(polyMul(polyAdd(l, []fr.Element{gamma, beta}), polyAdd(r, []fr.Element{gamma, betaShift})), polyAdd(o, []fr.Element{gamma, betaShiftSquare}))
+	den := polyMul(
+		polyMul(polyAdd(l, polyScale(p.s[0], beta), []fr.Element{gamma}), polyAdd(r, polyScale(p.s[1], beta), []fr.Element{gamma})),
+		polyAdd(o, polyScale(p.s[2], beta), []fr.Element{gamma}),
+	)
+
+	var alphaSquare fr.Element
+	alphaSquare.Square(&alpha)
+
+	numerator := polyAdd(
+		gate,
+		polyScale(polyAdd(polyMul(zShifted, den), polyScale(polyMul(z, num), minusOne)), alpha),
+		polyScale(polyMul(polyAdd(z, []fr.Element{minusOne}), l1), alphaSquare),
+	)
+
+	chunk := n + 2
+	quotient := polyDivVanishing(numerator, n)
+	if len(quotient) > 3*chunk {
+		panic("quotient exceeds three chunks")
+	}
+	quotient = append(quotient, make([]fr.Element, 3*chunk-len(quotient))...)
+	h := [3][]fr.Element{quotient[:chunk], quotient[chunk : 2*chunk], quotient[2*chunk:]}
+	for i := range h {
+		proof.h[i] = p.commit(h[i])
+	}
+	zeta := transcriptChallenge(fs, "zeta", proof.h[:]...)
+
+	// round 4: linearised polynomial and opening proofs
+	var zetaOmega fr.Element
+	zetaOmega.Mul(&zeta, &p.omega)
+	lZeta, rZeta, oZeta := polyEval(l, zeta), polyEval(r, zeta), polyEval(o, zeta)
+	s1Zeta, s2Zeta, qcpZeta := polyEval(p.s[0], zeta), polyEval(p.s[1], zeta), polyEval(p.qcp, zeta)
+	zu := polyEval(z, zetaOmega)
+
+	var alphaSquareL1, tmp fr.Element
+	alphaSquareL1 = polyEval(l1, zeta)
+	alphaSquareL1.Mul(&alphaSquareL1, &alphaSquare)
+
+	var s1Coeff fr.Element
+	s1Coeff.Mul(&beta, &s1Zeta).Add(&s1Coeff, &lZeta).Add(&s1Coeff, &gamma)
+	tmp.Mul(&beta, &s2Zeta).Add(&tmp, &rZeta).Add(&tmp, &gamma)
+	s1Coeff.Mul(&s1Coeff, &tmp).Mul(&s1Coeff, &beta).Mul(&s1Coeff, &alpha).Mul(&s1Coeff, &zu)
+
+	var zCoeff fr.Element
+	zCoeff = polyEval(num, zeta)
+	zCoeff.Mul(&zCoeff, &alpha).Neg(&zCoeff).Add(&zCoeff, &alphaSquareL1)
+
+	var zh, zetaNPlusTwo, lrZeta fr.Element
+	zh.Exp(zeta, new(big.Int).SetUint64(p.n)).Sub(&zh, &one).Neg(&zh)
+	zetaNPlusTwo.Exp(zeta, big.NewInt(int64(chunk)))
+	lrZeta.Mul(&lZeta, &rZeta)
+
+	var zhZetaNPlusTwo, zhZetaNPlusTwoSquare fr.Element
+	zhZetaNPlusTwo.Mul(&zh, &zetaNPlusTwo)
+	zhZetaNPlusTwoSquare.Mul(&zhZetaNPlusTwo, &zetaNPlusTwo)
+
+	linearised := polyAdd(
+		polyScale(pi2, qcpZeta),
+		polyScale(p.ql, lZeta), polyScale(p.qr, rZeta), polyScale(p.qm, lrZeta), polyScale(p.qo, oZeta), p.qk,
+		polyScale(p.s[2], s1Coeff), polyScale(z, zCoeff),
+		polyScale(h[0], zh), polyScale(h[1], zhZetaNPlusTwo), polyScale(h[2], zhZetaNPlusTwoSquare),
+	)
+
+	polys := [][]fr.Element{linearised, l, r, o, p.s[0], p.s[1], p.qcp}
+	digests := []kzg.Digest{p.commit(linearised), proof.lro[0], proof.lro[1], proof.lro[2], vk.s[0], vk.s[1], vk.qcp[0]}
+
+	var err error
+	proof.batchedProof, err = kzg.BatchOpenSinglePoint(polys, digests, zeta, sha256.New(), p.srs.Pk, zu.Marshal())
+	if err != nil {
+		panic(err)
+	}
+
+	proof.zShiftedOpening, err = kzg.Open(z, zetaOmega, p.srs.Pk)
+	if err != nil {
+		panic(err)
+	}
+
+	return proof
+}
+
+// prove returns the gnark encoding of a proof for the public inputs.
+func (p *plonkProver) prove(publicInputs [][]byte) []byte {
+	proof := p.proof(publicInputs)
+	return proof.encode()
+}
+
+// encode returns the gnark encoding of the verifying key, written by its WriteTo or WriteRawTo methods.
+func (vk plonkTestVerifyingKey) encode(options ...func(*bn254.Encoder)) []byte {
+	return encodeGnark(options,
+		vk.size, &vk.sizeInv, &vk.generator, vk.nbPublicVariables, &vk.cosetShift,
+		&vk.s[0], &vk.s[1], &vk.s[2],
+		&vk.ql, &vk.qr, &vk.qm, &vk.qo, &vk.qk,
+		vk.qcp,
+		&vk.kzg.G1, &vk.kzg.G2[0], &vk.kzg.G2[1], &vk.kzg.Lines,
+		vk.commitmentConstraintIndexes,
+	)
+}
+
+// encode returns the gnark encoding of the proof, written by its WriteTo or WriteRawTo methods.
+func (proof plonkTestProof) encode(options ...func(*bn254.Encoder)) []byte {
+	return encodeGnark(options,
+		&proof.lro[0], &proof.lro[1], &proof.lro[2], &proof.z,
+		&proof.h[0], &proof.h[1], &proof.h[2],
+		&proof.batchedProof.H, proof.batchedProof.ClaimedValues,
+		&proof.zShiftedOpening.H, &proof.zShiftedOpening.ClaimedValue,
+		proof.bsb22Commitments,
+	)
+}
+
+func encodeGnark(options []func(*bn254.Encoder), values ...any) []byte {
+	var buf bytes.Buffer
+	enc := bn254.NewEncoder(&buf, options...)
+	for _, v := range values {
+		if err := enc.Encode(v); err != nil {
+			panic(err)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func bindTranscript(fs *fiatshamir.Transcript, challenge string, values ...[]byte) {
+	for _, bz := range values {
+		if err := fs.Bind(challenge, bz); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func transcriptChallenge(fs *fiatshamir.Transcript, challenge string, points ...bn254.G1Affine) fr.Element {
+	for _, point := range points {
+		bindTranscript(fs, challenge, point.Marshal())
+	}
+
+	bz, err := fs.ComputeChallenge(challenge)
+	if err != nil {
+		panic(err)
+	}
+
+	var e fr.Element
+	e.SetBytes(bz)
+	return e
+}
+
+// interpolate returns the coefficients of the polynomial taking the values at the powers of omega.
+func (p *plonkProver) interpolate(values []fr.Element) []fr.Element {
+	var nInv, omegaInv fr.Element
+	nInv.SetUint64(uint64(len(values))).Inverse(&nInv)
+	omegaInv.Inverse(&p.omega)
+
+	coeffs := make([]fr.Element, len(values))
+	var omegaInvJ fr.Element
+	omegaInvJ.SetOne()
+	for j := range coeffs {
+		coeffs[j] = polyEval(values, omegaInvJ)
+		coeffs[j].Mul(&coeffs[j], &nInv)
+		omegaInvJ.Mul(&omegaInvJ, &omegaInv)
+	}
+
+	return coeffs
+}
+
+// blind adds a random multiple of degree below order of the vanishing polynomial to the polynomial, which
+// leaves its values over the domain unchanged.
+func (p *plonkProver) blind(poly []fr.Element, order int) []fr.Element {
+	res := append(append([]fr.Element(nil), poly...), make([]fr.Element, order)...)
+	for i := 0; i < order; i++ {
+		var b fr.Element
+		if _, err := b.SetRandom(); err != nil {
+			panic(err)
+		}
+		res[i].Sub(&res[i], &b)
+		res[int(p.n)+i].Add(&res[int(p.n)+i], &b)
+	}
+
+	return res
+}
+
+func (p *plonkProver) commit(poly []fr.Element) bn254.G1Affine {
+	commitment, err := kzg.Commit(poly, p.srs.Pk)
+	if err != nil {
+		panic(err)
+	}
+
+	return commitment
+}
+
+func polyEval(poly []fr.Element, x fr.Element) fr.Element {
+	var res fr.Element
+	for i := len(poly) - 1; i >= 0; i-- {
+		res.Mul(&res, &x).Add(&res, &poly[i])
+	}
+	return res
+}
+
+func polyAdd(polys ...[]fr.Element) []fr.Element {
+	var size int
+	for _, poly := range polys {
+		size = max(size, len(poly))
+	}
+
+	res := make([]fr.Element, size)
+	for _, poly := range polys {
+		for i := range poly {
+			res[i].Add(&res[i], &poly[i])
+		}
+	}
+	return res
+}
+
+func polyScale(poly []fr.Element, s fr.Element) []fr.Element {
+	res := make([]fr.Element, len(poly))
+	for i := range poly {
+		res[i].Mul(&poly[i], &s)
+	}
+	return res
+}
+
+func polyMul(a, b []fr.Element) []fr.Element {
+	res := make([]fr.Element, len(a)+len(b)-1)
+	for i := range a {
+		for j := range b {
+			var term fr.Element
+			term.Mul(&a[i], &b[j])
+			res[i+j].Add(&res[i+j], &term)
+		}
+	}
+	return res
+}
+
+// polyDivVanishing divides the polynomial by X^n - 1 and panics if the remainder is not zero.
+func polyDivVanishing(poly []fr.Element, n int) []fr.Element {
+	rem := append([]fr.Element(nil), poly...)
+	quotient := make([]fr.Element, max(len(poly)-n, 0))
+	for i := len(rem) - 1; i >= n; i-- {
+		quotient[i-n].Add(&quotient[i-n], &rem[i])
+		rem[i-n].Add(&rem[i-n], &rem[i])
+		rem[i].SetZero()
+	}
+
+	for i := range rem {
+		if !rem[i].IsZero() {
+			panic("polynomial is not divisible by the vanishing polynomial")
+		}
+	}
+
+	return quotient
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+)
+
+// NbPublicInputs is the number of public inputs of the state transition proofs. Verifying keys must
+// accept exactly this number of public inputs.
+const NbPublicInputs = 2
+
+// PublicInputs returns the public inputs of the proof that the header follows from the trusted consensus state.
+// The chain ID, the trusted height, root and timestamp and the new height, root and timestamp are committed to
+// with a sha256 digest, which is split into its two 16-byte halves so that each public input fits in the BN254
+// scalar field. Provers must compute the same digest inside the proven program.
+func PublicInputs(chainID string, trustedHeight clienttypes.Height, trustedConsensusState *ConsensusState, header *Header) [][]byte {
+	bz := binary.BigEndian.AppendUint64(nil, uint64(len(chainID)))
+	bz = append(bz, chainID...)
+	bz = binary.BigEndian.AppendUint64(bz, trustedHeight.RevisionNumber)
+	bz = binary.BigEndian.AppendUint64(bz, trustedHeight.RevisionHeight)
+	bz = append(bz, trustedConsensusState.Root...)
+	bz = binary.BigEndian.AppendUint64(bz, trustedConsensusState.Timestamp)
+	bz = binary.BigEndian.AppendUint64(bz, header.Height.RevisionNumber)
+	bz = binary.BigEndian.AppendUint64(bz, header.Height.RevisionHeight)
+	bz = append(bz, header.Root...)
+	bz = binary.BigEndian.AppendUint64(bz, header.Timestamp)
+
+	digest := sha256.Sum256(bz)
+	return [][]byte{digest[:16], digest[16:]}
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Command gnark generates the gnark reference vectors of the zk light client verifiers, proven by the gnark
+// v0.13.0 PLONK and Groth16 provers over BN254. The vectors are regenerated with:
+//
+//	go run . > ../gnark_vectors.json
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/backend/plonk"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/logger"
+	"github.com/consensys/gnark/test/unsafekzg"
+)
+
+// cubicCircuit proves knowledge of x such that x^3 + x + 5 == y, with an additional public input z == x * y.
+type cubicCircuit struct {
+	X frontend.Variable
+	Y frontend.Variable `gnark:",public"`
+	Z frontend.Variable `gnark:",public"`
+}
+
+func (c *cubicCircuit) Define(api frontend.API) error {
+	x3 := api.Mul(c.X, c.X, c.X)
+	api.AssertIsEqual(c.Y, api.Add(x3, c.X, 5))
+	api.AssertIsEqual(c.Z, api.Mul(c.X, c.Y))
+	return nil
+}
+
+// commitCircuit is the cubic circuit with a BSB22 commitment to the private input.
+type commitCircuit struct {
+	cubicCircuit
+}
+
+func (c *commitCircuit) Define(api frontend.API) error {
+	if err := c.cubicCircuit.Define(api); err != nil {
+		return err
+	}
+	committer := api.(frontend.Committer)
+	cm, err := committer.Commit(c.X)
+	if err != nil {
+		return err
+	}
+	api.AssertIsDifferent(cm, 0)
+	return nil
+}
+
+type plonkVector struct {
+	Name         string   `json:"name"`
+	VerifyingKey string   `json:"verifying_key"`
+	Proof        string   `json:"proof"`
+	PublicInputs []string `json:"public_inputs"`
+}
+
+type groth16Vector struct {
+	Name         string   `json:"name"`
+	Alpha        string   `json:"alpha"`
+	Beta         string   `json:"beta"`
+	Gamma        string   `json:"gamma"`
+	Delta        string   `json:"delta"`
+	IC           []string `json:"ic"`
+	A            string   `json:"a"`
+	B            string   `json:"b"`
+	C            string   `json:"c"`
+	PublicInputs []string `json:"public_inputs"`
+}
+
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func inputs() []string {
+	y := big.NewInt(3*3*3 + 3 + 5)
+	z := new(big.Int).Mul(big.NewInt(3), y)
+	return []string{hex.EncodeToString(pad(y)), hex.EncodeToString(pad(z))}
+}
+
+func pad(v *big.Int) []byte {
+	bz := make([]byte, 32)
+	v.FillBytes(bz)
+	return bz
+}
+
+func assignment() cubicCircuit {
+	return cubicCircuit{X: 3, Y: 35, Z: 105}
+}
+
+func plonkVec(name string, circuit, assign frontend.Circuit) plonkVector {
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, circuit)
+	must(err)
+	srs, srsLagrange, err := unsafekzg.NewSRS(ccs)
+	must(err)
+	pk, vk, err := plonk.Setup(ccs, srs, srsLagrange)
+	must(err)
+	witness, err := frontend.NewWitness(assign, ecc.BN254.ScalarField())
+	must(err)
+	proof, err := plonk.Prove(ccs, pk, witness)
+	must(err)
+	publicWitness, err := witness.Public()
+	must(err)
+	must(plonk.Verify(proof, vk, publicWitness))
+
+	var vkBuf, proofBuf bytes.Buffer
+	_, err = vk.WriteTo(&vkBuf)
+	must(err)
+	_, err = proof.WriteTo(&proofBuf)
+	must(err)
+	return plonkVector{Name: name, VerifyingKey: hex.EncodeToString(vkBuf.Bytes()), Proof: hex.EncodeToString(proofBuf.Bytes()), PublicInputs: inputs()}
+}
+
+func g1(p *bn254.G1Affine) string { bz := p.Bytes(); return hex.EncodeToString(bz[:]) }
+func g2(p *bn254.G2Affine) string { bz := p.Bytes(); return hex.EncodeToString(bz[:]) }
+
+func main() {
+	logger.Disable()
+
+	a := assignment()
+	vectors := struct {
+		Plonk   []plonkVector   `json:"plonk"`
+		Groth16 []groth16Vector `json:"groth16"`
+	}{}
+	vectors.Plonk = append(vectors.Plonk,
+		plonkVec("cubic", &cubicCircuit{}, &a),
+		plonkVec("cubic with bsb22 commitment", &commitCircuit{}, &commitCircuit{cubicCircuit: a}),
+	)
+
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &cubicCircuit{})
+	must(err)
+	pk, vk, err := groth16.Setup(ccs)
+	must(err)
+	witness, err := frontend.NewWitness(&a, ecc.BN254.ScalarField())
+	must(err)
+	proof, err := groth16.Prove(ccs, pk, witness)
+	must(err)
+	publicWitness, err := witness.Public()
+	must(err)
+	must(groth16.Verify(proof, vk, publicWitness))
+
+	gvk := vk.(*groth16bn254.VerifyingKey)
+	gproof := proof.(*groth16bn254.Proof)
+	g := groth16Vector{
+		Name:         "cubic",
+		Alpha:        g1(&gvk.G1.Alpha),
+		Beta:         g2(&gvk.G2.Beta),
+		Gamma:        g2(&gvk.G2.Gamma),
+		Delta:        g2(&gvk.G2.Delta),
+		A:            g1(&gproof.Ar),
+		B:            g2(&gproof.Bs),
+		C:            g1(&gproof.Krs),
+		PublicInputs: inputs(),
+	}
+	for i := range gvk.G1.K {
+		g.IC = append(g.IC, g1(&gvk.G1.K[i]))
+	}
+	vectors.Groth16 = append(vectors.Groth16, g)
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	must(enc.Encode(vectors))
+}
@@ -0,0 +1,624 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/lightclients/zk/v1/tx.proto
+
+package zk
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateVerifier defines the sdk.Msg type to replace the verifier of a zk
+// light client, for example to upgrade the program specific verifying key.
+type MsgUpdateVerifier struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// identifier of the zk client
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// new verifier of the client
+	Verifier Verifier `protobuf:"bytes,3,opt,name=verifier,proto3" json:"verifier"`
+}
+
+func (m *MsgUpdateVerifier) Reset()         { *m = MsgUpdateVerifier{} }
+func (m *MsgUpdateVerifier) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateVerifier) ProtoMessage()    {}
+func (*MsgUpdateVerifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c88114a0a7dac474, []int{0}
+}
+func (m *MsgUpdateVerifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateVerifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateVerifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateVerifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateVerifier.Merge(m, src)
+}
+func (m *MsgUpdateVerifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateVerifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateVerifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateVerifier proto.InternalMessageInfo
+
+// MsgUpdateVerifierResponse defines the Msg/UpdateVerifier response type.
+type MsgUpdateVerifierResponse struct {
+}
+
+func (m *MsgUpdateVerifierResponse) Reset()         { *m = MsgUpdateVerifierResponse{} }
+func (m *MsgUpdateVerifierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateVerifierResponse) ProtoMessage()    {}
+func (*MsgUpdateVerifierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c88114a0a7dac474, []int{1}
+}
+func (m *MsgUpdateVerifierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateVerifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateVerifierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateVerifierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateVerifierResponse.Merge(m, src)
+}
+func (m *MsgUpdateVerifierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateVerifierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateVerifierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateVerifierResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateVerifier)(nil), "ibc.lightclients.zk.v1.MsgUpdateVerifier")
+	proto.RegisterType((*MsgUpdateVerifierResponse)(nil), "ibc.lightclients.zk.v1.MsgUpdateVerifierResponse")
+}
+
+func init() { proto.RegisterFile("ibc/lightclients/zk/v1/tx.proto", fileDescriptor_c88114a0a7dac474) }
+
+var fileDescriptor_c88114a0a7dac474 = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x73, 0x56, 0x4b, 0x7b, 0x82, 0x62, 0x90, 0x5a, 0x53, 0x48, 0x4b, 0xa7, 0x5a, 0xe8,
+	0x1d, 0xa9, 0x8b, 0xe8, 0xd6, 0xcd, 0xa1, 0x4b, 0x40, 0x07, 0x17, 0x31, 0x97, 0xf3, 0x7a, 0xa4,
+	0xc9, 0x95, 0xdc, 0x35, 0x48, 0x70, 0x10, 0x27, 0x47, 0xbf, 0x80, 0xe0, 0x47, 0xe8, 0xc7, 0xe8,
+	0xd8, 0xd1, 0x49, 0xa4, 0x1d, 0xfa, 0x35, 0xa4, 0x49, 0xaa, 0x62, 0x2d, 0xb8, 0xbd, 0x7b, 0xf7,
+	0x7f, 0xff, 0xdf, 0x7b, 0xfc, 0x61, 0x95, 0x3b, 0x04, 0xf7, 0x39, 0xeb, 0x29, 0xd2, 0xe7, 0x34,
+	0x50, 0x12, 0xc7, 0x1e, 0x8e, 0x2c, 0xac, 0xee, 0xd0, 0x20, 0x14, 0x4a, 0xe8, 0x25, 0xee, 0x10,
+	0xf4, 0x53, 0x80, 0x62, 0x0f, 0x45, 0x96, 0x71, 0x40, 0x84, 0xf4, 0x85, 0xc4, 0xbe, 0x64, 0x0b,
+	0xbd, 0x2f, 0x59, 0x3a, 0x60, 0xec, 0x33, 0xc1, 0x44, 0x52, 0xe2, 0x45, 0x95, 0x75, 0xd7, 0x71,
+	0x62, 0x2f, 0x15, 0xd4, 0x5f, 0x00, 0xdc, 0xeb, 0x4a, 0x76, 0x31, 0x70, 0x6f, 0x14, 0xbd, 0xa4,
+	0x21, 0xbf, 0xe5, 0x34, 0xd4, 0x4b, 0x30, 0x2f, 0x39, 0x0b, 0x68, 0x58, 0x06, 0x35, 0xd0, 0x28,
+	0xda, 0xd9, 0x4b, 0xaf, 0xc0, 0x62, 0xea, 0x73, 0xcd, 0xdd, 0xf2, 0x46, 0xf2, 0x55, 0x48, 0x1b,
+	0xe7, 0xae, 0xde, 0x81, 0x85, 0x28, 0x33, 0x28, 0xe7, 0x6a, 0xa0, 0xb1, 0xdd, 0xae, 0xa1, 0xbf,
+	0xaf, 0x40, 0x4b, 0x50, 0x67, 0x73, 0xfc, 0x5e, 0xd5, 0xec, 0xaf, 0xb9, 0xd3, 0xdd, 0xa7, 0xd7,
+	0xaa, 0xf6, 0x38, 0x1f, 0x35, 0x33, 0x62, 0xbd, 0x02, 0x0f, 0x57, 0xd6, 0xb3, 0xa9, 0x1c, 0x88,
+	0x40, 0xd2, 0xf6, 0x3d, 0xcc, 0x75, 0x25, 0xd3, 0x03, 0xb8, 0xf3, 0x6b, 0xff, 0xa3, 0x75, 0xe0,
+	0x15, 0x2f, 0xc3, 0xfa, 0xb7, 0x74, 0x89, 0x35, 0xb6, 0x1e, 0xe6, 0xa3, 0x26, 0xe8, 0xd8, 0xe3,
+	0xa9, 0x09, 0x26, 0x53, 0x13, 0x7c, 0x4c, 0x4d, 0xf0, 0x3c, 0x33, 0xb5, 0xc9, 0xcc, 0xd4, 0xde,
+	0x66, 0xa6, 0x76, 0x75, 0xc2, 0xb8, 0xea, 0x0d, 0x1d, 0x44, 0x84, 0x8f, 0xb3, 0xbc, 0xb8, 0x43,
+	0x5a, 0x4c, 0xe0, 0xc8, 0xb2, 0xb0, 0x2f, 0xdc, 0x61, 0x9f, 0xca, 0x34, 0x96, 0xd6, 0x77, 0x2e,
+	0x67, 0xb1, 0xe7, 0xe4, 0x93, 0x54, 0x8e, 0x3f, 0x07, 0x00, 0x46, 0x05, 0xdf, 0x8a, 0x20, 0x02,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateVerifier defines a rpc handler method for MsgUpdateVerifier.
+	UpdateVerifier(ctx context.Context, in *MsgUpdateVerifier, opts ...grpc.CallOption) (*MsgUpdateVerifierResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateVerifier(ctx context.Context, in *MsgUpdateVerifier, opts ...grpc.CallOption) (*MsgUpdateVerifierResponse, error) {
+	out := new(MsgUpdateVerifierResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.zk.v1.Msg/UpdateVerifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateVerifier defines a rpc handler method for MsgUpdateVerifier.
+	UpdateVerifier(context.Context, *MsgUpdateVerifier) (*MsgUpdateVerifierResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateVerifier(ctx context.Context, req *MsgUpdateVerifier) (*MsgUpdateVerifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVerifier not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateVerifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateVerifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateVerifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.zk.v1.Msg/UpdateVerifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateVerifier(ctx, req.(*MsgUpdateVerifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.lightclients.zk.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateVerifier",
+			Handler:    _Msg_UpdateVerifier_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/lightclients/zk/v1/tx.proto",
+}
+
+func (m *MsgUpdateVerifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVerifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVerifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Verifier.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVerifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVerifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVerifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateVerifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Verifier.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateVerifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateVerifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVerifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVerifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Verifier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVerifierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVerifierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVerifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
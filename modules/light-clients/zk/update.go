@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+)
+
+// VerifyClientMessage introspects the provided ClientMessage and checks its validity.
+// A Header is considered valid if its timestamp is after the trusted consensus state and not too far ahead of
+// the block time, and its proof verifies under the verifying key of the client for the public inputs committing
+// to the trusted and new states.
+func (cs *ClientState) VerifyClientMessage(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, verifier ProofVerifier, clientMsg exported.ClientMessage) error {
+	if cs.IsFrozen {
+		return ErrClientFrozen
+	}
+
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected type %T, got %T", (*Header)(nil), clientMsg)
+	}
+
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+
+	trustedConsensusState, found := getConsensusState(clientStore, cdc, header.TrustedHeight)
+	if !found {
+		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "could not get trusted consensus state from clientStore for trusted height: %s", header.TrustedHeight)
+	}
+
+	if header.Timestamp <= trustedConsensusState.Timestamp {
+		return errorsmod.Wrapf(ErrInvalidHeader, "header timestamp %d must be after trusted timestamp %d", header.Timestamp, trustedConsensusState.Timestamp)
+	}
+
+	maxTimestamp := uint64(ctx.BlockTime().Add(cs.MaxClockDrift).UnixNano())
+	if header.Timestamp > maxTimestamp {
+		return errorsmod.Wrapf(ErrInvalidHeader, "header timestamp %d is after the block time plus max clock drift %d", header.Timestamp, maxTimestamp)
+	}
+
+	publicInputs := PublicInputs(cs.ChainId, header.TrustedHeight, trustedConsensusState, header)
+	if err := verifier.Verify(cs.Verifier.VerifyingKey, header.Proof, publicInputs); err != nil {
+		return errorsmod.Wrapf(err, "failed to verify proof from trusted height %s to height %s", header.TrustedHeight, header.Height)
+	}
+
+	return nil
+}
+
+// CheckForMisbehaviour returns true if a consensus state is already stored at the height of the header and it
+// conflicts with the state proven by the header.
+func (ClientState) CheckForMisbehaviour(cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) bool {
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		return false
+	}
+
+	existingConsensusState, found := getConsensusState(clientStore, cdc, header.Height)
+	if !found {
+		return false
+	}
+
+	return existingConsensusState.Timestamp != header.Timestamp || !bytes.Equal(existingConsensusState.Root, header.Root)
+}
+
+// UpdateState stores the consensus state proven by the header and updates the latest height of the client.
+// A list containing the updated consensus height is returned.
+// Since client message is validated in VerifyClientMessage, we don't validate much here, and panics on anything unexpected.
+func (cs *ClientState) UpdateState(cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		panic(fmt.Sprintf("expected type %T, got type %T", (*Header)(nil), clientMsg))
+	}
+
+	setConsensusState(clientStore, cdc, header.consensusState(), header.Height)
+
+	if header.Height.GT(cs.LatestHeight) {
+		cs.LatestHeight = header.Height
+	}
+
+	setClientState(clientStore, cdc, cs)
+
+	return []exported.Height{header.Height}
+}
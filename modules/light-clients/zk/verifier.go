@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package zk
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// ProofSystemGroth16 is the proof system name of the Groth16 verifier over BN254.
+	ProofSystemGroth16 = "groth16-bn254"
+	// ProofSystemPlonk is the proof system name of the gnark PLONK verifier over BN254 with KZG commitments.
+	ProofSystemPlonk = "plonk-bn254"
+)
+
+// ProofVerifier verifies the succinct proofs of a proof system. Verifiers are registered with the light client
+// module by proof system name, so that applications can plug in additional proof systems. The program
+// specific verifying key is stored in the client state and can be replaced through governance.
+type ProofVerifier interface {
+	// ValidateVerifyingKey returns an error if the verifying key cannot be decoded or does not
+	// accept the provided number of public inputs.
+	ValidateVerifyingKey(verifyingKey []byte, nbPublicInputs int) error
+	// Verify returns an error if the proof is not valid for the verifying key and the public inputs.
+	// The public inputs are big-endian encoded unsigned integers.
+	Verify(verifyingKey, proof []byte, publicInputs [][]byte) error
+}
+
+// DefaultVerifiers returns the verifiers of the proof systems supported by default.
+func DefaultVerifiers() map[string]ProofVerifier {
+	return map[string]ProofVerifier{
+		ProofSystemGroth16: Groth16Verifier{},
+		ProofSystemPlonk:   PlonkVerifier{},
+	}
+}
+
+// setPoint decodes the encoded curve point into p, wrapping decoding failures with codespaceErr. The decoding
+// checks that the point lies in the correct subgroup.
+func setPoint(codespaceErr error, name string, bz []byte, p interface{ SetBytes([]byte) (int, error) }) error {
+	n, err := p.SetBytes(bz)
+	if err != nil {
+		return errorsmod.Wrapf(codespaceErr, "invalid %s point: %v", name, err)
+	}
+
+	if n != len(bz) {
+		return errorsmod.Wrapf(codespaceErr, "invalid %s point: %d trailing bytes", name, len(bz)-n)
+	}
+
+	return nil
+}
+
+// setScalar decodes the big-endian encoded scalar into e, wrapping decoding failures with codespaceErr. The scalar
+// must be an element of the BN254 scalar field.
+func setScalar(codespaceErr error, name string, bz []byte, e *fr.Element) error {
+	if len(bz) > fr.Bytes {
+		return errorsmod.Wrapf(codespaceErr, "%s exceeds %d bytes", name, fr.Bytes)
+	}
+
+	scalar := new(big.Int).SetBytes(bz)
+	if scalar.Cmp(fr.Modulus()) >= 0 {
+		return errorsmod.Wrapf(codespaceErr, "%s is not a scalar field element", name)
+	}
+
+	e.SetBigInt(scalar)
+	return nil
+}
+
+// publicInputScalars decodes the public inputs into elements of the BN254 scalar field.
+func publicInputScalars(publicInputs [][]byte) ([]fr.Element, error) {
+	scalars := make([]fr.Element, len(publicInputs))
+	for i, input := range publicInputs {
+		if err := setScalar(ErrInvalidPublicInputs, fmt.Sprintf("public input %d", i), input, &scalars[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return scalars, nil
+}
@@ -0,0 +1,1976 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/lightclients/zk/v1/zk.proto
+
+package zk
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	types1 "github.com/cosmos/ibc-go/v11/modules/core/23-commitment/types"
+	_go "github.com/cosmos/ics23/go"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClientState defines a light client which is updated with succinct proofs that
+// a new counterparty state root follows from a trusted one. Membership proofs
+// are verified against the proven state roots in the configured proof format.
+type ClientState struct {
+	// chain identifier of the counterparty, bound by the proven state transitions
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// latest height the client was updated to
+	LatestHeight types.Height `protobuf:"bytes,2,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height"`
+	// whether the client is frozen
+	IsFrozen bool `protobuf:"varint,3,opt,name=is_frozen,json=isFrozen,proto3" json:"is_frozen,omitempty"`
+	// maximum duration a proven timestamp may be ahead of the block time
+	MaxClockDrift time.Duration `protobuf:"bytes,4,opt,name=max_clock_drift,json=maxClockDrift,proto3,stdduration" json:"max_clock_drift"`
+	// proof specifications of the counterparty commitment store, used by ICS-23
+	// proofs
+	ProofSpecs []*_go.ProofSpec `protobuf:"bytes,5,rep,name=proof_specs,json=proofSpecs,proto3" json:"proof_specs,omitempty"`
+	// verifier of the state transition proofs
+	Verifier Verifier `protobuf:"bytes,6,opt,name=verifier,proto3" json:"verifier"`
+	// format of the membership proofs of the counterparty commitment store
+	ProofFormat types1.ProofFormat `protobuf:"varint,7,opt,name=proof_format,json=proofFormat,proto3,enum=ibc.core.commitment.v1.ProofFormat" json:"proof_format,omitempty"`
+}
+
+func (m *ClientState) Reset()         { *m = ClientState{} }
+func (m *ClientState) String() string { return proto.CompactTextString(m) }
+func (*ClientState) ProtoMessage()    {}
+func (*ClientState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63046753f47102cc, []int{0}
+}
+func (m *ClientState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientState.Merge(m, src)
+}
+func (m *ClientState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientState proto.InternalMessageInfo
+
+// Verifier defines the proof system and the program specific verifying key used
+// to verify state transition proofs.
+type Verifier struct {
+	// name of the proof system, which selects the registered verifier
+	ProofSystem string `protobuf:"bytes,1,opt,name=proof_system,json=proofSystem,proto3" json:"proof_system,omitempty"`
+	// verifying key in the encoding of the proof system
+	VerifyingKey []byte `protobuf:"bytes,2,opt,name=verifying_key,json=verifyingKey,proto3" json:"verifying_key,omitempty"`
+}
+
+func (m *Verifier) Reset()         { *m = Verifier{} }
+func (m *Verifier) String() string { return proto.CompactTextString(m) }
+func (*Verifier) ProtoMessage()    {}
+func (*Verifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63046753f47102cc, []int{1}
+}
+func (m *Verifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Verifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Verifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Verifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Verifier.Merge(m, src)
+}
+func (m *Verifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *Verifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_Verifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Verifier proto.InternalMessageInfo
+
+// ConsensusState defines the consensus state of a zk light client.
+type ConsensusState struct {
+	// timestamp of the counterparty block in nanoseconds
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// 32-byte state root of the counterparty block
+	Root []byte `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (m *ConsensusState) Reset()         { *m = ConsensusState{} }
+func (m *ConsensusState) String() string { return proto.CompactTextString(m) }
+func (*ConsensusState) ProtoMessage()    {}
+func (*ConsensusState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63046753f47102cc, []int{2}
+}
+func (m *ConsensusState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsensusState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsensusState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsensusState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusState.Merge(m, src)
+}
+func (m *ConsensusState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsensusState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusState proto.InternalMessageInfo
+
+// Header defines the client message used to update a zk light client. It holds
+// a succinct proof that the new state root follows from the state root of the
+// trusted consensus state.
+type Header struct {
+	// height of the trusted consensus state
+	TrustedHeight types.Height `protobuf:"bytes,1,opt,name=trusted_height,json=trustedHeight,proto3" json:"trusted_height"`
+	// height of the new consensus state
+	Height types.Height `protobuf:"bytes,2,opt,name=height,proto3" json:"height"`
+	// 32-byte state root at the new height
+	Root []byte `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	// timestamp at the new height in nanoseconds
+	Timestamp uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// proof in the encoding of the proof system of the client
+	Proof []byte `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *Header) Reset()         { *m = Header{} }
+func (m *Header) String() string { return proto.CompactTextString(m) }
+func (*Header) ProtoMessage()    {}
+func (*Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63046753f47102cc, []int{3}
+}
+func (m *Header) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Header.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Header.Merge(m, src)
+}
+func (m *Header) XXX_Size() int {
+	return m.Size()
+}
+func (m *Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Header proto.InternalMessageInfo
+
+// Groth16VerifyingKey defines the verifying key of a Groth16 circuit over BN254.
+// The G1 and G2 points are encoded in the compressed or uncompressed
+// gnark-crypto format.
+type Groth16VerifyingKey struct {
+	Alpha []byte `protobuf:"bytes,1,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	Beta  []byte `protobuf:"bytes,2,opt,name=beta,proto3" json:"beta,omitempty"`
+	Gamma []byte `protobuf:"bytes,3,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	Delta []byte `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	// G1 points of the linear combination of the public inputs, the first point
+	// being the constant term
+	IC [][]byte `protobuf:"bytes,5,rep,name=ic,proto3" json:"ic,omitempty"`
+}
+
+func (m *Groth16VerifyingKey) Reset()         { *m = Groth16VerifyingKey{} }
+func (m *Groth16VerifyingKey) String() string { return proto.CompactTextString(m) }
+func (*Groth16VerifyingKey) ProtoMessage()    {}
+func (*Groth16VerifyingKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63046753f47102cc, []int{4}
+}
+func (m *Groth16VerifyingKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Groth16VerifyingKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Groth16VerifyingKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Groth16VerifyingKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Groth16VerifyingKey.Merge(m, src)
+}
+func (m *Groth16VerifyingKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *Groth16VerifyingKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_Groth16VerifyingKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Groth16VerifyingKey proto.InternalMessageInfo
+
+// Groth16Proof defines a Groth16 proof over BN254.
+type Groth16Proof struct {
+	A []byte `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B []byte `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	C []byte `protobuf:"bytes,3,opt,name=c,proto3" json:"c,omitempty"`
+}
+
+func (m *Groth16Proof) Reset()         { *m = Groth16Proof{} }
+func (m *Groth16Proof) String() string { return proto.CompactTextString(m) }
+func (*Groth16Proof) ProtoMessage()    {}
+func (*Groth16Proof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63046753f47102cc, []int{5}
+}
+func (m *Groth16Proof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Groth16Proof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Groth16Proof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Groth16Proof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Groth16Proof.Merge(m, src)
+}
+func (m *Groth16Proof) XXX_Size() int {
+	return m.Size()
+}
+func (m *Groth16Proof) XXX_DiscardUnknown() {
+	xxx_messageInfo_Groth16Proof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Groth16Proof proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.zk.v1.ClientState")
+	proto.RegisterType((*Verifier)(nil), "ibc.lightclients.zk.v1.Verifier")
+	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.zk.v1.ConsensusState")
+	proto.RegisterType((*Header)(nil), "ibc.lightclients.zk.v1.Header")
+	proto.RegisterType((*Groth16VerifyingKey)(nil), "ibc.lightclients.zk.v1.Groth16VerifyingKey")
+	proto.RegisterType((*Groth16Proof)(nil), "ibc.lightclients.zk.v1.Groth16Proof")
+}
+
+func init() { proto.RegisterFile("ibc/lightclients/zk/v1/zk.proto", fileDescriptor_63046753f47102cc) }
+
+var fileDescriptor_63046753f47102cc = []byte{
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xda, 0x48,
+	0x18, 0x66, 0x80, 0x10, 0x32, 0x98, 0xac, 0xe4, 0x8d, 0x22, 0x27, 0x1b, 0x01, 0x9b, 0x1c, 0x96,
+	0x4b, 0x6c, 0x91, 0x48, 0xab, 0x68, 0x23, 0xed, 0x01, 0xd2, 0x7c, 0x28, 0x97, 0xca, 0x91, 0xa2,
+	0xaa, 0x17, 0x34, 0x1e, 0x0f, 0x66, 0x84, 0xcd, 0x58, 0x9e, 0x01, 0x05, 0x7e, 0x41, 0x7b, 0xeb,
+	0xb1, 0xc7, 0x1e, 0xfb, 0x53, 0x72, 0xcc, 0xb1, 0xbd, 0xa4, 0x15, 0xf9, 0x23, 0xd5, 0x7c, 0x18,
+	0xd2, 0xaa, 0x87, 0xf6, 0x36, 0xcf, 0xcb, 0xf3, 0xbc, 0xef, 0xf3, 0x7e, 0x60, 0xd8, 0xa4, 0x01,
+	0xf6, 0x62, 0x1a, 0x0d, 0x05, 0x8e, 0x29, 0x19, 0x0b, 0xee, 0xcd, 0x47, 0xde, 0xb4, 0xe3, 0xcd,
+	0x47, 0x6e, 0x9a, 0x31, 0xc1, 0xec, 0x6d, 0x1a, 0x60, 0xf7, 0x39, 0xc1, 0x9d, 0x8f, 0xdc, 0x69,
+	0x67, 0x77, 0x0f, 0x33, 0x9e, 0x30, 0xee, 0x51, 0xcc, 0x8f, 0x8e, 0xa5, 0x22, 0xcd, 0x18, 0x1b,
+	0x70, 0xad, 0xda, 0xdd, 0x8a, 0x58, 0xc4, 0xd4, 0xd3, 0x93, 0x2f, 0x13, 0x6d, 0x44, 0x8c, 0x45,
+	0x31, 0xf1, 0x14, 0x0a, 0x26, 0x03, 0x2f, 0x9c, 0x64, 0x48, 0x50, 0x36, 0x36, 0xbf, 0x2b, 0x33,
+	0x98, 0x65, 0xc4, 0xd3, 0xb5, 0x64, 0x5a, 0xfd, 0x32, 0x84, 0x7f, 0x56, 0x04, 0x96, 0x24, 0x54,
+	0x24, 0x39, 0x69, 0x89, 0x34, 0x71, 0xff, 0x63, 0x09, 0xd6, 0x7a, 0x4a, 0x79, 0x23, 0x90, 0x20,
+	0xf6, 0x0e, 0xac, 0xe2, 0x21, 0xa2, 0xe3, 0x3e, 0x0d, 0x1d, 0xd0, 0x02, 0xed, 0x0d, 0x7f, 0x5d,
+	0xe1, 0xab, 0xd0, 0x7e, 0x01, 0xeb, 0x31, 0x12, 0x84, 0x8b, 0xfe, 0x90, 0xc8, 0x36, 0x9d, 0x62,
+	0x0b, 0xb4, 0x6b, 0x47, 0xbb, 0xae, 0x6c, 0x5c, 0xd6, 0x72, 0x8d, 0x85, 0x69, 0xc7, 0xbd, 0x54,
+	0x8c, 0x6e, 0xf9, 0xfe, 0xb1, 0x59, 0xf0, 0x2d, 0x2d, 0xd3, 0x31, 0xfb, 0x2f, 0xb8, 0x41, 0x79,
+	0x7f, 0x90, 0xb1, 0x39, 0x19, 0x3b, 0xa5, 0x16, 0x68, 0x57, 0xfd, 0x2a, 0xe5, 0xe7, 0x0a, 0xdb,
+	0xd7, 0xf0, 0x8f, 0x04, 0xdd, 0xf5, 0x71, 0xcc, 0xf0, 0xa8, 0x1f, 0x66, 0x74, 0x20, 0x9c, 0xb2,
+	0xaa, 0xb2, 0xe3, 0xea, 0x91, 0xb8, 0xf9, 0x48, 0xdc, 0x33, 0x33, 0x92, 0x6e, 0x55, 0x16, 0x79,
+	0xff, 0xa5, 0x09, 0xfc, 0x7a, 0x82, 0xee, 0x7a, 0x52, 0x7a, 0x26, 0x95, 0xf6, 0x29, 0xac, 0xa9,
+	0x59, 0xf7, 0x79, 0x4a, 0x30, 0x77, 0xd6, 0x5a, 0x25, 0x65, 0x57, 0xef, 0xc3, 0x55, 0xfb, 0x90,
+	0x5e, 0x5f, 0x4a, 0xce, 0x4d, 0x4a, 0xb0, 0x0f, 0xd3, 0xfc, 0xc9, 0xed, 0x2e, 0xac, 0x4e, 0x49,
+	0x46, 0x07, 0x94, 0x64, 0x4e, 0x45, 0x59, 0x68, 0xb9, 0x3f, 0xdf, 0xb0, 0x7b, 0x6b, 0x78, 0xa6,
+	0xdd, 0xa5, 0xce, 0x3e, 0x87, 0x96, 0x36, 0x30, 0x60, 0x59, 0x82, 0x84, 0xb3, 0xde, 0x02, 0xed,
+	0xcd, 0xa3, 0x83, 0x67, 0x03, 0x5b, 0xad, 0x23, 0x37, 0x72, 0xae, 0xa8, 0x7e, 0x2d, 0x5d, 0x81,
+	0xff, 0xca, 0x6f, 0x3e, 0x34, 0x0b, 0xfb, 0xaf, 0x60, 0x35, 0xaf, 0x64, 0xff, 0x9d, 0x67, 0xe6,
+	0x33, 0x2e, 0x48, 0x62, 0x56, 0xa5, 0x45, 0x37, 0x2a, 0x64, 0x1f, 0xc0, 0xba, 0x32, 0x32, 0xa3,
+	0xe3, 0xa8, 0x3f, 0x22, 0x33, 0xb5, 0x2e, 0xcb, 0xb7, 0x96, 0xc1, 0x6b, 0x32, 0x33, 0x99, 0x2f,
+	0xe1, 0x66, 0x8f, 0x8d, 0x39, 0x19, 0xf3, 0x09, 0xd7, 0x67, 0xb0, 0x07, 0x37, 0x04, 0x4d, 0x08,
+	0x17, 0x28, 0x49, 0x55, 0xf2, 0xb2, 0xbf, 0x0a, 0xd8, 0x36, 0x2c, 0x67, 0x8c, 0x09, 0x93, 0x51,
+	0xbd, 0x4d, 0xa6, 0xcf, 0x00, 0x56, 0x2e, 0x09, 0x0a, 0x49, 0x66, 0x5f, 0xc0, 0x4d, 0x91, 0x4d,
+	0xb8, 0x20, 0x61, 0x7e, 0x2f, 0xe0, 0x17, 0xef, 0xa5, 0x6e, 0x74, 0xe6, 0x60, 0x4e, 0x60, 0xe5,
+	0x37, 0x0f, 0xce, 0xf0, 0x97, 0x3e, 0x4b, 0x2b, 0x9f, 0xdf, 0x77, 0x56, 0xfe, 0xb1, 0xb3, 0x2d,
+	0xb8, 0xa6, 0x66, 0xe8, 0xac, 0x29, 0x89, 0x06, 0xa6, 0xb7, 0xb7, 0x00, 0xfe, 0x79, 0x91, 0x31,
+	0x31, 0xec, 0xfc, 0x7b, 0xfb, 0x6c, 0x86, 0x52, 0x83, 0xe2, 0x74, 0x88, 0x54, 0x7f, 0x96, 0xaf,
+	0x81, 0xac, 0x1d, 0x10, 0x81, 0xf2, 0x19, 0xc9, 0xb7, 0x64, 0x46, 0x28, 0x49, 0x90, 0x31, 0xa4,
+	0x81, 0x8c, 0x86, 0x24, 0x16, 0x48, 0xb9, 0xb1, 0x7c, 0x0d, 0xec, 0x6d, 0x58, 0xa4, 0x58, 0xdd,
+	0xac, 0xd5, 0xad, 0x2c, 0x1e, 0x9b, 0xc5, 0xab, 0x9e, 0x5f, 0xa4, 0xd8, 0x78, 0xf9, 0x1f, 0x5a,
+	0xc6, 0x8a, 0x3a, 0x1a, 0xdb, 0x82, 0x20, 0xaf, 0x0f, 0x90, 0x44, 0x81, 0x29, 0x0c, 0x02, 0x89,
+	0xb0, 0xa9, 0x08, 0x8c, 0xbe, 0xeb, 0xdf, 0x2f, 0x1a, 0xe0, 0x61, 0xd1, 0x00, 0x5f, 0x17, 0x0d,
+	0xf0, 0xee, 0xa9, 0x51, 0x78, 0x78, 0x6a, 0x14, 0x3e, 0x3d, 0x35, 0x0a, 0xaf, 0x4f, 0x22, 0x2a,
+	0x86, 0x93, 0x40, 0x9e, 0xa6, 0x97, 0x7f, 0xb9, 0x02, 0x7c, 0x18, 0x31, 0x6f, 0xda, 0xe9, 0x78,
+	0x09, 0x0b, 0x27, 0x31, 0xe1, 0xfa, 0x43, 0x78, 0xb8, 0xfa, 0x12, 0x9e, 0xce, 0x47, 0x41, 0x45,
+	0xfd, 0x35, 0x8f, 0xbf, 0x0d, 0x00, 0x03, 0x40, 0xc5, 0x3b, 0x2a, 0x05, 0x00, 0x00,
+}
+
+func (m *ClientState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofFormat != 0 {
+		i = encodeVarintZk(dAtA, i, uint64(m.ProofFormat))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Verifier.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintZk(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ProofSpecs) > 0 {
+		for iNdEx := len(m.ProofSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintZk(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxClockDrift, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxClockDrift):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintZk(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.IsFrozen {
+		i--
+		if m.IsFrozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.LatestHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintZk(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Verifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Verifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Verifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerifyingKey) > 0 {
+		i -= len(m.VerifyingKey)
+		copy(dAtA[i:], m.VerifyingKey)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.VerifyingKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProofSystem) > 0 {
+		i -= len(m.ProofSystem)
+		copy(dAtA[i:], m.ProofSystem)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.ProofSystem)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsensusState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintZk(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintZk(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintZk(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TrustedHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintZk(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Groth16VerifyingKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Groth16VerifyingKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Groth16VerifyingKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IC) > 0 {
+		for iNdEx := len(m.IC) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IC[iNdEx])
+			copy(dAtA[i:], m.IC[iNdEx])
+			i = encodeVarintZk(dAtA, i, uint64(len(m.IC[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Delta) > 0 {
+		i -= len(m.Delta)
+		copy(dAtA[i:], m.Delta)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.Delta)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Gamma) > 0 {
+		i -= len(m.Gamma)
+		copy(dAtA[i:], m.Gamma)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.Gamma)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Beta) > 0 {
+		i -= len(m.Beta)
+		copy(dAtA[i:], m.Beta)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.Beta)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Alpha) > 0 {
+		i -= len(m.Alpha)
+		copy(dAtA[i:], m.Alpha)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.Alpha)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Groth16Proof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Groth16Proof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Groth16Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.C) > 0 {
+		i -= len(m.C)
+		copy(dAtA[i:], m.C)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.C)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.B) > 0 {
+		i -= len(m.B)
+		copy(dAtA[i:], m.B)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.B)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.A) > 0 {
+		i -= len(m.A)
+		copy(dAtA[i:], m.A)
+		i = encodeVarintZk(dAtA, i, uint64(len(m.A)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintZk(dAtA []byte, offset int, v uint64) int {
+	offset -= sovZk(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClientState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	l = m.LatestHeight.Size()
+	n += 1 + l + sovZk(uint64(l))
+	if m.IsFrozen {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxClockDrift)
+	n += 1 + l + sovZk(uint64(l))
+	if len(m.ProofSpecs) > 0 {
+		for _, e := range m.ProofSpecs {
+			l = e.Size()
+			n += 1 + l + sovZk(uint64(l))
+		}
+	}
+	l = m.Verifier.Size()
+	n += 1 + l + sovZk(uint64(l))
+	if m.ProofFormat != 0 {
+		n += 1 + sovZk(uint64(m.ProofFormat))
+	}
+	return n
+}
+
+func (m *Verifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProofSystem)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	l = len(m.VerifyingKey)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsensusState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovZk(uint64(m.Timestamp))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	return n
+}
+
+func (m *Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TrustedHeight.Size()
+	n += 1 + l + sovZk(uint64(l))
+	l = m.Height.Size()
+	n += 1 + l + sovZk(uint64(l))
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovZk(uint64(m.Timestamp))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	return n
+}
+
+func (m *Groth16VerifyingKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Alpha)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	l = len(m.Beta)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	l = len(m.Gamma)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	l = len(m.Delta)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	if len(m.IC) > 0 {
+		for _, b := range m.IC {
+			l = len(b)
+			n += 1 + l + sovZk(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Groth16Proof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.A)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	l = len(m.B)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	l = len(m.C)
+	if l > 0 {
+		n += 1 + l + sovZk(uint64(l))
+	}
+	return n
+}
+
+func sovZk(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozZk(x uint64) (n int) {
+	return sovZk(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFrozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFrozen = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClockDrift", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxClockDrift, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSpecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofSpecs = append(m.ProofSpecs, &_go.ProofSpec{})
+			if err := m.ProofSpecs[len(m.ProofSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Verifier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofFormat", wireType)
+			}
+			m.ProofFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofFormat |= types1.ProofFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Verifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Verifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Verifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSystem", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofSystem = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyingKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyingKey = append(m.VerifyingKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerifyingKey == nil {
+				m.VerifyingKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrustedHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Groth16VerifyingKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Groth16VerifyingKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Groth16VerifyingKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alpha = append(m.Alpha[:0], dAtA[iNdEx:postIndex]...)
+			if m.Alpha == nil {
+				m.Alpha = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beta = append(m.Beta[:0], dAtA[iNdEx:postIndex]...)
+			if m.Beta == nil {
+				m.Beta = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gamma = append(m.Gamma[:0], dAtA[iNdEx:postIndex]...)
+			if m.Gamma == nil {
+				m.Gamma = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delta = append(m.Delta[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delta == nil {
+				m.Delta = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IC", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IC = append(m.IC, make([]byte, postIndex-iNdEx))
+			copy(m.IC[len(m.IC)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Groth16Proof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Groth16Proof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Groth16Proof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.A = append(m.A[:0], dAtA[iNdEx:postIndex]...)
+			if m.A == nil {
+				m.A = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.B = append(m.B[:0], dAtA[iNdEx:postIndex]...)
+			if m.B == nil {
+				m.B = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.C = append(m.C[:0], dAtA[iNdEx:postIndex]...)
+			if m.C == nil {
+				m.C = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipZk(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowZk
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowZk
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthZk
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupZk
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthZk
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthZk        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowZk          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupZk = fmt.Errorf("proto: unexpected end of group")
+)
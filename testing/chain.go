@@ -297,6 +297,39 @@ func (c *TestChain) QueryProofForStore(storeKey string, key []byte, height int64
 	return proof, clienttypes.NewHeight(revision, uint64(res.Height)+1)
 }
 
+// QueryBatchProof performs an abci query for each of the given keys and returns the proto encoded merkle proof combining
+// them, to be verified by light clients implementing exported.BatchVerifier, and the height at which the proof will succeed
+// on a tendermint verifier. Only the IBC store is supported.
+func (c *TestChain) QueryBatchProof(keys [][]byte) ([]byte, clienttypes.Height) {
+	return c.QueryBatchProofAtHeight(keys, c.App.LastBlockHeight())
+}
+
+// QueryBatchProofAtHeight performs an abci query for each of the given keys at the provided height and returns the proto
+// encoded merkle proof combining them and the height at which the proof will succeed on a tendermint verifier. Only the
+// IBC store is supported.
+func (c *TestChain) QueryBatchProofAtHeight(keys [][]byte, height int64) ([]byte, clienttypes.Height) {
+	var (
+		merkleProofs []commitmenttypes.MerkleProof
+		proofHeight  clienttypes.Height
+	)
+	for _, key := range keys {
+		proof, queryHeight := c.QueryProofAtHeight(key, height)
+		proofHeight = queryHeight
+
+		var merkleProof commitmenttypes.MerkleProof
+		require.NoError(c.TB, c.App.AppCodec().Unmarshal(proof, &merkleProof))
+		merkleProofs = append(merkleProofs, merkleProof)
+	}
+
+	batchProof, err := commitmenttypes.CombineMerkleProofs(merkleProofs)
+	require.NoError(c.TB, err)
+
+	proof, err := c.App.AppCodec().Marshal(&batchProof)
+	require.NoError(c.TB, err)
+
+	return proof, proofHeight
+}
+
 // QueryUpgradeProof performs an abci query with the given key and returns the proto encoded merkle proof
 // for the query and the height at which the proof will succeed on a tendermint verifier.
 func (c *TestChain) QueryUpgradeProof(key []byte, height uint64) ([]byte, clienttypes.Height) {
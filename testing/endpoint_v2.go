@@ -124,6 +124,42 @@ func (ep *Endpoint) MsgAcknowledgePacket(packet channeltypesv2.Packet, ack chann
 	return ep.Counterparty.UpdateClient()
 }
 
+// MsgRecvPackets sends a MsgRecvPackets on the associated endpoint with the provided packets, proven with a single
+// combined proof of their packet commitments.
+func (ep *Endpoint) MsgRecvPackets(packets []channeltypesv2.Packet) error {
+	packetKeys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		packetKeys[i] = hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence)
+	}
+	proof, proofHeight := ep.Counterparty.Chain.QueryBatchProof(packetKeys)
+
+	msg := channeltypesv2.NewMsgRecvPackets(packets, proof, proofHeight, ep.Chain.SenderAccount.GetAddress().String())
+
+	if err := ep.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	return ep.Counterparty.UpdateClient()
+}
+
+// MsgAcknowledgePackets sends a MsgAcknowledgements on the associated endpoint with the provided packets and acks,
+// proven with a single combined proof of the acknowledgements.
+func (ep *Endpoint) MsgAcknowledgePackets(packets []channeltypesv2.Packet, acks []channeltypesv2.Acknowledgement) error {
+	ackKeys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		ackKeys[i] = hostv2.PacketAcknowledgementKey(packet.DestinationClient, packet.Sequence)
+	}
+	proof, proofHeight := ep.Counterparty.Chain.QueryBatchProof(ackKeys)
+
+	msg := channeltypesv2.NewMsgAcknowledgements(packets, acks, proof, proofHeight, ep.Chain.SenderAccount.GetAddress().String())
+
+	if err := ep.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	return ep.Counterparty.UpdateClient()
+}
+
 // MsgTimeoutPacket sends a MsgTimeout on the associated endpoint with the provided packet.
 func (ep *Endpoint) MsgTimeoutPacket(packet channeltypesv2.Packet) error {
 	packetKey := hostv2.PacketReceiptKey(packet.DestinationClient, packet.Sequence)
@@ -87,9 +87,11 @@ import (
 	icacontroller "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/keeper"
 	icacontrollertypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/types"
+	icacontrollerv2 "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/controller/v2"
 	icahost "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/types"
+	icahostv2 "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/host/v2"
 	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
 	packetforward "github.com/cosmos/ibc-go/v11/modules/apps/packet-forward-middleware"
 	packetforwardkeeper "github.com/cosmos/ibc-go/v11/modules/apps/packet-forward-middleware/keeper"
@@ -110,6 +112,9 @@ import (
 	solomachine "github.com/cosmos/ibc-go/v11/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v11/modules/light-clients/07-tendermint"
 	"github.com/cosmos/ibc-go/v11/modules/light-clients/attestations"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/ethereum"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/quorum"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/zk"
 	ibcmock "github.com/cosmos/ibc-go/v11/testing/mock"
 	mockv2 "github.com/cosmos/ibc-go/v11/testing/mock/v2"
 )
@@ -379,6 +384,14 @@ func NewSimApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// Enable the migration of interchain accounts to 27-gmp accounts
+	app.ICAHostKeeper.WithGMPKeeper(app.GMPKeeper)
+
+	// Enforce the send limits of the message policies of the interchain accounts host and pay the relayer fees of
+	// interchain accounts and 27-gmp accounts
+	app.ICAHostKeeper.WithBankKeeper(app.BankKeeper)
+	app.GMPKeeper.WithBankKeeper(app.BankKeeper)
+
 	// Create IBC Router
 	ibcRouter := porttypes.NewRouter()
 	ibcRouterV2 := ibcapi.NewRouter()
@@ -481,10 +494,17 @@ func NewSimApp(
 	// Register the ICS-27 GMP module
 	ibcRouterV2.AddRoute(gmptypes.PortID, gmp.NewIBCModule(app.GMPKeeper))
 
+	// register the interchain accounts v2 modules, all controller ports are routed to the controller module by prefix.
+	ibcRouterV2.AddRoute(icatypes.HostPortID, icahostv2.NewIBCModule(app.ICAHostKeeper))
+	ibcRouterV2.AddPrefixRoute(icatypes.ControllerPortPrefix, icacontrollerv2.NewIBCModule(app.ICAControllerKeeper))
+
 	// Seal the IBC Router
 	app.IBCKeeper.SetRouter(ibcRouter)
 	app.IBCKeeper.SetRouterV2(ibcRouterV2)
 
+	// the channel v2 keeper proves the packet commitments of batch queries against the committed multistore
+	app.IBCKeeper.ChannelKeeperV2.SetStoreQuerier(app.CommitMultiStore().(storetypes.Queryable))
+
 	clientKeeper := app.IBCKeeper.ClientKeeper
 	storeProvider := app.IBCKeeper.ClientKeeper.GetStoreProvider()
 
@@ -497,6 +517,15 @@ func NewSimApp(
 	attestationsLightClientModule := attestations.NewLightClientModule(appCodec, storeProvider)
 	clientKeeper.AddRoute(attestations.ModuleName, &attestationsLightClientModule)
 
+	ethereumLightClientModule := ethereum.NewLightClientModule(appCodec, storeProvider)
+	clientKeeper.AddRoute(ethereum.ModuleName, &ethereumLightClientModule)
+
+	quorumLightClientModule := quorum.NewLightClientModule(appCodec, storeProvider, clientKeeper)
+	clientKeeper.AddRoute(quorum.ModuleName, &quorumLightClientModule)
+
+	zkLightClientModule := zk.NewLightClientModule(appCodec, storeProvider, authtypes.NewModuleAddress(govtypes.ModuleName).String(), zk.DefaultVerifiers())
+	clientKeeper.AddRoute(zk.ModuleName, &zkLightClientModule)
+
 	// ****  Module Options ****
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -531,6 +560,9 @@ func NewSimApp(
 		ibctm.NewAppModule(tmLightClientModule),
 		solomachine.NewAppModule(smLightClientModule),
 		attestations.NewAppModule(attestationsLightClientModule),
+		ethereum.NewAppModule(ethereumLightClientModule),
+		quorum.NewAppModule(quorumLightClientModule),
+		zk.NewAppModule(zkLightClientModule),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
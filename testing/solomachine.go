@@ -501,6 +501,51 @@ func (solo *Solomachine) GenerateProof(signBytes *solomachine.SignBytes) []byte
 	return proof
 }
 
+// GenerateBatchProofs takes in the path and data pairs of a batch, generates a single signature over the
+// Merkle root of the batch and marshals a proof for each pair. Each proof contains the inclusion proof of
+// its pair against the root. The solo machine sequence is incremented once for the batch.
+func (solo *Solomachine) GenerateBatchProofs(paths, data [][]byte) [][]byte {
+	require.Len(solo.t, data, len(paths))
+
+	leaves := make([][]byte, len(paths))
+	for i, path := range paths {
+		leaf, err := solomachine.BatchLeafBytes(solo.cdc, path, data[i])
+		require.NoError(solo.t, err)
+
+		leaves[i] = leaf
+	}
+
+	root, inclusionProofs := solomachine.NewBatchInclusionProofs(leaves)
+	signBytes := &solomachine.SignBytes{
+		Sequence:    solo.Sequence,
+		Timestamp:   solo.Time,
+		Diversifier: solo.Diversifier,
+		Path:        []byte(solomachine.SentinelBatchPath),
+		Data:        root,
+	}
+
+	bz, err := solo.cdc.Marshal(signBytes)
+	require.NoError(solo.t, err)
+
+	sig := solo.GenerateSignature(bz)
+
+	proofs := make([][]byte, len(inclusionProofs))
+	for i, inclusionProof := range inclusionProofs {
+		signatureDoc := &solomachine.TimestampedSignatureData{
+			SignatureData: sig,
+			Timestamp:     solo.Time,
+			Batch:         inclusionProof,
+		}
+
+		proofs[i], err = solo.cdc.Marshal(signatureDoc)
+		require.NoError(solo.t, err)
+	}
+
+	solo.Sequence++
+
+	return proofs
+}
+
 // GenerateConnOpenTryProof generates the proofTry required for the connection open ack handshake step.
 // The clientID, connectionID provided represent the clientID and connectionID created on the counterparty chain, that is the tendermint chain.
 func (solo *Solomachine) GenerateConnOpenTryProof(counterpartyClientID, counterpartyConnectionID string) []byte {